@@ -1,14 +1,41 @@
 package design
 
 import (
+	"github.com/InjectiveLabs/injective-guilds-service/api/errcode"
 	. "goa.design/goa/v3/dsl"
 	_ "goa.design/plugins/v3/docs"
 )
 
+// errorCodes returns codes of errcode package as enum values
+func errorCodes() []interface{} {
+	codes := make([]interface{}, len(errcode.All))
+	for i, code := range errcode.All {
+		codes[i] = code
+	}
+	return codes
+}
+
+var APIError = Type("APIError", func() {
+	Description("Error with a machine-readable code")
+	ErrorName(1, "name", String, "Name of error: not_found, invalid_arg or internal")
+	Field(2, "code", String, "Machine-readable error code", func() {
+		Enum(errorCodes()...)
+	})
+	Field(3, "message", String, "Error message in english")
+	Field(4, "details", MapOf(String, String), "Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE")
+
+	Required("name")
+	Required("code")
+	Required("message")
+})
+
 var Market = Type("Market", func() {
 	Description("Market supported by guild")
 	Field(1, "market_id", String)
 	Field(2, "is_perpetual", Boolean)
+	Field(3, "status", String, "Market status of exchange, empty if it has not been refreshed")
+	Field(4, "maker_fee_rate", String)
+	Field(5, "taker_fee_rate", String)
 
 	Required("market_id")
 	Required("is_perpetual")
@@ -39,6 +66,9 @@ var Guild = Type("Guild", func() {
 	Field(8, "member_count", Int)
 	Field(9, "current_portfolio", SingleGuildPortfolio)
 	Field(10, "default_member_address", String)
+	Field(11, "has_inactive_markets", Boolean, "Guild has delisted or paused markets")
+	Field(12, "archived", Boolean, "Archived guild is closed to new members, its history is kept")
+	Field(13, "access_mode", String, "open, allowlist or invite_code, invite code is passed in params when entering guild")
 
 	Required("id")
 	Required("name")
@@ -67,11 +97,57 @@ var Balance = Type("Balance", func() {
 	Required("price_usd")
 })
 
+var CashFlow = Type("CashFlow", func() {
+	Description("External flow into (positive amount) or out of (negative amount) a portfolio since previous snapshot")
+	Field(1, "type", String, func() {
+		Enum("deposit", "withdraw", "transfer_in", "transfer_out", "member_joined", "member_left")
+	})
+	Field(2, "injective_address", String)
+	Field(3, "denom", String)
+	Field(4, "amount", String)
+	Field(5, "executed_at", Int64)
+
+	Required("type", "denom", "amount", "executed_at")
+})
+
+var Position = Type("Position", func() {
+	Description("Derivative position held by a trading subaccount at snapshot time")
+	Field(1, "subaccount_id", String)
+	Field(2, "market_id", String)
+	Field(3, "ticker", String)
+	Field(4, "quote_denom", String)
+	Field(5, "direction", String)
+	Field(6, "quantity", String)
+	Field(7, "entry_price", String)
+	Field(8, "mark_price", String)
+	Field(9, "margin", String)
+	Field(10, "liquidation_price", String)
+
+	Required("subaccount_id", "market_id", "ticker", "direction", "quantity")
+	Required("entry_price", "mark_price", "margin", "liquidation_price")
+})
+
 var SingleAccountPortfolio = Type("SingleAccountPortfolio", func() {
 	Description("Single account portfio snapshot")
 	Field(1, "injective_address", String)
 	Field(2, "balances", ArrayOf(Balance))
 	Field(3, "updated_at", Int64)
+	Field(4, "value_usd", Float64, func() {
+		Description("Usd value of balances, unrealized pnl and bank balances")
+	})
+	Field(5, "cash_flows", ArrayOf(CashFlow))
+	Field(6, "net_flow_usd", Float64, func() {
+		Description("Usd value of cash flows since previous snapshot")
+	})
+	Field(7, "twr", Float64, func() {
+		Description("Cumulative time-weighted return since first snapshot of the requested range")
+	})
+	Field(8, "mwr", Float64, func() {
+		Description("Cumulative money-weighted (modified Dietz) return since first snapshot of the requested range")
+	})
+	Field(9, "guild_id", String, func() {
+		Description("Guild of the address at the time snapshot was taken")
+	})
 	Required("injective_address")
 	Required("balances")
 	Required("updated_at")
@@ -79,9 +155,9 @@ var SingleAccountPortfolio = Type("SingleAccountPortfolio", func() {
 
 var MonthlyAccountPortfolio = Type("MonthlyAccountPortfolio", func() {
 	Description("Account monthly first and last portfio snapshot of a month")
-	Field(0, "time", UInt64)
-	Field(1, "begin_snapshot", SingleAccountPortfolio)
-	Field(2, "end_snapshot", SingleAccountPortfolio)
+	Field(1, "time", UInt64)
+	Field(2, "begin_snapshot", SingleAccountPortfolio)
+	Field(3, "end_snapshot", SingleAccountPortfolio)
 	Required("time")
 	Required("begin_snapshot")
 	Required("end_snapshot")
@@ -89,9 +165,22 @@ var MonthlyAccountPortfolio = Type("MonthlyAccountPortfolio", func() {
 
 var SingleGuildPortfolio = Type("SingleGuildPortfolio", func() {
 	Description("Single guild portfolio snapshot")
-	Field(0, "guild_id", String)
-	Field(1, "balances", ArrayOf(Balance))
-	Field(2, "updated_at", Int64)
+	Field(1, "guild_id", String)
+	Field(2, "balances", ArrayOf(Balance))
+	Field(3, "updated_at", Int64)
+	Field(4, "value_usd", Float64, func() {
+		Description("Usd value of balances, unrealized pnl and bank balances")
+	})
+	Field(5, "cash_flows", ArrayOf(CashFlow))
+	Field(6, "net_flow_usd", Float64, func() {
+		Description("Usd value of member transfers and joins/leaves since previous snapshot")
+	})
+	Field(7, "twr", Float64, func() {
+		Description("Cumulative time-weighted return since first snapshot of the requested range")
+	})
+	Field(8, "mwr", Float64, func() {
+		Description("Cumulative money-weighted (modified Dietz) return since first snapshot of the requested range")
+	})
 	Required("balances")
 	Required("updated_at")
 })
@@ -103,9 +192,248 @@ var GuildMember = Type("GuildMember", func() {
 	Field(3, "since", Int64)
 	Field(4, "guild_id", String)
 	Field(5, "params", String)
+	Field(6, "subaccount_ids", ArrayOf(String), func() {
+		Description("Registered non-default subaccounts, portfolio is aggregated over default subaccount and these")
+	})
 
 	Required("injective_address")
 	Required("is_default_guild_member")
 	Required("since")
 	Required("params")
 })
+
+var Membership = Type("Membership", func() {
+	Description("Guild membership of an account")
+	Field(1, "guild_id", String)
+	Field(2, "is_default_guild_member", Boolean)
+	Field(3, "since", Int64)
+	Field(4, "ended_at", Int64, func() {
+		Description("Time member left the guild in milliseconds, omitted for current membership")
+	})
+
+	Required("guild_id")
+	Required("is_default_guild_member")
+	Required("since")
+})
+
+var WaitlistEntry = Type("WaitlistEntry", func() {
+	Description("Address waiting for a slot in a guild")
+	Field(1, "injective_address", String)
+	Field(2, "position", Int, func() {
+		Description("1-based position in waitlist")
+	})
+	Field(3, "enqueued_at", Int64)
+
+	Required("injective_address")
+	Required("position")
+	Required("enqueued_at")
+})
+
+var Grant = Type("Grant", func() {
+	Description("Required grant from member to guild master")
+	Field(1, "msg", String)
+	Field(2, "is_granted", Boolean)
+	Field(3, "expiration", Int64, func() {
+		Description("Grant expiration in milliseconds, omitted if not granted")
+	})
+
+	Required("msg")
+	Required("is_granted")
+})
+
+var Trade = Type("Trade", func() {
+	Description("Member fill ingested from exchange api")
+	Field(1, "trade_id", String)
+	Field(2, "subaccount_id", String)
+	Field(3, "market_id", String)
+	Field(4, "is_perpetual", Boolean)
+	Field(5, "direction", String)
+	Field(6, "execution_type", String)
+	Field(7, "price", String)
+	Field(8, "quantity", String)
+	Field(9, "quote_denom", String)
+	Field(10, "fee", String)
+	Field(11, "volume", String, func() {
+		Description("price * quantity in quote denom")
+	})
+	Field(12, "realized_pnl", String, func() {
+		Description("Pnl of closed quantity against average entry price, fee excluded")
+	})
+	Field(13, "executed_at", Int64)
+
+	Required("trade_id", "subaccount_id", "market_id", "is_perpetual", "direction", "execution_type")
+	Required("price", "quantity", "quote_denom", "fee", "volume", "realized_pnl", "executed_at")
+})
+
+var TradeStats = Type("TradeStats", func() {
+	Description("Realized pnl, paid fees and volume of trades in a quote denom")
+	Field(1, "quote_denom", String)
+	Field(2, "realized_pnl", String)
+	Field(3, "fees", String)
+	Field(4, "volume", String)
+	Field(5, "trade_count", Int)
+
+	Required("quote_denom", "realized_pnl", "fees", "volume", "trade_count")
+})
+
+var GuildFeeTotal = Type("GuildFeeTotal", func() {
+	Description("Fees paid by guild members and routed to guild master in a quote denom")
+	Field(1, "quote_denom", String)
+	Field(2, "fees_paid", String, func() {
+		Description("Trading fees paid by members, maker rebates deducted")
+	})
+	Field(3, "master_fees", String, func() {
+		Description("Relayer share of fees earned by guild master address")
+	})
+	Field(4, "volume", String)
+	Field(5, "trade_count", Int)
+	Field(6, "unresolved_fees", String, func() {
+		Description("Relayer share of fees paid on trades whose fee recipient couldn't be resolved, not counted in master_fees")
+	})
+
+	Required("quote_denom", "fees_paid", "master_fees", "volume", "trade_count", "unresolved_fees")
+})
+
+var MemberFeeContribution = Type("MemberFeeContribution", func() {
+	Description("Fees routed to guild master from a member's trades in a quote denom")
+	Field(1, "injective_address", String)
+	Field(2, "quote_denom", String)
+	Field(3, "fees_paid", String)
+	Field(4, "master_fees", String)
+	Field(5, "volume", String)
+	Field(6, "trade_count", Int)
+	Field(7, "share", String, func() {
+		Description("Member master_fees over guild master_fees in the quote denom, from 0 to 1")
+	})
+	Field(8, "unresolved_fees", String)
+
+	Required("injective_address", "quote_denom", "fees_paid", "master_fees", "volume", "trade_count", "share", "unresolved_fees")
+})
+
+var DailyGuildFee = Type("DailyGuildFee", func() {
+	Description("Guild fee totals of a UTC day")
+	Field(1, "day", Int64, func() {
+		Description("Start of UTC day in milliseconds")
+	})
+	Field(2, "quote_denom", String)
+	Field(3, "fees_paid", String)
+	Field(4, "master_fees", String)
+	Field(5, "volume", String)
+	Field(6, "trade_count", Int)
+	Field(7, "unresolved_fees", String)
+
+	Required("day", "quote_denom", "fees_paid", "master_fees", "volume", "trade_count", "unresolved_fees")
+})
+
+var Transfer = Type("Transfer", func() {
+	Description("Deposit into or withdrawal from a member subaccount")
+	Field(1, "transfer_id", String)
+	Field(2, "subaccount_id", String)
+	Field(3, "transfer_type", String, func() {
+		Enum("deposit", "withdraw")
+	})
+	Field(4, "denom", String)
+	Field(5, "amount", String, func() {
+		Description("Positive for deposit, negative for withdrawal")
+	})
+	Field(6, "executed_at", Int64)
+
+	Required("transfer_id", "subaccount_id", "transfer_type", "denom", "amount", "executed_at")
+})
+
+var NetFlow = Type("NetFlow", func() {
+	Description("Deposits and withdrawals aggregated in a denom, transfers with subaccounts outside the guild count as deposits or withdrawals")
+	Field(1, "denom", String)
+	Field(2, "deposits", String)
+	Field(3, "withdrawals", String)
+	Field(4, "net_flow", String, func() {
+		Description("deposits - withdrawals")
+	})
+	Field(5, "transfer_count", Int)
+
+	Required("denom", "deposits", "withdrawals", "net_flow", "transfer_count")
+})
+
+var MarketExposure = Type("MarketExposure", func() {
+	Description("Usd notional of guild positions in a derivative market")
+	Field(1, "market_id", String)
+	Field(2, "ticker", String)
+	Field(3, "notional_usd", Float64)
+	Field(4, "share", Float64, func() {
+		Description("Fraction of guild total notional")
+	})
+
+	Required("market_id", "ticker", "notional_usd", "share")
+})
+
+var GuildRisk = Type("GuildRisk", func() {
+	Description("Guild risk metrics computed at a capture cycle")
+	Field(1, "guild_id", String)
+	Field(2, "window_start", Int64, func() {
+		Description("Time of first guild snapshot used for drawdown, volatility and sharpe ratio")
+	})
+	Field(3, "snapshot_count", Int)
+	Field(4, "max_drawdown", Float64, func() {
+		Description("Largest peak to trough decline of time-weighted value, as a positive fraction")
+	})
+	Field(5, "volatility", Float64, func() {
+		Description("Standard deviation of per-snapshot returns")
+	})
+	Field(6, "sharpe_ratio", Float64, func() {
+		Description("Mean over standard deviation of per-snapshot returns, not annualized")
+	})
+	Field(7, "notional_usd", Float64)
+	Field(8, "equity_usd", Float64)
+	Field(9, "leverage", Float64, func() {
+		Description("Notional over equity")
+	})
+	Field(10, "markets", ArrayOf(MarketExposure))
+	Field(11, "updated_at", Int64)
+
+	Required("guild_id", "window_start", "snapshot_count", "max_drawdown", "volatility", "sharpe_ratio")
+	Required("notional_usd", "equity_usd", "leverage", "markets", "updated_at")
+})
+
+var StreamPortfolio = Type("StreamPortfolio", func() {
+	Description("Portfolio snapshot of a stream update")
+	Field(1, "balances", ArrayOf(Balance))
+	Field(2, "member_count", Int, func() {
+		Description("Member count of guild portfolio, omitted for account portfolio")
+	})
+	Field(3, "updated_at", Int64)
+
+	Required("balances", "updated_at")
+})
+
+var StreamMembership = Type("StreamMembership", func() {
+	Description("Membership change of a stream update")
+	Field(1, "is_default_guild_member", Boolean)
+	Field(2, "since", Int64)
+	Field(3, "ended_at", Int64, func() {
+		Description("Time member left the guild in milliseconds, set for member_left")
+	})
+
+	Required("is_default_guild_member", "since")
+})
+
+var PortfolioUpdate = Type("PortfolioUpdate", func() {
+	Description("Portfolio snapshot or membership change written by the process")
+	Field(1, "kind", String, func() {
+		Enum("guild_portfolio", "account_portfolio", "member_joined", "member_left")
+	})
+	Field(2, "guild_id", String)
+	Field(3, "injective_address", String, func() {
+		Description("Omitted for guild portfolio")
+	})
+	Field(4, "portfolio", StreamPortfolio, func() {
+		Description("Set for guild_portfolio and account_portfolio")
+	})
+	Field(5, "membership", StreamMembership, func() {
+		Description("Set for member_joined and member_left")
+	})
+	Field(6, "id", String, func() {
+		Description("Update ID to resume the stream with")
+	})
+
+	Required("id", "kind", "guild_id")
+})
@@ -18,13 +18,29 @@ var _ = Service("GuildsService", func() {
 		cors.Headers("Content-Type")
 	})
 
-	Error("not_found", ErrorResult, "Not found")
-	Error("invalid_arg", ErrorResult, "Invalid argument")
-	Error("internal", ErrorResult, "Internal Server Error")
+	Error("not_found", APIError, "Not found")
+	Error("invalid_arg", APIError, "Invalid argument")
+	Error("internal", APIError, "Internal Server Error")
+
+	HTTP(func() {
+		Response("not_found", StatusNotFound)
+		Response("invalid_arg", StatusBadRequest)
+		Response("internal", StatusInternalServerError)
+	})
+
+	GRPC(func() {
+		Response("not_found", CodeNotFound)
+		Response("invalid_arg", CodeInvalidArgument)
+		Response("internal", CodeInternal)
+	})
 
 	Method("GetAllGuilds", func() {
 		Description("Get all guilds")
 
+		Payload(func() {
+			Field(1, "include_archived", Boolean, "Include archived guilds")
+		})
+
 		Result(func() {
 			Field(1, "guilds", ArrayOf(Guild), func() {
 				Description("Existing guilds")
@@ -33,11 +49,16 @@ var _ = Service("GuildsService", func() {
 
 		HTTP(func() {
 			GET("/guilds")
+			Param("include_archived")
 
 			Response(CodeOK)
 			Response("not_found", StatusNotFound)
 			Response("internal", StatusInternalServerError)
 		})
+
+		GRPC(func() {
+			Response(CodeOK)
+		})
 	})
 
 	Method("GetSingleGuild", func() {
@@ -62,6 +83,10 @@ var _ = Service("GuildsService", func() {
 			Response("not_found", StatusNotFound)
 			Response("internal", StatusInternalServerError)
 		})
+
+		GRPC(func() {
+			Response(CodeOK)
+		})
 	})
 
 	Method("GetGuildMembers", func() {
@@ -83,9 +108,14 @@ var _ = Service("GuildsService", func() {
 			GET("/guilds/{guildID}/members")
 
 			Response(CodeOK)
+			Response("invalid_arg", StatusBadRequest)
 			Response("not_found", StatusNotFound)
 			Response("internal", StatusInternalServerError)
 		})
+
+		GRPC(func() {
+			Response(CodeOK)
+		})
 	})
 
 	Method("GetGuildMasterAddress", func() {
@@ -108,6 +138,10 @@ var _ = Service("GuildsService", func() {
 			Response("not_found", StatusNotFound)
 			Response("internal", StatusInternalServerError)
 		})
+
+		GRPC(func() {
+			Response(CodeOK)
+		})
 	})
 
 	Method("GetGuildDefaultMember", func() {
@@ -127,26 +161,39 @@ var _ = Service("GuildsService", func() {
 			GET("/guilds/{guildID}/default-member")
 
 			Response(CodeOK)
+			Response("invalid_arg", 400)
 			Response("not_found", 404)
 			Response("internal", 500)
 		})
+
+		GRPC(func() {
+			Response(CodeOK)
+		})
 	})
 
 	Method("EnterGuild", func() {
 		Description("Enter the guild")
 
 		Payload(func() {
-			Field(0, "guildID", String)
-			Field(1, "injective_address", String)
-			Field(2, "params", String)
+			Field(1, "guildID", String)
+			Field(2, "injective_address", String)
+			Field(3, "params", String)
+			Field(4, "subaccount_ids", ArrayOf(String), func() {
+				Description("Non-default subaccounts owned by injective_address to trade from, default subaccount is always included")
+			})
 
 			Required("guildID")
 			Required("injective_address")
 		})
 
 		Result(func() {
-			Field(1, "join_status", String)
+			Field(1, "join_status", String, func() {
+				Description("success, or waitlisted if guild is at capacity")
+			})
 			Field(2, "message", String)
+			Field(3, "waitlist_position", Int, func() {
+				Description("1-based position in guild waitlist if waitlisted")
+			})
 		})
 
 		HTTP(func() {
@@ -156,14 +203,48 @@ var _ = Service("GuildsService", func() {
 			Response("not_found", StatusNotFound)
 			Response("internal", StatusInternalServerError)
 		})
+
+		GRPC(func() {
+			Response(CodeOK)
+		})
+	})
+
+	Method("GetGuildWaitlist", func() {
+		Description("Get waitlist of a guild at capacity, head of the queue first")
+
+		Payload(func() {
+			Field(1, "guildID", String)
+			Field(2, "injective_address", String, func() {
+				Description("Only return position of this address")
+			})
+			Required("guildID")
+		})
+
+		Result(func() {
+			Field(1, "waitlist", ArrayOf(WaitlistEntry))
+		})
+
+		HTTP(func() {
+			GET("/guilds/{guildID}/waitlist")
+			Param("injective_address")
+
+			Response(CodeOK)
+			Response("invalid_arg", StatusBadRequest)
+			Response("not_found", StatusNotFound)
+			Response("internal", StatusInternalServerError)
+		})
+
+		GRPC(func() {
+			Response(CodeOK)
+		})
 	})
 
 	Method("LeaveGuild", func() {
 		Description("Leave the guild, guildID")
 
 		Payload(func() {
-			Field(0, "guildID", String)
-			Field(1, "injective_address", String)
+			Field(1, "guildID", String)
+			Field(2, "injective_address", String)
 
 			Required("guildID")
 			Required("injective_address")
@@ -179,6 +260,46 @@ var _ = Service("GuildsService", func() {
 			Response("not_found", StatusNotFound)
 			Response("internal", StatusInternalServerError)
 		})
+
+		GRPC(func() {
+			Response(CodeOK)
+		})
+	})
+
+	Method("TransferMember", func() {
+		Description("Move a member to another guild without leaving, grants to the new guild master are required")
+
+		Payload(func() {
+			Field(1, "injective_address", String)
+			Field(2, "guild_id", String, func() {
+				Description("Guild to move the member to")
+			})
+			Field(3, "params", String)
+			Field(4, "subaccount_ids", ArrayOf(String), func() {
+				Description("Non-default subaccounts to trade from in the new guild, current subaccounts are kept if omitted")
+			})
+
+			Required("injective_address")
+			Required("guild_id")
+		})
+
+		Result(func() {
+			Field(1, "transfer_status", String)
+			Field(2, "from_guild_id", String)
+		})
+
+		HTTP(func() {
+			POST("/members/{injective_address}/transfer")
+
+			Response(CodeOK)
+			Response("invalid_arg", StatusBadRequest)
+			Response("not_found", StatusNotFound)
+			Response("internal", StatusInternalServerError)
+		})
+
+		GRPC(func() {
+			Response(CodeOK)
+		})
 	})
 
 	Method("GetGuildMarkets", func() {
@@ -201,6 +322,10 @@ var _ = Service("GuildsService", func() {
 			Response("not_found", StatusNotFound)
 			Response("internal", StatusInternalServerError)
 		})
+
+		GRPC(func() {
+			Response(CodeOK)
+		})
 	})
 
 	Method("GetGuildPortfolios", func() {
@@ -216,6 +341,9 @@ var _ = Service("GuildsService", func() {
 
 		Result(func() {
 			Field(1, "portfolios", ArrayOf(SingleGuildPortfolio))
+			Field(2, "net_flows", ArrayOf(NetFlow), func() {
+				Description("Member deposits and withdrawals in the requested range")
+			})
 		})
 
 		HTTP(func() {
@@ -227,6 +355,10 @@ var _ = Service("GuildsService", func() {
 			Response("not_found", StatusNotFound)
 			Response("internal", StatusInternalServerError)
 		})
+
+		GRPC(func() {
+			Response(CodeOK)
+		})
 	})
 
 	Method("GetAccountInfo", func() {
@@ -248,6 +380,10 @@ var _ = Service("GuildsService", func() {
 			Response("not_found", StatusNotFound)
 			Response("internal", StatusInternalServerError)
 		})
+
+		GRPC(func() {
+			Response(CodeOK)
+		})
 	})
 
 	Method("GetAccountPortfolio", func() {
@@ -269,6 +405,10 @@ var _ = Service("GuildsService", func() {
 			Response("not_found", StatusNotFound)
 			Response("internal", StatusInternalServerError)
 		})
+
+		GRPC(func() {
+			Response(CodeOK)
+		})
 	})
 
 	Method("GetAccountPortfolios", func() {
@@ -278,22 +418,59 @@ var _ = Service("GuildsService", func() {
 			Field(1, "injective_address", String)
 			Field(2, "start_time", Int64)
 			Field(3, "end_time", Int64)
+			Field(4, "guild_id", String, func() {
+				Description("Only return snapshots taken while address was member of the guild")
+			})
 			Required("injective_address")
 		})
 
 		Result(func() {
 			Field(1, "portfolios", ArrayOf(SingleAccountPortfolio))
+			Field(2, "net_flows", ArrayOf(NetFlow), func() {
+				Description("Deposits and withdrawals in the requested range")
+			})
 		})
 
 		HTTP(func() {
 			GET("/members/{injective_address}/portfolios")
 			Param("start_time")
 			Param("end_time")
+			Param("guild_id")
 
 			Response(CodeOK)
+			Response("invalid_arg", StatusBadRequest)
 			Response("not_found", StatusNotFound)
 			Response("internal", StatusInternalServerError)
 		})
+
+		GRPC(func() {
+			Response(CodeOK)
+		})
+	})
+
+	Method("GetAccountMemberships", func() {
+		Description("Get current and past guild memberships of an account, latest first")
+
+		Payload(func() {
+			Field(1, "injective_address", String)
+			Required("injective_address")
+		})
+
+		Result(func() {
+			Field(1, "memberships", ArrayOf(Membership))
+		})
+
+		HTTP(func() {
+			GET("/members/{injective_address}/memberships")
+
+			Response(CodeOK)
+			Response("invalid_arg", StatusBadRequest)
+			Response("internal", StatusInternalServerError)
+		})
+
+		GRPC(func() {
+			Response(CodeOK)
+		})
 	})
 
 	Method("GetAccountMonthlyPortfolios", func() {
@@ -319,5 +496,260 @@ var _ = Service("GuildsService", func() {
 			Response("not_found", StatusNotFound)
 			Response("internal", StatusInternalServerError)
 		})
+
+		GRPC(func() {
+			Response(CodeOK)
+		})
+	})
+
+	Method("GetAccountGrants", func() {
+		Description("Get required grants of a member with their expiration")
+
+		Payload(func() {
+			Field(1, "injective_address", String)
+			Required("injective_address")
+		})
+
+		Result(func() {
+			Field(1, "grants", ArrayOf(Grant))
+		})
+
+		HTTP(func() {
+			GET("/members/{injective_address}/grants")
+
+			Response(CodeOK)
+			Response("not_found", StatusNotFound)
+			Response("invalid_arg", StatusBadRequest)
+			Response("internal", StatusInternalServerError)
+		})
+
+		GRPC(func() {
+			Response(CodeOK)
+		})
+	})
+
+	Method("GetAccountTrades", func() {
+		Description("Get ingested trades of a member, newest first")
+
+		Payload(func() {
+			Field(1, "injective_address", String)
+			Field(2, "start_time", Int64)
+			Field(3, "end_time", Int64)
+			Field(4, "limit", Int64)
+			Required("injective_address")
+		})
+
+		Result(func() {
+			Field(1, "trades", ArrayOf(Trade))
+		})
+
+		HTTP(func() {
+			GET("/members/{injective_address}/trades")
+			Param("start_time")
+			Param("end_time")
+			Param("limit")
+
+			Response(CodeOK)
+			Response("invalid_arg", StatusBadRequest)
+			Response("internal", StatusInternalServerError)
+		})
+
+		GRPC(func() {
+			Response(CodeOK)
+		})
+	})
+
+	Method("GetAccountTradeStats", func() {
+		Description("Get realized pnl, fee totals and volume of a member")
+
+		Payload(func() {
+			Field(1, "injective_address", String)
+			Field(2, "start_time", Int64)
+			Field(3, "end_time", Int64)
+			Required("injective_address")
+		})
+
+		Result(func() {
+			Field(1, "stats", ArrayOf(TradeStats))
+		})
+
+		HTTP(func() {
+			GET("/members/{injective_address}/trade-stats")
+			Param("start_time")
+			Param("end_time")
+
+			Response(CodeOK)
+			Response("invalid_arg", StatusBadRequest)
+			Response("internal", StatusInternalServerError)
+		})
+
+		GRPC(func() {
+			Response(CodeOK)
+		})
+	})
+
+	Method("GetGuildTradeStats", func() {
+		Description("Get realized pnl, fee totals and volume of a guild")
+
+		Payload(func() {
+			Field(1, "guildID", String)
+			Field(2, "start_time", Int64)
+			Field(3, "end_time", Int64)
+			Required("guildID")
+		})
+
+		Result(func() {
+			Field(1, "stats", ArrayOf(TradeStats))
+		})
+
+		HTTP(func() {
+			GET("/guilds/{guildID}/trade-stats")
+			Param("start_time")
+			Param("end_time")
+
+			Response(CodeOK)
+			Response("invalid_arg", StatusBadRequest)
+			Response("not_found", StatusNotFound)
+			Response("internal", StatusInternalServerError)
+		})
+
+		GRPC(func() {
+			Response(CodeOK)
+		})
+	})
+
+	Method("GetGuildFees", func() {
+		Description("Get fees routed to guild master address from member trades, computed daily")
+
+		Payload(func() {
+			Field(1, "guildID", String)
+			Field(2, "start_time", Int64)
+			Field(3, "end_time", Int64)
+			Required("guildID")
+		})
+
+		Result(func() {
+			Field(1, "master_address", String)
+			Field(2, "totals", ArrayOf(GuildFeeTotal))
+			Field(3, "members", ArrayOf(MemberFeeContribution))
+			Field(4, "daily", ArrayOf(DailyGuildFee))
+		})
+
+		HTTP(func() {
+			GET("/guilds/{guildID}/fees")
+			Param("start_time")
+			Param("end_time")
+
+			Response(CodeOK)
+			Response("invalid_arg", StatusBadRequest)
+			Response("not_found", StatusNotFound)
+			Response("internal", StatusInternalServerError)
+		})
+
+		GRPC(func() {
+			Response(CodeOK)
+		})
+	})
+
+	Method("GetAccountTransfers", func() {
+		Description("Get ingested deposits and withdrawals of a member, newest first")
+
+		Payload(func() {
+			Field(1, "injective_address", String)
+			Field(2, "start_time", Int64)
+			Field(3, "end_time", Int64)
+			Field(4, "limit", Int64)
+			Required("injective_address")
+		})
+
+		Result(func() {
+			Field(1, "transfers", ArrayOf(Transfer))
+			Field(2, "net_flows", ArrayOf(NetFlow))
+		})
+
+		HTTP(func() {
+			GET("/members/{injective_address}/transfers")
+			Param("start_time")
+			Param("end_time")
+			Param("limit")
+
+			Response(CodeOK)
+			Response("invalid_arg", StatusBadRequest)
+			Response("internal", StatusInternalServerError)
+		})
+
+		GRPC(func() {
+			Response(CodeOK)
+		})
+	})
+
+	Method("GetAccountPositions", func() {
+		Description("Get derivative positions of latest account portfolio snapshot")
+
+		Payload(func() {
+			Field(1, "injective_address", String)
+			Required("injective_address")
+		})
+
+		Result(func() {
+			Field(1, "positions", ArrayOf(Position))
+			Field(2, "updated_at", Int64)
+			Required("positions", "updated_at")
+		})
+
+		HTTP(func() {
+			GET("/members/{injective_address}/positions")
+
+			Response(CodeOK)
+			Response("invalid_arg", StatusBadRequest)
+			Response("not_found", StatusNotFound)
+			Response("internal", StatusInternalServerError)
+		})
+
+		GRPC(func() {
+			Response(CodeOK)
+		})
+	})
+
+	Method("GetGuildRisk", func() {
+		Description("Get latest risk metrics of a guild")
+
+		Payload(func() {
+			Field(1, "guildID", String)
+			Required("guildID")
+		})
+
+		Result(func() {
+			Field(1, "data", GuildRisk)
+		})
+
+		HTTP(func() {
+			GET("/guilds/{guildID}/risk")
+
+			Response(CodeOK)
+			Response("invalid_arg", StatusBadRequest)
+			Response("not_found", StatusNotFound)
+			Response("internal", StatusInternalServerError)
+		})
+
+		GRPC(func() {
+			Response(CodeOK)
+		})
+	})
+
+	Method("StreamPortfolioUpdates", func() {
+		Description("Stream portfolio snapshots and membership changes as they are written, gRPC only")
+
+		Payload(func() {
+			Field(1, "guild_id", String, "Guild to stream, all guilds if empty")
+			Field(2, "injective_address", String, "Member to stream, guild portfolios are not sent if set")
+			Field(3, "last_event_id", String, "ID of last update received, recent updates after it are sent first")
+		})
+
+		StreamingResult(PortfolioUpdate)
+
+		GRPC(func() {
+			Response(CodeOK)
+		})
 	})
 })
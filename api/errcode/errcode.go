@@ -0,0 +1,41 @@
+// Package errcode has machine-readable codes of api errors, it's the single source of
+// APIError code enum of api design and codes returned by the service
+package errcode
+
+// Error codes let front-ends localize messages, details carry values to format them
+const (
+	InvalidArgument     = "INVALID_ARGUMENT"
+	NotFound            = "NOT_FOUND"
+	Internal            = "INTERNAL"
+	GuildNotFound       = "GUILD_NOT_FOUND"
+	GuildFull           = "GUILD_FULL"
+	GuildArchived       = "GUILD_ARCHIVED"
+	AlreadyMember       = "ALREADY_MEMBER"
+	MemberNotFound      = "MEMBER_NOT_FOUND"
+	InsufficientBalance = "INSUFFICIENT_BALANCE"
+	MissingGrant        = "MISSING_GRANT"
+	OpenOrders          = "OPEN_ORDERS"
+	OpenPositions       = "OPEN_POSITIONS"
+	GrantsNotRevoked    = "GRANTS_NOT_REVOKED"
+	NotAllowlisted      = "NOT_ALLOWLISTED"
+	InvalidInviteCode   = "INVALID_INVITE_CODE"
+)
+
+// All is the list of codes, a new code must be added here to be accepted by api design
+var All = []string{
+	InvalidArgument,
+	NotFound,
+	Internal,
+	GuildNotFound,
+	GuildFull,
+	GuildArchived,
+	AlreadyMember,
+	MemberNotFound,
+	InsufficientBalance,
+	MissingGrant,
+	OpenOrders,
+	OpenPositions,
+	GrantsNotRevoked,
+	NotAllowlisted,
+	InvalidInviteCode,
+}
@@ -0,0 +1,762 @@
+// Code generated by goa v3.6.2, DO NOT EDIT.
+//
+// Guilds gRPC client CLI support package
+//
+// Command:
+// $ goa gen github.com/InjectiveLabs/injective-guilds-service/api/design -o ../
+
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	guildsservicec "github.com/InjectiveLabs/injective-guilds-service/api/gen/grpc/guilds_service/client"
+	goa "goa.design/goa/v3/pkg"
+	grpc "google.golang.org/grpc"
+)
+
+// UsageCommands returns the set of commands and sub-commands using the format
+//
+//	command (subcommand1|subcommand2|...)
+func UsageCommands() string {
+	return `guilds-service (get-all-guilds|get-single-guild|get-guild-members|get-guild-master-address|get-guild-default-member|enter-guild|get-guild-waitlist|leave-guild|transfer-member|get-guild-markets|get-guild-portfolios|get-account-info|get-account-portfolio|get-account-portfolios|get-account-memberships|get-account-monthly-portfolios|get-account-grants|get-account-trades|get-account-trade-stats|get-guild-trade-stats|get-guild-fees|get-account-transfers|get-account-positions|get-guild-risk|stream-portfolio-updates)
+`
+}
+
+// UsageExamples produces an example of a valid invocation of the CLI tool.
+func UsageExamples() string {
+	return os.Args[0] + ` guilds-service get-all-guilds --message '{
+      "include_archived": false
+   }'` + "\n" +
+		""
+}
+
+// ParseEndpoint returns the endpoint and payload as specified on the command
+// line.
+func ParseEndpoint(cc *grpc.ClientConn, opts ...grpc.CallOption) (goa.Endpoint, interface{}, error) {
+	var (
+		guildsServiceFlags = flag.NewFlagSet("guilds-service", flag.ContinueOnError)
+
+		guildsServiceGetAllGuildsFlags       = flag.NewFlagSet("get-all-guilds", flag.ExitOnError)
+		guildsServiceGetAllGuildsMessageFlag = guildsServiceGetAllGuildsFlags.String("message", "", "")
+
+		guildsServiceGetSingleGuildFlags       = flag.NewFlagSet("get-single-guild", flag.ExitOnError)
+		guildsServiceGetSingleGuildMessageFlag = guildsServiceGetSingleGuildFlags.String("message", "", "")
+
+		guildsServiceGetGuildMembersFlags       = flag.NewFlagSet("get-guild-members", flag.ExitOnError)
+		guildsServiceGetGuildMembersMessageFlag = guildsServiceGetGuildMembersFlags.String("message", "", "")
+
+		guildsServiceGetGuildMasterAddressFlags       = flag.NewFlagSet("get-guild-master-address", flag.ExitOnError)
+		guildsServiceGetGuildMasterAddressMessageFlag = guildsServiceGetGuildMasterAddressFlags.String("message", "", "")
+
+		guildsServiceGetGuildDefaultMemberFlags       = flag.NewFlagSet("get-guild-default-member", flag.ExitOnError)
+		guildsServiceGetGuildDefaultMemberMessageFlag = guildsServiceGetGuildDefaultMemberFlags.String("message", "", "")
+
+		guildsServiceEnterGuildFlags       = flag.NewFlagSet("enter-guild", flag.ExitOnError)
+		guildsServiceEnterGuildMessageFlag = guildsServiceEnterGuildFlags.String("message", "", "")
+
+		guildsServiceGetGuildWaitlistFlags       = flag.NewFlagSet("get-guild-waitlist", flag.ExitOnError)
+		guildsServiceGetGuildWaitlistMessageFlag = guildsServiceGetGuildWaitlistFlags.String("message", "", "")
+
+		guildsServiceLeaveGuildFlags       = flag.NewFlagSet("leave-guild", flag.ExitOnError)
+		guildsServiceLeaveGuildMessageFlag = guildsServiceLeaveGuildFlags.String("message", "", "")
+
+		guildsServiceTransferMemberFlags       = flag.NewFlagSet("transfer-member", flag.ExitOnError)
+		guildsServiceTransferMemberMessageFlag = guildsServiceTransferMemberFlags.String("message", "", "")
+
+		guildsServiceGetGuildMarketsFlags       = flag.NewFlagSet("get-guild-markets", flag.ExitOnError)
+		guildsServiceGetGuildMarketsMessageFlag = guildsServiceGetGuildMarketsFlags.String("message", "", "")
+
+		guildsServiceGetGuildPortfoliosFlags       = flag.NewFlagSet("get-guild-portfolios", flag.ExitOnError)
+		guildsServiceGetGuildPortfoliosMessageFlag = guildsServiceGetGuildPortfoliosFlags.String("message", "", "")
+
+		guildsServiceGetAccountInfoFlags       = flag.NewFlagSet("get-account-info", flag.ExitOnError)
+		guildsServiceGetAccountInfoMessageFlag = guildsServiceGetAccountInfoFlags.String("message", "", "")
+
+		guildsServiceGetAccountPortfolioFlags       = flag.NewFlagSet("get-account-portfolio", flag.ExitOnError)
+		guildsServiceGetAccountPortfolioMessageFlag = guildsServiceGetAccountPortfolioFlags.String("message", "", "")
+
+		guildsServiceGetAccountPortfoliosFlags       = flag.NewFlagSet("get-account-portfolios", flag.ExitOnError)
+		guildsServiceGetAccountPortfoliosMessageFlag = guildsServiceGetAccountPortfoliosFlags.String("message", "", "")
+
+		guildsServiceGetAccountMembershipsFlags       = flag.NewFlagSet("get-account-memberships", flag.ExitOnError)
+		guildsServiceGetAccountMembershipsMessageFlag = guildsServiceGetAccountMembershipsFlags.String("message", "", "")
+
+		guildsServiceGetAccountMonthlyPortfoliosFlags       = flag.NewFlagSet("get-account-monthly-portfolios", flag.ExitOnError)
+		guildsServiceGetAccountMonthlyPortfoliosMessageFlag = guildsServiceGetAccountMonthlyPortfoliosFlags.String("message", "", "")
+
+		guildsServiceGetAccountGrantsFlags       = flag.NewFlagSet("get-account-grants", flag.ExitOnError)
+		guildsServiceGetAccountGrantsMessageFlag = guildsServiceGetAccountGrantsFlags.String("message", "", "")
+
+		guildsServiceGetAccountTradesFlags       = flag.NewFlagSet("get-account-trades", flag.ExitOnError)
+		guildsServiceGetAccountTradesMessageFlag = guildsServiceGetAccountTradesFlags.String("message", "", "")
+
+		guildsServiceGetAccountTradeStatsFlags       = flag.NewFlagSet("get-account-trade-stats", flag.ExitOnError)
+		guildsServiceGetAccountTradeStatsMessageFlag = guildsServiceGetAccountTradeStatsFlags.String("message", "", "")
+
+		guildsServiceGetGuildTradeStatsFlags       = flag.NewFlagSet("get-guild-trade-stats", flag.ExitOnError)
+		guildsServiceGetGuildTradeStatsMessageFlag = guildsServiceGetGuildTradeStatsFlags.String("message", "", "")
+
+		guildsServiceGetGuildFeesFlags       = flag.NewFlagSet("get-guild-fees", flag.ExitOnError)
+		guildsServiceGetGuildFeesMessageFlag = guildsServiceGetGuildFeesFlags.String("message", "", "")
+
+		guildsServiceGetAccountTransfersFlags       = flag.NewFlagSet("get-account-transfers", flag.ExitOnError)
+		guildsServiceGetAccountTransfersMessageFlag = guildsServiceGetAccountTransfersFlags.String("message", "", "")
+
+		guildsServiceGetAccountPositionsFlags       = flag.NewFlagSet("get-account-positions", flag.ExitOnError)
+		guildsServiceGetAccountPositionsMessageFlag = guildsServiceGetAccountPositionsFlags.String("message", "", "")
+
+		guildsServiceGetGuildRiskFlags       = flag.NewFlagSet("get-guild-risk", flag.ExitOnError)
+		guildsServiceGetGuildRiskMessageFlag = guildsServiceGetGuildRiskFlags.String("message", "", "")
+
+		guildsServiceStreamPortfolioUpdatesFlags       = flag.NewFlagSet("stream-portfolio-updates", flag.ExitOnError)
+		guildsServiceStreamPortfolioUpdatesMessageFlag = guildsServiceStreamPortfolioUpdatesFlags.String("message", "", "")
+	)
+	guildsServiceFlags.Usage = guildsServiceUsage
+	guildsServiceGetAllGuildsFlags.Usage = guildsServiceGetAllGuildsUsage
+	guildsServiceGetSingleGuildFlags.Usage = guildsServiceGetSingleGuildUsage
+	guildsServiceGetGuildMembersFlags.Usage = guildsServiceGetGuildMembersUsage
+	guildsServiceGetGuildMasterAddressFlags.Usage = guildsServiceGetGuildMasterAddressUsage
+	guildsServiceGetGuildDefaultMemberFlags.Usage = guildsServiceGetGuildDefaultMemberUsage
+	guildsServiceEnterGuildFlags.Usage = guildsServiceEnterGuildUsage
+	guildsServiceGetGuildWaitlistFlags.Usage = guildsServiceGetGuildWaitlistUsage
+	guildsServiceLeaveGuildFlags.Usage = guildsServiceLeaveGuildUsage
+	guildsServiceTransferMemberFlags.Usage = guildsServiceTransferMemberUsage
+	guildsServiceGetGuildMarketsFlags.Usage = guildsServiceGetGuildMarketsUsage
+	guildsServiceGetGuildPortfoliosFlags.Usage = guildsServiceGetGuildPortfoliosUsage
+	guildsServiceGetAccountInfoFlags.Usage = guildsServiceGetAccountInfoUsage
+	guildsServiceGetAccountPortfolioFlags.Usage = guildsServiceGetAccountPortfolioUsage
+	guildsServiceGetAccountPortfoliosFlags.Usage = guildsServiceGetAccountPortfoliosUsage
+	guildsServiceGetAccountMembershipsFlags.Usage = guildsServiceGetAccountMembershipsUsage
+	guildsServiceGetAccountMonthlyPortfoliosFlags.Usage = guildsServiceGetAccountMonthlyPortfoliosUsage
+	guildsServiceGetAccountGrantsFlags.Usage = guildsServiceGetAccountGrantsUsage
+	guildsServiceGetAccountTradesFlags.Usage = guildsServiceGetAccountTradesUsage
+	guildsServiceGetAccountTradeStatsFlags.Usage = guildsServiceGetAccountTradeStatsUsage
+	guildsServiceGetGuildTradeStatsFlags.Usage = guildsServiceGetGuildTradeStatsUsage
+	guildsServiceGetGuildFeesFlags.Usage = guildsServiceGetGuildFeesUsage
+	guildsServiceGetAccountTransfersFlags.Usage = guildsServiceGetAccountTransfersUsage
+	guildsServiceGetAccountPositionsFlags.Usage = guildsServiceGetAccountPositionsUsage
+	guildsServiceGetGuildRiskFlags.Usage = guildsServiceGetGuildRiskUsage
+	guildsServiceStreamPortfolioUpdatesFlags.Usage = guildsServiceStreamPortfolioUpdatesUsage
+
+	if err := flag.CommandLine.Parse(os.Args[1:]); err != nil {
+		return nil, nil, err
+	}
+
+	if flag.NArg() < 2 { // two non flag args are required: SERVICE and ENDPOINT (aka COMMAND)
+		return nil, nil, fmt.Errorf("not enough arguments")
+	}
+
+	var (
+		svcn string
+		svcf *flag.FlagSet
+	)
+	{
+		svcn = flag.Arg(0)
+		switch svcn {
+		case "guilds-service":
+			svcf = guildsServiceFlags
+		default:
+			return nil, nil, fmt.Errorf("unknown service %q", svcn)
+		}
+	}
+	if err := svcf.Parse(flag.Args()[1:]); err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		epn string
+		epf *flag.FlagSet
+	)
+	{
+		epn = svcf.Arg(0)
+		switch svcn {
+		case "guilds-service":
+			switch epn {
+			case "get-all-guilds":
+				epf = guildsServiceGetAllGuildsFlags
+
+			case "get-single-guild":
+				epf = guildsServiceGetSingleGuildFlags
+
+			case "get-guild-members":
+				epf = guildsServiceGetGuildMembersFlags
+
+			case "get-guild-master-address":
+				epf = guildsServiceGetGuildMasterAddressFlags
+
+			case "get-guild-default-member":
+				epf = guildsServiceGetGuildDefaultMemberFlags
+
+			case "enter-guild":
+				epf = guildsServiceEnterGuildFlags
+
+			case "get-guild-waitlist":
+				epf = guildsServiceGetGuildWaitlistFlags
+
+			case "leave-guild":
+				epf = guildsServiceLeaveGuildFlags
+
+			case "transfer-member":
+				epf = guildsServiceTransferMemberFlags
+
+			case "get-guild-markets":
+				epf = guildsServiceGetGuildMarketsFlags
+
+			case "get-guild-portfolios":
+				epf = guildsServiceGetGuildPortfoliosFlags
+
+			case "get-account-info":
+				epf = guildsServiceGetAccountInfoFlags
+
+			case "get-account-portfolio":
+				epf = guildsServiceGetAccountPortfolioFlags
+
+			case "get-account-portfolios":
+				epf = guildsServiceGetAccountPortfoliosFlags
+
+			case "get-account-memberships":
+				epf = guildsServiceGetAccountMembershipsFlags
+
+			case "get-account-monthly-portfolios":
+				epf = guildsServiceGetAccountMonthlyPortfoliosFlags
+
+			case "get-account-grants":
+				epf = guildsServiceGetAccountGrantsFlags
+
+			case "get-account-trades":
+				epf = guildsServiceGetAccountTradesFlags
+
+			case "get-account-trade-stats":
+				epf = guildsServiceGetAccountTradeStatsFlags
+
+			case "get-guild-trade-stats":
+				epf = guildsServiceGetGuildTradeStatsFlags
+
+			case "get-guild-fees":
+				epf = guildsServiceGetGuildFeesFlags
+
+			case "get-account-transfers":
+				epf = guildsServiceGetAccountTransfersFlags
+
+			case "get-account-positions":
+				epf = guildsServiceGetAccountPositionsFlags
+
+			case "get-guild-risk":
+				epf = guildsServiceGetGuildRiskFlags
+
+			case "stream-portfolio-updates":
+				epf = guildsServiceStreamPortfolioUpdatesFlags
+
+			}
+
+		}
+	}
+	if epf == nil {
+		return nil, nil, fmt.Errorf("unknown %q endpoint %q", svcn, epn)
+	}
+
+	// Parse endpoint flags if any
+	if svcf.NArg() > 1 {
+		if err := epf.Parse(svcf.Args()[1:]); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var (
+		data     interface{}
+		endpoint goa.Endpoint
+		err      error
+	)
+	{
+		switch svcn {
+		case "guilds-service":
+			c := guildsservicec.NewClient(cc, opts...)
+			switch epn {
+			case "get-all-guilds":
+				endpoint = c.GetAllGuilds()
+				data, err = guildsservicec.BuildGetAllGuildsPayload(*guildsServiceGetAllGuildsMessageFlag)
+			case "get-single-guild":
+				endpoint = c.GetSingleGuild()
+				data, err = guildsservicec.BuildGetSingleGuildPayload(*guildsServiceGetSingleGuildMessageFlag)
+			case "get-guild-members":
+				endpoint = c.GetGuildMembers()
+				data, err = guildsservicec.BuildGetGuildMembersPayload(*guildsServiceGetGuildMembersMessageFlag)
+			case "get-guild-master-address":
+				endpoint = c.GetGuildMasterAddress()
+				data, err = guildsservicec.BuildGetGuildMasterAddressPayload(*guildsServiceGetGuildMasterAddressMessageFlag)
+			case "get-guild-default-member":
+				endpoint = c.GetGuildDefaultMember()
+				data, err = guildsservicec.BuildGetGuildDefaultMemberPayload(*guildsServiceGetGuildDefaultMemberMessageFlag)
+			case "enter-guild":
+				endpoint = c.EnterGuild()
+				data, err = guildsservicec.BuildEnterGuildPayload(*guildsServiceEnterGuildMessageFlag)
+			case "get-guild-waitlist":
+				endpoint = c.GetGuildWaitlist()
+				data, err = guildsservicec.BuildGetGuildWaitlistPayload(*guildsServiceGetGuildWaitlistMessageFlag)
+			case "leave-guild":
+				endpoint = c.LeaveGuild()
+				data, err = guildsservicec.BuildLeaveGuildPayload(*guildsServiceLeaveGuildMessageFlag)
+			case "transfer-member":
+				endpoint = c.TransferMember()
+				data, err = guildsservicec.BuildTransferMemberPayload(*guildsServiceTransferMemberMessageFlag)
+			case "get-guild-markets":
+				endpoint = c.GetGuildMarkets()
+				data, err = guildsservicec.BuildGetGuildMarketsPayload(*guildsServiceGetGuildMarketsMessageFlag)
+			case "get-guild-portfolios":
+				endpoint = c.GetGuildPortfolios()
+				data, err = guildsservicec.BuildGetGuildPortfoliosPayload(*guildsServiceGetGuildPortfoliosMessageFlag)
+			case "get-account-info":
+				endpoint = c.GetAccountInfo()
+				data, err = guildsservicec.BuildGetAccountInfoPayload(*guildsServiceGetAccountInfoMessageFlag)
+			case "get-account-portfolio":
+				endpoint = c.GetAccountPortfolio()
+				data, err = guildsservicec.BuildGetAccountPortfolioPayload(*guildsServiceGetAccountPortfolioMessageFlag)
+			case "get-account-portfolios":
+				endpoint = c.GetAccountPortfolios()
+				data, err = guildsservicec.BuildGetAccountPortfoliosPayload(*guildsServiceGetAccountPortfoliosMessageFlag)
+			case "get-account-memberships":
+				endpoint = c.GetAccountMemberships()
+				data, err = guildsservicec.BuildGetAccountMembershipsPayload(*guildsServiceGetAccountMembershipsMessageFlag)
+			case "get-account-monthly-portfolios":
+				endpoint = c.GetAccountMonthlyPortfolios()
+				data, err = guildsservicec.BuildGetAccountMonthlyPortfoliosPayload(*guildsServiceGetAccountMonthlyPortfoliosMessageFlag)
+			case "get-account-grants":
+				endpoint = c.GetAccountGrants()
+				data, err = guildsservicec.BuildGetAccountGrantsPayload(*guildsServiceGetAccountGrantsMessageFlag)
+			case "get-account-trades":
+				endpoint = c.GetAccountTrades()
+				data, err = guildsservicec.BuildGetAccountTradesPayload(*guildsServiceGetAccountTradesMessageFlag)
+			case "get-account-trade-stats":
+				endpoint = c.GetAccountTradeStats()
+				data, err = guildsservicec.BuildGetAccountTradeStatsPayload(*guildsServiceGetAccountTradeStatsMessageFlag)
+			case "get-guild-trade-stats":
+				endpoint = c.GetGuildTradeStats()
+				data, err = guildsservicec.BuildGetGuildTradeStatsPayload(*guildsServiceGetGuildTradeStatsMessageFlag)
+			case "get-guild-fees":
+				endpoint = c.GetGuildFees()
+				data, err = guildsservicec.BuildGetGuildFeesPayload(*guildsServiceGetGuildFeesMessageFlag)
+			case "get-account-transfers":
+				endpoint = c.GetAccountTransfers()
+				data, err = guildsservicec.BuildGetAccountTransfersPayload(*guildsServiceGetAccountTransfersMessageFlag)
+			case "get-account-positions":
+				endpoint = c.GetAccountPositions()
+				data, err = guildsservicec.BuildGetAccountPositionsPayload(*guildsServiceGetAccountPositionsMessageFlag)
+			case "get-guild-risk":
+				endpoint = c.GetGuildRisk()
+				data, err = guildsservicec.BuildGetGuildRiskPayload(*guildsServiceGetGuildRiskMessageFlag)
+			case "stream-portfolio-updates":
+				endpoint = c.StreamPortfolioUpdates()
+				data, err = guildsservicec.BuildStreamPortfolioUpdatesPayload(*guildsServiceStreamPortfolioUpdatesMessageFlag)
+			}
+		}
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return endpoint, data, nil
+}
+
+// guilds-serviceUsage displays the usage of the guilds-service command and its
+// subcommands.
+func guildsServiceUsage() {
+	fmt.Fprintf(os.Stderr, `Service supports trading guild queries
+Usage:
+    %[1]s [globalflags] guilds-service COMMAND [flags]
+
+COMMAND:
+    get-all-guilds: Get all guilds
+    get-single-guild: Get a single guild base on ID
+    get-guild-members: Get all members a given guild (include default member)
+    get-guild-master-address: Get master address of given guild
+    get-guild-default-member: Get default guild member
+    enter-guild: Enter the guild
+    get-guild-waitlist: Get waitlist of a guild at capacity, head of the queue first
+    leave-guild: Leave the guild, guildID
+    transfer-member: Move a member to another guild without leaving, grants to the new guild master are required
+    get-guild-markets: Get the guild markets
+    get-guild-portfolios: Get the guild markets
+    get-account-info: Get current account member status
+    get-account-portfolio: Get current account portfolio snapshot
+    get-account-portfolios: Get current account portfolios snapshots all the time
+    get-account-memberships: Get current and past guild memberships of an account, latest first
+    get-account-monthly-portfolios: Get current account portfolios monthly snapshots, including start_time, end_time snapshots
+    get-account-grants: Get required grants of a member with their expiration
+    get-account-trades: Get ingested trades of a member, newest first
+    get-account-trade-stats: Get realized pnl, fee totals and volume of a member
+    get-guild-trade-stats: Get realized pnl, fee totals and volume of a guild
+    get-guild-fees: Get fees routed to guild master address from member trades, computed daily
+    get-account-transfers: Get ingested deposits and withdrawals of a member, newest first
+    get-account-positions: Get derivative positions of latest account portfolio snapshot
+    get-guild-risk: Get latest risk metrics of a guild
+    stream-portfolio-updates: Stream portfolio snapshots and membership changes as they are written, gRPC only
+
+Additional help:
+    %[1]s guilds-service COMMAND --help
+`, os.Args[0])
+}
+func guildsServiceGetAllGuildsUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] guilds-service get-all-guilds -message JSON
+
+Get all guilds
+    -message JSON: 
+
+Example:
+    %[1]s guilds-service get-all-guilds --message '{
+      "include_archived": false
+   }'
+`, os.Args[0])
+}
+
+func guildsServiceGetSingleGuildUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] guilds-service get-single-guild -message JSON
+
+Get a single guild base on ID
+    -message JSON: 
+
+Example:
+    %[1]s guilds-service get-single-guild --message '{
+      "guildID": "Asperiores voluptatem explicabo ut tempore."
+   }'
+`, os.Args[0])
+}
+
+func guildsServiceGetGuildMembersUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] guilds-service get-guild-members -message JSON
+
+Get all members a given guild (include default member)
+    -message JSON: 
+
+Example:
+    %[1]s guilds-service get-guild-members --message '{
+      "guildID": "Aliquid qui molestias sunt fuga."
+   }'
+`, os.Args[0])
+}
+
+func guildsServiceGetGuildMasterAddressUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] guilds-service get-guild-master-address -message JSON
+
+Get master address of given guild
+    -message JSON: 
+
+Example:
+    %[1]s guilds-service get-guild-master-address --message '{
+      "guildID": "Soluta est possimus consequatur."
+   }'
+`, os.Args[0])
+}
+
+func guildsServiceGetGuildDefaultMemberUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] guilds-service get-guild-default-member -message JSON
+
+Get default guild member
+    -message JSON: 
+
+Example:
+    %[1]s guilds-service get-guild-default-member --message '{
+      "guildID": "Impedit explicabo deserunt quam eius."
+   }'
+`, os.Args[0])
+}
+
+func guildsServiceEnterGuildUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] guilds-service enter-guild -message JSON
+
+Enter the guild
+    -message JSON: 
+
+Example:
+    %[1]s guilds-service enter-guild --message '{
+      "guildID": "Sed voluptatem exercitationem reiciendis tempora.",
+      "injective_address": "Cumque dolor sint quaerat nam maiores libero.",
+      "params": "Voluptatem ut reprehenderit alias quis vero.",
+      "subaccount_ids": [
+         "Rerum nisi odit.",
+         "Qui ut quam cum magnam animi numquam.",
+         "Quod iusto omnis."
+      ]
+   }'
+`, os.Args[0])
+}
+
+func guildsServiceGetGuildWaitlistUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] guilds-service get-guild-waitlist -message JSON
+
+Get waitlist of a guild at capacity, head of the queue first
+    -message JSON: 
+
+Example:
+    %[1]s guilds-service get-guild-waitlist --message '{
+      "guildID": "Voluptatem adipisci voluptatem quia rerum ipsa aspernatur.",
+      "injective_address": "Non nostrum est neque."
+   }'
+`, os.Args[0])
+}
+
+func guildsServiceLeaveGuildUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] guilds-service leave-guild -message JSON
+
+Leave the guild, guildID
+    -message JSON: 
+
+Example:
+    %[1]s guilds-service leave-guild --message '{
+      "guildID": "Nobis dolorem.",
+      "injective_address": "Aut ratione aliquid molestiae tempora hic harum."
+   }'
+`, os.Args[0])
+}
+
+func guildsServiceTransferMemberUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] guilds-service transfer-member -message JSON
+
+Move a member to another guild without leaving, grants to the new guild master are required
+    -message JSON: 
+
+Example:
+    %[1]s guilds-service transfer-member --message '{
+      "guild_id": "Eum sed nostrum.",
+      "injective_address": "Vel maxime.",
+      "params": "Autem quia distinctio maiores ipsum.",
+      "subaccount_ids": [
+         "Reiciendis rerum quaerat dicta autem suscipit animi.",
+         "Quis cumque molestiae nobis.",
+         "Pariatur placeat suscipit non fugit rerum.",
+         "Dolor veritatis hic ut qui."
+      ]
+   }'
+`, os.Args[0])
+}
+
+func guildsServiceGetGuildMarketsUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] guilds-service get-guild-markets -message JSON
+
+Get the guild markets
+    -message JSON: 
+
+Example:
+    %[1]s guilds-service get-guild-markets --message '{
+      "guildID": "Perferendis eius odit."
+   }'
+`, os.Args[0])
+}
+
+func guildsServiceGetGuildPortfoliosUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] guilds-service get-guild-portfolios -message JSON
+
+Get the guild markets
+    -message JSON: 
+
+Example:
+    %[1]s guilds-service get-guild-portfolios --message '{
+      "end_time": 8244773029622670980,
+      "guildID": "Sunt deserunt et.",
+      "start_time": 2627691725784589356
+   }'
+`, os.Args[0])
+}
+
+func guildsServiceGetAccountInfoUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] guilds-service get-account-info -message JSON
+
+Get current account member status
+    -message JSON: 
+
+Example:
+    %[1]s guilds-service get-account-info --message '{
+      "injective_address": "Sit quis est."
+   }'
+`, os.Args[0])
+}
+
+func guildsServiceGetAccountPortfolioUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] guilds-service get-account-portfolio -message JSON
+
+Get current account portfolio snapshot
+    -message JSON: 
+
+Example:
+    %[1]s guilds-service get-account-portfolio --message '{
+      "injective_address": "Ut sit aliquid qui id."
+   }'
+`, os.Args[0])
+}
+
+func guildsServiceGetAccountPortfoliosUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] guilds-service get-account-portfolios -message JSON
+
+Get current account portfolios snapshots all the time
+    -message JSON: 
+
+Example:
+    %[1]s guilds-service get-account-portfolios --message '{
+      "end_time": 7334355108593481704,
+      "guild_id": "Soluta similique sunt eum.",
+      "injective_address": "Qui similique sunt mollitia.",
+      "start_time": 1778990171064774979
+   }'
+`, os.Args[0])
+}
+
+func guildsServiceGetAccountMembershipsUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] guilds-service get-account-memberships -message JSON
+
+Get current and past guild memberships of an account, latest first
+    -message JSON: 
+
+Example:
+    %[1]s guilds-service get-account-memberships --message '{
+      "injective_address": "Amet repudiandae iure modi."
+   }'
+`, os.Args[0])
+}
+
+func guildsServiceGetAccountMonthlyPortfoliosUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] guilds-service get-account-monthly-portfolios -message JSON
+
+Get current account portfolios monthly snapshots, including start_time, end_time snapshots
+    -message JSON: 
+
+Example:
+    %[1]s guilds-service get-account-monthly-portfolios --message '{
+      "end_time": 6289983053451601708,
+      "injective_address": "Facere saepe eum dolorem.",
+      "start_time": 415979598256199300
+   }'
+`, os.Args[0])
+}
+
+func guildsServiceGetAccountGrantsUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] guilds-service get-account-grants -message JSON
+
+Get required grants of a member with their expiration
+    -message JSON: 
+
+Example:
+    %[1]s guilds-service get-account-grants --message '{
+      "injective_address": "Deserunt voluptatem."
+   }'
+`, os.Args[0])
+}
+
+func guildsServiceGetAccountTradesUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] guilds-service get-account-trades -message JSON
+
+Get ingested trades of a member, newest first
+    -message JSON: 
+
+Example:
+    %[1]s guilds-service get-account-trades --message '{
+      "end_time": 2024024348297001890,
+      "injective_address": "Ea perspiciatis hic.",
+      "limit": 3218097939626789332,
+      "start_time": 2756567090391200800
+   }'
+`, os.Args[0])
+}
+
+func guildsServiceGetAccountTradeStatsUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] guilds-service get-account-trade-stats -message JSON
+
+Get realized pnl, fee totals and volume of a member
+    -message JSON: 
+
+Example:
+    %[1]s guilds-service get-account-trade-stats --message '{
+      "end_time": 4592834174800486800,
+      "injective_address": "Distinctio et quidem voluptatem voluptatibus.",
+      "start_time": 3990766382893376473
+   }'
+`, os.Args[0])
+}
+
+func guildsServiceGetGuildTradeStatsUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] guilds-service get-guild-trade-stats -message JSON
+
+Get realized pnl, fee totals and volume of a guild
+    -message JSON: 
+
+Example:
+    %[1]s guilds-service get-guild-trade-stats --message '{
+      "end_time": 2061470302329605065,
+      "guildID": "Harum ab.",
+      "start_time": 2706510039981699904
+   }'
+`, os.Args[0])
+}
+
+func guildsServiceGetGuildFeesUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] guilds-service get-guild-fees -message JSON
+
+Get fees routed to guild master address from member trades, computed daily
+    -message JSON: 
+
+Example:
+    %[1]s guilds-service get-guild-fees --message '{
+      "end_time": 5088498043211415095,
+      "guildID": "Qui repudiandae voluptatibus sequi quae laborum totam.",
+      "start_time": 4376617673466765707
+   }'
+`, os.Args[0])
+}
+
+func guildsServiceGetAccountTransfersUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] guilds-service get-account-transfers -message JSON
+
+Get ingested deposits and withdrawals of a member, newest first
+    -message JSON: 
+
+Example:
+    %[1]s guilds-service get-account-transfers --message '{
+      "end_time": 4314751392317343294,
+      "injective_address": "Sapiente cumque in deserunt et fuga impedit.",
+      "limit": 5986541094969328171,
+      "start_time": 1245407664569182885
+   }'
+`, os.Args[0])
+}
+
+func guildsServiceGetAccountPositionsUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] guilds-service get-account-positions -message JSON
+
+Get derivative positions of latest account portfolio snapshot
+    -message JSON: 
+
+Example:
+    %[1]s guilds-service get-account-positions --message '{
+      "injective_address": "Quae esse molestiae corrupti."
+   }'
+`, os.Args[0])
+}
+
+func guildsServiceGetGuildRiskUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] guilds-service get-guild-risk -message JSON
+
+Get latest risk metrics of a guild
+    -message JSON: 
+
+Example:
+    %[1]s guilds-service get-guild-risk --message '{
+      "guildID": "Temporibus et ullam est."
+   }'
+`, os.Args[0])
+}
+
+func guildsServiceStreamPortfolioUpdatesUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] guilds-service stream-portfolio-updates -message JSON
+
+Stream portfolio snapshots and membership changes as they are written, gRPC only
+    -message JSON: 
+
+Example:
+    %[1]s guilds-service stream-portfolio-updates --message '{
+      "guild_id": "Non nam et reprehenderit repellendus.",
+      "injective_address": "Omnis sit omnis accusantium voluptatibus.",
+      "last_event_id": "Qui quia voluptates necessitatibus."
+   }'
+`, os.Args[0])
+}
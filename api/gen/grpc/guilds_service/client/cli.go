@@ -0,0 +1,604 @@
+// Code generated by goa v3.6.2, DO NOT EDIT.
+//
+// GuildsService gRPC client CLI support package
+//
+// Command:
+// $ goa gen github.com/InjectiveLabs/injective-guilds-service/api/design -o ../
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+
+	guilds_servicepb "github.com/InjectiveLabs/injective-guilds-service/api/gen/grpc/guilds_service/pb"
+	guildsservice "github.com/InjectiveLabs/injective-guilds-service/api/gen/guilds_service"
+)
+
+// BuildGetAllGuildsPayload builds the payload for the GuildsService
+// GetAllGuilds endpoint from CLI flags.
+func BuildGetAllGuildsPayload(guildsServiceGetAllGuildsMessage string) (*guildsservice.GetAllGuildsPayload, error) {
+	var err error
+	var message guilds_servicepb.GetAllGuildsRequest
+	{
+		if guildsServiceGetAllGuildsMessage != "" {
+			err = json.Unmarshal([]byte(guildsServiceGetAllGuildsMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"include_archived\": false\n   }'")
+			}
+		}
+	}
+	v := &guildsservice.GetAllGuildsPayload{
+		IncludeArchived: &message.IncludeArchived,
+	}
+
+	return v, nil
+}
+
+// BuildGetSingleGuildPayload builds the payload for the GuildsService
+// GetSingleGuild endpoint from CLI flags.
+func BuildGetSingleGuildPayload(guildsServiceGetSingleGuildMessage string) (*guildsservice.GetSingleGuildPayload, error) {
+	var err error
+	var message guilds_servicepb.GetSingleGuildRequest
+	{
+		if guildsServiceGetSingleGuildMessage != "" {
+			err = json.Unmarshal([]byte(guildsServiceGetSingleGuildMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"guildID\": \"Asperiores voluptatem explicabo ut tempore.\"\n   }'")
+			}
+		}
+	}
+	v := &guildsservice.GetSingleGuildPayload{
+		GuildID: message.GuildId,
+	}
+
+	return v, nil
+}
+
+// BuildGetGuildMembersPayload builds the payload for the GuildsService
+// GetGuildMembers endpoint from CLI flags.
+func BuildGetGuildMembersPayload(guildsServiceGetGuildMembersMessage string) (*guildsservice.GetGuildMembersPayload, error) {
+	var err error
+	var message guilds_servicepb.GetGuildMembersRequest
+	{
+		if guildsServiceGetGuildMembersMessage != "" {
+			err = json.Unmarshal([]byte(guildsServiceGetGuildMembersMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"guildID\": \"Aliquid qui molestias sunt fuga.\"\n   }'")
+			}
+		}
+	}
+	v := &guildsservice.GetGuildMembersPayload{
+		GuildID: message.GuildId,
+	}
+
+	return v, nil
+}
+
+// BuildGetGuildMasterAddressPayload builds the payload for the GuildsService
+// GetGuildMasterAddress endpoint from CLI flags.
+func BuildGetGuildMasterAddressPayload(guildsServiceGetGuildMasterAddressMessage string) (*guildsservice.GetGuildMasterAddressPayload, error) {
+	var err error
+	var message guilds_servicepb.GetGuildMasterAddressRequest
+	{
+		if guildsServiceGetGuildMasterAddressMessage != "" {
+			err = json.Unmarshal([]byte(guildsServiceGetGuildMasterAddressMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"guildID\": \"Soluta est possimus consequatur.\"\n   }'")
+			}
+		}
+	}
+	v := &guildsservice.GetGuildMasterAddressPayload{
+		GuildID: message.GuildId,
+	}
+
+	return v, nil
+}
+
+// BuildGetGuildDefaultMemberPayload builds the payload for the GuildsService
+// GetGuildDefaultMember endpoint from CLI flags.
+func BuildGetGuildDefaultMemberPayload(guildsServiceGetGuildDefaultMemberMessage string) (*guildsservice.GetGuildDefaultMemberPayload, error) {
+	var err error
+	var message guilds_servicepb.GetGuildDefaultMemberRequest
+	{
+		if guildsServiceGetGuildDefaultMemberMessage != "" {
+			err = json.Unmarshal([]byte(guildsServiceGetGuildDefaultMemberMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"guildID\": \"Impedit explicabo deserunt quam eius.\"\n   }'")
+			}
+		}
+	}
+	v := &guildsservice.GetGuildDefaultMemberPayload{
+		GuildID: message.GuildId,
+	}
+
+	return v, nil
+}
+
+// BuildEnterGuildPayload builds the payload for the GuildsService EnterGuild
+// endpoint from CLI flags.
+func BuildEnterGuildPayload(guildsServiceEnterGuildMessage string) (*guildsservice.EnterGuildPayload, error) {
+	var err error
+	var message guilds_servicepb.EnterGuildRequest
+	{
+		if guildsServiceEnterGuildMessage != "" {
+			err = json.Unmarshal([]byte(guildsServiceEnterGuildMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"guildID\": \"Sed voluptatem exercitationem reiciendis tempora.\",\n      \"injective_address\": \"Cumque dolor sint quaerat nam maiores libero.\",\n      \"params\": \"Voluptatem ut reprehenderit alias quis vero.\",\n      \"subaccount_ids\": [\n         \"Rerum nisi odit.\",\n         \"Qui ut quam cum magnam animi numquam.\",\n         \"Quod iusto omnis.\"\n      ]\n   }'")
+			}
+		}
+	}
+	v := &guildsservice.EnterGuildPayload{
+		GuildID:          message.GuildId,
+		InjectiveAddress: message.InjectiveAddress,
+	}
+	if message.Params != "" {
+		v.Params = &message.Params
+	}
+	if message.SubaccountIds != nil {
+		v.SubaccountIds = make([]string, len(message.SubaccountIds))
+		for i, val := range message.SubaccountIds {
+			v.SubaccountIds[i] = val
+		}
+	}
+
+	return v, nil
+}
+
+// BuildGetGuildWaitlistPayload builds the payload for the GuildsService
+// GetGuildWaitlist endpoint from CLI flags.
+func BuildGetGuildWaitlistPayload(guildsServiceGetGuildWaitlistMessage string) (*guildsservice.GetGuildWaitlistPayload, error) {
+	var err error
+	var message guilds_servicepb.GetGuildWaitlistRequest
+	{
+		if guildsServiceGetGuildWaitlistMessage != "" {
+			err = json.Unmarshal([]byte(guildsServiceGetGuildWaitlistMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"guildID\": \"Voluptatem adipisci voluptatem quia rerum ipsa aspernatur.\",\n      \"injective_address\": \"Non nostrum est neque.\"\n   }'")
+			}
+		}
+	}
+	v := &guildsservice.GetGuildWaitlistPayload{
+		GuildID: message.GuildId,
+	}
+	if message.InjectiveAddress != "" {
+		v.InjectiveAddress = &message.InjectiveAddress
+	}
+
+	return v, nil
+}
+
+// BuildLeaveGuildPayload builds the payload for the GuildsService LeaveGuild
+// endpoint from CLI flags.
+func BuildLeaveGuildPayload(guildsServiceLeaveGuildMessage string) (*guildsservice.LeaveGuildPayload, error) {
+	var err error
+	var message guilds_servicepb.LeaveGuildRequest
+	{
+		if guildsServiceLeaveGuildMessage != "" {
+			err = json.Unmarshal([]byte(guildsServiceLeaveGuildMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"guildID\": \"Nobis dolorem.\",\n      \"injective_address\": \"Aut ratione aliquid molestiae tempora hic harum.\"\n   }'")
+			}
+		}
+	}
+	v := &guildsservice.LeaveGuildPayload{
+		GuildID:          message.GuildId,
+		InjectiveAddress: message.InjectiveAddress,
+	}
+
+	return v, nil
+}
+
+// BuildTransferMemberPayload builds the payload for the GuildsService
+// TransferMember endpoint from CLI flags.
+func BuildTransferMemberPayload(guildsServiceTransferMemberMessage string) (*guildsservice.TransferMemberPayload, error) {
+	var err error
+	var message guilds_servicepb.TransferMemberRequest
+	{
+		if guildsServiceTransferMemberMessage != "" {
+			err = json.Unmarshal([]byte(guildsServiceTransferMemberMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"guild_id\": \"Eum sed nostrum.\",\n      \"injective_address\": \"Vel maxime.\",\n      \"params\": \"Autem quia distinctio maiores ipsum.\",\n      \"subaccount_ids\": [\n         \"Reiciendis rerum quaerat dicta autem suscipit animi.\",\n         \"Quis cumque molestiae nobis.\",\n         \"Pariatur placeat suscipit non fugit rerum.\",\n         \"Dolor veritatis hic ut qui.\"\n      ]\n   }'")
+			}
+		}
+	}
+	v := &guildsservice.TransferMemberPayload{
+		InjectiveAddress: message.InjectiveAddress,
+		GuildID:          message.GuildId,
+	}
+	if message.Params != "" {
+		v.Params = &message.Params
+	}
+	if message.SubaccountIds != nil {
+		v.SubaccountIds = make([]string, len(message.SubaccountIds))
+		for i, val := range message.SubaccountIds {
+			v.SubaccountIds[i] = val
+		}
+	}
+
+	return v, nil
+}
+
+// BuildGetGuildMarketsPayload builds the payload for the GuildsService
+// GetGuildMarkets endpoint from CLI flags.
+func BuildGetGuildMarketsPayload(guildsServiceGetGuildMarketsMessage string) (*guildsservice.GetGuildMarketsPayload, error) {
+	var err error
+	var message guilds_servicepb.GetGuildMarketsRequest
+	{
+		if guildsServiceGetGuildMarketsMessage != "" {
+			err = json.Unmarshal([]byte(guildsServiceGetGuildMarketsMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"guildID\": \"Perferendis eius odit.\"\n   }'")
+			}
+		}
+	}
+	v := &guildsservice.GetGuildMarketsPayload{
+		GuildID: message.GuildId,
+	}
+
+	return v, nil
+}
+
+// BuildGetGuildPortfoliosPayload builds the payload for the GuildsService
+// GetGuildPortfolios endpoint from CLI flags.
+func BuildGetGuildPortfoliosPayload(guildsServiceGetGuildPortfoliosMessage string) (*guildsservice.GetGuildPortfoliosPayload, error) {
+	var err error
+	var message guilds_servicepb.GetGuildPortfoliosRequest
+	{
+		if guildsServiceGetGuildPortfoliosMessage != "" {
+			err = json.Unmarshal([]byte(guildsServiceGetGuildPortfoliosMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"end_time\": 8244773029622670980,\n      \"guildID\": \"Sunt deserunt et.\",\n      \"start_time\": 2627691725784589356\n   }'")
+			}
+		}
+	}
+	v := &guildsservice.GetGuildPortfoliosPayload{
+		GuildID: message.GuildId,
+	}
+	if message.StartTime != 0 {
+		v.StartTime = &message.StartTime
+	}
+	if message.EndTime != 0 {
+		v.EndTime = &message.EndTime
+	}
+
+	return v, nil
+}
+
+// BuildGetAccountInfoPayload builds the payload for the GuildsService
+// GetAccountInfo endpoint from CLI flags.
+func BuildGetAccountInfoPayload(guildsServiceGetAccountInfoMessage string) (*guildsservice.GetAccountInfoPayload, error) {
+	var err error
+	var message guilds_servicepb.GetAccountInfoRequest
+	{
+		if guildsServiceGetAccountInfoMessage != "" {
+			err = json.Unmarshal([]byte(guildsServiceGetAccountInfoMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"injective_address\": \"Sit quis est.\"\n   }'")
+			}
+		}
+	}
+	v := &guildsservice.GetAccountInfoPayload{
+		InjectiveAddress: message.InjectiveAddress,
+	}
+
+	return v, nil
+}
+
+// BuildGetAccountPortfolioPayload builds the payload for the GuildsService
+// GetAccountPortfolio endpoint from CLI flags.
+func BuildGetAccountPortfolioPayload(guildsServiceGetAccountPortfolioMessage string) (*guildsservice.GetAccountPortfolioPayload, error) {
+	var err error
+	var message guilds_servicepb.GetAccountPortfolioRequest
+	{
+		if guildsServiceGetAccountPortfolioMessage != "" {
+			err = json.Unmarshal([]byte(guildsServiceGetAccountPortfolioMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"injective_address\": \"Ut sit aliquid qui id.\"\n   }'")
+			}
+		}
+	}
+	v := &guildsservice.GetAccountPortfolioPayload{
+		InjectiveAddress: message.InjectiveAddress,
+	}
+
+	return v, nil
+}
+
+// BuildGetAccountPortfoliosPayload builds the payload for the GuildsService
+// GetAccountPortfolios endpoint from CLI flags.
+func BuildGetAccountPortfoliosPayload(guildsServiceGetAccountPortfoliosMessage string) (*guildsservice.GetAccountPortfoliosPayload, error) {
+	var err error
+	var message guilds_servicepb.GetAccountPortfoliosRequest
+	{
+		if guildsServiceGetAccountPortfoliosMessage != "" {
+			err = json.Unmarshal([]byte(guildsServiceGetAccountPortfoliosMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"end_time\": 7334355108593481704,\n      \"guild_id\": \"Soluta similique sunt eum.\",\n      \"injective_address\": \"Qui similique sunt mollitia.\",\n      \"start_time\": 1778990171064774979\n   }'")
+			}
+		}
+	}
+	v := &guildsservice.GetAccountPortfoliosPayload{
+		InjectiveAddress: message.InjectiveAddress,
+	}
+	if message.StartTime != 0 {
+		v.StartTime = &message.StartTime
+	}
+	if message.EndTime != 0 {
+		v.EndTime = &message.EndTime
+	}
+	if message.GuildId != "" {
+		v.GuildID = &message.GuildId
+	}
+
+	return v, nil
+}
+
+// BuildGetAccountMembershipsPayload builds the payload for the GuildsService
+// GetAccountMemberships endpoint from CLI flags.
+func BuildGetAccountMembershipsPayload(guildsServiceGetAccountMembershipsMessage string) (*guildsservice.GetAccountMembershipsPayload, error) {
+	var err error
+	var message guilds_servicepb.GetAccountMembershipsRequest
+	{
+		if guildsServiceGetAccountMembershipsMessage != "" {
+			err = json.Unmarshal([]byte(guildsServiceGetAccountMembershipsMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"injective_address\": \"Amet repudiandae iure modi.\"\n   }'")
+			}
+		}
+	}
+	v := &guildsservice.GetAccountMembershipsPayload{
+		InjectiveAddress: message.InjectiveAddress,
+	}
+
+	return v, nil
+}
+
+// BuildGetAccountMonthlyPortfoliosPayload builds the payload for the
+// GuildsService GetAccountMonthlyPortfolios endpoint from CLI flags.
+func BuildGetAccountMonthlyPortfoliosPayload(guildsServiceGetAccountMonthlyPortfoliosMessage string) (*guildsservice.GetAccountMonthlyPortfoliosPayload, error) {
+	var err error
+	var message guilds_servicepb.GetAccountMonthlyPortfoliosRequest
+	{
+		if guildsServiceGetAccountMonthlyPortfoliosMessage != "" {
+			err = json.Unmarshal([]byte(guildsServiceGetAccountMonthlyPortfoliosMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"end_time\": 6289983053451601708,\n      \"injective_address\": \"Facere saepe eum dolorem.\",\n      \"start_time\": 415979598256199300\n   }'")
+			}
+		}
+	}
+	v := &guildsservice.GetAccountMonthlyPortfoliosPayload{
+		InjectiveAddress: message.InjectiveAddress,
+	}
+	if message.StartTime != 0 {
+		v.StartTime = &message.StartTime
+	}
+	if message.EndTime != 0 {
+		v.EndTime = &message.EndTime
+	}
+
+	return v, nil
+}
+
+// BuildGetAccountGrantsPayload builds the payload for the GuildsService
+// GetAccountGrants endpoint from CLI flags.
+func BuildGetAccountGrantsPayload(guildsServiceGetAccountGrantsMessage string) (*guildsservice.GetAccountGrantsPayload, error) {
+	var err error
+	var message guilds_servicepb.GetAccountGrantsRequest
+	{
+		if guildsServiceGetAccountGrantsMessage != "" {
+			err = json.Unmarshal([]byte(guildsServiceGetAccountGrantsMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"injective_address\": \"Deserunt voluptatem.\"\n   }'")
+			}
+		}
+	}
+	v := &guildsservice.GetAccountGrantsPayload{
+		InjectiveAddress: message.InjectiveAddress,
+	}
+
+	return v, nil
+}
+
+// BuildGetAccountTradesPayload builds the payload for the GuildsService
+// GetAccountTrades endpoint from CLI flags.
+func BuildGetAccountTradesPayload(guildsServiceGetAccountTradesMessage string) (*guildsservice.GetAccountTradesPayload, error) {
+	var err error
+	var message guilds_servicepb.GetAccountTradesRequest
+	{
+		if guildsServiceGetAccountTradesMessage != "" {
+			err = json.Unmarshal([]byte(guildsServiceGetAccountTradesMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"end_time\": 2024024348297001890,\n      \"injective_address\": \"Ea perspiciatis hic.\",\n      \"limit\": 3218097939626789332,\n      \"start_time\": 2756567090391200800\n   }'")
+			}
+		}
+	}
+	v := &guildsservice.GetAccountTradesPayload{
+		InjectiveAddress: message.InjectiveAddress,
+	}
+	if message.StartTime != 0 {
+		v.StartTime = &message.StartTime
+	}
+	if message.EndTime != 0 {
+		v.EndTime = &message.EndTime
+	}
+	if message.Limit != 0 {
+		v.Limit = &message.Limit
+	}
+
+	return v, nil
+}
+
+// BuildGetAccountTradeStatsPayload builds the payload for the GuildsService
+// GetAccountTradeStats endpoint from CLI flags.
+func BuildGetAccountTradeStatsPayload(guildsServiceGetAccountTradeStatsMessage string) (*guildsservice.GetAccountTradeStatsPayload, error) {
+	var err error
+	var message guilds_servicepb.GetAccountTradeStatsRequest
+	{
+		if guildsServiceGetAccountTradeStatsMessage != "" {
+			err = json.Unmarshal([]byte(guildsServiceGetAccountTradeStatsMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"end_time\": 4592834174800486800,\n      \"injective_address\": \"Distinctio et quidem voluptatem voluptatibus.\",\n      \"start_time\": 3990766382893376473\n   }'")
+			}
+		}
+	}
+	v := &guildsservice.GetAccountTradeStatsPayload{
+		InjectiveAddress: message.InjectiveAddress,
+	}
+	if message.StartTime != 0 {
+		v.StartTime = &message.StartTime
+	}
+	if message.EndTime != 0 {
+		v.EndTime = &message.EndTime
+	}
+
+	return v, nil
+}
+
+// BuildGetGuildTradeStatsPayload builds the payload for the GuildsService
+// GetGuildTradeStats endpoint from CLI flags.
+func BuildGetGuildTradeStatsPayload(guildsServiceGetGuildTradeStatsMessage string) (*guildsservice.GetGuildTradeStatsPayload, error) {
+	var err error
+	var message guilds_servicepb.GetGuildTradeStatsRequest
+	{
+		if guildsServiceGetGuildTradeStatsMessage != "" {
+			err = json.Unmarshal([]byte(guildsServiceGetGuildTradeStatsMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"end_time\": 2061470302329605065,\n      \"guildID\": \"Harum ab.\",\n      \"start_time\": 2706510039981699904\n   }'")
+			}
+		}
+	}
+	v := &guildsservice.GetGuildTradeStatsPayload{
+		GuildID: message.GuildId,
+	}
+	if message.StartTime != 0 {
+		v.StartTime = &message.StartTime
+	}
+	if message.EndTime != 0 {
+		v.EndTime = &message.EndTime
+	}
+
+	return v, nil
+}
+
+// BuildGetGuildFeesPayload builds the payload for the GuildsService
+// GetGuildFees endpoint from CLI flags.
+func BuildGetGuildFeesPayload(guildsServiceGetGuildFeesMessage string) (*guildsservice.GetGuildFeesPayload, error) {
+	var err error
+	var message guilds_servicepb.GetGuildFeesRequest
+	{
+		if guildsServiceGetGuildFeesMessage != "" {
+			err = json.Unmarshal([]byte(guildsServiceGetGuildFeesMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"end_time\": 5088498043211415095,\n      \"guildID\": \"Qui repudiandae voluptatibus sequi quae laborum totam.\",\n      \"start_time\": 4376617673466765707\n   }'")
+			}
+		}
+	}
+	v := &guildsservice.GetGuildFeesPayload{
+		GuildID: message.GuildId,
+	}
+	if message.StartTime != 0 {
+		v.StartTime = &message.StartTime
+	}
+	if message.EndTime != 0 {
+		v.EndTime = &message.EndTime
+	}
+
+	return v, nil
+}
+
+// BuildGetAccountTransfersPayload builds the payload for the GuildsService
+// GetAccountTransfers endpoint from CLI flags.
+func BuildGetAccountTransfersPayload(guildsServiceGetAccountTransfersMessage string) (*guildsservice.GetAccountTransfersPayload, error) {
+	var err error
+	var message guilds_servicepb.GetAccountTransfersRequest
+	{
+		if guildsServiceGetAccountTransfersMessage != "" {
+			err = json.Unmarshal([]byte(guildsServiceGetAccountTransfersMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"end_time\": 4314751392317343294,\n      \"injective_address\": \"Sapiente cumque in deserunt et fuga impedit.\",\n      \"limit\": 5986541094969328171,\n      \"start_time\": 1245407664569182885\n   }'")
+			}
+		}
+	}
+	v := &guildsservice.GetAccountTransfersPayload{
+		InjectiveAddress: message.InjectiveAddress,
+	}
+	if message.StartTime != 0 {
+		v.StartTime = &message.StartTime
+	}
+	if message.EndTime != 0 {
+		v.EndTime = &message.EndTime
+	}
+	if message.Limit != 0 {
+		v.Limit = &message.Limit
+	}
+
+	return v, nil
+}
+
+// BuildGetAccountPositionsPayload builds the payload for the GuildsService
+// GetAccountPositions endpoint from CLI flags.
+func BuildGetAccountPositionsPayload(guildsServiceGetAccountPositionsMessage string) (*guildsservice.GetAccountPositionsPayload, error) {
+	var err error
+	var message guilds_servicepb.GetAccountPositionsRequest
+	{
+		if guildsServiceGetAccountPositionsMessage != "" {
+			err = json.Unmarshal([]byte(guildsServiceGetAccountPositionsMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"injective_address\": \"Quae esse molestiae corrupti.\"\n   }'")
+			}
+		}
+	}
+	v := &guildsservice.GetAccountPositionsPayload{
+		InjectiveAddress: message.InjectiveAddress,
+	}
+
+	return v, nil
+}
+
+// BuildGetGuildRiskPayload builds the payload for the GuildsService
+// GetGuildRisk endpoint from CLI flags.
+func BuildGetGuildRiskPayload(guildsServiceGetGuildRiskMessage string) (*guildsservice.GetGuildRiskPayload, error) {
+	var err error
+	var message guilds_servicepb.GetGuildRiskRequest
+	{
+		if guildsServiceGetGuildRiskMessage != "" {
+			err = json.Unmarshal([]byte(guildsServiceGetGuildRiskMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"guildID\": \"Temporibus et ullam est.\"\n   }'")
+			}
+		}
+	}
+	v := &guildsservice.GetGuildRiskPayload{
+		GuildID: message.GuildId,
+	}
+
+	return v, nil
+}
+
+// BuildStreamPortfolioUpdatesPayload builds the payload for the GuildsService
+// StreamPortfolioUpdates endpoint from CLI flags.
+func BuildStreamPortfolioUpdatesPayload(guildsServiceStreamPortfolioUpdatesMessage string) (*guildsservice.StreamPortfolioUpdatesPayload, error) {
+	var err error
+	var message guilds_servicepb.StreamPortfolioUpdatesRequest
+	{
+		if guildsServiceStreamPortfolioUpdatesMessage != "" {
+			err = json.Unmarshal([]byte(guildsServiceStreamPortfolioUpdatesMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"guild_id\": \"Non nam et reprehenderit repellendus.\",\n      \"injective_address\": \"Omnis sit omnis accusantium voluptatibus.\",\n      \"last_event_id\": \"Qui quia voluptates necessitatibus.\"\n   }'")
+			}
+		}
+	}
+	v := &guildsservice.StreamPortfolioUpdatesPayload{}
+	if message.GuildId != "" {
+		v.GuildID = &message.GuildId
+	}
+	if message.InjectiveAddress != "" {
+		v.InjectiveAddress = &message.InjectiveAddress
+	}
+	if message.LastEventId != "" {
+		v.LastEventID = &message.LastEventId
+	}
+
+	return v, nil
+}
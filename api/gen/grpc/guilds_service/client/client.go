@@ -0,0 +1,978 @@
+// Code generated by goa v3.6.2, DO NOT EDIT.
+//
+// GuildsService gRPC client
+//
+// Command:
+// $ goa gen github.com/InjectiveLabs/injective-guilds-service/api/design -o ../
+
+package client
+
+import (
+	"context"
+
+	guilds_servicepb "github.com/InjectiveLabs/injective-guilds-service/api/gen/grpc/guilds_service/pb"
+	guildsservice "github.com/InjectiveLabs/injective-guilds-service/api/gen/guilds_service"
+	goagrpc "goa.design/goa/v3/grpc"
+	goapb "goa.design/goa/v3/grpc/pb"
+	goa "goa.design/goa/v3/pkg"
+	"google.golang.org/grpc"
+)
+
+// Client lists the service endpoint gRPC clients.
+type Client struct {
+	grpccli guilds_servicepb.GuildsServiceClient
+	opts    []grpc.CallOption
+}
+
+// StreamPortfolioUpdatesClientStream implements the
+// guildsservice.StreamPortfolioUpdatesClientStream interface.
+type StreamPortfolioUpdatesClientStream struct {
+	stream guilds_servicepb.GuildsService_StreamPortfolioUpdatesClient
+}
+
+// NewClient instantiates gRPC client for all the GuildsService service servers.
+func NewClient(cc *grpc.ClientConn, opts ...grpc.CallOption) *Client {
+	return &Client{
+		grpccli: guilds_servicepb.NewGuildsServiceClient(cc),
+		opts:    opts,
+	}
+}
+
+// GetAllGuilds calls the "GetAllGuilds" function in
+// guilds_servicepb.GuildsServiceClient interface.
+func (c *Client) GetAllGuilds() goa.Endpoint {
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		inv := goagrpc.NewInvoker(
+			BuildGetAllGuildsFunc(c.grpccli, c.opts...),
+			EncodeGetAllGuildsRequest,
+			DecodeGetAllGuildsResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *guilds_servicepb.GetAllGuildsNotFoundError:
+				if err := ValidateGetAllGuildsNotFoundError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetAllGuildsNotFoundError(message)
+			case *guilds_servicepb.GetAllGuildsInvalidArgError:
+				if err := ValidateGetAllGuildsInvalidArgError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetAllGuildsInvalidArgError(message)
+			case *guilds_servicepb.GetAllGuildsInternalError:
+				if err := ValidateGetAllGuildsInternalError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetAllGuildsInternalError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault(err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// GetSingleGuild calls the "GetSingleGuild" function in
+// guilds_servicepb.GuildsServiceClient interface.
+func (c *Client) GetSingleGuild() goa.Endpoint {
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		inv := goagrpc.NewInvoker(
+			BuildGetSingleGuildFunc(c.grpccli, c.opts...),
+			EncodeGetSingleGuildRequest,
+			DecodeGetSingleGuildResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *guilds_servicepb.GetSingleGuildNotFoundError:
+				if err := ValidateGetSingleGuildNotFoundError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetSingleGuildNotFoundError(message)
+			case *guilds_servicepb.GetSingleGuildInvalidArgError:
+				if err := ValidateGetSingleGuildInvalidArgError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetSingleGuildInvalidArgError(message)
+			case *guilds_servicepb.GetSingleGuildInternalError:
+				if err := ValidateGetSingleGuildInternalError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetSingleGuildInternalError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault(err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// GetGuildMembers calls the "GetGuildMembers" function in
+// guilds_servicepb.GuildsServiceClient interface.
+func (c *Client) GetGuildMembers() goa.Endpoint {
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		inv := goagrpc.NewInvoker(
+			BuildGetGuildMembersFunc(c.grpccli, c.opts...),
+			EncodeGetGuildMembersRequest,
+			DecodeGetGuildMembersResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *guilds_servicepb.GetGuildMembersNotFoundError:
+				if err := ValidateGetGuildMembersNotFoundError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetGuildMembersNotFoundError(message)
+			case *guilds_servicepb.GetGuildMembersInvalidArgError:
+				if err := ValidateGetGuildMembersInvalidArgError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetGuildMembersInvalidArgError(message)
+			case *guilds_servicepb.GetGuildMembersInternalError:
+				if err := ValidateGetGuildMembersInternalError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetGuildMembersInternalError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault(err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// GetGuildMasterAddress calls the "GetGuildMasterAddress" function in
+// guilds_servicepb.GuildsServiceClient interface.
+func (c *Client) GetGuildMasterAddress() goa.Endpoint {
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		inv := goagrpc.NewInvoker(
+			BuildGetGuildMasterAddressFunc(c.grpccli, c.opts...),
+			EncodeGetGuildMasterAddressRequest,
+			DecodeGetGuildMasterAddressResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *guilds_servicepb.GetGuildMasterAddressNotFoundError:
+				if err := ValidateGetGuildMasterAddressNotFoundError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetGuildMasterAddressNotFoundError(message)
+			case *guilds_servicepb.GetGuildMasterAddressInvalidArgError:
+				if err := ValidateGetGuildMasterAddressInvalidArgError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetGuildMasterAddressInvalidArgError(message)
+			case *guilds_servicepb.GetGuildMasterAddressInternalError:
+				if err := ValidateGetGuildMasterAddressInternalError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetGuildMasterAddressInternalError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault(err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// GetGuildDefaultMember calls the "GetGuildDefaultMember" function in
+// guilds_servicepb.GuildsServiceClient interface.
+func (c *Client) GetGuildDefaultMember() goa.Endpoint {
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		inv := goagrpc.NewInvoker(
+			BuildGetGuildDefaultMemberFunc(c.grpccli, c.opts...),
+			EncodeGetGuildDefaultMemberRequest,
+			DecodeGetGuildDefaultMemberResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *guilds_servicepb.GetGuildDefaultMemberNotFoundError:
+				if err := ValidateGetGuildDefaultMemberNotFoundError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetGuildDefaultMemberNotFoundError(message)
+			case *guilds_servicepb.GetGuildDefaultMemberInvalidArgError:
+				if err := ValidateGetGuildDefaultMemberInvalidArgError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetGuildDefaultMemberInvalidArgError(message)
+			case *guilds_servicepb.GetGuildDefaultMemberInternalError:
+				if err := ValidateGetGuildDefaultMemberInternalError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetGuildDefaultMemberInternalError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault(err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// EnterGuild calls the "EnterGuild" function in
+// guilds_servicepb.GuildsServiceClient interface.
+func (c *Client) EnterGuild() goa.Endpoint {
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		inv := goagrpc.NewInvoker(
+			BuildEnterGuildFunc(c.grpccli, c.opts...),
+			EncodeEnterGuildRequest,
+			DecodeEnterGuildResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *guilds_servicepb.EnterGuildNotFoundError:
+				if err := ValidateEnterGuildNotFoundError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewEnterGuildNotFoundError(message)
+			case *guilds_servicepb.EnterGuildInvalidArgError:
+				if err := ValidateEnterGuildInvalidArgError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewEnterGuildInvalidArgError(message)
+			case *guilds_servicepb.EnterGuildInternalError:
+				if err := ValidateEnterGuildInternalError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewEnterGuildInternalError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault(err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// GetGuildWaitlist calls the "GetGuildWaitlist" function in
+// guilds_servicepb.GuildsServiceClient interface.
+func (c *Client) GetGuildWaitlist() goa.Endpoint {
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		inv := goagrpc.NewInvoker(
+			BuildGetGuildWaitlistFunc(c.grpccli, c.opts...),
+			EncodeGetGuildWaitlistRequest,
+			DecodeGetGuildWaitlistResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *guilds_servicepb.GetGuildWaitlistNotFoundError:
+				if err := ValidateGetGuildWaitlistNotFoundError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetGuildWaitlistNotFoundError(message)
+			case *guilds_servicepb.GetGuildWaitlistInvalidArgError:
+				if err := ValidateGetGuildWaitlistInvalidArgError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetGuildWaitlistInvalidArgError(message)
+			case *guilds_servicepb.GetGuildWaitlistInternalError:
+				if err := ValidateGetGuildWaitlistInternalError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetGuildWaitlistInternalError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault(err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// LeaveGuild calls the "LeaveGuild" function in
+// guilds_servicepb.GuildsServiceClient interface.
+func (c *Client) LeaveGuild() goa.Endpoint {
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		inv := goagrpc.NewInvoker(
+			BuildLeaveGuildFunc(c.grpccli, c.opts...),
+			EncodeLeaveGuildRequest,
+			DecodeLeaveGuildResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *guilds_servicepb.LeaveGuildNotFoundError:
+				if err := ValidateLeaveGuildNotFoundError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewLeaveGuildNotFoundError(message)
+			case *guilds_servicepb.LeaveGuildInvalidArgError:
+				if err := ValidateLeaveGuildInvalidArgError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewLeaveGuildInvalidArgError(message)
+			case *guilds_servicepb.LeaveGuildInternalError:
+				if err := ValidateLeaveGuildInternalError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewLeaveGuildInternalError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault(err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// TransferMember calls the "TransferMember" function in
+// guilds_servicepb.GuildsServiceClient interface.
+func (c *Client) TransferMember() goa.Endpoint {
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		inv := goagrpc.NewInvoker(
+			BuildTransferMemberFunc(c.grpccli, c.opts...),
+			EncodeTransferMemberRequest,
+			DecodeTransferMemberResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *guilds_servicepb.TransferMemberNotFoundError:
+				if err := ValidateTransferMemberNotFoundError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewTransferMemberNotFoundError(message)
+			case *guilds_servicepb.TransferMemberInvalidArgError:
+				if err := ValidateTransferMemberInvalidArgError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewTransferMemberInvalidArgError(message)
+			case *guilds_servicepb.TransferMemberInternalError:
+				if err := ValidateTransferMemberInternalError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewTransferMemberInternalError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault(err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// GetGuildMarkets calls the "GetGuildMarkets" function in
+// guilds_servicepb.GuildsServiceClient interface.
+func (c *Client) GetGuildMarkets() goa.Endpoint {
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		inv := goagrpc.NewInvoker(
+			BuildGetGuildMarketsFunc(c.grpccli, c.opts...),
+			EncodeGetGuildMarketsRequest,
+			DecodeGetGuildMarketsResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *guilds_servicepb.GetGuildMarketsNotFoundError:
+				if err := ValidateGetGuildMarketsNotFoundError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetGuildMarketsNotFoundError(message)
+			case *guilds_servicepb.GetGuildMarketsInvalidArgError:
+				if err := ValidateGetGuildMarketsInvalidArgError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetGuildMarketsInvalidArgError(message)
+			case *guilds_servicepb.GetGuildMarketsInternalError:
+				if err := ValidateGetGuildMarketsInternalError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetGuildMarketsInternalError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault(err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// GetGuildPortfolios calls the "GetGuildPortfolios" function in
+// guilds_servicepb.GuildsServiceClient interface.
+func (c *Client) GetGuildPortfolios() goa.Endpoint {
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		inv := goagrpc.NewInvoker(
+			BuildGetGuildPortfoliosFunc(c.grpccli, c.opts...),
+			EncodeGetGuildPortfoliosRequest,
+			DecodeGetGuildPortfoliosResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *guilds_servicepb.GetGuildPortfoliosNotFoundError:
+				if err := ValidateGetGuildPortfoliosNotFoundError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetGuildPortfoliosNotFoundError(message)
+			case *guilds_servicepb.GetGuildPortfoliosInvalidArgError:
+				if err := ValidateGetGuildPortfoliosInvalidArgError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetGuildPortfoliosInvalidArgError(message)
+			case *guilds_servicepb.GetGuildPortfoliosInternalError:
+				if err := ValidateGetGuildPortfoliosInternalError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetGuildPortfoliosInternalError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault(err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// GetAccountInfo calls the "GetAccountInfo" function in
+// guilds_servicepb.GuildsServiceClient interface.
+func (c *Client) GetAccountInfo() goa.Endpoint {
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		inv := goagrpc.NewInvoker(
+			BuildGetAccountInfoFunc(c.grpccli, c.opts...),
+			EncodeGetAccountInfoRequest,
+			DecodeGetAccountInfoResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *guilds_servicepb.GetAccountInfoNotFoundError:
+				if err := ValidateGetAccountInfoNotFoundError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetAccountInfoNotFoundError(message)
+			case *guilds_servicepb.GetAccountInfoInvalidArgError:
+				if err := ValidateGetAccountInfoInvalidArgError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetAccountInfoInvalidArgError(message)
+			case *guilds_servicepb.GetAccountInfoInternalError:
+				if err := ValidateGetAccountInfoInternalError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetAccountInfoInternalError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault(err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// GetAccountPortfolio calls the "GetAccountPortfolio" function in
+// guilds_servicepb.GuildsServiceClient interface.
+func (c *Client) GetAccountPortfolio() goa.Endpoint {
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		inv := goagrpc.NewInvoker(
+			BuildGetAccountPortfolioFunc(c.grpccli, c.opts...),
+			EncodeGetAccountPortfolioRequest,
+			DecodeGetAccountPortfolioResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *guilds_servicepb.GetAccountPortfolioNotFoundError:
+				if err := ValidateGetAccountPortfolioNotFoundError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetAccountPortfolioNotFoundError(message)
+			case *guilds_servicepb.GetAccountPortfolioInvalidArgError:
+				if err := ValidateGetAccountPortfolioInvalidArgError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetAccountPortfolioInvalidArgError(message)
+			case *guilds_servicepb.GetAccountPortfolioInternalError:
+				if err := ValidateGetAccountPortfolioInternalError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetAccountPortfolioInternalError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault(err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// GetAccountPortfolios calls the "GetAccountPortfolios" function in
+// guilds_servicepb.GuildsServiceClient interface.
+func (c *Client) GetAccountPortfolios() goa.Endpoint {
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		inv := goagrpc.NewInvoker(
+			BuildGetAccountPortfoliosFunc(c.grpccli, c.opts...),
+			EncodeGetAccountPortfoliosRequest,
+			DecodeGetAccountPortfoliosResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *guilds_servicepb.GetAccountPortfoliosNotFoundError:
+				if err := ValidateGetAccountPortfoliosNotFoundError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetAccountPortfoliosNotFoundError(message)
+			case *guilds_servicepb.GetAccountPortfoliosInvalidArgError:
+				if err := ValidateGetAccountPortfoliosInvalidArgError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetAccountPortfoliosInvalidArgError(message)
+			case *guilds_servicepb.GetAccountPortfoliosInternalError:
+				if err := ValidateGetAccountPortfoliosInternalError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetAccountPortfoliosInternalError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault(err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// GetAccountMemberships calls the "GetAccountMemberships" function in
+// guilds_servicepb.GuildsServiceClient interface.
+func (c *Client) GetAccountMemberships() goa.Endpoint {
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		inv := goagrpc.NewInvoker(
+			BuildGetAccountMembershipsFunc(c.grpccli, c.opts...),
+			EncodeGetAccountMembershipsRequest,
+			DecodeGetAccountMembershipsResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *guilds_servicepb.GetAccountMembershipsNotFoundError:
+				if err := ValidateGetAccountMembershipsNotFoundError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetAccountMembershipsNotFoundError(message)
+			case *guilds_servicepb.GetAccountMembershipsInvalidArgError:
+				if err := ValidateGetAccountMembershipsInvalidArgError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetAccountMembershipsInvalidArgError(message)
+			case *guilds_servicepb.GetAccountMembershipsInternalError:
+				if err := ValidateGetAccountMembershipsInternalError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetAccountMembershipsInternalError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault(err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// GetAccountMonthlyPortfolios calls the "GetAccountMonthlyPortfolios" function
+// in guilds_servicepb.GuildsServiceClient interface.
+func (c *Client) GetAccountMonthlyPortfolios() goa.Endpoint {
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		inv := goagrpc.NewInvoker(
+			BuildGetAccountMonthlyPortfoliosFunc(c.grpccli, c.opts...),
+			EncodeGetAccountMonthlyPortfoliosRequest,
+			DecodeGetAccountMonthlyPortfoliosResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *guilds_servicepb.GetAccountMonthlyPortfoliosNotFoundError:
+				if err := ValidateGetAccountMonthlyPortfoliosNotFoundError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetAccountMonthlyPortfoliosNotFoundError(message)
+			case *guilds_servicepb.GetAccountMonthlyPortfoliosInvalidArgError:
+				if err := ValidateGetAccountMonthlyPortfoliosInvalidArgError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetAccountMonthlyPortfoliosInvalidArgError(message)
+			case *guilds_servicepb.GetAccountMonthlyPortfoliosInternalError:
+				if err := ValidateGetAccountMonthlyPortfoliosInternalError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetAccountMonthlyPortfoliosInternalError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault(err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// GetAccountGrants calls the "GetAccountGrants" function in
+// guilds_servicepb.GuildsServiceClient interface.
+func (c *Client) GetAccountGrants() goa.Endpoint {
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		inv := goagrpc.NewInvoker(
+			BuildGetAccountGrantsFunc(c.grpccli, c.opts...),
+			EncodeGetAccountGrantsRequest,
+			DecodeGetAccountGrantsResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *guilds_servicepb.GetAccountGrantsNotFoundError:
+				if err := ValidateGetAccountGrantsNotFoundError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetAccountGrantsNotFoundError(message)
+			case *guilds_servicepb.GetAccountGrantsInvalidArgError:
+				if err := ValidateGetAccountGrantsInvalidArgError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetAccountGrantsInvalidArgError(message)
+			case *guilds_servicepb.GetAccountGrantsInternalError:
+				if err := ValidateGetAccountGrantsInternalError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetAccountGrantsInternalError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault(err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// GetAccountTrades calls the "GetAccountTrades" function in
+// guilds_servicepb.GuildsServiceClient interface.
+func (c *Client) GetAccountTrades() goa.Endpoint {
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		inv := goagrpc.NewInvoker(
+			BuildGetAccountTradesFunc(c.grpccli, c.opts...),
+			EncodeGetAccountTradesRequest,
+			DecodeGetAccountTradesResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *guilds_servicepb.GetAccountTradesNotFoundError:
+				if err := ValidateGetAccountTradesNotFoundError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetAccountTradesNotFoundError(message)
+			case *guilds_servicepb.GetAccountTradesInvalidArgError:
+				if err := ValidateGetAccountTradesInvalidArgError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetAccountTradesInvalidArgError(message)
+			case *guilds_servicepb.GetAccountTradesInternalError:
+				if err := ValidateGetAccountTradesInternalError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetAccountTradesInternalError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault(err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// GetAccountTradeStats calls the "GetAccountTradeStats" function in
+// guilds_servicepb.GuildsServiceClient interface.
+func (c *Client) GetAccountTradeStats() goa.Endpoint {
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		inv := goagrpc.NewInvoker(
+			BuildGetAccountTradeStatsFunc(c.grpccli, c.opts...),
+			EncodeGetAccountTradeStatsRequest,
+			DecodeGetAccountTradeStatsResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *guilds_servicepb.GetAccountTradeStatsNotFoundError:
+				if err := ValidateGetAccountTradeStatsNotFoundError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetAccountTradeStatsNotFoundError(message)
+			case *guilds_servicepb.GetAccountTradeStatsInvalidArgError:
+				if err := ValidateGetAccountTradeStatsInvalidArgError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetAccountTradeStatsInvalidArgError(message)
+			case *guilds_servicepb.GetAccountTradeStatsInternalError:
+				if err := ValidateGetAccountTradeStatsInternalError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetAccountTradeStatsInternalError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault(err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// GetGuildTradeStats calls the "GetGuildTradeStats" function in
+// guilds_servicepb.GuildsServiceClient interface.
+func (c *Client) GetGuildTradeStats() goa.Endpoint {
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		inv := goagrpc.NewInvoker(
+			BuildGetGuildTradeStatsFunc(c.grpccli, c.opts...),
+			EncodeGetGuildTradeStatsRequest,
+			DecodeGetGuildTradeStatsResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *guilds_servicepb.GetGuildTradeStatsNotFoundError:
+				if err := ValidateGetGuildTradeStatsNotFoundError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetGuildTradeStatsNotFoundError(message)
+			case *guilds_servicepb.GetGuildTradeStatsInvalidArgError:
+				if err := ValidateGetGuildTradeStatsInvalidArgError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetGuildTradeStatsInvalidArgError(message)
+			case *guilds_servicepb.GetGuildTradeStatsInternalError:
+				if err := ValidateGetGuildTradeStatsInternalError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetGuildTradeStatsInternalError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault(err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// GetGuildFees calls the "GetGuildFees" function in
+// guilds_servicepb.GuildsServiceClient interface.
+func (c *Client) GetGuildFees() goa.Endpoint {
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		inv := goagrpc.NewInvoker(
+			BuildGetGuildFeesFunc(c.grpccli, c.opts...),
+			EncodeGetGuildFeesRequest,
+			DecodeGetGuildFeesResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *guilds_servicepb.GetGuildFeesNotFoundError:
+				if err := ValidateGetGuildFeesNotFoundError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetGuildFeesNotFoundError(message)
+			case *guilds_servicepb.GetGuildFeesInvalidArgError:
+				if err := ValidateGetGuildFeesInvalidArgError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetGuildFeesInvalidArgError(message)
+			case *guilds_servicepb.GetGuildFeesInternalError:
+				if err := ValidateGetGuildFeesInternalError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetGuildFeesInternalError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault(err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// GetAccountTransfers calls the "GetAccountTransfers" function in
+// guilds_servicepb.GuildsServiceClient interface.
+func (c *Client) GetAccountTransfers() goa.Endpoint {
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		inv := goagrpc.NewInvoker(
+			BuildGetAccountTransfersFunc(c.grpccli, c.opts...),
+			EncodeGetAccountTransfersRequest,
+			DecodeGetAccountTransfersResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *guilds_servicepb.GetAccountTransfersNotFoundError:
+				if err := ValidateGetAccountTransfersNotFoundError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetAccountTransfersNotFoundError(message)
+			case *guilds_servicepb.GetAccountTransfersInvalidArgError:
+				if err := ValidateGetAccountTransfersInvalidArgError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetAccountTransfersInvalidArgError(message)
+			case *guilds_servicepb.GetAccountTransfersInternalError:
+				if err := ValidateGetAccountTransfersInternalError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetAccountTransfersInternalError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault(err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// GetAccountPositions calls the "GetAccountPositions" function in
+// guilds_servicepb.GuildsServiceClient interface.
+func (c *Client) GetAccountPositions() goa.Endpoint {
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		inv := goagrpc.NewInvoker(
+			BuildGetAccountPositionsFunc(c.grpccli, c.opts...),
+			EncodeGetAccountPositionsRequest,
+			DecodeGetAccountPositionsResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *guilds_servicepb.GetAccountPositionsNotFoundError:
+				if err := ValidateGetAccountPositionsNotFoundError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetAccountPositionsNotFoundError(message)
+			case *guilds_servicepb.GetAccountPositionsInvalidArgError:
+				if err := ValidateGetAccountPositionsInvalidArgError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetAccountPositionsInvalidArgError(message)
+			case *guilds_servicepb.GetAccountPositionsInternalError:
+				if err := ValidateGetAccountPositionsInternalError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetAccountPositionsInternalError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault(err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// GetGuildRisk calls the "GetGuildRisk" function in
+// guilds_servicepb.GuildsServiceClient interface.
+func (c *Client) GetGuildRisk() goa.Endpoint {
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		inv := goagrpc.NewInvoker(
+			BuildGetGuildRiskFunc(c.grpccli, c.opts...),
+			EncodeGetGuildRiskRequest,
+			DecodeGetGuildRiskResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *guilds_servicepb.GetGuildRiskNotFoundError:
+				if err := ValidateGetGuildRiskNotFoundError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetGuildRiskNotFoundError(message)
+			case *guilds_servicepb.GetGuildRiskInvalidArgError:
+				if err := ValidateGetGuildRiskInvalidArgError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetGuildRiskInvalidArgError(message)
+			case *guilds_servicepb.GetGuildRiskInternalError:
+				if err := ValidateGetGuildRiskInternalError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewGetGuildRiskInternalError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault(err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// StreamPortfolioUpdates calls the "StreamPortfolioUpdates" function in
+// guilds_servicepb.GuildsServiceClient interface.
+func (c *Client) StreamPortfolioUpdates() goa.Endpoint {
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		inv := goagrpc.NewInvoker(
+			BuildStreamPortfolioUpdatesFunc(c.grpccli, c.opts...),
+			EncodeStreamPortfolioUpdatesRequest,
+			DecodeStreamPortfolioUpdatesResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *guilds_servicepb.StreamPortfolioUpdatesNotFoundError:
+				if err := ValidateStreamPortfolioUpdatesNotFoundError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewStreamPortfolioUpdatesNotFoundError(message)
+			case *guilds_servicepb.StreamPortfolioUpdatesInvalidArgError:
+				if err := ValidateStreamPortfolioUpdatesInvalidArgError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewStreamPortfolioUpdatesInvalidArgError(message)
+			case *guilds_servicepb.StreamPortfolioUpdatesInternalError:
+				if err := ValidateStreamPortfolioUpdatesInternalError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewStreamPortfolioUpdatesInternalError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault(err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// Recv reads instances of "guilds_servicepb.StreamPortfolioUpdatesResponse"
+// from the "StreamPortfolioUpdates" endpoint gRPC stream.
+func (s *StreamPortfolioUpdatesClientStream) Recv() (*guildsservice.PortfolioUpdate, error) {
+	var res *guildsservice.PortfolioUpdate
+	v, err := s.stream.Recv()
+	if err != nil {
+		return res, err
+	}
+	if err = ValidateStreamPortfolioUpdatesResponse(v); err != nil {
+		return res, err
+	}
+	return NewPortfolioUpdate(v), nil
+}
@@ -0,0 +1,917 @@
+// Code generated by goa v3.6.2, DO NOT EDIT.
+//
+// GuildsService gRPC client encoders and decoders
+//
+// Command:
+// $ goa gen github.com/InjectiveLabs/injective-guilds-service/api/design -o ../
+
+package client
+
+import (
+	"context"
+
+	guilds_servicepb "github.com/InjectiveLabs/injective-guilds-service/api/gen/grpc/guilds_service/pb"
+	guildsservice "github.com/InjectiveLabs/injective-guilds-service/api/gen/guilds_service"
+	goagrpc "goa.design/goa/v3/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// BuildGetAllGuildsFunc builds the remote method to invoke for "GuildsService"
+// service "GetAllGuilds" endpoint.
+func BuildGetAllGuildsFunc(grpccli guilds_servicepb.GuildsServiceClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb interface{}, opts ...grpc.CallOption) (interface{}, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.GetAllGuilds(ctx, reqpb.(*guilds_servicepb.GetAllGuildsRequest), opts...)
+		}
+		return grpccli.GetAllGuilds(ctx, &guilds_servicepb.GetAllGuildsRequest{}, opts...)
+	}
+}
+
+// EncodeGetAllGuildsRequest encodes requests sent to GuildsService
+// GetAllGuilds endpoint.
+func EncodeGetAllGuildsRequest(ctx context.Context, v interface{}, md *metadata.MD) (interface{}, error) {
+	payload, ok := v.(*guildsservice.GetAllGuildsPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetAllGuilds", "*guildsservice.GetAllGuildsPayload", v)
+	}
+	return NewGetAllGuildsRequest(payload), nil
+}
+
+// DecodeGetAllGuildsResponse decodes responses from the GuildsService
+// GetAllGuilds endpoint.
+func DecodeGetAllGuildsResponse(ctx context.Context, v interface{}, hdr, trlr metadata.MD) (interface{}, error) {
+	message, ok := v.(*guilds_servicepb.GetAllGuildsResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetAllGuilds", "*guilds_servicepb.GetAllGuildsResponse", v)
+	}
+	if err := ValidateGetAllGuildsResponse(message); err != nil {
+		return nil, err
+	}
+	res := NewGetAllGuildsResult(message)
+	return res, nil
+}
+
+// BuildGetSingleGuildFunc builds the remote method to invoke for
+// "GuildsService" service "GetSingleGuild" endpoint.
+func BuildGetSingleGuildFunc(grpccli guilds_servicepb.GuildsServiceClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb interface{}, opts ...grpc.CallOption) (interface{}, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.GetSingleGuild(ctx, reqpb.(*guilds_servicepb.GetSingleGuildRequest), opts...)
+		}
+		return grpccli.GetSingleGuild(ctx, &guilds_servicepb.GetSingleGuildRequest{}, opts...)
+	}
+}
+
+// EncodeGetSingleGuildRequest encodes requests sent to GuildsService
+// GetSingleGuild endpoint.
+func EncodeGetSingleGuildRequest(ctx context.Context, v interface{}, md *metadata.MD) (interface{}, error) {
+	payload, ok := v.(*guildsservice.GetSingleGuildPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetSingleGuild", "*guildsservice.GetSingleGuildPayload", v)
+	}
+	return NewGetSingleGuildRequest(payload), nil
+}
+
+// DecodeGetSingleGuildResponse decodes responses from the GuildsService
+// GetSingleGuild endpoint.
+func DecodeGetSingleGuildResponse(ctx context.Context, v interface{}, hdr, trlr metadata.MD) (interface{}, error) {
+	message, ok := v.(*guilds_servicepb.GetSingleGuildResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetSingleGuild", "*guilds_servicepb.GetSingleGuildResponse", v)
+	}
+	if err := ValidateGetSingleGuildResponse(message); err != nil {
+		return nil, err
+	}
+	res := NewGetSingleGuildResult(message)
+	return res, nil
+}
+
+// BuildGetGuildMembersFunc builds the remote method to invoke for
+// "GuildsService" service "GetGuildMembers" endpoint.
+func BuildGetGuildMembersFunc(grpccli guilds_servicepb.GuildsServiceClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb interface{}, opts ...grpc.CallOption) (interface{}, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.GetGuildMembers(ctx, reqpb.(*guilds_servicepb.GetGuildMembersRequest), opts...)
+		}
+		return grpccli.GetGuildMembers(ctx, &guilds_servicepb.GetGuildMembersRequest{}, opts...)
+	}
+}
+
+// EncodeGetGuildMembersRequest encodes requests sent to GuildsService
+// GetGuildMembers endpoint.
+func EncodeGetGuildMembersRequest(ctx context.Context, v interface{}, md *metadata.MD) (interface{}, error) {
+	payload, ok := v.(*guildsservice.GetGuildMembersPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetGuildMembers", "*guildsservice.GetGuildMembersPayload", v)
+	}
+	return NewGetGuildMembersRequest(payload), nil
+}
+
+// DecodeGetGuildMembersResponse decodes responses from the GuildsService
+// GetGuildMembers endpoint.
+func DecodeGetGuildMembersResponse(ctx context.Context, v interface{}, hdr, trlr metadata.MD) (interface{}, error) {
+	message, ok := v.(*guilds_servicepb.GetGuildMembersResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetGuildMembers", "*guilds_servicepb.GetGuildMembersResponse", v)
+	}
+	res := NewGetGuildMembersResult(message)
+	return res, nil
+}
+
+// BuildGetGuildMasterAddressFunc builds the remote method to invoke for
+// "GuildsService" service "GetGuildMasterAddress" endpoint.
+func BuildGetGuildMasterAddressFunc(grpccli guilds_servicepb.GuildsServiceClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb interface{}, opts ...grpc.CallOption) (interface{}, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.GetGuildMasterAddress(ctx, reqpb.(*guilds_servicepb.GetGuildMasterAddressRequest), opts...)
+		}
+		return grpccli.GetGuildMasterAddress(ctx, &guilds_servicepb.GetGuildMasterAddressRequest{}, opts...)
+	}
+}
+
+// EncodeGetGuildMasterAddressRequest encodes requests sent to GuildsService
+// GetGuildMasterAddress endpoint.
+func EncodeGetGuildMasterAddressRequest(ctx context.Context, v interface{}, md *metadata.MD) (interface{}, error) {
+	payload, ok := v.(*guildsservice.GetGuildMasterAddressPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetGuildMasterAddress", "*guildsservice.GetGuildMasterAddressPayload", v)
+	}
+	return NewGetGuildMasterAddressRequest(payload), nil
+}
+
+// DecodeGetGuildMasterAddressResponse decodes responses from the GuildsService
+// GetGuildMasterAddress endpoint.
+func DecodeGetGuildMasterAddressResponse(ctx context.Context, v interface{}, hdr, trlr metadata.MD) (interface{}, error) {
+	message, ok := v.(*guilds_servicepb.GetGuildMasterAddressResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetGuildMasterAddress", "*guilds_servicepb.GetGuildMasterAddressResponse", v)
+	}
+	res := NewGetGuildMasterAddressResult(message)
+	return res, nil
+}
+
+// BuildGetGuildDefaultMemberFunc builds the remote method to invoke for
+// "GuildsService" service "GetGuildDefaultMember" endpoint.
+func BuildGetGuildDefaultMemberFunc(grpccli guilds_servicepb.GuildsServiceClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb interface{}, opts ...grpc.CallOption) (interface{}, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.GetGuildDefaultMember(ctx, reqpb.(*guilds_servicepb.GetGuildDefaultMemberRequest), opts...)
+		}
+		return grpccli.GetGuildDefaultMember(ctx, &guilds_servicepb.GetGuildDefaultMemberRequest{}, opts...)
+	}
+}
+
+// EncodeGetGuildDefaultMemberRequest encodes requests sent to GuildsService
+// GetGuildDefaultMember endpoint.
+func EncodeGetGuildDefaultMemberRequest(ctx context.Context, v interface{}, md *metadata.MD) (interface{}, error) {
+	payload, ok := v.(*guildsservice.GetGuildDefaultMemberPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetGuildDefaultMember", "*guildsservice.GetGuildDefaultMemberPayload", v)
+	}
+	return NewGetGuildDefaultMemberRequest(payload), nil
+}
+
+// DecodeGetGuildDefaultMemberResponse decodes responses from the GuildsService
+// GetGuildDefaultMember endpoint.
+func DecodeGetGuildDefaultMemberResponse(ctx context.Context, v interface{}, hdr, trlr metadata.MD) (interface{}, error) {
+	message, ok := v.(*guilds_servicepb.GetGuildDefaultMemberResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetGuildDefaultMember", "*guilds_servicepb.GetGuildDefaultMemberResponse", v)
+	}
+	res := NewGetGuildDefaultMemberResult(message)
+	return res, nil
+}
+
+// BuildEnterGuildFunc builds the remote method to invoke for "GuildsService"
+// service "EnterGuild" endpoint.
+func BuildEnterGuildFunc(grpccli guilds_servicepb.GuildsServiceClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb interface{}, opts ...grpc.CallOption) (interface{}, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.EnterGuild(ctx, reqpb.(*guilds_servicepb.EnterGuildRequest), opts...)
+		}
+		return grpccli.EnterGuild(ctx, &guilds_servicepb.EnterGuildRequest{}, opts...)
+	}
+}
+
+// EncodeEnterGuildRequest encodes requests sent to GuildsService EnterGuild
+// endpoint.
+func EncodeEnterGuildRequest(ctx context.Context, v interface{}, md *metadata.MD) (interface{}, error) {
+	payload, ok := v.(*guildsservice.EnterGuildPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "EnterGuild", "*guildsservice.EnterGuildPayload", v)
+	}
+	return NewEnterGuildRequest(payload), nil
+}
+
+// DecodeEnterGuildResponse decodes responses from the GuildsService EnterGuild
+// endpoint.
+func DecodeEnterGuildResponse(ctx context.Context, v interface{}, hdr, trlr metadata.MD) (interface{}, error) {
+	message, ok := v.(*guilds_servicepb.EnterGuildResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "EnterGuild", "*guilds_servicepb.EnterGuildResponse", v)
+	}
+	res := NewEnterGuildResult(message)
+	return res, nil
+}
+
+// BuildGetGuildWaitlistFunc builds the remote method to invoke for
+// "GuildsService" service "GetGuildWaitlist" endpoint.
+func BuildGetGuildWaitlistFunc(grpccli guilds_servicepb.GuildsServiceClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb interface{}, opts ...grpc.CallOption) (interface{}, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.GetGuildWaitlist(ctx, reqpb.(*guilds_servicepb.GetGuildWaitlistRequest), opts...)
+		}
+		return grpccli.GetGuildWaitlist(ctx, &guilds_servicepb.GetGuildWaitlistRequest{}, opts...)
+	}
+}
+
+// EncodeGetGuildWaitlistRequest encodes requests sent to GuildsService
+// GetGuildWaitlist endpoint.
+func EncodeGetGuildWaitlistRequest(ctx context.Context, v interface{}, md *metadata.MD) (interface{}, error) {
+	payload, ok := v.(*guildsservice.GetGuildWaitlistPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetGuildWaitlist", "*guildsservice.GetGuildWaitlistPayload", v)
+	}
+	return NewGetGuildWaitlistRequest(payload), nil
+}
+
+// DecodeGetGuildWaitlistResponse decodes responses from the GuildsService
+// GetGuildWaitlist endpoint.
+func DecodeGetGuildWaitlistResponse(ctx context.Context, v interface{}, hdr, trlr metadata.MD) (interface{}, error) {
+	message, ok := v.(*guilds_servicepb.GetGuildWaitlistResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetGuildWaitlist", "*guilds_servicepb.GetGuildWaitlistResponse", v)
+	}
+	res := NewGetGuildWaitlistResult(message)
+	return res, nil
+}
+
+// BuildLeaveGuildFunc builds the remote method to invoke for "GuildsService"
+// service "LeaveGuild" endpoint.
+func BuildLeaveGuildFunc(grpccli guilds_servicepb.GuildsServiceClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb interface{}, opts ...grpc.CallOption) (interface{}, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.LeaveGuild(ctx, reqpb.(*guilds_servicepb.LeaveGuildRequest), opts...)
+		}
+		return grpccli.LeaveGuild(ctx, &guilds_servicepb.LeaveGuildRequest{}, opts...)
+	}
+}
+
+// EncodeLeaveGuildRequest encodes requests sent to GuildsService LeaveGuild
+// endpoint.
+func EncodeLeaveGuildRequest(ctx context.Context, v interface{}, md *metadata.MD) (interface{}, error) {
+	payload, ok := v.(*guildsservice.LeaveGuildPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "LeaveGuild", "*guildsservice.LeaveGuildPayload", v)
+	}
+	return NewLeaveGuildRequest(payload), nil
+}
+
+// DecodeLeaveGuildResponse decodes responses from the GuildsService LeaveGuild
+// endpoint.
+func DecodeLeaveGuildResponse(ctx context.Context, v interface{}, hdr, trlr metadata.MD) (interface{}, error) {
+	message, ok := v.(*guilds_servicepb.LeaveGuildResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "LeaveGuild", "*guilds_servicepb.LeaveGuildResponse", v)
+	}
+	res := NewLeaveGuildResult(message)
+	return res, nil
+}
+
+// BuildTransferMemberFunc builds the remote method to invoke for
+// "GuildsService" service "TransferMember" endpoint.
+func BuildTransferMemberFunc(grpccli guilds_servicepb.GuildsServiceClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb interface{}, opts ...grpc.CallOption) (interface{}, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.TransferMember(ctx, reqpb.(*guilds_servicepb.TransferMemberRequest), opts...)
+		}
+		return grpccli.TransferMember(ctx, &guilds_servicepb.TransferMemberRequest{}, opts...)
+	}
+}
+
+// EncodeTransferMemberRequest encodes requests sent to GuildsService
+// TransferMember endpoint.
+func EncodeTransferMemberRequest(ctx context.Context, v interface{}, md *metadata.MD) (interface{}, error) {
+	payload, ok := v.(*guildsservice.TransferMemberPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "TransferMember", "*guildsservice.TransferMemberPayload", v)
+	}
+	return NewTransferMemberRequest(payload), nil
+}
+
+// DecodeTransferMemberResponse decodes responses from the GuildsService
+// TransferMember endpoint.
+func DecodeTransferMemberResponse(ctx context.Context, v interface{}, hdr, trlr metadata.MD) (interface{}, error) {
+	message, ok := v.(*guilds_servicepb.TransferMemberResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "TransferMember", "*guilds_servicepb.TransferMemberResponse", v)
+	}
+	res := NewTransferMemberResult(message)
+	return res, nil
+}
+
+// BuildGetGuildMarketsFunc builds the remote method to invoke for
+// "GuildsService" service "GetGuildMarkets" endpoint.
+func BuildGetGuildMarketsFunc(grpccli guilds_servicepb.GuildsServiceClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb interface{}, opts ...grpc.CallOption) (interface{}, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.GetGuildMarkets(ctx, reqpb.(*guilds_servicepb.GetGuildMarketsRequest), opts...)
+		}
+		return grpccli.GetGuildMarkets(ctx, &guilds_servicepb.GetGuildMarketsRequest{}, opts...)
+	}
+}
+
+// EncodeGetGuildMarketsRequest encodes requests sent to GuildsService
+// GetGuildMarkets endpoint.
+func EncodeGetGuildMarketsRequest(ctx context.Context, v interface{}, md *metadata.MD) (interface{}, error) {
+	payload, ok := v.(*guildsservice.GetGuildMarketsPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetGuildMarkets", "*guildsservice.GetGuildMarketsPayload", v)
+	}
+	return NewGetGuildMarketsRequest(payload), nil
+}
+
+// DecodeGetGuildMarketsResponse decodes responses from the GuildsService
+// GetGuildMarkets endpoint.
+func DecodeGetGuildMarketsResponse(ctx context.Context, v interface{}, hdr, trlr metadata.MD) (interface{}, error) {
+	message, ok := v.(*guilds_servicepb.GetGuildMarketsResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetGuildMarkets", "*guilds_servicepb.GetGuildMarketsResponse", v)
+	}
+	res := NewGetGuildMarketsResult(message)
+	return res, nil
+}
+
+// BuildGetGuildPortfoliosFunc builds the remote method to invoke for
+// "GuildsService" service "GetGuildPortfolios" endpoint.
+func BuildGetGuildPortfoliosFunc(grpccli guilds_servicepb.GuildsServiceClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb interface{}, opts ...grpc.CallOption) (interface{}, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.GetGuildPortfolios(ctx, reqpb.(*guilds_servicepb.GetGuildPortfoliosRequest), opts...)
+		}
+		return grpccli.GetGuildPortfolios(ctx, &guilds_servicepb.GetGuildPortfoliosRequest{}, opts...)
+	}
+}
+
+// EncodeGetGuildPortfoliosRequest encodes requests sent to GuildsService
+// GetGuildPortfolios endpoint.
+func EncodeGetGuildPortfoliosRequest(ctx context.Context, v interface{}, md *metadata.MD) (interface{}, error) {
+	payload, ok := v.(*guildsservice.GetGuildPortfoliosPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetGuildPortfolios", "*guildsservice.GetGuildPortfoliosPayload", v)
+	}
+	return NewGetGuildPortfoliosRequest(payload), nil
+}
+
+// DecodeGetGuildPortfoliosResponse decodes responses from the GuildsService
+// GetGuildPortfolios endpoint.
+func DecodeGetGuildPortfoliosResponse(ctx context.Context, v interface{}, hdr, trlr metadata.MD) (interface{}, error) {
+	message, ok := v.(*guilds_servicepb.GetGuildPortfoliosResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetGuildPortfolios", "*guilds_servicepb.GetGuildPortfoliosResponse", v)
+	}
+	if err := ValidateGetGuildPortfoliosResponse(message); err != nil {
+		return nil, err
+	}
+	res := NewGetGuildPortfoliosResult(message)
+	return res, nil
+}
+
+// BuildGetAccountInfoFunc builds the remote method to invoke for
+// "GuildsService" service "GetAccountInfo" endpoint.
+func BuildGetAccountInfoFunc(grpccli guilds_servicepb.GuildsServiceClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb interface{}, opts ...grpc.CallOption) (interface{}, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.GetAccountInfo(ctx, reqpb.(*guilds_servicepb.GetAccountInfoRequest), opts...)
+		}
+		return grpccli.GetAccountInfo(ctx, &guilds_servicepb.GetAccountInfoRequest{}, opts...)
+	}
+}
+
+// EncodeGetAccountInfoRequest encodes requests sent to GuildsService
+// GetAccountInfo endpoint.
+func EncodeGetAccountInfoRequest(ctx context.Context, v interface{}, md *metadata.MD) (interface{}, error) {
+	payload, ok := v.(*guildsservice.GetAccountInfoPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetAccountInfo", "*guildsservice.GetAccountInfoPayload", v)
+	}
+	return NewGetAccountInfoRequest(payload), nil
+}
+
+// DecodeGetAccountInfoResponse decodes responses from the GuildsService
+// GetAccountInfo endpoint.
+func DecodeGetAccountInfoResponse(ctx context.Context, v interface{}, hdr, trlr metadata.MD) (interface{}, error) {
+	message, ok := v.(*guilds_servicepb.GetAccountInfoResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetAccountInfo", "*guilds_servicepb.GetAccountInfoResponse", v)
+	}
+	res := NewGetAccountInfoResult(message)
+	return res, nil
+}
+
+// BuildGetAccountPortfolioFunc builds the remote method to invoke for
+// "GuildsService" service "GetAccountPortfolio" endpoint.
+func BuildGetAccountPortfolioFunc(grpccli guilds_servicepb.GuildsServiceClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb interface{}, opts ...grpc.CallOption) (interface{}, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.GetAccountPortfolio(ctx, reqpb.(*guilds_servicepb.GetAccountPortfolioRequest), opts...)
+		}
+		return grpccli.GetAccountPortfolio(ctx, &guilds_servicepb.GetAccountPortfolioRequest{}, opts...)
+	}
+}
+
+// EncodeGetAccountPortfolioRequest encodes requests sent to GuildsService
+// GetAccountPortfolio endpoint.
+func EncodeGetAccountPortfolioRequest(ctx context.Context, v interface{}, md *metadata.MD) (interface{}, error) {
+	payload, ok := v.(*guildsservice.GetAccountPortfolioPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetAccountPortfolio", "*guildsservice.GetAccountPortfolioPayload", v)
+	}
+	return NewGetAccountPortfolioRequest(payload), nil
+}
+
+// DecodeGetAccountPortfolioResponse decodes responses from the GuildsService
+// GetAccountPortfolio endpoint.
+func DecodeGetAccountPortfolioResponse(ctx context.Context, v interface{}, hdr, trlr metadata.MD) (interface{}, error) {
+	message, ok := v.(*guilds_servicepb.GetAccountPortfolioResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetAccountPortfolio", "*guilds_servicepb.GetAccountPortfolioResponse", v)
+	}
+	if err := ValidateGetAccountPortfolioResponse(message); err != nil {
+		return nil, err
+	}
+	res := NewGetAccountPortfolioResult(message)
+	return res, nil
+}
+
+// BuildGetAccountPortfoliosFunc builds the remote method to invoke for
+// "GuildsService" service "GetAccountPortfolios" endpoint.
+func BuildGetAccountPortfoliosFunc(grpccli guilds_servicepb.GuildsServiceClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb interface{}, opts ...grpc.CallOption) (interface{}, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.GetAccountPortfolios(ctx, reqpb.(*guilds_servicepb.GetAccountPortfoliosRequest), opts...)
+		}
+		return grpccli.GetAccountPortfolios(ctx, &guilds_servicepb.GetAccountPortfoliosRequest{}, opts...)
+	}
+}
+
+// EncodeGetAccountPortfoliosRequest encodes requests sent to GuildsService
+// GetAccountPortfolios endpoint.
+func EncodeGetAccountPortfoliosRequest(ctx context.Context, v interface{}, md *metadata.MD) (interface{}, error) {
+	payload, ok := v.(*guildsservice.GetAccountPortfoliosPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetAccountPortfolios", "*guildsservice.GetAccountPortfoliosPayload", v)
+	}
+	return NewGetAccountPortfoliosRequest(payload), nil
+}
+
+// DecodeGetAccountPortfoliosResponse decodes responses from the GuildsService
+// GetAccountPortfolios endpoint.
+func DecodeGetAccountPortfoliosResponse(ctx context.Context, v interface{}, hdr, trlr metadata.MD) (interface{}, error) {
+	message, ok := v.(*guilds_servicepb.GetAccountPortfoliosResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetAccountPortfolios", "*guilds_servicepb.GetAccountPortfoliosResponse", v)
+	}
+	if err := ValidateGetAccountPortfoliosResponse(message); err != nil {
+		return nil, err
+	}
+	res := NewGetAccountPortfoliosResult(message)
+	return res, nil
+}
+
+// BuildGetAccountMembershipsFunc builds the remote method to invoke for
+// "GuildsService" service "GetAccountMemberships" endpoint.
+func BuildGetAccountMembershipsFunc(grpccli guilds_servicepb.GuildsServiceClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb interface{}, opts ...grpc.CallOption) (interface{}, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.GetAccountMemberships(ctx, reqpb.(*guilds_servicepb.GetAccountMembershipsRequest), opts...)
+		}
+		return grpccli.GetAccountMemberships(ctx, &guilds_servicepb.GetAccountMembershipsRequest{}, opts...)
+	}
+}
+
+// EncodeGetAccountMembershipsRequest encodes requests sent to GuildsService
+// GetAccountMemberships endpoint.
+func EncodeGetAccountMembershipsRequest(ctx context.Context, v interface{}, md *metadata.MD) (interface{}, error) {
+	payload, ok := v.(*guildsservice.GetAccountMembershipsPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetAccountMemberships", "*guildsservice.GetAccountMembershipsPayload", v)
+	}
+	return NewGetAccountMembershipsRequest(payload), nil
+}
+
+// DecodeGetAccountMembershipsResponse decodes responses from the GuildsService
+// GetAccountMemberships endpoint.
+func DecodeGetAccountMembershipsResponse(ctx context.Context, v interface{}, hdr, trlr metadata.MD) (interface{}, error) {
+	message, ok := v.(*guilds_servicepb.GetAccountMembershipsResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetAccountMemberships", "*guilds_servicepb.GetAccountMembershipsResponse", v)
+	}
+	res := NewGetAccountMembershipsResult(message)
+	return res, nil
+}
+
+// BuildGetAccountMonthlyPortfoliosFunc builds the remote method to invoke for
+// "GuildsService" service "GetAccountMonthlyPortfolios" endpoint.
+func BuildGetAccountMonthlyPortfoliosFunc(grpccli guilds_servicepb.GuildsServiceClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb interface{}, opts ...grpc.CallOption) (interface{}, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.GetAccountMonthlyPortfolios(ctx, reqpb.(*guilds_servicepb.GetAccountMonthlyPortfoliosRequest), opts...)
+		}
+		return grpccli.GetAccountMonthlyPortfolios(ctx, &guilds_servicepb.GetAccountMonthlyPortfoliosRequest{}, opts...)
+	}
+}
+
+// EncodeGetAccountMonthlyPortfoliosRequest encodes requests sent to
+// GuildsService GetAccountMonthlyPortfolios endpoint.
+func EncodeGetAccountMonthlyPortfoliosRequest(ctx context.Context, v interface{}, md *metadata.MD) (interface{}, error) {
+	payload, ok := v.(*guildsservice.GetAccountMonthlyPortfoliosPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetAccountMonthlyPortfolios", "*guildsservice.GetAccountMonthlyPortfoliosPayload", v)
+	}
+	return NewGetAccountMonthlyPortfoliosRequest(payload), nil
+}
+
+// DecodeGetAccountMonthlyPortfoliosResponse decodes responses from the
+// GuildsService GetAccountMonthlyPortfolios endpoint.
+func DecodeGetAccountMonthlyPortfoliosResponse(ctx context.Context, v interface{}, hdr, trlr metadata.MD) (interface{}, error) {
+	message, ok := v.(*guilds_servicepb.GetAccountMonthlyPortfoliosResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetAccountMonthlyPortfolios", "*guilds_servicepb.GetAccountMonthlyPortfoliosResponse", v)
+	}
+	if err := ValidateGetAccountMonthlyPortfoliosResponse(message); err != nil {
+		return nil, err
+	}
+	res := NewGetAccountMonthlyPortfoliosResult(message)
+	return res, nil
+}
+
+// BuildGetAccountGrantsFunc builds the remote method to invoke for
+// "GuildsService" service "GetAccountGrants" endpoint.
+func BuildGetAccountGrantsFunc(grpccli guilds_servicepb.GuildsServiceClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb interface{}, opts ...grpc.CallOption) (interface{}, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.GetAccountGrants(ctx, reqpb.(*guilds_servicepb.GetAccountGrantsRequest), opts...)
+		}
+		return grpccli.GetAccountGrants(ctx, &guilds_servicepb.GetAccountGrantsRequest{}, opts...)
+	}
+}
+
+// EncodeGetAccountGrantsRequest encodes requests sent to GuildsService
+// GetAccountGrants endpoint.
+func EncodeGetAccountGrantsRequest(ctx context.Context, v interface{}, md *metadata.MD) (interface{}, error) {
+	payload, ok := v.(*guildsservice.GetAccountGrantsPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetAccountGrants", "*guildsservice.GetAccountGrantsPayload", v)
+	}
+	return NewGetAccountGrantsRequest(payload), nil
+}
+
+// DecodeGetAccountGrantsResponse decodes responses from the GuildsService
+// GetAccountGrants endpoint.
+func DecodeGetAccountGrantsResponse(ctx context.Context, v interface{}, hdr, trlr metadata.MD) (interface{}, error) {
+	message, ok := v.(*guilds_servicepb.GetAccountGrantsResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetAccountGrants", "*guilds_servicepb.GetAccountGrantsResponse", v)
+	}
+	res := NewGetAccountGrantsResult(message)
+	return res, nil
+}
+
+// BuildGetAccountTradesFunc builds the remote method to invoke for
+// "GuildsService" service "GetAccountTrades" endpoint.
+func BuildGetAccountTradesFunc(grpccli guilds_servicepb.GuildsServiceClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb interface{}, opts ...grpc.CallOption) (interface{}, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.GetAccountTrades(ctx, reqpb.(*guilds_servicepb.GetAccountTradesRequest), opts...)
+		}
+		return grpccli.GetAccountTrades(ctx, &guilds_servicepb.GetAccountTradesRequest{}, opts...)
+	}
+}
+
+// EncodeGetAccountTradesRequest encodes requests sent to GuildsService
+// GetAccountTrades endpoint.
+func EncodeGetAccountTradesRequest(ctx context.Context, v interface{}, md *metadata.MD) (interface{}, error) {
+	payload, ok := v.(*guildsservice.GetAccountTradesPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetAccountTrades", "*guildsservice.GetAccountTradesPayload", v)
+	}
+	return NewGetAccountTradesRequest(payload), nil
+}
+
+// DecodeGetAccountTradesResponse decodes responses from the GuildsService
+// GetAccountTrades endpoint.
+func DecodeGetAccountTradesResponse(ctx context.Context, v interface{}, hdr, trlr metadata.MD) (interface{}, error) {
+	message, ok := v.(*guilds_servicepb.GetAccountTradesResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetAccountTrades", "*guilds_servicepb.GetAccountTradesResponse", v)
+	}
+	res := NewGetAccountTradesResult(message)
+	return res, nil
+}
+
+// BuildGetAccountTradeStatsFunc builds the remote method to invoke for
+// "GuildsService" service "GetAccountTradeStats" endpoint.
+func BuildGetAccountTradeStatsFunc(grpccli guilds_servicepb.GuildsServiceClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb interface{}, opts ...grpc.CallOption) (interface{}, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.GetAccountTradeStats(ctx, reqpb.(*guilds_servicepb.GetAccountTradeStatsRequest), opts...)
+		}
+		return grpccli.GetAccountTradeStats(ctx, &guilds_servicepb.GetAccountTradeStatsRequest{}, opts...)
+	}
+}
+
+// EncodeGetAccountTradeStatsRequest encodes requests sent to GuildsService
+// GetAccountTradeStats endpoint.
+func EncodeGetAccountTradeStatsRequest(ctx context.Context, v interface{}, md *metadata.MD) (interface{}, error) {
+	payload, ok := v.(*guildsservice.GetAccountTradeStatsPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetAccountTradeStats", "*guildsservice.GetAccountTradeStatsPayload", v)
+	}
+	return NewGetAccountTradeStatsRequest(payload), nil
+}
+
+// DecodeGetAccountTradeStatsResponse decodes responses from the GuildsService
+// GetAccountTradeStats endpoint.
+func DecodeGetAccountTradeStatsResponse(ctx context.Context, v interface{}, hdr, trlr metadata.MD) (interface{}, error) {
+	message, ok := v.(*guilds_servicepb.GetAccountTradeStatsResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetAccountTradeStats", "*guilds_servicepb.GetAccountTradeStatsResponse", v)
+	}
+	res := NewGetAccountTradeStatsResult(message)
+	return res, nil
+}
+
+// BuildGetGuildTradeStatsFunc builds the remote method to invoke for
+// "GuildsService" service "GetGuildTradeStats" endpoint.
+func BuildGetGuildTradeStatsFunc(grpccli guilds_servicepb.GuildsServiceClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb interface{}, opts ...grpc.CallOption) (interface{}, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.GetGuildTradeStats(ctx, reqpb.(*guilds_servicepb.GetGuildTradeStatsRequest), opts...)
+		}
+		return grpccli.GetGuildTradeStats(ctx, &guilds_servicepb.GetGuildTradeStatsRequest{}, opts...)
+	}
+}
+
+// EncodeGetGuildTradeStatsRequest encodes requests sent to GuildsService
+// GetGuildTradeStats endpoint.
+func EncodeGetGuildTradeStatsRequest(ctx context.Context, v interface{}, md *metadata.MD) (interface{}, error) {
+	payload, ok := v.(*guildsservice.GetGuildTradeStatsPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetGuildTradeStats", "*guildsservice.GetGuildTradeStatsPayload", v)
+	}
+	return NewGetGuildTradeStatsRequest(payload), nil
+}
+
+// DecodeGetGuildTradeStatsResponse decodes responses from the GuildsService
+// GetGuildTradeStats endpoint.
+func DecodeGetGuildTradeStatsResponse(ctx context.Context, v interface{}, hdr, trlr metadata.MD) (interface{}, error) {
+	message, ok := v.(*guilds_servicepb.GetGuildTradeStatsResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetGuildTradeStats", "*guilds_servicepb.GetGuildTradeStatsResponse", v)
+	}
+	res := NewGetGuildTradeStatsResult(message)
+	return res, nil
+}
+
+// BuildGetGuildFeesFunc builds the remote method to invoke for "GuildsService"
+// service "GetGuildFees" endpoint.
+func BuildGetGuildFeesFunc(grpccli guilds_servicepb.GuildsServiceClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb interface{}, opts ...grpc.CallOption) (interface{}, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.GetGuildFees(ctx, reqpb.(*guilds_servicepb.GetGuildFeesRequest), opts...)
+		}
+		return grpccli.GetGuildFees(ctx, &guilds_servicepb.GetGuildFeesRequest{}, opts...)
+	}
+}
+
+// EncodeGetGuildFeesRequest encodes requests sent to GuildsService
+// GetGuildFees endpoint.
+func EncodeGetGuildFeesRequest(ctx context.Context, v interface{}, md *metadata.MD) (interface{}, error) {
+	payload, ok := v.(*guildsservice.GetGuildFeesPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetGuildFees", "*guildsservice.GetGuildFeesPayload", v)
+	}
+	return NewGetGuildFeesRequest(payload), nil
+}
+
+// DecodeGetGuildFeesResponse decodes responses from the GuildsService
+// GetGuildFees endpoint.
+func DecodeGetGuildFeesResponse(ctx context.Context, v interface{}, hdr, trlr metadata.MD) (interface{}, error) {
+	message, ok := v.(*guilds_servicepb.GetGuildFeesResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetGuildFees", "*guilds_servicepb.GetGuildFeesResponse", v)
+	}
+	res := NewGetGuildFeesResult(message)
+	return res, nil
+}
+
+// BuildGetAccountTransfersFunc builds the remote method to invoke for
+// "GuildsService" service "GetAccountTransfers" endpoint.
+func BuildGetAccountTransfersFunc(grpccli guilds_servicepb.GuildsServiceClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb interface{}, opts ...grpc.CallOption) (interface{}, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.GetAccountTransfers(ctx, reqpb.(*guilds_servicepb.GetAccountTransfersRequest), opts...)
+		}
+		return grpccli.GetAccountTransfers(ctx, &guilds_servicepb.GetAccountTransfersRequest{}, opts...)
+	}
+}
+
+// EncodeGetAccountTransfersRequest encodes requests sent to GuildsService
+// GetAccountTransfers endpoint.
+func EncodeGetAccountTransfersRequest(ctx context.Context, v interface{}, md *metadata.MD) (interface{}, error) {
+	payload, ok := v.(*guildsservice.GetAccountTransfersPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetAccountTransfers", "*guildsservice.GetAccountTransfersPayload", v)
+	}
+	return NewGetAccountTransfersRequest(payload), nil
+}
+
+// DecodeGetAccountTransfersResponse decodes responses from the GuildsService
+// GetAccountTransfers endpoint.
+func DecodeGetAccountTransfersResponse(ctx context.Context, v interface{}, hdr, trlr metadata.MD) (interface{}, error) {
+	message, ok := v.(*guilds_servicepb.GetAccountTransfersResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetAccountTransfers", "*guilds_servicepb.GetAccountTransfersResponse", v)
+	}
+	if err := ValidateGetAccountTransfersResponse(message); err != nil {
+		return nil, err
+	}
+	res := NewGetAccountTransfersResult(message)
+	return res, nil
+}
+
+// BuildGetAccountPositionsFunc builds the remote method to invoke for
+// "GuildsService" service "GetAccountPositions" endpoint.
+func BuildGetAccountPositionsFunc(grpccli guilds_servicepb.GuildsServiceClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb interface{}, opts ...grpc.CallOption) (interface{}, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.GetAccountPositions(ctx, reqpb.(*guilds_servicepb.GetAccountPositionsRequest), opts...)
+		}
+		return grpccli.GetAccountPositions(ctx, &guilds_servicepb.GetAccountPositionsRequest{}, opts...)
+	}
+}
+
+// EncodeGetAccountPositionsRequest encodes requests sent to GuildsService
+// GetAccountPositions endpoint.
+func EncodeGetAccountPositionsRequest(ctx context.Context, v interface{}, md *metadata.MD) (interface{}, error) {
+	payload, ok := v.(*guildsservice.GetAccountPositionsPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetAccountPositions", "*guildsservice.GetAccountPositionsPayload", v)
+	}
+	return NewGetAccountPositionsRequest(payload), nil
+}
+
+// DecodeGetAccountPositionsResponse decodes responses from the GuildsService
+// GetAccountPositions endpoint.
+func DecodeGetAccountPositionsResponse(ctx context.Context, v interface{}, hdr, trlr metadata.MD) (interface{}, error) {
+	message, ok := v.(*guilds_servicepb.GetAccountPositionsResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetAccountPositions", "*guilds_servicepb.GetAccountPositionsResponse", v)
+	}
+	if err := ValidateGetAccountPositionsResponse(message); err != nil {
+		return nil, err
+	}
+	res := NewGetAccountPositionsResult(message)
+	return res, nil
+}
+
+// BuildGetGuildRiskFunc builds the remote method to invoke for "GuildsService"
+// service "GetGuildRisk" endpoint.
+func BuildGetGuildRiskFunc(grpccli guilds_servicepb.GuildsServiceClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb interface{}, opts ...grpc.CallOption) (interface{}, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.GetGuildRisk(ctx, reqpb.(*guilds_servicepb.GetGuildRiskRequest), opts...)
+		}
+		return grpccli.GetGuildRisk(ctx, &guilds_servicepb.GetGuildRiskRequest{}, opts...)
+	}
+}
+
+// EncodeGetGuildRiskRequest encodes requests sent to GuildsService
+// GetGuildRisk endpoint.
+func EncodeGetGuildRiskRequest(ctx context.Context, v interface{}, md *metadata.MD) (interface{}, error) {
+	payload, ok := v.(*guildsservice.GetGuildRiskPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetGuildRisk", "*guildsservice.GetGuildRiskPayload", v)
+	}
+	return NewGetGuildRiskRequest(payload), nil
+}
+
+// DecodeGetGuildRiskResponse decodes responses from the GuildsService
+// GetGuildRisk endpoint.
+func DecodeGetGuildRiskResponse(ctx context.Context, v interface{}, hdr, trlr metadata.MD) (interface{}, error) {
+	message, ok := v.(*guilds_servicepb.GetGuildRiskResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetGuildRisk", "*guilds_servicepb.GetGuildRiskResponse", v)
+	}
+	if err := ValidateGetGuildRiskResponse(message); err != nil {
+		return nil, err
+	}
+	res := NewGetGuildRiskResult(message)
+	return res, nil
+}
+
+// BuildStreamPortfolioUpdatesFunc builds the remote method to invoke for
+// "GuildsService" service "StreamPortfolioUpdates" endpoint.
+func BuildStreamPortfolioUpdatesFunc(grpccli guilds_servicepb.GuildsServiceClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb interface{}, opts ...grpc.CallOption) (interface{}, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.StreamPortfolioUpdates(ctx, reqpb.(*guilds_servicepb.StreamPortfolioUpdatesRequest), opts...)
+		}
+		return grpccli.StreamPortfolioUpdates(ctx, &guilds_servicepb.StreamPortfolioUpdatesRequest{}, opts...)
+	}
+}
+
+// EncodeStreamPortfolioUpdatesRequest encodes requests sent to GuildsService
+// StreamPortfolioUpdates endpoint.
+func EncodeStreamPortfolioUpdatesRequest(ctx context.Context, v interface{}, md *metadata.MD) (interface{}, error) {
+	payload, ok := v.(*guildsservice.StreamPortfolioUpdatesPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "StreamPortfolioUpdates", "*guildsservice.StreamPortfolioUpdatesPayload", v)
+	}
+	return NewStreamPortfolioUpdatesRequest(payload), nil
+}
+
+// DecodeStreamPortfolioUpdatesResponse decodes responses from the
+// GuildsService StreamPortfolioUpdates endpoint.
+func DecodeStreamPortfolioUpdatesResponse(ctx context.Context, v interface{}, hdr, trlr metadata.MD) (interface{}, error) {
+	return &StreamPortfolioUpdatesClientStream{
+		stream: v.(guilds_servicepb.GuildsService_StreamPortfolioUpdatesClient),
+	}, nil
+}
@@ -0,0 +1,3879 @@
+// Code generated by goa v3.6.2, DO NOT EDIT.
+//
+// GuildsService gRPC client types
+//
+// Command:
+// $ goa gen github.com/InjectiveLabs/injective-guilds-service/api/design -o ../
+
+package client
+
+import (
+	guilds_servicepb "github.com/InjectiveLabs/injective-guilds-service/api/gen/grpc/guilds_service/pb"
+	guildsservice "github.com/InjectiveLabs/injective-guilds-service/api/gen/guilds_service"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// NewGetAllGuildsRequest builds the gRPC request type from the payload of the
+// "GetAllGuilds" endpoint of the "GuildsService" service.
+func NewGetAllGuildsRequest(payload *guildsservice.GetAllGuildsPayload) *guilds_servicepb.GetAllGuildsRequest {
+	message := &guilds_servicepb.GetAllGuildsRequest{}
+	if payload.IncludeArchived != nil {
+		message.IncludeArchived = *payload.IncludeArchived
+	}
+	return message
+}
+
+// NewGetAllGuildsResult builds the result type of the "GetAllGuilds" endpoint
+// of the "GuildsService" service from the gRPC response type.
+func NewGetAllGuildsResult(message *guilds_servicepb.GetAllGuildsResponse) *guildsservice.GetAllGuildsResult {
+	result := &guildsservice.GetAllGuildsResult{}
+	if message.Guilds != nil {
+		result.Guilds = make([]*guildsservice.Guild, len(message.Guilds))
+		for i, val := range message.Guilds {
+			result.Guilds[i] = &guildsservice.Guild{
+				ID:                   val.Id,
+				Name:                 val.Name,
+				Description:          val.Description,
+				MasterAddress:        val.MasterAddress,
+				StakingRequirement:   val.StakingRequirement,
+				Capacity:             int(val.Capacity),
+				MemberCount:          int(val.MemberCount),
+				DefaultMemberAddress: val.DefaultMemberAddress,
+				HasInactiveMarkets:   &val.HasInactiveMarkets,
+				Archived:             &val.Archived,
+			}
+			if val.AccessMode != "" {
+				result.Guilds[i].AccessMode = &val.AccessMode
+			}
+			if val.Requirements != nil {
+				result.Guilds[i].Requirements = make([]*guildsservice.Requirement, len(val.Requirements))
+				for j, val := range val.Requirements {
+					result.Guilds[i].Requirements[j] = &guildsservice.Requirement{
+						Denom:        val.Denom,
+						MinAmountUsd: val.MinAmountUsd,
+						MinAmount:    val.MinAmount,
+					}
+				}
+			}
+			if val.CurrentPortfolio != nil {
+				result.Guilds[i].CurrentPortfolio = protobufGuildsServicepbSingleGuildPortfolioToGuildsserviceSingleGuildPortfolio(val.CurrentPortfolio)
+			}
+		}
+	}
+	return result
+}
+
+// NewGetAllGuildsNotFoundError builds the error type of the "GetAllGuilds"
+// endpoint of the "GuildsService" service from the gRPC error response type.
+func NewGetAllGuildsNotFoundError(message *guilds_servicepb.GetAllGuildsNotFoundError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetAllGuildsInvalidArgError builds the error type of the "GetAllGuilds"
+// endpoint of the "GuildsService" service from the gRPC error response type.
+func NewGetAllGuildsInvalidArgError(message *guilds_servicepb.GetAllGuildsInvalidArgError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetAllGuildsInternalError builds the error type of the "GetAllGuilds"
+// endpoint of the "GuildsService" service from the gRPC error response type.
+func NewGetAllGuildsInternalError(message *guilds_servicepb.GetAllGuildsInternalError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetSingleGuildRequest builds the gRPC request type from the payload of
+// the "GetSingleGuild" endpoint of the "GuildsService" service.
+func NewGetSingleGuildRequest(payload *guildsservice.GetSingleGuildPayload) *guilds_servicepb.GetSingleGuildRequest {
+	message := &guilds_servicepb.GetSingleGuildRequest{
+		GuildId: payload.GuildID,
+	}
+	return message
+}
+
+// NewGetSingleGuildResult builds the result type of the "GetSingleGuild"
+// endpoint of the "GuildsService" service from the gRPC response type.
+func NewGetSingleGuildResult(message *guilds_servicepb.GetSingleGuildResponse) *guildsservice.GetSingleGuildResult {
+	result := &guildsservice.GetSingleGuildResult{}
+	if message.Guild != nil {
+		result.Guild = protobufGuildsServicepbGuildToGuildsserviceGuild(message.Guild)
+	}
+	return result
+}
+
+// NewGetSingleGuildNotFoundError builds the error type of the "GetSingleGuild"
+// endpoint of the "GuildsService" service from the gRPC error response type.
+func NewGetSingleGuildNotFoundError(message *guilds_servicepb.GetSingleGuildNotFoundError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetSingleGuildInvalidArgError builds the error type of the
+// "GetSingleGuild" endpoint of the "GuildsService" service from the gRPC error
+// response type.
+func NewGetSingleGuildInvalidArgError(message *guilds_servicepb.GetSingleGuildInvalidArgError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetSingleGuildInternalError builds the error type of the "GetSingleGuild"
+// endpoint of the "GuildsService" service from the gRPC error response type.
+func NewGetSingleGuildInternalError(message *guilds_servicepb.GetSingleGuildInternalError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetGuildMembersRequest builds the gRPC request type from the payload of
+// the "GetGuildMembers" endpoint of the "GuildsService" service.
+func NewGetGuildMembersRequest(payload *guildsservice.GetGuildMembersPayload) *guilds_servicepb.GetGuildMembersRequest {
+	message := &guilds_servicepb.GetGuildMembersRequest{
+		GuildId: payload.GuildID,
+	}
+	return message
+}
+
+// NewGetGuildMembersResult builds the result type of the "GetGuildMembers"
+// endpoint of the "GuildsService" service from the gRPC response type.
+func NewGetGuildMembersResult(message *guilds_servicepb.GetGuildMembersResponse) *guildsservice.GetGuildMembersResult {
+	result := &guildsservice.GetGuildMembersResult{}
+	if message.Members != nil {
+		result.Members = make([]*guildsservice.GuildMember, len(message.Members))
+		for i, val := range message.Members {
+			result.Members[i] = &guildsservice.GuildMember{
+				InjectiveAddress:     val.InjectiveAddress,
+				IsDefaultGuildMember: val.IsDefaultGuildMember,
+				Since:                val.Since,
+				Params:               val.Params,
+			}
+			if val.GuildId != "" {
+				result.Members[i].GuildID = &val.GuildId
+			}
+			if val.SubaccountIds != nil {
+				result.Members[i].SubaccountIds = make([]string, len(val.SubaccountIds))
+				for j, val := range val.SubaccountIds {
+					result.Members[i].SubaccountIds[j] = val
+				}
+			}
+		}
+	}
+	return result
+}
+
+// NewGetGuildMembersNotFoundError builds the error type of the
+// "GetGuildMembers" endpoint of the "GuildsService" service from the gRPC
+// error response type.
+func NewGetGuildMembersNotFoundError(message *guilds_servicepb.GetGuildMembersNotFoundError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetGuildMembersInvalidArgError builds the error type of the
+// "GetGuildMembers" endpoint of the "GuildsService" service from the gRPC
+// error response type.
+func NewGetGuildMembersInvalidArgError(message *guilds_servicepb.GetGuildMembersInvalidArgError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetGuildMembersInternalError builds the error type of the
+// "GetGuildMembers" endpoint of the "GuildsService" service from the gRPC
+// error response type.
+func NewGetGuildMembersInternalError(message *guilds_servicepb.GetGuildMembersInternalError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetGuildMasterAddressRequest builds the gRPC request type from the
+// payload of the "GetGuildMasterAddress" endpoint of the "GuildsService"
+// service.
+func NewGetGuildMasterAddressRequest(payload *guildsservice.GetGuildMasterAddressPayload) *guilds_servicepb.GetGuildMasterAddressRequest {
+	message := &guilds_servicepb.GetGuildMasterAddressRequest{
+		GuildId: payload.GuildID,
+	}
+	return message
+}
+
+// NewGetGuildMasterAddressResult builds the result type of the
+// "GetGuildMasterAddress" endpoint of the "GuildsService" service from the
+// gRPC response type.
+func NewGetGuildMasterAddressResult(message *guilds_servicepb.GetGuildMasterAddressResponse) *guildsservice.GetGuildMasterAddressResult {
+	result := &guildsservice.GetGuildMasterAddressResult{}
+	if message.MasterAddress != "" {
+		result.MasterAddress = &message.MasterAddress
+	}
+	return result
+}
+
+// NewGetGuildMasterAddressNotFoundError builds the error type of the
+// "GetGuildMasterAddress" endpoint of the "GuildsService" service from the
+// gRPC error response type.
+func NewGetGuildMasterAddressNotFoundError(message *guilds_servicepb.GetGuildMasterAddressNotFoundError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetGuildMasterAddressInvalidArgError builds the error type of the
+// "GetGuildMasterAddress" endpoint of the "GuildsService" service from the
+// gRPC error response type.
+func NewGetGuildMasterAddressInvalidArgError(message *guilds_servicepb.GetGuildMasterAddressInvalidArgError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetGuildMasterAddressInternalError builds the error type of the
+// "GetGuildMasterAddress" endpoint of the "GuildsService" service from the
+// gRPC error response type.
+func NewGetGuildMasterAddressInternalError(message *guilds_servicepb.GetGuildMasterAddressInternalError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetGuildDefaultMemberRequest builds the gRPC request type from the
+// payload of the "GetGuildDefaultMember" endpoint of the "GuildsService"
+// service.
+func NewGetGuildDefaultMemberRequest(payload *guildsservice.GetGuildDefaultMemberPayload) *guilds_servicepb.GetGuildDefaultMemberRequest {
+	message := &guilds_servicepb.GetGuildDefaultMemberRequest{
+		GuildId: payload.GuildID,
+	}
+	return message
+}
+
+// NewGetGuildDefaultMemberResult builds the result type of the
+// "GetGuildDefaultMember" endpoint of the "GuildsService" service from the
+// gRPC response type.
+func NewGetGuildDefaultMemberResult(message *guilds_servicepb.GetGuildDefaultMemberResponse) *guildsservice.GetGuildDefaultMemberResult {
+	result := &guildsservice.GetGuildDefaultMemberResult{}
+	if message.DefaultMember != nil {
+		result.DefaultMember = protobufGuildsServicepbGuildMemberToGuildsserviceGuildMember(message.DefaultMember)
+	}
+	return result
+}
+
+// NewGetGuildDefaultMemberNotFoundError builds the error type of the
+// "GetGuildDefaultMember" endpoint of the "GuildsService" service from the
+// gRPC error response type.
+func NewGetGuildDefaultMemberNotFoundError(message *guilds_servicepb.GetGuildDefaultMemberNotFoundError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetGuildDefaultMemberInvalidArgError builds the error type of the
+// "GetGuildDefaultMember" endpoint of the "GuildsService" service from the
+// gRPC error response type.
+func NewGetGuildDefaultMemberInvalidArgError(message *guilds_servicepb.GetGuildDefaultMemberInvalidArgError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetGuildDefaultMemberInternalError builds the error type of the
+// "GetGuildDefaultMember" endpoint of the "GuildsService" service from the
+// gRPC error response type.
+func NewGetGuildDefaultMemberInternalError(message *guilds_servicepb.GetGuildDefaultMemberInternalError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewEnterGuildRequest builds the gRPC request type from the payload of the
+// "EnterGuild" endpoint of the "GuildsService" service.
+func NewEnterGuildRequest(payload *guildsservice.EnterGuildPayload) *guilds_servicepb.EnterGuildRequest {
+	message := &guilds_servicepb.EnterGuildRequest{
+		GuildId:          payload.GuildID,
+		InjectiveAddress: payload.InjectiveAddress,
+	}
+	if payload.Params != nil {
+		message.Params = *payload.Params
+	}
+	if payload.SubaccountIds != nil {
+		message.SubaccountIds = make([]string, len(payload.SubaccountIds))
+		for i, val := range payload.SubaccountIds {
+			message.SubaccountIds[i] = val
+		}
+	}
+	return message
+}
+
+// NewEnterGuildResult builds the result type of the "EnterGuild" endpoint of
+// the "GuildsService" service from the gRPC response type.
+func NewEnterGuildResult(message *guilds_servicepb.EnterGuildResponse) *guildsservice.EnterGuildResult {
+	result := &guildsservice.EnterGuildResult{}
+	if message.JoinStatus != "" {
+		result.JoinStatus = &message.JoinStatus
+	}
+	if message.Message_ != "" {
+		result.Message = &message.Message_
+	}
+	if message.WaitlistPosition != 0 {
+		waitlistPositionptr := int(message.WaitlistPosition)
+		result.WaitlistPosition = &waitlistPositionptr
+	}
+	return result
+}
+
+// NewEnterGuildNotFoundError builds the error type of the "EnterGuild"
+// endpoint of the "GuildsService" service from the gRPC error response type.
+func NewEnterGuildNotFoundError(message *guilds_servicepb.EnterGuildNotFoundError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewEnterGuildInvalidArgError builds the error type of the "EnterGuild"
+// endpoint of the "GuildsService" service from the gRPC error response type.
+func NewEnterGuildInvalidArgError(message *guilds_servicepb.EnterGuildInvalidArgError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewEnterGuildInternalError builds the error type of the "EnterGuild"
+// endpoint of the "GuildsService" service from the gRPC error response type.
+func NewEnterGuildInternalError(message *guilds_servicepb.EnterGuildInternalError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetGuildWaitlistRequest builds the gRPC request type from the payload of
+// the "GetGuildWaitlist" endpoint of the "GuildsService" service.
+func NewGetGuildWaitlistRequest(payload *guildsservice.GetGuildWaitlistPayload) *guilds_servicepb.GetGuildWaitlistRequest {
+	message := &guilds_servicepb.GetGuildWaitlistRequest{
+		GuildId: payload.GuildID,
+	}
+	if payload.InjectiveAddress != nil {
+		message.InjectiveAddress = *payload.InjectiveAddress
+	}
+	return message
+}
+
+// NewGetGuildWaitlistResult builds the result type of the "GetGuildWaitlist"
+// endpoint of the "GuildsService" service from the gRPC response type.
+func NewGetGuildWaitlistResult(message *guilds_servicepb.GetGuildWaitlistResponse) *guildsservice.GetGuildWaitlistResult {
+	result := &guildsservice.GetGuildWaitlistResult{}
+	if message.Waitlist != nil {
+		result.Waitlist = make([]*guildsservice.WaitlistEntry, len(message.Waitlist))
+		for i, val := range message.Waitlist {
+			result.Waitlist[i] = &guildsservice.WaitlistEntry{
+				InjectiveAddress: val.InjectiveAddress,
+				Position:         int(val.Position),
+				EnqueuedAt:       val.EnqueuedAt,
+			}
+		}
+	}
+	return result
+}
+
+// NewGetGuildWaitlistNotFoundError builds the error type of the
+// "GetGuildWaitlist" endpoint of the "GuildsService" service from the gRPC
+// error response type.
+func NewGetGuildWaitlistNotFoundError(message *guilds_servicepb.GetGuildWaitlistNotFoundError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetGuildWaitlistInvalidArgError builds the error type of the
+// "GetGuildWaitlist" endpoint of the "GuildsService" service from the gRPC
+// error response type.
+func NewGetGuildWaitlistInvalidArgError(message *guilds_servicepb.GetGuildWaitlistInvalidArgError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetGuildWaitlistInternalError builds the error type of the
+// "GetGuildWaitlist" endpoint of the "GuildsService" service from the gRPC
+// error response type.
+func NewGetGuildWaitlistInternalError(message *guilds_servicepb.GetGuildWaitlistInternalError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewLeaveGuildRequest builds the gRPC request type from the payload of the
+// "LeaveGuild" endpoint of the "GuildsService" service.
+func NewLeaveGuildRequest(payload *guildsservice.LeaveGuildPayload) *guilds_servicepb.LeaveGuildRequest {
+	message := &guilds_servicepb.LeaveGuildRequest{
+		GuildId:          payload.GuildID,
+		InjectiveAddress: payload.InjectiveAddress,
+	}
+	return message
+}
+
+// NewLeaveGuildResult builds the result type of the "LeaveGuild" endpoint of
+// the "GuildsService" service from the gRPC response type.
+func NewLeaveGuildResult(message *guilds_servicepb.LeaveGuildResponse) *guildsservice.LeaveGuildResult {
+	result := &guildsservice.LeaveGuildResult{}
+	if message.LeaveStatus != "" {
+		result.LeaveStatus = &message.LeaveStatus
+	}
+	return result
+}
+
+// NewLeaveGuildNotFoundError builds the error type of the "LeaveGuild"
+// endpoint of the "GuildsService" service from the gRPC error response type.
+func NewLeaveGuildNotFoundError(message *guilds_servicepb.LeaveGuildNotFoundError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewLeaveGuildInvalidArgError builds the error type of the "LeaveGuild"
+// endpoint of the "GuildsService" service from the gRPC error response type.
+func NewLeaveGuildInvalidArgError(message *guilds_servicepb.LeaveGuildInvalidArgError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewLeaveGuildInternalError builds the error type of the "LeaveGuild"
+// endpoint of the "GuildsService" service from the gRPC error response type.
+func NewLeaveGuildInternalError(message *guilds_servicepb.LeaveGuildInternalError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewTransferMemberRequest builds the gRPC request type from the payload of
+// the "TransferMember" endpoint of the "GuildsService" service.
+func NewTransferMemberRequest(payload *guildsservice.TransferMemberPayload) *guilds_servicepb.TransferMemberRequest {
+	message := &guilds_servicepb.TransferMemberRequest{
+		InjectiveAddress: payload.InjectiveAddress,
+		GuildId:          payload.GuildID,
+	}
+	if payload.Params != nil {
+		message.Params = *payload.Params
+	}
+	if payload.SubaccountIds != nil {
+		message.SubaccountIds = make([]string, len(payload.SubaccountIds))
+		for i, val := range payload.SubaccountIds {
+			message.SubaccountIds[i] = val
+		}
+	}
+	return message
+}
+
+// NewTransferMemberResult builds the result type of the "TransferMember"
+// endpoint of the "GuildsService" service from the gRPC response type.
+func NewTransferMemberResult(message *guilds_servicepb.TransferMemberResponse) *guildsservice.TransferMemberResult {
+	result := &guildsservice.TransferMemberResult{}
+	if message.TransferStatus != "" {
+		result.TransferStatus = &message.TransferStatus
+	}
+	if message.FromGuildId != "" {
+		result.FromGuildID = &message.FromGuildId
+	}
+	return result
+}
+
+// NewTransferMemberNotFoundError builds the error type of the "TransferMember"
+// endpoint of the "GuildsService" service from the gRPC error response type.
+func NewTransferMemberNotFoundError(message *guilds_servicepb.TransferMemberNotFoundError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewTransferMemberInvalidArgError builds the error type of the
+// "TransferMember" endpoint of the "GuildsService" service from the gRPC error
+// response type.
+func NewTransferMemberInvalidArgError(message *guilds_servicepb.TransferMemberInvalidArgError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewTransferMemberInternalError builds the error type of the "TransferMember"
+// endpoint of the "GuildsService" service from the gRPC error response type.
+func NewTransferMemberInternalError(message *guilds_servicepb.TransferMemberInternalError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetGuildMarketsRequest builds the gRPC request type from the payload of
+// the "GetGuildMarkets" endpoint of the "GuildsService" service.
+func NewGetGuildMarketsRequest(payload *guildsservice.GetGuildMarketsPayload) *guilds_servicepb.GetGuildMarketsRequest {
+	message := &guilds_servicepb.GetGuildMarketsRequest{
+		GuildId: payload.GuildID,
+	}
+	return message
+}
+
+// NewGetGuildMarketsResult builds the result type of the "GetGuildMarkets"
+// endpoint of the "GuildsService" service from the gRPC response type.
+func NewGetGuildMarketsResult(message *guilds_servicepb.GetGuildMarketsResponse) *guildsservice.GetGuildMarketsResult {
+	result := &guildsservice.GetGuildMarketsResult{}
+	if message.Markets != nil {
+		result.Markets = make([]*guildsservice.Market, len(message.Markets))
+		for i, val := range message.Markets {
+			result.Markets[i] = &guildsservice.Market{
+				MarketID:    val.MarketId,
+				IsPerpetual: val.IsPerpetual,
+			}
+			if val.Status != "" {
+				result.Markets[i].Status = &val.Status
+			}
+			if val.MakerFeeRate != "" {
+				result.Markets[i].MakerFeeRate = &val.MakerFeeRate
+			}
+			if val.TakerFeeRate != "" {
+				result.Markets[i].TakerFeeRate = &val.TakerFeeRate
+			}
+		}
+	}
+	return result
+}
+
+// NewGetGuildMarketsNotFoundError builds the error type of the
+// "GetGuildMarkets" endpoint of the "GuildsService" service from the gRPC
+// error response type.
+func NewGetGuildMarketsNotFoundError(message *guilds_servicepb.GetGuildMarketsNotFoundError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetGuildMarketsInvalidArgError builds the error type of the
+// "GetGuildMarkets" endpoint of the "GuildsService" service from the gRPC
+// error response type.
+func NewGetGuildMarketsInvalidArgError(message *guilds_servicepb.GetGuildMarketsInvalidArgError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetGuildMarketsInternalError builds the error type of the
+// "GetGuildMarkets" endpoint of the "GuildsService" service from the gRPC
+// error response type.
+func NewGetGuildMarketsInternalError(message *guilds_servicepb.GetGuildMarketsInternalError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetGuildPortfoliosRequest builds the gRPC request type from the payload
+// of the "GetGuildPortfolios" endpoint of the "GuildsService" service.
+func NewGetGuildPortfoliosRequest(payload *guildsservice.GetGuildPortfoliosPayload) *guilds_servicepb.GetGuildPortfoliosRequest {
+	message := &guilds_servicepb.GetGuildPortfoliosRequest{
+		GuildId: payload.GuildID,
+	}
+	if payload.StartTime != nil {
+		message.StartTime = *payload.StartTime
+	}
+	if payload.EndTime != nil {
+		message.EndTime = *payload.EndTime
+	}
+	return message
+}
+
+// NewGetGuildPortfoliosResult builds the result type of the
+// "GetGuildPortfolios" endpoint of the "GuildsService" service from the gRPC
+// response type.
+func NewGetGuildPortfoliosResult(message *guilds_servicepb.GetGuildPortfoliosResponse) *guildsservice.GetGuildPortfoliosResult {
+	result := &guildsservice.GetGuildPortfoliosResult{}
+	if message.Portfolios != nil {
+		result.Portfolios = make([]*guildsservice.SingleGuildPortfolio, len(message.Portfolios))
+		for i, val := range message.Portfolios {
+			result.Portfolios[i] = &guildsservice.SingleGuildPortfolio{
+				UpdatedAt: val.UpdatedAt,
+			}
+			if val.GuildId != "" {
+				result.Portfolios[i].GuildID = &val.GuildId
+			}
+			if val.ValueUsd != 0 {
+				result.Portfolios[i].ValueUsd = &val.ValueUsd
+			}
+			if val.NetFlowUsd != 0 {
+				result.Portfolios[i].NetFlowUsd = &val.NetFlowUsd
+			}
+			if val.Twr != 0 {
+				result.Portfolios[i].Twr = &val.Twr
+			}
+			if val.Mwr != 0 {
+				result.Portfolios[i].Mwr = &val.Mwr
+			}
+			if val.Balances != nil {
+				result.Portfolios[i].Balances = make([]*guildsservice.Balance, len(val.Balances))
+				for j, val := range val.Balances {
+					result.Portfolios[i].Balances[j] = &guildsservice.Balance{
+						Denom:            val.Denom,
+						TotalBalance:     val.TotalBalance,
+						AvailableBalance: val.AvailableBalance,
+						UnrealizedPnl:    val.UnrealizedPnl,
+						MarginHold:       val.MarginHold,
+						PriceUsd:         val.PriceUsd,
+					}
+				}
+			}
+			if val.CashFlows != nil {
+				result.Portfolios[i].CashFlows = make([]*guildsservice.CashFlow, len(val.CashFlows))
+				for j, val := range val.CashFlows {
+					result.Portfolios[i].CashFlows[j] = &guildsservice.CashFlow{
+						Type:       val.Type,
+						Denom:      val.Denom,
+						Amount:     val.Amount,
+						ExecutedAt: val.ExecutedAt,
+					}
+					if val.InjectiveAddress != "" {
+						result.Portfolios[i].CashFlows[j].InjectiveAddress = &val.InjectiveAddress
+					}
+				}
+			}
+		}
+	}
+	if message.NetFlows != nil {
+		result.NetFlows = make([]*guildsservice.NetFlow, len(message.NetFlows))
+		for i, val := range message.NetFlows {
+			result.NetFlows[i] = &guildsservice.NetFlow{
+				Denom:         val.Denom,
+				Deposits:      val.Deposits,
+				Withdrawals:   val.Withdrawals,
+				NetFlow:       val.NetFlow,
+				TransferCount: int(val.TransferCount),
+			}
+		}
+	}
+	return result
+}
+
+// NewGetGuildPortfoliosNotFoundError builds the error type of the
+// "GetGuildPortfolios" endpoint of the "GuildsService" service from the gRPC
+// error response type.
+func NewGetGuildPortfoliosNotFoundError(message *guilds_servicepb.GetGuildPortfoliosNotFoundError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetGuildPortfoliosInvalidArgError builds the error type of the
+// "GetGuildPortfolios" endpoint of the "GuildsService" service from the gRPC
+// error response type.
+func NewGetGuildPortfoliosInvalidArgError(message *guilds_servicepb.GetGuildPortfoliosInvalidArgError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetGuildPortfoliosInternalError builds the error type of the
+// "GetGuildPortfolios" endpoint of the "GuildsService" service from the gRPC
+// error response type.
+func NewGetGuildPortfoliosInternalError(message *guilds_servicepb.GetGuildPortfoliosInternalError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetAccountInfoRequest builds the gRPC request type from the payload of
+// the "GetAccountInfo" endpoint of the "GuildsService" service.
+func NewGetAccountInfoRequest(payload *guildsservice.GetAccountInfoPayload) *guilds_servicepb.GetAccountInfoRequest {
+	message := &guilds_servicepb.GetAccountInfoRequest{
+		InjectiveAddress: payload.InjectiveAddress,
+	}
+	return message
+}
+
+// NewGetAccountInfoResult builds the result type of the "GetAccountInfo"
+// endpoint of the "GuildsService" service from the gRPC response type.
+func NewGetAccountInfoResult(message *guilds_servicepb.GetAccountInfoResponse) *guildsservice.GetAccountInfoResult {
+	result := &guildsservice.GetAccountInfoResult{}
+	if message.Data != nil {
+		result.Data = protobufGuildsServicepbGuildMemberToGuildsserviceGuildMember(message.Data)
+	}
+	return result
+}
+
+// NewGetAccountInfoNotFoundError builds the error type of the "GetAccountInfo"
+// endpoint of the "GuildsService" service from the gRPC error response type.
+func NewGetAccountInfoNotFoundError(message *guilds_servicepb.GetAccountInfoNotFoundError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetAccountInfoInvalidArgError builds the error type of the
+// "GetAccountInfo" endpoint of the "GuildsService" service from the gRPC error
+// response type.
+func NewGetAccountInfoInvalidArgError(message *guilds_servicepb.GetAccountInfoInvalidArgError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetAccountInfoInternalError builds the error type of the "GetAccountInfo"
+// endpoint of the "GuildsService" service from the gRPC error response type.
+func NewGetAccountInfoInternalError(message *guilds_servicepb.GetAccountInfoInternalError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetAccountPortfolioRequest builds the gRPC request type from the payload
+// of the "GetAccountPortfolio" endpoint of the "GuildsService" service.
+func NewGetAccountPortfolioRequest(payload *guildsservice.GetAccountPortfolioPayload) *guilds_servicepb.GetAccountPortfolioRequest {
+	message := &guilds_servicepb.GetAccountPortfolioRequest{
+		InjectiveAddress: payload.InjectiveAddress,
+	}
+	return message
+}
+
+// NewGetAccountPortfolioResult builds the result type of the
+// "GetAccountPortfolio" endpoint of the "GuildsService" service from the gRPC
+// response type.
+func NewGetAccountPortfolioResult(message *guilds_servicepb.GetAccountPortfolioResponse) *guildsservice.GetAccountPortfolioResult {
+	result := &guildsservice.GetAccountPortfolioResult{}
+	if message.Data != nil {
+		result.Data = protobufGuildsServicepbSingleAccountPortfolioToGuildsserviceSingleAccountPortfolio(message.Data)
+	}
+	return result
+}
+
+// NewGetAccountPortfolioNotFoundError builds the error type of the
+// "GetAccountPortfolio" endpoint of the "GuildsService" service from the gRPC
+// error response type.
+func NewGetAccountPortfolioNotFoundError(message *guilds_servicepb.GetAccountPortfolioNotFoundError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetAccountPortfolioInvalidArgError builds the error type of the
+// "GetAccountPortfolio" endpoint of the "GuildsService" service from the gRPC
+// error response type.
+func NewGetAccountPortfolioInvalidArgError(message *guilds_servicepb.GetAccountPortfolioInvalidArgError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetAccountPortfolioInternalError builds the error type of the
+// "GetAccountPortfolio" endpoint of the "GuildsService" service from the gRPC
+// error response type.
+func NewGetAccountPortfolioInternalError(message *guilds_servicepb.GetAccountPortfolioInternalError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetAccountPortfoliosRequest builds the gRPC request type from the payload
+// of the "GetAccountPortfolios" endpoint of the "GuildsService" service.
+func NewGetAccountPortfoliosRequest(payload *guildsservice.GetAccountPortfoliosPayload) *guilds_servicepb.GetAccountPortfoliosRequest {
+	message := &guilds_servicepb.GetAccountPortfoliosRequest{
+		InjectiveAddress: payload.InjectiveAddress,
+	}
+	if payload.StartTime != nil {
+		message.StartTime = *payload.StartTime
+	}
+	if payload.EndTime != nil {
+		message.EndTime = *payload.EndTime
+	}
+	if payload.GuildID != nil {
+		message.GuildId = *payload.GuildID
+	}
+	return message
+}
+
+// NewGetAccountPortfoliosResult builds the result type of the
+// "GetAccountPortfolios" endpoint of the "GuildsService" service from the gRPC
+// response type.
+func NewGetAccountPortfoliosResult(message *guilds_servicepb.GetAccountPortfoliosResponse) *guildsservice.GetAccountPortfoliosResult {
+	result := &guildsservice.GetAccountPortfoliosResult{}
+	if message.Portfolios != nil {
+		result.Portfolios = make([]*guildsservice.SingleAccountPortfolio, len(message.Portfolios))
+		for i, val := range message.Portfolios {
+			result.Portfolios[i] = &guildsservice.SingleAccountPortfolio{
+				InjectiveAddress: val.InjectiveAddress,
+				UpdatedAt:        val.UpdatedAt,
+			}
+			if val.ValueUsd != 0 {
+				result.Portfolios[i].ValueUsd = &val.ValueUsd
+			}
+			if val.NetFlowUsd != 0 {
+				result.Portfolios[i].NetFlowUsd = &val.NetFlowUsd
+			}
+			if val.Twr != 0 {
+				result.Portfolios[i].Twr = &val.Twr
+			}
+			if val.Mwr != 0 {
+				result.Portfolios[i].Mwr = &val.Mwr
+			}
+			if val.GuildId != "" {
+				result.Portfolios[i].GuildID = &val.GuildId
+			}
+			if val.Balances != nil {
+				result.Portfolios[i].Balances = make([]*guildsservice.Balance, len(val.Balances))
+				for j, val := range val.Balances {
+					result.Portfolios[i].Balances[j] = &guildsservice.Balance{
+						Denom:            val.Denom,
+						TotalBalance:     val.TotalBalance,
+						AvailableBalance: val.AvailableBalance,
+						UnrealizedPnl:    val.UnrealizedPnl,
+						MarginHold:       val.MarginHold,
+						PriceUsd:         val.PriceUsd,
+					}
+				}
+			}
+			if val.CashFlows != nil {
+				result.Portfolios[i].CashFlows = make([]*guildsservice.CashFlow, len(val.CashFlows))
+				for j, val := range val.CashFlows {
+					result.Portfolios[i].CashFlows[j] = &guildsservice.CashFlow{
+						Type:       val.Type,
+						Denom:      val.Denom,
+						Amount:     val.Amount,
+						ExecutedAt: val.ExecutedAt,
+					}
+					if val.InjectiveAddress != "" {
+						result.Portfolios[i].CashFlows[j].InjectiveAddress = &val.InjectiveAddress
+					}
+				}
+			}
+		}
+	}
+	if message.NetFlows != nil {
+		result.NetFlows = make([]*guildsservice.NetFlow, len(message.NetFlows))
+		for i, val := range message.NetFlows {
+			result.NetFlows[i] = &guildsservice.NetFlow{
+				Denom:         val.Denom,
+				Deposits:      val.Deposits,
+				Withdrawals:   val.Withdrawals,
+				NetFlow:       val.NetFlow,
+				TransferCount: int(val.TransferCount),
+			}
+		}
+	}
+	return result
+}
+
+// NewGetAccountPortfoliosNotFoundError builds the error type of the
+// "GetAccountPortfolios" endpoint of the "GuildsService" service from the gRPC
+// error response type.
+func NewGetAccountPortfoliosNotFoundError(message *guilds_servicepb.GetAccountPortfoliosNotFoundError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetAccountPortfoliosInvalidArgError builds the error type of the
+// "GetAccountPortfolios" endpoint of the "GuildsService" service from the gRPC
+// error response type.
+func NewGetAccountPortfoliosInvalidArgError(message *guilds_servicepb.GetAccountPortfoliosInvalidArgError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetAccountPortfoliosInternalError builds the error type of the
+// "GetAccountPortfolios" endpoint of the "GuildsService" service from the gRPC
+// error response type.
+func NewGetAccountPortfoliosInternalError(message *guilds_servicepb.GetAccountPortfoliosInternalError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetAccountMembershipsRequest builds the gRPC request type from the
+// payload of the "GetAccountMemberships" endpoint of the "GuildsService"
+// service.
+func NewGetAccountMembershipsRequest(payload *guildsservice.GetAccountMembershipsPayload) *guilds_servicepb.GetAccountMembershipsRequest {
+	message := &guilds_servicepb.GetAccountMembershipsRequest{
+		InjectiveAddress: payload.InjectiveAddress,
+	}
+	return message
+}
+
+// NewGetAccountMembershipsResult builds the result type of the
+// "GetAccountMemberships" endpoint of the "GuildsService" service from the
+// gRPC response type.
+func NewGetAccountMembershipsResult(message *guilds_servicepb.GetAccountMembershipsResponse) *guildsservice.GetAccountMembershipsResult {
+	result := &guildsservice.GetAccountMembershipsResult{}
+	if message.Memberships != nil {
+		result.Memberships = make([]*guildsservice.Membership, len(message.Memberships))
+		for i, val := range message.Memberships {
+			result.Memberships[i] = &guildsservice.Membership{
+				GuildID:              val.GuildId,
+				IsDefaultGuildMember: val.IsDefaultGuildMember,
+				Since:                val.Since,
+			}
+			if val.EndedAt != 0 {
+				result.Memberships[i].EndedAt = &val.EndedAt
+			}
+		}
+	}
+	return result
+}
+
+// NewGetAccountMembershipsNotFoundError builds the error type of the
+// "GetAccountMemberships" endpoint of the "GuildsService" service from the
+// gRPC error response type.
+func NewGetAccountMembershipsNotFoundError(message *guilds_servicepb.GetAccountMembershipsNotFoundError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetAccountMembershipsInvalidArgError builds the error type of the
+// "GetAccountMemberships" endpoint of the "GuildsService" service from the
+// gRPC error response type.
+func NewGetAccountMembershipsInvalidArgError(message *guilds_servicepb.GetAccountMembershipsInvalidArgError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetAccountMembershipsInternalError builds the error type of the
+// "GetAccountMemberships" endpoint of the "GuildsService" service from the
+// gRPC error response type.
+func NewGetAccountMembershipsInternalError(message *guilds_servicepb.GetAccountMembershipsInternalError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetAccountMonthlyPortfoliosRequest builds the gRPC request type from the
+// payload of the "GetAccountMonthlyPortfolios" endpoint of the "GuildsService"
+// service.
+func NewGetAccountMonthlyPortfoliosRequest(payload *guildsservice.GetAccountMonthlyPortfoliosPayload) *guilds_servicepb.GetAccountMonthlyPortfoliosRequest {
+	message := &guilds_servicepb.GetAccountMonthlyPortfoliosRequest{
+		InjectiveAddress: payload.InjectiveAddress,
+	}
+	if payload.StartTime != nil {
+		message.StartTime = *payload.StartTime
+	}
+	if payload.EndTime != nil {
+		message.EndTime = *payload.EndTime
+	}
+	return message
+}
+
+// NewGetAccountMonthlyPortfoliosResult builds the result type of the
+// "GetAccountMonthlyPortfolios" endpoint of the "GuildsService" service from
+// the gRPC response type.
+func NewGetAccountMonthlyPortfoliosResult(message *guilds_servicepb.GetAccountMonthlyPortfoliosResponse) *guildsservice.GetAccountMonthlyPortfoliosResult {
+	result := &guildsservice.GetAccountMonthlyPortfoliosResult{}
+	if message.Portfolios != nil {
+		result.Portfolios = make([]*guildsservice.MonthlyAccountPortfolio, len(message.Portfolios))
+		for i, val := range message.Portfolios {
+			result.Portfolios[i] = &guildsservice.MonthlyAccountPortfolio{
+				Time: val.Time,
+			}
+			if val.BeginSnapshot != nil {
+				result.Portfolios[i].BeginSnapshot = protobufGuildsServicepbSingleAccountPortfolioToGuildsserviceSingleAccountPortfolio(val.BeginSnapshot)
+			}
+			if val.EndSnapshot != nil {
+				result.Portfolios[i].EndSnapshot = protobufGuildsServicepbSingleAccountPortfolioToGuildsserviceSingleAccountPortfolio(val.EndSnapshot)
+			}
+		}
+	}
+	return result
+}
+
+// NewGetAccountMonthlyPortfoliosNotFoundError builds the error type of the
+// "GetAccountMonthlyPortfolios" endpoint of the "GuildsService" service from
+// the gRPC error response type.
+func NewGetAccountMonthlyPortfoliosNotFoundError(message *guilds_servicepb.GetAccountMonthlyPortfoliosNotFoundError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetAccountMonthlyPortfoliosInvalidArgError builds the error type of the
+// "GetAccountMonthlyPortfolios" endpoint of the "GuildsService" service from
+// the gRPC error response type.
+func NewGetAccountMonthlyPortfoliosInvalidArgError(message *guilds_servicepb.GetAccountMonthlyPortfoliosInvalidArgError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetAccountMonthlyPortfoliosInternalError builds the error type of the
+// "GetAccountMonthlyPortfolios" endpoint of the "GuildsService" service from
+// the gRPC error response type.
+func NewGetAccountMonthlyPortfoliosInternalError(message *guilds_servicepb.GetAccountMonthlyPortfoliosInternalError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetAccountGrantsRequest builds the gRPC request type from the payload of
+// the "GetAccountGrants" endpoint of the "GuildsService" service.
+func NewGetAccountGrantsRequest(payload *guildsservice.GetAccountGrantsPayload) *guilds_servicepb.GetAccountGrantsRequest {
+	message := &guilds_servicepb.GetAccountGrantsRequest{
+		InjectiveAddress: payload.InjectiveAddress,
+	}
+	return message
+}
+
+// NewGetAccountGrantsResult builds the result type of the "GetAccountGrants"
+// endpoint of the "GuildsService" service from the gRPC response type.
+func NewGetAccountGrantsResult(message *guilds_servicepb.GetAccountGrantsResponse) *guildsservice.GetAccountGrantsResult {
+	result := &guildsservice.GetAccountGrantsResult{}
+	if message.Grants != nil {
+		result.Grants = make([]*guildsservice.Grant, len(message.Grants))
+		for i, val := range message.Grants {
+			result.Grants[i] = &guildsservice.Grant{
+				Msg:       val.Msg,
+				IsGranted: val.IsGranted,
+			}
+			if val.Expiration != 0 {
+				result.Grants[i].Expiration = &val.Expiration
+			}
+		}
+	}
+	return result
+}
+
+// NewGetAccountGrantsNotFoundError builds the error type of the
+// "GetAccountGrants" endpoint of the "GuildsService" service from the gRPC
+// error response type.
+func NewGetAccountGrantsNotFoundError(message *guilds_servicepb.GetAccountGrantsNotFoundError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetAccountGrantsInvalidArgError builds the error type of the
+// "GetAccountGrants" endpoint of the "GuildsService" service from the gRPC
+// error response type.
+func NewGetAccountGrantsInvalidArgError(message *guilds_servicepb.GetAccountGrantsInvalidArgError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetAccountGrantsInternalError builds the error type of the
+// "GetAccountGrants" endpoint of the "GuildsService" service from the gRPC
+// error response type.
+func NewGetAccountGrantsInternalError(message *guilds_servicepb.GetAccountGrantsInternalError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetAccountTradesRequest builds the gRPC request type from the payload of
+// the "GetAccountTrades" endpoint of the "GuildsService" service.
+func NewGetAccountTradesRequest(payload *guildsservice.GetAccountTradesPayload) *guilds_servicepb.GetAccountTradesRequest {
+	message := &guilds_servicepb.GetAccountTradesRequest{
+		InjectiveAddress: payload.InjectiveAddress,
+	}
+	if payload.StartTime != nil {
+		message.StartTime = *payload.StartTime
+	}
+	if payload.EndTime != nil {
+		message.EndTime = *payload.EndTime
+	}
+	if payload.Limit != nil {
+		message.Limit = *payload.Limit
+	}
+	return message
+}
+
+// NewGetAccountTradesResult builds the result type of the "GetAccountTrades"
+// endpoint of the "GuildsService" service from the gRPC response type.
+func NewGetAccountTradesResult(message *guilds_servicepb.GetAccountTradesResponse) *guildsservice.GetAccountTradesResult {
+	result := &guildsservice.GetAccountTradesResult{}
+	if message.Trades != nil {
+		result.Trades = make([]*guildsservice.Trade, len(message.Trades))
+		for i, val := range message.Trades {
+			result.Trades[i] = &guildsservice.Trade{
+				TradeID:       val.TradeId,
+				SubaccountID:  val.SubaccountId,
+				MarketID:      val.MarketId,
+				IsPerpetual:   val.IsPerpetual,
+				Direction:     val.Direction,
+				ExecutionType: val.ExecutionType,
+				Price:         val.Price,
+				Quantity:      val.Quantity,
+				QuoteDenom:    val.QuoteDenom,
+				Fee:           val.Fee,
+				Volume:        val.Volume,
+				RealizedPnl:   val.RealizedPnl,
+				ExecutedAt:    val.ExecutedAt,
+			}
+		}
+	}
+	return result
+}
+
+// NewGetAccountTradesNotFoundError builds the error type of the
+// "GetAccountTrades" endpoint of the "GuildsService" service from the gRPC
+// error response type.
+func NewGetAccountTradesNotFoundError(message *guilds_servicepb.GetAccountTradesNotFoundError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetAccountTradesInvalidArgError builds the error type of the
+// "GetAccountTrades" endpoint of the "GuildsService" service from the gRPC
+// error response type.
+func NewGetAccountTradesInvalidArgError(message *guilds_servicepb.GetAccountTradesInvalidArgError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetAccountTradesInternalError builds the error type of the
+// "GetAccountTrades" endpoint of the "GuildsService" service from the gRPC
+// error response type.
+func NewGetAccountTradesInternalError(message *guilds_servicepb.GetAccountTradesInternalError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetAccountTradeStatsRequest builds the gRPC request type from the payload
+// of the "GetAccountTradeStats" endpoint of the "GuildsService" service.
+func NewGetAccountTradeStatsRequest(payload *guildsservice.GetAccountTradeStatsPayload) *guilds_servicepb.GetAccountTradeStatsRequest {
+	message := &guilds_servicepb.GetAccountTradeStatsRequest{
+		InjectiveAddress: payload.InjectiveAddress,
+	}
+	if payload.StartTime != nil {
+		message.StartTime = *payload.StartTime
+	}
+	if payload.EndTime != nil {
+		message.EndTime = *payload.EndTime
+	}
+	return message
+}
+
+// NewGetAccountTradeStatsResult builds the result type of the
+// "GetAccountTradeStats" endpoint of the "GuildsService" service from the gRPC
+// response type.
+func NewGetAccountTradeStatsResult(message *guilds_servicepb.GetAccountTradeStatsResponse) *guildsservice.GetAccountTradeStatsResult {
+	result := &guildsservice.GetAccountTradeStatsResult{}
+	if message.Stats != nil {
+		result.Stats = make([]*guildsservice.TradeStats, len(message.Stats))
+		for i, val := range message.Stats {
+			result.Stats[i] = &guildsservice.TradeStats{
+				QuoteDenom:  val.QuoteDenom,
+				RealizedPnl: val.RealizedPnl,
+				Fees:        val.Fees,
+				Volume:      val.Volume,
+				TradeCount:  int(val.TradeCount),
+			}
+		}
+	}
+	return result
+}
+
+// NewGetAccountTradeStatsNotFoundError builds the error type of the
+// "GetAccountTradeStats" endpoint of the "GuildsService" service from the gRPC
+// error response type.
+func NewGetAccountTradeStatsNotFoundError(message *guilds_servicepb.GetAccountTradeStatsNotFoundError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetAccountTradeStatsInvalidArgError builds the error type of the
+// "GetAccountTradeStats" endpoint of the "GuildsService" service from the gRPC
+// error response type.
+func NewGetAccountTradeStatsInvalidArgError(message *guilds_servicepb.GetAccountTradeStatsInvalidArgError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetAccountTradeStatsInternalError builds the error type of the
+// "GetAccountTradeStats" endpoint of the "GuildsService" service from the gRPC
+// error response type.
+func NewGetAccountTradeStatsInternalError(message *guilds_servicepb.GetAccountTradeStatsInternalError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetGuildTradeStatsRequest builds the gRPC request type from the payload
+// of the "GetGuildTradeStats" endpoint of the "GuildsService" service.
+func NewGetGuildTradeStatsRequest(payload *guildsservice.GetGuildTradeStatsPayload) *guilds_servicepb.GetGuildTradeStatsRequest {
+	message := &guilds_servicepb.GetGuildTradeStatsRequest{
+		GuildId: payload.GuildID,
+	}
+	if payload.StartTime != nil {
+		message.StartTime = *payload.StartTime
+	}
+	if payload.EndTime != nil {
+		message.EndTime = *payload.EndTime
+	}
+	return message
+}
+
+// NewGetGuildTradeStatsResult builds the result type of the
+// "GetGuildTradeStats" endpoint of the "GuildsService" service from the gRPC
+// response type.
+func NewGetGuildTradeStatsResult(message *guilds_servicepb.GetGuildTradeStatsResponse) *guildsservice.GetGuildTradeStatsResult {
+	result := &guildsservice.GetGuildTradeStatsResult{}
+	if message.Stats != nil {
+		result.Stats = make([]*guildsservice.TradeStats, len(message.Stats))
+		for i, val := range message.Stats {
+			result.Stats[i] = &guildsservice.TradeStats{
+				QuoteDenom:  val.QuoteDenom,
+				RealizedPnl: val.RealizedPnl,
+				Fees:        val.Fees,
+				Volume:      val.Volume,
+				TradeCount:  int(val.TradeCount),
+			}
+		}
+	}
+	return result
+}
+
+// NewGetGuildTradeStatsNotFoundError builds the error type of the
+// "GetGuildTradeStats" endpoint of the "GuildsService" service from the gRPC
+// error response type.
+func NewGetGuildTradeStatsNotFoundError(message *guilds_servicepb.GetGuildTradeStatsNotFoundError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetGuildTradeStatsInvalidArgError builds the error type of the
+// "GetGuildTradeStats" endpoint of the "GuildsService" service from the gRPC
+// error response type.
+func NewGetGuildTradeStatsInvalidArgError(message *guilds_servicepb.GetGuildTradeStatsInvalidArgError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetGuildTradeStatsInternalError builds the error type of the
+// "GetGuildTradeStats" endpoint of the "GuildsService" service from the gRPC
+// error response type.
+func NewGetGuildTradeStatsInternalError(message *guilds_servicepb.GetGuildTradeStatsInternalError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetGuildFeesRequest builds the gRPC request type from the payload of the
+// "GetGuildFees" endpoint of the "GuildsService" service.
+func NewGetGuildFeesRequest(payload *guildsservice.GetGuildFeesPayload) *guilds_servicepb.GetGuildFeesRequest {
+	message := &guilds_servicepb.GetGuildFeesRequest{
+		GuildId: payload.GuildID,
+	}
+	if payload.StartTime != nil {
+		message.StartTime = *payload.StartTime
+	}
+	if payload.EndTime != nil {
+		message.EndTime = *payload.EndTime
+	}
+	return message
+}
+
+// NewGetGuildFeesResult builds the result type of the "GetGuildFees" endpoint
+// of the "GuildsService" service from the gRPC response type.
+func NewGetGuildFeesResult(message *guilds_servicepb.GetGuildFeesResponse) *guildsservice.GetGuildFeesResult {
+	result := &guildsservice.GetGuildFeesResult{}
+	if message.MasterAddress != "" {
+		result.MasterAddress = &message.MasterAddress
+	}
+	if message.Totals != nil {
+		result.Totals = make([]*guildsservice.GuildFeeTotal, len(message.Totals))
+		for i, val := range message.Totals {
+			result.Totals[i] = &guildsservice.GuildFeeTotal{
+				QuoteDenom:     val.QuoteDenom,
+				FeesPaid:       val.FeesPaid,
+				MasterFees:     val.MasterFees,
+				Volume:         val.Volume,
+				TradeCount:     int(val.TradeCount),
+				UnresolvedFees: val.UnresolvedFees,
+			}
+		}
+	}
+	if message.Members != nil {
+		result.Members = make([]*guildsservice.MemberFeeContribution, len(message.Members))
+		for i, val := range message.Members {
+			result.Members[i] = &guildsservice.MemberFeeContribution{
+				InjectiveAddress: val.InjectiveAddress,
+				QuoteDenom:       val.QuoteDenom,
+				FeesPaid:         val.FeesPaid,
+				MasterFees:       val.MasterFees,
+				Volume:           val.Volume,
+				TradeCount:       int(val.TradeCount),
+				Share:            val.Share,
+				UnresolvedFees:   val.UnresolvedFees,
+			}
+		}
+	}
+	if message.Daily != nil {
+		result.Daily = make([]*guildsservice.DailyGuildFee, len(message.Daily))
+		for i, val := range message.Daily {
+			result.Daily[i] = &guildsservice.DailyGuildFee{
+				Day:            val.Day,
+				QuoteDenom:     val.QuoteDenom,
+				FeesPaid:       val.FeesPaid,
+				MasterFees:     val.MasterFees,
+				Volume:         val.Volume,
+				TradeCount:     int(val.TradeCount),
+				UnresolvedFees: val.UnresolvedFees,
+			}
+		}
+	}
+	return result
+}
+
+// NewGetGuildFeesNotFoundError builds the error type of the "GetGuildFees"
+// endpoint of the "GuildsService" service from the gRPC error response type.
+func NewGetGuildFeesNotFoundError(message *guilds_servicepb.GetGuildFeesNotFoundError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetGuildFeesInvalidArgError builds the error type of the "GetGuildFees"
+// endpoint of the "GuildsService" service from the gRPC error response type.
+func NewGetGuildFeesInvalidArgError(message *guilds_servicepb.GetGuildFeesInvalidArgError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetGuildFeesInternalError builds the error type of the "GetGuildFees"
+// endpoint of the "GuildsService" service from the gRPC error response type.
+func NewGetGuildFeesInternalError(message *guilds_servicepb.GetGuildFeesInternalError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetAccountTransfersRequest builds the gRPC request type from the payload
+// of the "GetAccountTransfers" endpoint of the "GuildsService" service.
+func NewGetAccountTransfersRequest(payload *guildsservice.GetAccountTransfersPayload) *guilds_servicepb.GetAccountTransfersRequest {
+	message := &guilds_servicepb.GetAccountTransfersRequest{
+		InjectiveAddress: payload.InjectiveAddress,
+	}
+	if payload.StartTime != nil {
+		message.StartTime = *payload.StartTime
+	}
+	if payload.EndTime != nil {
+		message.EndTime = *payload.EndTime
+	}
+	if payload.Limit != nil {
+		message.Limit = *payload.Limit
+	}
+	return message
+}
+
+// NewGetAccountTransfersResult builds the result type of the
+// "GetAccountTransfers" endpoint of the "GuildsService" service from the gRPC
+// response type.
+func NewGetAccountTransfersResult(message *guilds_servicepb.GetAccountTransfersResponse) *guildsservice.GetAccountTransfersResult {
+	result := &guildsservice.GetAccountTransfersResult{}
+	if message.Transfers != nil {
+		result.Transfers = make([]*guildsservice.Transfer, len(message.Transfers))
+		for i, val := range message.Transfers {
+			result.Transfers[i] = &guildsservice.Transfer{
+				TransferID:   val.TransferId,
+				SubaccountID: val.SubaccountId,
+				TransferType: val.TransferType,
+				Denom:        val.Denom,
+				Amount:       val.Amount,
+				ExecutedAt:   val.ExecutedAt,
+			}
+		}
+	}
+	if message.NetFlows != nil {
+		result.NetFlows = make([]*guildsservice.NetFlow, len(message.NetFlows))
+		for i, val := range message.NetFlows {
+			result.NetFlows[i] = &guildsservice.NetFlow{
+				Denom:         val.Denom,
+				Deposits:      val.Deposits,
+				Withdrawals:   val.Withdrawals,
+				NetFlow:       val.NetFlow,
+				TransferCount: int(val.TransferCount),
+			}
+		}
+	}
+	return result
+}
+
+// NewGetAccountTransfersNotFoundError builds the error type of the
+// "GetAccountTransfers" endpoint of the "GuildsService" service from the gRPC
+// error response type.
+func NewGetAccountTransfersNotFoundError(message *guilds_servicepb.GetAccountTransfersNotFoundError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetAccountTransfersInvalidArgError builds the error type of the
+// "GetAccountTransfers" endpoint of the "GuildsService" service from the gRPC
+// error response type.
+func NewGetAccountTransfersInvalidArgError(message *guilds_servicepb.GetAccountTransfersInvalidArgError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetAccountTransfersInternalError builds the error type of the
+// "GetAccountTransfers" endpoint of the "GuildsService" service from the gRPC
+// error response type.
+func NewGetAccountTransfersInternalError(message *guilds_servicepb.GetAccountTransfersInternalError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetAccountPositionsRequest builds the gRPC request type from the payload
+// of the "GetAccountPositions" endpoint of the "GuildsService" service.
+func NewGetAccountPositionsRequest(payload *guildsservice.GetAccountPositionsPayload) *guilds_servicepb.GetAccountPositionsRequest {
+	message := &guilds_servicepb.GetAccountPositionsRequest{
+		InjectiveAddress: payload.InjectiveAddress,
+	}
+	return message
+}
+
+// NewGetAccountPositionsResult builds the result type of the
+// "GetAccountPositions" endpoint of the "GuildsService" service from the gRPC
+// response type.
+func NewGetAccountPositionsResult(message *guilds_servicepb.GetAccountPositionsResponse) *guildsservice.GetAccountPositionsResult {
+	result := &guildsservice.GetAccountPositionsResult{
+		UpdatedAt: message.UpdatedAt,
+	}
+	if message.Positions != nil {
+		result.Positions = make([]*guildsservice.Position, len(message.Positions))
+		for i, val := range message.Positions {
+			result.Positions[i] = &guildsservice.Position{
+				SubaccountID:     val.SubaccountId,
+				MarketID:         val.MarketId,
+				Ticker:           val.Ticker,
+				Direction:        val.Direction,
+				Quantity:         val.Quantity,
+				EntryPrice:       val.EntryPrice,
+				MarkPrice:        val.MarkPrice,
+				Margin:           val.Margin,
+				LiquidationPrice: val.LiquidationPrice,
+			}
+			if val.QuoteDenom != "" {
+				result.Positions[i].QuoteDenom = &val.QuoteDenom
+			}
+		}
+	}
+	return result
+}
+
+// NewGetAccountPositionsNotFoundError builds the error type of the
+// "GetAccountPositions" endpoint of the "GuildsService" service from the gRPC
+// error response type.
+func NewGetAccountPositionsNotFoundError(message *guilds_servicepb.GetAccountPositionsNotFoundError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetAccountPositionsInvalidArgError builds the error type of the
+// "GetAccountPositions" endpoint of the "GuildsService" service from the gRPC
+// error response type.
+func NewGetAccountPositionsInvalidArgError(message *guilds_servicepb.GetAccountPositionsInvalidArgError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetAccountPositionsInternalError builds the error type of the
+// "GetAccountPositions" endpoint of the "GuildsService" service from the gRPC
+// error response type.
+func NewGetAccountPositionsInternalError(message *guilds_servicepb.GetAccountPositionsInternalError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetGuildRiskRequest builds the gRPC request type from the payload of the
+// "GetGuildRisk" endpoint of the "GuildsService" service.
+func NewGetGuildRiskRequest(payload *guildsservice.GetGuildRiskPayload) *guilds_servicepb.GetGuildRiskRequest {
+	message := &guilds_servicepb.GetGuildRiskRequest{
+		GuildId: payload.GuildID,
+	}
+	return message
+}
+
+// NewGetGuildRiskResult builds the result type of the "GetGuildRisk" endpoint
+// of the "GuildsService" service from the gRPC response type.
+func NewGetGuildRiskResult(message *guilds_servicepb.GetGuildRiskResponse) *guildsservice.GetGuildRiskResult {
+	result := &guildsservice.GetGuildRiskResult{}
+	if message.Data != nil {
+		result.Data = protobufGuildsServicepbGuildRiskToGuildsserviceGuildRisk(message.Data)
+	}
+	return result
+}
+
+// NewGetGuildRiskNotFoundError builds the error type of the "GetGuildRisk"
+// endpoint of the "GuildsService" service from the gRPC error response type.
+func NewGetGuildRiskNotFoundError(message *guilds_servicepb.GetGuildRiskNotFoundError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetGuildRiskInvalidArgError builds the error type of the "GetGuildRisk"
+// endpoint of the "GuildsService" service from the gRPC error response type.
+func NewGetGuildRiskInvalidArgError(message *guilds_servicepb.GetGuildRiskInvalidArgError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewGetGuildRiskInternalError builds the error type of the "GetGuildRisk"
+// endpoint of the "GuildsService" service from the gRPC error response type.
+func NewGetGuildRiskInternalError(message *guilds_servicepb.GetGuildRiskInternalError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewStreamPortfolioUpdatesRequest builds the gRPC request type from the
+// payload of the "StreamPortfolioUpdates" endpoint of the "GuildsService"
+// service.
+func NewStreamPortfolioUpdatesRequest(payload *guildsservice.StreamPortfolioUpdatesPayload) *guilds_servicepb.StreamPortfolioUpdatesRequest {
+	message := &guilds_servicepb.StreamPortfolioUpdatesRequest{}
+	if payload.GuildID != nil {
+		message.GuildId = *payload.GuildID
+	}
+	if payload.InjectiveAddress != nil {
+		message.InjectiveAddress = *payload.InjectiveAddress
+	}
+	if payload.LastEventID != nil {
+		message.LastEventId = *payload.LastEventID
+	}
+	return message
+}
+
+func NewPortfolioUpdate(v *guilds_servicepb.StreamPortfolioUpdatesResponse) *guildsservice.PortfolioUpdate {
+	result := &guildsservice.PortfolioUpdate{
+		Kind:    v.Kind,
+		GuildID: v.GuildId,
+		ID:      v.Id,
+	}
+	if v.InjectiveAddress != "" {
+		result.InjectiveAddress = &v.InjectiveAddress
+	}
+	if v.Portfolio != nil {
+		result.Portfolio = protobufGuildsServicepbStreamPortfolioToGuildsserviceStreamPortfolio(v.Portfolio)
+	}
+	if v.Membership != nil {
+		result.Membership = protobufGuildsServicepbStreamMembershipToGuildsserviceStreamMembership(v.Membership)
+	}
+	return result
+}
+
+// NewStreamPortfolioUpdatesNotFoundError builds the error type of the
+// "StreamPortfolioUpdates" endpoint of the "GuildsService" service from the
+// gRPC error response type.
+func NewStreamPortfolioUpdatesNotFoundError(message *guilds_servicepb.StreamPortfolioUpdatesNotFoundError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewStreamPortfolioUpdatesInvalidArgError builds the error type of the
+// "StreamPortfolioUpdates" endpoint of the "GuildsService" service from the
+// gRPC error response type.
+func NewStreamPortfolioUpdatesInvalidArgError(message *guilds_servicepb.StreamPortfolioUpdatesInvalidArgError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// NewStreamPortfolioUpdatesInternalError builds the error type of the
+// "StreamPortfolioUpdates" endpoint of the "GuildsService" service from the
+// gRPC error response type.
+func NewStreamPortfolioUpdatesInternalError(message *guilds_servicepb.StreamPortfolioUpdatesInternalError) *guildsservice.APIError {
+	er := &guildsservice.APIError{
+		Name:    message.Name,
+		Code:    message.Code,
+		Message: message.Message_,
+	}
+	if message.Details != nil {
+		er.Details = make(map[string]string, len(message.Details))
+		for key, val := range message.Details {
+			tk := key
+			tv := val
+			er.Details[tk] = tv
+		}
+	}
+	return er
+}
+
+// ValidateGetAllGuildsNotFoundError runs the validations defined on
+// GetAllGuildsNotFoundError.
+func ValidateGetAllGuildsNotFoundError(message *guilds_servicepb.GetAllGuildsNotFoundError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetAllGuildsInvalidArgError runs the validations defined on
+// GetAllGuildsInvalidArgError.
+func ValidateGetAllGuildsInvalidArgError(message *guilds_servicepb.GetAllGuildsInvalidArgError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetAllGuildsInternalError runs the validations defined on
+// GetAllGuildsInternalError.
+func ValidateGetAllGuildsInternalError(message *guilds_servicepb.GetAllGuildsInternalError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetAllGuildsResponse runs the validations defined on
+// GetAllGuildsResponse.
+func ValidateGetAllGuildsResponse(message *guilds_servicepb.GetAllGuildsResponse) (err error) {
+	for _, e := range message.Guilds {
+		if e != nil {
+			if err2 := ValidateGuild(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateGuild runs the validations defined on Guild.
+func ValidateGuild(message *guilds_servicepb.Guild) (err error) {
+	if message.Requirements == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("requirements", "message"))
+	}
+	if message.CurrentPortfolio != nil {
+		if err2 := ValidateSingleGuildPortfolio(message.CurrentPortfolio); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateRequirement runs the validations defined on Requirement.
+func ValidateRequirement(message *guilds_servicepb.Requirement) (err error) {
+
+	return
+}
+
+// ValidateSingleGuildPortfolio runs the validations defined on
+// SingleGuildPortfolio.
+func ValidateSingleGuildPortfolio(message *guilds_servicepb.SingleGuildPortfolio) (err error) {
+	if message.Balances == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("balances", "message"))
+	}
+	for _, e := range message.CashFlows {
+		if e != nil {
+			if err2 := ValidateCashFlow(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateBalance runs the validations defined on Balance.
+func ValidateBalance(message *guilds_servicepb.Balance) (err error) {
+
+	return
+}
+
+// ValidateCashFlow runs the validations defined on CashFlow.
+func ValidateCashFlow(message *guilds_servicepb.CashFlow) (err error) {
+	if !(message.Type == "deposit" || message.Type == "withdraw" || message.Type == "transfer_in" || message.Type == "transfer_out" || message.Type == "member_joined" || message.Type == "member_left") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.type", message.Type, []interface{}{"deposit", "withdraw", "transfer_in", "transfer_out", "member_joined", "member_left"}))
+	}
+	return
+}
+
+// ValidateGetSingleGuildNotFoundError runs the validations defined on
+// GetSingleGuildNotFoundError.
+func ValidateGetSingleGuildNotFoundError(message *guilds_servicepb.GetSingleGuildNotFoundError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetSingleGuildInvalidArgError runs the validations defined on
+// GetSingleGuildInvalidArgError.
+func ValidateGetSingleGuildInvalidArgError(message *guilds_servicepb.GetSingleGuildInvalidArgError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetSingleGuildInternalError runs the validations defined on
+// GetSingleGuildInternalError.
+func ValidateGetSingleGuildInternalError(message *guilds_servicepb.GetSingleGuildInternalError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetSingleGuildResponse runs the validations defined on
+// GetSingleGuildResponse.
+func ValidateGetSingleGuildResponse(message *guilds_servicepb.GetSingleGuildResponse) (err error) {
+	if message.Guild != nil {
+		if err2 := ValidateGuild(message.Guild); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateGetGuildMembersNotFoundError runs the validations defined on
+// GetGuildMembersNotFoundError.
+func ValidateGetGuildMembersNotFoundError(message *guilds_servicepb.GetGuildMembersNotFoundError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetGuildMembersInvalidArgError runs the validations defined on
+// GetGuildMembersInvalidArgError.
+func ValidateGetGuildMembersInvalidArgError(message *guilds_servicepb.GetGuildMembersInvalidArgError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetGuildMembersInternalError runs the validations defined on
+// GetGuildMembersInternalError.
+func ValidateGetGuildMembersInternalError(message *guilds_servicepb.GetGuildMembersInternalError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetGuildMasterAddressNotFoundError runs the validations defined on
+// GetGuildMasterAddressNotFoundError.
+func ValidateGetGuildMasterAddressNotFoundError(message *guilds_servicepb.GetGuildMasterAddressNotFoundError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetGuildMasterAddressInvalidArgError runs the validations defined on
+// GetGuildMasterAddressInvalidArgError.
+func ValidateGetGuildMasterAddressInvalidArgError(message *guilds_servicepb.GetGuildMasterAddressInvalidArgError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetGuildMasterAddressInternalError runs the validations defined on
+// GetGuildMasterAddressInternalError.
+func ValidateGetGuildMasterAddressInternalError(message *guilds_servicepb.GetGuildMasterAddressInternalError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetGuildDefaultMemberNotFoundError runs the validations defined on
+// GetGuildDefaultMemberNotFoundError.
+func ValidateGetGuildDefaultMemberNotFoundError(message *guilds_servicepb.GetGuildDefaultMemberNotFoundError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetGuildDefaultMemberInvalidArgError runs the validations defined on
+// GetGuildDefaultMemberInvalidArgError.
+func ValidateGetGuildDefaultMemberInvalidArgError(message *guilds_servicepb.GetGuildDefaultMemberInvalidArgError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetGuildDefaultMemberInternalError runs the validations defined on
+// GetGuildDefaultMemberInternalError.
+func ValidateGetGuildDefaultMemberInternalError(message *guilds_servicepb.GetGuildDefaultMemberInternalError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateEnterGuildNotFoundError runs the validations defined on
+// EnterGuildNotFoundError.
+func ValidateEnterGuildNotFoundError(message *guilds_servicepb.EnterGuildNotFoundError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateEnterGuildInvalidArgError runs the validations defined on
+// EnterGuildInvalidArgError.
+func ValidateEnterGuildInvalidArgError(message *guilds_servicepb.EnterGuildInvalidArgError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateEnterGuildInternalError runs the validations defined on
+// EnterGuildInternalError.
+func ValidateEnterGuildInternalError(message *guilds_servicepb.EnterGuildInternalError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetGuildWaitlistNotFoundError runs the validations defined on
+// GetGuildWaitlistNotFoundError.
+func ValidateGetGuildWaitlistNotFoundError(message *guilds_servicepb.GetGuildWaitlistNotFoundError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetGuildWaitlistInvalidArgError runs the validations defined on
+// GetGuildWaitlistInvalidArgError.
+func ValidateGetGuildWaitlistInvalidArgError(message *guilds_servicepb.GetGuildWaitlistInvalidArgError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetGuildWaitlistInternalError runs the validations defined on
+// GetGuildWaitlistInternalError.
+func ValidateGetGuildWaitlistInternalError(message *guilds_servicepb.GetGuildWaitlistInternalError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateLeaveGuildNotFoundError runs the validations defined on
+// LeaveGuildNotFoundError.
+func ValidateLeaveGuildNotFoundError(message *guilds_servicepb.LeaveGuildNotFoundError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateLeaveGuildInvalidArgError runs the validations defined on
+// LeaveGuildInvalidArgError.
+func ValidateLeaveGuildInvalidArgError(message *guilds_servicepb.LeaveGuildInvalidArgError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateLeaveGuildInternalError runs the validations defined on
+// LeaveGuildInternalError.
+func ValidateLeaveGuildInternalError(message *guilds_servicepb.LeaveGuildInternalError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateTransferMemberNotFoundError runs the validations defined on
+// TransferMemberNotFoundError.
+func ValidateTransferMemberNotFoundError(message *guilds_servicepb.TransferMemberNotFoundError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateTransferMemberInvalidArgError runs the validations defined on
+// TransferMemberInvalidArgError.
+func ValidateTransferMemberInvalidArgError(message *guilds_servicepb.TransferMemberInvalidArgError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateTransferMemberInternalError runs the validations defined on
+// TransferMemberInternalError.
+func ValidateTransferMemberInternalError(message *guilds_servicepb.TransferMemberInternalError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetGuildMarketsNotFoundError runs the validations defined on
+// GetGuildMarketsNotFoundError.
+func ValidateGetGuildMarketsNotFoundError(message *guilds_servicepb.GetGuildMarketsNotFoundError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetGuildMarketsInvalidArgError runs the validations defined on
+// GetGuildMarketsInvalidArgError.
+func ValidateGetGuildMarketsInvalidArgError(message *guilds_servicepb.GetGuildMarketsInvalidArgError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetGuildMarketsInternalError runs the validations defined on
+// GetGuildMarketsInternalError.
+func ValidateGetGuildMarketsInternalError(message *guilds_servicepb.GetGuildMarketsInternalError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetGuildPortfoliosNotFoundError runs the validations defined on
+// GetGuildPortfoliosNotFoundError.
+func ValidateGetGuildPortfoliosNotFoundError(message *guilds_servicepb.GetGuildPortfoliosNotFoundError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetGuildPortfoliosInvalidArgError runs the validations defined on
+// GetGuildPortfoliosInvalidArgError.
+func ValidateGetGuildPortfoliosInvalidArgError(message *guilds_servicepb.GetGuildPortfoliosInvalidArgError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetGuildPortfoliosInternalError runs the validations defined on
+// GetGuildPortfoliosInternalError.
+func ValidateGetGuildPortfoliosInternalError(message *guilds_servicepb.GetGuildPortfoliosInternalError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetGuildPortfoliosResponse runs the validations defined on
+// GetGuildPortfoliosResponse.
+func ValidateGetGuildPortfoliosResponse(message *guilds_servicepb.GetGuildPortfoliosResponse) (err error) {
+	for _, e := range message.Portfolios {
+		if e != nil {
+			if err2 := ValidateSingleGuildPortfolio(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateNetFlow runs the validations defined on NetFlow.
+func ValidateNetFlow(message *guilds_servicepb.NetFlow) (err error) {
+
+	return
+}
+
+// ValidateGetAccountInfoNotFoundError runs the validations defined on
+// GetAccountInfoNotFoundError.
+func ValidateGetAccountInfoNotFoundError(message *guilds_servicepb.GetAccountInfoNotFoundError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetAccountInfoInvalidArgError runs the validations defined on
+// GetAccountInfoInvalidArgError.
+func ValidateGetAccountInfoInvalidArgError(message *guilds_servicepb.GetAccountInfoInvalidArgError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetAccountInfoInternalError runs the validations defined on
+// GetAccountInfoInternalError.
+func ValidateGetAccountInfoInternalError(message *guilds_servicepb.GetAccountInfoInternalError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetAccountPortfolioNotFoundError runs the validations defined on
+// GetAccountPortfolioNotFoundError.
+func ValidateGetAccountPortfolioNotFoundError(message *guilds_servicepb.GetAccountPortfolioNotFoundError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetAccountPortfolioInvalidArgError runs the validations defined on
+// GetAccountPortfolioInvalidArgError.
+func ValidateGetAccountPortfolioInvalidArgError(message *guilds_servicepb.GetAccountPortfolioInvalidArgError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetAccountPortfolioInternalError runs the validations defined on
+// GetAccountPortfolioInternalError.
+func ValidateGetAccountPortfolioInternalError(message *guilds_servicepb.GetAccountPortfolioInternalError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetAccountPortfolioResponse runs the validations defined on
+// GetAccountPortfolioResponse.
+func ValidateGetAccountPortfolioResponse(message *guilds_servicepb.GetAccountPortfolioResponse) (err error) {
+	if message.Data != nil {
+		if err2 := ValidateSingleAccountPortfolio(message.Data); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateSingleAccountPortfolio runs the validations defined on
+// SingleAccountPortfolio.
+func ValidateSingleAccountPortfolio(message *guilds_servicepb.SingleAccountPortfolio) (err error) {
+	if message.Balances == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("balances", "message"))
+	}
+	for _, e := range message.CashFlows {
+		if e != nil {
+			if err2 := ValidateCashFlow(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateGetAccountPortfoliosNotFoundError runs the validations defined on
+// GetAccountPortfoliosNotFoundError.
+func ValidateGetAccountPortfoliosNotFoundError(message *guilds_servicepb.GetAccountPortfoliosNotFoundError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetAccountPortfoliosInvalidArgError runs the validations defined on
+// GetAccountPortfoliosInvalidArgError.
+func ValidateGetAccountPortfoliosInvalidArgError(message *guilds_servicepb.GetAccountPortfoliosInvalidArgError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetAccountPortfoliosInternalError runs the validations defined on
+// GetAccountPortfoliosInternalError.
+func ValidateGetAccountPortfoliosInternalError(message *guilds_servicepb.GetAccountPortfoliosInternalError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetAccountPortfoliosResponse runs the validations defined on
+// GetAccountPortfoliosResponse.
+func ValidateGetAccountPortfoliosResponse(message *guilds_servicepb.GetAccountPortfoliosResponse) (err error) {
+	for _, e := range message.Portfolios {
+		if e != nil {
+			if err2 := ValidateSingleAccountPortfolio(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateGetAccountMembershipsNotFoundError runs the validations defined on
+// GetAccountMembershipsNotFoundError.
+func ValidateGetAccountMembershipsNotFoundError(message *guilds_servicepb.GetAccountMembershipsNotFoundError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetAccountMembershipsInvalidArgError runs the validations defined on
+// GetAccountMembershipsInvalidArgError.
+func ValidateGetAccountMembershipsInvalidArgError(message *guilds_servicepb.GetAccountMembershipsInvalidArgError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetAccountMembershipsInternalError runs the validations defined on
+// GetAccountMembershipsInternalError.
+func ValidateGetAccountMembershipsInternalError(message *guilds_servicepb.GetAccountMembershipsInternalError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetAccountMonthlyPortfoliosNotFoundError runs the validations
+// defined on GetAccountMonthlyPortfoliosNotFoundError.
+func ValidateGetAccountMonthlyPortfoliosNotFoundError(message *guilds_servicepb.GetAccountMonthlyPortfoliosNotFoundError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetAccountMonthlyPortfoliosInvalidArgError runs the validations
+// defined on GetAccountMonthlyPortfoliosInvalidArgError.
+func ValidateGetAccountMonthlyPortfoliosInvalidArgError(message *guilds_servicepb.GetAccountMonthlyPortfoliosInvalidArgError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetAccountMonthlyPortfoliosInternalError runs the validations
+// defined on GetAccountMonthlyPortfoliosInternalError.
+func ValidateGetAccountMonthlyPortfoliosInternalError(message *guilds_servicepb.GetAccountMonthlyPortfoliosInternalError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetAccountMonthlyPortfoliosResponse runs the validations defined on
+// GetAccountMonthlyPortfoliosResponse.
+func ValidateGetAccountMonthlyPortfoliosResponse(message *guilds_servicepb.GetAccountMonthlyPortfoliosResponse) (err error) {
+	for _, e := range message.Portfolios {
+		if e != nil {
+			if err2 := ValidateMonthlyAccountPortfolio(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateMonthlyAccountPortfolio runs the validations defined on
+// MonthlyAccountPortfolio.
+func ValidateMonthlyAccountPortfolio(message *guilds_servicepb.MonthlyAccountPortfolio) (err error) {
+	if message.BeginSnapshot == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("begin_snapshot", "message"))
+	}
+	if message.EndSnapshot == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("end_snapshot", "message"))
+	}
+	if message.BeginSnapshot != nil {
+		if err2 := ValidateSingleAccountPortfolio(message.BeginSnapshot); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if message.EndSnapshot != nil {
+		if err2 := ValidateSingleAccountPortfolio(message.EndSnapshot); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateGetAccountGrantsNotFoundError runs the validations defined on
+// GetAccountGrantsNotFoundError.
+func ValidateGetAccountGrantsNotFoundError(message *guilds_servicepb.GetAccountGrantsNotFoundError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetAccountGrantsInvalidArgError runs the validations defined on
+// GetAccountGrantsInvalidArgError.
+func ValidateGetAccountGrantsInvalidArgError(message *guilds_servicepb.GetAccountGrantsInvalidArgError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetAccountGrantsInternalError runs the validations defined on
+// GetAccountGrantsInternalError.
+func ValidateGetAccountGrantsInternalError(message *guilds_servicepb.GetAccountGrantsInternalError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetAccountTradesNotFoundError runs the validations defined on
+// GetAccountTradesNotFoundError.
+func ValidateGetAccountTradesNotFoundError(message *guilds_servicepb.GetAccountTradesNotFoundError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetAccountTradesInvalidArgError runs the validations defined on
+// GetAccountTradesInvalidArgError.
+func ValidateGetAccountTradesInvalidArgError(message *guilds_servicepb.GetAccountTradesInvalidArgError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetAccountTradesInternalError runs the validations defined on
+// GetAccountTradesInternalError.
+func ValidateGetAccountTradesInternalError(message *guilds_servicepb.GetAccountTradesInternalError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetAccountTradeStatsNotFoundError runs the validations defined on
+// GetAccountTradeStatsNotFoundError.
+func ValidateGetAccountTradeStatsNotFoundError(message *guilds_servicepb.GetAccountTradeStatsNotFoundError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetAccountTradeStatsInvalidArgError runs the validations defined on
+// GetAccountTradeStatsInvalidArgError.
+func ValidateGetAccountTradeStatsInvalidArgError(message *guilds_servicepb.GetAccountTradeStatsInvalidArgError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetAccountTradeStatsInternalError runs the validations defined on
+// GetAccountTradeStatsInternalError.
+func ValidateGetAccountTradeStatsInternalError(message *guilds_servicepb.GetAccountTradeStatsInternalError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetGuildTradeStatsNotFoundError runs the validations defined on
+// GetGuildTradeStatsNotFoundError.
+func ValidateGetGuildTradeStatsNotFoundError(message *guilds_servicepb.GetGuildTradeStatsNotFoundError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetGuildTradeStatsInvalidArgError runs the validations defined on
+// GetGuildTradeStatsInvalidArgError.
+func ValidateGetGuildTradeStatsInvalidArgError(message *guilds_servicepb.GetGuildTradeStatsInvalidArgError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetGuildTradeStatsInternalError runs the validations defined on
+// GetGuildTradeStatsInternalError.
+func ValidateGetGuildTradeStatsInternalError(message *guilds_servicepb.GetGuildTradeStatsInternalError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetGuildFeesNotFoundError runs the validations defined on
+// GetGuildFeesNotFoundError.
+func ValidateGetGuildFeesNotFoundError(message *guilds_servicepb.GetGuildFeesNotFoundError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetGuildFeesInvalidArgError runs the validations defined on
+// GetGuildFeesInvalidArgError.
+func ValidateGetGuildFeesInvalidArgError(message *guilds_servicepb.GetGuildFeesInvalidArgError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetGuildFeesInternalError runs the validations defined on
+// GetGuildFeesInternalError.
+func ValidateGetGuildFeesInternalError(message *guilds_servicepb.GetGuildFeesInternalError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetAccountTransfersNotFoundError runs the validations defined on
+// GetAccountTransfersNotFoundError.
+func ValidateGetAccountTransfersNotFoundError(message *guilds_servicepb.GetAccountTransfersNotFoundError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetAccountTransfersInvalidArgError runs the validations defined on
+// GetAccountTransfersInvalidArgError.
+func ValidateGetAccountTransfersInvalidArgError(message *guilds_servicepb.GetAccountTransfersInvalidArgError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetAccountTransfersInternalError runs the validations defined on
+// GetAccountTransfersInternalError.
+func ValidateGetAccountTransfersInternalError(message *guilds_servicepb.GetAccountTransfersInternalError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetAccountTransfersResponse runs the validations defined on
+// GetAccountTransfersResponse.
+func ValidateGetAccountTransfersResponse(message *guilds_servicepb.GetAccountTransfersResponse) (err error) {
+	for _, e := range message.Transfers {
+		if e != nil {
+			if err2 := ValidateTransfer(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateTransfer runs the validations defined on Transfer.
+func ValidateTransfer(message *guilds_servicepb.Transfer) (err error) {
+	if !(message.TransferType == "deposit" || message.TransferType == "withdraw") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.transfer_type", message.TransferType, []interface{}{"deposit", "withdraw"}))
+	}
+	return
+}
+
+// ValidateGetAccountPositionsNotFoundError runs the validations defined on
+// GetAccountPositionsNotFoundError.
+func ValidateGetAccountPositionsNotFoundError(message *guilds_servicepb.GetAccountPositionsNotFoundError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetAccountPositionsInvalidArgError runs the validations defined on
+// GetAccountPositionsInvalidArgError.
+func ValidateGetAccountPositionsInvalidArgError(message *guilds_servicepb.GetAccountPositionsInvalidArgError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetAccountPositionsInternalError runs the validations defined on
+// GetAccountPositionsInternalError.
+func ValidateGetAccountPositionsInternalError(message *guilds_servicepb.GetAccountPositionsInternalError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetAccountPositionsResponse runs the validations defined on
+// GetAccountPositionsResponse.
+func ValidateGetAccountPositionsResponse(message *guilds_servicepb.GetAccountPositionsResponse) (err error) {
+	if message.Positions == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("positions", "message"))
+	}
+	return
+}
+
+// ValidatePosition runs the validations defined on Position.
+func ValidatePosition(message *guilds_servicepb.Position) (err error) {
+
+	return
+}
+
+// ValidateGetGuildRiskNotFoundError runs the validations defined on
+// GetGuildRiskNotFoundError.
+func ValidateGetGuildRiskNotFoundError(message *guilds_servicepb.GetGuildRiskNotFoundError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetGuildRiskInvalidArgError runs the validations defined on
+// GetGuildRiskInvalidArgError.
+func ValidateGetGuildRiskInvalidArgError(message *guilds_servicepb.GetGuildRiskInvalidArgError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetGuildRiskInternalError runs the validations defined on
+// GetGuildRiskInternalError.
+func ValidateGetGuildRiskInternalError(message *guilds_servicepb.GetGuildRiskInternalError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateGetGuildRiskResponse runs the validations defined on
+// GetGuildRiskResponse.
+func ValidateGetGuildRiskResponse(message *guilds_servicepb.GetGuildRiskResponse) (err error) {
+	if message.Data != nil {
+		if err2 := ValidateGuildRisk(message.Data); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateGuildRisk runs the validations defined on GuildRisk.
+func ValidateGuildRisk(message *guilds_servicepb.GuildRisk) (err error) {
+	if message.Markets == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("markets", "message"))
+	}
+	return
+}
+
+// ValidateMarketExposure runs the validations defined on MarketExposure.
+func ValidateMarketExposure(message *guilds_servicepb.MarketExposure) (err error) {
+
+	return
+}
+
+// ValidateStreamPortfolioUpdatesNotFoundError runs the validations defined on
+// StreamPortfolioUpdatesNotFoundError.
+func ValidateStreamPortfolioUpdatesNotFoundError(message *guilds_servicepb.StreamPortfolioUpdatesNotFoundError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateStreamPortfolioUpdatesInvalidArgError runs the validations defined
+// on StreamPortfolioUpdatesInvalidArgError.
+func ValidateStreamPortfolioUpdatesInvalidArgError(message *guilds_servicepb.StreamPortfolioUpdatesInvalidArgError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateStreamPortfolioUpdatesInternalError runs the validations defined on
+// StreamPortfolioUpdatesInternalError.
+func ValidateStreamPortfolioUpdatesInternalError(message *guilds_servicepb.StreamPortfolioUpdatesInternalError) (err error) {
+	if !(message.Code == "INVALID_ARGUMENT" || message.Code == "NOT_FOUND" || message.Code == "INTERNAL" || message.Code == "GUILD_NOT_FOUND" || message.Code == "GUILD_FULL" || message.Code == "GUILD_ARCHIVED" || message.Code == "ALREADY_MEMBER" || message.Code == "MEMBER_NOT_FOUND" || message.Code == "INSUFFICIENT_BALANCE" || message.Code == "MISSING_GRANT" || message.Code == "OPEN_ORDERS" || message.Code == "OPEN_POSITIONS" || message.Code == "GRANTS_NOT_REVOKED" || message.Code == "NOT_ALLOWLISTED" || message.Code == "INVALID_INVITE_CODE") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.code", message.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+	}
+	return
+}
+
+// ValidateStreamPortfolioUpdatesResponse runs the validations defined on
+// StreamPortfolioUpdatesResponse.
+func ValidateStreamPortfolioUpdatesResponse(message *guilds_servicepb.StreamPortfolioUpdatesResponse) (err error) {
+	if !(message.Kind == "guild_portfolio" || message.Kind == "account_portfolio" || message.Kind == "member_joined" || message.Kind == "member_left") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.kind", message.Kind, []interface{}{"guild_portfolio", "account_portfolio", "member_joined", "member_left"}))
+	}
+	if message.Portfolio != nil {
+		if err2 := ValidateStreamPortfolio(message.Portfolio); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateStreamPortfolio runs the validations defined on StreamPortfolio.
+func ValidateStreamPortfolio(message *guilds_servicepb.StreamPortfolio) (err error) {
+	if message.Balances == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("balances", "message"))
+	}
+	return
+}
+
+// ValidateStreamMembership runs the validations defined on StreamMembership.
+func ValidateStreamMembership(message *guilds_servicepb.StreamMembership) (err error) {
+
+	return
+}
+
+// svcGuildsserviceSingleGuildPortfolioToGuildsServicepbSingleGuildPortfolio
+// builds a value of type *guilds_servicepb.SingleGuildPortfolio from a value
+// of type *guildsservice.SingleGuildPortfolio.
+func svcGuildsserviceSingleGuildPortfolioToGuildsServicepbSingleGuildPortfolio(v *guildsservice.SingleGuildPortfolio) *guilds_servicepb.SingleGuildPortfolio {
+	if v == nil {
+		return nil
+	}
+	res := &guilds_servicepb.SingleGuildPortfolio{
+		UpdatedAt: v.UpdatedAt,
+	}
+	if v.GuildID != nil {
+		res.GuildId = *v.GuildID
+	}
+	if v.ValueUsd != nil {
+		res.ValueUsd = *v.ValueUsd
+	}
+	if v.NetFlowUsd != nil {
+		res.NetFlowUsd = *v.NetFlowUsd
+	}
+	if v.Twr != nil {
+		res.Twr = *v.Twr
+	}
+	if v.Mwr != nil {
+		res.Mwr = *v.Mwr
+	}
+	if v.Balances != nil {
+		res.Balances = make([]*guilds_servicepb.Balance, len(v.Balances))
+		for i, val := range v.Balances {
+			res.Balances[i] = &guilds_servicepb.Balance{
+				Denom:            val.Denom,
+				TotalBalance:     val.TotalBalance,
+				AvailableBalance: val.AvailableBalance,
+				UnrealizedPnl:    val.UnrealizedPnl,
+				MarginHold:       val.MarginHold,
+				PriceUsd:         val.PriceUsd,
+			}
+		}
+	}
+	if v.CashFlows != nil {
+		res.CashFlows = make([]*guilds_servicepb.CashFlow, len(v.CashFlows))
+		for i, val := range v.CashFlows {
+			res.CashFlows[i] = &guilds_servicepb.CashFlow{
+				Type:       val.Type,
+				Denom:      val.Denom,
+				Amount:     val.Amount,
+				ExecutedAt: val.ExecutedAt,
+			}
+			if val.InjectiveAddress != nil {
+				res.CashFlows[i].InjectiveAddress = *val.InjectiveAddress
+			}
+		}
+	}
+
+	return res
+}
+
+// protobufGuildsServicepbSingleGuildPortfolioToGuildsserviceSingleGuildPortfolio
+// builds a value of type *guildsservice.SingleGuildPortfolio from a value of
+// type *guilds_servicepb.SingleGuildPortfolio.
+func protobufGuildsServicepbSingleGuildPortfolioToGuildsserviceSingleGuildPortfolio(v *guilds_servicepb.SingleGuildPortfolio) *guildsservice.SingleGuildPortfolio {
+	if v == nil {
+		return nil
+	}
+	res := &guildsservice.SingleGuildPortfolio{
+		UpdatedAt: v.UpdatedAt,
+	}
+	if v.GuildId != "" {
+		res.GuildID = &v.GuildId
+	}
+	if v.ValueUsd != 0 {
+		res.ValueUsd = &v.ValueUsd
+	}
+	if v.NetFlowUsd != 0 {
+		res.NetFlowUsd = &v.NetFlowUsd
+	}
+	if v.Twr != 0 {
+		res.Twr = &v.Twr
+	}
+	if v.Mwr != 0 {
+		res.Mwr = &v.Mwr
+	}
+	if v.Balances != nil {
+		res.Balances = make([]*guildsservice.Balance, len(v.Balances))
+		for i, val := range v.Balances {
+			res.Balances[i] = &guildsservice.Balance{
+				Denom:            val.Denom,
+				TotalBalance:     val.TotalBalance,
+				AvailableBalance: val.AvailableBalance,
+				UnrealizedPnl:    val.UnrealizedPnl,
+				MarginHold:       val.MarginHold,
+				PriceUsd:         val.PriceUsd,
+			}
+		}
+	}
+	if v.CashFlows != nil {
+		res.CashFlows = make([]*guildsservice.CashFlow, len(v.CashFlows))
+		for i, val := range v.CashFlows {
+			res.CashFlows[i] = &guildsservice.CashFlow{
+				Type:       val.Type,
+				Denom:      val.Denom,
+				Amount:     val.Amount,
+				ExecutedAt: val.ExecutedAt,
+			}
+			if val.InjectiveAddress != "" {
+				res.CashFlows[i].InjectiveAddress = &val.InjectiveAddress
+			}
+		}
+	}
+
+	return res
+}
+
+// svcGuildsserviceGuildToGuildsServicepbGuild builds a value of type
+// *guilds_servicepb.Guild from a value of type *guildsservice.Guild.
+func svcGuildsserviceGuildToGuildsServicepbGuild(v *guildsservice.Guild) *guilds_servicepb.Guild {
+	if v == nil {
+		return nil
+	}
+	res := &guilds_servicepb.Guild{
+		Id:                   v.ID,
+		Name:                 v.Name,
+		Description:          v.Description,
+		MasterAddress:        v.MasterAddress,
+		StakingRequirement:   v.StakingRequirement,
+		Capacity:             int32(v.Capacity),
+		MemberCount:          int32(v.MemberCount),
+		DefaultMemberAddress: v.DefaultMemberAddress,
+	}
+	if v.HasInactiveMarkets != nil {
+		res.HasInactiveMarkets = *v.HasInactiveMarkets
+	}
+	if v.Archived != nil {
+		res.Archived = *v.Archived
+	}
+	if v.AccessMode != nil {
+		res.AccessMode = *v.AccessMode
+	}
+	if v.Requirements != nil {
+		res.Requirements = make([]*guilds_servicepb.Requirement, len(v.Requirements))
+		for i, val := range v.Requirements {
+			res.Requirements[i] = &guilds_servicepb.Requirement{
+				Denom:        val.Denom,
+				MinAmountUsd: val.MinAmountUsd,
+				MinAmount:    val.MinAmount,
+			}
+		}
+	}
+	if v.CurrentPortfolio != nil {
+		res.CurrentPortfolio = svcGuildsserviceSingleGuildPortfolioToGuildsServicepbSingleGuildPortfolio(v.CurrentPortfolio)
+	}
+
+	return res
+}
+
+// protobufGuildsServicepbGuildToGuildsserviceGuild builds a value of type
+// *guildsservice.Guild from a value of type *guilds_servicepb.Guild.
+func protobufGuildsServicepbGuildToGuildsserviceGuild(v *guilds_servicepb.Guild) *guildsservice.Guild {
+	if v == nil {
+		return nil
+	}
+	res := &guildsservice.Guild{
+		ID:                   v.Id,
+		Name:                 v.Name,
+		Description:          v.Description,
+		MasterAddress:        v.MasterAddress,
+		StakingRequirement:   v.StakingRequirement,
+		Capacity:             int(v.Capacity),
+		MemberCount:          int(v.MemberCount),
+		DefaultMemberAddress: v.DefaultMemberAddress,
+		HasInactiveMarkets:   &v.HasInactiveMarkets,
+		Archived:             &v.Archived,
+	}
+	if v.AccessMode != "" {
+		res.AccessMode = &v.AccessMode
+	}
+	if v.Requirements != nil {
+		res.Requirements = make([]*guildsservice.Requirement, len(v.Requirements))
+		for i, val := range v.Requirements {
+			res.Requirements[i] = &guildsservice.Requirement{
+				Denom:        val.Denom,
+				MinAmountUsd: val.MinAmountUsd,
+				MinAmount:    val.MinAmount,
+			}
+		}
+	}
+	if v.CurrentPortfolio != nil {
+		res.CurrentPortfolio = protobufGuildsServicepbSingleGuildPortfolioToGuildsserviceSingleGuildPortfolio(v.CurrentPortfolio)
+	}
+
+	return res
+}
+
+// svcGuildsserviceGuildMemberToGuildsServicepbGuildMember builds a value of
+// type *guilds_servicepb.GuildMember from a value of type
+// *guildsservice.GuildMember.
+func svcGuildsserviceGuildMemberToGuildsServicepbGuildMember(v *guildsservice.GuildMember) *guilds_servicepb.GuildMember {
+	if v == nil {
+		return nil
+	}
+	res := &guilds_servicepb.GuildMember{
+		InjectiveAddress:     v.InjectiveAddress,
+		IsDefaultGuildMember: v.IsDefaultGuildMember,
+		Since:                v.Since,
+		Params:               v.Params,
+	}
+	if v.GuildID != nil {
+		res.GuildId = *v.GuildID
+	}
+	if v.SubaccountIds != nil {
+		res.SubaccountIds = make([]string, len(v.SubaccountIds))
+		for i, val := range v.SubaccountIds {
+			res.SubaccountIds[i] = val
+		}
+	}
+
+	return res
+}
+
+// protobufGuildsServicepbGuildMemberToGuildsserviceGuildMember builds a value
+// of type *guildsservice.GuildMember from a value of type
+// *guilds_servicepb.GuildMember.
+func protobufGuildsServicepbGuildMemberToGuildsserviceGuildMember(v *guilds_servicepb.GuildMember) *guildsservice.GuildMember {
+	if v == nil {
+		return nil
+	}
+	res := &guildsservice.GuildMember{
+		InjectiveAddress:     v.InjectiveAddress,
+		IsDefaultGuildMember: v.IsDefaultGuildMember,
+		Since:                v.Since,
+		Params:               v.Params,
+	}
+	if v.GuildId != "" {
+		res.GuildID = &v.GuildId
+	}
+	if v.SubaccountIds != nil {
+		res.SubaccountIds = make([]string, len(v.SubaccountIds))
+		for i, val := range v.SubaccountIds {
+			res.SubaccountIds[i] = val
+		}
+	}
+
+	return res
+}
+
+// svcGuildsserviceSingleAccountPortfolioToGuildsServicepbSingleAccountPortfolio
+// builds a value of type *guilds_servicepb.SingleAccountPortfolio from a value
+// of type *guildsservice.SingleAccountPortfolio.
+func svcGuildsserviceSingleAccountPortfolioToGuildsServicepbSingleAccountPortfolio(v *guildsservice.SingleAccountPortfolio) *guilds_servicepb.SingleAccountPortfolio {
+	if v == nil {
+		return nil
+	}
+	res := &guilds_servicepb.SingleAccountPortfolio{
+		InjectiveAddress: v.InjectiveAddress,
+		UpdatedAt:        v.UpdatedAt,
+	}
+	if v.ValueUsd != nil {
+		res.ValueUsd = *v.ValueUsd
+	}
+	if v.NetFlowUsd != nil {
+		res.NetFlowUsd = *v.NetFlowUsd
+	}
+	if v.Twr != nil {
+		res.Twr = *v.Twr
+	}
+	if v.Mwr != nil {
+		res.Mwr = *v.Mwr
+	}
+	if v.GuildID != nil {
+		res.GuildId = *v.GuildID
+	}
+	if v.Balances != nil {
+		res.Balances = make([]*guilds_servicepb.Balance, len(v.Balances))
+		for i, val := range v.Balances {
+			res.Balances[i] = &guilds_servicepb.Balance{
+				Denom:            val.Denom,
+				TotalBalance:     val.TotalBalance,
+				AvailableBalance: val.AvailableBalance,
+				UnrealizedPnl:    val.UnrealizedPnl,
+				MarginHold:       val.MarginHold,
+				PriceUsd:         val.PriceUsd,
+			}
+		}
+	}
+	if v.CashFlows != nil {
+		res.CashFlows = make([]*guilds_servicepb.CashFlow, len(v.CashFlows))
+		for i, val := range v.CashFlows {
+			res.CashFlows[i] = &guilds_servicepb.CashFlow{
+				Type:       val.Type,
+				Denom:      val.Denom,
+				Amount:     val.Amount,
+				ExecutedAt: val.ExecutedAt,
+			}
+			if val.InjectiveAddress != nil {
+				res.CashFlows[i].InjectiveAddress = *val.InjectiveAddress
+			}
+		}
+	}
+
+	return res
+}
+
+// protobufGuildsServicepbSingleAccountPortfolioToGuildsserviceSingleAccountPortfolio
+// builds a value of type *guildsservice.SingleAccountPortfolio from a value of
+// type *guilds_servicepb.SingleAccountPortfolio.
+func protobufGuildsServicepbSingleAccountPortfolioToGuildsserviceSingleAccountPortfolio(v *guilds_servicepb.SingleAccountPortfolio) *guildsservice.SingleAccountPortfolio {
+	if v == nil {
+		return nil
+	}
+	res := &guildsservice.SingleAccountPortfolio{
+		InjectiveAddress: v.InjectiveAddress,
+		UpdatedAt:        v.UpdatedAt,
+	}
+	if v.ValueUsd != 0 {
+		res.ValueUsd = &v.ValueUsd
+	}
+	if v.NetFlowUsd != 0 {
+		res.NetFlowUsd = &v.NetFlowUsd
+	}
+	if v.Twr != 0 {
+		res.Twr = &v.Twr
+	}
+	if v.Mwr != 0 {
+		res.Mwr = &v.Mwr
+	}
+	if v.GuildId != "" {
+		res.GuildID = &v.GuildId
+	}
+	if v.Balances != nil {
+		res.Balances = make([]*guildsservice.Balance, len(v.Balances))
+		for i, val := range v.Balances {
+			res.Balances[i] = &guildsservice.Balance{
+				Denom:            val.Denom,
+				TotalBalance:     val.TotalBalance,
+				AvailableBalance: val.AvailableBalance,
+				UnrealizedPnl:    val.UnrealizedPnl,
+				MarginHold:       val.MarginHold,
+				PriceUsd:         val.PriceUsd,
+			}
+		}
+	}
+	if v.CashFlows != nil {
+		res.CashFlows = make([]*guildsservice.CashFlow, len(v.CashFlows))
+		for i, val := range v.CashFlows {
+			res.CashFlows[i] = &guildsservice.CashFlow{
+				Type:       val.Type,
+				Denom:      val.Denom,
+				Amount:     val.Amount,
+				ExecutedAt: val.ExecutedAt,
+			}
+			if val.InjectiveAddress != "" {
+				res.CashFlows[i].InjectiveAddress = &val.InjectiveAddress
+			}
+		}
+	}
+
+	return res
+}
+
+// svcGuildsserviceGuildRiskToGuildsServicepbGuildRisk builds a value of type
+// *guilds_servicepb.GuildRisk from a value of type *guildsservice.GuildRisk.
+func svcGuildsserviceGuildRiskToGuildsServicepbGuildRisk(v *guildsservice.GuildRisk) *guilds_servicepb.GuildRisk {
+	if v == nil {
+		return nil
+	}
+	res := &guilds_servicepb.GuildRisk{
+		GuildId:       v.GuildID,
+		WindowStart:   v.WindowStart,
+		SnapshotCount: int32(v.SnapshotCount),
+		MaxDrawdown:   v.MaxDrawdown,
+		Volatility:    v.Volatility,
+		SharpeRatio:   v.SharpeRatio,
+		NotionalUsd:   v.NotionalUsd,
+		EquityUsd:     v.EquityUsd,
+		Leverage:      v.Leverage,
+		UpdatedAt:     v.UpdatedAt,
+	}
+	if v.Markets != nil {
+		res.Markets = make([]*guilds_servicepb.MarketExposure, len(v.Markets))
+		for i, val := range v.Markets {
+			res.Markets[i] = &guilds_servicepb.MarketExposure{
+				MarketId:    val.MarketID,
+				Ticker:      val.Ticker,
+				NotionalUsd: val.NotionalUsd,
+				Share:       val.Share,
+			}
+		}
+	}
+
+	return res
+}
+
+// protobufGuildsServicepbGuildRiskToGuildsserviceGuildRisk builds a value of
+// type *guildsservice.GuildRisk from a value of type
+// *guilds_servicepb.GuildRisk.
+func protobufGuildsServicepbGuildRiskToGuildsserviceGuildRisk(v *guilds_servicepb.GuildRisk) *guildsservice.GuildRisk {
+	if v == nil {
+		return nil
+	}
+	res := &guildsservice.GuildRisk{
+		GuildID:       v.GuildId,
+		WindowStart:   v.WindowStart,
+		SnapshotCount: int(v.SnapshotCount),
+		MaxDrawdown:   v.MaxDrawdown,
+		Volatility:    v.Volatility,
+		SharpeRatio:   v.SharpeRatio,
+		NotionalUsd:   v.NotionalUsd,
+		EquityUsd:     v.EquityUsd,
+		Leverage:      v.Leverage,
+		UpdatedAt:     v.UpdatedAt,
+	}
+	if v.Markets != nil {
+		res.Markets = make([]*guildsservice.MarketExposure, len(v.Markets))
+		for i, val := range v.Markets {
+			res.Markets[i] = &guildsservice.MarketExposure{
+				MarketID:    val.MarketId,
+				Ticker:      val.Ticker,
+				NotionalUsd: val.NotionalUsd,
+				Share:       val.Share,
+			}
+		}
+	}
+
+	return res
+}
+
+// svcGuildsserviceStreamPortfolioToGuildsServicepbStreamPortfolio builds a
+// value of type *guilds_servicepb.StreamPortfolio from a value of type
+// *guildsservice.StreamPortfolio.
+func svcGuildsserviceStreamPortfolioToGuildsServicepbStreamPortfolio(v *guildsservice.StreamPortfolio) *guilds_servicepb.StreamPortfolio {
+	if v == nil {
+		return nil
+	}
+	res := &guilds_servicepb.StreamPortfolio{
+		UpdatedAt: v.UpdatedAt,
+	}
+	if v.MemberCount != nil {
+		res.MemberCount = int32(*v.MemberCount)
+	}
+	if v.Balances != nil {
+		res.Balances = make([]*guilds_servicepb.Balance, len(v.Balances))
+		for i, val := range v.Balances {
+			res.Balances[i] = &guilds_servicepb.Balance{
+				Denom:            val.Denom,
+				TotalBalance:     val.TotalBalance,
+				AvailableBalance: val.AvailableBalance,
+				UnrealizedPnl:    val.UnrealizedPnl,
+				MarginHold:       val.MarginHold,
+				PriceUsd:         val.PriceUsd,
+			}
+		}
+	}
+
+	return res
+}
+
+// svcGuildsserviceStreamMembershipToGuildsServicepbStreamMembership builds a
+// value of type *guilds_servicepb.StreamMembership from a value of type
+// *guildsservice.StreamMembership.
+func svcGuildsserviceStreamMembershipToGuildsServicepbStreamMembership(v *guildsservice.StreamMembership) *guilds_servicepb.StreamMembership {
+	if v == nil {
+		return nil
+	}
+	res := &guilds_servicepb.StreamMembership{
+		IsDefaultGuildMember: v.IsDefaultGuildMember,
+		Since:                v.Since,
+	}
+	if v.EndedAt != nil {
+		res.EndedAt = *v.EndedAt
+	}
+
+	return res
+}
+
+// protobufGuildsServicepbStreamPortfolioToGuildsserviceStreamPortfolio builds
+// a value of type *guildsservice.StreamPortfolio from a value of type
+// *guilds_servicepb.StreamPortfolio.
+func protobufGuildsServicepbStreamPortfolioToGuildsserviceStreamPortfolio(v *guilds_servicepb.StreamPortfolio) *guildsservice.StreamPortfolio {
+	if v == nil {
+		return nil
+	}
+	res := &guildsservice.StreamPortfolio{
+		UpdatedAt: v.UpdatedAt,
+	}
+	if v.MemberCount != 0 {
+		memberCountptr := int(v.MemberCount)
+		res.MemberCount = &memberCountptr
+	}
+	if v.Balances != nil {
+		res.Balances = make([]*guildsservice.Balance, len(v.Balances))
+		for i, val := range v.Balances {
+			res.Balances[i] = &guildsservice.Balance{
+				Denom:            val.Denom,
+				TotalBalance:     val.TotalBalance,
+				AvailableBalance: val.AvailableBalance,
+				UnrealizedPnl:    val.UnrealizedPnl,
+				MarginHold:       val.MarginHold,
+				PriceUsd:         val.PriceUsd,
+			}
+		}
+	}
+
+	return res
+}
+
+// protobufGuildsServicepbStreamMembershipToGuildsserviceStreamMembership
+// builds a value of type *guildsservice.StreamMembership from a value of type
+// *guilds_servicepb.StreamMembership.
+func protobufGuildsServicepbStreamMembershipToGuildsserviceStreamMembership(v *guilds_servicepb.StreamMembership) *guildsservice.StreamMembership {
+	if v == nil {
+		return nil
+	}
+	res := &guildsservice.StreamMembership{
+		IsDefaultGuildMember: v.IsDefaultGuildMember,
+		Since:                v.Since,
+	}
+	if v.EndedAt != 0 {
+		res.EndedAt = &v.EndedAt
+	}
+
+	return res
+}
@@ -0,0 +1,1053 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: goadesign_goagen_guilds_service.proto
+
+package guilds_servicepb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// GuildsServiceClient is the client API for GuildsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GuildsServiceClient interface {
+	// Get all guilds
+	GetAllGuilds(ctx context.Context, in *GetAllGuildsRequest, opts ...grpc.CallOption) (*GetAllGuildsResponse, error)
+	// Get a single guild base on ID
+	GetSingleGuild(ctx context.Context, in *GetSingleGuildRequest, opts ...grpc.CallOption) (*GetSingleGuildResponse, error)
+	// Get all members a given guild (include default member)
+	GetGuildMembers(ctx context.Context, in *GetGuildMembersRequest, opts ...grpc.CallOption) (*GetGuildMembersResponse, error)
+	// Get master address of given guild
+	GetGuildMasterAddress(ctx context.Context, in *GetGuildMasterAddressRequest, opts ...grpc.CallOption) (*GetGuildMasterAddressResponse, error)
+	// Get default guild member
+	GetGuildDefaultMember(ctx context.Context, in *GetGuildDefaultMemberRequest, opts ...grpc.CallOption) (*GetGuildDefaultMemberResponse, error)
+	// Enter the guild
+	EnterGuild(ctx context.Context, in *EnterGuildRequest, opts ...grpc.CallOption) (*EnterGuildResponse, error)
+	// Get waitlist of a guild at capacity, head of the queue first
+	GetGuildWaitlist(ctx context.Context, in *GetGuildWaitlistRequest, opts ...grpc.CallOption) (*GetGuildWaitlistResponse, error)
+	// Leave the guild, guildID
+	LeaveGuild(ctx context.Context, in *LeaveGuildRequest, opts ...grpc.CallOption) (*LeaveGuildResponse, error)
+	// Move a member to another guild without leaving, grants to the new guild
+	// master are required
+	TransferMember(ctx context.Context, in *TransferMemberRequest, opts ...grpc.CallOption) (*TransferMemberResponse, error)
+	// Get the guild markets
+	GetGuildMarkets(ctx context.Context, in *GetGuildMarketsRequest, opts ...grpc.CallOption) (*GetGuildMarketsResponse, error)
+	// Get the guild markets
+	GetGuildPortfolios(ctx context.Context, in *GetGuildPortfoliosRequest, opts ...grpc.CallOption) (*GetGuildPortfoliosResponse, error)
+	// Get current account member status
+	GetAccountInfo(ctx context.Context, in *GetAccountInfoRequest, opts ...grpc.CallOption) (*GetAccountInfoResponse, error)
+	// Get current account portfolio snapshot
+	GetAccountPortfolio(ctx context.Context, in *GetAccountPortfolioRequest, opts ...grpc.CallOption) (*GetAccountPortfolioResponse, error)
+	// Get current account portfolios snapshots all the time
+	GetAccountPortfolios(ctx context.Context, in *GetAccountPortfoliosRequest, opts ...grpc.CallOption) (*GetAccountPortfoliosResponse, error)
+	// Get current and past guild memberships of an account, latest first
+	GetAccountMemberships(ctx context.Context, in *GetAccountMembershipsRequest, opts ...grpc.CallOption) (*GetAccountMembershipsResponse, error)
+	// Get current account portfolios monthly snapshots, including start_time,
+	// end_time snapshots
+	GetAccountMonthlyPortfolios(ctx context.Context, in *GetAccountMonthlyPortfoliosRequest, opts ...grpc.CallOption) (*GetAccountMonthlyPortfoliosResponse, error)
+	// Get required grants of a member with their expiration
+	GetAccountGrants(ctx context.Context, in *GetAccountGrantsRequest, opts ...grpc.CallOption) (*GetAccountGrantsResponse, error)
+	// Get ingested trades of a member, newest first
+	GetAccountTrades(ctx context.Context, in *GetAccountTradesRequest, opts ...grpc.CallOption) (*GetAccountTradesResponse, error)
+	// Get realized pnl, fee totals and volume of a member
+	GetAccountTradeStats(ctx context.Context, in *GetAccountTradeStatsRequest, opts ...grpc.CallOption) (*GetAccountTradeStatsResponse, error)
+	// Get realized pnl, fee totals and volume of a guild
+	GetGuildTradeStats(ctx context.Context, in *GetGuildTradeStatsRequest, opts ...grpc.CallOption) (*GetGuildTradeStatsResponse, error)
+	// Get fees routed to guild master address from member trades, computed daily
+	GetGuildFees(ctx context.Context, in *GetGuildFeesRequest, opts ...grpc.CallOption) (*GetGuildFeesResponse, error)
+	// Get ingested deposits and withdrawals of a member, newest first
+	GetAccountTransfers(ctx context.Context, in *GetAccountTransfersRequest, opts ...grpc.CallOption) (*GetAccountTransfersResponse, error)
+	// Get derivative positions of latest account portfolio snapshot
+	GetAccountPositions(ctx context.Context, in *GetAccountPositionsRequest, opts ...grpc.CallOption) (*GetAccountPositionsResponse, error)
+	// Get latest risk metrics of a guild
+	GetGuildRisk(ctx context.Context, in *GetGuildRiskRequest, opts ...grpc.CallOption) (*GetGuildRiskResponse, error)
+	// Stream portfolio snapshots and membership changes as they are written, gRPC
+	// only
+	StreamPortfolioUpdates(ctx context.Context, in *StreamPortfolioUpdatesRequest, opts ...grpc.CallOption) (GuildsService_StreamPortfolioUpdatesClient, error)
+}
+
+type guildsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGuildsServiceClient(cc grpc.ClientConnInterface) GuildsServiceClient {
+	return &guildsServiceClient{cc}
+}
+
+func (c *guildsServiceClient) GetAllGuilds(ctx context.Context, in *GetAllGuildsRequest, opts ...grpc.CallOption) (*GetAllGuildsResponse, error) {
+	out := new(GetAllGuildsResponse)
+	err := c.cc.Invoke(ctx, "/guilds_service.GuildsService/GetAllGuilds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildsServiceClient) GetSingleGuild(ctx context.Context, in *GetSingleGuildRequest, opts ...grpc.CallOption) (*GetSingleGuildResponse, error) {
+	out := new(GetSingleGuildResponse)
+	err := c.cc.Invoke(ctx, "/guilds_service.GuildsService/GetSingleGuild", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildsServiceClient) GetGuildMembers(ctx context.Context, in *GetGuildMembersRequest, opts ...grpc.CallOption) (*GetGuildMembersResponse, error) {
+	out := new(GetGuildMembersResponse)
+	err := c.cc.Invoke(ctx, "/guilds_service.GuildsService/GetGuildMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildsServiceClient) GetGuildMasterAddress(ctx context.Context, in *GetGuildMasterAddressRequest, opts ...grpc.CallOption) (*GetGuildMasterAddressResponse, error) {
+	out := new(GetGuildMasterAddressResponse)
+	err := c.cc.Invoke(ctx, "/guilds_service.GuildsService/GetGuildMasterAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildsServiceClient) GetGuildDefaultMember(ctx context.Context, in *GetGuildDefaultMemberRequest, opts ...grpc.CallOption) (*GetGuildDefaultMemberResponse, error) {
+	out := new(GetGuildDefaultMemberResponse)
+	err := c.cc.Invoke(ctx, "/guilds_service.GuildsService/GetGuildDefaultMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildsServiceClient) EnterGuild(ctx context.Context, in *EnterGuildRequest, opts ...grpc.CallOption) (*EnterGuildResponse, error) {
+	out := new(EnterGuildResponse)
+	err := c.cc.Invoke(ctx, "/guilds_service.GuildsService/EnterGuild", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildsServiceClient) GetGuildWaitlist(ctx context.Context, in *GetGuildWaitlistRequest, opts ...grpc.CallOption) (*GetGuildWaitlistResponse, error) {
+	out := new(GetGuildWaitlistResponse)
+	err := c.cc.Invoke(ctx, "/guilds_service.GuildsService/GetGuildWaitlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildsServiceClient) LeaveGuild(ctx context.Context, in *LeaveGuildRequest, opts ...grpc.CallOption) (*LeaveGuildResponse, error) {
+	out := new(LeaveGuildResponse)
+	err := c.cc.Invoke(ctx, "/guilds_service.GuildsService/LeaveGuild", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildsServiceClient) TransferMember(ctx context.Context, in *TransferMemberRequest, opts ...grpc.CallOption) (*TransferMemberResponse, error) {
+	out := new(TransferMemberResponse)
+	err := c.cc.Invoke(ctx, "/guilds_service.GuildsService/TransferMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildsServiceClient) GetGuildMarkets(ctx context.Context, in *GetGuildMarketsRequest, opts ...grpc.CallOption) (*GetGuildMarketsResponse, error) {
+	out := new(GetGuildMarketsResponse)
+	err := c.cc.Invoke(ctx, "/guilds_service.GuildsService/GetGuildMarkets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildsServiceClient) GetGuildPortfolios(ctx context.Context, in *GetGuildPortfoliosRequest, opts ...grpc.CallOption) (*GetGuildPortfoliosResponse, error) {
+	out := new(GetGuildPortfoliosResponse)
+	err := c.cc.Invoke(ctx, "/guilds_service.GuildsService/GetGuildPortfolios", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildsServiceClient) GetAccountInfo(ctx context.Context, in *GetAccountInfoRequest, opts ...grpc.CallOption) (*GetAccountInfoResponse, error) {
+	out := new(GetAccountInfoResponse)
+	err := c.cc.Invoke(ctx, "/guilds_service.GuildsService/GetAccountInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildsServiceClient) GetAccountPortfolio(ctx context.Context, in *GetAccountPortfolioRequest, opts ...grpc.CallOption) (*GetAccountPortfolioResponse, error) {
+	out := new(GetAccountPortfolioResponse)
+	err := c.cc.Invoke(ctx, "/guilds_service.GuildsService/GetAccountPortfolio", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildsServiceClient) GetAccountPortfolios(ctx context.Context, in *GetAccountPortfoliosRequest, opts ...grpc.CallOption) (*GetAccountPortfoliosResponse, error) {
+	out := new(GetAccountPortfoliosResponse)
+	err := c.cc.Invoke(ctx, "/guilds_service.GuildsService/GetAccountPortfolios", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildsServiceClient) GetAccountMemberships(ctx context.Context, in *GetAccountMembershipsRequest, opts ...grpc.CallOption) (*GetAccountMembershipsResponse, error) {
+	out := new(GetAccountMembershipsResponse)
+	err := c.cc.Invoke(ctx, "/guilds_service.GuildsService/GetAccountMemberships", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildsServiceClient) GetAccountMonthlyPortfolios(ctx context.Context, in *GetAccountMonthlyPortfoliosRequest, opts ...grpc.CallOption) (*GetAccountMonthlyPortfoliosResponse, error) {
+	out := new(GetAccountMonthlyPortfoliosResponse)
+	err := c.cc.Invoke(ctx, "/guilds_service.GuildsService/GetAccountMonthlyPortfolios", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildsServiceClient) GetAccountGrants(ctx context.Context, in *GetAccountGrantsRequest, opts ...grpc.CallOption) (*GetAccountGrantsResponse, error) {
+	out := new(GetAccountGrantsResponse)
+	err := c.cc.Invoke(ctx, "/guilds_service.GuildsService/GetAccountGrants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildsServiceClient) GetAccountTrades(ctx context.Context, in *GetAccountTradesRequest, opts ...grpc.CallOption) (*GetAccountTradesResponse, error) {
+	out := new(GetAccountTradesResponse)
+	err := c.cc.Invoke(ctx, "/guilds_service.GuildsService/GetAccountTrades", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildsServiceClient) GetAccountTradeStats(ctx context.Context, in *GetAccountTradeStatsRequest, opts ...grpc.CallOption) (*GetAccountTradeStatsResponse, error) {
+	out := new(GetAccountTradeStatsResponse)
+	err := c.cc.Invoke(ctx, "/guilds_service.GuildsService/GetAccountTradeStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildsServiceClient) GetGuildTradeStats(ctx context.Context, in *GetGuildTradeStatsRequest, opts ...grpc.CallOption) (*GetGuildTradeStatsResponse, error) {
+	out := new(GetGuildTradeStatsResponse)
+	err := c.cc.Invoke(ctx, "/guilds_service.GuildsService/GetGuildTradeStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildsServiceClient) GetGuildFees(ctx context.Context, in *GetGuildFeesRequest, opts ...grpc.CallOption) (*GetGuildFeesResponse, error) {
+	out := new(GetGuildFeesResponse)
+	err := c.cc.Invoke(ctx, "/guilds_service.GuildsService/GetGuildFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildsServiceClient) GetAccountTransfers(ctx context.Context, in *GetAccountTransfersRequest, opts ...grpc.CallOption) (*GetAccountTransfersResponse, error) {
+	out := new(GetAccountTransfersResponse)
+	err := c.cc.Invoke(ctx, "/guilds_service.GuildsService/GetAccountTransfers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildsServiceClient) GetAccountPositions(ctx context.Context, in *GetAccountPositionsRequest, opts ...grpc.CallOption) (*GetAccountPositionsResponse, error) {
+	out := new(GetAccountPositionsResponse)
+	err := c.cc.Invoke(ctx, "/guilds_service.GuildsService/GetAccountPositions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildsServiceClient) GetGuildRisk(ctx context.Context, in *GetGuildRiskRequest, opts ...grpc.CallOption) (*GetGuildRiskResponse, error) {
+	out := new(GetGuildRiskResponse)
+	err := c.cc.Invoke(ctx, "/guilds_service.GuildsService/GetGuildRisk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildsServiceClient) StreamPortfolioUpdates(ctx context.Context, in *StreamPortfolioUpdatesRequest, opts ...grpc.CallOption) (GuildsService_StreamPortfolioUpdatesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GuildsService_ServiceDesc.Streams[0], "/guilds_service.GuildsService/StreamPortfolioUpdates", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &guildsServiceStreamPortfolioUpdatesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GuildsService_StreamPortfolioUpdatesClient interface {
+	Recv() (*StreamPortfolioUpdatesResponse, error)
+	grpc.ClientStream
+}
+
+type guildsServiceStreamPortfolioUpdatesClient struct {
+	grpc.ClientStream
+}
+
+func (x *guildsServiceStreamPortfolioUpdatesClient) Recv() (*StreamPortfolioUpdatesResponse, error) {
+	m := new(StreamPortfolioUpdatesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// GuildsServiceServer is the server API for GuildsService service.
+// All implementations must embed UnimplementedGuildsServiceServer
+// for forward compatibility
+type GuildsServiceServer interface {
+	// Get all guilds
+	GetAllGuilds(context.Context, *GetAllGuildsRequest) (*GetAllGuildsResponse, error)
+	// Get a single guild base on ID
+	GetSingleGuild(context.Context, *GetSingleGuildRequest) (*GetSingleGuildResponse, error)
+	// Get all members a given guild (include default member)
+	GetGuildMembers(context.Context, *GetGuildMembersRequest) (*GetGuildMembersResponse, error)
+	// Get master address of given guild
+	GetGuildMasterAddress(context.Context, *GetGuildMasterAddressRequest) (*GetGuildMasterAddressResponse, error)
+	// Get default guild member
+	GetGuildDefaultMember(context.Context, *GetGuildDefaultMemberRequest) (*GetGuildDefaultMemberResponse, error)
+	// Enter the guild
+	EnterGuild(context.Context, *EnterGuildRequest) (*EnterGuildResponse, error)
+	// Get waitlist of a guild at capacity, head of the queue first
+	GetGuildWaitlist(context.Context, *GetGuildWaitlistRequest) (*GetGuildWaitlistResponse, error)
+	// Leave the guild, guildID
+	LeaveGuild(context.Context, *LeaveGuildRequest) (*LeaveGuildResponse, error)
+	// Move a member to another guild without leaving, grants to the new guild
+	// master are required
+	TransferMember(context.Context, *TransferMemberRequest) (*TransferMemberResponse, error)
+	// Get the guild markets
+	GetGuildMarkets(context.Context, *GetGuildMarketsRequest) (*GetGuildMarketsResponse, error)
+	// Get the guild markets
+	GetGuildPortfolios(context.Context, *GetGuildPortfoliosRequest) (*GetGuildPortfoliosResponse, error)
+	// Get current account member status
+	GetAccountInfo(context.Context, *GetAccountInfoRequest) (*GetAccountInfoResponse, error)
+	// Get current account portfolio snapshot
+	GetAccountPortfolio(context.Context, *GetAccountPortfolioRequest) (*GetAccountPortfolioResponse, error)
+	// Get current account portfolios snapshots all the time
+	GetAccountPortfolios(context.Context, *GetAccountPortfoliosRequest) (*GetAccountPortfoliosResponse, error)
+	// Get current and past guild memberships of an account, latest first
+	GetAccountMemberships(context.Context, *GetAccountMembershipsRequest) (*GetAccountMembershipsResponse, error)
+	// Get current account portfolios monthly snapshots, including start_time,
+	// end_time snapshots
+	GetAccountMonthlyPortfolios(context.Context, *GetAccountMonthlyPortfoliosRequest) (*GetAccountMonthlyPortfoliosResponse, error)
+	// Get required grants of a member with their expiration
+	GetAccountGrants(context.Context, *GetAccountGrantsRequest) (*GetAccountGrantsResponse, error)
+	// Get ingested trades of a member, newest first
+	GetAccountTrades(context.Context, *GetAccountTradesRequest) (*GetAccountTradesResponse, error)
+	// Get realized pnl, fee totals and volume of a member
+	GetAccountTradeStats(context.Context, *GetAccountTradeStatsRequest) (*GetAccountTradeStatsResponse, error)
+	// Get realized pnl, fee totals and volume of a guild
+	GetGuildTradeStats(context.Context, *GetGuildTradeStatsRequest) (*GetGuildTradeStatsResponse, error)
+	// Get fees routed to guild master address from member trades, computed daily
+	GetGuildFees(context.Context, *GetGuildFeesRequest) (*GetGuildFeesResponse, error)
+	// Get ingested deposits and withdrawals of a member, newest first
+	GetAccountTransfers(context.Context, *GetAccountTransfersRequest) (*GetAccountTransfersResponse, error)
+	// Get derivative positions of latest account portfolio snapshot
+	GetAccountPositions(context.Context, *GetAccountPositionsRequest) (*GetAccountPositionsResponse, error)
+	// Get latest risk metrics of a guild
+	GetGuildRisk(context.Context, *GetGuildRiskRequest) (*GetGuildRiskResponse, error)
+	// Stream portfolio snapshots and membership changes as they are written, gRPC
+	// only
+	StreamPortfolioUpdates(*StreamPortfolioUpdatesRequest, GuildsService_StreamPortfolioUpdatesServer) error
+	mustEmbedUnimplementedGuildsServiceServer()
+}
+
+// UnimplementedGuildsServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedGuildsServiceServer struct {
+}
+
+func (UnimplementedGuildsServiceServer) GetAllGuilds(context.Context, *GetAllGuildsRequest) (*GetAllGuildsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllGuilds not implemented")
+}
+func (UnimplementedGuildsServiceServer) GetSingleGuild(context.Context, *GetSingleGuildRequest) (*GetSingleGuildResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSingleGuild not implemented")
+}
+func (UnimplementedGuildsServiceServer) GetGuildMembers(context.Context, *GetGuildMembersRequest) (*GetGuildMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGuildMembers not implemented")
+}
+func (UnimplementedGuildsServiceServer) GetGuildMasterAddress(context.Context, *GetGuildMasterAddressRequest) (*GetGuildMasterAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGuildMasterAddress not implemented")
+}
+func (UnimplementedGuildsServiceServer) GetGuildDefaultMember(context.Context, *GetGuildDefaultMemberRequest) (*GetGuildDefaultMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGuildDefaultMember not implemented")
+}
+func (UnimplementedGuildsServiceServer) EnterGuild(context.Context, *EnterGuildRequest) (*EnterGuildResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnterGuild not implemented")
+}
+func (UnimplementedGuildsServiceServer) GetGuildWaitlist(context.Context, *GetGuildWaitlistRequest) (*GetGuildWaitlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGuildWaitlist not implemented")
+}
+func (UnimplementedGuildsServiceServer) LeaveGuild(context.Context, *LeaveGuildRequest) (*LeaveGuildResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveGuild not implemented")
+}
+func (UnimplementedGuildsServiceServer) TransferMember(context.Context, *TransferMemberRequest) (*TransferMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferMember not implemented")
+}
+func (UnimplementedGuildsServiceServer) GetGuildMarkets(context.Context, *GetGuildMarketsRequest) (*GetGuildMarketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGuildMarkets not implemented")
+}
+func (UnimplementedGuildsServiceServer) GetGuildPortfolios(context.Context, *GetGuildPortfoliosRequest) (*GetGuildPortfoliosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGuildPortfolios not implemented")
+}
+func (UnimplementedGuildsServiceServer) GetAccountInfo(context.Context, *GetAccountInfoRequest) (*GetAccountInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountInfo not implemented")
+}
+func (UnimplementedGuildsServiceServer) GetAccountPortfolio(context.Context, *GetAccountPortfolioRequest) (*GetAccountPortfolioResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountPortfolio not implemented")
+}
+func (UnimplementedGuildsServiceServer) GetAccountPortfolios(context.Context, *GetAccountPortfoliosRequest) (*GetAccountPortfoliosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountPortfolios not implemented")
+}
+func (UnimplementedGuildsServiceServer) GetAccountMemberships(context.Context, *GetAccountMembershipsRequest) (*GetAccountMembershipsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountMemberships not implemented")
+}
+func (UnimplementedGuildsServiceServer) GetAccountMonthlyPortfolios(context.Context, *GetAccountMonthlyPortfoliosRequest) (*GetAccountMonthlyPortfoliosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountMonthlyPortfolios not implemented")
+}
+func (UnimplementedGuildsServiceServer) GetAccountGrants(context.Context, *GetAccountGrantsRequest) (*GetAccountGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountGrants not implemented")
+}
+func (UnimplementedGuildsServiceServer) GetAccountTrades(context.Context, *GetAccountTradesRequest) (*GetAccountTradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountTrades not implemented")
+}
+func (UnimplementedGuildsServiceServer) GetAccountTradeStats(context.Context, *GetAccountTradeStatsRequest) (*GetAccountTradeStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountTradeStats not implemented")
+}
+func (UnimplementedGuildsServiceServer) GetGuildTradeStats(context.Context, *GetGuildTradeStatsRequest) (*GetGuildTradeStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGuildTradeStats not implemented")
+}
+func (UnimplementedGuildsServiceServer) GetGuildFees(context.Context, *GetGuildFeesRequest) (*GetGuildFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGuildFees not implemented")
+}
+func (UnimplementedGuildsServiceServer) GetAccountTransfers(context.Context, *GetAccountTransfersRequest) (*GetAccountTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountTransfers not implemented")
+}
+func (UnimplementedGuildsServiceServer) GetAccountPositions(context.Context, *GetAccountPositionsRequest) (*GetAccountPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountPositions not implemented")
+}
+func (UnimplementedGuildsServiceServer) GetGuildRisk(context.Context, *GetGuildRiskRequest) (*GetGuildRiskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGuildRisk not implemented")
+}
+func (UnimplementedGuildsServiceServer) StreamPortfolioUpdates(*StreamPortfolioUpdatesRequest, GuildsService_StreamPortfolioUpdatesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamPortfolioUpdates not implemented")
+}
+func (UnimplementedGuildsServiceServer) mustEmbedUnimplementedGuildsServiceServer() {}
+
+// UnsafeGuildsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GuildsServiceServer will
+// result in compilation errors.
+type UnsafeGuildsServiceServer interface {
+	mustEmbedUnimplementedGuildsServiceServer()
+}
+
+func RegisterGuildsServiceServer(s grpc.ServiceRegistrar, srv GuildsServiceServer) {
+	s.RegisterService(&GuildsService_ServiceDesc, srv)
+}
+
+func _GuildsService_GetAllGuilds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllGuildsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildsServiceServer).GetAllGuilds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/guilds_service.GuildsService/GetAllGuilds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildsServiceServer).GetAllGuilds(ctx, req.(*GetAllGuildsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuildsService_GetSingleGuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSingleGuildRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildsServiceServer).GetSingleGuild(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/guilds_service.GuildsService/GetSingleGuild",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildsServiceServer).GetSingleGuild(ctx, req.(*GetSingleGuildRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuildsService_GetGuildMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGuildMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildsServiceServer).GetGuildMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/guilds_service.GuildsService/GetGuildMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildsServiceServer).GetGuildMembers(ctx, req.(*GetGuildMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuildsService_GetGuildMasterAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGuildMasterAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildsServiceServer).GetGuildMasterAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/guilds_service.GuildsService/GetGuildMasterAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildsServiceServer).GetGuildMasterAddress(ctx, req.(*GetGuildMasterAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuildsService_GetGuildDefaultMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGuildDefaultMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildsServiceServer).GetGuildDefaultMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/guilds_service.GuildsService/GetGuildDefaultMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildsServiceServer).GetGuildDefaultMember(ctx, req.(*GetGuildDefaultMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuildsService_EnterGuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnterGuildRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildsServiceServer).EnterGuild(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/guilds_service.GuildsService/EnterGuild",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildsServiceServer).EnterGuild(ctx, req.(*EnterGuildRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuildsService_GetGuildWaitlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGuildWaitlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildsServiceServer).GetGuildWaitlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/guilds_service.GuildsService/GetGuildWaitlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildsServiceServer).GetGuildWaitlist(ctx, req.(*GetGuildWaitlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuildsService_LeaveGuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveGuildRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildsServiceServer).LeaveGuild(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/guilds_service.GuildsService/LeaveGuild",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildsServiceServer).LeaveGuild(ctx, req.(*LeaveGuildRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuildsService_TransferMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildsServiceServer).TransferMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/guilds_service.GuildsService/TransferMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildsServiceServer).TransferMember(ctx, req.(*TransferMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuildsService_GetGuildMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGuildMarketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildsServiceServer).GetGuildMarkets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/guilds_service.GuildsService/GetGuildMarkets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildsServiceServer).GetGuildMarkets(ctx, req.(*GetGuildMarketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuildsService_GetGuildPortfolios_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGuildPortfoliosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildsServiceServer).GetGuildPortfolios(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/guilds_service.GuildsService/GetGuildPortfolios",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildsServiceServer).GetGuildPortfolios(ctx, req.(*GetGuildPortfoliosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuildsService_GetAccountInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildsServiceServer).GetAccountInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/guilds_service.GuildsService/GetAccountInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildsServiceServer).GetAccountInfo(ctx, req.(*GetAccountInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuildsService_GetAccountPortfolio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountPortfolioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildsServiceServer).GetAccountPortfolio(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/guilds_service.GuildsService/GetAccountPortfolio",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildsServiceServer).GetAccountPortfolio(ctx, req.(*GetAccountPortfolioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuildsService_GetAccountPortfolios_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountPortfoliosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildsServiceServer).GetAccountPortfolios(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/guilds_service.GuildsService/GetAccountPortfolios",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildsServiceServer).GetAccountPortfolios(ctx, req.(*GetAccountPortfoliosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuildsService_GetAccountMemberships_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountMembershipsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildsServiceServer).GetAccountMemberships(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/guilds_service.GuildsService/GetAccountMemberships",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildsServiceServer).GetAccountMemberships(ctx, req.(*GetAccountMembershipsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuildsService_GetAccountMonthlyPortfolios_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountMonthlyPortfoliosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildsServiceServer).GetAccountMonthlyPortfolios(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/guilds_service.GuildsService/GetAccountMonthlyPortfolios",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildsServiceServer).GetAccountMonthlyPortfolios(ctx, req.(*GetAccountMonthlyPortfoliosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuildsService_GetAccountGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountGrantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildsServiceServer).GetAccountGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/guilds_service.GuildsService/GetAccountGrants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildsServiceServer).GetAccountGrants(ctx, req.(*GetAccountGrantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuildsService_GetAccountTrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountTradesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildsServiceServer).GetAccountTrades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/guilds_service.GuildsService/GetAccountTrades",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildsServiceServer).GetAccountTrades(ctx, req.(*GetAccountTradesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuildsService_GetAccountTradeStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountTradeStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildsServiceServer).GetAccountTradeStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/guilds_service.GuildsService/GetAccountTradeStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildsServiceServer).GetAccountTradeStats(ctx, req.(*GetAccountTradeStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuildsService_GetGuildTradeStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGuildTradeStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildsServiceServer).GetGuildTradeStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/guilds_service.GuildsService/GetGuildTradeStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildsServiceServer).GetGuildTradeStats(ctx, req.(*GetGuildTradeStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuildsService_GetGuildFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGuildFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildsServiceServer).GetGuildFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/guilds_service.GuildsService/GetGuildFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildsServiceServer).GetGuildFees(ctx, req.(*GetGuildFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuildsService_GetAccountTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildsServiceServer).GetAccountTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/guilds_service.GuildsService/GetAccountTransfers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildsServiceServer).GetAccountTransfers(ctx, req.(*GetAccountTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuildsService_GetAccountPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountPositionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildsServiceServer).GetAccountPositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/guilds_service.GuildsService/GetAccountPositions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildsServiceServer).GetAccountPositions(ctx, req.(*GetAccountPositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuildsService_GetGuildRisk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGuildRiskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildsServiceServer).GetGuildRisk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/guilds_service.GuildsService/GetGuildRisk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildsServiceServer).GetGuildRisk(ctx, req.(*GetGuildRiskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuildsService_StreamPortfolioUpdates_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamPortfolioUpdatesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GuildsServiceServer).StreamPortfolioUpdates(m, &guildsServiceStreamPortfolioUpdatesServer{stream})
+}
+
+type GuildsService_StreamPortfolioUpdatesServer interface {
+	Send(*StreamPortfolioUpdatesResponse) error
+	grpc.ServerStream
+}
+
+type guildsServiceStreamPortfolioUpdatesServer struct {
+	grpc.ServerStream
+}
+
+func (x *guildsServiceStreamPortfolioUpdatesServer) Send(m *StreamPortfolioUpdatesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// GuildsService_ServiceDesc is the grpc.ServiceDesc for GuildsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GuildsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "guilds_service.GuildsService",
+	HandlerType: (*GuildsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAllGuilds",
+			Handler:    _GuildsService_GetAllGuilds_Handler,
+		},
+		{
+			MethodName: "GetSingleGuild",
+			Handler:    _GuildsService_GetSingleGuild_Handler,
+		},
+		{
+			MethodName: "GetGuildMembers",
+			Handler:    _GuildsService_GetGuildMembers_Handler,
+		},
+		{
+			MethodName: "GetGuildMasterAddress",
+			Handler:    _GuildsService_GetGuildMasterAddress_Handler,
+		},
+		{
+			MethodName: "GetGuildDefaultMember",
+			Handler:    _GuildsService_GetGuildDefaultMember_Handler,
+		},
+		{
+			MethodName: "EnterGuild",
+			Handler:    _GuildsService_EnterGuild_Handler,
+		},
+		{
+			MethodName: "GetGuildWaitlist",
+			Handler:    _GuildsService_GetGuildWaitlist_Handler,
+		},
+		{
+			MethodName: "LeaveGuild",
+			Handler:    _GuildsService_LeaveGuild_Handler,
+		},
+		{
+			MethodName: "TransferMember",
+			Handler:    _GuildsService_TransferMember_Handler,
+		},
+		{
+			MethodName: "GetGuildMarkets",
+			Handler:    _GuildsService_GetGuildMarkets_Handler,
+		},
+		{
+			MethodName: "GetGuildPortfolios",
+			Handler:    _GuildsService_GetGuildPortfolios_Handler,
+		},
+		{
+			MethodName: "GetAccountInfo",
+			Handler:    _GuildsService_GetAccountInfo_Handler,
+		},
+		{
+			MethodName: "GetAccountPortfolio",
+			Handler:    _GuildsService_GetAccountPortfolio_Handler,
+		},
+		{
+			MethodName: "GetAccountPortfolios",
+			Handler:    _GuildsService_GetAccountPortfolios_Handler,
+		},
+		{
+			MethodName: "GetAccountMemberships",
+			Handler:    _GuildsService_GetAccountMemberships_Handler,
+		},
+		{
+			MethodName: "GetAccountMonthlyPortfolios",
+			Handler:    _GuildsService_GetAccountMonthlyPortfolios_Handler,
+		},
+		{
+			MethodName: "GetAccountGrants",
+			Handler:    _GuildsService_GetAccountGrants_Handler,
+		},
+		{
+			MethodName: "GetAccountTrades",
+			Handler:    _GuildsService_GetAccountTrades_Handler,
+		},
+		{
+			MethodName: "GetAccountTradeStats",
+			Handler:    _GuildsService_GetAccountTradeStats_Handler,
+		},
+		{
+			MethodName: "GetGuildTradeStats",
+			Handler:    _GuildsService_GetGuildTradeStats_Handler,
+		},
+		{
+			MethodName: "GetGuildFees",
+			Handler:    _GuildsService_GetGuildFees_Handler,
+		},
+		{
+			MethodName: "GetAccountTransfers",
+			Handler:    _GuildsService_GetAccountTransfers_Handler,
+		},
+		{
+			MethodName: "GetAccountPositions",
+			Handler:    _GuildsService_GetAccountPositions_Handler,
+		},
+		{
+			MethodName: "GetGuildRisk",
+			Handler:    _GuildsService_GetGuildRisk_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamPortfolioUpdates",
+			Handler:       _GuildsService_StreamPortfolioUpdates_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "goadesign_goagen_guilds_service.proto",
+}
@@ -0,0 +1,767 @@
+// Code generated by goa v3.6.2, DO NOT EDIT.
+//
+// GuildsService gRPC server encoders and decoders
+//
+// Command:
+// $ goa gen github.com/InjectiveLabs/injective-guilds-service/api/design -o ../
+
+package server
+
+import (
+	"context"
+
+	guilds_servicepb "github.com/InjectiveLabs/injective-guilds-service/api/gen/grpc/guilds_service/pb"
+	guildsservice "github.com/InjectiveLabs/injective-guilds-service/api/gen/guilds_service"
+	goagrpc "goa.design/goa/v3/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// EncodeGetAllGuildsResponse encodes responses from the "GuildsService"
+// service "GetAllGuilds" endpoint.
+func EncodeGetAllGuildsResponse(ctx context.Context, v interface{}, hdr, trlr *metadata.MD) (interface{}, error) {
+	result, ok := v.(*guildsservice.GetAllGuildsResult)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetAllGuilds", "*guildsservice.GetAllGuildsResult", v)
+	}
+	resp := NewGetAllGuildsResponse(result)
+	return resp, nil
+}
+
+// DecodeGetAllGuildsRequest decodes requests sent to "GuildsService" service
+// "GetAllGuilds" endpoint.
+func DecodeGetAllGuildsRequest(ctx context.Context, v interface{}, md metadata.MD) (interface{}, error) {
+	var (
+		message *guilds_servicepb.GetAllGuildsRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*guilds_servicepb.GetAllGuildsRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("GuildsService", "GetAllGuilds", "*guilds_servicepb.GetAllGuildsRequest", v)
+		}
+	}
+	var payload *guildsservice.GetAllGuildsPayload
+	{
+		payload = NewGetAllGuildsPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeGetSingleGuildResponse encodes responses from the "GuildsService"
+// service "GetSingleGuild" endpoint.
+func EncodeGetSingleGuildResponse(ctx context.Context, v interface{}, hdr, trlr *metadata.MD) (interface{}, error) {
+	result, ok := v.(*guildsservice.GetSingleGuildResult)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetSingleGuild", "*guildsservice.GetSingleGuildResult", v)
+	}
+	resp := NewGetSingleGuildResponse(result)
+	return resp, nil
+}
+
+// DecodeGetSingleGuildRequest decodes requests sent to "GuildsService" service
+// "GetSingleGuild" endpoint.
+func DecodeGetSingleGuildRequest(ctx context.Context, v interface{}, md metadata.MD) (interface{}, error) {
+	var (
+		message *guilds_servicepb.GetSingleGuildRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*guilds_servicepb.GetSingleGuildRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("GuildsService", "GetSingleGuild", "*guilds_servicepb.GetSingleGuildRequest", v)
+		}
+	}
+	var payload *guildsservice.GetSingleGuildPayload
+	{
+		payload = NewGetSingleGuildPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeGetGuildMembersResponse encodes responses from the "GuildsService"
+// service "GetGuildMembers" endpoint.
+func EncodeGetGuildMembersResponse(ctx context.Context, v interface{}, hdr, trlr *metadata.MD) (interface{}, error) {
+	result, ok := v.(*guildsservice.GetGuildMembersResult)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetGuildMembers", "*guildsservice.GetGuildMembersResult", v)
+	}
+	resp := NewGetGuildMembersResponse(result)
+	return resp, nil
+}
+
+// DecodeGetGuildMembersRequest decodes requests sent to "GuildsService"
+// service "GetGuildMembers" endpoint.
+func DecodeGetGuildMembersRequest(ctx context.Context, v interface{}, md metadata.MD) (interface{}, error) {
+	var (
+		message *guilds_servicepb.GetGuildMembersRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*guilds_servicepb.GetGuildMembersRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("GuildsService", "GetGuildMembers", "*guilds_servicepb.GetGuildMembersRequest", v)
+		}
+	}
+	var payload *guildsservice.GetGuildMembersPayload
+	{
+		payload = NewGetGuildMembersPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeGetGuildMasterAddressResponse encodes responses from the
+// "GuildsService" service "GetGuildMasterAddress" endpoint.
+func EncodeGetGuildMasterAddressResponse(ctx context.Context, v interface{}, hdr, trlr *metadata.MD) (interface{}, error) {
+	result, ok := v.(*guildsservice.GetGuildMasterAddressResult)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetGuildMasterAddress", "*guildsservice.GetGuildMasterAddressResult", v)
+	}
+	resp := NewGetGuildMasterAddressResponse(result)
+	return resp, nil
+}
+
+// DecodeGetGuildMasterAddressRequest decodes requests sent to "GuildsService"
+// service "GetGuildMasterAddress" endpoint.
+func DecodeGetGuildMasterAddressRequest(ctx context.Context, v interface{}, md metadata.MD) (interface{}, error) {
+	var (
+		message *guilds_servicepb.GetGuildMasterAddressRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*guilds_servicepb.GetGuildMasterAddressRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("GuildsService", "GetGuildMasterAddress", "*guilds_servicepb.GetGuildMasterAddressRequest", v)
+		}
+	}
+	var payload *guildsservice.GetGuildMasterAddressPayload
+	{
+		payload = NewGetGuildMasterAddressPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeGetGuildDefaultMemberResponse encodes responses from the
+// "GuildsService" service "GetGuildDefaultMember" endpoint.
+func EncodeGetGuildDefaultMemberResponse(ctx context.Context, v interface{}, hdr, trlr *metadata.MD) (interface{}, error) {
+	result, ok := v.(*guildsservice.GetGuildDefaultMemberResult)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetGuildDefaultMember", "*guildsservice.GetGuildDefaultMemberResult", v)
+	}
+	resp := NewGetGuildDefaultMemberResponse(result)
+	return resp, nil
+}
+
+// DecodeGetGuildDefaultMemberRequest decodes requests sent to "GuildsService"
+// service "GetGuildDefaultMember" endpoint.
+func DecodeGetGuildDefaultMemberRequest(ctx context.Context, v interface{}, md metadata.MD) (interface{}, error) {
+	var (
+		message *guilds_servicepb.GetGuildDefaultMemberRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*guilds_servicepb.GetGuildDefaultMemberRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("GuildsService", "GetGuildDefaultMember", "*guilds_servicepb.GetGuildDefaultMemberRequest", v)
+		}
+	}
+	var payload *guildsservice.GetGuildDefaultMemberPayload
+	{
+		payload = NewGetGuildDefaultMemberPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeEnterGuildResponse encodes responses from the "GuildsService" service
+// "EnterGuild" endpoint.
+func EncodeEnterGuildResponse(ctx context.Context, v interface{}, hdr, trlr *metadata.MD) (interface{}, error) {
+	result, ok := v.(*guildsservice.EnterGuildResult)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "EnterGuild", "*guildsservice.EnterGuildResult", v)
+	}
+	resp := NewEnterGuildResponse(result)
+	return resp, nil
+}
+
+// DecodeEnterGuildRequest decodes requests sent to "GuildsService" service
+// "EnterGuild" endpoint.
+func DecodeEnterGuildRequest(ctx context.Context, v interface{}, md metadata.MD) (interface{}, error) {
+	var (
+		message *guilds_servicepb.EnterGuildRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*guilds_servicepb.EnterGuildRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("GuildsService", "EnterGuild", "*guilds_servicepb.EnterGuildRequest", v)
+		}
+	}
+	var payload *guildsservice.EnterGuildPayload
+	{
+		payload = NewEnterGuildPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeGetGuildWaitlistResponse encodes responses from the "GuildsService"
+// service "GetGuildWaitlist" endpoint.
+func EncodeGetGuildWaitlistResponse(ctx context.Context, v interface{}, hdr, trlr *metadata.MD) (interface{}, error) {
+	result, ok := v.(*guildsservice.GetGuildWaitlistResult)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetGuildWaitlist", "*guildsservice.GetGuildWaitlistResult", v)
+	}
+	resp := NewGetGuildWaitlistResponse(result)
+	return resp, nil
+}
+
+// DecodeGetGuildWaitlistRequest decodes requests sent to "GuildsService"
+// service "GetGuildWaitlist" endpoint.
+func DecodeGetGuildWaitlistRequest(ctx context.Context, v interface{}, md metadata.MD) (interface{}, error) {
+	var (
+		message *guilds_servicepb.GetGuildWaitlistRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*guilds_servicepb.GetGuildWaitlistRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("GuildsService", "GetGuildWaitlist", "*guilds_servicepb.GetGuildWaitlistRequest", v)
+		}
+	}
+	var payload *guildsservice.GetGuildWaitlistPayload
+	{
+		payload = NewGetGuildWaitlistPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeLeaveGuildResponse encodes responses from the "GuildsService" service
+// "LeaveGuild" endpoint.
+func EncodeLeaveGuildResponse(ctx context.Context, v interface{}, hdr, trlr *metadata.MD) (interface{}, error) {
+	result, ok := v.(*guildsservice.LeaveGuildResult)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "LeaveGuild", "*guildsservice.LeaveGuildResult", v)
+	}
+	resp := NewLeaveGuildResponse(result)
+	return resp, nil
+}
+
+// DecodeLeaveGuildRequest decodes requests sent to "GuildsService" service
+// "LeaveGuild" endpoint.
+func DecodeLeaveGuildRequest(ctx context.Context, v interface{}, md metadata.MD) (interface{}, error) {
+	var (
+		message *guilds_servicepb.LeaveGuildRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*guilds_servicepb.LeaveGuildRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("GuildsService", "LeaveGuild", "*guilds_servicepb.LeaveGuildRequest", v)
+		}
+	}
+	var payload *guildsservice.LeaveGuildPayload
+	{
+		payload = NewLeaveGuildPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeTransferMemberResponse encodes responses from the "GuildsService"
+// service "TransferMember" endpoint.
+func EncodeTransferMemberResponse(ctx context.Context, v interface{}, hdr, trlr *metadata.MD) (interface{}, error) {
+	result, ok := v.(*guildsservice.TransferMemberResult)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "TransferMember", "*guildsservice.TransferMemberResult", v)
+	}
+	resp := NewTransferMemberResponse(result)
+	return resp, nil
+}
+
+// DecodeTransferMemberRequest decodes requests sent to "GuildsService" service
+// "TransferMember" endpoint.
+func DecodeTransferMemberRequest(ctx context.Context, v interface{}, md metadata.MD) (interface{}, error) {
+	var (
+		message *guilds_servicepb.TransferMemberRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*guilds_servicepb.TransferMemberRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("GuildsService", "TransferMember", "*guilds_servicepb.TransferMemberRequest", v)
+		}
+	}
+	var payload *guildsservice.TransferMemberPayload
+	{
+		payload = NewTransferMemberPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeGetGuildMarketsResponse encodes responses from the "GuildsService"
+// service "GetGuildMarkets" endpoint.
+func EncodeGetGuildMarketsResponse(ctx context.Context, v interface{}, hdr, trlr *metadata.MD) (interface{}, error) {
+	result, ok := v.(*guildsservice.GetGuildMarketsResult)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetGuildMarkets", "*guildsservice.GetGuildMarketsResult", v)
+	}
+	resp := NewGetGuildMarketsResponse(result)
+	return resp, nil
+}
+
+// DecodeGetGuildMarketsRequest decodes requests sent to "GuildsService"
+// service "GetGuildMarkets" endpoint.
+func DecodeGetGuildMarketsRequest(ctx context.Context, v interface{}, md metadata.MD) (interface{}, error) {
+	var (
+		message *guilds_servicepb.GetGuildMarketsRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*guilds_servicepb.GetGuildMarketsRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("GuildsService", "GetGuildMarkets", "*guilds_servicepb.GetGuildMarketsRequest", v)
+		}
+	}
+	var payload *guildsservice.GetGuildMarketsPayload
+	{
+		payload = NewGetGuildMarketsPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeGetGuildPortfoliosResponse encodes responses from the "GuildsService"
+// service "GetGuildPortfolios" endpoint.
+func EncodeGetGuildPortfoliosResponse(ctx context.Context, v interface{}, hdr, trlr *metadata.MD) (interface{}, error) {
+	result, ok := v.(*guildsservice.GetGuildPortfoliosResult)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetGuildPortfolios", "*guildsservice.GetGuildPortfoliosResult", v)
+	}
+	resp := NewGetGuildPortfoliosResponse(result)
+	return resp, nil
+}
+
+// DecodeGetGuildPortfoliosRequest decodes requests sent to "GuildsService"
+// service "GetGuildPortfolios" endpoint.
+func DecodeGetGuildPortfoliosRequest(ctx context.Context, v interface{}, md metadata.MD) (interface{}, error) {
+	var (
+		message *guilds_servicepb.GetGuildPortfoliosRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*guilds_servicepb.GetGuildPortfoliosRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("GuildsService", "GetGuildPortfolios", "*guilds_servicepb.GetGuildPortfoliosRequest", v)
+		}
+	}
+	var payload *guildsservice.GetGuildPortfoliosPayload
+	{
+		payload = NewGetGuildPortfoliosPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeGetAccountInfoResponse encodes responses from the "GuildsService"
+// service "GetAccountInfo" endpoint.
+func EncodeGetAccountInfoResponse(ctx context.Context, v interface{}, hdr, trlr *metadata.MD) (interface{}, error) {
+	result, ok := v.(*guildsservice.GetAccountInfoResult)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetAccountInfo", "*guildsservice.GetAccountInfoResult", v)
+	}
+	resp := NewGetAccountInfoResponse(result)
+	return resp, nil
+}
+
+// DecodeGetAccountInfoRequest decodes requests sent to "GuildsService" service
+// "GetAccountInfo" endpoint.
+func DecodeGetAccountInfoRequest(ctx context.Context, v interface{}, md metadata.MD) (interface{}, error) {
+	var (
+		message *guilds_servicepb.GetAccountInfoRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*guilds_servicepb.GetAccountInfoRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("GuildsService", "GetAccountInfo", "*guilds_servicepb.GetAccountInfoRequest", v)
+		}
+	}
+	var payload *guildsservice.GetAccountInfoPayload
+	{
+		payload = NewGetAccountInfoPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeGetAccountPortfolioResponse encodes responses from the "GuildsService"
+// service "GetAccountPortfolio" endpoint.
+func EncodeGetAccountPortfolioResponse(ctx context.Context, v interface{}, hdr, trlr *metadata.MD) (interface{}, error) {
+	result, ok := v.(*guildsservice.GetAccountPortfolioResult)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetAccountPortfolio", "*guildsservice.GetAccountPortfolioResult", v)
+	}
+	resp := NewGetAccountPortfolioResponse(result)
+	return resp, nil
+}
+
+// DecodeGetAccountPortfolioRequest decodes requests sent to "GuildsService"
+// service "GetAccountPortfolio" endpoint.
+func DecodeGetAccountPortfolioRequest(ctx context.Context, v interface{}, md metadata.MD) (interface{}, error) {
+	var (
+		message *guilds_servicepb.GetAccountPortfolioRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*guilds_servicepb.GetAccountPortfolioRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("GuildsService", "GetAccountPortfolio", "*guilds_servicepb.GetAccountPortfolioRequest", v)
+		}
+	}
+	var payload *guildsservice.GetAccountPortfolioPayload
+	{
+		payload = NewGetAccountPortfolioPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeGetAccountPortfoliosResponse encodes responses from the
+// "GuildsService" service "GetAccountPortfolios" endpoint.
+func EncodeGetAccountPortfoliosResponse(ctx context.Context, v interface{}, hdr, trlr *metadata.MD) (interface{}, error) {
+	result, ok := v.(*guildsservice.GetAccountPortfoliosResult)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetAccountPortfolios", "*guildsservice.GetAccountPortfoliosResult", v)
+	}
+	resp := NewGetAccountPortfoliosResponse(result)
+	return resp, nil
+}
+
+// DecodeGetAccountPortfoliosRequest decodes requests sent to "GuildsService"
+// service "GetAccountPortfolios" endpoint.
+func DecodeGetAccountPortfoliosRequest(ctx context.Context, v interface{}, md metadata.MD) (interface{}, error) {
+	var (
+		message *guilds_servicepb.GetAccountPortfoliosRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*guilds_servicepb.GetAccountPortfoliosRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("GuildsService", "GetAccountPortfolios", "*guilds_servicepb.GetAccountPortfoliosRequest", v)
+		}
+	}
+	var payload *guildsservice.GetAccountPortfoliosPayload
+	{
+		payload = NewGetAccountPortfoliosPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeGetAccountMembershipsResponse encodes responses from the
+// "GuildsService" service "GetAccountMemberships" endpoint.
+func EncodeGetAccountMembershipsResponse(ctx context.Context, v interface{}, hdr, trlr *metadata.MD) (interface{}, error) {
+	result, ok := v.(*guildsservice.GetAccountMembershipsResult)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetAccountMemberships", "*guildsservice.GetAccountMembershipsResult", v)
+	}
+	resp := NewGetAccountMembershipsResponse(result)
+	return resp, nil
+}
+
+// DecodeGetAccountMembershipsRequest decodes requests sent to "GuildsService"
+// service "GetAccountMemberships" endpoint.
+func DecodeGetAccountMembershipsRequest(ctx context.Context, v interface{}, md metadata.MD) (interface{}, error) {
+	var (
+		message *guilds_servicepb.GetAccountMembershipsRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*guilds_servicepb.GetAccountMembershipsRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("GuildsService", "GetAccountMemberships", "*guilds_servicepb.GetAccountMembershipsRequest", v)
+		}
+	}
+	var payload *guildsservice.GetAccountMembershipsPayload
+	{
+		payload = NewGetAccountMembershipsPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeGetAccountMonthlyPortfoliosResponse encodes responses from the
+// "GuildsService" service "GetAccountMonthlyPortfolios" endpoint.
+func EncodeGetAccountMonthlyPortfoliosResponse(ctx context.Context, v interface{}, hdr, trlr *metadata.MD) (interface{}, error) {
+	result, ok := v.(*guildsservice.GetAccountMonthlyPortfoliosResult)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetAccountMonthlyPortfolios", "*guildsservice.GetAccountMonthlyPortfoliosResult", v)
+	}
+	resp := NewGetAccountMonthlyPortfoliosResponse(result)
+	return resp, nil
+}
+
+// DecodeGetAccountMonthlyPortfoliosRequest decodes requests sent to
+// "GuildsService" service "GetAccountMonthlyPortfolios" endpoint.
+func DecodeGetAccountMonthlyPortfoliosRequest(ctx context.Context, v interface{}, md metadata.MD) (interface{}, error) {
+	var (
+		message *guilds_servicepb.GetAccountMonthlyPortfoliosRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*guilds_servicepb.GetAccountMonthlyPortfoliosRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("GuildsService", "GetAccountMonthlyPortfolios", "*guilds_servicepb.GetAccountMonthlyPortfoliosRequest", v)
+		}
+	}
+	var payload *guildsservice.GetAccountMonthlyPortfoliosPayload
+	{
+		payload = NewGetAccountMonthlyPortfoliosPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeGetAccountGrantsResponse encodes responses from the "GuildsService"
+// service "GetAccountGrants" endpoint.
+func EncodeGetAccountGrantsResponse(ctx context.Context, v interface{}, hdr, trlr *metadata.MD) (interface{}, error) {
+	result, ok := v.(*guildsservice.GetAccountGrantsResult)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetAccountGrants", "*guildsservice.GetAccountGrantsResult", v)
+	}
+	resp := NewGetAccountGrantsResponse(result)
+	return resp, nil
+}
+
+// DecodeGetAccountGrantsRequest decodes requests sent to "GuildsService"
+// service "GetAccountGrants" endpoint.
+func DecodeGetAccountGrantsRequest(ctx context.Context, v interface{}, md metadata.MD) (interface{}, error) {
+	var (
+		message *guilds_servicepb.GetAccountGrantsRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*guilds_servicepb.GetAccountGrantsRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("GuildsService", "GetAccountGrants", "*guilds_servicepb.GetAccountGrantsRequest", v)
+		}
+	}
+	var payload *guildsservice.GetAccountGrantsPayload
+	{
+		payload = NewGetAccountGrantsPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeGetAccountTradesResponse encodes responses from the "GuildsService"
+// service "GetAccountTrades" endpoint.
+func EncodeGetAccountTradesResponse(ctx context.Context, v interface{}, hdr, trlr *metadata.MD) (interface{}, error) {
+	result, ok := v.(*guildsservice.GetAccountTradesResult)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetAccountTrades", "*guildsservice.GetAccountTradesResult", v)
+	}
+	resp := NewGetAccountTradesResponse(result)
+	return resp, nil
+}
+
+// DecodeGetAccountTradesRequest decodes requests sent to "GuildsService"
+// service "GetAccountTrades" endpoint.
+func DecodeGetAccountTradesRequest(ctx context.Context, v interface{}, md metadata.MD) (interface{}, error) {
+	var (
+		message *guilds_servicepb.GetAccountTradesRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*guilds_servicepb.GetAccountTradesRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("GuildsService", "GetAccountTrades", "*guilds_servicepb.GetAccountTradesRequest", v)
+		}
+	}
+	var payload *guildsservice.GetAccountTradesPayload
+	{
+		payload = NewGetAccountTradesPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeGetAccountTradeStatsResponse encodes responses from the
+// "GuildsService" service "GetAccountTradeStats" endpoint.
+func EncodeGetAccountTradeStatsResponse(ctx context.Context, v interface{}, hdr, trlr *metadata.MD) (interface{}, error) {
+	result, ok := v.(*guildsservice.GetAccountTradeStatsResult)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetAccountTradeStats", "*guildsservice.GetAccountTradeStatsResult", v)
+	}
+	resp := NewGetAccountTradeStatsResponse(result)
+	return resp, nil
+}
+
+// DecodeGetAccountTradeStatsRequest decodes requests sent to "GuildsService"
+// service "GetAccountTradeStats" endpoint.
+func DecodeGetAccountTradeStatsRequest(ctx context.Context, v interface{}, md metadata.MD) (interface{}, error) {
+	var (
+		message *guilds_servicepb.GetAccountTradeStatsRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*guilds_servicepb.GetAccountTradeStatsRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("GuildsService", "GetAccountTradeStats", "*guilds_servicepb.GetAccountTradeStatsRequest", v)
+		}
+	}
+	var payload *guildsservice.GetAccountTradeStatsPayload
+	{
+		payload = NewGetAccountTradeStatsPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeGetGuildTradeStatsResponse encodes responses from the "GuildsService"
+// service "GetGuildTradeStats" endpoint.
+func EncodeGetGuildTradeStatsResponse(ctx context.Context, v interface{}, hdr, trlr *metadata.MD) (interface{}, error) {
+	result, ok := v.(*guildsservice.GetGuildTradeStatsResult)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetGuildTradeStats", "*guildsservice.GetGuildTradeStatsResult", v)
+	}
+	resp := NewGetGuildTradeStatsResponse(result)
+	return resp, nil
+}
+
+// DecodeGetGuildTradeStatsRequest decodes requests sent to "GuildsService"
+// service "GetGuildTradeStats" endpoint.
+func DecodeGetGuildTradeStatsRequest(ctx context.Context, v interface{}, md metadata.MD) (interface{}, error) {
+	var (
+		message *guilds_servicepb.GetGuildTradeStatsRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*guilds_servicepb.GetGuildTradeStatsRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("GuildsService", "GetGuildTradeStats", "*guilds_servicepb.GetGuildTradeStatsRequest", v)
+		}
+	}
+	var payload *guildsservice.GetGuildTradeStatsPayload
+	{
+		payload = NewGetGuildTradeStatsPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeGetGuildFeesResponse encodes responses from the "GuildsService"
+// service "GetGuildFees" endpoint.
+func EncodeGetGuildFeesResponse(ctx context.Context, v interface{}, hdr, trlr *metadata.MD) (interface{}, error) {
+	result, ok := v.(*guildsservice.GetGuildFeesResult)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetGuildFees", "*guildsservice.GetGuildFeesResult", v)
+	}
+	resp := NewGetGuildFeesResponse(result)
+	return resp, nil
+}
+
+// DecodeGetGuildFeesRequest decodes requests sent to "GuildsService" service
+// "GetGuildFees" endpoint.
+func DecodeGetGuildFeesRequest(ctx context.Context, v interface{}, md metadata.MD) (interface{}, error) {
+	var (
+		message *guilds_servicepb.GetGuildFeesRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*guilds_servicepb.GetGuildFeesRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("GuildsService", "GetGuildFees", "*guilds_servicepb.GetGuildFeesRequest", v)
+		}
+	}
+	var payload *guildsservice.GetGuildFeesPayload
+	{
+		payload = NewGetGuildFeesPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeGetAccountTransfersResponse encodes responses from the "GuildsService"
+// service "GetAccountTransfers" endpoint.
+func EncodeGetAccountTransfersResponse(ctx context.Context, v interface{}, hdr, trlr *metadata.MD) (interface{}, error) {
+	result, ok := v.(*guildsservice.GetAccountTransfersResult)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetAccountTransfers", "*guildsservice.GetAccountTransfersResult", v)
+	}
+	resp := NewGetAccountTransfersResponse(result)
+	return resp, nil
+}
+
+// DecodeGetAccountTransfersRequest decodes requests sent to "GuildsService"
+// service "GetAccountTransfers" endpoint.
+func DecodeGetAccountTransfersRequest(ctx context.Context, v interface{}, md metadata.MD) (interface{}, error) {
+	var (
+		message *guilds_servicepb.GetAccountTransfersRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*guilds_servicepb.GetAccountTransfersRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("GuildsService", "GetAccountTransfers", "*guilds_servicepb.GetAccountTransfersRequest", v)
+		}
+	}
+	var payload *guildsservice.GetAccountTransfersPayload
+	{
+		payload = NewGetAccountTransfersPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeGetAccountPositionsResponse encodes responses from the "GuildsService"
+// service "GetAccountPositions" endpoint.
+func EncodeGetAccountPositionsResponse(ctx context.Context, v interface{}, hdr, trlr *metadata.MD) (interface{}, error) {
+	result, ok := v.(*guildsservice.GetAccountPositionsResult)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetAccountPositions", "*guildsservice.GetAccountPositionsResult", v)
+	}
+	resp := NewGetAccountPositionsResponse(result)
+	return resp, nil
+}
+
+// DecodeGetAccountPositionsRequest decodes requests sent to "GuildsService"
+// service "GetAccountPositions" endpoint.
+func DecodeGetAccountPositionsRequest(ctx context.Context, v interface{}, md metadata.MD) (interface{}, error) {
+	var (
+		message *guilds_servicepb.GetAccountPositionsRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*guilds_servicepb.GetAccountPositionsRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("GuildsService", "GetAccountPositions", "*guilds_servicepb.GetAccountPositionsRequest", v)
+		}
+	}
+	var payload *guildsservice.GetAccountPositionsPayload
+	{
+		payload = NewGetAccountPositionsPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeGetGuildRiskResponse encodes responses from the "GuildsService"
+// service "GetGuildRisk" endpoint.
+func EncodeGetGuildRiskResponse(ctx context.Context, v interface{}, hdr, trlr *metadata.MD) (interface{}, error) {
+	result, ok := v.(*guildsservice.GetGuildRiskResult)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "GetGuildRisk", "*guildsservice.GetGuildRiskResult", v)
+	}
+	resp := NewGetGuildRiskResponse(result)
+	return resp, nil
+}
+
+// DecodeGetGuildRiskRequest decodes requests sent to "GuildsService" service
+// "GetGuildRisk" endpoint.
+func DecodeGetGuildRiskRequest(ctx context.Context, v interface{}, md metadata.MD) (interface{}, error) {
+	var (
+		message *guilds_servicepb.GetGuildRiskRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*guilds_servicepb.GetGuildRiskRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("GuildsService", "GetGuildRisk", "*guilds_servicepb.GetGuildRiskRequest", v)
+		}
+	}
+	var payload *guildsservice.GetGuildRiskPayload
+	{
+		payload = NewGetGuildRiskPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeStreamPortfolioUpdatesResponse encodes responses from the
+// "GuildsService" service "StreamPortfolioUpdates" endpoint.
+func EncodeStreamPortfolioUpdatesResponse(ctx context.Context, v interface{}, hdr, trlr *metadata.MD) (interface{}, error) {
+	result, ok := v.(*guildsservice.PortfolioUpdate)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("GuildsService", "StreamPortfolioUpdates", "*guildsservice.PortfolioUpdate", v)
+	}
+	resp := NewStreamPortfolioUpdatesResponse(result)
+	return resp, nil
+}
+
+// DecodeStreamPortfolioUpdatesRequest decodes requests sent to "GuildsService"
+// service "StreamPortfolioUpdates" endpoint.
+func DecodeStreamPortfolioUpdatesRequest(ctx context.Context, v interface{}, md metadata.MD) (interface{}, error) {
+	var (
+		message *guilds_servicepb.StreamPortfolioUpdatesRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*guilds_servicepb.StreamPortfolioUpdatesRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("GuildsService", "StreamPortfolioUpdates", "*guilds_servicepb.StreamPortfolioUpdatesRequest", v)
+		}
+	}
+	var payload *guildsservice.StreamPortfolioUpdatesPayload
+	{
+		payload = NewStreamPortfolioUpdatesPayload(message)
+	}
+	return payload, nil
+}
@@ -0,0 +1,1080 @@
+// Code generated by goa v3.6.2, DO NOT EDIT.
+//
+// GuildsService gRPC server
+//
+// Command:
+// $ goa gen github.com/InjectiveLabs/injective-guilds-service/api/design -o ../
+
+package server
+
+import (
+	"context"
+	"errors"
+
+	guilds_servicepb "github.com/InjectiveLabs/injective-guilds-service/api/gen/grpc/guilds_service/pb"
+	guildsservice "github.com/InjectiveLabs/injective-guilds-service/api/gen/guilds_service"
+	goagrpc "goa.design/goa/v3/grpc"
+	goa "goa.design/goa/v3/pkg"
+	"google.golang.org/grpc/codes"
+)
+
+// Server implements the guilds_servicepb.GuildsServiceServer interface.
+type Server struct {
+	GetAllGuildsH                goagrpc.UnaryHandler
+	GetSingleGuildH              goagrpc.UnaryHandler
+	GetGuildMembersH             goagrpc.UnaryHandler
+	GetGuildMasterAddressH       goagrpc.UnaryHandler
+	GetGuildDefaultMemberH       goagrpc.UnaryHandler
+	EnterGuildH                  goagrpc.UnaryHandler
+	GetGuildWaitlistH            goagrpc.UnaryHandler
+	LeaveGuildH                  goagrpc.UnaryHandler
+	TransferMemberH              goagrpc.UnaryHandler
+	GetGuildMarketsH             goagrpc.UnaryHandler
+	GetGuildPortfoliosH          goagrpc.UnaryHandler
+	GetAccountInfoH              goagrpc.UnaryHandler
+	GetAccountPortfolioH         goagrpc.UnaryHandler
+	GetAccountPortfoliosH        goagrpc.UnaryHandler
+	GetAccountMembershipsH       goagrpc.UnaryHandler
+	GetAccountMonthlyPortfoliosH goagrpc.UnaryHandler
+	GetAccountGrantsH            goagrpc.UnaryHandler
+	GetAccountTradesH            goagrpc.UnaryHandler
+	GetAccountTradeStatsH        goagrpc.UnaryHandler
+	GetGuildTradeStatsH          goagrpc.UnaryHandler
+	GetGuildFeesH                goagrpc.UnaryHandler
+	GetAccountTransfersH         goagrpc.UnaryHandler
+	GetAccountPositionsH         goagrpc.UnaryHandler
+	GetGuildRiskH                goagrpc.UnaryHandler
+	StreamPortfolioUpdatesH      goagrpc.StreamHandler
+	guilds_servicepb.UnimplementedGuildsServiceServer
+}
+
+// ErrorNamer is an interface implemented by generated error structs that
+// exposes the name of the error as defined in the expr.
+type ErrorNamer interface {
+	ErrorName() string
+}
+
+// StreamPortfolioUpdatesServerStream implements the
+// guildsservice.StreamPortfolioUpdatesServerStream interface.
+type StreamPortfolioUpdatesServerStream struct {
+	stream guilds_servicepb.GuildsService_StreamPortfolioUpdatesServer
+}
+
+// New instantiates the server struct with the GuildsService service endpoints.
+func New(e *guildsservice.Endpoints, uh goagrpc.UnaryHandler, sh goagrpc.StreamHandler) *Server {
+	return &Server{
+		GetAllGuildsH:                NewGetAllGuildsHandler(e.GetAllGuilds, uh),
+		GetSingleGuildH:              NewGetSingleGuildHandler(e.GetSingleGuild, uh),
+		GetGuildMembersH:             NewGetGuildMembersHandler(e.GetGuildMembers, uh),
+		GetGuildMasterAddressH:       NewGetGuildMasterAddressHandler(e.GetGuildMasterAddress, uh),
+		GetGuildDefaultMemberH:       NewGetGuildDefaultMemberHandler(e.GetGuildDefaultMember, uh),
+		EnterGuildH:                  NewEnterGuildHandler(e.EnterGuild, uh),
+		GetGuildWaitlistH:            NewGetGuildWaitlistHandler(e.GetGuildWaitlist, uh),
+		LeaveGuildH:                  NewLeaveGuildHandler(e.LeaveGuild, uh),
+		TransferMemberH:              NewTransferMemberHandler(e.TransferMember, uh),
+		GetGuildMarketsH:             NewGetGuildMarketsHandler(e.GetGuildMarkets, uh),
+		GetGuildPortfoliosH:          NewGetGuildPortfoliosHandler(e.GetGuildPortfolios, uh),
+		GetAccountInfoH:              NewGetAccountInfoHandler(e.GetAccountInfo, uh),
+		GetAccountPortfolioH:         NewGetAccountPortfolioHandler(e.GetAccountPortfolio, uh),
+		GetAccountPortfoliosH:        NewGetAccountPortfoliosHandler(e.GetAccountPortfolios, uh),
+		GetAccountMembershipsH:       NewGetAccountMembershipsHandler(e.GetAccountMemberships, uh),
+		GetAccountMonthlyPortfoliosH: NewGetAccountMonthlyPortfoliosHandler(e.GetAccountMonthlyPortfolios, uh),
+		GetAccountGrantsH:            NewGetAccountGrantsHandler(e.GetAccountGrants, uh),
+		GetAccountTradesH:            NewGetAccountTradesHandler(e.GetAccountTrades, uh),
+		GetAccountTradeStatsH:        NewGetAccountTradeStatsHandler(e.GetAccountTradeStats, uh),
+		GetGuildTradeStatsH:          NewGetGuildTradeStatsHandler(e.GetGuildTradeStats, uh),
+		GetGuildFeesH:                NewGetGuildFeesHandler(e.GetGuildFees, uh),
+		GetAccountTransfersH:         NewGetAccountTransfersHandler(e.GetAccountTransfers, uh),
+		GetAccountPositionsH:         NewGetAccountPositionsHandler(e.GetAccountPositions, uh),
+		GetGuildRiskH:                NewGetGuildRiskHandler(e.GetGuildRisk, uh),
+		StreamPortfolioUpdatesH:      NewStreamPortfolioUpdatesHandler(e.StreamPortfolioUpdates, sh),
+	}
+}
+
+// NewGetAllGuildsHandler creates a gRPC handler which serves the
+// "GuildsService" service "GetAllGuilds" endpoint.
+func NewGetAllGuildsHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeGetAllGuildsRequest, EncodeGetAllGuildsResponse)
+	}
+	return h
+}
+
+// GetAllGuilds implements the "GetAllGuilds" method in
+// guilds_servicepb.GuildsServiceServer interface.
+func (s *Server) GetAllGuilds(ctx context.Context, message *guilds_servicepb.GetAllGuildsRequest) (*guilds_servicepb.GetAllGuildsResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "GetAllGuilds")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "GuildsService")
+	resp, err := s.GetAllGuildsH.Handle(ctx, message)
+	if err != nil {
+		var en ErrorNamer
+		if errors.As(err, &en) {
+			switch en.ErrorName() {
+			case "not_found":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, NewGetAllGuildsNotFoundError(er))
+			case "invalid_arg":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, NewGetAllGuildsInvalidArgError(er))
+			case "internal":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Internal, err, NewGetAllGuildsInternalError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*guilds_servicepb.GetAllGuildsResponse), nil
+}
+
+// NewGetSingleGuildHandler creates a gRPC handler which serves the
+// "GuildsService" service "GetSingleGuild" endpoint.
+func NewGetSingleGuildHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeGetSingleGuildRequest, EncodeGetSingleGuildResponse)
+	}
+	return h
+}
+
+// GetSingleGuild implements the "GetSingleGuild" method in
+// guilds_servicepb.GuildsServiceServer interface.
+func (s *Server) GetSingleGuild(ctx context.Context, message *guilds_servicepb.GetSingleGuildRequest) (*guilds_servicepb.GetSingleGuildResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "GetSingleGuild")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "GuildsService")
+	resp, err := s.GetSingleGuildH.Handle(ctx, message)
+	if err != nil {
+		var en ErrorNamer
+		if errors.As(err, &en) {
+			switch en.ErrorName() {
+			case "not_found":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, NewGetSingleGuildNotFoundError(er))
+			case "invalid_arg":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, NewGetSingleGuildInvalidArgError(er))
+			case "internal":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Internal, err, NewGetSingleGuildInternalError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*guilds_servicepb.GetSingleGuildResponse), nil
+}
+
+// NewGetGuildMembersHandler creates a gRPC handler which serves the
+// "GuildsService" service "GetGuildMembers" endpoint.
+func NewGetGuildMembersHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeGetGuildMembersRequest, EncodeGetGuildMembersResponse)
+	}
+	return h
+}
+
+// GetGuildMembers implements the "GetGuildMembers" method in
+// guilds_servicepb.GuildsServiceServer interface.
+func (s *Server) GetGuildMembers(ctx context.Context, message *guilds_servicepb.GetGuildMembersRequest) (*guilds_servicepb.GetGuildMembersResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "GetGuildMembers")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "GuildsService")
+	resp, err := s.GetGuildMembersH.Handle(ctx, message)
+	if err != nil {
+		var en ErrorNamer
+		if errors.As(err, &en) {
+			switch en.ErrorName() {
+			case "not_found":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, NewGetGuildMembersNotFoundError(er))
+			case "invalid_arg":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, NewGetGuildMembersInvalidArgError(er))
+			case "internal":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Internal, err, NewGetGuildMembersInternalError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*guilds_servicepb.GetGuildMembersResponse), nil
+}
+
+// NewGetGuildMasterAddressHandler creates a gRPC handler which serves the
+// "GuildsService" service "GetGuildMasterAddress" endpoint.
+func NewGetGuildMasterAddressHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeGetGuildMasterAddressRequest, EncodeGetGuildMasterAddressResponse)
+	}
+	return h
+}
+
+// GetGuildMasterAddress implements the "GetGuildMasterAddress" method in
+// guilds_servicepb.GuildsServiceServer interface.
+func (s *Server) GetGuildMasterAddress(ctx context.Context, message *guilds_servicepb.GetGuildMasterAddressRequest) (*guilds_servicepb.GetGuildMasterAddressResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "GetGuildMasterAddress")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "GuildsService")
+	resp, err := s.GetGuildMasterAddressH.Handle(ctx, message)
+	if err != nil {
+		var en ErrorNamer
+		if errors.As(err, &en) {
+			switch en.ErrorName() {
+			case "not_found":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, NewGetGuildMasterAddressNotFoundError(er))
+			case "invalid_arg":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, NewGetGuildMasterAddressInvalidArgError(er))
+			case "internal":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Internal, err, NewGetGuildMasterAddressInternalError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*guilds_servicepb.GetGuildMasterAddressResponse), nil
+}
+
+// NewGetGuildDefaultMemberHandler creates a gRPC handler which serves the
+// "GuildsService" service "GetGuildDefaultMember" endpoint.
+func NewGetGuildDefaultMemberHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeGetGuildDefaultMemberRequest, EncodeGetGuildDefaultMemberResponse)
+	}
+	return h
+}
+
+// GetGuildDefaultMember implements the "GetGuildDefaultMember" method in
+// guilds_servicepb.GuildsServiceServer interface.
+func (s *Server) GetGuildDefaultMember(ctx context.Context, message *guilds_servicepb.GetGuildDefaultMemberRequest) (*guilds_servicepb.GetGuildDefaultMemberResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "GetGuildDefaultMember")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "GuildsService")
+	resp, err := s.GetGuildDefaultMemberH.Handle(ctx, message)
+	if err != nil {
+		var en ErrorNamer
+		if errors.As(err, &en) {
+			switch en.ErrorName() {
+			case "not_found":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, NewGetGuildDefaultMemberNotFoundError(er))
+			case "invalid_arg":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, NewGetGuildDefaultMemberInvalidArgError(er))
+			case "internal":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Internal, err, NewGetGuildDefaultMemberInternalError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*guilds_servicepb.GetGuildDefaultMemberResponse), nil
+}
+
+// NewEnterGuildHandler creates a gRPC handler which serves the "GuildsService"
+// service "EnterGuild" endpoint.
+func NewEnterGuildHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeEnterGuildRequest, EncodeEnterGuildResponse)
+	}
+	return h
+}
+
+// EnterGuild implements the "EnterGuild" method in
+// guilds_servicepb.GuildsServiceServer interface.
+func (s *Server) EnterGuild(ctx context.Context, message *guilds_servicepb.EnterGuildRequest) (*guilds_servicepb.EnterGuildResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "EnterGuild")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "GuildsService")
+	resp, err := s.EnterGuildH.Handle(ctx, message)
+	if err != nil {
+		var en ErrorNamer
+		if errors.As(err, &en) {
+			switch en.ErrorName() {
+			case "not_found":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, NewEnterGuildNotFoundError(er))
+			case "invalid_arg":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, NewEnterGuildInvalidArgError(er))
+			case "internal":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Internal, err, NewEnterGuildInternalError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*guilds_servicepb.EnterGuildResponse), nil
+}
+
+// NewGetGuildWaitlistHandler creates a gRPC handler which serves the
+// "GuildsService" service "GetGuildWaitlist" endpoint.
+func NewGetGuildWaitlistHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeGetGuildWaitlistRequest, EncodeGetGuildWaitlistResponse)
+	}
+	return h
+}
+
+// GetGuildWaitlist implements the "GetGuildWaitlist" method in
+// guilds_servicepb.GuildsServiceServer interface.
+func (s *Server) GetGuildWaitlist(ctx context.Context, message *guilds_servicepb.GetGuildWaitlistRequest) (*guilds_servicepb.GetGuildWaitlistResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "GetGuildWaitlist")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "GuildsService")
+	resp, err := s.GetGuildWaitlistH.Handle(ctx, message)
+	if err != nil {
+		var en ErrorNamer
+		if errors.As(err, &en) {
+			switch en.ErrorName() {
+			case "not_found":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, NewGetGuildWaitlistNotFoundError(er))
+			case "invalid_arg":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, NewGetGuildWaitlistInvalidArgError(er))
+			case "internal":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Internal, err, NewGetGuildWaitlistInternalError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*guilds_servicepb.GetGuildWaitlistResponse), nil
+}
+
+// NewLeaveGuildHandler creates a gRPC handler which serves the "GuildsService"
+// service "LeaveGuild" endpoint.
+func NewLeaveGuildHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeLeaveGuildRequest, EncodeLeaveGuildResponse)
+	}
+	return h
+}
+
+// LeaveGuild implements the "LeaveGuild" method in
+// guilds_servicepb.GuildsServiceServer interface.
+func (s *Server) LeaveGuild(ctx context.Context, message *guilds_servicepb.LeaveGuildRequest) (*guilds_servicepb.LeaveGuildResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "LeaveGuild")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "GuildsService")
+	resp, err := s.LeaveGuildH.Handle(ctx, message)
+	if err != nil {
+		var en ErrorNamer
+		if errors.As(err, &en) {
+			switch en.ErrorName() {
+			case "not_found":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, NewLeaveGuildNotFoundError(er))
+			case "invalid_arg":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, NewLeaveGuildInvalidArgError(er))
+			case "internal":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Internal, err, NewLeaveGuildInternalError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*guilds_servicepb.LeaveGuildResponse), nil
+}
+
+// NewTransferMemberHandler creates a gRPC handler which serves the
+// "GuildsService" service "TransferMember" endpoint.
+func NewTransferMemberHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeTransferMemberRequest, EncodeTransferMemberResponse)
+	}
+	return h
+}
+
+// TransferMember implements the "TransferMember" method in
+// guilds_servicepb.GuildsServiceServer interface.
+func (s *Server) TransferMember(ctx context.Context, message *guilds_servicepb.TransferMemberRequest) (*guilds_servicepb.TransferMemberResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "TransferMember")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "GuildsService")
+	resp, err := s.TransferMemberH.Handle(ctx, message)
+	if err != nil {
+		var en ErrorNamer
+		if errors.As(err, &en) {
+			switch en.ErrorName() {
+			case "not_found":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, NewTransferMemberNotFoundError(er))
+			case "invalid_arg":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, NewTransferMemberInvalidArgError(er))
+			case "internal":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Internal, err, NewTransferMemberInternalError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*guilds_servicepb.TransferMemberResponse), nil
+}
+
+// NewGetGuildMarketsHandler creates a gRPC handler which serves the
+// "GuildsService" service "GetGuildMarkets" endpoint.
+func NewGetGuildMarketsHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeGetGuildMarketsRequest, EncodeGetGuildMarketsResponse)
+	}
+	return h
+}
+
+// GetGuildMarkets implements the "GetGuildMarkets" method in
+// guilds_servicepb.GuildsServiceServer interface.
+func (s *Server) GetGuildMarkets(ctx context.Context, message *guilds_servicepb.GetGuildMarketsRequest) (*guilds_servicepb.GetGuildMarketsResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "GetGuildMarkets")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "GuildsService")
+	resp, err := s.GetGuildMarketsH.Handle(ctx, message)
+	if err != nil {
+		var en ErrorNamer
+		if errors.As(err, &en) {
+			switch en.ErrorName() {
+			case "not_found":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, NewGetGuildMarketsNotFoundError(er))
+			case "invalid_arg":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, NewGetGuildMarketsInvalidArgError(er))
+			case "internal":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Internal, err, NewGetGuildMarketsInternalError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*guilds_servicepb.GetGuildMarketsResponse), nil
+}
+
+// NewGetGuildPortfoliosHandler creates a gRPC handler which serves the
+// "GuildsService" service "GetGuildPortfolios" endpoint.
+func NewGetGuildPortfoliosHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeGetGuildPortfoliosRequest, EncodeGetGuildPortfoliosResponse)
+	}
+	return h
+}
+
+// GetGuildPortfolios implements the "GetGuildPortfolios" method in
+// guilds_servicepb.GuildsServiceServer interface.
+func (s *Server) GetGuildPortfolios(ctx context.Context, message *guilds_servicepb.GetGuildPortfoliosRequest) (*guilds_servicepb.GetGuildPortfoliosResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "GetGuildPortfolios")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "GuildsService")
+	resp, err := s.GetGuildPortfoliosH.Handle(ctx, message)
+	if err != nil {
+		var en ErrorNamer
+		if errors.As(err, &en) {
+			switch en.ErrorName() {
+			case "not_found":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, NewGetGuildPortfoliosNotFoundError(er))
+			case "invalid_arg":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, NewGetGuildPortfoliosInvalidArgError(er))
+			case "internal":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Internal, err, NewGetGuildPortfoliosInternalError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*guilds_servicepb.GetGuildPortfoliosResponse), nil
+}
+
+// NewGetAccountInfoHandler creates a gRPC handler which serves the
+// "GuildsService" service "GetAccountInfo" endpoint.
+func NewGetAccountInfoHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeGetAccountInfoRequest, EncodeGetAccountInfoResponse)
+	}
+	return h
+}
+
+// GetAccountInfo implements the "GetAccountInfo" method in
+// guilds_servicepb.GuildsServiceServer interface.
+func (s *Server) GetAccountInfo(ctx context.Context, message *guilds_servicepb.GetAccountInfoRequest) (*guilds_servicepb.GetAccountInfoResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "GetAccountInfo")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "GuildsService")
+	resp, err := s.GetAccountInfoH.Handle(ctx, message)
+	if err != nil {
+		var en ErrorNamer
+		if errors.As(err, &en) {
+			switch en.ErrorName() {
+			case "not_found":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, NewGetAccountInfoNotFoundError(er))
+			case "invalid_arg":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, NewGetAccountInfoInvalidArgError(er))
+			case "internal":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Internal, err, NewGetAccountInfoInternalError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*guilds_servicepb.GetAccountInfoResponse), nil
+}
+
+// NewGetAccountPortfolioHandler creates a gRPC handler which serves the
+// "GuildsService" service "GetAccountPortfolio" endpoint.
+func NewGetAccountPortfolioHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeGetAccountPortfolioRequest, EncodeGetAccountPortfolioResponse)
+	}
+	return h
+}
+
+// GetAccountPortfolio implements the "GetAccountPortfolio" method in
+// guilds_servicepb.GuildsServiceServer interface.
+func (s *Server) GetAccountPortfolio(ctx context.Context, message *guilds_servicepb.GetAccountPortfolioRequest) (*guilds_servicepb.GetAccountPortfolioResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "GetAccountPortfolio")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "GuildsService")
+	resp, err := s.GetAccountPortfolioH.Handle(ctx, message)
+	if err != nil {
+		var en ErrorNamer
+		if errors.As(err, &en) {
+			switch en.ErrorName() {
+			case "not_found":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, NewGetAccountPortfolioNotFoundError(er))
+			case "invalid_arg":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, NewGetAccountPortfolioInvalidArgError(er))
+			case "internal":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Internal, err, NewGetAccountPortfolioInternalError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*guilds_servicepb.GetAccountPortfolioResponse), nil
+}
+
+// NewGetAccountPortfoliosHandler creates a gRPC handler which serves the
+// "GuildsService" service "GetAccountPortfolios" endpoint.
+func NewGetAccountPortfoliosHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeGetAccountPortfoliosRequest, EncodeGetAccountPortfoliosResponse)
+	}
+	return h
+}
+
+// GetAccountPortfolios implements the "GetAccountPortfolios" method in
+// guilds_servicepb.GuildsServiceServer interface.
+func (s *Server) GetAccountPortfolios(ctx context.Context, message *guilds_servicepb.GetAccountPortfoliosRequest) (*guilds_servicepb.GetAccountPortfoliosResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "GetAccountPortfolios")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "GuildsService")
+	resp, err := s.GetAccountPortfoliosH.Handle(ctx, message)
+	if err != nil {
+		var en ErrorNamer
+		if errors.As(err, &en) {
+			switch en.ErrorName() {
+			case "not_found":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, NewGetAccountPortfoliosNotFoundError(er))
+			case "invalid_arg":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, NewGetAccountPortfoliosInvalidArgError(er))
+			case "internal":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Internal, err, NewGetAccountPortfoliosInternalError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*guilds_servicepb.GetAccountPortfoliosResponse), nil
+}
+
+// NewGetAccountMembershipsHandler creates a gRPC handler which serves the
+// "GuildsService" service "GetAccountMemberships" endpoint.
+func NewGetAccountMembershipsHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeGetAccountMembershipsRequest, EncodeGetAccountMembershipsResponse)
+	}
+	return h
+}
+
+// GetAccountMemberships implements the "GetAccountMemberships" method in
+// guilds_servicepb.GuildsServiceServer interface.
+func (s *Server) GetAccountMemberships(ctx context.Context, message *guilds_servicepb.GetAccountMembershipsRequest) (*guilds_servicepb.GetAccountMembershipsResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "GetAccountMemberships")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "GuildsService")
+	resp, err := s.GetAccountMembershipsH.Handle(ctx, message)
+	if err != nil {
+		var en ErrorNamer
+		if errors.As(err, &en) {
+			switch en.ErrorName() {
+			case "not_found":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, NewGetAccountMembershipsNotFoundError(er))
+			case "invalid_arg":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, NewGetAccountMembershipsInvalidArgError(er))
+			case "internal":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Internal, err, NewGetAccountMembershipsInternalError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*guilds_servicepb.GetAccountMembershipsResponse), nil
+}
+
+// NewGetAccountMonthlyPortfoliosHandler creates a gRPC handler which serves
+// the "GuildsService" service "GetAccountMonthlyPortfolios" endpoint.
+func NewGetAccountMonthlyPortfoliosHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeGetAccountMonthlyPortfoliosRequest, EncodeGetAccountMonthlyPortfoliosResponse)
+	}
+	return h
+}
+
+// GetAccountMonthlyPortfolios implements the "GetAccountMonthlyPortfolios"
+// method in guilds_servicepb.GuildsServiceServer interface.
+func (s *Server) GetAccountMonthlyPortfolios(ctx context.Context, message *guilds_servicepb.GetAccountMonthlyPortfoliosRequest) (*guilds_servicepb.GetAccountMonthlyPortfoliosResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "GetAccountMonthlyPortfolios")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "GuildsService")
+	resp, err := s.GetAccountMonthlyPortfoliosH.Handle(ctx, message)
+	if err != nil {
+		var en ErrorNamer
+		if errors.As(err, &en) {
+			switch en.ErrorName() {
+			case "not_found":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, NewGetAccountMonthlyPortfoliosNotFoundError(er))
+			case "invalid_arg":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, NewGetAccountMonthlyPortfoliosInvalidArgError(er))
+			case "internal":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Internal, err, NewGetAccountMonthlyPortfoliosInternalError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*guilds_servicepb.GetAccountMonthlyPortfoliosResponse), nil
+}
+
+// NewGetAccountGrantsHandler creates a gRPC handler which serves the
+// "GuildsService" service "GetAccountGrants" endpoint.
+func NewGetAccountGrantsHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeGetAccountGrantsRequest, EncodeGetAccountGrantsResponse)
+	}
+	return h
+}
+
+// GetAccountGrants implements the "GetAccountGrants" method in
+// guilds_servicepb.GuildsServiceServer interface.
+func (s *Server) GetAccountGrants(ctx context.Context, message *guilds_servicepb.GetAccountGrantsRequest) (*guilds_servicepb.GetAccountGrantsResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "GetAccountGrants")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "GuildsService")
+	resp, err := s.GetAccountGrantsH.Handle(ctx, message)
+	if err != nil {
+		var en ErrorNamer
+		if errors.As(err, &en) {
+			switch en.ErrorName() {
+			case "not_found":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, NewGetAccountGrantsNotFoundError(er))
+			case "invalid_arg":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, NewGetAccountGrantsInvalidArgError(er))
+			case "internal":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Internal, err, NewGetAccountGrantsInternalError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*guilds_servicepb.GetAccountGrantsResponse), nil
+}
+
+// NewGetAccountTradesHandler creates a gRPC handler which serves the
+// "GuildsService" service "GetAccountTrades" endpoint.
+func NewGetAccountTradesHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeGetAccountTradesRequest, EncodeGetAccountTradesResponse)
+	}
+	return h
+}
+
+// GetAccountTrades implements the "GetAccountTrades" method in
+// guilds_servicepb.GuildsServiceServer interface.
+func (s *Server) GetAccountTrades(ctx context.Context, message *guilds_servicepb.GetAccountTradesRequest) (*guilds_servicepb.GetAccountTradesResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "GetAccountTrades")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "GuildsService")
+	resp, err := s.GetAccountTradesH.Handle(ctx, message)
+	if err != nil {
+		var en ErrorNamer
+		if errors.As(err, &en) {
+			switch en.ErrorName() {
+			case "not_found":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, NewGetAccountTradesNotFoundError(er))
+			case "invalid_arg":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, NewGetAccountTradesInvalidArgError(er))
+			case "internal":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Internal, err, NewGetAccountTradesInternalError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*guilds_servicepb.GetAccountTradesResponse), nil
+}
+
+// NewGetAccountTradeStatsHandler creates a gRPC handler which serves the
+// "GuildsService" service "GetAccountTradeStats" endpoint.
+func NewGetAccountTradeStatsHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeGetAccountTradeStatsRequest, EncodeGetAccountTradeStatsResponse)
+	}
+	return h
+}
+
+// GetAccountTradeStats implements the "GetAccountTradeStats" method in
+// guilds_servicepb.GuildsServiceServer interface.
+func (s *Server) GetAccountTradeStats(ctx context.Context, message *guilds_servicepb.GetAccountTradeStatsRequest) (*guilds_servicepb.GetAccountTradeStatsResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "GetAccountTradeStats")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "GuildsService")
+	resp, err := s.GetAccountTradeStatsH.Handle(ctx, message)
+	if err != nil {
+		var en ErrorNamer
+		if errors.As(err, &en) {
+			switch en.ErrorName() {
+			case "not_found":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, NewGetAccountTradeStatsNotFoundError(er))
+			case "invalid_arg":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, NewGetAccountTradeStatsInvalidArgError(er))
+			case "internal":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Internal, err, NewGetAccountTradeStatsInternalError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*guilds_servicepb.GetAccountTradeStatsResponse), nil
+}
+
+// NewGetGuildTradeStatsHandler creates a gRPC handler which serves the
+// "GuildsService" service "GetGuildTradeStats" endpoint.
+func NewGetGuildTradeStatsHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeGetGuildTradeStatsRequest, EncodeGetGuildTradeStatsResponse)
+	}
+	return h
+}
+
+// GetGuildTradeStats implements the "GetGuildTradeStats" method in
+// guilds_servicepb.GuildsServiceServer interface.
+func (s *Server) GetGuildTradeStats(ctx context.Context, message *guilds_servicepb.GetGuildTradeStatsRequest) (*guilds_servicepb.GetGuildTradeStatsResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "GetGuildTradeStats")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "GuildsService")
+	resp, err := s.GetGuildTradeStatsH.Handle(ctx, message)
+	if err != nil {
+		var en ErrorNamer
+		if errors.As(err, &en) {
+			switch en.ErrorName() {
+			case "not_found":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, NewGetGuildTradeStatsNotFoundError(er))
+			case "invalid_arg":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, NewGetGuildTradeStatsInvalidArgError(er))
+			case "internal":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Internal, err, NewGetGuildTradeStatsInternalError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*guilds_servicepb.GetGuildTradeStatsResponse), nil
+}
+
+// NewGetGuildFeesHandler creates a gRPC handler which serves the
+// "GuildsService" service "GetGuildFees" endpoint.
+func NewGetGuildFeesHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeGetGuildFeesRequest, EncodeGetGuildFeesResponse)
+	}
+	return h
+}
+
+// GetGuildFees implements the "GetGuildFees" method in
+// guilds_servicepb.GuildsServiceServer interface.
+func (s *Server) GetGuildFees(ctx context.Context, message *guilds_servicepb.GetGuildFeesRequest) (*guilds_servicepb.GetGuildFeesResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "GetGuildFees")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "GuildsService")
+	resp, err := s.GetGuildFeesH.Handle(ctx, message)
+	if err != nil {
+		var en ErrorNamer
+		if errors.As(err, &en) {
+			switch en.ErrorName() {
+			case "not_found":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, NewGetGuildFeesNotFoundError(er))
+			case "invalid_arg":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, NewGetGuildFeesInvalidArgError(er))
+			case "internal":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Internal, err, NewGetGuildFeesInternalError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*guilds_servicepb.GetGuildFeesResponse), nil
+}
+
+// NewGetAccountTransfersHandler creates a gRPC handler which serves the
+// "GuildsService" service "GetAccountTransfers" endpoint.
+func NewGetAccountTransfersHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeGetAccountTransfersRequest, EncodeGetAccountTransfersResponse)
+	}
+	return h
+}
+
+// GetAccountTransfers implements the "GetAccountTransfers" method in
+// guilds_servicepb.GuildsServiceServer interface.
+func (s *Server) GetAccountTransfers(ctx context.Context, message *guilds_servicepb.GetAccountTransfersRequest) (*guilds_servicepb.GetAccountTransfersResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "GetAccountTransfers")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "GuildsService")
+	resp, err := s.GetAccountTransfersH.Handle(ctx, message)
+	if err != nil {
+		var en ErrorNamer
+		if errors.As(err, &en) {
+			switch en.ErrorName() {
+			case "not_found":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, NewGetAccountTransfersNotFoundError(er))
+			case "invalid_arg":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, NewGetAccountTransfersInvalidArgError(er))
+			case "internal":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Internal, err, NewGetAccountTransfersInternalError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*guilds_servicepb.GetAccountTransfersResponse), nil
+}
+
+// NewGetAccountPositionsHandler creates a gRPC handler which serves the
+// "GuildsService" service "GetAccountPositions" endpoint.
+func NewGetAccountPositionsHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeGetAccountPositionsRequest, EncodeGetAccountPositionsResponse)
+	}
+	return h
+}
+
+// GetAccountPositions implements the "GetAccountPositions" method in
+// guilds_servicepb.GuildsServiceServer interface.
+func (s *Server) GetAccountPositions(ctx context.Context, message *guilds_servicepb.GetAccountPositionsRequest) (*guilds_servicepb.GetAccountPositionsResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "GetAccountPositions")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "GuildsService")
+	resp, err := s.GetAccountPositionsH.Handle(ctx, message)
+	if err != nil {
+		var en ErrorNamer
+		if errors.As(err, &en) {
+			switch en.ErrorName() {
+			case "not_found":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, NewGetAccountPositionsNotFoundError(er))
+			case "invalid_arg":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, NewGetAccountPositionsInvalidArgError(er))
+			case "internal":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Internal, err, NewGetAccountPositionsInternalError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*guilds_servicepb.GetAccountPositionsResponse), nil
+}
+
+// NewGetGuildRiskHandler creates a gRPC handler which serves the
+// "GuildsService" service "GetGuildRisk" endpoint.
+func NewGetGuildRiskHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeGetGuildRiskRequest, EncodeGetGuildRiskResponse)
+	}
+	return h
+}
+
+// GetGuildRisk implements the "GetGuildRisk" method in
+// guilds_servicepb.GuildsServiceServer interface.
+func (s *Server) GetGuildRisk(ctx context.Context, message *guilds_servicepb.GetGuildRiskRequest) (*guilds_servicepb.GetGuildRiskResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "GetGuildRisk")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "GuildsService")
+	resp, err := s.GetGuildRiskH.Handle(ctx, message)
+	if err != nil {
+		var en ErrorNamer
+		if errors.As(err, &en) {
+			switch en.ErrorName() {
+			case "not_found":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, NewGetGuildRiskNotFoundError(er))
+			case "invalid_arg":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, NewGetGuildRiskInvalidArgError(er))
+			case "internal":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Internal, err, NewGetGuildRiskInternalError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*guilds_servicepb.GetGuildRiskResponse), nil
+}
+
+// NewStreamPortfolioUpdatesHandler creates a gRPC handler which serves the
+// "GuildsService" service "StreamPortfolioUpdates" endpoint.
+func NewStreamPortfolioUpdatesHandler(endpoint goa.Endpoint, h goagrpc.StreamHandler) goagrpc.StreamHandler {
+	if h == nil {
+		h = goagrpc.NewStreamHandler(endpoint, DecodeStreamPortfolioUpdatesRequest)
+	}
+	return h
+}
+
+// StreamPortfolioUpdates implements the "StreamPortfolioUpdates" method in
+// guilds_servicepb.GuildsServiceServer interface.
+func (s *Server) StreamPortfolioUpdates(message *guilds_servicepb.StreamPortfolioUpdatesRequest, stream guilds_servicepb.GuildsService_StreamPortfolioUpdatesServer) error {
+	ctx := stream.Context()
+	ctx = context.WithValue(ctx, goa.MethodKey, "StreamPortfolioUpdates")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "GuildsService")
+	p, err := s.StreamPortfolioUpdatesH.Decode(ctx, message)
+	if err != nil {
+		var en ErrorNamer
+		if errors.As(err, &en) {
+			switch en.ErrorName() {
+			case "not_found":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return goagrpc.NewStatusError(codes.NotFound, err, NewStreamPortfolioUpdatesNotFoundError(er))
+			case "invalid_arg":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return goagrpc.NewStatusError(codes.InvalidArgument, err, NewStreamPortfolioUpdatesInvalidArgError(er))
+			case "internal":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return goagrpc.NewStatusError(codes.Internal, err, NewStreamPortfolioUpdatesInternalError(er))
+			}
+		}
+		return goagrpc.EncodeError(err)
+	}
+	ep := &guildsservice.StreamPortfolioUpdatesEndpointInput{
+		Stream:  &StreamPortfolioUpdatesServerStream{stream: stream},
+		Payload: p.(*guildsservice.StreamPortfolioUpdatesPayload),
+	}
+	err = s.StreamPortfolioUpdatesH.Handle(ctx, ep)
+	if err != nil {
+		var en ErrorNamer
+		if errors.As(err, &en) {
+			switch en.ErrorName() {
+			case "not_found":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return goagrpc.NewStatusError(codes.NotFound, err, NewStreamPortfolioUpdatesNotFoundError(er))
+			case "invalid_arg":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return goagrpc.NewStatusError(codes.InvalidArgument, err, NewStreamPortfolioUpdatesInvalidArgError(er))
+			case "internal":
+				var er *guildsservice.APIError
+				errors.As(err, &er)
+				return goagrpc.NewStatusError(codes.Internal, err, NewStreamPortfolioUpdatesInternalError(er))
+			}
+		}
+		return goagrpc.EncodeError(err)
+	}
+	return nil
+}
+
+// Send streams instances of "guilds_servicepb.StreamPortfolioUpdatesResponse"
+// to the "StreamPortfolioUpdates" endpoint gRPC stream.
+func (s *StreamPortfolioUpdatesServerStream) Send(res *guildsservice.PortfolioUpdate) error {
+	v := NewStreamPortfolioUpdatesResponse(res)
+	return s.stream.Send(v)
+}
+
+func (s *StreamPortfolioUpdatesServerStream) Close() error {
+	// nothing to do here
+	return nil
+}
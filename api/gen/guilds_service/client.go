@@ -21,17 +21,29 @@ type Client struct {
 	GetGuildMasterAddressEndpoint       goa.Endpoint
 	GetGuildDefaultMemberEndpoint       goa.Endpoint
 	EnterGuildEndpoint                  goa.Endpoint
+	GetGuildWaitlistEndpoint            goa.Endpoint
 	LeaveGuildEndpoint                  goa.Endpoint
+	TransferMemberEndpoint              goa.Endpoint
 	GetGuildMarketsEndpoint             goa.Endpoint
 	GetGuildPortfoliosEndpoint          goa.Endpoint
 	GetAccountInfoEndpoint              goa.Endpoint
 	GetAccountPortfolioEndpoint         goa.Endpoint
 	GetAccountPortfoliosEndpoint        goa.Endpoint
+	GetAccountMembershipsEndpoint       goa.Endpoint
 	GetAccountMonthlyPortfoliosEndpoint goa.Endpoint
+	GetAccountGrantsEndpoint            goa.Endpoint
+	GetAccountTradesEndpoint            goa.Endpoint
+	GetAccountTradeStatsEndpoint        goa.Endpoint
+	GetGuildTradeStatsEndpoint          goa.Endpoint
+	GetGuildFeesEndpoint                goa.Endpoint
+	GetAccountTransfersEndpoint         goa.Endpoint
+	GetAccountPositionsEndpoint         goa.Endpoint
+	GetGuildRiskEndpoint                goa.Endpoint
+	StreamPortfolioUpdatesEndpoint      goa.Endpoint
 }
 
 // NewClient initializes a "GuildsService" service client given the endpoints.
-func NewClient(getAllGuilds, getSingleGuild, getGuildMembers, getGuildMasterAddress, getGuildDefaultMember, enterGuild, leaveGuild, getGuildMarkets, getGuildPortfolios, getAccountInfo, getAccountPortfolio, getAccountPortfolios, getAccountMonthlyPortfolios goa.Endpoint) *Client {
+func NewClient(getAllGuilds, getSingleGuild, getGuildMembers, getGuildMasterAddress, getGuildDefaultMember, enterGuild, getGuildWaitlist, leaveGuild, transferMember, getGuildMarkets, getGuildPortfolios, getAccountInfo, getAccountPortfolio, getAccountPortfolios, getAccountMemberships, getAccountMonthlyPortfolios, getAccountGrants, getAccountTrades, getAccountTradeStats, getGuildTradeStats, getGuildFees, getAccountTransfers, getAccountPositions, getGuildRisk, streamPortfolioUpdates goa.Endpoint) *Client {
 	return &Client{
 		GetAllGuildsEndpoint:                getAllGuilds,
 		GetSingleGuildEndpoint:              getSingleGuild,
@@ -39,21 +51,33 @@ func NewClient(getAllGuilds, getSingleGuild, getGuildMembers, getGuildMasterAddr
 		GetGuildMasterAddressEndpoint:       getGuildMasterAddress,
 		GetGuildDefaultMemberEndpoint:       getGuildDefaultMember,
 		EnterGuildEndpoint:                  enterGuild,
+		GetGuildWaitlistEndpoint:            getGuildWaitlist,
 		LeaveGuildEndpoint:                  leaveGuild,
+		TransferMemberEndpoint:              transferMember,
 		GetGuildMarketsEndpoint:             getGuildMarkets,
 		GetGuildPortfoliosEndpoint:          getGuildPortfolios,
 		GetAccountInfoEndpoint:              getAccountInfo,
 		GetAccountPortfolioEndpoint:         getAccountPortfolio,
 		GetAccountPortfoliosEndpoint:        getAccountPortfolios,
+		GetAccountMembershipsEndpoint:       getAccountMemberships,
 		GetAccountMonthlyPortfoliosEndpoint: getAccountMonthlyPortfolios,
+		GetAccountGrantsEndpoint:            getAccountGrants,
+		GetAccountTradesEndpoint:            getAccountTrades,
+		GetAccountTradeStatsEndpoint:        getAccountTradeStats,
+		GetGuildTradeStatsEndpoint:          getGuildTradeStats,
+		GetGuildFeesEndpoint:                getGuildFees,
+		GetAccountTransfersEndpoint:         getAccountTransfers,
+		GetAccountPositionsEndpoint:         getAccountPositions,
+		GetGuildRiskEndpoint:                getGuildRisk,
+		StreamPortfolioUpdatesEndpoint:      streamPortfolioUpdates,
 	}
 }
 
 // GetAllGuilds calls the "GetAllGuilds" endpoint of the "GuildsService"
 // service.
-func (c *Client) GetAllGuilds(ctx context.Context) (res *GetAllGuildsResult, err error) {
+func (c *Client) GetAllGuilds(ctx context.Context, p *GetAllGuildsPayload) (res *GetAllGuildsResult, err error) {
 	var ires interface{}
-	ires, err = c.GetAllGuildsEndpoint(ctx, nil)
+	ires, err = c.GetAllGuildsEndpoint(ctx, p)
 	if err != nil {
 		return
 	}
@@ -114,6 +138,17 @@ func (c *Client) EnterGuild(ctx context.Context, p *EnterGuildPayload) (res *Ent
 	return ires.(*EnterGuildResult), nil
 }
 
+// GetGuildWaitlist calls the "GetGuildWaitlist" endpoint of the
+// "GuildsService" service.
+func (c *Client) GetGuildWaitlist(ctx context.Context, p *GetGuildWaitlistPayload) (res *GetGuildWaitlistResult, err error) {
+	var ires interface{}
+	ires, err = c.GetGuildWaitlistEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*GetGuildWaitlistResult), nil
+}
+
 // LeaveGuild calls the "LeaveGuild" endpoint of the "GuildsService" service.
 func (c *Client) LeaveGuild(ctx context.Context, p *LeaveGuildPayload) (res *LeaveGuildResult, err error) {
 	var ires interface{}
@@ -124,6 +159,17 @@ func (c *Client) LeaveGuild(ctx context.Context, p *LeaveGuildPayload) (res *Lea
 	return ires.(*LeaveGuildResult), nil
 }
 
+// TransferMember calls the "TransferMember" endpoint of the "GuildsService"
+// service.
+func (c *Client) TransferMember(ctx context.Context, p *TransferMemberPayload) (res *TransferMemberResult, err error) {
+	var ires interface{}
+	ires, err = c.TransferMemberEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*TransferMemberResult), nil
+}
+
 // GetGuildMarkets calls the "GetGuildMarkets" endpoint of the "GuildsService"
 // service.
 func (c *Client) GetGuildMarkets(ctx context.Context, p *GetGuildMarketsPayload) (res *GetGuildMarketsResult, err error) {
@@ -179,6 +225,17 @@ func (c *Client) GetAccountPortfolios(ctx context.Context, p *GetAccountPortfoli
 	return ires.(*GetAccountPortfoliosResult), nil
 }
 
+// GetAccountMemberships calls the "GetAccountMemberships" endpoint of the
+// "GuildsService" service.
+func (c *Client) GetAccountMemberships(ctx context.Context, p *GetAccountMembershipsPayload) (res *GetAccountMembershipsResult, err error) {
+	var ires interface{}
+	ires, err = c.GetAccountMembershipsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*GetAccountMembershipsResult), nil
+}
+
 // GetAccountMonthlyPortfolios calls the "GetAccountMonthlyPortfolios" endpoint
 // of the "GuildsService" service.
 func (c *Client) GetAccountMonthlyPortfolios(ctx context.Context, p *GetAccountMonthlyPortfoliosPayload) (res *GetAccountMonthlyPortfoliosResult, err error) {
@@ -189,3 +246,102 @@ func (c *Client) GetAccountMonthlyPortfolios(ctx context.Context, p *GetAccountM
 	}
 	return ires.(*GetAccountMonthlyPortfoliosResult), nil
 }
+
+// GetAccountGrants calls the "GetAccountGrants" endpoint of the
+// "GuildsService" service.
+func (c *Client) GetAccountGrants(ctx context.Context, p *GetAccountGrantsPayload) (res *GetAccountGrantsResult, err error) {
+	var ires interface{}
+	ires, err = c.GetAccountGrantsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*GetAccountGrantsResult), nil
+}
+
+// GetAccountTrades calls the "GetAccountTrades" endpoint of the
+// "GuildsService" service.
+func (c *Client) GetAccountTrades(ctx context.Context, p *GetAccountTradesPayload) (res *GetAccountTradesResult, err error) {
+	var ires interface{}
+	ires, err = c.GetAccountTradesEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*GetAccountTradesResult), nil
+}
+
+// GetAccountTradeStats calls the "GetAccountTradeStats" endpoint of the
+// "GuildsService" service.
+func (c *Client) GetAccountTradeStats(ctx context.Context, p *GetAccountTradeStatsPayload) (res *GetAccountTradeStatsResult, err error) {
+	var ires interface{}
+	ires, err = c.GetAccountTradeStatsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*GetAccountTradeStatsResult), nil
+}
+
+// GetGuildTradeStats calls the "GetGuildTradeStats" endpoint of the
+// "GuildsService" service.
+func (c *Client) GetGuildTradeStats(ctx context.Context, p *GetGuildTradeStatsPayload) (res *GetGuildTradeStatsResult, err error) {
+	var ires interface{}
+	ires, err = c.GetGuildTradeStatsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*GetGuildTradeStatsResult), nil
+}
+
+// GetGuildFees calls the "GetGuildFees" endpoint of the "GuildsService"
+// service.
+func (c *Client) GetGuildFees(ctx context.Context, p *GetGuildFeesPayload) (res *GetGuildFeesResult, err error) {
+	var ires interface{}
+	ires, err = c.GetGuildFeesEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*GetGuildFeesResult), nil
+}
+
+// GetAccountTransfers calls the "GetAccountTransfers" endpoint of the
+// "GuildsService" service.
+func (c *Client) GetAccountTransfers(ctx context.Context, p *GetAccountTransfersPayload) (res *GetAccountTransfersResult, err error) {
+	var ires interface{}
+	ires, err = c.GetAccountTransfersEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*GetAccountTransfersResult), nil
+}
+
+// GetAccountPositions calls the "GetAccountPositions" endpoint of the
+// "GuildsService" service.
+func (c *Client) GetAccountPositions(ctx context.Context, p *GetAccountPositionsPayload) (res *GetAccountPositionsResult, err error) {
+	var ires interface{}
+	ires, err = c.GetAccountPositionsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*GetAccountPositionsResult), nil
+}
+
+// GetGuildRisk calls the "GetGuildRisk" endpoint of the "GuildsService"
+// service.
+func (c *Client) GetGuildRisk(ctx context.Context, p *GetGuildRiskPayload) (res *GetGuildRiskResult, err error) {
+	var ires interface{}
+	ires, err = c.GetGuildRiskEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*GetGuildRiskResult), nil
+}
+
+// StreamPortfolioUpdates calls the "StreamPortfolioUpdates" endpoint of the
+// "GuildsService" service.
+func (c *Client) StreamPortfolioUpdates(ctx context.Context, p *StreamPortfolioUpdatesPayload) (res StreamPortfolioUpdatesClientStream, err error) {
+	var ires interface{}
+	ires, err = c.StreamPortfolioUpdatesEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(StreamPortfolioUpdatesClientStream), nil
+}
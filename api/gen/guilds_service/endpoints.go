@@ -21,13 +21,35 @@ type Endpoints struct {
 	GetGuildMasterAddress       goa.Endpoint
 	GetGuildDefaultMember       goa.Endpoint
 	EnterGuild                  goa.Endpoint
+	GetGuildWaitlist            goa.Endpoint
 	LeaveGuild                  goa.Endpoint
+	TransferMember              goa.Endpoint
 	GetGuildMarkets             goa.Endpoint
 	GetGuildPortfolios          goa.Endpoint
 	GetAccountInfo              goa.Endpoint
 	GetAccountPortfolio         goa.Endpoint
 	GetAccountPortfolios        goa.Endpoint
+	GetAccountMemberships       goa.Endpoint
 	GetAccountMonthlyPortfolios goa.Endpoint
+	GetAccountGrants            goa.Endpoint
+	GetAccountTrades            goa.Endpoint
+	GetAccountTradeStats        goa.Endpoint
+	GetGuildTradeStats          goa.Endpoint
+	GetGuildFees                goa.Endpoint
+	GetAccountTransfers         goa.Endpoint
+	GetAccountPositions         goa.Endpoint
+	GetGuildRisk                goa.Endpoint
+	StreamPortfolioUpdates      goa.Endpoint
+}
+
+// StreamPortfolioUpdatesEndpointInput holds both the payload and the server
+// stream of the "StreamPortfolioUpdates" method.
+type StreamPortfolioUpdatesEndpointInput struct {
+	// Payload is the method payload.
+	Payload *StreamPortfolioUpdatesPayload
+	// Stream is the server stream used by the "StreamPortfolioUpdates" method to
+	// send data.
+	Stream StreamPortfolioUpdatesServerStream
 }
 
 // NewEndpoints wraps the methods of the "GuildsService" service with endpoints.
@@ -39,13 +61,25 @@ func NewEndpoints(s Service) *Endpoints {
 		GetGuildMasterAddress:       NewGetGuildMasterAddressEndpoint(s),
 		GetGuildDefaultMember:       NewGetGuildDefaultMemberEndpoint(s),
 		EnterGuild:                  NewEnterGuildEndpoint(s),
+		GetGuildWaitlist:            NewGetGuildWaitlistEndpoint(s),
 		LeaveGuild:                  NewLeaveGuildEndpoint(s),
+		TransferMember:              NewTransferMemberEndpoint(s),
 		GetGuildMarkets:             NewGetGuildMarketsEndpoint(s),
 		GetGuildPortfolios:          NewGetGuildPortfoliosEndpoint(s),
 		GetAccountInfo:              NewGetAccountInfoEndpoint(s),
 		GetAccountPortfolio:         NewGetAccountPortfolioEndpoint(s),
 		GetAccountPortfolios:        NewGetAccountPortfoliosEndpoint(s),
+		GetAccountMemberships:       NewGetAccountMembershipsEndpoint(s),
 		GetAccountMonthlyPortfolios: NewGetAccountMonthlyPortfoliosEndpoint(s),
+		GetAccountGrants:            NewGetAccountGrantsEndpoint(s),
+		GetAccountTrades:            NewGetAccountTradesEndpoint(s),
+		GetAccountTradeStats:        NewGetAccountTradeStatsEndpoint(s),
+		GetGuildTradeStats:          NewGetGuildTradeStatsEndpoint(s),
+		GetGuildFees:                NewGetGuildFeesEndpoint(s),
+		GetAccountTransfers:         NewGetAccountTransfersEndpoint(s),
+		GetAccountPositions:         NewGetAccountPositionsEndpoint(s),
+		GetGuildRisk:                NewGetGuildRiskEndpoint(s),
+		StreamPortfolioUpdates:      NewStreamPortfolioUpdatesEndpoint(s),
 	}
 }
 
@@ -58,20 +92,33 @@ func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.GetGuildMasterAddress = m(e.GetGuildMasterAddress)
 	e.GetGuildDefaultMember = m(e.GetGuildDefaultMember)
 	e.EnterGuild = m(e.EnterGuild)
+	e.GetGuildWaitlist = m(e.GetGuildWaitlist)
 	e.LeaveGuild = m(e.LeaveGuild)
+	e.TransferMember = m(e.TransferMember)
 	e.GetGuildMarkets = m(e.GetGuildMarkets)
 	e.GetGuildPortfolios = m(e.GetGuildPortfolios)
 	e.GetAccountInfo = m(e.GetAccountInfo)
 	e.GetAccountPortfolio = m(e.GetAccountPortfolio)
 	e.GetAccountPortfolios = m(e.GetAccountPortfolios)
+	e.GetAccountMemberships = m(e.GetAccountMemberships)
 	e.GetAccountMonthlyPortfolios = m(e.GetAccountMonthlyPortfolios)
+	e.GetAccountGrants = m(e.GetAccountGrants)
+	e.GetAccountTrades = m(e.GetAccountTrades)
+	e.GetAccountTradeStats = m(e.GetAccountTradeStats)
+	e.GetGuildTradeStats = m(e.GetGuildTradeStats)
+	e.GetGuildFees = m(e.GetGuildFees)
+	e.GetAccountTransfers = m(e.GetAccountTransfers)
+	e.GetAccountPositions = m(e.GetAccountPositions)
+	e.GetGuildRisk = m(e.GetGuildRisk)
+	e.StreamPortfolioUpdates = m(e.StreamPortfolioUpdates)
 }
 
 // NewGetAllGuildsEndpoint returns an endpoint function that calls the method
 // "GetAllGuilds" of service "GuildsService".
 func NewGetAllGuildsEndpoint(s Service) goa.Endpoint {
 	return func(ctx context.Context, req interface{}) (interface{}, error) {
-		return s.GetAllGuilds(ctx)
+		p := req.(*GetAllGuildsPayload)
+		return s.GetAllGuilds(ctx, p)
 	}
 }
 
@@ -120,6 +167,15 @@ func NewEnterGuildEndpoint(s Service) goa.Endpoint {
 	}
 }
 
+// NewGetGuildWaitlistEndpoint returns an endpoint function that calls the
+// method "GetGuildWaitlist" of service "GuildsService".
+func NewGetGuildWaitlistEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		p := req.(*GetGuildWaitlistPayload)
+		return s.GetGuildWaitlist(ctx, p)
+	}
+}
+
 // NewLeaveGuildEndpoint returns an endpoint function that calls the method
 // "LeaveGuild" of service "GuildsService".
 func NewLeaveGuildEndpoint(s Service) goa.Endpoint {
@@ -129,6 +185,15 @@ func NewLeaveGuildEndpoint(s Service) goa.Endpoint {
 	}
 }
 
+// NewTransferMemberEndpoint returns an endpoint function that calls the method
+// "TransferMember" of service "GuildsService".
+func NewTransferMemberEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		p := req.(*TransferMemberPayload)
+		return s.TransferMember(ctx, p)
+	}
+}
+
 // NewGetGuildMarketsEndpoint returns an endpoint function that calls the
 // method "GetGuildMarkets" of service "GuildsService".
 func NewGetGuildMarketsEndpoint(s Service) goa.Endpoint {
@@ -174,6 +239,15 @@ func NewGetAccountPortfoliosEndpoint(s Service) goa.Endpoint {
 	}
 }
 
+// NewGetAccountMembershipsEndpoint returns an endpoint function that calls the
+// method "GetAccountMemberships" of service "GuildsService".
+func NewGetAccountMembershipsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		p := req.(*GetAccountMembershipsPayload)
+		return s.GetAccountMemberships(ctx, p)
+	}
+}
+
 // NewGetAccountMonthlyPortfoliosEndpoint returns an endpoint function that
 // calls the method "GetAccountMonthlyPortfolios" of service "GuildsService".
 func NewGetAccountMonthlyPortfoliosEndpoint(s Service) goa.Endpoint {
@@ -182,3 +256,84 @@ func NewGetAccountMonthlyPortfoliosEndpoint(s Service) goa.Endpoint {
 		return s.GetAccountMonthlyPortfolios(ctx, p)
 	}
 }
+
+// NewGetAccountGrantsEndpoint returns an endpoint function that calls the
+// method "GetAccountGrants" of service "GuildsService".
+func NewGetAccountGrantsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		p := req.(*GetAccountGrantsPayload)
+		return s.GetAccountGrants(ctx, p)
+	}
+}
+
+// NewGetAccountTradesEndpoint returns an endpoint function that calls the
+// method "GetAccountTrades" of service "GuildsService".
+func NewGetAccountTradesEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		p := req.(*GetAccountTradesPayload)
+		return s.GetAccountTrades(ctx, p)
+	}
+}
+
+// NewGetAccountTradeStatsEndpoint returns an endpoint function that calls the
+// method "GetAccountTradeStats" of service "GuildsService".
+func NewGetAccountTradeStatsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		p := req.(*GetAccountTradeStatsPayload)
+		return s.GetAccountTradeStats(ctx, p)
+	}
+}
+
+// NewGetGuildTradeStatsEndpoint returns an endpoint function that calls the
+// method "GetGuildTradeStats" of service "GuildsService".
+func NewGetGuildTradeStatsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		p := req.(*GetGuildTradeStatsPayload)
+		return s.GetGuildTradeStats(ctx, p)
+	}
+}
+
+// NewGetGuildFeesEndpoint returns an endpoint function that calls the method
+// "GetGuildFees" of service "GuildsService".
+func NewGetGuildFeesEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		p := req.(*GetGuildFeesPayload)
+		return s.GetGuildFees(ctx, p)
+	}
+}
+
+// NewGetAccountTransfersEndpoint returns an endpoint function that calls the
+// method "GetAccountTransfers" of service "GuildsService".
+func NewGetAccountTransfersEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		p := req.(*GetAccountTransfersPayload)
+		return s.GetAccountTransfers(ctx, p)
+	}
+}
+
+// NewGetAccountPositionsEndpoint returns an endpoint function that calls the
+// method "GetAccountPositions" of service "GuildsService".
+func NewGetAccountPositionsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		p := req.(*GetAccountPositionsPayload)
+		return s.GetAccountPositions(ctx, p)
+	}
+}
+
+// NewGetGuildRiskEndpoint returns an endpoint function that calls the method
+// "GetGuildRisk" of service "GuildsService".
+func NewGetGuildRiskEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		p := req.(*GetGuildRiskPayload)
+		return s.GetGuildRisk(ctx, p)
+	}
+}
+
+// NewStreamPortfolioUpdatesEndpoint returns an endpoint function that calls
+// the method "StreamPortfolioUpdates" of service "GuildsService".
+func NewStreamPortfolioUpdatesEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		ep := req.(*StreamPortfolioUpdatesEndpointInput)
+		return nil, s.StreamPortfolioUpdates(ctx, ep.Payload, ep.Stream)
+	}
+}
@@ -9,14 +9,12 @@ package guildsservice
 
 import (
 	"context"
-
-	goa "goa.design/goa/v3/pkg"
 )
 
 // Service supports trading guild queries
 type Service interface {
 	// Get all guilds
-	GetAllGuilds(context.Context) (res *GetAllGuildsResult, err error)
+	GetAllGuilds(context.Context, *GetAllGuildsPayload) (res *GetAllGuildsResult, err error)
 	// Get a single guild base on ID
 	GetSingleGuild(context.Context, *GetSingleGuildPayload) (res *GetSingleGuildResult, err error)
 	// Get all members a given guild (include default member)
@@ -27,8 +25,13 @@ type Service interface {
 	GetGuildDefaultMember(context.Context, *GetGuildDefaultMemberPayload) (res *GetGuildDefaultMemberResult, err error)
 	// Enter the guild
 	EnterGuild(context.Context, *EnterGuildPayload) (res *EnterGuildResult, err error)
+	// Get waitlist of a guild at capacity, head of the queue first
+	GetGuildWaitlist(context.Context, *GetGuildWaitlistPayload) (res *GetGuildWaitlistResult, err error)
 	// Leave the guild, guildID
 	LeaveGuild(context.Context, *LeaveGuildPayload) (res *LeaveGuildResult, err error)
+	// Move a member to another guild without leaving, grants to the new guild
+	// master are required
+	TransferMember(context.Context, *TransferMemberPayload) (res *TransferMemberResult, err error)
 	// Get the guild markets
 	GetGuildMarkets(context.Context, *GetGuildMarketsPayload) (res *GetGuildMarketsResult, err error)
 	// Get the guild markets
@@ -39,9 +42,30 @@ type Service interface {
 	GetAccountPortfolio(context.Context, *GetAccountPortfolioPayload) (res *GetAccountPortfolioResult, err error)
 	// Get current account portfolios snapshots all the time
 	GetAccountPortfolios(context.Context, *GetAccountPortfoliosPayload) (res *GetAccountPortfoliosResult, err error)
+	// Get current and past guild memberships of an account, latest first
+	GetAccountMemberships(context.Context, *GetAccountMembershipsPayload) (res *GetAccountMembershipsResult, err error)
 	// Get current account portfolios monthly snapshots, including start_time,
 	// end_time snapshots
 	GetAccountMonthlyPortfolios(context.Context, *GetAccountMonthlyPortfoliosPayload) (res *GetAccountMonthlyPortfoliosResult, err error)
+	// Get required grants of a member with their expiration
+	GetAccountGrants(context.Context, *GetAccountGrantsPayload) (res *GetAccountGrantsResult, err error)
+	// Get ingested trades of a member, newest first
+	GetAccountTrades(context.Context, *GetAccountTradesPayload) (res *GetAccountTradesResult, err error)
+	// Get realized pnl, fee totals and volume of a member
+	GetAccountTradeStats(context.Context, *GetAccountTradeStatsPayload) (res *GetAccountTradeStatsResult, err error)
+	// Get realized pnl, fee totals and volume of a guild
+	GetGuildTradeStats(context.Context, *GetGuildTradeStatsPayload) (res *GetGuildTradeStatsResult, err error)
+	// Get fees routed to guild master address from member trades, computed daily
+	GetGuildFees(context.Context, *GetGuildFeesPayload) (res *GetGuildFeesResult, err error)
+	// Get ingested deposits and withdrawals of a member, newest first
+	GetAccountTransfers(context.Context, *GetAccountTransfersPayload) (res *GetAccountTransfersResult, err error)
+	// Get derivative positions of latest account portfolio snapshot
+	GetAccountPositions(context.Context, *GetAccountPositionsPayload) (res *GetAccountPositionsResult, err error)
+	// Get latest risk metrics of a guild
+	GetGuildRisk(context.Context, *GetGuildRiskPayload) (res *GetGuildRiskResult, err error)
+	// Stream portfolio snapshots and membership changes as they are written, gRPC
+	// only
+	StreamPortfolioUpdates(context.Context, *StreamPortfolioUpdatesPayload, StreamPortfolioUpdatesServerStream) (err error)
 }
 
 // ServiceName is the name of the service as defined in the design. This is the
@@ -52,7 +76,35 @@ const ServiceName = "GuildsService"
 // MethodNames lists the service method names as defined in the design. These
 // are the same values that are set in the endpoint request contexts under the
 // MethodKey key.
-var MethodNames = [13]string{"GetAllGuilds", "GetSingleGuild", "GetGuildMembers", "GetGuildMasterAddress", "GetGuildDefaultMember", "EnterGuild", "LeaveGuild", "GetGuildMarkets", "GetGuildPortfolios", "GetAccountInfo", "GetAccountPortfolio", "GetAccountPortfolios", "GetAccountMonthlyPortfolios"}
+var MethodNames = [25]string{"GetAllGuilds", "GetSingleGuild", "GetGuildMembers", "GetGuildMasterAddress", "GetGuildDefaultMember", "EnterGuild", "GetGuildWaitlist", "LeaveGuild", "TransferMember", "GetGuildMarkets", "GetGuildPortfolios", "GetAccountInfo", "GetAccountPortfolio", "GetAccountPortfolios", "GetAccountMemberships", "GetAccountMonthlyPortfolios", "GetAccountGrants", "GetAccountTrades", "GetAccountTradeStats", "GetGuildTradeStats", "GetGuildFees", "GetAccountTransfers", "GetAccountPositions", "GetGuildRisk", "StreamPortfolioUpdates"}
+
+// StreamPortfolioUpdatesServerStream is the interface a
+// "StreamPortfolioUpdates" endpoint server stream must satisfy.
+type StreamPortfolioUpdatesServerStream interface {
+	// Send streams instances of "PortfolioUpdate".
+	Send(*PortfolioUpdate) error
+	// Close closes the stream.
+	Close() error
+}
+
+// StreamPortfolioUpdatesClientStream is the interface a
+// "StreamPortfolioUpdates" endpoint client stream must satisfy.
+type StreamPortfolioUpdatesClientStream interface {
+	// Recv reads instances of "PortfolioUpdate" from the stream.
+	Recv() (*PortfolioUpdate, error)
+}
+
+// Error with a machine-readable code
+type APIError struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name string
+	// Machine-readable error code
+	Code string
+	// Error message in english
+	Message string
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string
+}
 
 type Balance struct {
 	Denom            string
@@ -63,19 +115,59 @@ type Balance struct {
 	PriceUsd         float64
 }
 
+// External flow into (positive amount) or out of (negative amount) a portfolio
+// since previous snapshot
+type CashFlow struct {
+	Type             string
+	InjectiveAddress *string
+	Denom            string
+	Amount           string
+	ExecutedAt       int64
+}
+
+// Guild fee totals of a UTC day
+type DailyGuildFee struct {
+	// Start of UTC day in milliseconds
+	Day            int64
+	QuoteDenom     string
+	FeesPaid       string
+	MasterFees     string
+	Volume         string
+	TradeCount     int
+	UnresolvedFees string
+}
+
 // EnterGuildPayload is the payload type of the GuildsService service
 // EnterGuild method.
 type EnterGuildPayload struct {
 	GuildID          string
 	InjectiveAddress string
 	Params           *string
+	// Non-default subaccounts owned by injective_address to trade from, default
+	// subaccount is always included
+	SubaccountIds []string
 }
 
 // EnterGuildResult is the result type of the GuildsService service EnterGuild
 // method.
 type EnterGuildResult struct {
+	// success, or waitlisted if guild is at capacity
 	JoinStatus *string
 	Message    *string
+	// 1-based position in guild waitlist if waitlisted
+	WaitlistPosition *int
+}
+
+// GetAccountGrantsPayload is the payload type of the GuildsService service
+// GetAccountGrants method.
+type GetAccountGrantsPayload struct {
+	InjectiveAddress string
+}
+
+// GetAccountGrantsResult is the result type of the GuildsService service
+// GetAccountGrants method.
+type GetAccountGrantsResult struct {
+	Grants []*Grant
 }
 
 // GetAccountInfoPayload is the payload type of the GuildsService service
@@ -90,6 +182,18 @@ type GetAccountInfoResult struct {
 	Data *GuildMember
 }
 
+// GetAccountMembershipsPayload is the payload type of the GuildsService
+// service GetAccountMemberships method.
+type GetAccountMembershipsPayload struct {
+	InjectiveAddress string
+}
+
+// GetAccountMembershipsResult is the result type of the GuildsService service
+// GetAccountMemberships method.
+type GetAccountMembershipsResult struct {
+	Memberships []*Membership
+}
+
 // GetAccountMonthlyPortfoliosPayload is the payload type of the GuildsService
 // service GetAccountMonthlyPortfolios method.
 type GetAccountMonthlyPortfoliosPayload struct {
@@ -122,12 +226,81 @@ type GetAccountPortfoliosPayload struct {
 	InjectiveAddress string
 	StartTime        *int64
 	EndTime          *int64
+	// Only return snapshots taken while address was member of the guild
+	GuildID *string
 }
 
 // GetAccountPortfoliosResult is the result type of the GuildsService service
 // GetAccountPortfolios method.
 type GetAccountPortfoliosResult struct {
 	Portfolios []*SingleAccountPortfolio
+	// Deposits and withdrawals in the requested range
+	NetFlows []*NetFlow
+}
+
+// GetAccountPositionsPayload is the payload type of the GuildsService service
+// GetAccountPositions method.
+type GetAccountPositionsPayload struct {
+	InjectiveAddress string
+}
+
+// GetAccountPositionsResult is the result type of the GuildsService service
+// GetAccountPositions method.
+type GetAccountPositionsResult struct {
+	Positions []*Position
+	UpdatedAt int64
+}
+
+// GetAccountTradeStatsPayload is the payload type of the GuildsService service
+// GetAccountTradeStats method.
+type GetAccountTradeStatsPayload struct {
+	InjectiveAddress string
+	StartTime        *int64
+	EndTime          *int64
+}
+
+// GetAccountTradeStatsResult is the result type of the GuildsService service
+// GetAccountTradeStats method.
+type GetAccountTradeStatsResult struct {
+	Stats []*TradeStats
+}
+
+// GetAccountTradesPayload is the payload type of the GuildsService service
+// GetAccountTrades method.
+type GetAccountTradesPayload struct {
+	InjectiveAddress string
+	StartTime        *int64
+	EndTime          *int64
+	Limit            *int64
+}
+
+// GetAccountTradesResult is the result type of the GuildsService service
+// GetAccountTrades method.
+type GetAccountTradesResult struct {
+	Trades []*Trade
+}
+
+// GetAccountTransfersPayload is the payload type of the GuildsService service
+// GetAccountTransfers method.
+type GetAccountTransfersPayload struct {
+	InjectiveAddress string
+	StartTime        *int64
+	EndTime          *int64
+	Limit            *int64
+}
+
+// GetAccountTransfersResult is the result type of the GuildsService service
+// GetAccountTransfers method.
+type GetAccountTransfersResult struct {
+	Transfers []*Transfer
+	NetFlows  []*NetFlow
+}
+
+// GetAllGuildsPayload is the payload type of the GuildsService service
+// GetAllGuilds method.
+type GetAllGuildsPayload struct {
+	// Include archived guilds
+	IncludeArchived *bool
 }
 
 // GetAllGuildsResult is the result type of the GuildsService service
@@ -149,6 +322,23 @@ type GetGuildDefaultMemberResult struct {
 	DefaultMember *GuildMember
 }
 
+// GetGuildFeesPayload is the payload type of the GuildsService service
+// GetGuildFees method.
+type GetGuildFeesPayload struct {
+	GuildID   string
+	StartTime *int64
+	EndTime   *int64
+}
+
+// GetGuildFeesResult is the result type of the GuildsService service
+// GetGuildFees method.
+type GetGuildFeesResult struct {
+	MasterAddress *string
+	Totals        []*GuildFeeTotal
+	Members       []*MemberFeeContribution
+	Daily         []*DailyGuildFee
+}
+
 // GetGuildMarketsPayload is the payload type of the GuildsService service
 // GetGuildMarkets method.
 type GetGuildMarketsPayload struct {
@@ -198,6 +388,48 @@ type GetGuildPortfoliosPayload struct {
 // GetGuildPortfolios method.
 type GetGuildPortfoliosResult struct {
 	Portfolios []*SingleGuildPortfolio
+	// Member deposits and withdrawals in the requested range
+	NetFlows []*NetFlow
+}
+
+// GetGuildRiskPayload is the payload type of the GuildsService service
+// GetGuildRisk method.
+type GetGuildRiskPayload struct {
+	GuildID string
+}
+
+// GetGuildRiskResult is the result type of the GuildsService service
+// GetGuildRisk method.
+type GetGuildRiskResult struct {
+	Data *GuildRisk
+}
+
+// GetGuildTradeStatsPayload is the payload type of the GuildsService service
+// GetGuildTradeStats method.
+type GetGuildTradeStatsPayload struct {
+	GuildID   string
+	StartTime *int64
+	EndTime   *int64
+}
+
+// GetGuildTradeStatsResult is the result type of the GuildsService service
+// GetGuildTradeStats method.
+type GetGuildTradeStatsResult struct {
+	Stats []*TradeStats
+}
+
+// GetGuildWaitlistPayload is the payload type of the GuildsService service
+// GetGuildWaitlist method.
+type GetGuildWaitlistPayload struct {
+	GuildID string
+	// Only return position of this address
+	InjectiveAddress *string
+}
+
+// GetGuildWaitlistResult is the result type of the GuildsService service
+// GetGuildWaitlist method.
+type GetGuildWaitlistResult struct {
+	Waitlist []*WaitlistEntry
 }
 
 // GetSingleGuildPayload is the payload type of the GuildsService service
@@ -213,6 +445,14 @@ type GetSingleGuildResult struct {
 	Guild *Guild
 }
 
+// Required grant from member to guild master
+type Grant struct {
+	Msg       string
+	IsGranted bool
+	// Grant expiration in milliseconds, omitted if not granted
+	Expiration *int64
+}
+
 // Guild info
 type Guild struct {
 	ID                   string
@@ -225,6 +465,27 @@ type Guild struct {
 	MemberCount          int
 	CurrentPortfolio     *SingleGuildPortfolio
 	DefaultMemberAddress string
+	// Guild has delisted or paused markets
+	HasInactiveMarkets *bool
+	// Archived guild is closed to new members, its history is kept
+	Archived *bool
+	// open, allowlist or invite_code, invite code is passed in params when
+	// entering guild
+	AccessMode *string
+}
+
+// Fees paid by guild members and routed to guild master in a quote denom
+type GuildFeeTotal struct {
+	QuoteDenom string
+	// Trading fees paid by members, maker rebates deducted
+	FeesPaid string
+	// Relayer share of fees earned by guild master address
+	MasterFees string
+	Volume     string
+	TradeCount int
+	// Relayer share of fees paid on trades whose fee recipient couldn't be
+	// resolved, not counted in master_fees
+	UnresolvedFees string
 }
 
 // Guild member metadata
@@ -234,6 +495,29 @@ type GuildMember struct {
 	Since                int64
 	GuildID              *string
 	Params               string
+	// Registered non-default subaccounts, portfolio is aggregated over default
+	// subaccount and these
+	SubaccountIds []string
+}
+
+// Guild risk metrics computed at a capture cycle
+type GuildRisk struct {
+	GuildID string
+	// Time of first guild snapshot used for drawdown, volatility and sharpe ratio
+	WindowStart   int64
+	SnapshotCount int
+	// Largest peak to trough decline of time-weighted value, as a positive fraction
+	MaxDrawdown float64
+	// Standard deviation of per-snapshot returns
+	Volatility float64
+	// Mean over standard deviation of per-snapshot returns, not annualized
+	SharpeRatio float64
+	NotionalUsd float64
+	EquityUsd   float64
+	// Notional over equity
+	Leverage  float64
+	Markets   []*MarketExposure
+	UpdatedAt int64
 }
 
 // LeaveGuildPayload is the payload type of the GuildsService service
@@ -253,6 +537,41 @@ type LeaveGuildResult struct {
 type Market struct {
 	MarketID    string
 	IsPerpetual bool
+	// Market status of exchange, empty if it has not been refreshed
+	Status       *string
+	MakerFeeRate *string
+	TakerFeeRate *string
+}
+
+// Usd notional of guild positions in a derivative market
+type MarketExposure struct {
+	MarketID    string
+	Ticker      string
+	NotionalUsd float64
+	// Fraction of guild total notional
+	Share float64
+}
+
+// Fees routed to guild master from a member's trades in a quote denom
+type MemberFeeContribution struct {
+	InjectiveAddress string
+	QuoteDenom       string
+	FeesPaid         string
+	MasterFees       string
+	Volume           string
+	TradeCount       int
+	// Member master_fees over guild master_fees in the quote denom, from 0 to 1
+	Share          string
+	UnresolvedFees string
+}
+
+// Guild membership of an account
+type Membership struct {
+	GuildID              string
+	IsDefaultGuildMember bool
+	Since                int64
+	// Time member left the guild in milliseconds, omitted for current membership
+	EndedAt *int64
 }
 
 // Account monthly first and last portfio snapshot of a month
@@ -262,6 +581,46 @@ type MonthlyAccountPortfolio struct {
 	EndSnapshot   *SingleAccountPortfolio
 }
 
+// Deposits and withdrawals aggregated in a denom, transfers with subaccounts
+// outside the guild count as deposits or withdrawals
+type NetFlow struct {
+	Denom       string
+	Deposits    string
+	Withdrawals string
+	// deposits - withdrawals
+	NetFlow       string
+	TransferCount int
+}
+
+// PortfolioUpdate is the result type of the GuildsService service
+// StreamPortfolioUpdates method.
+type PortfolioUpdate struct {
+	Kind    string
+	GuildID string
+	// Omitted for guild portfolio
+	InjectiveAddress *string
+	// Set for guild_portfolio and account_portfolio
+	Portfolio *StreamPortfolio
+	// Set for member_joined and member_left
+	Membership *StreamMembership
+	// Update ID to resume the stream with
+	ID string
+}
+
+// Derivative position held by a trading subaccount at snapshot time
+type Position struct {
+	SubaccountID     string
+	MarketID         string
+	Ticker           string
+	QuoteDenom       *string
+	Direction        string
+	Quantity         string
+	EntryPrice       string
+	MarkPrice        string
+	Margin           string
+	LiquidationPrice string
+}
+
 type Requirement struct {
 	Denom        string
 	MinAmountUsd float64
@@ -273,6 +632,18 @@ type SingleAccountPortfolio struct {
 	InjectiveAddress string
 	Balances         []*Balance
 	UpdatedAt        int64
+	// Usd value of balances, unrealized pnl and bank balances
+	ValueUsd  *float64
+	CashFlows []*CashFlow
+	// Usd value of cash flows since previous snapshot
+	NetFlowUsd *float64
+	// Cumulative time-weighted return since first snapshot of the requested range
+	Twr *float64
+	// Cumulative money-weighted (modified Dietz) return since first snapshot of
+	// the requested range
+	Mwr *float64
+	// Guild of the address at the time snapshot was taken
+	GuildID *string
 }
 
 // Single guild portfolio snapshot
@@ -280,31 +651,117 @@ type SingleGuildPortfolio struct {
 	GuildID   *string
 	Balances  []*Balance
 	UpdatedAt int64
+	// Usd value of balances, unrealized pnl and bank balances
+	ValueUsd  *float64
+	CashFlows []*CashFlow
+	// Usd value of member transfers and joins/leaves since previous snapshot
+	NetFlowUsd *float64
+	// Cumulative time-weighted return since first snapshot of the requested range
+	Twr *float64
+	// Cumulative money-weighted (modified Dietz) return since first snapshot of
+	// the requested range
+	Mwr *float64
+}
+
+// Membership change of a stream update
+type StreamMembership struct {
+	IsDefaultGuildMember bool
+	Since                int64
+	// Time member left the guild in milliseconds, set for member_left
+	EndedAt *int64
+}
+
+// Portfolio snapshot of a stream update
+type StreamPortfolio struct {
+	Balances []*Balance
+	// Member count of guild portfolio, omitted for account portfolio
+	MemberCount *int
+	UpdatedAt   int64
+}
+
+// StreamPortfolioUpdatesPayload is the payload type of the GuildsService
+// service StreamPortfolioUpdates method.
+type StreamPortfolioUpdatesPayload struct {
+	// Guild to stream, all guilds if empty
+	GuildID *string
+	// Member to stream, guild portfolios are not sent if set
+	InjectiveAddress *string
+	// ID of last update received, recent updates after it are sent first
+	LastEventID *string
+}
+
+// Member fill ingested from exchange api
+type Trade struct {
+	TradeID       string
+	SubaccountID  string
+	MarketID      string
+	IsPerpetual   bool
+	Direction     string
+	ExecutionType string
+	Price         string
+	Quantity      string
+	QuoteDenom    string
+	Fee           string
+	// price * quantity in quote denom
+	Volume string
+	// Pnl of closed quantity against average entry price, fee excluded
+	RealizedPnl string
+	ExecutedAt  int64
+}
+
+// Realized pnl, paid fees and volume of trades in a quote denom
+type TradeStats struct {
+	QuoteDenom  string
+	RealizedPnl string
+	Fees        string
+	Volume      string
+	TradeCount  int
+}
+
+// Deposit into or withdrawal from a member subaccount
+type Transfer struct {
+	TransferID   string
+	SubaccountID string
+	TransferType string
+	Denom        string
+	// Positive for deposit, negative for withdrawal
+	Amount     string
+	ExecutedAt int64
 }
 
-// MakeNotFound builds a goa.ServiceError from an error.
-func MakeNotFound(err error) *goa.ServiceError {
-	return &goa.ServiceError{
-		Name:    "not_found",
-		ID:      goa.NewErrorID(),
-		Message: err.Error(),
-	}
+// TransferMemberPayload is the payload type of the GuildsService service
+// TransferMember method.
+type TransferMemberPayload struct {
+	InjectiveAddress string
+	// Guild to move the member to
+	GuildID string
+	Params  *string
+	// Non-default subaccounts to trade from in the new guild, current subaccounts
+	// are kept if omitted
+	SubaccountIds []string
+}
+
+// TransferMemberResult is the result type of the GuildsService service
+// TransferMember method.
+type TransferMemberResult struct {
+	TransferStatus *string
+	FromGuildID    *string
+}
+
+// Address waiting for a slot in a guild
+type WaitlistEntry struct {
+	InjectiveAddress string
+	// 1-based position in waitlist
+	Position   int
+	EnqueuedAt int64
 }
 
-// MakeInvalidArg builds a goa.ServiceError from an error.
-func MakeInvalidArg(err error) *goa.ServiceError {
-	return &goa.ServiceError{
-		Name:    "invalid_arg",
-		ID:      goa.NewErrorID(),
-		Message: err.Error(),
-	}
+// Error returns an error description.
+func (e *APIError) Error() string {
+	return "Error with a machine-readable code"
 }
 
-// MakeInternal builds a goa.ServiceError from an error.
-func MakeInternal(err error) *goa.ServiceError {
-	return &goa.ServiceError{
-		Name:    "internal",
-		ID:      goa.NewErrorID(),
-		Message: err.Error(),
-	}
+// ErrorName returns "APIError".
+func (e *APIError) ErrorName() string {
+	return e.Name
 }
@@ -20,16 +20,15 @@ import (
 
 // UsageCommands returns the set of commands and sub-commands using the format
 //
-//    command (subcommand1|subcommand2|...)
-//
+//	command (subcommand1|subcommand2|...)
 func UsageCommands() string {
-	return `guilds-service (get-all-guilds|get-single-guild|get-guild-members|get-guild-master-address|get-guild-default-member|enter-guild|leave-guild|get-guild-markets|get-guild-portfolios|get-account-info|get-account-portfolio|get-account-portfolios|get-account-monthly-portfolios)
+	return `guilds-service (get-all-guilds|get-single-guild|get-guild-members|get-guild-master-address|get-guild-default-member|enter-guild|get-guild-waitlist|leave-guild|transfer-member|get-guild-markets|get-guild-portfolios|get-account-info|get-account-portfolio|get-account-portfolios|get-account-memberships|get-account-monthly-portfolios|get-account-grants|get-account-trades|get-account-trade-stats|get-guild-trade-stats|get-guild-fees|get-account-transfers|get-account-positions|get-guild-risk)
 `
 }
 
 // UsageExamples produces an example of a valid invocation of the CLI tool.
 func UsageExamples() string {
-	return os.Args[0] + ` guilds-service get-all-guilds` + "\n" +
+	return os.Args[0] + ` guilds-service get-all-guilds --include-archived true` + "\n" +
 		""
 }
 
@@ -45,7 +44,8 @@ func ParseEndpoint(
 	var (
 		guildsServiceFlags = flag.NewFlagSet("guilds-service", flag.ContinueOnError)
 
-		guildsServiceGetAllGuildsFlags = flag.NewFlagSet("get-all-guilds", flag.ExitOnError)
+		guildsServiceGetAllGuildsFlags               = flag.NewFlagSet("get-all-guilds", flag.ExitOnError)
+		guildsServiceGetAllGuildsIncludeArchivedFlag = guildsServiceGetAllGuildsFlags.String("include-archived", "", "")
 
 		guildsServiceGetSingleGuildFlags       = flag.NewFlagSet("get-single-guild", flag.ExitOnError)
 		guildsServiceGetSingleGuildGuildIDFlag = guildsServiceGetSingleGuildFlags.String("guild-id", "REQUIRED", "")
@@ -63,10 +63,18 @@ func ParseEndpoint(
 		guildsServiceEnterGuildBodyFlag    = guildsServiceEnterGuildFlags.String("body", "REQUIRED", "")
 		guildsServiceEnterGuildGuildIDFlag = guildsServiceEnterGuildFlags.String("guild-id", "REQUIRED", "")
 
+		guildsServiceGetGuildWaitlistFlags                = flag.NewFlagSet("get-guild-waitlist", flag.ExitOnError)
+		guildsServiceGetGuildWaitlistGuildIDFlag          = guildsServiceGetGuildWaitlistFlags.String("guild-id", "REQUIRED", "")
+		guildsServiceGetGuildWaitlistInjectiveAddressFlag = guildsServiceGetGuildWaitlistFlags.String("injective-address", "", "")
+
 		guildsServiceLeaveGuildFlags                = flag.NewFlagSet("leave-guild", flag.ExitOnError)
 		guildsServiceLeaveGuildGuildIDFlag          = guildsServiceLeaveGuildFlags.String("guild-id", "REQUIRED", "")
 		guildsServiceLeaveGuildInjectiveAddressFlag = guildsServiceLeaveGuildFlags.String("injective-address", "REQUIRED", "")
 
+		guildsServiceTransferMemberFlags                = flag.NewFlagSet("transfer-member", flag.ExitOnError)
+		guildsServiceTransferMemberBodyFlag             = guildsServiceTransferMemberFlags.String("body", "REQUIRED", "")
+		guildsServiceTransferMemberInjectiveAddressFlag = guildsServiceTransferMemberFlags.String("injective-address", "REQUIRED", "")
+
 		guildsServiceGetGuildMarketsFlags       = flag.NewFlagSet("get-guild-markets", flag.ExitOnError)
 		guildsServiceGetGuildMarketsGuildIDFlag = guildsServiceGetGuildMarketsFlags.String("guild-id", "REQUIRED", "")
 
@@ -85,11 +93,51 @@ func ParseEndpoint(
 		guildsServiceGetAccountPortfoliosInjectiveAddressFlag = guildsServiceGetAccountPortfoliosFlags.String("injective-address", "REQUIRED", "")
 		guildsServiceGetAccountPortfoliosStartTimeFlag        = guildsServiceGetAccountPortfoliosFlags.String("start-time", "", "")
 		guildsServiceGetAccountPortfoliosEndTimeFlag          = guildsServiceGetAccountPortfoliosFlags.String("end-time", "", "")
+		guildsServiceGetAccountPortfoliosGuildIDFlag          = guildsServiceGetAccountPortfoliosFlags.String("guild-id", "", "")
+
+		guildsServiceGetAccountMembershipsFlags                = flag.NewFlagSet("get-account-memberships", flag.ExitOnError)
+		guildsServiceGetAccountMembershipsInjectiveAddressFlag = guildsServiceGetAccountMembershipsFlags.String("injective-address", "REQUIRED", "")
 
 		guildsServiceGetAccountMonthlyPortfoliosFlags                = flag.NewFlagSet("get-account-monthly-portfolios", flag.ExitOnError)
 		guildsServiceGetAccountMonthlyPortfoliosInjectiveAddressFlag = guildsServiceGetAccountMonthlyPortfoliosFlags.String("injective-address", "REQUIRED", "")
 		guildsServiceGetAccountMonthlyPortfoliosStartTimeFlag        = guildsServiceGetAccountMonthlyPortfoliosFlags.String("start-time", "", "")
 		guildsServiceGetAccountMonthlyPortfoliosEndTimeFlag          = guildsServiceGetAccountMonthlyPortfoliosFlags.String("end-time", "", "")
+
+		guildsServiceGetAccountGrantsFlags                = flag.NewFlagSet("get-account-grants", flag.ExitOnError)
+		guildsServiceGetAccountGrantsInjectiveAddressFlag = guildsServiceGetAccountGrantsFlags.String("injective-address", "REQUIRED", "")
+
+		guildsServiceGetAccountTradesFlags                = flag.NewFlagSet("get-account-trades", flag.ExitOnError)
+		guildsServiceGetAccountTradesInjectiveAddressFlag = guildsServiceGetAccountTradesFlags.String("injective-address", "REQUIRED", "")
+		guildsServiceGetAccountTradesStartTimeFlag        = guildsServiceGetAccountTradesFlags.String("start-time", "", "")
+		guildsServiceGetAccountTradesEndTimeFlag          = guildsServiceGetAccountTradesFlags.String("end-time", "", "")
+		guildsServiceGetAccountTradesLimitFlag            = guildsServiceGetAccountTradesFlags.String("limit", "", "")
+
+		guildsServiceGetAccountTradeStatsFlags                = flag.NewFlagSet("get-account-trade-stats", flag.ExitOnError)
+		guildsServiceGetAccountTradeStatsInjectiveAddressFlag = guildsServiceGetAccountTradeStatsFlags.String("injective-address", "REQUIRED", "")
+		guildsServiceGetAccountTradeStatsStartTimeFlag        = guildsServiceGetAccountTradeStatsFlags.String("start-time", "", "")
+		guildsServiceGetAccountTradeStatsEndTimeFlag          = guildsServiceGetAccountTradeStatsFlags.String("end-time", "", "")
+
+		guildsServiceGetGuildTradeStatsFlags         = flag.NewFlagSet("get-guild-trade-stats", flag.ExitOnError)
+		guildsServiceGetGuildTradeStatsGuildIDFlag   = guildsServiceGetGuildTradeStatsFlags.String("guild-id", "REQUIRED", "")
+		guildsServiceGetGuildTradeStatsStartTimeFlag = guildsServiceGetGuildTradeStatsFlags.String("start-time", "", "")
+		guildsServiceGetGuildTradeStatsEndTimeFlag   = guildsServiceGetGuildTradeStatsFlags.String("end-time", "", "")
+
+		guildsServiceGetGuildFeesFlags         = flag.NewFlagSet("get-guild-fees", flag.ExitOnError)
+		guildsServiceGetGuildFeesGuildIDFlag   = guildsServiceGetGuildFeesFlags.String("guild-id", "REQUIRED", "")
+		guildsServiceGetGuildFeesStartTimeFlag = guildsServiceGetGuildFeesFlags.String("start-time", "", "")
+		guildsServiceGetGuildFeesEndTimeFlag   = guildsServiceGetGuildFeesFlags.String("end-time", "", "")
+
+		guildsServiceGetAccountTransfersFlags                = flag.NewFlagSet("get-account-transfers", flag.ExitOnError)
+		guildsServiceGetAccountTransfersInjectiveAddressFlag = guildsServiceGetAccountTransfersFlags.String("injective-address", "REQUIRED", "")
+		guildsServiceGetAccountTransfersStartTimeFlag        = guildsServiceGetAccountTransfersFlags.String("start-time", "", "")
+		guildsServiceGetAccountTransfersEndTimeFlag          = guildsServiceGetAccountTransfersFlags.String("end-time", "", "")
+		guildsServiceGetAccountTransfersLimitFlag            = guildsServiceGetAccountTransfersFlags.String("limit", "", "")
+
+		guildsServiceGetAccountPositionsFlags                = flag.NewFlagSet("get-account-positions", flag.ExitOnError)
+		guildsServiceGetAccountPositionsInjectiveAddressFlag = guildsServiceGetAccountPositionsFlags.String("injective-address", "REQUIRED", "")
+
+		guildsServiceGetGuildRiskFlags       = flag.NewFlagSet("get-guild-risk", flag.ExitOnError)
+		guildsServiceGetGuildRiskGuildIDFlag = guildsServiceGetGuildRiskFlags.String("guild-id", "REQUIRED", "")
 	)
 	guildsServiceFlags.Usage = guildsServiceUsage
 	guildsServiceGetAllGuildsFlags.Usage = guildsServiceGetAllGuildsUsage
@@ -98,13 +146,24 @@ func ParseEndpoint(
 	guildsServiceGetGuildMasterAddressFlags.Usage = guildsServiceGetGuildMasterAddressUsage
 	guildsServiceGetGuildDefaultMemberFlags.Usage = guildsServiceGetGuildDefaultMemberUsage
 	guildsServiceEnterGuildFlags.Usage = guildsServiceEnterGuildUsage
+	guildsServiceGetGuildWaitlistFlags.Usage = guildsServiceGetGuildWaitlistUsage
 	guildsServiceLeaveGuildFlags.Usage = guildsServiceLeaveGuildUsage
+	guildsServiceTransferMemberFlags.Usage = guildsServiceTransferMemberUsage
 	guildsServiceGetGuildMarketsFlags.Usage = guildsServiceGetGuildMarketsUsage
 	guildsServiceGetGuildPortfoliosFlags.Usage = guildsServiceGetGuildPortfoliosUsage
 	guildsServiceGetAccountInfoFlags.Usage = guildsServiceGetAccountInfoUsage
 	guildsServiceGetAccountPortfolioFlags.Usage = guildsServiceGetAccountPortfolioUsage
 	guildsServiceGetAccountPortfoliosFlags.Usage = guildsServiceGetAccountPortfoliosUsage
+	guildsServiceGetAccountMembershipsFlags.Usage = guildsServiceGetAccountMembershipsUsage
 	guildsServiceGetAccountMonthlyPortfoliosFlags.Usage = guildsServiceGetAccountMonthlyPortfoliosUsage
+	guildsServiceGetAccountGrantsFlags.Usage = guildsServiceGetAccountGrantsUsage
+	guildsServiceGetAccountTradesFlags.Usage = guildsServiceGetAccountTradesUsage
+	guildsServiceGetAccountTradeStatsFlags.Usage = guildsServiceGetAccountTradeStatsUsage
+	guildsServiceGetGuildTradeStatsFlags.Usage = guildsServiceGetGuildTradeStatsUsage
+	guildsServiceGetGuildFeesFlags.Usage = guildsServiceGetGuildFeesUsage
+	guildsServiceGetAccountTransfersFlags.Usage = guildsServiceGetAccountTransfersUsage
+	guildsServiceGetAccountPositionsFlags.Usage = guildsServiceGetAccountPositionsUsage
+	guildsServiceGetGuildRiskFlags.Usage = guildsServiceGetGuildRiskUsage
 
 	if err := flag.CommandLine.Parse(os.Args[1:]); err != nil {
 		return nil, nil, err
@@ -158,9 +217,15 @@ func ParseEndpoint(
 			case "enter-guild":
 				epf = guildsServiceEnterGuildFlags
 
+			case "get-guild-waitlist":
+				epf = guildsServiceGetGuildWaitlistFlags
+
 			case "leave-guild":
 				epf = guildsServiceLeaveGuildFlags
 
+			case "transfer-member":
+				epf = guildsServiceTransferMemberFlags
+
 			case "get-guild-markets":
 				epf = guildsServiceGetGuildMarketsFlags
 
@@ -176,9 +241,36 @@ func ParseEndpoint(
 			case "get-account-portfolios":
 				epf = guildsServiceGetAccountPortfoliosFlags
 
+			case "get-account-memberships":
+				epf = guildsServiceGetAccountMembershipsFlags
+
 			case "get-account-monthly-portfolios":
 				epf = guildsServiceGetAccountMonthlyPortfoliosFlags
 
+			case "get-account-grants":
+				epf = guildsServiceGetAccountGrantsFlags
+
+			case "get-account-trades":
+				epf = guildsServiceGetAccountTradesFlags
+
+			case "get-account-trade-stats":
+				epf = guildsServiceGetAccountTradeStatsFlags
+
+			case "get-guild-trade-stats":
+				epf = guildsServiceGetGuildTradeStatsFlags
+
+			case "get-guild-fees":
+				epf = guildsServiceGetGuildFeesFlags
+
+			case "get-account-transfers":
+				epf = guildsServiceGetAccountTransfersFlags
+
+			case "get-account-positions":
+				epf = guildsServiceGetAccountPositionsFlags
+
+			case "get-guild-risk":
+				epf = guildsServiceGetGuildRiskFlags
+
 			}
 
 		}
@@ -206,7 +298,7 @@ func ParseEndpoint(
 			switch epn {
 			case "get-all-guilds":
 				endpoint = c.GetAllGuilds()
-				data = nil
+				data, err = guildsservicec.BuildGetAllGuildsPayload(*guildsServiceGetAllGuildsIncludeArchivedFlag)
 			case "get-single-guild":
 				endpoint = c.GetSingleGuild()
 				data, err = guildsservicec.BuildGetSingleGuildPayload(*guildsServiceGetSingleGuildGuildIDFlag)
@@ -222,9 +314,15 @@ func ParseEndpoint(
 			case "enter-guild":
 				endpoint = c.EnterGuild()
 				data, err = guildsservicec.BuildEnterGuildPayload(*guildsServiceEnterGuildBodyFlag, *guildsServiceEnterGuildGuildIDFlag)
+			case "get-guild-waitlist":
+				endpoint = c.GetGuildWaitlist()
+				data, err = guildsservicec.BuildGetGuildWaitlistPayload(*guildsServiceGetGuildWaitlistGuildIDFlag, *guildsServiceGetGuildWaitlistInjectiveAddressFlag)
 			case "leave-guild":
 				endpoint = c.LeaveGuild()
 				data, err = guildsservicec.BuildLeaveGuildPayload(*guildsServiceLeaveGuildGuildIDFlag, *guildsServiceLeaveGuildInjectiveAddressFlag)
+			case "transfer-member":
+				endpoint = c.TransferMember()
+				data, err = guildsservicec.BuildTransferMemberPayload(*guildsServiceTransferMemberBodyFlag, *guildsServiceTransferMemberInjectiveAddressFlag)
 			case "get-guild-markets":
 				endpoint = c.GetGuildMarkets()
 				data, err = guildsservicec.BuildGetGuildMarketsPayload(*guildsServiceGetGuildMarketsGuildIDFlag)
@@ -239,10 +337,37 @@ func ParseEndpoint(
 				data, err = guildsservicec.BuildGetAccountPortfolioPayload(*guildsServiceGetAccountPortfolioInjectiveAddressFlag)
 			case "get-account-portfolios":
 				endpoint = c.GetAccountPortfolios()
-				data, err = guildsservicec.BuildGetAccountPortfoliosPayload(*guildsServiceGetAccountPortfoliosInjectiveAddressFlag, *guildsServiceGetAccountPortfoliosStartTimeFlag, *guildsServiceGetAccountPortfoliosEndTimeFlag)
+				data, err = guildsservicec.BuildGetAccountPortfoliosPayload(*guildsServiceGetAccountPortfoliosInjectiveAddressFlag, *guildsServiceGetAccountPortfoliosStartTimeFlag, *guildsServiceGetAccountPortfoliosEndTimeFlag, *guildsServiceGetAccountPortfoliosGuildIDFlag)
+			case "get-account-memberships":
+				endpoint = c.GetAccountMemberships()
+				data, err = guildsservicec.BuildGetAccountMembershipsPayload(*guildsServiceGetAccountMembershipsInjectiveAddressFlag)
 			case "get-account-monthly-portfolios":
 				endpoint = c.GetAccountMonthlyPortfolios()
 				data, err = guildsservicec.BuildGetAccountMonthlyPortfoliosPayload(*guildsServiceGetAccountMonthlyPortfoliosInjectiveAddressFlag, *guildsServiceGetAccountMonthlyPortfoliosStartTimeFlag, *guildsServiceGetAccountMonthlyPortfoliosEndTimeFlag)
+			case "get-account-grants":
+				endpoint = c.GetAccountGrants()
+				data, err = guildsservicec.BuildGetAccountGrantsPayload(*guildsServiceGetAccountGrantsInjectiveAddressFlag)
+			case "get-account-trades":
+				endpoint = c.GetAccountTrades()
+				data, err = guildsservicec.BuildGetAccountTradesPayload(*guildsServiceGetAccountTradesInjectiveAddressFlag, *guildsServiceGetAccountTradesStartTimeFlag, *guildsServiceGetAccountTradesEndTimeFlag, *guildsServiceGetAccountTradesLimitFlag)
+			case "get-account-trade-stats":
+				endpoint = c.GetAccountTradeStats()
+				data, err = guildsservicec.BuildGetAccountTradeStatsPayload(*guildsServiceGetAccountTradeStatsInjectiveAddressFlag, *guildsServiceGetAccountTradeStatsStartTimeFlag, *guildsServiceGetAccountTradeStatsEndTimeFlag)
+			case "get-guild-trade-stats":
+				endpoint = c.GetGuildTradeStats()
+				data, err = guildsservicec.BuildGetGuildTradeStatsPayload(*guildsServiceGetGuildTradeStatsGuildIDFlag, *guildsServiceGetGuildTradeStatsStartTimeFlag, *guildsServiceGetGuildTradeStatsEndTimeFlag)
+			case "get-guild-fees":
+				endpoint = c.GetGuildFees()
+				data, err = guildsservicec.BuildGetGuildFeesPayload(*guildsServiceGetGuildFeesGuildIDFlag, *guildsServiceGetGuildFeesStartTimeFlag, *guildsServiceGetGuildFeesEndTimeFlag)
+			case "get-account-transfers":
+				endpoint = c.GetAccountTransfers()
+				data, err = guildsservicec.BuildGetAccountTransfersPayload(*guildsServiceGetAccountTransfersInjectiveAddressFlag, *guildsServiceGetAccountTransfersStartTimeFlag, *guildsServiceGetAccountTransfersEndTimeFlag, *guildsServiceGetAccountTransfersLimitFlag)
+			case "get-account-positions":
+				endpoint = c.GetAccountPositions()
+				data, err = guildsservicec.BuildGetAccountPositionsPayload(*guildsServiceGetAccountPositionsInjectiveAddressFlag)
+			case "get-guild-risk":
+				endpoint = c.GetGuildRisk()
+				data, err = guildsservicec.BuildGetGuildRiskPayload(*guildsServiceGetGuildRiskGuildIDFlag)
 			}
 		}
 	}
@@ -267,25 +392,37 @@ COMMAND:
     get-guild-master-address: Get master address of given guild
     get-guild-default-member: Get default guild member
     enter-guild: Enter the guild
+    get-guild-waitlist: Get waitlist of a guild at capacity, head of the queue first
     leave-guild: Leave the guild, guildID
+    transfer-member: Move a member to another guild without leaving, grants to the new guild master are required
     get-guild-markets: Get the guild markets
     get-guild-portfolios: Get the guild markets
     get-account-info: Get current account member status
     get-account-portfolio: Get current account portfolio snapshot
     get-account-portfolios: Get current account portfolios snapshots all the time
+    get-account-memberships: Get current and past guild memberships of an account, latest first
     get-account-monthly-portfolios: Get current account portfolios monthly snapshots, including start_time, end_time snapshots
+    get-account-grants: Get required grants of a member with their expiration
+    get-account-trades: Get ingested trades of a member, newest first
+    get-account-trade-stats: Get realized pnl, fee totals and volume of a member
+    get-guild-trade-stats: Get realized pnl, fee totals and volume of a guild
+    get-guild-fees: Get fees routed to guild master address from member trades, computed daily
+    get-account-transfers: Get ingested deposits and withdrawals of a member, newest first
+    get-account-positions: Get derivative positions of latest account portfolio snapshot
+    get-guild-risk: Get latest risk metrics of a guild
 
 Additional help:
     %[1]s guilds-service COMMAND --help
 `, os.Args[0])
 }
 func guildsServiceGetAllGuildsUsage() {
-	fmt.Fprintf(os.Stderr, `%[1]s [flags] guilds-service get-all-guilds
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] guilds-service get-all-guilds -include-archived BOOL
 
 Get all guilds
+    -include-archived BOOL: 
 
 Example:
-    %[1]s guilds-service get-all-guilds
+    %[1]s guilds-service get-all-guilds --include-archived true
 `, os.Args[0])
 }
 
@@ -296,7 +433,7 @@ Get a single guild base on ID
     -guild-id STRING: 
 
 Example:
-    %[1]s guilds-service get-single-guild --guild-id "Id aut."
+    %[1]s guilds-service get-single-guild --guild-id "Consequatur tempore dolor eligendi molestiae."
 `, os.Args[0])
 }
 
@@ -307,7 +444,7 @@ Get all members a given guild (include default member)
     -guild-id STRING: 
 
 Example:
-    %[1]s guilds-service get-guild-members --guild-id "Adipisci reiciendis minima id."
+    %[1]s guilds-service get-guild-members --guild-id "Asperiores aut."
 `, os.Args[0])
 }
 
@@ -318,7 +455,7 @@ Get master address of given guild
     -guild-id STRING: 
 
 Example:
-    %[1]s guilds-service get-guild-master-address --guild-id "Eum vel quia nemo et placeat."
+    %[1]s guilds-service get-guild-master-address --guild-id "Et id nulla temporibus rem ipsam."
 `, os.Args[0])
 }
 
@@ -329,7 +466,7 @@ Get default guild member
     -guild-id STRING: 
 
 Example:
-    %[1]s guilds-service get-guild-default-member --guild-id "Alias at magnam ut magnam."
+    %[1]s guilds-service get-guild-default-member --guild-id "Et amet."
 `, os.Args[0])
 }
 
@@ -342,9 +479,26 @@ Enter the guild
 
 Example:
     %[1]s guilds-service enter-guild --body '{
-      "injective_address": "Iste eaque nesciunt.",
-      "params": "Provident odio deserunt."
-   }' --guild-id "Enim ab enim similique ipsa ut voluptatem."
+      "injective_address": "Quae quam amet.",
+      "params": "Sequi numquam animi.",
+      "subaccount_ids": [
+         "Quo fuga.",
+         "Autem debitis quidem est iure vel.",
+         "Occaecati voluptatem id quia."
+      ]
+   }' --guild-id "Ex totam eligendi est consequatur vel."
+`, os.Args[0])
+}
+
+func guildsServiceGetGuildWaitlistUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] guilds-service get-guild-waitlist -guild-id STRING -injective-address STRING
+
+Get waitlist of a guild at capacity, head of the queue first
+    -guild-id STRING: 
+    -injective-address STRING: 
+
+Example:
+    %[1]s guilds-service get-guild-waitlist --guild-id "Animi est nemo dolor architecto est quod." --injective-address "Minus id ullam quis rerum consequatur."
 `, os.Args[0])
 }
 
@@ -356,7 +510,26 @@ Leave the guild, guildID
     -injective-address STRING: 
 
 Example:
-    %[1]s guilds-service leave-guild --guild-id "Et eaque qui." --injective-address "Nulla et ipsum autem inventore sed maxime."
+    %[1]s guilds-service leave-guild --guild-id "Molestias deleniti nihil." --injective-address "Aliquid totam itaque eum et quibusdam."
+`, os.Args[0])
+}
+
+func guildsServiceTransferMemberUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] guilds-service transfer-member -body JSON -injective-address STRING
+
+Move a member to another guild without leaving, grants to the new guild master are required
+    -body JSON: 
+    -injective-address STRING: 
+
+Example:
+    %[1]s guilds-service transfer-member --body '{
+      "guild_id": "Quas aut et autem sint.",
+      "params": "Qui autem.",
+      "subaccount_ids": [
+         "Et voluptatem itaque quod et qui culpa.",
+         "Voluptate aut rerum quis rem fuga qui."
+      ]
+   }' --injective-address "Est odit corrupti dolor."
 `, os.Args[0])
 }
 
@@ -367,7 +540,7 @@ Get the guild markets
     -guild-id STRING: 
 
 Example:
-    %[1]s guilds-service get-guild-markets --guild-id "Aliquam aliquam cum cumque."
+    %[1]s guilds-service get-guild-markets --guild-id "Consequatur quisquam placeat praesentium voluptatibus."
 `, os.Args[0])
 }
 
@@ -380,7 +553,7 @@ Get the guild markets
     -end-time INT64: 
 
 Example:
-    %[1]s guilds-service get-guild-portfolios --guild-id "Rerum inventore ut." --start-time 6431764627704927984 --end-time 2948849279376133018
+    %[1]s guilds-service get-guild-portfolios --guild-id "In quae eum quae non molestiae." --start-time 2666178492332132993 --end-time 6527400901395156614
 `, os.Args[0])
 }
 
@@ -391,7 +564,7 @@ Get current account member status
     -injective-address STRING: 
 
 Example:
-    %[1]s guilds-service get-account-info --injective-address "Accusantium modi."
+    %[1]s guilds-service get-account-info --injective-address "Similique est quod aliquid consectetur est."
 `, os.Args[0])
 }
 
@@ -402,20 +575,32 @@ Get current account portfolio snapshot
     -injective-address STRING: 
 
 Example:
-    %[1]s guilds-service get-account-portfolio --injective-address "Quia omnis et nobis ab."
+    %[1]s guilds-service get-account-portfolio --injective-address "Pariatur in."
 `, os.Args[0])
 }
 
 func guildsServiceGetAccountPortfoliosUsage() {
-	fmt.Fprintf(os.Stderr, `%[1]s [flags] guilds-service get-account-portfolios -injective-address STRING -start-time INT64 -end-time INT64
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] guilds-service get-account-portfolios -injective-address STRING -start-time INT64 -end-time INT64 -guild-id STRING
 
 Get current account portfolios snapshots all the time
     -injective-address STRING: 
     -start-time INT64: 
     -end-time INT64: 
+    -guild-id STRING: 
+
+Example:
+    %[1]s guilds-service get-account-portfolios --injective-address "Non excepturi quae voluptatem perspiciatis rerum perferendis." --start-time 451199616838762020 --end-time 4158825390950407217 --guild-id "Praesentium excepturi unde fugit harum."
+`, os.Args[0])
+}
+
+func guildsServiceGetAccountMembershipsUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] guilds-service get-account-memberships -injective-address STRING
+
+Get current and past guild memberships of an account, latest first
+    -injective-address STRING: 
 
 Example:
-    %[1]s guilds-service get-account-portfolios --injective-address "Corporis omnis vitae maxime aperiam." --start-time 368398946302755242 --end-time 7731842352685932931
+    %[1]s guilds-service get-account-memberships --injective-address "Autem doloremque atque expedita sed iusto."
 `, os.Args[0])
 }
 
@@ -428,6 +613,106 @@ Get current account portfolios monthly snapshots, including start_time, end_time
     -end-time INT64: 
 
 Example:
-    %[1]s guilds-service get-account-monthly-portfolios --injective-address "Odio est dolorem sint eos." --start-time 3713714815008291278 --end-time 8233069734045214598
+    %[1]s guilds-service get-account-monthly-portfolios --injective-address "Atque dolorem quod facilis possimus id." --start-time 7269055194289639936 --end-time 8172167442191175129
+`, os.Args[0])
+}
+
+func guildsServiceGetAccountGrantsUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] guilds-service get-account-grants -injective-address STRING
+
+Get required grants of a member with their expiration
+    -injective-address STRING: 
+
+Example:
+    %[1]s guilds-service get-account-grants --injective-address "Est et autem."
+`, os.Args[0])
+}
+
+func guildsServiceGetAccountTradesUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] guilds-service get-account-trades -injective-address STRING -start-time INT64 -end-time INT64 -limit INT64
+
+Get ingested trades of a member, newest first
+    -injective-address STRING: 
+    -start-time INT64: 
+    -end-time INT64: 
+    -limit INT64: 
+
+Example:
+    %[1]s guilds-service get-account-trades --injective-address "Ipsa repudiandae sapiente dolorum iure." --start-time 8435170765836544615 --end-time 5274860095187690456 --limit 4522994196716884877
+`, os.Args[0])
+}
+
+func guildsServiceGetAccountTradeStatsUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] guilds-service get-account-trade-stats -injective-address STRING -start-time INT64 -end-time INT64
+
+Get realized pnl, fee totals and volume of a member
+    -injective-address STRING: 
+    -start-time INT64: 
+    -end-time INT64: 
+
+Example:
+    %[1]s guilds-service get-account-trade-stats --injective-address "Ea et eos." --start-time 452430387800266843 --end-time 6128454841599781806
+`, os.Args[0])
+}
+
+func guildsServiceGetGuildTradeStatsUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] guilds-service get-guild-trade-stats -guild-id STRING -start-time INT64 -end-time INT64
+
+Get realized pnl, fee totals and volume of a guild
+    -guild-id STRING: 
+    -start-time INT64: 
+    -end-time INT64: 
+
+Example:
+    %[1]s guilds-service get-guild-trade-stats --guild-id "Assumenda voluptatem rerum libero id." --start-time 3064118665791956710 --end-time 4302920713937197018
+`, os.Args[0])
+}
+
+func guildsServiceGetGuildFeesUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] guilds-service get-guild-fees -guild-id STRING -start-time INT64 -end-time INT64
+
+Get fees routed to guild master address from member trades, computed daily
+    -guild-id STRING: 
+    -start-time INT64: 
+    -end-time INT64: 
+
+Example:
+    %[1]s guilds-service get-guild-fees --guild-id "Ab occaecati aut quaerat et vel et." --start-time 7425723754671030494 --end-time 2396641177701149835
+`, os.Args[0])
+}
+
+func guildsServiceGetAccountTransfersUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] guilds-service get-account-transfers -injective-address STRING -start-time INT64 -end-time INT64 -limit INT64
+
+Get ingested deposits and withdrawals of a member, newest first
+    -injective-address STRING: 
+    -start-time INT64: 
+    -end-time INT64: 
+    -limit INT64: 
+
+Example:
+    %[1]s guilds-service get-account-transfers --injective-address "Sunt voluptate amet." --start-time 3279422990155530888 --end-time 465905963639327496 --limit 4582142090095382450
+`, os.Args[0])
+}
+
+func guildsServiceGetAccountPositionsUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] guilds-service get-account-positions -injective-address STRING
+
+Get derivative positions of latest account portfolio snapshot
+    -injective-address STRING: 
+
+Example:
+    %[1]s guilds-service get-account-positions --injective-address "Quas porro repellat harum quisquam."
+`, os.Args[0])
+}
+
+func guildsServiceGetGuildRiskUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] guilds-service get-guild-risk -guild-id STRING
+
+Get latest risk metrics of a guild
+    -guild-id STRING: 
+
+Example:
+    %[1]s guilds-service get-guild-risk --guild-id "Cum magni iure sunt harum id."
 `, os.Args[0])
 }
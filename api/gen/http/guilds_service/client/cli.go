@@ -15,6 +15,27 @@ import (
 	guildsservice "github.com/InjectiveLabs/injective-guilds-service/api/gen/guilds_service"
 )
 
+// BuildGetAllGuildsPayload builds the payload for the GuildsService
+// GetAllGuilds endpoint from CLI flags.
+func BuildGetAllGuildsPayload(guildsServiceGetAllGuildsIncludeArchived string) (*guildsservice.GetAllGuildsPayload, error) {
+	var err error
+	var includeArchived *bool
+	{
+		if guildsServiceGetAllGuildsIncludeArchived != "" {
+			var val bool
+			val, err = strconv.ParseBool(guildsServiceGetAllGuildsIncludeArchived)
+			includeArchived = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for includeArchived, must be BOOL")
+			}
+		}
+	}
+	v := &guildsservice.GetAllGuildsPayload{}
+	v.IncludeArchived = includeArchived
+
+	return v, nil
+}
+
 // BuildGetSingleGuildPayload builds the payload for the GuildsService
 // GetSingleGuild endpoint from CLI flags.
 func BuildGetSingleGuildPayload(guildsServiceGetSingleGuildGuildID string) (*guildsservice.GetSingleGuildPayload, error) {
@@ -75,7 +96,7 @@ func BuildEnterGuildPayload(guildsServiceEnterGuildBody string, guildsServiceEnt
 	{
 		err = json.Unmarshal([]byte(guildsServiceEnterGuildBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"injective_address\": \"Iste eaque nesciunt.\",\n      \"params\": \"Provident odio deserunt.\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"injective_address\": \"Quae quam amet.\",\n      \"params\": \"Sequi numquam animi.\",\n      \"subaccount_ids\": [\n         \"Quo fuga.\",\n         \"Autem debitis quidem est iure vel.\",\n         \"Occaecati voluptatem id quia.\"\n      ]\n   }'")
 		}
 	}
 	var guildID string
@@ -86,11 +107,37 @@ func BuildEnterGuildPayload(guildsServiceEnterGuildBody string, guildsServiceEnt
 		InjectiveAddress: body.InjectiveAddress,
 		Params:           body.Params,
 	}
+	if body.SubaccountIds != nil {
+		v.SubaccountIds = make([]string, len(body.SubaccountIds))
+		for i, val := range body.SubaccountIds {
+			v.SubaccountIds[i] = val
+		}
+	}
 	v.GuildID = guildID
 
 	return v, nil
 }
 
+// BuildGetGuildWaitlistPayload builds the payload for the GuildsService
+// GetGuildWaitlist endpoint from CLI flags.
+func BuildGetGuildWaitlistPayload(guildsServiceGetGuildWaitlistGuildID string, guildsServiceGetGuildWaitlistInjectiveAddress string) (*guildsservice.GetGuildWaitlistPayload, error) {
+	var guildID string
+	{
+		guildID = guildsServiceGetGuildWaitlistGuildID
+	}
+	var injectiveAddress *string
+	{
+		if guildsServiceGetGuildWaitlistInjectiveAddress != "" {
+			injectiveAddress = &guildsServiceGetGuildWaitlistInjectiveAddress
+		}
+	}
+	v := &guildsservice.GetGuildWaitlistPayload{}
+	v.GuildID = guildID
+	v.InjectiveAddress = injectiveAddress
+
+	return v, nil
+}
+
 // BuildLeaveGuildPayload builds the payload for the GuildsService LeaveGuild
 // endpoint from CLI flags.
 func BuildLeaveGuildPayload(guildsServiceLeaveGuildGuildID string, guildsServiceLeaveGuildInjectiveAddress string) (*guildsservice.LeaveGuildPayload, error) {
@@ -109,6 +156,36 @@ func BuildLeaveGuildPayload(guildsServiceLeaveGuildGuildID string, guildsService
 	return v, nil
 }
 
+// BuildTransferMemberPayload builds the payload for the GuildsService
+// TransferMember endpoint from CLI flags.
+func BuildTransferMemberPayload(guildsServiceTransferMemberBody string, guildsServiceTransferMemberInjectiveAddress string) (*guildsservice.TransferMemberPayload, error) {
+	var err error
+	var body TransferMemberRequestBody
+	{
+		err = json.Unmarshal([]byte(guildsServiceTransferMemberBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"guild_id\": \"Quas aut et autem sint.\",\n      \"params\": \"Qui autem.\",\n      \"subaccount_ids\": [\n         \"Et voluptatem itaque quod et qui culpa.\",\n         \"Voluptate aut rerum quis rem fuga qui.\"\n      ]\n   }'")
+		}
+	}
+	var injectiveAddress string
+	{
+		injectiveAddress = guildsServiceTransferMemberInjectiveAddress
+	}
+	v := &guildsservice.TransferMemberPayload{
+		GuildID: body.GuildID,
+		Params:  body.Params,
+	}
+	if body.SubaccountIds != nil {
+		v.SubaccountIds = make([]string, len(body.SubaccountIds))
+		for i, val := range body.SubaccountIds {
+			v.SubaccountIds[i] = val
+		}
+	}
+	v.InjectiveAddress = injectiveAddress
+
+	return v, nil
+}
+
 // BuildGetGuildMarketsPayload builds the payload for the GuildsService
 // GetGuildMarkets endpoint from CLI flags.
 func BuildGetGuildMarketsPayload(guildsServiceGetGuildMarketsGuildID string) (*guildsservice.GetGuildMarketsPayload, error) {
@@ -185,7 +262,7 @@ func BuildGetAccountPortfolioPayload(guildsServiceGetAccountPortfolioInjectiveAd
 
 // BuildGetAccountPortfoliosPayload builds the payload for the GuildsService
 // GetAccountPortfolios endpoint from CLI flags.
-func BuildGetAccountPortfoliosPayload(guildsServiceGetAccountPortfoliosInjectiveAddress string, guildsServiceGetAccountPortfoliosStartTime string, guildsServiceGetAccountPortfoliosEndTime string) (*guildsservice.GetAccountPortfoliosPayload, error) {
+func BuildGetAccountPortfoliosPayload(guildsServiceGetAccountPortfoliosInjectiveAddress string, guildsServiceGetAccountPortfoliosStartTime string, guildsServiceGetAccountPortfoliosEndTime string, guildsServiceGetAccountPortfoliosGuildID string) (*guildsservice.GetAccountPortfoliosPayload, error) {
 	var injectiveAddress string
 	{
 		injectiveAddress = guildsServiceGetAccountPortfoliosInjectiveAddress
@@ -210,10 +287,30 @@ func BuildGetAccountPortfoliosPayload(guildsServiceGetAccountPortfoliosInjective
 			}
 		}
 	}
+	var guildID *string
+	{
+		if guildsServiceGetAccountPortfoliosGuildID != "" {
+			guildID = &guildsServiceGetAccountPortfoliosGuildID
+		}
+	}
 	v := &guildsservice.GetAccountPortfoliosPayload{}
 	v.InjectiveAddress = injectiveAddress
 	v.StartTime = startTime
 	v.EndTime = endTime
+	v.GuildID = guildID
+
+	return v, nil
+}
+
+// BuildGetAccountMembershipsPayload builds the payload for the GuildsService
+// GetAccountMemberships endpoint from CLI flags.
+func BuildGetAccountMembershipsPayload(guildsServiceGetAccountMembershipsInjectiveAddress string) (*guildsservice.GetAccountMembershipsPayload, error) {
+	var injectiveAddress string
+	{
+		injectiveAddress = guildsServiceGetAccountMembershipsInjectiveAddress
+	}
+	v := &guildsservice.GetAccountMembershipsPayload{}
+	v.InjectiveAddress = injectiveAddress
 
 	return v, nil
 }
@@ -252,3 +349,239 @@ func BuildGetAccountMonthlyPortfoliosPayload(guildsServiceGetAccountMonthlyPortf
 
 	return v, nil
 }
+
+// BuildGetAccountGrantsPayload builds the payload for the GuildsService
+// GetAccountGrants endpoint from CLI flags.
+func BuildGetAccountGrantsPayload(guildsServiceGetAccountGrantsInjectiveAddress string) (*guildsservice.GetAccountGrantsPayload, error) {
+	var injectiveAddress string
+	{
+		injectiveAddress = guildsServiceGetAccountGrantsInjectiveAddress
+	}
+	v := &guildsservice.GetAccountGrantsPayload{}
+	v.InjectiveAddress = injectiveAddress
+
+	return v, nil
+}
+
+// BuildGetAccountTradesPayload builds the payload for the GuildsService
+// GetAccountTrades endpoint from CLI flags.
+func BuildGetAccountTradesPayload(guildsServiceGetAccountTradesInjectiveAddress string, guildsServiceGetAccountTradesStartTime string, guildsServiceGetAccountTradesEndTime string, guildsServiceGetAccountTradesLimit string) (*guildsservice.GetAccountTradesPayload, error) {
+	var injectiveAddress string
+	{
+		injectiveAddress = guildsServiceGetAccountTradesInjectiveAddress
+	}
+	var startTime *int64
+	{
+		if guildsServiceGetAccountTradesStartTime != "" {
+			val, err := strconv.ParseInt(guildsServiceGetAccountTradesStartTime, 10, 64)
+			startTime = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for startTime, must be INT64")
+			}
+		}
+	}
+	var endTime *int64
+	{
+		if guildsServiceGetAccountTradesEndTime != "" {
+			val, err := strconv.ParseInt(guildsServiceGetAccountTradesEndTime, 10, 64)
+			endTime = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for endTime, must be INT64")
+			}
+		}
+	}
+	var limit *int64
+	{
+		if guildsServiceGetAccountTradesLimit != "" {
+			val, err := strconv.ParseInt(guildsServiceGetAccountTradesLimit, 10, 64)
+			limit = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT64")
+			}
+		}
+	}
+	v := &guildsservice.GetAccountTradesPayload{}
+	v.InjectiveAddress = injectiveAddress
+	v.StartTime = startTime
+	v.EndTime = endTime
+	v.Limit = limit
+
+	return v, nil
+}
+
+// BuildGetAccountTradeStatsPayload builds the payload for the GuildsService
+// GetAccountTradeStats endpoint from CLI flags.
+func BuildGetAccountTradeStatsPayload(guildsServiceGetAccountTradeStatsInjectiveAddress string, guildsServiceGetAccountTradeStatsStartTime string, guildsServiceGetAccountTradeStatsEndTime string) (*guildsservice.GetAccountTradeStatsPayload, error) {
+	var injectiveAddress string
+	{
+		injectiveAddress = guildsServiceGetAccountTradeStatsInjectiveAddress
+	}
+	var startTime *int64
+	{
+		if guildsServiceGetAccountTradeStatsStartTime != "" {
+			val, err := strconv.ParseInt(guildsServiceGetAccountTradeStatsStartTime, 10, 64)
+			startTime = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for startTime, must be INT64")
+			}
+		}
+	}
+	var endTime *int64
+	{
+		if guildsServiceGetAccountTradeStatsEndTime != "" {
+			val, err := strconv.ParseInt(guildsServiceGetAccountTradeStatsEndTime, 10, 64)
+			endTime = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for endTime, must be INT64")
+			}
+		}
+	}
+	v := &guildsservice.GetAccountTradeStatsPayload{}
+	v.InjectiveAddress = injectiveAddress
+	v.StartTime = startTime
+	v.EndTime = endTime
+
+	return v, nil
+}
+
+// BuildGetGuildTradeStatsPayload builds the payload for the GuildsService
+// GetGuildTradeStats endpoint from CLI flags.
+func BuildGetGuildTradeStatsPayload(guildsServiceGetGuildTradeStatsGuildID string, guildsServiceGetGuildTradeStatsStartTime string, guildsServiceGetGuildTradeStatsEndTime string) (*guildsservice.GetGuildTradeStatsPayload, error) {
+	var guildID string
+	{
+		guildID = guildsServiceGetGuildTradeStatsGuildID
+	}
+	var startTime *int64
+	{
+		if guildsServiceGetGuildTradeStatsStartTime != "" {
+			val, err := strconv.ParseInt(guildsServiceGetGuildTradeStatsStartTime, 10, 64)
+			startTime = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for startTime, must be INT64")
+			}
+		}
+	}
+	var endTime *int64
+	{
+		if guildsServiceGetGuildTradeStatsEndTime != "" {
+			val, err := strconv.ParseInt(guildsServiceGetGuildTradeStatsEndTime, 10, 64)
+			endTime = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for endTime, must be INT64")
+			}
+		}
+	}
+	v := &guildsservice.GetGuildTradeStatsPayload{}
+	v.GuildID = guildID
+	v.StartTime = startTime
+	v.EndTime = endTime
+
+	return v, nil
+}
+
+// BuildGetGuildFeesPayload builds the payload for the GuildsService
+// GetGuildFees endpoint from CLI flags.
+func BuildGetGuildFeesPayload(guildsServiceGetGuildFeesGuildID string, guildsServiceGetGuildFeesStartTime string, guildsServiceGetGuildFeesEndTime string) (*guildsservice.GetGuildFeesPayload, error) {
+	var guildID string
+	{
+		guildID = guildsServiceGetGuildFeesGuildID
+	}
+	var startTime *int64
+	{
+		if guildsServiceGetGuildFeesStartTime != "" {
+			val, err := strconv.ParseInt(guildsServiceGetGuildFeesStartTime, 10, 64)
+			startTime = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for startTime, must be INT64")
+			}
+		}
+	}
+	var endTime *int64
+	{
+		if guildsServiceGetGuildFeesEndTime != "" {
+			val, err := strconv.ParseInt(guildsServiceGetGuildFeesEndTime, 10, 64)
+			endTime = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for endTime, must be INT64")
+			}
+		}
+	}
+	v := &guildsservice.GetGuildFeesPayload{}
+	v.GuildID = guildID
+	v.StartTime = startTime
+	v.EndTime = endTime
+
+	return v, nil
+}
+
+// BuildGetAccountTransfersPayload builds the payload for the GuildsService
+// GetAccountTransfers endpoint from CLI flags.
+func BuildGetAccountTransfersPayload(guildsServiceGetAccountTransfersInjectiveAddress string, guildsServiceGetAccountTransfersStartTime string, guildsServiceGetAccountTransfersEndTime string, guildsServiceGetAccountTransfersLimit string) (*guildsservice.GetAccountTransfersPayload, error) {
+	var injectiveAddress string
+	{
+		injectiveAddress = guildsServiceGetAccountTransfersInjectiveAddress
+	}
+	var startTime *int64
+	{
+		if guildsServiceGetAccountTransfersStartTime != "" {
+			val, err := strconv.ParseInt(guildsServiceGetAccountTransfersStartTime, 10, 64)
+			startTime = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for startTime, must be INT64")
+			}
+		}
+	}
+	var endTime *int64
+	{
+		if guildsServiceGetAccountTransfersEndTime != "" {
+			val, err := strconv.ParseInt(guildsServiceGetAccountTransfersEndTime, 10, 64)
+			endTime = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for endTime, must be INT64")
+			}
+		}
+	}
+	var limit *int64
+	{
+		if guildsServiceGetAccountTransfersLimit != "" {
+			val, err := strconv.ParseInt(guildsServiceGetAccountTransfersLimit, 10, 64)
+			limit = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT64")
+			}
+		}
+	}
+	v := &guildsservice.GetAccountTransfersPayload{}
+	v.InjectiveAddress = injectiveAddress
+	v.StartTime = startTime
+	v.EndTime = endTime
+	v.Limit = limit
+
+	return v, nil
+}
+
+// BuildGetAccountPositionsPayload builds the payload for the GuildsService
+// GetAccountPositions endpoint from CLI flags.
+func BuildGetAccountPositionsPayload(guildsServiceGetAccountPositionsInjectiveAddress string) (*guildsservice.GetAccountPositionsPayload, error) {
+	var injectiveAddress string
+	{
+		injectiveAddress = guildsServiceGetAccountPositionsInjectiveAddress
+	}
+	v := &guildsservice.GetAccountPositionsPayload{}
+	v.InjectiveAddress = injectiveAddress
+
+	return v, nil
+}
+
+// BuildGetGuildRiskPayload builds the payload for the GuildsService
+// GetGuildRisk endpoint from CLI flags.
+func BuildGetGuildRiskPayload(guildsServiceGetGuildRiskGuildID string) (*guildsservice.GetGuildRiskPayload, error) {
+	var guildID string
+	{
+		guildID = guildsServiceGetGuildRiskGuildID
+	}
+	v := &guildsservice.GetGuildRiskPayload{}
+	v.GuildID = guildID
+
+	return v, nil
+}
@@ -41,10 +41,18 @@ type Client struct {
 	// endpoint.
 	EnterGuildDoer goahttp.Doer
 
+	// GetGuildWaitlist Doer is the HTTP client used to make requests to the
+	// GetGuildWaitlist endpoint.
+	GetGuildWaitlistDoer goahttp.Doer
+
 	// LeaveGuild Doer is the HTTP client used to make requests to the LeaveGuild
 	// endpoint.
 	LeaveGuildDoer goahttp.Doer
 
+	// TransferMember Doer is the HTTP client used to make requests to the
+	// TransferMember endpoint.
+	TransferMemberDoer goahttp.Doer
+
 	// GetGuildMarkets Doer is the HTTP client used to make requests to the
 	// GetGuildMarkets endpoint.
 	GetGuildMarketsDoer goahttp.Doer
@@ -65,10 +73,46 @@ type Client struct {
 	// GetAccountPortfolios endpoint.
 	GetAccountPortfoliosDoer goahttp.Doer
 
+	// GetAccountMemberships Doer is the HTTP client used to make requests to the
+	// GetAccountMemberships endpoint.
+	GetAccountMembershipsDoer goahttp.Doer
+
 	// GetAccountMonthlyPortfolios Doer is the HTTP client used to make requests to
 	// the GetAccountMonthlyPortfolios endpoint.
 	GetAccountMonthlyPortfoliosDoer goahttp.Doer
 
+	// GetAccountGrants Doer is the HTTP client used to make requests to the
+	// GetAccountGrants endpoint.
+	GetAccountGrantsDoer goahttp.Doer
+
+	// GetAccountTrades Doer is the HTTP client used to make requests to the
+	// GetAccountTrades endpoint.
+	GetAccountTradesDoer goahttp.Doer
+
+	// GetAccountTradeStats Doer is the HTTP client used to make requests to the
+	// GetAccountTradeStats endpoint.
+	GetAccountTradeStatsDoer goahttp.Doer
+
+	// GetGuildTradeStats Doer is the HTTP client used to make requests to the
+	// GetGuildTradeStats endpoint.
+	GetGuildTradeStatsDoer goahttp.Doer
+
+	// GetGuildFees Doer is the HTTP client used to make requests to the
+	// GetGuildFees endpoint.
+	GetGuildFeesDoer goahttp.Doer
+
+	// GetAccountTransfers Doer is the HTTP client used to make requests to the
+	// GetAccountTransfers endpoint.
+	GetAccountTransfersDoer goahttp.Doer
+
+	// GetAccountPositions Doer is the HTTP client used to make requests to the
+	// GetAccountPositions endpoint.
+	GetAccountPositionsDoer goahttp.Doer
+
+	// GetGuildRisk Doer is the HTTP client used to make requests to the
+	// GetGuildRisk endpoint.
+	GetGuildRiskDoer goahttp.Doer
+
 	// CORS Doer is the HTTP client used to make requests to the  endpoint.
 	CORSDoer goahttp.Doer
 
@@ -99,13 +143,24 @@ func NewClient(
 		GetGuildMasterAddressDoer:       doer,
 		GetGuildDefaultMemberDoer:       doer,
 		EnterGuildDoer:                  doer,
+		GetGuildWaitlistDoer:            doer,
 		LeaveGuildDoer:                  doer,
+		TransferMemberDoer:              doer,
 		GetGuildMarketsDoer:             doer,
 		GetGuildPortfoliosDoer:          doer,
 		GetAccountInfoDoer:              doer,
 		GetAccountPortfolioDoer:         doer,
 		GetAccountPortfoliosDoer:        doer,
+		GetAccountMembershipsDoer:       doer,
 		GetAccountMonthlyPortfoliosDoer: doer,
+		GetAccountGrantsDoer:            doer,
+		GetAccountTradesDoer:            doer,
+		GetAccountTradeStatsDoer:        doer,
+		GetGuildTradeStatsDoer:          doer,
+		GetGuildFeesDoer:                doer,
+		GetAccountTransfersDoer:         doer,
+		GetAccountPositionsDoer:         doer,
+		GetGuildRiskDoer:                doer,
 		CORSDoer:                        doer,
 		RestoreResponseBody:             restoreBody,
 		scheme:                          scheme,
@@ -119,6 +174,7 @@ func NewClient(
 // GuildsService service GetAllGuilds server.
 func (c *Client) GetAllGuilds() goa.Endpoint {
 	var (
+		encodeRequest  = EncodeGetAllGuildsRequest(c.encoder)
 		decodeResponse = DecodeGetAllGuildsResponse(c.decoder, c.RestoreResponseBody)
 	)
 	return func(ctx context.Context, v interface{}) (interface{}, error) {
@@ -126,6 +182,10 @@ func (c *Client) GetAllGuilds() goa.Endpoint {
 		if err != nil {
 			return nil, err
 		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
 		resp, err := c.GetAllGuildsDoer.Do(req)
 		if err != nil {
 			return nil, goahttp.ErrRequestError("GuildsService", "GetAllGuilds", err)
@@ -234,6 +294,30 @@ func (c *Client) EnterGuild() goa.Endpoint {
 	}
 }
 
+// GetGuildWaitlist returns an endpoint that makes HTTP requests to the
+// GuildsService service GetGuildWaitlist server.
+func (c *Client) GetGuildWaitlist() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetGuildWaitlistRequest(c.encoder)
+		decodeResponse = DecodeGetGuildWaitlistResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		req, err := c.BuildGetGuildWaitlistRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetGuildWaitlistDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("GuildsService", "GetGuildWaitlist", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // LeaveGuild returns an endpoint that makes HTTP requests to the GuildsService
 // service LeaveGuild server.
 func (c *Client) LeaveGuild() goa.Endpoint {
@@ -253,6 +337,30 @@ func (c *Client) LeaveGuild() goa.Endpoint {
 	}
 }
 
+// TransferMember returns an endpoint that makes HTTP requests to the
+// GuildsService service TransferMember server.
+func (c *Client) TransferMember() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeTransferMemberRequest(c.encoder)
+		decodeResponse = DecodeTransferMemberResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		req, err := c.BuildTransferMemberRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.TransferMemberDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("GuildsService", "TransferMember", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // GetGuildMarkets returns an endpoint that makes HTTP requests to the
 // GuildsService service GetGuildMarkets server.
 func (c *Client) GetGuildMarkets() goa.Endpoint {
@@ -358,6 +466,25 @@ func (c *Client) GetAccountPortfolios() goa.Endpoint {
 	}
 }
 
+// GetAccountMemberships returns an endpoint that makes HTTP requests to the
+// GuildsService service GetAccountMemberships server.
+func (c *Client) GetAccountMemberships() goa.Endpoint {
+	var (
+		decodeResponse = DecodeGetAccountMembershipsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		req, err := c.BuildGetAccountMembershipsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetAccountMembershipsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("GuildsService", "GetAccountMemberships", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // GetAccountMonthlyPortfolios returns an endpoint that makes HTTP requests to
 // the GuildsService service GetAccountMonthlyPortfolios server.
 func (c *Client) GetAccountMonthlyPortfolios() goa.Endpoint {
@@ -381,3 +508,180 @@ func (c *Client) GetAccountMonthlyPortfolios() goa.Endpoint {
 		return decodeResponse(resp)
 	}
 }
+
+// GetAccountGrants returns an endpoint that makes HTTP requests to the
+// GuildsService service GetAccountGrants server.
+func (c *Client) GetAccountGrants() goa.Endpoint {
+	var (
+		decodeResponse = DecodeGetAccountGrantsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		req, err := c.BuildGetAccountGrantsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetAccountGrantsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("GuildsService", "GetAccountGrants", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetAccountTrades returns an endpoint that makes HTTP requests to the
+// GuildsService service GetAccountTrades server.
+func (c *Client) GetAccountTrades() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetAccountTradesRequest(c.encoder)
+		decodeResponse = DecodeGetAccountTradesResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		req, err := c.BuildGetAccountTradesRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetAccountTradesDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("GuildsService", "GetAccountTrades", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetAccountTradeStats returns an endpoint that makes HTTP requests to the
+// GuildsService service GetAccountTradeStats server.
+func (c *Client) GetAccountTradeStats() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetAccountTradeStatsRequest(c.encoder)
+		decodeResponse = DecodeGetAccountTradeStatsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		req, err := c.BuildGetAccountTradeStatsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetAccountTradeStatsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("GuildsService", "GetAccountTradeStats", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetGuildTradeStats returns an endpoint that makes HTTP requests to the
+// GuildsService service GetGuildTradeStats server.
+func (c *Client) GetGuildTradeStats() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetGuildTradeStatsRequest(c.encoder)
+		decodeResponse = DecodeGetGuildTradeStatsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		req, err := c.BuildGetGuildTradeStatsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetGuildTradeStatsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("GuildsService", "GetGuildTradeStats", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetGuildFees returns an endpoint that makes HTTP requests to the
+// GuildsService service GetGuildFees server.
+func (c *Client) GetGuildFees() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetGuildFeesRequest(c.encoder)
+		decodeResponse = DecodeGetGuildFeesResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		req, err := c.BuildGetGuildFeesRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetGuildFeesDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("GuildsService", "GetGuildFees", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetAccountTransfers returns an endpoint that makes HTTP requests to the
+// GuildsService service GetAccountTransfers server.
+func (c *Client) GetAccountTransfers() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetAccountTransfersRequest(c.encoder)
+		decodeResponse = DecodeGetAccountTransfersResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		req, err := c.BuildGetAccountTransfersRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetAccountTransfersDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("GuildsService", "GetAccountTransfers", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetAccountPositions returns an endpoint that makes HTTP requests to the
+// GuildsService service GetAccountPositions server.
+func (c *Client) GetAccountPositions() goa.Endpoint {
+	var (
+		decodeResponse = DecodeGetAccountPositionsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		req, err := c.BuildGetAccountPositionsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetAccountPositionsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("GuildsService", "GetAccountPositions", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetGuildRisk returns an endpoint that makes HTTP requests to the
+// GuildsService service GetGuildRisk server.
+func (c *Client) GetGuildRisk() goa.Endpoint {
+	var (
+		decodeResponse = DecodeGetGuildRiskResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		req, err := c.BuildGetGuildRiskRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetGuildRiskDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("GuildsService", "GetGuildRisk", err)
+		}
+		return decodeResponse(resp)
+	}
+}
@@ -34,13 +34,31 @@ func (c *Client) BuildGetAllGuildsRequest(ctx context.Context, v interface{}) (*
 	return req, nil
 }
 
+// EncodeGetAllGuildsRequest returns an encoder for requests sent to the
+// GuildsService GetAllGuilds server.
+func EncodeGetAllGuildsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, interface{}) error {
+	return func(req *http.Request, v interface{}) error {
+		p, ok := v.(*guildsservice.GetAllGuildsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("GuildsService", "GetAllGuilds", "*guildsservice.GetAllGuildsPayload", v)
+		}
+		values := req.URL.Query()
+		if p.IncludeArchived != nil {
+			values.Add("include_archived", fmt.Sprintf("%v", *p.IncludeArchived))
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
 // DecodeGetAllGuildsResponse returns a decoder for responses returned by the
 // GuildsService GetAllGuilds endpoint. restoreBody controls whether the
 // response body should be restored after having been read.
 // DecodeGetAllGuildsResponse may return the following errors:
-//	- "not_found" (type *goa.ServiceError): http.StatusNotFound
-//	- "internal" (type *goa.ServiceError): http.StatusInternalServerError
-//	- error: internal error
+//   - "not_found" (type *guildsservice.APIError): http.StatusNotFound
+//   - "internal" (type *guildsservice.APIError): http.StatusInternalServerError
+//   - "invalid_arg" (type *guildsservice.APIError): http.StatusBadRequest
+//   - error: internal error
 func DecodeGetAllGuildsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (interface{}, error) {
 	return func(resp *http.Response) (interface{}, error) {
 		if restoreBody {
@@ -99,6 +117,20 @@ func DecodeGetAllGuildsResponse(decoder func(*http.Response) goahttp.Decoder, re
 				return nil, goahttp.ErrValidationError("GuildsService", "GetAllGuilds", err)
 			}
 			return nil, NewGetAllGuildsInternal(&body)
+		case http.StatusBadRequest:
+			var (
+				body GetAllGuildsInvalidArgResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetAllGuilds", err)
+			}
+			err = ValidateGetAllGuildsInvalidArgResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("GuildsService", "GetAllGuilds", err)
+			}
+			return nil, NewGetAllGuildsInvalidArg(&body)
 		default:
 			body, _ := ioutil.ReadAll(resp.Body)
 			return nil, goahttp.ErrInvalidResponse("GuildsService", "GetAllGuilds", resp.StatusCode, string(body))
@@ -135,9 +167,10 @@ func (c *Client) BuildGetSingleGuildRequest(ctx context.Context, v interface{})
 // GuildsService GetSingleGuild endpoint. restoreBody controls whether the
 // response body should be restored after having been read.
 // DecodeGetSingleGuildResponse may return the following errors:
-//	- "not_found" (type *goa.ServiceError): http.StatusNotFound
-//	- "internal" (type *goa.ServiceError): http.StatusInternalServerError
-//	- error: internal error
+//   - "not_found" (type *guildsservice.APIError): http.StatusNotFound
+//   - "internal" (type *guildsservice.APIError): http.StatusInternalServerError
+//   - "invalid_arg" (type *guildsservice.APIError): http.StatusBadRequest
+//   - error: internal error
 func DecodeGetSingleGuildResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (interface{}, error) {
 	return func(resp *http.Response) (interface{}, error) {
 		if restoreBody {
@@ -196,6 +229,20 @@ func DecodeGetSingleGuildResponse(decoder func(*http.Response) goahttp.Decoder,
 				return nil, goahttp.ErrValidationError("GuildsService", "GetSingleGuild", err)
 			}
 			return nil, NewGetSingleGuildInternal(&body)
+		case http.StatusBadRequest:
+			var (
+				body GetSingleGuildInvalidArgResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetSingleGuild", err)
+			}
+			err = ValidateGetSingleGuildInvalidArgResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("GuildsService", "GetSingleGuild", err)
+			}
+			return nil, NewGetSingleGuildInvalidArg(&body)
 		default:
 			body, _ := ioutil.ReadAll(resp.Body)
 			return nil, goahttp.ErrInvalidResponse("GuildsService", "GetSingleGuild", resp.StatusCode, string(body))
@@ -232,9 +279,10 @@ func (c *Client) BuildGetGuildMembersRequest(ctx context.Context, v interface{})
 // the GuildsService GetGuildMembers endpoint. restoreBody controls whether the
 // response body should be restored after having been read.
 // DecodeGetGuildMembersResponse may return the following errors:
-//	- "not_found" (type *goa.ServiceError): http.StatusNotFound
-//	- "internal" (type *goa.ServiceError): http.StatusInternalServerError
-//	- error: internal error
+//   - "invalid_arg" (type *guildsservice.APIError): http.StatusBadRequest
+//   - "not_found" (type *guildsservice.APIError): http.StatusNotFound
+//   - "internal" (type *guildsservice.APIError): http.StatusInternalServerError
+//   - error: internal error
 func DecodeGetGuildMembersResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (interface{}, error) {
 	return func(resp *http.Response) (interface{}, error) {
 		if restoreBody {
@@ -265,6 +313,20 @@ func DecodeGetGuildMembersResponse(decoder func(*http.Response) goahttp.Decoder,
 			}
 			res := NewGetGuildMembersResultOK(&body)
 			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body GetGuildMembersInvalidArgResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetGuildMembers", err)
+			}
+			err = ValidateGetGuildMembersInvalidArgResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("GuildsService", "GetGuildMembers", err)
+			}
+			return nil, NewGetGuildMembersInvalidArg(&body)
 		case http.StatusNotFound:
 			var (
 				body GetGuildMembersNotFoundResponseBody
@@ -330,9 +392,10 @@ func (c *Client) BuildGetGuildMasterAddressRequest(ctx context.Context, v interf
 // by the GuildsService GetGuildMasterAddress endpoint. restoreBody controls
 // whether the response body should be restored after having been read.
 // DecodeGetGuildMasterAddressResponse may return the following errors:
-//	- "not_found" (type *goa.ServiceError): http.StatusNotFound
-//	- "internal" (type *goa.ServiceError): http.StatusInternalServerError
-//	- error: internal error
+//   - "not_found" (type *guildsservice.APIError): http.StatusNotFound
+//   - "internal" (type *guildsservice.APIError): http.StatusInternalServerError
+//   - "invalid_arg" (type *guildsservice.APIError): http.StatusBadRequest
+//   - error: internal error
 func DecodeGetGuildMasterAddressResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (interface{}, error) {
 	return func(resp *http.Response) (interface{}, error) {
 		if restoreBody {
@@ -387,6 +450,20 @@ func DecodeGetGuildMasterAddressResponse(decoder func(*http.Response) goahttp.De
 				return nil, goahttp.ErrValidationError("GuildsService", "GetGuildMasterAddress", err)
 			}
 			return nil, NewGetGuildMasterAddressInternal(&body)
+		case http.StatusBadRequest:
+			var (
+				body GetGuildMasterAddressInvalidArgResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetGuildMasterAddress", err)
+			}
+			err = ValidateGetGuildMasterAddressInvalidArgResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("GuildsService", "GetGuildMasterAddress", err)
+			}
+			return nil, NewGetGuildMasterAddressInvalidArg(&body)
 		default:
 			body, _ := ioutil.ReadAll(resp.Body)
 			return nil, goahttp.ErrInvalidResponse("GuildsService", "GetGuildMasterAddress", resp.StatusCode, string(body))
@@ -424,9 +501,10 @@ func (c *Client) BuildGetGuildDefaultMemberRequest(ctx context.Context, v interf
 // by the GuildsService GetGuildDefaultMember endpoint. restoreBody controls
 // whether the response body should be restored after having been read.
 // DecodeGetGuildDefaultMemberResponse may return the following errors:
-//	- "not_found" (type *goa.ServiceError): http.StatusNotFound
-//	- "internal" (type *goa.ServiceError): http.StatusInternalServerError
-//	- error: internal error
+//   - "invalid_arg" (type *guildsservice.APIError): http.StatusBadRequest
+//   - "not_found" (type *guildsservice.APIError): http.StatusNotFound
+//   - "internal" (type *guildsservice.APIError): http.StatusInternalServerError
+//   - error: internal error
 func DecodeGetGuildDefaultMemberResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (interface{}, error) {
 	return func(resp *http.Response) (interface{}, error) {
 		if restoreBody {
@@ -457,6 +535,20 @@ func DecodeGetGuildDefaultMemberResponse(decoder func(*http.Response) goahttp.De
 			}
 			res := NewGetGuildDefaultMemberResultOK(&body)
 			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body GetGuildDefaultMemberInvalidArgResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetGuildDefaultMember", err)
+			}
+			err = ValidateGetGuildDefaultMemberInvalidArgResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("GuildsService", "GetGuildDefaultMember", err)
+			}
+			return nil, NewGetGuildDefaultMemberInvalidArg(&body)
 		case http.StatusNotFound:
 			var (
 				body GetGuildDefaultMemberNotFoundResponseBody
@@ -537,9 +629,10 @@ func EncodeEnterGuildRequest(encoder func(*http.Request) goahttp.Encoder) func(*
 // GuildsService EnterGuild endpoint. restoreBody controls whether the response
 // body should be restored after having been read.
 // DecodeEnterGuildResponse may return the following errors:
-//	- "not_found" (type *goa.ServiceError): http.StatusNotFound
-//	- "internal" (type *goa.ServiceError): http.StatusInternalServerError
-//	- error: internal error
+//   - "not_found" (type *guildsservice.APIError): http.StatusNotFound
+//   - "internal" (type *guildsservice.APIError): http.StatusInternalServerError
+//   - "invalid_arg" (type *guildsservice.APIError): http.StatusBadRequest
+//   - error: internal error
 func DecodeEnterGuildResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (interface{}, error) {
 	return func(resp *http.Response) (interface{}, error) {
 		if restoreBody {
@@ -594,6 +687,20 @@ func DecodeEnterGuildResponse(decoder func(*http.Response) goahttp.Decoder, rest
 				return nil, goahttp.ErrValidationError("GuildsService", "EnterGuild", err)
 			}
 			return nil, NewEnterGuildInternal(&body)
+		case http.StatusBadRequest:
+			var (
+				body EnterGuildInvalidArgResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("GuildsService", "EnterGuild", err)
+			}
+			err = ValidateEnterGuildInvalidArgResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("GuildsService", "EnterGuild", err)
+			}
+			return nil, NewEnterGuildInvalidArg(&body)
 		default:
 			body, _ := ioutil.ReadAll(resp.Body)
 			return nil, goahttp.ErrInvalidResponse("GuildsService", "EnterGuild", resp.StatusCode, string(body))
@@ -601,25 +708,23 @@ func DecodeEnterGuildResponse(decoder func(*http.Response) goahttp.Decoder, rest
 	}
 }
 
-// BuildLeaveGuildRequest instantiates a HTTP request object with method and
-// path set to call the "GuildsService" service "LeaveGuild" endpoint
-func (c *Client) BuildLeaveGuildRequest(ctx context.Context, v interface{}) (*http.Request, error) {
+// BuildGetGuildWaitlistRequest instantiates a HTTP request object with method
+// and path set to call the "GuildsService" service "GetGuildWaitlist" endpoint
+func (c *Client) BuildGetGuildWaitlistRequest(ctx context.Context, v interface{}) (*http.Request, error) {
 	var (
-		guildID          string
-		injectiveAddress string
+		guildID string
 	)
 	{
-		p, ok := v.(*guildsservice.LeaveGuildPayload)
+		p, ok := v.(*guildsservice.GetGuildWaitlistPayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("GuildsService", "LeaveGuild", "*guildsservice.LeaveGuildPayload", v)
+			return nil, goahttp.ErrInvalidType("GuildsService", "GetGuildWaitlist", "*guildsservice.GetGuildWaitlistPayload", v)
 		}
 		guildID = p.GuildID
-		injectiveAddress = p.InjectiveAddress
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: LeaveGuildGuildsServicePath(guildID, injectiveAddress)}
-	req, err := http.NewRequest("DELETE", u.String(), nil)
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetGuildWaitlistGuildsServicePath(guildID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("GuildsService", "LeaveGuild", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("GuildsService", "GetGuildWaitlist", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -628,14 +733,32 @@ func (c *Client) BuildLeaveGuildRequest(ctx context.Context, v interface{}) (*ht
 	return req, nil
 }
 
-// DecodeLeaveGuildResponse returns a decoder for responses returned by the
-// GuildsService LeaveGuild endpoint. restoreBody controls whether the response
-// body should be restored after having been read.
-// DecodeLeaveGuildResponse may return the following errors:
-//	- "not_found" (type *goa.ServiceError): http.StatusNotFound
-//	- "internal" (type *goa.ServiceError): http.StatusInternalServerError
-//	- error: internal error
-func DecodeLeaveGuildResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (interface{}, error) {
+// EncodeGetGuildWaitlistRequest returns an encoder for requests sent to the
+// GuildsService GetGuildWaitlist server.
+func EncodeGetGuildWaitlistRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, interface{}) error {
+	return func(req *http.Request, v interface{}) error {
+		p, ok := v.(*guildsservice.GetGuildWaitlistPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("GuildsService", "GetGuildWaitlist", "*guildsservice.GetGuildWaitlistPayload", v)
+		}
+		values := req.URL.Query()
+		if p.InjectiveAddress != nil {
+			values.Add("injective_address", *p.InjectiveAddress)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetGuildWaitlistResponse returns a decoder for responses returned by
+// the GuildsService GetGuildWaitlist endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodeGetGuildWaitlistResponse may return the following errors:
+//   - "invalid_arg" (type *guildsservice.APIError): http.StatusBadRequest
+//   - "not_found" (type *guildsservice.APIError): http.StatusNotFound
+//   - "internal" (type *guildsservice.APIError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeGetGuildWaitlistResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (interface{}, error) {
 	return func(resp *http.Response) (interface{}, error) {
 		if restoreBody {
 			b, err := ioutil.ReadAll(resp.Body)
@@ -652,67 +775,87 @@ func DecodeLeaveGuildResponse(decoder func(*http.Response) goahttp.Decoder, rest
 		switch resp.StatusCode {
 		case http.StatusOK:
 			var (
-				body LeaveGuildResponseBody
+				body GetGuildWaitlistResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("GuildsService", "LeaveGuild", err)
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetGuildWaitlist", err)
 			}
-			res := NewLeaveGuildResultOK(&body)
+			err = ValidateGetGuildWaitlistResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("GuildsService", "GetGuildWaitlist", err)
+			}
+			res := NewGetGuildWaitlistResultOK(&body)
 			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body GetGuildWaitlistInvalidArgResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetGuildWaitlist", err)
+			}
+			err = ValidateGetGuildWaitlistInvalidArgResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("GuildsService", "GetGuildWaitlist", err)
+			}
+			return nil, NewGetGuildWaitlistInvalidArg(&body)
 		case http.StatusNotFound:
 			var (
-				body LeaveGuildNotFoundResponseBody
+				body GetGuildWaitlistNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("GuildsService", "LeaveGuild", err)
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetGuildWaitlist", err)
 			}
-			err = ValidateLeaveGuildNotFoundResponseBody(&body)
+			err = ValidateGetGuildWaitlistNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("GuildsService", "LeaveGuild", err)
+				return nil, goahttp.ErrValidationError("GuildsService", "GetGuildWaitlist", err)
 			}
-			return nil, NewLeaveGuildNotFound(&body)
+			return nil, NewGetGuildWaitlistNotFound(&body)
 		case http.StatusInternalServerError:
 			var (
-				body LeaveGuildInternalResponseBody
+				body GetGuildWaitlistInternalResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("GuildsService", "LeaveGuild", err)
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetGuildWaitlist", err)
 			}
-			err = ValidateLeaveGuildInternalResponseBody(&body)
+			err = ValidateGetGuildWaitlistInternalResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("GuildsService", "LeaveGuild", err)
+				return nil, goahttp.ErrValidationError("GuildsService", "GetGuildWaitlist", err)
 			}
-			return nil, NewLeaveGuildInternal(&body)
+			return nil, NewGetGuildWaitlistInternal(&body)
 		default:
 			body, _ := ioutil.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("GuildsService", "LeaveGuild", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("GuildsService", "GetGuildWaitlist", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildGetGuildMarketsRequest instantiates a HTTP request object with method
-// and path set to call the "GuildsService" service "GetGuildMarkets" endpoint
-func (c *Client) BuildGetGuildMarketsRequest(ctx context.Context, v interface{}) (*http.Request, error) {
+// BuildLeaveGuildRequest instantiates a HTTP request object with method and
+// path set to call the "GuildsService" service "LeaveGuild" endpoint
+func (c *Client) BuildLeaveGuildRequest(ctx context.Context, v interface{}) (*http.Request, error) {
 	var (
-		guildID string
+		guildID          string
+		injectiveAddress string
 	)
 	{
-		p, ok := v.(*guildsservice.GetGuildMarketsPayload)
+		p, ok := v.(*guildsservice.LeaveGuildPayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("GuildsService", "GetGuildMarkets", "*guildsservice.GetGuildMarketsPayload", v)
+			return nil, goahttp.ErrInvalidType("GuildsService", "LeaveGuild", "*guildsservice.LeaveGuildPayload", v)
 		}
 		guildID = p.GuildID
+		injectiveAddress = p.InjectiveAddress
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetGuildMarketsGuildsServicePath(guildID)}
-	req, err := http.NewRequest("GET", u.String(), nil)
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: LeaveGuildGuildsServicePath(guildID, injectiveAddress)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("GuildsService", "GetGuildMarkets", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("GuildsService", "LeaveGuild", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -721,14 +864,15 @@ func (c *Client) BuildGetGuildMarketsRequest(ctx context.Context, v interface{})
 	return req, nil
 }
 
-// DecodeGetGuildMarketsResponse returns a decoder for responses returned by
-// the GuildsService GetGuildMarkets endpoint. restoreBody controls whether the
-// response body should be restored after having been read.
-// DecodeGetGuildMarketsResponse may return the following errors:
-//	- "not_found" (type *goa.ServiceError): http.StatusNotFound
-//	- "internal" (type *goa.ServiceError): http.StatusInternalServerError
-//	- error: internal error
-func DecodeGetGuildMarketsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (interface{}, error) {
+// DecodeLeaveGuildResponse returns a decoder for responses returned by the
+// GuildsService LeaveGuild endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeLeaveGuildResponse may return the following errors:
+//   - "not_found" (type *guildsservice.APIError): http.StatusNotFound
+//   - "internal" (type *guildsservice.APIError): http.StatusInternalServerError
+//   - "invalid_arg" (type *guildsservice.APIError): http.StatusBadRequest
+//   - error: internal error
+func DecodeLeaveGuildResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (interface{}, error) {
 	return func(resp *http.Response) (interface{}, error) {
 		if restoreBody {
 			b, err := ioutil.ReadAll(resp.Body)
@@ -745,72 +889,81 @@ func DecodeGetGuildMarketsResponse(decoder func(*http.Response) goahttp.Decoder,
 		switch resp.StatusCode {
 		case http.StatusOK:
 			var (
-				body GetGuildMarketsResponseBody
+				body LeaveGuildResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("GuildsService", "GetGuildMarkets", err)
-			}
-			err = ValidateGetGuildMarketsResponseBody(&body)
-			if err != nil {
-				return nil, goahttp.ErrValidationError("GuildsService", "GetGuildMarkets", err)
+				return nil, goahttp.ErrDecodingError("GuildsService", "LeaveGuild", err)
 			}
-			res := NewGetGuildMarketsResultOK(&body)
+			res := NewLeaveGuildResultOK(&body)
 			return res, nil
 		case http.StatusNotFound:
 			var (
-				body GetGuildMarketsNotFoundResponseBody
+				body LeaveGuildNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("GuildsService", "GetGuildMarkets", err)
+				return nil, goahttp.ErrDecodingError("GuildsService", "LeaveGuild", err)
 			}
-			err = ValidateGetGuildMarketsNotFoundResponseBody(&body)
+			err = ValidateLeaveGuildNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("GuildsService", "GetGuildMarkets", err)
+				return nil, goahttp.ErrValidationError("GuildsService", "LeaveGuild", err)
 			}
-			return nil, NewGetGuildMarketsNotFound(&body)
+			return nil, NewLeaveGuildNotFound(&body)
 		case http.StatusInternalServerError:
 			var (
-				body GetGuildMarketsInternalResponseBody
+				body LeaveGuildInternalResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("GuildsService", "GetGuildMarkets", err)
+				return nil, goahttp.ErrDecodingError("GuildsService", "LeaveGuild", err)
 			}
-			err = ValidateGetGuildMarketsInternalResponseBody(&body)
+			err = ValidateLeaveGuildInternalResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("GuildsService", "GetGuildMarkets", err)
+				return nil, goahttp.ErrValidationError("GuildsService", "LeaveGuild", err)
 			}
-			return nil, NewGetGuildMarketsInternal(&body)
+			return nil, NewLeaveGuildInternal(&body)
+		case http.StatusBadRequest:
+			var (
+				body LeaveGuildInvalidArgResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("GuildsService", "LeaveGuild", err)
+			}
+			err = ValidateLeaveGuildInvalidArgResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("GuildsService", "LeaveGuild", err)
+			}
+			return nil, NewLeaveGuildInvalidArg(&body)
 		default:
 			body, _ := ioutil.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("GuildsService", "GetGuildMarkets", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("GuildsService", "LeaveGuild", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildGetGuildPortfoliosRequest instantiates a HTTP request object with
-// method and path set to call the "GuildsService" service "GetGuildPortfolios"
-// endpoint
-func (c *Client) BuildGetGuildPortfoliosRequest(ctx context.Context, v interface{}) (*http.Request, error) {
+// BuildTransferMemberRequest instantiates a HTTP request object with method
+// and path set to call the "GuildsService" service "TransferMember" endpoint
+func (c *Client) BuildTransferMemberRequest(ctx context.Context, v interface{}) (*http.Request, error) {
 	var (
-		guildID string
+		injectiveAddress string
 	)
 	{
-		p, ok := v.(*guildsservice.GetGuildPortfoliosPayload)
+		p, ok := v.(*guildsservice.TransferMemberPayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("GuildsService", "GetGuildPortfolios", "*guildsservice.GetGuildPortfoliosPayload", v)
+			return nil, goahttp.ErrInvalidType("GuildsService", "TransferMember", "*guildsservice.TransferMemberPayload", v)
 		}
-		guildID = p.GuildID
+		injectiveAddress = p.InjectiveAddress
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetGuildPortfoliosGuildsServicePath(guildID)}
-	req, err := http.NewRequest("GET", u.String(), nil)
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: TransferMemberGuildsServicePath(injectiveAddress)}
+	req, err := http.NewRequest("POST", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("GuildsService", "GetGuildPortfolios", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("GuildsService", "TransferMember", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -819,34 +972,31 @@ func (c *Client) BuildGetGuildPortfoliosRequest(ctx context.Context, v interface
 	return req, nil
 }
 
-// EncodeGetGuildPortfoliosRequest returns an encoder for requests sent to the
-// GuildsService GetGuildPortfolios server.
-func EncodeGetGuildPortfoliosRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, interface{}) error {
+// EncodeTransferMemberRequest returns an encoder for requests sent to the
+// GuildsService TransferMember server.
+func EncodeTransferMemberRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, interface{}) error {
 	return func(req *http.Request, v interface{}) error {
-		p, ok := v.(*guildsservice.GetGuildPortfoliosPayload)
+		p, ok := v.(*guildsservice.TransferMemberPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("GuildsService", "GetGuildPortfolios", "*guildsservice.GetGuildPortfoliosPayload", v)
-		}
-		values := req.URL.Query()
-		if p.StartTime != nil {
-			values.Add("start_time", fmt.Sprintf("%v", *p.StartTime))
+			return goahttp.ErrInvalidType("GuildsService", "TransferMember", "*guildsservice.TransferMemberPayload", v)
 		}
-		if p.EndTime != nil {
-			values.Add("end_time", fmt.Sprintf("%v", *p.EndTime))
+		body := NewTransferMemberRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("GuildsService", "TransferMember", err)
 		}
-		req.URL.RawQuery = values.Encode()
 		return nil
 	}
 }
 
-// DecodeGetGuildPortfoliosResponse returns a decoder for responses returned by
-// the GuildsService GetGuildPortfolios endpoint. restoreBody controls whether
-// the response body should be restored after having been read.
-// DecodeGetGuildPortfoliosResponse may return the following errors:
-//	- "not_found" (type *goa.ServiceError): http.StatusNotFound
-//	- "internal" (type *goa.ServiceError): http.StatusInternalServerError
-//	- error: internal error
-func DecodeGetGuildPortfoliosResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (interface{}, error) {
+// DecodeTransferMemberResponse returns a decoder for responses returned by the
+// GuildsService TransferMember endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeTransferMemberResponse may return the following errors:
+//   - "invalid_arg" (type *guildsservice.APIError): http.StatusBadRequest
+//   - "not_found" (type *guildsservice.APIError): http.StatusNotFound
+//   - "internal" (type *guildsservice.APIError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeTransferMemberResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (interface{}, error) {
 	return func(resp *http.Response) (interface{}, error) {
 		if restoreBody {
 			b, err := ioutil.ReadAll(resp.Body)
@@ -863,58 +1013,313 @@ func DecodeGetGuildPortfoliosResponse(decoder func(*http.Response) goahttp.Decod
 		switch resp.StatusCode {
 		case http.StatusOK:
 			var (
-				body GetGuildPortfoliosResponseBody
+				body TransferMemberResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("GuildsService", "GetGuildPortfolios", err)
+				return nil, goahttp.ErrDecodingError("GuildsService", "TransferMember", err)
 			}
-			err = ValidateGetGuildPortfoliosResponseBody(&body)
+			res := NewTransferMemberResultOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body TransferMemberInvalidArgResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("GuildsService", "GetGuildPortfolios", err)
+				return nil, goahttp.ErrDecodingError("GuildsService", "TransferMember", err)
 			}
-			res := NewGetGuildPortfoliosResultOK(&body)
-			return res, nil
+			err = ValidateTransferMemberInvalidArgResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("GuildsService", "TransferMember", err)
+			}
+			return nil, NewTransferMemberInvalidArg(&body)
 		case http.StatusNotFound:
 			var (
-				body GetGuildPortfoliosNotFoundResponseBody
+				body TransferMemberNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("GuildsService", "GetGuildPortfolios", err)
+				return nil, goahttp.ErrDecodingError("GuildsService", "TransferMember", err)
 			}
-			err = ValidateGetGuildPortfoliosNotFoundResponseBody(&body)
+			err = ValidateTransferMemberNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("GuildsService", "GetGuildPortfolios", err)
+				return nil, goahttp.ErrValidationError("GuildsService", "TransferMember", err)
 			}
-			return nil, NewGetGuildPortfoliosNotFound(&body)
+			return nil, NewTransferMemberNotFound(&body)
 		case http.StatusInternalServerError:
 			var (
-				body GetGuildPortfoliosInternalResponseBody
+				body TransferMemberInternalResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("GuildsService", "GetGuildPortfolios", err)
+				return nil, goahttp.ErrDecodingError("GuildsService", "TransferMember", err)
 			}
-			err = ValidateGetGuildPortfoliosInternalResponseBody(&body)
+			err = ValidateTransferMemberInternalResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("GuildsService", "GetGuildPortfolios", err)
+				return nil, goahttp.ErrValidationError("GuildsService", "TransferMember", err)
 			}
-			return nil, NewGetGuildPortfoliosInternal(&body)
+			return nil, NewTransferMemberInternal(&body)
 		default:
 			body, _ := ioutil.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("GuildsService", "GetGuildPortfolios", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("GuildsService", "TransferMember", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildGetAccountInfoRequest instantiates a HTTP request object with method
-// and path set to call the "GuildsService" service "GetAccountInfo" endpoint
-func (c *Client) BuildGetAccountInfoRequest(ctx context.Context, v interface{}) (*http.Request, error) {
-	var (
+// BuildGetGuildMarketsRequest instantiates a HTTP request object with method
+// and path set to call the "GuildsService" service "GetGuildMarkets" endpoint
+func (c *Client) BuildGetGuildMarketsRequest(ctx context.Context, v interface{}) (*http.Request, error) {
+	var (
+		guildID string
+	)
+	{
+		p, ok := v.(*guildsservice.GetGuildMarketsPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("GuildsService", "GetGuildMarkets", "*guildsservice.GetGuildMarketsPayload", v)
+		}
+		guildID = p.GuildID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetGuildMarketsGuildsServicePath(guildID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("GuildsService", "GetGuildMarkets", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeGetGuildMarketsResponse returns a decoder for responses returned by
+// the GuildsService GetGuildMarkets endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeGetGuildMarketsResponse may return the following errors:
+//   - "not_found" (type *guildsservice.APIError): http.StatusNotFound
+//   - "internal" (type *guildsservice.APIError): http.StatusInternalServerError
+//   - "invalid_arg" (type *guildsservice.APIError): http.StatusBadRequest
+//   - error: internal error
+func DecodeGetGuildMarketsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (interface{}, error) {
+	return func(resp *http.Response) (interface{}, error) {
+		if restoreBody {
+			b, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetGuildMarketsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetGuildMarkets", err)
+			}
+			err = ValidateGetGuildMarketsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("GuildsService", "GetGuildMarkets", err)
+			}
+			res := NewGetGuildMarketsResultOK(&body)
+			return res, nil
+		case http.StatusNotFound:
+			var (
+				body GetGuildMarketsNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetGuildMarkets", err)
+			}
+			err = ValidateGetGuildMarketsNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("GuildsService", "GetGuildMarkets", err)
+			}
+			return nil, NewGetGuildMarketsNotFound(&body)
+		case http.StatusInternalServerError:
+			var (
+				body GetGuildMarketsInternalResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetGuildMarkets", err)
+			}
+			err = ValidateGetGuildMarketsInternalResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("GuildsService", "GetGuildMarkets", err)
+			}
+			return nil, NewGetGuildMarketsInternal(&body)
+		case http.StatusBadRequest:
+			var (
+				body GetGuildMarketsInvalidArgResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetGuildMarkets", err)
+			}
+			err = ValidateGetGuildMarketsInvalidArgResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("GuildsService", "GetGuildMarkets", err)
+			}
+			return nil, NewGetGuildMarketsInvalidArg(&body)
+		default:
+			body, _ := ioutil.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("GuildsService", "GetGuildMarkets", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetGuildPortfoliosRequest instantiates a HTTP request object with
+// method and path set to call the "GuildsService" service "GetGuildPortfolios"
+// endpoint
+func (c *Client) BuildGetGuildPortfoliosRequest(ctx context.Context, v interface{}) (*http.Request, error) {
+	var (
+		guildID string
+	)
+	{
+		p, ok := v.(*guildsservice.GetGuildPortfoliosPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("GuildsService", "GetGuildPortfolios", "*guildsservice.GetGuildPortfoliosPayload", v)
+		}
+		guildID = p.GuildID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetGuildPortfoliosGuildsServicePath(guildID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("GuildsService", "GetGuildPortfolios", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetGuildPortfoliosRequest returns an encoder for requests sent to the
+// GuildsService GetGuildPortfolios server.
+func EncodeGetGuildPortfoliosRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, interface{}) error {
+	return func(req *http.Request, v interface{}) error {
+		p, ok := v.(*guildsservice.GetGuildPortfoliosPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("GuildsService", "GetGuildPortfolios", "*guildsservice.GetGuildPortfoliosPayload", v)
+		}
+		values := req.URL.Query()
+		if p.StartTime != nil {
+			values.Add("start_time", fmt.Sprintf("%v", *p.StartTime))
+		}
+		if p.EndTime != nil {
+			values.Add("end_time", fmt.Sprintf("%v", *p.EndTime))
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetGuildPortfoliosResponse returns a decoder for responses returned by
+// the GuildsService GetGuildPortfolios endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodeGetGuildPortfoliosResponse may return the following errors:
+//   - "not_found" (type *guildsservice.APIError): http.StatusNotFound
+//   - "internal" (type *guildsservice.APIError): http.StatusInternalServerError
+//   - "invalid_arg" (type *guildsservice.APIError): http.StatusBadRequest
+//   - error: internal error
+func DecodeGetGuildPortfoliosResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (interface{}, error) {
+	return func(resp *http.Response) (interface{}, error) {
+		if restoreBody {
+			b, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetGuildPortfoliosResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetGuildPortfolios", err)
+			}
+			err = ValidateGetGuildPortfoliosResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("GuildsService", "GetGuildPortfolios", err)
+			}
+			res := NewGetGuildPortfoliosResultOK(&body)
+			return res, nil
+		case http.StatusNotFound:
+			var (
+				body GetGuildPortfoliosNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetGuildPortfolios", err)
+			}
+			err = ValidateGetGuildPortfoliosNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("GuildsService", "GetGuildPortfolios", err)
+			}
+			return nil, NewGetGuildPortfoliosNotFound(&body)
+		case http.StatusInternalServerError:
+			var (
+				body GetGuildPortfoliosInternalResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetGuildPortfolios", err)
+			}
+			err = ValidateGetGuildPortfoliosInternalResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("GuildsService", "GetGuildPortfolios", err)
+			}
+			return nil, NewGetGuildPortfoliosInternal(&body)
+		case http.StatusBadRequest:
+			var (
+				body GetGuildPortfoliosInvalidArgResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetGuildPortfolios", err)
+			}
+			err = ValidateGetGuildPortfoliosInvalidArgResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("GuildsService", "GetGuildPortfolios", err)
+			}
+			return nil, NewGetGuildPortfoliosInvalidArg(&body)
+		default:
+			body, _ := ioutil.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("GuildsService", "GetGuildPortfolios", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetAccountInfoRequest instantiates a HTTP request object with method
+// and path set to call the "GuildsService" service "GetAccountInfo" endpoint
+func (c *Client) BuildGetAccountInfoRequest(ctx context.Context, v interface{}) (*http.Request, error) {
+	var (
 		injectiveAddress string
 	)
 	{
@@ -940,9 +1345,10 @@ func (c *Client) BuildGetAccountInfoRequest(ctx context.Context, v interface{})
 // GuildsService GetAccountInfo endpoint. restoreBody controls whether the
 // response body should be restored after having been read.
 // DecodeGetAccountInfoResponse may return the following errors:
-//	- "not_found" (type *goa.ServiceError): http.StatusNotFound
-//	- "internal" (type *goa.ServiceError): http.StatusInternalServerError
-//	- error: internal error
+//   - "not_found" (type *guildsservice.APIError): http.StatusNotFound
+//   - "internal" (type *guildsservice.APIError): http.StatusInternalServerError
+//   - "invalid_arg" (type *guildsservice.APIError): http.StatusBadRequest
+//   - error: internal error
 func DecodeGetAccountInfoResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (interface{}, error) {
 	return func(resp *http.Response) (interface{}, error) {
 		if restoreBody {
@@ -1001,6 +1407,20 @@ func DecodeGetAccountInfoResponse(decoder func(*http.Response) goahttp.Decoder,
 				return nil, goahttp.ErrValidationError("GuildsService", "GetAccountInfo", err)
 			}
 			return nil, NewGetAccountInfoInternal(&body)
+		case http.StatusBadRequest:
+			var (
+				body GetAccountInfoInvalidArgResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetAccountInfo", err)
+			}
+			err = ValidateGetAccountInfoInvalidArgResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("GuildsService", "GetAccountInfo", err)
+			}
+			return nil, NewGetAccountInfoInvalidArg(&body)
 		default:
 			body, _ := ioutil.ReadAll(resp.Body)
 			return nil, goahttp.ErrInvalidResponse("GuildsService", "GetAccountInfo", resp.StatusCode, string(body))
@@ -1038,9 +1458,10 @@ func (c *Client) BuildGetAccountPortfolioRequest(ctx context.Context, v interfac
 // by the GuildsService GetAccountPortfolio endpoint. restoreBody controls
 // whether the response body should be restored after having been read.
 // DecodeGetAccountPortfolioResponse may return the following errors:
-//	- "not_found" (type *goa.ServiceError): http.StatusNotFound
-//	- "internal" (type *goa.ServiceError): http.StatusInternalServerError
-//	- error: internal error
+//   - "not_found" (type *guildsservice.APIError): http.StatusNotFound
+//   - "internal" (type *guildsservice.APIError): http.StatusInternalServerError
+//   - "invalid_arg" (type *guildsservice.APIError): http.StatusBadRequest
+//   - error: internal error
 func DecodeGetAccountPortfolioResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (interface{}, error) {
 	return func(resp *http.Response) (interface{}, error) {
 		if restoreBody {
@@ -1067,63 +1488,1241 @@ func DecodeGetAccountPortfolioResponse(decoder func(*http.Response) goahttp.Deco
 			}
 			err = ValidateGetAccountPortfolioResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("GuildsService", "GetAccountPortfolio", err)
+				return nil, goahttp.ErrValidationError("GuildsService", "GetAccountPortfolio", err)
+			}
+			res := NewGetAccountPortfolioResultOK(&body)
+			return res, nil
+		case http.StatusNotFound:
+			var (
+				body GetAccountPortfolioNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetAccountPortfolio", err)
+			}
+			err = ValidateGetAccountPortfolioNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("GuildsService", "GetAccountPortfolio", err)
+			}
+			return nil, NewGetAccountPortfolioNotFound(&body)
+		case http.StatusInternalServerError:
+			var (
+				body GetAccountPortfolioInternalResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetAccountPortfolio", err)
+			}
+			err = ValidateGetAccountPortfolioInternalResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("GuildsService", "GetAccountPortfolio", err)
+			}
+			return nil, NewGetAccountPortfolioInternal(&body)
+		case http.StatusBadRequest:
+			var (
+				body GetAccountPortfolioInvalidArgResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetAccountPortfolio", err)
+			}
+			err = ValidateGetAccountPortfolioInvalidArgResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("GuildsService", "GetAccountPortfolio", err)
+			}
+			return nil, NewGetAccountPortfolioInvalidArg(&body)
+		default:
+			body, _ := ioutil.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("GuildsService", "GetAccountPortfolio", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetAccountPortfoliosRequest instantiates a HTTP request object with
+// method and path set to call the "GuildsService" service
+// "GetAccountPortfolios" endpoint
+func (c *Client) BuildGetAccountPortfoliosRequest(ctx context.Context, v interface{}) (*http.Request, error) {
+	var (
+		injectiveAddress string
+	)
+	{
+		p, ok := v.(*guildsservice.GetAccountPortfoliosPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("GuildsService", "GetAccountPortfolios", "*guildsservice.GetAccountPortfoliosPayload", v)
+		}
+		injectiveAddress = p.InjectiveAddress
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetAccountPortfoliosGuildsServicePath(injectiveAddress)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("GuildsService", "GetAccountPortfolios", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetAccountPortfoliosRequest returns an encoder for requests sent to
+// the GuildsService GetAccountPortfolios server.
+func EncodeGetAccountPortfoliosRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, interface{}) error {
+	return func(req *http.Request, v interface{}) error {
+		p, ok := v.(*guildsservice.GetAccountPortfoliosPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("GuildsService", "GetAccountPortfolios", "*guildsservice.GetAccountPortfoliosPayload", v)
+		}
+		values := req.URL.Query()
+		if p.StartTime != nil {
+			values.Add("start_time", fmt.Sprintf("%v", *p.StartTime))
+		}
+		if p.EndTime != nil {
+			values.Add("end_time", fmt.Sprintf("%v", *p.EndTime))
+		}
+		if p.GuildID != nil {
+			values.Add("guild_id", *p.GuildID)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetAccountPortfoliosResponse returns a decoder for responses returned
+// by the GuildsService GetAccountPortfolios endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeGetAccountPortfoliosResponse may return the following errors:
+//   - "invalid_arg" (type *guildsservice.APIError): http.StatusBadRequest
+//   - "not_found" (type *guildsservice.APIError): http.StatusNotFound
+//   - "internal" (type *guildsservice.APIError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeGetAccountPortfoliosResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (interface{}, error) {
+	return func(resp *http.Response) (interface{}, error) {
+		if restoreBody {
+			b, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetAccountPortfoliosResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetAccountPortfolios", err)
+			}
+			err = ValidateGetAccountPortfoliosResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("GuildsService", "GetAccountPortfolios", err)
+			}
+			res := NewGetAccountPortfoliosResultOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body GetAccountPortfoliosInvalidArgResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetAccountPortfolios", err)
+			}
+			err = ValidateGetAccountPortfoliosInvalidArgResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("GuildsService", "GetAccountPortfolios", err)
+			}
+			return nil, NewGetAccountPortfoliosInvalidArg(&body)
+		case http.StatusNotFound:
+			var (
+				body GetAccountPortfoliosNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetAccountPortfolios", err)
+			}
+			err = ValidateGetAccountPortfoliosNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("GuildsService", "GetAccountPortfolios", err)
+			}
+			return nil, NewGetAccountPortfoliosNotFound(&body)
+		case http.StatusInternalServerError:
+			var (
+				body GetAccountPortfoliosInternalResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetAccountPortfolios", err)
+			}
+			err = ValidateGetAccountPortfoliosInternalResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("GuildsService", "GetAccountPortfolios", err)
+			}
+			return nil, NewGetAccountPortfoliosInternal(&body)
+		default:
+			body, _ := ioutil.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("GuildsService", "GetAccountPortfolios", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetAccountMembershipsRequest instantiates a HTTP request object with
+// method and path set to call the "GuildsService" service
+// "GetAccountMemberships" endpoint
+func (c *Client) BuildGetAccountMembershipsRequest(ctx context.Context, v interface{}) (*http.Request, error) {
+	var (
+		injectiveAddress string
+	)
+	{
+		p, ok := v.(*guildsservice.GetAccountMembershipsPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("GuildsService", "GetAccountMemberships", "*guildsservice.GetAccountMembershipsPayload", v)
+		}
+		injectiveAddress = p.InjectiveAddress
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetAccountMembershipsGuildsServicePath(injectiveAddress)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("GuildsService", "GetAccountMemberships", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeGetAccountMembershipsResponse returns a decoder for responses returned
+// by the GuildsService GetAccountMemberships endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeGetAccountMembershipsResponse may return the following errors:
+//   - "invalid_arg" (type *guildsservice.APIError): http.StatusBadRequest
+//   - "internal" (type *guildsservice.APIError): http.StatusInternalServerError
+//   - "not_found" (type *guildsservice.APIError): http.StatusNotFound
+//   - error: internal error
+func DecodeGetAccountMembershipsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (interface{}, error) {
+	return func(resp *http.Response) (interface{}, error) {
+		if restoreBody {
+			b, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetAccountMembershipsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetAccountMemberships", err)
+			}
+			err = ValidateGetAccountMembershipsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("GuildsService", "GetAccountMemberships", err)
+			}
+			res := NewGetAccountMembershipsResultOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body GetAccountMembershipsInvalidArgResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetAccountMemberships", err)
+			}
+			err = ValidateGetAccountMembershipsInvalidArgResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("GuildsService", "GetAccountMemberships", err)
+			}
+			return nil, NewGetAccountMembershipsInvalidArg(&body)
+		case http.StatusInternalServerError:
+			var (
+				body GetAccountMembershipsInternalResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetAccountMemberships", err)
+			}
+			err = ValidateGetAccountMembershipsInternalResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("GuildsService", "GetAccountMemberships", err)
+			}
+			return nil, NewGetAccountMembershipsInternal(&body)
+		case http.StatusNotFound:
+			var (
+				body GetAccountMembershipsNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetAccountMemberships", err)
+			}
+			err = ValidateGetAccountMembershipsNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("GuildsService", "GetAccountMemberships", err)
+			}
+			return nil, NewGetAccountMembershipsNotFound(&body)
+		default:
+			body, _ := ioutil.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("GuildsService", "GetAccountMemberships", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetAccountMonthlyPortfoliosRequest instantiates a HTTP request object
+// with method and path set to call the "GuildsService" service
+// "GetAccountMonthlyPortfolios" endpoint
+func (c *Client) BuildGetAccountMonthlyPortfoliosRequest(ctx context.Context, v interface{}) (*http.Request, error) {
+	var (
+		injectiveAddress string
+	)
+	{
+		p, ok := v.(*guildsservice.GetAccountMonthlyPortfoliosPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("GuildsService", "GetAccountMonthlyPortfolios", "*guildsservice.GetAccountMonthlyPortfoliosPayload", v)
+		}
+		injectiveAddress = p.InjectiveAddress
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetAccountMonthlyPortfoliosGuildsServicePath(injectiveAddress)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("GuildsService", "GetAccountMonthlyPortfolios", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetAccountMonthlyPortfoliosRequest returns an encoder for requests
+// sent to the GuildsService GetAccountMonthlyPortfolios server.
+func EncodeGetAccountMonthlyPortfoliosRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, interface{}) error {
+	return func(req *http.Request, v interface{}) error {
+		p, ok := v.(*guildsservice.GetAccountMonthlyPortfoliosPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("GuildsService", "GetAccountMonthlyPortfolios", "*guildsservice.GetAccountMonthlyPortfoliosPayload", v)
+		}
+		values := req.URL.Query()
+		if p.StartTime != nil {
+			values.Add("start_time", fmt.Sprintf("%v", *p.StartTime))
+		}
+		if p.EndTime != nil {
+			values.Add("end_time", fmt.Sprintf("%v", *p.EndTime))
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetAccountMonthlyPortfoliosResponse returns a decoder for responses
+// returned by the GuildsService GetAccountMonthlyPortfolios endpoint.
+// restoreBody controls whether the response body should be restored after
+// having been read.
+// DecodeGetAccountMonthlyPortfoliosResponse may return the following errors:
+//   - "not_found" (type *guildsservice.APIError): http.StatusNotFound
+//   - "internal" (type *guildsservice.APIError): http.StatusInternalServerError
+//   - "invalid_arg" (type *guildsservice.APIError): http.StatusBadRequest
+//   - error: internal error
+func DecodeGetAccountMonthlyPortfoliosResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (interface{}, error) {
+	return func(resp *http.Response) (interface{}, error) {
+		if restoreBody {
+			b, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetAccountMonthlyPortfoliosResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetAccountMonthlyPortfolios", err)
+			}
+			err = ValidateGetAccountMonthlyPortfoliosResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("GuildsService", "GetAccountMonthlyPortfolios", err)
+			}
+			res := NewGetAccountMonthlyPortfoliosResultOK(&body)
+			return res, nil
+		case http.StatusNotFound:
+			var (
+				body GetAccountMonthlyPortfoliosNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetAccountMonthlyPortfolios", err)
+			}
+			err = ValidateGetAccountMonthlyPortfoliosNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("GuildsService", "GetAccountMonthlyPortfolios", err)
+			}
+			return nil, NewGetAccountMonthlyPortfoliosNotFound(&body)
+		case http.StatusInternalServerError:
+			var (
+				body GetAccountMonthlyPortfoliosInternalResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetAccountMonthlyPortfolios", err)
+			}
+			err = ValidateGetAccountMonthlyPortfoliosInternalResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("GuildsService", "GetAccountMonthlyPortfolios", err)
+			}
+			return nil, NewGetAccountMonthlyPortfoliosInternal(&body)
+		case http.StatusBadRequest:
+			var (
+				body GetAccountMonthlyPortfoliosInvalidArgResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetAccountMonthlyPortfolios", err)
+			}
+			err = ValidateGetAccountMonthlyPortfoliosInvalidArgResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("GuildsService", "GetAccountMonthlyPortfolios", err)
+			}
+			return nil, NewGetAccountMonthlyPortfoliosInvalidArg(&body)
+		default:
+			body, _ := ioutil.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("GuildsService", "GetAccountMonthlyPortfolios", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetAccountGrantsRequest instantiates a HTTP request object with method
+// and path set to call the "GuildsService" service "GetAccountGrants" endpoint
+func (c *Client) BuildGetAccountGrantsRequest(ctx context.Context, v interface{}) (*http.Request, error) {
+	var (
+		injectiveAddress string
+	)
+	{
+		p, ok := v.(*guildsservice.GetAccountGrantsPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("GuildsService", "GetAccountGrants", "*guildsservice.GetAccountGrantsPayload", v)
+		}
+		injectiveAddress = p.InjectiveAddress
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetAccountGrantsGuildsServicePath(injectiveAddress)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("GuildsService", "GetAccountGrants", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeGetAccountGrantsResponse returns a decoder for responses returned by
+// the GuildsService GetAccountGrants endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodeGetAccountGrantsResponse may return the following errors:
+//   - "not_found" (type *guildsservice.APIError): http.StatusNotFound
+//   - "invalid_arg" (type *guildsservice.APIError): http.StatusBadRequest
+//   - "internal" (type *guildsservice.APIError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeGetAccountGrantsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (interface{}, error) {
+	return func(resp *http.Response) (interface{}, error) {
+		if restoreBody {
+			b, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetAccountGrantsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetAccountGrants", err)
+			}
+			err = ValidateGetAccountGrantsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("GuildsService", "GetAccountGrants", err)
+			}
+			res := NewGetAccountGrantsResultOK(&body)
+			return res, nil
+		case http.StatusNotFound:
+			var (
+				body GetAccountGrantsNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetAccountGrants", err)
+			}
+			err = ValidateGetAccountGrantsNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("GuildsService", "GetAccountGrants", err)
+			}
+			return nil, NewGetAccountGrantsNotFound(&body)
+		case http.StatusBadRequest:
+			var (
+				body GetAccountGrantsInvalidArgResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetAccountGrants", err)
+			}
+			err = ValidateGetAccountGrantsInvalidArgResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("GuildsService", "GetAccountGrants", err)
+			}
+			return nil, NewGetAccountGrantsInvalidArg(&body)
+		case http.StatusInternalServerError:
+			var (
+				body GetAccountGrantsInternalResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetAccountGrants", err)
+			}
+			err = ValidateGetAccountGrantsInternalResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("GuildsService", "GetAccountGrants", err)
+			}
+			return nil, NewGetAccountGrantsInternal(&body)
+		default:
+			body, _ := ioutil.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("GuildsService", "GetAccountGrants", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetAccountTradesRequest instantiates a HTTP request object with method
+// and path set to call the "GuildsService" service "GetAccountTrades" endpoint
+func (c *Client) BuildGetAccountTradesRequest(ctx context.Context, v interface{}) (*http.Request, error) {
+	var (
+		injectiveAddress string
+	)
+	{
+		p, ok := v.(*guildsservice.GetAccountTradesPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("GuildsService", "GetAccountTrades", "*guildsservice.GetAccountTradesPayload", v)
+		}
+		injectiveAddress = p.InjectiveAddress
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetAccountTradesGuildsServicePath(injectiveAddress)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("GuildsService", "GetAccountTrades", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetAccountTradesRequest returns an encoder for requests sent to the
+// GuildsService GetAccountTrades server.
+func EncodeGetAccountTradesRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, interface{}) error {
+	return func(req *http.Request, v interface{}) error {
+		p, ok := v.(*guildsservice.GetAccountTradesPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("GuildsService", "GetAccountTrades", "*guildsservice.GetAccountTradesPayload", v)
+		}
+		values := req.URL.Query()
+		if p.StartTime != nil {
+			values.Add("start_time", fmt.Sprintf("%v", *p.StartTime))
+		}
+		if p.EndTime != nil {
+			values.Add("end_time", fmt.Sprintf("%v", *p.EndTime))
+		}
+		if p.Limit != nil {
+			values.Add("limit", fmt.Sprintf("%v", *p.Limit))
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetAccountTradesResponse returns a decoder for responses returned by
+// the GuildsService GetAccountTrades endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodeGetAccountTradesResponse may return the following errors:
+//   - "invalid_arg" (type *guildsservice.APIError): http.StatusBadRequest
+//   - "internal" (type *guildsservice.APIError): http.StatusInternalServerError
+//   - "not_found" (type *guildsservice.APIError): http.StatusNotFound
+//   - error: internal error
+func DecodeGetAccountTradesResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (interface{}, error) {
+	return func(resp *http.Response) (interface{}, error) {
+		if restoreBody {
+			b, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetAccountTradesResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetAccountTrades", err)
+			}
+			err = ValidateGetAccountTradesResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("GuildsService", "GetAccountTrades", err)
+			}
+			res := NewGetAccountTradesResultOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body GetAccountTradesInvalidArgResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetAccountTrades", err)
+			}
+			err = ValidateGetAccountTradesInvalidArgResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("GuildsService", "GetAccountTrades", err)
+			}
+			return nil, NewGetAccountTradesInvalidArg(&body)
+		case http.StatusInternalServerError:
+			var (
+				body GetAccountTradesInternalResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetAccountTrades", err)
+			}
+			err = ValidateGetAccountTradesInternalResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("GuildsService", "GetAccountTrades", err)
+			}
+			return nil, NewGetAccountTradesInternal(&body)
+		case http.StatusNotFound:
+			var (
+				body GetAccountTradesNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetAccountTrades", err)
+			}
+			err = ValidateGetAccountTradesNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("GuildsService", "GetAccountTrades", err)
+			}
+			return nil, NewGetAccountTradesNotFound(&body)
+		default:
+			body, _ := ioutil.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("GuildsService", "GetAccountTrades", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetAccountTradeStatsRequest instantiates a HTTP request object with
+// method and path set to call the "GuildsService" service
+// "GetAccountTradeStats" endpoint
+func (c *Client) BuildGetAccountTradeStatsRequest(ctx context.Context, v interface{}) (*http.Request, error) {
+	var (
+		injectiveAddress string
+	)
+	{
+		p, ok := v.(*guildsservice.GetAccountTradeStatsPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("GuildsService", "GetAccountTradeStats", "*guildsservice.GetAccountTradeStatsPayload", v)
+		}
+		injectiveAddress = p.InjectiveAddress
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetAccountTradeStatsGuildsServicePath(injectiveAddress)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("GuildsService", "GetAccountTradeStats", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetAccountTradeStatsRequest returns an encoder for requests sent to
+// the GuildsService GetAccountTradeStats server.
+func EncodeGetAccountTradeStatsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, interface{}) error {
+	return func(req *http.Request, v interface{}) error {
+		p, ok := v.(*guildsservice.GetAccountTradeStatsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("GuildsService", "GetAccountTradeStats", "*guildsservice.GetAccountTradeStatsPayload", v)
+		}
+		values := req.URL.Query()
+		if p.StartTime != nil {
+			values.Add("start_time", fmt.Sprintf("%v", *p.StartTime))
+		}
+		if p.EndTime != nil {
+			values.Add("end_time", fmt.Sprintf("%v", *p.EndTime))
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetAccountTradeStatsResponse returns a decoder for responses returned
+// by the GuildsService GetAccountTradeStats endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeGetAccountTradeStatsResponse may return the following errors:
+//   - "invalid_arg" (type *guildsservice.APIError): http.StatusBadRequest
+//   - "internal" (type *guildsservice.APIError): http.StatusInternalServerError
+//   - "not_found" (type *guildsservice.APIError): http.StatusNotFound
+//   - error: internal error
+func DecodeGetAccountTradeStatsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (interface{}, error) {
+	return func(resp *http.Response) (interface{}, error) {
+		if restoreBody {
+			b, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetAccountTradeStatsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetAccountTradeStats", err)
+			}
+			err = ValidateGetAccountTradeStatsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("GuildsService", "GetAccountTradeStats", err)
+			}
+			res := NewGetAccountTradeStatsResultOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body GetAccountTradeStatsInvalidArgResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetAccountTradeStats", err)
+			}
+			err = ValidateGetAccountTradeStatsInvalidArgResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("GuildsService", "GetAccountTradeStats", err)
+			}
+			return nil, NewGetAccountTradeStatsInvalidArg(&body)
+		case http.StatusInternalServerError:
+			var (
+				body GetAccountTradeStatsInternalResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetAccountTradeStats", err)
+			}
+			err = ValidateGetAccountTradeStatsInternalResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("GuildsService", "GetAccountTradeStats", err)
+			}
+			return nil, NewGetAccountTradeStatsInternal(&body)
+		case http.StatusNotFound:
+			var (
+				body GetAccountTradeStatsNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetAccountTradeStats", err)
+			}
+			err = ValidateGetAccountTradeStatsNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("GuildsService", "GetAccountTradeStats", err)
+			}
+			return nil, NewGetAccountTradeStatsNotFound(&body)
+		default:
+			body, _ := ioutil.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("GuildsService", "GetAccountTradeStats", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetGuildTradeStatsRequest instantiates a HTTP request object with
+// method and path set to call the "GuildsService" service "GetGuildTradeStats"
+// endpoint
+func (c *Client) BuildGetGuildTradeStatsRequest(ctx context.Context, v interface{}) (*http.Request, error) {
+	var (
+		guildID string
+	)
+	{
+		p, ok := v.(*guildsservice.GetGuildTradeStatsPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("GuildsService", "GetGuildTradeStats", "*guildsservice.GetGuildTradeStatsPayload", v)
+		}
+		guildID = p.GuildID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetGuildTradeStatsGuildsServicePath(guildID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("GuildsService", "GetGuildTradeStats", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetGuildTradeStatsRequest returns an encoder for requests sent to the
+// GuildsService GetGuildTradeStats server.
+func EncodeGetGuildTradeStatsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, interface{}) error {
+	return func(req *http.Request, v interface{}) error {
+		p, ok := v.(*guildsservice.GetGuildTradeStatsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("GuildsService", "GetGuildTradeStats", "*guildsservice.GetGuildTradeStatsPayload", v)
+		}
+		values := req.URL.Query()
+		if p.StartTime != nil {
+			values.Add("start_time", fmt.Sprintf("%v", *p.StartTime))
+		}
+		if p.EndTime != nil {
+			values.Add("end_time", fmt.Sprintf("%v", *p.EndTime))
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetGuildTradeStatsResponse returns a decoder for responses returned by
+// the GuildsService GetGuildTradeStats endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodeGetGuildTradeStatsResponse may return the following errors:
+//   - "invalid_arg" (type *guildsservice.APIError): http.StatusBadRequest
+//   - "not_found" (type *guildsservice.APIError): http.StatusNotFound
+//   - "internal" (type *guildsservice.APIError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeGetGuildTradeStatsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (interface{}, error) {
+	return func(resp *http.Response) (interface{}, error) {
+		if restoreBody {
+			b, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetGuildTradeStatsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetGuildTradeStats", err)
+			}
+			err = ValidateGetGuildTradeStatsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("GuildsService", "GetGuildTradeStats", err)
+			}
+			res := NewGetGuildTradeStatsResultOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body GetGuildTradeStatsInvalidArgResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetGuildTradeStats", err)
+			}
+			err = ValidateGetGuildTradeStatsInvalidArgResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("GuildsService", "GetGuildTradeStats", err)
+			}
+			return nil, NewGetGuildTradeStatsInvalidArg(&body)
+		case http.StatusNotFound:
+			var (
+				body GetGuildTradeStatsNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetGuildTradeStats", err)
+			}
+			err = ValidateGetGuildTradeStatsNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("GuildsService", "GetGuildTradeStats", err)
+			}
+			return nil, NewGetGuildTradeStatsNotFound(&body)
+		case http.StatusInternalServerError:
+			var (
+				body GetGuildTradeStatsInternalResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetGuildTradeStats", err)
+			}
+			err = ValidateGetGuildTradeStatsInternalResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("GuildsService", "GetGuildTradeStats", err)
+			}
+			return nil, NewGetGuildTradeStatsInternal(&body)
+		default:
+			body, _ := ioutil.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("GuildsService", "GetGuildTradeStats", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetGuildFeesRequest instantiates a HTTP request object with method and
+// path set to call the "GuildsService" service "GetGuildFees" endpoint
+func (c *Client) BuildGetGuildFeesRequest(ctx context.Context, v interface{}) (*http.Request, error) {
+	var (
+		guildID string
+	)
+	{
+		p, ok := v.(*guildsservice.GetGuildFeesPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("GuildsService", "GetGuildFees", "*guildsservice.GetGuildFeesPayload", v)
+		}
+		guildID = p.GuildID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetGuildFeesGuildsServicePath(guildID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("GuildsService", "GetGuildFees", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetGuildFeesRequest returns an encoder for requests sent to the
+// GuildsService GetGuildFees server.
+func EncodeGetGuildFeesRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, interface{}) error {
+	return func(req *http.Request, v interface{}) error {
+		p, ok := v.(*guildsservice.GetGuildFeesPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("GuildsService", "GetGuildFees", "*guildsservice.GetGuildFeesPayload", v)
+		}
+		values := req.URL.Query()
+		if p.StartTime != nil {
+			values.Add("start_time", fmt.Sprintf("%v", *p.StartTime))
+		}
+		if p.EndTime != nil {
+			values.Add("end_time", fmt.Sprintf("%v", *p.EndTime))
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetGuildFeesResponse returns a decoder for responses returned by the
+// GuildsService GetGuildFees endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeGetGuildFeesResponse may return the following errors:
+//   - "invalid_arg" (type *guildsservice.APIError): http.StatusBadRequest
+//   - "not_found" (type *guildsservice.APIError): http.StatusNotFound
+//   - "internal" (type *guildsservice.APIError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeGetGuildFeesResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (interface{}, error) {
+	return func(resp *http.Response) (interface{}, error) {
+		if restoreBody {
+			b, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetGuildFeesResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetGuildFees", err)
+			}
+			err = ValidateGetGuildFeesResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("GuildsService", "GetGuildFees", err)
+			}
+			res := NewGetGuildFeesResultOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body GetGuildFeesInvalidArgResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetGuildFees", err)
+			}
+			err = ValidateGetGuildFeesInvalidArgResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("GuildsService", "GetGuildFees", err)
+			}
+			return nil, NewGetGuildFeesInvalidArg(&body)
+		case http.StatusNotFound:
+			var (
+				body GetGuildFeesNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetGuildFees", err)
+			}
+			err = ValidateGetGuildFeesNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("GuildsService", "GetGuildFees", err)
+			}
+			return nil, NewGetGuildFeesNotFound(&body)
+		case http.StatusInternalServerError:
+			var (
+				body GetGuildFeesInternalResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetGuildFees", err)
+			}
+			err = ValidateGetGuildFeesInternalResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("GuildsService", "GetGuildFees", err)
+			}
+			return nil, NewGetGuildFeesInternal(&body)
+		default:
+			body, _ := ioutil.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("GuildsService", "GetGuildFees", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetAccountTransfersRequest instantiates a HTTP request object with
+// method and path set to call the "GuildsService" service
+// "GetAccountTransfers" endpoint
+func (c *Client) BuildGetAccountTransfersRequest(ctx context.Context, v interface{}) (*http.Request, error) {
+	var (
+		injectiveAddress string
+	)
+	{
+		p, ok := v.(*guildsservice.GetAccountTransfersPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("GuildsService", "GetAccountTransfers", "*guildsservice.GetAccountTransfersPayload", v)
+		}
+		injectiveAddress = p.InjectiveAddress
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetAccountTransfersGuildsServicePath(injectiveAddress)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("GuildsService", "GetAccountTransfers", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetAccountTransfersRequest returns an encoder for requests sent to the
+// GuildsService GetAccountTransfers server.
+func EncodeGetAccountTransfersRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, interface{}) error {
+	return func(req *http.Request, v interface{}) error {
+		p, ok := v.(*guildsservice.GetAccountTransfersPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("GuildsService", "GetAccountTransfers", "*guildsservice.GetAccountTransfersPayload", v)
+		}
+		values := req.URL.Query()
+		if p.StartTime != nil {
+			values.Add("start_time", fmt.Sprintf("%v", *p.StartTime))
+		}
+		if p.EndTime != nil {
+			values.Add("end_time", fmt.Sprintf("%v", *p.EndTime))
+		}
+		if p.Limit != nil {
+			values.Add("limit", fmt.Sprintf("%v", *p.Limit))
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetAccountTransfersResponse returns a decoder for responses returned
+// by the GuildsService GetAccountTransfers endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeGetAccountTransfersResponse may return the following errors:
+//   - "invalid_arg" (type *guildsservice.APIError): http.StatusBadRequest
+//   - "internal" (type *guildsservice.APIError): http.StatusInternalServerError
+//   - "not_found" (type *guildsservice.APIError): http.StatusNotFound
+//   - error: internal error
+func DecodeGetAccountTransfersResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (interface{}, error) {
+	return func(resp *http.Response) (interface{}, error) {
+		if restoreBody {
+			b, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetAccountTransfersResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetAccountTransfers", err)
+			}
+			err = ValidateGetAccountTransfersResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("GuildsService", "GetAccountTransfers", err)
+			}
+			res := NewGetAccountTransfersResultOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body GetAccountTransfersInvalidArgResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetAccountTransfers", err)
+			}
+			err = ValidateGetAccountTransfersInvalidArgResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("GuildsService", "GetAccountTransfers", err)
 			}
-			res := NewGetAccountPortfolioResultOK(&body)
-			return res, nil
-		case http.StatusNotFound:
+			return nil, NewGetAccountTransfersInvalidArg(&body)
+		case http.StatusInternalServerError:
 			var (
-				body GetAccountPortfolioNotFoundResponseBody
+				body GetAccountTransfersInternalResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("GuildsService", "GetAccountPortfolio", err)
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetAccountTransfers", err)
 			}
-			err = ValidateGetAccountPortfolioNotFoundResponseBody(&body)
+			err = ValidateGetAccountTransfersInternalResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("GuildsService", "GetAccountPortfolio", err)
+				return nil, goahttp.ErrValidationError("GuildsService", "GetAccountTransfers", err)
 			}
-			return nil, NewGetAccountPortfolioNotFound(&body)
-		case http.StatusInternalServerError:
+			return nil, NewGetAccountTransfersInternal(&body)
+		case http.StatusNotFound:
 			var (
-				body GetAccountPortfolioInternalResponseBody
+				body GetAccountTransfersNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("GuildsService", "GetAccountPortfolio", err)
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetAccountTransfers", err)
 			}
-			err = ValidateGetAccountPortfolioInternalResponseBody(&body)
+			err = ValidateGetAccountTransfersNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("GuildsService", "GetAccountPortfolio", err)
+				return nil, goahttp.ErrValidationError("GuildsService", "GetAccountTransfers", err)
 			}
-			return nil, NewGetAccountPortfolioInternal(&body)
+			return nil, NewGetAccountTransfersNotFound(&body)
 		default:
 			body, _ := ioutil.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("GuildsService", "GetAccountPortfolio", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("GuildsService", "GetAccountTransfers", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildGetAccountPortfoliosRequest instantiates a HTTP request object with
+// BuildGetAccountPositionsRequest instantiates a HTTP request object with
 // method and path set to call the "GuildsService" service
-// "GetAccountPortfolios" endpoint
-func (c *Client) BuildGetAccountPortfoliosRequest(ctx context.Context, v interface{}) (*http.Request, error) {
+// "GetAccountPositions" endpoint
+func (c *Client) BuildGetAccountPositionsRequest(ctx context.Context, v interface{}) (*http.Request, error) {
 	var (
 		injectiveAddress string
 	)
 	{
-		p, ok := v.(*guildsservice.GetAccountPortfoliosPayload)
+		p, ok := v.(*guildsservice.GetAccountPositionsPayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("GuildsService", "GetAccountPortfolios", "*guildsservice.GetAccountPortfoliosPayload", v)
+			return nil, goahttp.ErrInvalidType("GuildsService", "GetAccountPositions", "*guildsservice.GetAccountPositionsPayload", v)
 		}
 		injectiveAddress = p.InjectiveAddress
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetAccountPortfoliosGuildsServicePath(injectiveAddress)}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetAccountPositionsGuildsServicePath(injectiveAddress)}
 	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("GuildsService", "GetAccountPortfolios", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("GuildsService", "GetAccountPositions", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -1132,34 +2731,15 @@ func (c *Client) BuildGetAccountPortfoliosRequest(ctx context.Context, v interfa
 	return req, nil
 }
 
-// EncodeGetAccountPortfoliosRequest returns an encoder for requests sent to
-// the GuildsService GetAccountPortfolios server.
-func EncodeGetAccountPortfoliosRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, interface{}) error {
-	return func(req *http.Request, v interface{}) error {
-		p, ok := v.(*guildsservice.GetAccountPortfoliosPayload)
-		if !ok {
-			return goahttp.ErrInvalidType("GuildsService", "GetAccountPortfolios", "*guildsservice.GetAccountPortfoliosPayload", v)
-		}
-		values := req.URL.Query()
-		if p.StartTime != nil {
-			values.Add("start_time", fmt.Sprintf("%v", *p.StartTime))
-		}
-		if p.EndTime != nil {
-			values.Add("end_time", fmt.Sprintf("%v", *p.EndTime))
-		}
-		req.URL.RawQuery = values.Encode()
-		return nil
-	}
-}
-
-// DecodeGetAccountPortfoliosResponse returns a decoder for responses returned
-// by the GuildsService GetAccountPortfolios endpoint. restoreBody controls
+// DecodeGetAccountPositionsResponse returns a decoder for responses returned
+// by the GuildsService GetAccountPositions endpoint. restoreBody controls
 // whether the response body should be restored after having been read.
-// DecodeGetAccountPortfoliosResponse may return the following errors:
-//	- "not_found" (type *goa.ServiceError): http.StatusNotFound
-//	- "internal" (type *goa.ServiceError): http.StatusInternalServerError
-//	- error: internal error
-func DecodeGetAccountPortfoliosResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (interface{}, error) {
+// DecodeGetAccountPositionsResponse may return the following errors:
+//   - "invalid_arg" (type *guildsservice.APIError): http.StatusBadRequest
+//   - "not_found" (type *guildsservice.APIError): http.StatusNotFound
+//   - "internal" (type *guildsservice.APIError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeGetAccountPositionsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (interface{}, error) {
 	return func(resp *http.Response) (interface{}, error) {
 		if restoreBody {
 			b, err := ioutil.ReadAll(resp.Body)
@@ -1176,72 +2756,85 @@ func DecodeGetAccountPortfoliosResponse(decoder func(*http.Response) goahttp.Dec
 		switch resp.StatusCode {
 		case http.StatusOK:
 			var (
-				body GetAccountPortfoliosResponseBody
+				body GetAccountPositionsResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("GuildsService", "GetAccountPortfolios", err)
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetAccountPositions", err)
 			}
-			err = ValidateGetAccountPortfoliosResponseBody(&body)
+			err = ValidateGetAccountPositionsResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("GuildsService", "GetAccountPortfolios", err)
+				return nil, goahttp.ErrValidationError("GuildsService", "GetAccountPositions", err)
 			}
-			res := NewGetAccountPortfoliosResultOK(&body)
+			res := NewGetAccountPositionsResultOK(&body)
 			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body GetAccountPositionsInvalidArgResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetAccountPositions", err)
+			}
+			err = ValidateGetAccountPositionsInvalidArgResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("GuildsService", "GetAccountPositions", err)
+			}
+			return nil, NewGetAccountPositionsInvalidArg(&body)
 		case http.StatusNotFound:
 			var (
-				body GetAccountPortfoliosNotFoundResponseBody
+				body GetAccountPositionsNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("GuildsService", "GetAccountPortfolios", err)
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetAccountPositions", err)
 			}
-			err = ValidateGetAccountPortfoliosNotFoundResponseBody(&body)
+			err = ValidateGetAccountPositionsNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("GuildsService", "GetAccountPortfolios", err)
+				return nil, goahttp.ErrValidationError("GuildsService", "GetAccountPositions", err)
 			}
-			return nil, NewGetAccountPortfoliosNotFound(&body)
+			return nil, NewGetAccountPositionsNotFound(&body)
 		case http.StatusInternalServerError:
 			var (
-				body GetAccountPortfoliosInternalResponseBody
+				body GetAccountPositionsInternalResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("GuildsService", "GetAccountPortfolios", err)
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetAccountPositions", err)
 			}
-			err = ValidateGetAccountPortfoliosInternalResponseBody(&body)
+			err = ValidateGetAccountPositionsInternalResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("GuildsService", "GetAccountPortfolios", err)
+				return nil, goahttp.ErrValidationError("GuildsService", "GetAccountPositions", err)
 			}
-			return nil, NewGetAccountPortfoliosInternal(&body)
+			return nil, NewGetAccountPositionsInternal(&body)
 		default:
 			body, _ := ioutil.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("GuildsService", "GetAccountPortfolios", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("GuildsService", "GetAccountPositions", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildGetAccountMonthlyPortfoliosRequest instantiates a HTTP request object
-// with method and path set to call the "GuildsService" service
-// "GetAccountMonthlyPortfolios" endpoint
-func (c *Client) BuildGetAccountMonthlyPortfoliosRequest(ctx context.Context, v interface{}) (*http.Request, error) {
+// BuildGetGuildRiskRequest instantiates a HTTP request object with method and
+// path set to call the "GuildsService" service "GetGuildRisk" endpoint
+func (c *Client) BuildGetGuildRiskRequest(ctx context.Context, v interface{}) (*http.Request, error) {
 	var (
-		injectiveAddress string
+		guildID string
 	)
 	{
-		p, ok := v.(*guildsservice.GetAccountMonthlyPortfoliosPayload)
+		p, ok := v.(*guildsservice.GetGuildRiskPayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("GuildsService", "GetAccountMonthlyPortfolios", "*guildsservice.GetAccountMonthlyPortfoliosPayload", v)
+			return nil, goahttp.ErrInvalidType("GuildsService", "GetGuildRisk", "*guildsservice.GetGuildRiskPayload", v)
 		}
-		injectiveAddress = p.InjectiveAddress
+		guildID = p.GuildID
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetAccountMonthlyPortfoliosGuildsServicePath(injectiveAddress)}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetGuildRiskGuildsServicePath(guildID)}
 	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("GuildsService", "GetAccountMonthlyPortfolios", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("GuildsService", "GetGuildRisk", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -1250,35 +2843,15 @@ func (c *Client) BuildGetAccountMonthlyPortfoliosRequest(ctx context.Context, v
 	return req, nil
 }
 
-// EncodeGetAccountMonthlyPortfoliosRequest returns an encoder for requests
-// sent to the GuildsService GetAccountMonthlyPortfolios server.
-func EncodeGetAccountMonthlyPortfoliosRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, interface{}) error {
-	return func(req *http.Request, v interface{}) error {
-		p, ok := v.(*guildsservice.GetAccountMonthlyPortfoliosPayload)
-		if !ok {
-			return goahttp.ErrInvalidType("GuildsService", "GetAccountMonthlyPortfolios", "*guildsservice.GetAccountMonthlyPortfoliosPayload", v)
-		}
-		values := req.URL.Query()
-		if p.StartTime != nil {
-			values.Add("start_time", fmt.Sprintf("%v", *p.StartTime))
-		}
-		if p.EndTime != nil {
-			values.Add("end_time", fmt.Sprintf("%v", *p.EndTime))
-		}
-		req.URL.RawQuery = values.Encode()
-		return nil
-	}
-}
-
-// DecodeGetAccountMonthlyPortfoliosResponse returns a decoder for responses
-// returned by the GuildsService GetAccountMonthlyPortfolios endpoint.
-// restoreBody controls whether the response body should be restored after
-// having been read.
-// DecodeGetAccountMonthlyPortfoliosResponse may return the following errors:
-//	- "not_found" (type *goa.ServiceError): http.StatusNotFound
-//	- "internal" (type *goa.ServiceError): http.StatusInternalServerError
-//	- error: internal error
-func DecodeGetAccountMonthlyPortfoliosResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (interface{}, error) {
+// DecodeGetGuildRiskResponse returns a decoder for responses returned by the
+// GuildsService GetGuildRisk endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeGetGuildRiskResponse may return the following errors:
+//   - "invalid_arg" (type *guildsservice.APIError): http.StatusBadRequest
+//   - "not_found" (type *guildsservice.APIError): http.StatusNotFound
+//   - "internal" (type *guildsservice.APIError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeGetGuildRiskResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (interface{}, error) {
 	return func(resp *http.Response) (interface{}, error) {
 		if restoreBody {
 			b, err := ioutil.ReadAll(resp.Body)
@@ -1295,50 +2868,64 @@ func DecodeGetAccountMonthlyPortfoliosResponse(decoder func(*http.Response) goah
 		switch resp.StatusCode {
 		case http.StatusOK:
 			var (
-				body GetAccountMonthlyPortfoliosResponseBody
+				body GetGuildRiskResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("GuildsService", "GetAccountMonthlyPortfolios", err)
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetGuildRisk", err)
 			}
-			err = ValidateGetAccountMonthlyPortfoliosResponseBody(&body)
+			err = ValidateGetGuildRiskResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("GuildsService", "GetAccountMonthlyPortfolios", err)
+				return nil, goahttp.ErrValidationError("GuildsService", "GetGuildRisk", err)
 			}
-			res := NewGetAccountMonthlyPortfoliosResultOK(&body)
+			res := NewGetGuildRiskResultOK(&body)
 			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body GetGuildRiskInvalidArgResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetGuildRisk", err)
+			}
+			err = ValidateGetGuildRiskInvalidArgResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("GuildsService", "GetGuildRisk", err)
+			}
+			return nil, NewGetGuildRiskInvalidArg(&body)
 		case http.StatusNotFound:
 			var (
-				body GetAccountMonthlyPortfoliosNotFoundResponseBody
+				body GetGuildRiskNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("GuildsService", "GetAccountMonthlyPortfolios", err)
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetGuildRisk", err)
 			}
-			err = ValidateGetAccountMonthlyPortfoliosNotFoundResponseBody(&body)
+			err = ValidateGetGuildRiskNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("GuildsService", "GetAccountMonthlyPortfolios", err)
+				return nil, goahttp.ErrValidationError("GuildsService", "GetGuildRisk", err)
 			}
-			return nil, NewGetAccountMonthlyPortfoliosNotFound(&body)
+			return nil, NewGetGuildRiskNotFound(&body)
 		case http.StatusInternalServerError:
 			var (
-				body GetAccountMonthlyPortfoliosInternalResponseBody
+				body GetGuildRiskInternalResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("GuildsService", "GetAccountMonthlyPortfolios", err)
+				return nil, goahttp.ErrDecodingError("GuildsService", "GetGuildRisk", err)
 			}
-			err = ValidateGetAccountMonthlyPortfoliosInternalResponseBody(&body)
+			err = ValidateGetGuildRiskInternalResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("GuildsService", "GetAccountMonthlyPortfolios", err)
+				return nil, goahttp.ErrValidationError("GuildsService", "GetGuildRisk", err)
 			}
-			return nil, NewGetAccountMonthlyPortfoliosInternal(&body)
+			return nil, NewGetGuildRiskInternal(&body)
 		default:
 			body, _ := ioutil.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("GuildsService", "GetAccountMonthlyPortfolios", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("GuildsService", "GetGuildRisk", resp.StatusCode, string(body))
 		}
 	}
 }
@@ -1358,6 +2945,9 @@ func unmarshalGuildResponseBodyToGuildsserviceGuild(v *GuildResponseBody) *guild
 		Capacity:             *v.Capacity,
 		MemberCount:          *v.MemberCount,
 		DefaultMemberAddress: *v.DefaultMemberAddress,
+		HasInactiveMarkets:   v.HasInactiveMarkets,
+		Archived:             v.Archived,
+		AccessMode:           v.AccessMode,
 	}
 	res.Requirements = make([]*guildsservice.Requirement, len(v.Requirements))
 	for i, val := range v.Requirements {
@@ -1391,13 +2981,23 @@ func unmarshalSingleGuildPortfolioResponseBodyToGuildsserviceSingleGuildPortfoli
 		return nil
 	}
 	res := &guildsservice.SingleGuildPortfolio{
-		GuildID:   v.GuildID,
-		UpdatedAt: *v.UpdatedAt,
+		GuildID:    v.GuildID,
+		UpdatedAt:  *v.UpdatedAt,
+		ValueUsd:   v.ValueUsd,
+		NetFlowUsd: v.NetFlowUsd,
+		Twr:        v.Twr,
+		Mwr:        v.Mwr,
 	}
 	res.Balances = make([]*guildsservice.Balance, len(v.Balances))
 	for i, val := range v.Balances {
 		res.Balances[i] = unmarshalBalanceResponseBodyToGuildsserviceBalance(val)
 	}
+	if v.CashFlows != nil {
+		res.CashFlows = make([]*guildsservice.CashFlow, len(v.CashFlows))
+		for i, val := range v.CashFlows {
+			res.CashFlows[i] = unmarshalCashFlowResponseBodyToGuildsserviceCashFlow(val)
+		}
+	}
 
 	return res
 }
@@ -1417,6 +3017,23 @@ func unmarshalBalanceResponseBodyToGuildsserviceBalance(v *BalanceResponseBody)
 	return res
 }
 
+// unmarshalCashFlowResponseBodyToGuildsserviceCashFlow builds a value of type
+// *guildsservice.CashFlow from a value of type *CashFlowResponseBody.
+func unmarshalCashFlowResponseBodyToGuildsserviceCashFlow(v *CashFlowResponseBody) *guildsservice.CashFlow {
+	if v == nil {
+		return nil
+	}
+	res := &guildsservice.CashFlow{
+		Type:             *v.Type,
+		InjectiveAddress: v.InjectiveAddress,
+		Denom:            *v.Denom,
+		Amount:           *v.Amount,
+		ExecutedAt:       *v.ExecutedAt,
+	}
+
+	return res
+}
+
 // unmarshalGuildMemberResponseBodyToGuildsserviceGuildMember builds a value of
 // type *guildsservice.GuildMember from a value of type
 // *GuildMemberResponseBody.
@@ -1431,6 +3048,28 @@ func unmarshalGuildMemberResponseBodyToGuildsserviceGuildMember(v *GuildMemberRe
 		GuildID:              v.GuildID,
 		Params:               *v.Params,
 	}
+	if v.SubaccountIds != nil {
+		res.SubaccountIds = make([]string, len(v.SubaccountIds))
+		for i, val := range v.SubaccountIds {
+			res.SubaccountIds[i] = val
+		}
+	}
+
+	return res
+}
+
+// unmarshalWaitlistEntryResponseBodyToGuildsserviceWaitlistEntry builds a
+// value of type *guildsservice.WaitlistEntry from a value of type
+// *WaitlistEntryResponseBody.
+func unmarshalWaitlistEntryResponseBodyToGuildsserviceWaitlistEntry(v *WaitlistEntryResponseBody) *guildsservice.WaitlistEntry {
+	if v == nil {
+		return nil
+	}
+	res := &guildsservice.WaitlistEntry{
+		InjectiveAddress: *v.InjectiveAddress,
+		Position:         *v.Position,
+		EnqueuedAt:       *v.EnqueuedAt,
+	}
 
 	return res
 }
@@ -1442,8 +3081,28 @@ func unmarshalMarketResponseBodyToGuildsserviceMarket(v *MarketResponseBody) *gu
 		return nil
 	}
 	res := &guildsservice.Market{
-		MarketID:    *v.MarketID,
-		IsPerpetual: *v.IsPerpetual,
+		MarketID:     *v.MarketID,
+		IsPerpetual:  *v.IsPerpetual,
+		Status:       v.Status,
+		MakerFeeRate: v.MakerFeeRate,
+		TakerFeeRate: v.TakerFeeRate,
+	}
+
+	return res
+}
+
+// unmarshalNetFlowResponseBodyToGuildsserviceNetFlow builds a value of type
+// *guildsservice.NetFlow from a value of type *NetFlowResponseBody.
+func unmarshalNetFlowResponseBodyToGuildsserviceNetFlow(v *NetFlowResponseBody) *guildsservice.NetFlow {
+	if v == nil {
+		return nil
+	}
+	res := &guildsservice.NetFlow{
+		Denom:         *v.Denom,
+		Deposits:      *v.Deposits,
+		Withdrawals:   *v.Withdrawals,
+		NetFlow:       *v.NetFlow,
+		TransferCount: *v.TransferCount,
 	}
 
 	return res
@@ -1459,11 +3118,38 @@ func unmarshalSingleAccountPortfolioResponseBodyToGuildsserviceSingleAccountPort
 	res := &guildsservice.SingleAccountPortfolio{
 		InjectiveAddress: *v.InjectiveAddress,
 		UpdatedAt:        *v.UpdatedAt,
+		ValueUsd:         v.ValueUsd,
+		NetFlowUsd:       v.NetFlowUsd,
+		Twr:              v.Twr,
+		Mwr:              v.Mwr,
+		GuildID:          v.GuildID,
 	}
 	res.Balances = make([]*guildsservice.Balance, len(v.Balances))
 	for i, val := range v.Balances {
 		res.Balances[i] = unmarshalBalanceResponseBodyToGuildsserviceBalance(val)
 	}
+	if v.CashFlows != nil {
+		res.CashFlows = make([]*guildsservice.CashFlow, len(v.CashFlows))
+		for i, val := range v.CashFlows {
+			res.CashFlows[i] = unmarshalCashFlowResponseBodyToGuildsserviceCashFlow(val)
+		}
+	}
+
+	return res
+}
+
+// unmarshalMembershipResponseBodyToGuildsserviceMembership builds a value of
+// type *guildsservice.Membership from a value of type *MembershipResponseBody.
+func unmarshalMembershipResponseBodyToGuildsserviceMembership(v *MembershipResponseBody) *guildsservice.Membership {
+	if v == nil {
+		return nil
+	}
+	res := &guildsservice.Membership{
+		GuildID:              *v.GuildID,
+		IsDefaultGuildMember: *v.IsDefaultGuildMember,
+		Since:                *v.Since,
+		EndedAt:              v.EndedAt,
+	}
 
 	return res
 }
@@ -1483,3 +3169,197 @@ func unmarshalMonthlyAccountPortfolioResponseBodyToGuildsserviceMonthlyAccountPo
 
 	return res
 }
+
+// unmarshalGrantResponseBodyToGuildsserviceGrant builds a value of type
+// *guildsservice.Grant from a value of type *GrantResponseBody.
+func unmarshalGrantResponseBodyToGuildsserviceGrant(v *GrantResponseBody) *guildsservice.Grant {
+	if v == nil {
+		return nil
+	}
+	res := &guildsservice.Grant{
+		Msg:        *v.Msg,
+		IsGranted:  *v.IsGranted,
+		Expiration: v.Expiration,
+	}
+
+	return res
+}
+
+// unmarshalTradeResponseBodyToGuildsserviceTrade builds a value of type
+// *guildsservice.Trade from a value of type *TradeResponseBody.
+func unmarshalTradeResponseBodyToGuildsserviceTrade(v *TradeResponseBody) *guildsservice.Trade {
+	if v == nil {
+		return nil
+	}
+	res := &guildsservice.Trade{
+		TradeID:       *v.TradeID,
+		SubaccountID:  *v.SubaccountID,
+		MarketID:      *v.MarketID,
+		IsPerpetual:   *v.IsPerpetual,
+		Direction:     *v.Direction,
+		ExecutionType: *v.ExecutionType,
+		Price:         *v.Price,
+		Quantity:      *v.Quantity,
+		QuoteDenom:    *v.QuoteDenom,
+		Fee:           *v.Fee,
+		Volume:        *v.Volume,
+		RealizedPnl:   *v.RealizedPnl,
+		ExecutedAt:    *v.ExecutedAt,
+	}
+
+	return res
+}
+
+// unmarshalTradeStatsResponseBodyToGuildsserviceTradeStats builds a value of
+// type *guildsservice.TradeStats from a value of type *TradeStatsResponseBody.
+func unmarshalTradeStatsResponseBodyToGuildsserviceTradeStats(v *TradeStatsResponseBody) *guildsservice.TradeStats {
+	if v == nil {
+		return nil
+	}
+	res := &guildsservice.TradeStats{
+		QuoteDenom:  *v.QuoteDenom,
+		RealizedPnl: *v.RealizedPnl,
+		Fees:        *v.Fees,
+		Volume:      *v.Volume,
+		TradeCount:  *v.TradeCount,
+	}
+
+	return res
+}
+
+// unmarshalGuildFeeTotalResponseBodyToGuildsserviceGuildFeeTotal builds a
+// value of type *guildsservice.GuildFeeTotal from a value of type
+// *GuildFeeTotalResponseBody.
+func unmarshalGuildFeeTotalResponseBodyToGuildsserviceGuildFeeTotal(v *GuildFeeTotalResponseBody) *guildsservice.GuildFeeTotal {
+	if v == nil {
+		return nil
+	}
+	res := &guildsservice.GuildFeeTotal{
+		QuoteDenom:     *v.QuoteDenom,
+		FeesPaid:       *v.FeesPaid,
+		MasterFees:     *v.MasterFees,
+		Volume:         *v.Volume,
+		TradeCount:     *v.TradeCount,
+		UnresolvedFees: *v.UnresolvedFees,
+	}
+
+	return res
+}
+
+// unmarshalMemberFeeContributionResponseBodyToGuildsserviceMemberFeeContribution
+// builds a value of type *guildsservice.MemberFeeContribution from a value of
+// type *MemberFeeContributionResponseBody.
+func unmarshalMemberFeeContributionResponseBodyToGuildsserviceMemberFeeContribution(v *MemberFeeContributionResponseBody) *guildsservice.MemberFeeContribution {
+	if v == nil {
+		return nil
+	}
+	res := &guildsservice.MemberFeeContribution{
+		InjectiveAddress: *v.InjectiveAddress,
+		QuoteDenom:       *v.QuoteDenom,
+		FeesPaid:         *v.FeesPaid,
+		MasterFees:       *v.MasterFees,
+		Volume:           *v.Volume,
+		TradeCount:       *v.TradeCount,
+		Share:            *v.Share,
+		UnresolvedFees:   *v.UnresolvedFees,
+	}
+
+	return res
+}
+
+// unmarshalDailyGuildFeeResponseBodyToGuildsserviceDailyGuildFee builds a
+// value of type *guildsservice.DailyGuildFee from a value of type
+// *DailyGuildFeeResponseBody.
+func unmarshalDailyGuildFeeResponseBodyToGuildsserviceDailyGuildFee(v *DailyGuildFeeResponseBody) *guildsservice.DailyGuildFee {
+	if v == nil {
+		return nil
+	}
+	res := &guildsservice.DailyGuildFee{
+		Day:            *v.Day,
+		QuoteDenom:     *v.QuoteDenom,
+		FeesPaid:       *v.FeesPaid,
+		MasterFees:     *v.MasterFees,
+		Volume:         *v.Volume,
+		TradeCount:     *v.TradeCount,
+		UnresolvedFees: *v.UnresolvedFees,
+	}
+
+	return res
+}
+
+// unmarshalTransferResponseBodyToGuildsserviceTransfer builds a value of type
+// *guildsservice.Transfer from a value of type *TransferResponseBody.
+func unmarshalTransferResponseBodyToGuildsserviceTransfer(v *TransferResponseBody) *guildsservice.Transfer {
+	if v == nil {
+		return nil
+	}
+	res := &guildsservice.Transfer{
+		TransferID:   *v.TransferID,
+		SubaccountID: *v.SubaccountID,
+		TransferType: *v.TransferType,
+		Denom:        *v.Denom,
+		Amount:       *v.Amount,
+		ExecutedAt:   *v.ExecutedAt,
+	}
+
+	return res
+}
+
+// unmarshalPositionResponseBodyToGuildsservicePosition builds a value of type
+// *guildsservice.Position from a value of type *PositionResponseBody.
+func unmarshalPositionResponseBodyToGuildsservicePosition(v *PositionResponseBody) *guildsservice.Position {
+	res := &guildsservice.Position{
+		SubaccountID:     *v.SubaccountID,
+		MarketID:         *v.MarketID,
+		Ticker:           *v.Ticker,
+		QuoteDenom:       v.QuoteDenom,
+		Direction:        *v.Direction,
+		Quantity:         *v.Quantity,
+		EntryPrice:       *v.EntryPrice,
+		MarkPrice:        *v.MarkPrice,
+		Margin:           *v.Margin,
+		LiquidationPrice: *v.LiquidationPrice,
+	}
+
+	return res
+}
+
+// unmarshalGuildRiskResponseBodyToGuildsserviceGuildRisk builds a value of
+// type *guildsservice.GuildRisk from a value of type *GuildRiskResponseBody.
+func unmarshalGuildRiskResponseBodyToGuildsserviceGuildRisk(v *GuildRiskResponseBody) *guildsservice.GuildRisk {
+	if v == nil {
+		return nil
+	}
+	res := &guildsservice.GuildRisk{
+		GuildID:       *v.GuildID,
+		WindowStart:   *v.WindowStart,
+		SnapshotCount: *v.SnapshotCount,
+		MaxDrawdown:   *v.MaxDrawdown,
+		Volatility:    *v.Volatility,
+		SharpeRatio:   *v.SharpeRatio,
+		NotionalUsd:   *v.NotionalUsd,
+		EquityUsd:     *v.EquityUsd,
+		Leverage:      *v.Leverage,
+		UpdatedAt:     *v.UpdatedAt,
+	}
+	res.Markets = make([]*guildsservice.MarketExposure, len(v.Markets))
+	for i, val := range v.Markets {
+		res.Markets[i] = unmarshalMarketExposureResponseBodyToGuildsserviceMarketExposure(val)
+	}
+
+	return res
+}
+
+// unmarshalMarketExposureResponseBodyToGuildsserviceMarketExposure builds a
+// value of type *guildsservice.MarketExposure from a value of type
+// *MarketExposureResponseBody.
+func unmarshalMarketExposureResponseBodyToGuildsserviceMarketExposure(v *MarketExposureResponseBody) *guildsservice.MarketExposure {
+	res := &guildsservice.MarketExposure{
+		MarketID:    *v.MarketID,
+		Ticker:      *v.Ticker,
+		NotionalUsd: *v.NotionalUsd,
+		Share:       *v.Share,
+	}
+
+	return res
+}
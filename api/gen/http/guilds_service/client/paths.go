@@ -41,11 +41,21 @@ func EnterGuildGuildsServicePath(guildID string) string {
 	return fmt.Sprintf("/guilds/%v/member", guildID)
 }
 
+// GetGuildWaitlistGuildsServicePath returns the URL path to the GuildsService service GetGuildWaitlist HTTP endpoint.
+func GetGuildWaitlistGuildsServicePath(guildID string) string {
+	return fmt.Sprintf("/guilds/%v/waitlist", guildID)
+}
+
 // LeaveGuildGuildsServicePath returns the URL path to the GuildsService service LeaveGuild HTTP endpoint.
 func LeaveGuildGuildsServicePath(guildID string, injectiveAddress string) string {
 	return fmt.Sprintf("/guilds/%v/member/%v", guildID, injectiveAddress)
 }
 
+// TransferMemberGuildsServicePath returns the URL path to the GuildsService service TransferMember HTTP endpoint.
+func TransferMemberGuildsServicePath(injectiveAddress string) string {
+	return fmt.Sprintf("/members/%v/transfer", injectiveAddress)
+}
+
 // GetGuildMarketsGuildsServicePath returns the URL path to the GuildsService service GetGuildMarkets HTTP endpoint.
 func GetGuildMarketsGuildsServicePath(guildID string) string {
 	return fmt.Sprintf("/guilds/%v/markets", guildID)
@@ -71,7 +81,52 @@ func GetAccountPortfoliosGuildsServicePath(injectiveAddress string) string {
 	return fmt.Sprintf("/members/%v/portfolios", injectiveAddress)
 }
 
+// GetAccountMembershipsGuildsServicePath returns the URL path to the GuildsService service GetAccountMemberships HTTP endpoint.
+func GetAccountMembershipsGuildsServicePath(injectiveAddress string) string {
+	return fmt.Sprintf("/members/%v/memberships", injectiveAddress)
+}
+
 // GetAccountMonthlyPortfoliosGuildsServicePath returns the URL path to the GuildsService service GetAccountMonthlyPortfolios HTTP endpoint.
 func GetAccountMonthlyPortfoliosGuildsServicePath(injectiveAddress string) string {
 	return fmt.Sprintf("/members/%v/monthly-portfolios", injectiveAddress)
 }
+
+// GetAccountGrantsGuildsServicePath returns the URL path to the GuildsService service GetAccountGrants HTTP endpoint.
+func GetAccountGrantsGuildsServicePath(injectiveAddress string) string {
+	return fmt.Sprintf("/members/%v/grants", injectiveAddress)
+}
+
+// GetAccountTradesGuildsServicePath returns the URL path to the GuildsService service GetAccountTrades HTTP endpoint.
+func GetAccountTradesGuildsServicePath(injectiveAddress string) string {
+	return fmt.Sprintf("/members/%v/trades", injectiveAddress)
+}
+
+// GetAccountTradeStatsGuildsServicePath returns the URL path to the GuildsService service GetAccountTradeStats HTTP endpoint.
+func GetAccountTradeStatsGuildsServicePath(injectiveAddress string) string {
+	return fmt.Sprintf("/members/%v/trade-stats", injectiveAddress)
+}
+
+// GetGuildTradeStatsGuildsServicePath returns the URL path to the GuildsService service GetGuildTradeStats HTTP endpoint.
+func GetGuildTradeStatsGuildsServicePath(guildID string) string {
+	return fmt.Sprintf("/guilds/%v/trade-stats", guildID)
+}
+
+// GetGuildFeesGuildsServicePath returns the URL path to the GuildsService service GetGuildFees HTTP endpoint.
+func GetGuildFeesGuildsServicePath(guildID string) string {
+	return fmt.Sprintf("/guilds/%v/fees", guildID)
+}
+
+// GetAccountTransfersGuildsServicePath returns the URL path to the GuildsService service GetAccountTransfers HTTP endpoint.
+func GetAccountTransfersGuildsServicePath(injectiveAddress string) string {
+	return fmt.Sprintf("/members/%v/transfers", injectiveAddress)
+}
+
+// GetAccountPositionsGuildsServicePath returns the URL path to the GuildsService service GetAccountPositions HTTP endpoint.
+func GetAccountPositionsGuildsServicePath(injectiveAddress string) string {
+	return fmt.Sprintf("/members/%v/positions", injectiveAddress)
+}
+
+// GetGuildRiskGuildsServicePath returns the URL path to the GuildsService service GetGuildRisk HTTP endpoint.
+func GetGuildRiskGuildsServicePath(guildID string) string {
+	return fmt.Sprintf("/guilds/%v/risk", guildID)
+}
@@ -17,6 +17,20 @@ import (
 type EnterGuildRequestBody struct {
 	InjectiveAddress string  `form:"injective_address" json:"injective_address" xml:"injective_address"`
 	Params           *string `form:"params,omitempty" json:"params,omitempty" xml:"params,omitempty"`
+	// Non-default subaccounts owned by injective_address to trade from, default
+	// subaccount is always included
+	SubaccountIds []string `form:"subaccount_ids,omitempty" json:"subaccount_ids,omitempty" xml:"subaccount_ids,omitempty"`
+}
+
+// TransferMemberRequestBody is the type of the "GuildsService" service
+// "TransferMember" endpoint HTTP request body.
+type TransferMemberRequestBody struct {
+	// Guild to move the member to
+	GuildID string  `form:"guild_id" json:"guild_id" xml:"guild_id"`
+	Params  *string `form:"params,omitempty" json:"params,omitempty" xml:"params,omitempty"`
+	// Non-default subaccounts to trade from in the new guild, current subaccounts
+	// are kept if omitted
+	SubaccountIds []string `form:"subaccount_ids,omitempty" json:"subaccount_ids,omitempty" xml:"subaccount_ids,omitempty"`
 }
 
 // GetAllGuildsResponseBody is the type of the "GuildsService" service
@@ -55,8 +69,17 @@ type GetGuildDefaultMemberResponseBody struct {
 // EnterGuildResponseBody is the type of the "GuildsService" service
 // "EnterGuild" endpoint HTTP response body.
 type EnterGuildResponseBody struct {
+	// success, or waitlisted if guild is at capacity
 	JoinStatus *string `form:"join_status,omitempty" json:"join_status,omitempty" xml:"join_status,omitempty"`
 	Message    *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// 1-based position in guild waitlist if waitlisted
+	WaitlistPosition *int `form:"waitlist_position,omitempty" json:"waitlist_position,omitempty" xml:"waitlist_position,omitempty"`
+}
+
+// GetGuildWaitlistResponseBody is the type of the "GuildsService" service
+// "GetGuildWaitlist" endpoint HTTP response body.
+type GetGuildWaitlistResponseBody struct {
+	Waitlist []*WaitlistEntryResponseBody `form:"waitlist,omitempty" json:"waitlist,omitempty" xml:"waitlist,omitempty"`
 }
 
 // LeaveGuildResponseBody is the type of the "GuildsService" service
@@ -65,6 +88,13 @@ type LeaveGuildResponseBody struct {
 	LeaveStatus *string `form:"leave_status,omitempty" json:"leave_status,omitempty" xml:"leave_status,omitempty"`
 }
 
+// TransferMemberResponseBody is the type of the "GuildsService" service
+// "TransferMember" endpoint HTTP response body.
+type TransferMemberResponseBody struct {
+	TransferStatus *string `form:"transfer_status,omitempty" json:"transfer_status,omitempty" xml:"transfer_status,omitempty"`
+	FromGuildID    *string `form:"from_guild_id,omitempty" json:"from_guild_id,omitempty" xml:"from_guild_id,omitempty"`
+}
+
 // GetGuildMarketsResponseBody is the type of the "GuildsService" service
 // "GetGuildMarkets" endpoint HTTP response body.
 type GetGuildMarketsResponseBody struct {
@@ -75,6 +105,8 @@ type GetGuildMarketsResponseBody struct {
 // "GetGuildPortfolios" endpoint HTTP response body.
 type GetGuildPortfoliosResponseBody struct {
 	Portfolios []*SingleGuildPortfolioResponseBody `form:"portfolios,omitempty" json:"portfolios,omitempty" xml:"portfolios,omitempty"`
+	// Member deposits and withdrawals in the requested range
+	NetFlows []*NetFlowResponseBody `form:"net_flows,omitempty" json:"net_flows,omitempty" xml:"net_flows,omitempty"`
 }
 
 // GetAccountInfoResponseBody is the type of the "GuildsService" service
@@ -93,6 +125,14 @@ type GetAccountPortfolioResponseBody struct {
 // "GetAccountPortfolios" endpoint HTTP response body.
 type GetAccountPortfoliosResponseBody struct {
 	Portfolios []*SingleAccountPortfolioResponseBody `form:"portfolios,omitempty" json:"portfolios,omitempty" xml:"portfolios,omitempty"`
+	// Deposits and withdrawals in the requested range
+	NetFlows []*NetFlowResponseBody `form:"net_flows,omitempty" json:"net_flows,omitempty" xml:"net_flows,omitempty"`
+}
+
+// GetAccountMembershipsResponseBody is the type of the "GuildsService" service
+// "GetAccountMemberships" endpoint HTTP response body.
+type GetAccountMembershipsResponseBody struct {
+	Memberships []*MembershipResponseBody `form:"memberships,omitempty" json:"memberships,omitempty" xml:"memberships,omitempty"`
 }
 
 // GetAccountMonthlyPortfoliosResponseBody is the type of the "GuildsService"
@@ -101,492 +141,1053 @@ type GetAccountMonthlyPortfoliosResponseBody struct {
 	Portfolios []*MonthlyAccountPortfolioResponseBody `form:"portfolios,omitempty" json:"portfolios,omitempty" xml:"portfolios,omitempty"`
 }
 
+// GetAccountGrantsResponseBody is the type of the "GuildsService" service
+// "GetAccountGrants" endpoint HTTP response body.
+type GetAccountGrantsResponseBody struct {
+	Grants []*GrantResponseBody `form:"grants,omitempty" json:"grants,omitempty" xml:"grants,omitempty"`
+}
+
+// GetAccountTradesResponseBody is the type of the "GuildsService" service
+// "GetAccountTrades" endpoint HTTP response body.
+type GetAccountTradesResponseBody struct {
+	Trades []*TradeResponseBody `form:"trades,omitempty" json:"trades,omitempty" xml:"trades,omitempty"`
+}
+
+// GetAccountTradeStatsResponseBody is the type of the "GuildsService" service
+// "GetAccountTradeStats" endpoint HTTP response body.
+type GetAccountTradeStatsResponseBody struct {
+	Stats []*TradeStatsResponseBody `form:"stats,omitempty" json:"stats,omitempty" xml:"stats,omitempty"`
+}
+
+// GetGuildTradeStatsResponseBody is the type of the "GuildsService" service
+// "GetGuildTradeStats" endpoint HTTP response body.
+type GetGuildTradeStatsResponseBody struct {
+	Stats []*TradeStatsResponseBody `form:"stats,omitempty" json:"stats,omitempty" xml:"stats,omitempty"`
+}
+
+// GetGuildFeesResponseBody is the type of the "GuildsService" service
+// "GetGuildFees" endpoint HTTP response body.
+type GetGuildFeesResponseBody struct {
+	MasterAddress *string                              `form:"master_address,omitempty" json:"master_address,omitempty" xml:"master_address,omitempty"`
+	Totals        []*GuildFeeTotalResponseBody         `form:"totals,omitempty" json:"totals,omitempty" xml:"totals,omitempty"`
+	Members       []*MemberFeeContributionResponseBody `form:"members,omitempty" json:"members,omitempty" xml:"members,omitempty"`
+	Daily         []*DailyGuildFeeResponseBody         `form:"daily,omitempty" json:"daily,omitempty" xml:"daily,omitempty"`
+}
+
+// GetAccountTransfersResponseBody is the type of the "GuildsService" service
+// "GetAccountTransfers" endpoint HTTP response body.
+type GetAccountTransfersResponseBody struct {
+	Transfers []*TransferResponseBody `form:"transfers,omitempty" json:"transfers,omitempty" xml:"transfers,omitempty"`
+	NetFlows  []*NetFlowResponseBody  `form:"net_flows,omitempty" json:"net_flows,omitempty" xml:"net_flows,omitempty"`
+}
+
+// GetAccountPositionsResponseBody is the type of the "GuildsService" service
+// "GetAccountPositions" endpoint HTTP response body.
+type GetAccountPositionsResponseBody struct {
+	Positions []*PositionResponseBody `form:"positions,omitempty" json:"positions,omitempty" xml:"positions,omitempty"`
+	UpdatedAt *int64                  `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+}
+
+// GetGuildRiskResponseBody is the type of the "GuildsService" service
+// "GetGuildRisk" endpoint HTTP response body.
+type GetGuildRiskResponseBody struct {
+	Data *GuildRiskResponseBody `form:"data,omitempty" json:"data,omitempty" xml:"data,omitempty"`
+}
+
 // GetAllGuildsNotFoundResponseBody is the type of the "GuildsService" service
 // "GetAllGuilds" endpoint HTTP response body for the "not_found" error.
 type GetAllGuildsNotFoundResponseBody struct {
-	// Name is the name of this class of errors.
+	// Name of error: not_found, invalid_arg or internal
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
-	// ID is a unique identifier for this particular occurrence of the problem.
-	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// Message is a human-readable explanation specific to this occurrence of the
-	// problem.
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
-	// Is the error temporary?
-	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
-	// Is the error a timeout?
-	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
-	// Is the error a server-side fault?
-	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
 }
 
 // GetAllGuildsInternalResponseBody is the type of the "GuildsService" service
 // "GetAllGuilds" endpoint HTTP response body for the "internal" error.
 type GetAllGuildsInternalResponseBody struct {
-	// Name is the name of this class of errors.
+	// Name of error: not_found, invalid_arg or internal
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
-	// ID is a unique identifier for this particular occurrence of the problem.
-	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// Message is a human-readable explanation specific to this occurrence of the
-	// problem.
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
-	// Is the error temporary?
-	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
-	// Is the error a timeout?
-	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
-	// Is the error a server-side fault?
-	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetAllGuildsInvalidArgResponseBody is the type of the "GuildsService"
+// service "GetAllGuilds" endpoint HTTP response body for the "invalid_arg"
+// error.
+type GetAllGuildsInvalidArgResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
 }
 
 // GetSingleGuildNotFoundResponseBody is the type of the "GuildsService"
 // service "GetSingleGuild" endpoint HTTP response body for the "not_found"
 // error.
 type GetSingleGuildNotFoundResponseBody struct {
-	// Name is the name of this class of errors.
+	// Name of error: not_found, invalid_arg or internal
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
-	// ID is a unique identifier for this particular occurrence of the problem.
-	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// Message is a human-readable explanation specific to this occurrence of the
-	// problem.
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
-	// Is the error temporary?
-	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
-	// Is the error a timeout?
-	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
-	// Is the error a server-side fault?
-	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
 }
 
 // GetSingleGuildInternalResponseBody is the type of the "GuildsService"
 // service "GetSingleGuild" endpoint HTTP response body for the "internal"
 // error.
 type GetSingleGuildInternalResponseBody struct {
-	// Name is the name of this class of errors.
+	// Name of error: not_found, invalid_arg or internal
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetSingleGuildInvalidArgResponseBody is the type of the "GuildsService"
+// service "GetSingleGuild" endpoint HTTP response body for the "invalid_arg"
+// error.
+type GetSingleGuildInvalidArgResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetGuildMembersInvalidArgResponseBody is the type of the "GuildsService"
+// service "GetGuildMembers" endpoint HTTP response body for the "invalid_arg"
+// error.
+type GetGuildMembersInvalidArgResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
-	// ID is a unique identifier for this particular occurrence of the problem.
-	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// Message is a human-readable explanation specific to this occurrence of the
-	// problem.
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
-	// Is the error temporary?
-	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
-	// Is the error a timeout?
-	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
-	// Is the error a server-side fault?
-	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
 }
 
 // GetGuildMembersNotFoundResponseBody is the type of the "GuildsService"
 // service "GetGuildMembers" endpoint HTTP response body for the "not_found"
 // error.
 type GetGuildMembersNotFoundResponseBody struct {
-	// Name is the name of this class of errors.
+	// Name of error: not_found, invalid_arg or internal
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
-	// ID is a unique identifier for this particular occurrence of the problem.
-	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// Message is a human-readable explanation specific to this occurrence of the
-	// problem.
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
-	// Is the error temporary?
-	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
-	// Is the error a timeout?
-	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
-	// Is the error a server-side fault?
-	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
 }
 
 // GetGuildMembersInternalResponseBody is the type of the "GuildsService"
 // service "GetGuildMembers" endpoint HTTP response body for the "internal"
 // error.
 type GetGuildMembersInternalResponseBody struct {
-	// Name is the name of this class of errors.
+	// Name of error: not_found, invalid_arg or internal
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
-	// ID is a unique identifier for this particular occurrence of the problem.
-	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// Message is a human-readable explanation specific to this occurrence of the
-	// problem.
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
-	// Is the error temporary?
-	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
-	// Is the error a timeout?
-	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
-	// Is the error a server-side fault?
-	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
 }
 
 // GetGuildMasterAddressNotFoundResponseBody is the type of the "GuildsService"
 // service "GetGuildMasterAddress" endpoint HTTP response body for the
 // "not_found" error.
 type GetGuildMasterAddressNotFoundResponseBody struct {
-	// Name is the name of this class of errors.
+	// Name of error: not_found, invalid_arg or internal
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
-	// ID is a unique identifier for this particular occurrence of the problem.
-	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// Message is a human-readable explanation specific to this occurrence of the
-	// problem.
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
-	// Is the error temporary?
-	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
-	// Is the error a timeout?
-	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
-	// Is the error a server-side fault?
-	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
 }
 
 // GetGuildMasterAddressInternalResponseBody is the type of the "GuildsService"
 // service "GetGuildMasterAddress" endpoint HTTP response body for the
 // "internal" error.
 type GetGuildMasterAddressInternalResponseBody struct {
-	// Name is the name of this class of errors.
+	// Name of error: not_found, invalid_arg or internal
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetGuildMasterAddressInvalidArgResponseBody is the type of the
+// "GuildsService" service "GetGuildMasterAddress" endpoint HTTP response body
+// for the "invalid_arg" error.
+type GetGuildMasterAddressInvalidArgResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetGuildDefaultMemberInvalidArgResponseBody is the type of the
+// "GuildsService" service "GetGuildDefaultMember" endpoint HTTP response body
+// for the "invalid_arg" error.
+type GetGuildDefaultMemberInvalidArgResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
-	// ID is a unique identifier for this particular occurrence of the problem.
-	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// Message is a human-readable explanation specific to this occurrence of the
-	// problem.
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
-	// Is the error temporary?
-	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
-	// Is the error a timeout?
-	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
-	// Is the error a server-side fault?
-	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
 }
 
 // GetGuildDefaultMemberNotFoundResponseBody is the type of the "GuildsService"
 // service "GetGuildDefaultMember" endpoint HTTP response body for the
 // "not_found" error.
 type GetGuildDefaultMemberNotFoundResponseBody struct {
-	// Name is the name of this class of errors.
+	// Name of error: not_found, invalid_arg or internal
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
-	// ID is a unique identifier for this particular occurrence of the problem.
-	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// Message is a human-readable explanation specific to this occurrence of the
-	// problem.
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
-	// Is the error temporary?
-	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
-	// Is the error a timeout?
-	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
-	// Is the error a server-side fault?
-	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
 }
 
 // GetGuildDefaultMemberInternalResponseBody is the type of the "GuildsService"
 // service "GetGuildDefaultMember" endpoint HTTP response body for the
 // "internal" error.
 type GetGuildDefaultMemberInternalResponseBody struct {
-	// Name is the name of this class of errors.
+	// Name of error: not_found, invalid_arg or internal
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
-	// ID is a unique identifier for this particular occurrence of the problem.
-	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// Message is a human-readable explanation specific to this occurrence of the
-	// problem.
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
-	// Is the error temporary?
-	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
-	// Is the error a timeout?
-	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
-	// Is the error a server-side fault?
-	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
 }
 
 // EnterGuildNotFoundResponseBody is the type of the "GuildsService" service
 // "EnterGuild" endpoint HTTP response body for the "not_found" error.
 type EnterGuildNotFoundResponseBody struct {
-	// Name is the name of this class of errors.
+	// Name of error: not_found, invalid_arg or internal
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
-	// ID is a unique identifier for this particular occurrence of the problem.
-	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// Message is a human-readable explanation specific to this occurrence of the
-	// problem.
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
-	// Is the error temporary?
-	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
-	// Is the error a timeout?
-	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
-	// Is the error a server-side fault?
-	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
 }
 
 // EnterGuildInternalResponseBody is the type of the "GuildsService" service
 // "EnterGuild" endpoint HTTP response body for the "internal" error.
 type EnterGuildInternalResponseBody struct {
-	// Name is the name of this class of errors.
+	// Name of error: not_found, invalid_arg or internal
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// EnterGuildInvalidArgResponseBody is the type of the "GuildsService" service
+// "EnterGuild" endpoint HTTP response body for the "invalid_arg" error.
+type EnterGuildInvalidArgResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetGuildWaitlistInvalidArgResponseBody is the type of the "GuildsService"
+// service "GetGuildWaitlist" endpoint HTTP response body for the "invalid_arg"
+// error.
+type GetGuildWaitlistInvalidArgResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetGuildWaitlistNotFoundResponseBody is the type of the "GuildsService"
+// service "GetGuildWaitlist" endpoint HTTP response body for the "not_found"
+// error.
+type GetGuildWaitlistNotFoundResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetGuildWaitlistInternalResponseBody is the type of the "GuildsService"
+// service "GetGuildWaitlist" endpoint HTTP response body for the "internal"
+// error.
+type GetGuildWaitlistInternalResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
-	// ID is a unique identifier for this particular occurrence of the problem.
-	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// Message is a human-readable explanation specific to this occurrence of the
-	// problem.
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
-	// Is the error temporary?
-	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
-	// Is the error a timeout?
-	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
-	// Is the error a server-side fault?
-	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
 }
 
 // LeaveGuildNotFoundResponseBody is the type of the "GuildsService" service
 // "LeaveGuild" endpoint HTTP response body for the "not_found" error.
 type LeaveGuildNotFoundResponseBody struct {
-	// Name is the name of this class of errors.
+	// Name of error: not_found, invalid_arg or internal
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
-	// ID is a unique identifier for this particular occurrence of the problem.
-	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// Message is a human-readable explanation specific to this occurrence of the
-	// problem.
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
-	// Is the error temporary?
-	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
-	// Is the error a timeout?
-	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
-	// Is the error a server-side fault?
-	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
 }
 
 // LeaveGuildInternalResponseBody is the type of the "GuildsService" service
 // "LeaveGuild" endpoint HTTP response body for the "internal" error.
 type LeaveGuildInternalResponseBody struct {
-	// Name is the name of this class of errors.
+	// Name of error: not_found, invalid_arg or internal
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// LeaveGuildInvalidArgResponseBody is the type of the "GuildsService" service
+// "LeaveGuild" endpoint HTTP response body for the "invalid_arg" error.
+type LeaveGuildInvalidArgResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// TransferMemberInvalidArgResponseBody is the type of the "GuildsService"
+// service "TransferMember" endpoint HTTP response body for the "invalid_arg"
+// error.
+type TransferMemberInvalidArgResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// TransferMemberNotFoundResponseBody is the type of the "GuildsService"
+// service "TransferMember" endpoint HTTP response body for the "not_found"
+// error.
+type TransferMemberNotFoundResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
-	// ID is a unique identifier for this particular occurrence of the problem.
-	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// Message is a human-readable explanation specific to this occurrence of the
-	// problem.
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
-	// Is the error temporary?
-	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
-	// Is the error a timeout?
-	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
-	// Is the error a server-side fault?
-	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// TransferMemberInternalResponseBody is the type of the "GuildsService"
+// service "TransferMember" endpoint HTTP response body for the "internal"
+// error.
+type TransferMemberInternalResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
 }
 
 // GetGuildMarketsNotFoundResponseBody is the type of the "GuildsService"
 // service "GetGuildMarkets" endpoint HTTP response body for the "not_found"
 // error.
 type GetGuildMarketsNotFoundResponseBody struct {
-	// Name is the name of this class of errors.
+	// Name of error: not_found, invalid_arg or internal
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
-	// ID is a unique identifier for this particular occurrence of the problem.
-	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// Message is a human-readable explanation specific to this occurrence of the
-	// problem.
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
-	// Is the error temporary?
-	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
-	// Is the error a timeout?
-	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
-	// Is the error a server-side fault?
-	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
 }
 
 // GetGuildMarketsInternalResponseBody is the type of the "GuildsService"
 // service "GetGuildMarkets" endpoint HTTP response body for the "internal"
 // error.
 type GetGuildMarketsInternalResponseBody struct {
-	// Name is the name of this class of errors.
+	// Name of error: not_found, invalid_arg or internal
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetGuildMarketsInvalidArgResponseBody is the type of the "GuildsService"
+// service "GetGuildMarkets" endpoint HTTP response body for the "invalid_arg"
+// error.
+type GetGuildMarketsInvalidArgResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
-	// ID is a unique identifier for this particular occurrence of the problem.
-	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// Message is a human-readable explanation specific to this occurrence of the
-	// problem.
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
-	// Is the error temporary?
-	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
-	// Is the error a timeout?
-	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
-	// Is the error a server-side fault?
-	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
 }
 
 // GetGuildPortfoliosNotFoundResponseBody is the type of the "GuildsService"
 // service "GetGuildPortfolios" endpoint HTTP response body for the "not_found"
 // error.
 type GetGuildPortfoliosNotFoundResponseBody struct {
-	// Name is the name of this class of errors.
+	// Name of error: not_found, invalid_arg or internal
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
-	// ID is a unique identifier for this particular occurrence of the problem.
-	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// Message is a human-readable explanation specific to this occurrence of the
-	// problem.
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
-	// Is the error temporary?
-	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
-	// Is the error a timeout?
-	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
-	// Is the error a server-side fault?
-	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
 }
 
 // GetGuildPortfoliosInternalResponseBody is the type of the "GuildsService"
 // service "GetGuildPortfolios" endpoint HTTP response body for the "internal"
 // error.
 type GetGuildPortfoliosInternalResponseBody struct {
-	// Name is the name of this class of errors.
+	// Name of error: not_found, invalid_arg or internal
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetGuildPortfoliosInvalidArgResponseBody is the type of the "GuildsService"
+// service "GetGuildPortfolios" endpoint HTTP response body for the
+// "invalid_arg" error.
+type GetGuildPortfoliosInvalidArgResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
-	// ID is a unique identifier for this particular occurrence of the problem.
-	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// Message is a human-readable explanation specific to this occurrence of the
-	// problem.
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
-	// Is the error temporary?
-	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
-	// Is the error a timeout?
-	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
-	// Is the error a server-side fault?
-	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
 }
 
 // GetAccountInfoNotFoundResponseBody is the type of the "GuildsService"
 // service "GetAccountInfo" endpoint HTTP response body for the "not_found"
 // error.
 type GetAccountInfoNotFoundResponseBody struct {
-	// Name is the name of this class of errors.
+	// Name of error: not_found, invalid_arg or internal
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
-	// ID is a unique identifier for this particular occurrence of the problem.
-	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// Message is a human-readable explanation specific to this occurrence of the
-	// problem.
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
-	// Is the error temporary?
-	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
-	// Is the error a timeout?
-	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
-	// Is the error a server-side fault?
-	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
 }
 
 // GetAccountInfoInternalResponseBody is the type of the "GuildsService"
 // service "GetAccountInfo" endpoint HTTP response body for the "internal"
 // error.
 type GetAccountInfoInternalResponseBody struct {
-	// Name is the name of this class of errors.
+	// Name of error: not_found, invalid_arg or internal
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetAccountInfoInvalidArgResponseBody is the type of the "GuildsService"
+// service "GetAccountInfo" endpoint HTTP response body for the "invalid_arg"
+// error.
+type GetAccountInfoInvalidArgResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
-	// ID is a unique identifier for this particular occurrence of the problem.
-	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// Message is a human-readable explanation specific to this occurrence of the
-	// problem.
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
-	// Is the error temporary?
-	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
-	// Is the error a timeout?
-	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
-	// Is the error a server-side fault?
-	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
 }
 
 // GetAccountPortfolioNotFoundResponseBody is the type of the "GuildsService"
 // service "GetAccountPortfolio" endpoint HTTP response body for the
 // "not_found" error.
 type GetAccountPortfolioNotFoundResponseBody struct {
-	// Name is the name of this class of errors.
+	// Name of error: not_found, invalid_arg or internal
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
-	// ID is a unique identifier for this particular occurrence of the problem.
-	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// Message is a human-readable explanation specific to this occurrence of the
-	// problem.
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
-	// Is the error temporary?
-	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
-	// Is the error a timeout?
-	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
-	// Is the error a server-side fault?
-	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
 }
 
 // GetAccountPortfolioInternalResponseBody is the type of the "GuildsService"
 // service "GetAccountPortfolio" endpoint HTTP response body for the "internal"
 // error.
 type GetAccountPortfolioInternalResponseBody struct {
-	// Name is the name of this class of errors.
+	// Name of error: not_found, invalid_arg or internal
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetAccountPortfolioInvalidArgResponseBody is the type of the "GuildsService"
+// service "GetAccountPortfolio" endpoint HTTP response body for the
+// "invalid_arg" error.
+type GetAccountPortfolioInvalidArgResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
-	// ID is a unique identifier for this particular occurrence of the problem.
-	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// Message is a human-readable explanation specific to this occurrence of the
-	// problem.
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
-	// Is the error temporary?
-	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
-	// Is the error a timeout?
-	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
-	// Is the error a server-side fault?
-	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetAccountPortfoliosInvalidArgResponseBody is the type of the
+// "GuildsService" service "GetAccountPortfolios" endpoint HTTP response body
+// for the "invalid_arg" error.
+type GetAccountPortfoliosInvalidArgResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
 }
 
 // GetAccountPortfoliosNotFoundResponseBody is the type of the "GuildsService"
 // service "GetAccountPortfolios" endpoint HTTP response body for the
 // "not_found" error.
 type GetAccountPortfoliosNotFoundResponseBody struct {
-	// Name is the name of this class of errors.
+	// Name of error: not_found, invalid_arg or internal
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
-	// ID is a unique identifier for this particular occurrence of the problem.
-	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// Message is a human-readable explanation specific to this occurrence of the
-	// problem.
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
-	// Is the error temporary?
-	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
-	// Is the error a timeout?
-	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
-	// Is the error a server-side fault?
-	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
 }
 
 // GetAccountPortfoliosInternalResponseBody is the type of the "GuildsService"
 // service "GetAccountPortfolios" endpoint HTTP response body for the
 // "internal" error.
 type GetAccountPortfoliosInternalResponseBody struct {
-	// Name is the name of this class of errors.
+	// Name of error: not_found, invalid_arg or internal
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetAccountMembershipsInvalidArgResponseBody is the type of the
+// "GuildsService" service "GetAccountMemberships" endpoint HTTP response body
+// for the "invalid_arg" error.
+type GetAccountMembershipsInvalidArgResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
-	// ID is a unique identifier for this particular occurrence of the problem.
-	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// Message is a human-readable explanation specific to this occurrence of the
-	// problem.
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
-	// Is the error temporary?
-	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
-	// Is the error a timeout?
-	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
-	// Is the error a server-side fault?
-	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetAccountMembershipsInternalResponseBody is the type of the "GuildsService"
+// service "GetAccountMemberships" endpoint HTTP response body for the
+// "internal" error.
+type GetAccountMembershipsInternalResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetAccountMembershipsNotFoundResponseBody is the type of the "GuildsService"
+// service "GetAccountMemberships" endpoint HTTP response body for the
+// "not_found" error.
+type GetAccountMembershipsNotFoundResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
 }
 
 // GetAccountMonthlyPortfoliosNotFoundResponseBody is the type of the
 // "GuildsService" service "GetAccountMonthlyPortfolios" endpoint HTTP response
 // body for the "not_found" error.
 type GetAccountMonthlyPortfoliosNotFoundResponseBody struct {
-	// Name is the name of this class of errors.
+	// Name of error: not_found, invalid_arg or internal
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
-	// ID is a unique identifier for this particular occurrence of the problem.
-	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// Message is a human-readable explanation specific to this occurrence of the
-	// problem.
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
-	// Is the error temporary?
-	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
-	// Is the error a timeout?
-	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
-	// Is the error a server-side fault?
-	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
 }
 
 // GetAccountMonthlyPortfoliosInternalResponseBody is the type of the
 // "GuildsService" service "GetAccountMonthlyPortfolios" endpoint HTTP response
 // body for the "internal" error.
 type GetAccountMonthlyPortfoliosInternalResponseBody struct {
-	// Name is the name of this class of errors.
+	// Name of error: not_found, invalid_arg or internal
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetAccountMonthlyPortfoliosInvalidArgResponseBody is the type of the
+// "GuildsService" service "GetAccountMonthlyPortfolios" endpoint HTTP response
+// body for the "invalid_arg" error.
+type GetAccountMonthlyPortfoliosInvalidArgResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetAccountGrantsNotFoundResponseBody is the type of the "GuildsService"
+// service "GetAccountGrants" endpoint HTTP response body for the "not_found"
+// error.
+type GetAccountGrantsNotFoundResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetAccountGrantsInvalidArgResponseBody is the type of the "GuildsService"
+// service "GetAccountGrants" endpoint HTTP response body for the "invalid_arg"
+// error.
+type GetAccountGrantsInvalidArgResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetAccountGrantsInternalResponseBody is the type of the "GuildsService"
+// service "GetAccountGrants" endpoint HTTP response body for the "internal"
+// error.
+type GetAccountGrantsInternalResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetAccountTradesInvalidArgResponseBody is the type of the "GuildsService"
+// service "GetAccountTrades" endpoint HTTP response body for the "invalid_arg"
+// error.
+type GetAccountTradesInvalidArgResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetAccountTradesInternalResponseBody is the type of the "GuildsService"
+// service "GetAccountTrades" endpoint HTTP response body for the "internal"
+// error.
+type GetAccountTradesInternalResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetAccountTradesNotFoundResponseBody is the type of the "GuildsService"
+// service "GetAccountTrades" endpoint HTTP response body for the "not_found"
+// error.
+type GetAccountTradesNotFoundResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetAccountTradeStatsInvalidArgResponseBody is the type of the
+// "GuildsService" service "GetAccountTradeStats" endpoint HTTP response body
+// for the "invalid_arg" error.
+type GetAccountTradeStatsInvalidArgResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetAccountTradeStatsInternalResponseBody is the type of the "GuildsService"
+// service "GetAccountTradeStats" endpoint HTTP response body for the
+// "internal" error.
+type GetAccountTradeStatsInternalResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetAccountTradeStatsNotFoundResponseBody is the type of the "GuildsService"
+// service "GetAccountTradeStats" endpoint HTTP response body for the
+// "not_found" error.
+type GetAccountTradeStatsNotFoundResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetGuildTradeStatsInvalidArgResponseBody is the type of the "GuildsService"
+// service "GetGuildTradeStats" endpoint HTTP response body for the
+// "invalid_arg" error.
+type GetGuildTradeStatsInvalidArgResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetGuildTradeStatsNotFoundResponseBody is the type of the "GuildsService"
+// service "GetGuildTradeStats" endpoint HTTP response body for the "not_found"
+// error.
+type GetGuildTradeStatsNotFoundResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetGuildTradeStatsInternalResponseBody is the type of the "GuildsService"
+// service "GetGuildTradeStats" endpoint HTTP response body for the "internal"
+// error.
+type GetGuildTradeStatsInternalResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetGuildFeesInvalidArgResponseBody is the type of the "GuildsService"
+// service "GetGuildFees" endpoint HTTP response body for the "invalid_arg"
+// error.
+type GetGuildFeesInvalidArgResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetGuildFeesNotFoundResponseBody is the type of the "GuildsService" service
+// "GetGuildFees" endpoint HTTP response body for the "not_found" error.
+type GetGuildFeesNotFoundResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetGuildFeesInternalResponseBody is the type of the "GuildsService" service
+// "GetGuildFees" endpoint HTTP response body for the "internal" error.
+type GetGuildFeesInternalResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetAccountTransfersInvalidArgResponseBody is the type of the "GuildsService"
+// service "GetAccountTransfers" endpoint HTTP response body for the
+// "invalid_arg" error.
+type GetAccountTransfersInvalidArgResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetAccountTransfersInternalResponseBody is the type of the "GuildsService"
+// service "GetAccountTransfers" endpoint HTTP response body for the "internal"
+// error.
+type GetAccountTransfersInternalResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetAccountTransfersNotFoundResponseBody is the type of the "GuildsService"
+// service "GetAccountTransfers" endpoint HTTP response body for the
+// "not_found" error.
+type GetAccountTransfersNotFoundResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetAccountPositionsInvalidArgResponseBody is the type of the "GuildsService"
+// service "GetAccountPositions" endpoint HTTP response body for the
+// "invalid_arg" error.
+type GetAccountPositionsInvalidArgResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetAccountPositionsNotFoundResponseBody is the type of the "GuildsService"
+// service "GetAccountPositions" endpoint HTTP response body for the
+// "not_found" error.
+type GetAccountPositionsNotFoundResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetAccountPositionsInternalResponseBody is the type of the "GuildsService"
+// service "GetAccountPositions" endpoint HTTP response body for the "internal"
+// error.
+type GetAccountPositionsInternalResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetGuildRiskInvalidArgResponseBody is the type of the "GuildsService"
+// service "GetGuildRisk" endpoint HTTP response body for the "invalid_arg"
+// error.
+type GetGuildRiskInvalidArgResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetGuildRiskNotFoundResponseBody is the type of the "GuildsService" service
+// "GetGuildRisk" endpoint HTTP response body for the "not_found" error.
+type GetGuildRiskNotFoundResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetGuildRiskInternalResponseBody is the type of the "GuildsService" service
+// "GetGuildRisk" endpoint HTTP response body for the "internal" error.
+type GetGuildRiskInternalResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
-	// ID is a unique identifier for this particular occurrence of the problem.
-	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// Message is a human-readable explanation specific to this occurrence of the
-	// problem.
+	// Machine-readable error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message in english
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
-	// Is the error temporary?
-	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
-	// Is the error a timeout?
-	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
-	// Is the error a server-side fault?
-	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
 }
 
 // GuildResponseBody is used to define fields on response body types.
@@ -601,6 +1202,13 @@ type GuildResponseBody struct {
 	MemberCount          *int                              `form:"member_count,omitempty" json:"member_count,omitempty" xml:"member_count,omitempty"`
 	CurrentPortfolio     *SingleGuildPortfolioResponseBody `form:"current_portfolio,omitempty" json:"current_portfolio,omitempty" xml:"current_portfolio,omitempty"`
 	DefaultMemberAddress *string                           `form:"default_member_address,omitempty" json:"default_member_address,omitempty" xml:"default_member_address,omitempty"`
+	// Guild has delisted or paused markets
+	HasInactiveMarkets *bool `form:"has_inactive_markets,omitempty" json:"has_inactive_markets,omitempty" xml:"has_inactive_markets,omitempty"`
+	// Archived guild is closed to new members, its history is kept
+	Archived *bool `form:"archived,omitempty" json:"archived,omitempty" xml:"archived,omitempty"`
+	// open, allowlist or invite_code, invite code is passed in params when
+	// entering guild
+	AccessMode *string `form:"access_mode,omitempty" json:"access_mode,omitempty" xml:"access_mode,omitempty"`
 }
 
 // RequirementResponseBody is used to define fields on response body types.
@@ -616,6 +1224,16 @@ type SingleGuildPortfolioResponseBody struct {
 	GuildID   *string                `form:"guild_id,omitempty" json:"guild_id,omitempty" xml:"guild_id,omitempty"`
 	Balances  []*BalanceResponseBody `form:"balances,omitempty" json:"balances,omitempty" xml:"balances,omitempty"`
 	UpdatedAt *int64                 `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// Usd value of balances, unrealized pnl and bank balances
+	ValueUsd  *float64                `form:"value_usd,omitempty" json:"value_usd,omitempty" xml:"value_usd,omitempty"`
+	CashFlows []*CashFlowResponseBody `form:"cash_flows,omitempty" json:"cash_flows,omitempty" xml:"cash_flows,omitempty"`
+	// Usd value of member transfers and joins/leaves since previous snapshot
+	NetFlowUsd *float64 `form:"net_flow_usd,omitempty" json:"net_flow_usd,omitempty" xml:"net_flow_usd,omitempty"`
+	// Cumulative time-weighted return since first snapshot of the requested range
+	Twr *float64 `form:"twr,omitempty" json:"twr,omitempty" xml:"twr,omitempty"`
+	// Cumulative money-weighted (modified Dietz) return since first snapshot of
+	// the requested range
+	Mwr *float64 `form:"mwr,omitempty" json:"mwr,omitempty" xml:"mwr,omitempty"`
 }
 
 // BalanceResponseBody is used to define fields on response body types.
@@ -628,6 +1246,15 @@ type BalanceResponseBody struct {
 	PriceUsd         *float64 `form:"price_usd,omitempty" json:"price_usd,omitempty" xml:"price_usd,omitempty"`
 }
 
+// CashFlowResponseBody is used to define fields on response body types.
+type CashFlowResponseBody struct {
+	Type             *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+	InjectiveAddress *string `form:"injective_address,omitempty" json:"injective_address,omitempty" xml:"injective_address,omitempty"`
+	Denom            *string `form:"denom,omitempty" json:"denom,omitempty" xml:"denom,omitempty"`
+	Amount           *string `form:"amount,omitempty" json:"amount,omitempty" xml:"amount,omitempty"`
+	ExecutedAt       *int64  `form:"executed_at,omitempty" json:"executed_at,omitempty" xml:"executed_at,omitempty"`
+}
+
 // GuildMemberResponseBody is used to define fields on response body types.
 type GuildMemberResponseBody struct {
 	InjectiveAddress     *string `form:"injective_address,omitempty" json:"injective_address,omitempty" xml:"injective_address,omitempty"`
@@ -635,12 +1262,37 @@ type GuildMemberResponseBody struct {
 	Since                *int64  `form:"since,omitempty" json:"since,omitempty" xml:"since,omitempty"`
 	GuildID              *string `form:"guild_id,omitempty" json:"guild_id,omitempty" xml:"guild_id,omitempty"`
 	Params               *string `form:"params,omitempty" json:"params,omitempty" xml:"params,omitempty"`
+	// Registered non-default subaccounts, portfolio is aggregated over default
+	// subaccount and these
+	SubaccountIds []string `form:"subaccount_ids,omitempty" json:"subaccount_ids,omitempty" xml:"subaccount_ids,omitempty"`
+}
+
+// WaitlistEntryResponseBody is used to define fields on response body types.
+type WaitlistEntryResponseBody struct {
+	InjectiveAddress *string `form:"injective_address,omitempty" json:"injective_address,omitempty" xml:"injective_address,omitempty"`
+	// 1-based position in waitlist
+	Position   *int   `form:"position,omitempty" json:"position,omitempty" xml:"position,omitempty"`
+	EnqueuedAt *int64 `form:"enqueued_at,omitempty" json:"enqueued_at,omitempty" xml:"enqueued_at,omitempty"`
 }
 
 // MarketResponseBody is used to define fields on response body types.
 type MarketResponseBody struct {
 	MarketID    *string `form:"market_id,omitempty" json:"market_id,omitempty" xml:"market_id,omitempty"`
 	IsPerpetual *bool   `form:"is_perpetual,omitempty" json:"is_perpetual,omitempty" xml:"is_perpetual,omitempty"`
+	// Market status of exchange, empty if it has not been refreshed
+	Status       *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	MakerFeeRate *string `form:"maker_fee_rate,omitempty" json:"maker_fee_rate,omitempty" xml:"maker_fee_rate,omitempty"`
+	TakerFeeRate *string `form:"taker_fee_rate,omitempty" json:"taker_fee_rate,omitempty" xml:"taker_fee_rate,omitempty"`
+}
+
+// NetFlowResponseBody is used to define fields on response body types.
+type NetFlowResponseBody struct {
+	Denom       *string `form:"denom,omitempty" json:"denom,omitempty" xml:"denom,omitempty"`
+	Deposits    *string `form:"deposits,omitempty" json:"deposits,omitempty" xml:"deposits,omitempty"`
+	Withdrawals *string `form:"withdrawals,omitempty" json:"withdrawals,omitempty" xml:"withdrawals,omitempty"`
+	// deposits - withdrawals
+	NetFlow       *string `form:"net_flow,omitempty" json:"net_flow,omitempty" xml:"net_flow,omitempty"`
+	TransferCount *int    `form:"transfer_count,omitempty" json:"transfer_count,omitempty" xml:"transfer_count,omitempty"`
 }
 
 // SingleAccountPortfolioResponseBody is used to define fields on response body
@@ -649,6 +1301,27 @@ type SingleAccountPortfolioResponseBody struct {
 	InjectiveAddress *string                `form:"injective_address,omitempty" json:"injective_address,omitempty" xml:"injective_address,omitempty"`
 	Balances         []*BalanceResponseBody `form:"balances,omitempty" json:"balances,omitempty" xml:"balances,omitempty"`
 	UpdatedAt        *int64                 `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// Usd value of balances, unrealized pnl and bank balances
+	ValueUsd  *float64                `form:"value_usd,omitempty" json:"value_usd,omitempty" xml:"value_usd,omitempty"`
+	CashFlows []*CashFlowResponseBody `form:"cash_flows,omitempty" json:"cash_flows,omitempty" xml:"cash_flows,omitempty"`
+	// Usd value of cash flows since previous snapshot
+	NetFlowUsd *float64 `form:"net_flow_usd,omitempty" json:"net_flow_usd,omitempty" xml:"net_flow_usd,omitempty"`
+	// Cumulative time-weighted return since first snapshot of the requested range
+	Twr *float64 `form:"twr,omitempty" json:"twr,omitempty" xml:"twr,omitempty"`
+	// Cumulative money-weighted (modified Dietz) return since first snapshot of
+	// the requested range
+	Mwr *float64 `form:"mwr,omitempty" json:"mwr,omitempty" xml:"mwr,omitempty"`
+	// Guild of the address at the time snapshot was taken
+	GuildID *string `form:"guild_id,omitempty" json:"guild_id,omitempty" xml:"guild_id,omitempty"`
+}
+
+// MembershipResponseBody is used to define fields on response body types.
+type MembershipResponseBody struct {
+	GuildID              *string `form:"guild_id,omitempty" json:"guild_id,omitempty" xml:"guild_id,omitempty"`
+	IsDefaultGuildMember *bool   `form:"is_default_guild_member,omitempty" json:"is_default_guild_member,omitempty" xml:"is_default_guild_member,omitempty"`
+	Since                *int64  `form:"since,omitempty" json:"since,omitempty" xml:"since,omitempty"`
+	// Time member left the guild in milliseconds, omitted for current membership
+	EndedAt *int64 `form:"ended_at,omitempty" json:"ended_at,omitempty" xml:"ended_at,omitempty"`
 }
 
 // MonthlyAccountPortfolioResponseBody is used to define fields on response
@@ -659,6 +1332,136 @@ type MonthlyAccountPortfolioResponseBody struct {
 	EndSnapshot   *SingleAccountPortfolioResponseBody `form:"end_snapshot,omitempty" json:"end_snapshot,omitempty" xml:"end_snapshot,omitempty"`
 }
 
+// GrantResponseBody is used to define fields on response body types.
+type GrantResponseBody struct {
+	Msg       *string `form:"msg,omitempty" json:"msg,omitempty" xml:"msg,omitempty"`
+	IsGranted *bool   `form:"is_granted,omitempty" json:"is_granted,omitempty" xml:"is_granted,omitempty"`
+	// Grant expiration in milliseconds, omitted if not granted
+	Expiration *int64 `form:"expiration,omitempty" json:"expiration,omitempty" xml:"expiration,omitempty"`
+}
+
+// TradeResponseBody is used to define fields on response body types.
+type TradeResponseBody struct {
+	TradeID       *string `form:"trade_id,omitempty" json:"trade_id,omitempty" xml:"trade_id,omitempty"`
+	SubaccountID  *string `form:"subaccount_id,omitempty" json:"subaccount_id,omitempty" xml:"subaccount_id,omitempty"`
+	MarketID      *string `form:"market_id,omitempty" json:"market_id,omitempty" xml:"market_id,omitempty"`
+	IsPerpetual   *bool   `form:"is_perpetual,omitempty" json:"is_perpetual,omitempty" xml:"is_perpetual,omitempty"`
+	Direction     *string `form:"direction,omitempty" json:"direction,omitempty" xml:"direction,omitempty"`
+	ExecutionType *string `form:"execution_type,omitempty" json:"execution_type,omitempty" xml:"execution_type,omitempty"`
+	Price         *string `form:"price,omitempty" json:"price,omitempty" xml:"price,omitempty"`
+	Quantity      *string `form:"quantity,omitempty" json:"quantity,omitempty" xml:"quantity,omitempty"`
+	QuoteDenom    *string `form:"quote_denom,omitempty" json:"quote_denom,omitempty" xml:"quote_denom,omitempty"`
+	Fee           *string `form:"fee,omitempty" json:"fee,omitempty" xml:"fee,omitempty"`
+	// price * quantity in quote denom
+	Volume *string `form:"volume,omitempty" json:"volume,omitempty" xml:"volume,omitempty"`
+	// Pnl of closed quantity against average entry price, fee excluded
+	RealizedPnl *string `form:"realized_pnl,omitempty" json:"realized_pnl,omitempty" xml:"realized_pnl,omitempty"`
+	ExecutedAt  *int64  `form:"executed_at,omitempty" json:"executed_at,omitempty" xml:"executed_at,omitempty"`
+}
+
+// TradeStatsResponseBody is used to define fields on response body types.
+type TradeStatsResponseBody struct {
+	QuoteDenom  *string `form:"quote_denom,omitempty" json:"quote_denom,omitempty" xml:"quote_denom,omitempty"`
+	RealizedPnl *string `form:"realized_pnl,omitempty" json:"realized_pnl,omitempty" xml:"realized_pnl,omitempty"`
+	Fees        *string `form:"fees,omitempty" json:"fees,omitempty" xml:"fees,omitempty"`
+	Volume      *string `form:"volume,omitempty" json:"volume,omitempty" xml:"volume,omitempty"`
+	TradeCount  *int    `form:"trade_count,omitempty" json:"trade_count,omitempty" xml:"trade_count,omitempty"`
+}
+
+// GuildFeeTotalResponseBody is used to define fields on response body types.
+type GuildFeeTotalResponseBody struct {
+	QuoteDenom *string `form:"quote_denom,omitempty" json:"quote_denom,omitempty" xml:"quote_denom,omitempty"`
+	// Trading fees paid by members, maker rebates deducted
+	FeesPaid *string `form:"fees_paid,omitempty" json:"fees_paid,omitempty" xml:"fees_paid,omitempty"`
+	// Relayer share of fees earned by guild master address
+	MasterFees *string `form:"master_fees,omitempty" json:"master_fees,omitempty" xml:"master_fees,omitempty"`
+	Volume     *string `form:"volume,omitempty" json:"volume,omitempty" xml:"volume,omitempty"`
+	TradeCount *int    `form:"trade_count,omitempty" json:"trade_count,omitempty" xml:"trade_count,omitempty"`
+	// Relayer share of fees paid on trades whose fee recipient couldn't be
+	// resolved, not counted in master_fees
+	UnresolvedFees *string `form:"unresolved_fees,omitempty" json:"unresolved_fees,omitempty" xml:"unresolved_fees,omitempty"`
+}
+
+// MemberFeeContributionResponseBody is used to define fields on response body
+// types.
+type MemberFeeContributionResponseBody struct {
+	InjectiveAddress *string `form:"injective_address,omitempty" json:"injective_address,omitempty" xml:"injective_address,omitempty"`
+	QuoteDenom       *string `form:"quote_denom,omitempty" json:"quote_denom,omitempty" xml:"quote_denom,omitempty"`
+	FeesPaid         *string `form:"fees_paid,omitempty" json:"fees_paid,omitempty" xml:"fees_paid,omitempty"`
+	MasterFees       *string `form:"master_fees,omitempty" json:"master_fees,omitempty" xml:"master_fees,omitempty"`
+	Volume           *string `form:"volume,omitempty" json:"volume,omitempty" xml:"volume,omitempty"`
+	TradeCount       *int    `form:"trade_count,omitempty" json:"trade_count,omitempty" xml:"trade_count,omitempty"`
+	// Member master_fees over guild master_fees in the quote denom, from 0 to 1
+	Share          *string `form:"share,omitempty" json:"share,omitempty" xml:"share,omitempty"`
+	UnresolvedFees *string `form:"unresolved_fees,omitempty" json:"unresolved_fees,omitempty" xml:"unresolved_fees,omitempty"`
+}
+
+// DailyGuildFeeResponseBody is used to define fields on response body types.
+type DailyGuildFeeResponseBody struct {
+	// Start of UTC day in milliseconds
+	Day            *int64  `form:"day,omitempty" json:"day,omitempty" xml:"day,omitempty"`
+	QuoteDenom     *string `form:"quote_denom,omitempty" json:"quote_denom,omitempty" xml:"quote_denom,omitempty"`
+	FeesPaid       *string `form:"fees_paid,omitempty" json:"fees_paid,omitempty" xml:"fees_paid,omitempty"`
+	MasterFees     *string `form:"master_fees,omitempty" json:"master_fees,omitempty" xml:"master_fees,omitempty"`
+	Volume         *string `form:"volume,omitempty" json:"volume,omitempty" xml:"volume,omitempty"`
+	TradeCount     *int    `form:"trade_count,omitempty" json:"trade_count,omitempty" xml:"trade_count,omitempty"`
+	UnresolvedFees *string `form:"unresolved_fees,omitempty" json:"unresolved_fees,omitempty" xml:"unresolved_fees,omitempty"`
+}
+
+// TransferResponseBody is used to define fields on response body types.
+type TransferResponseBody struct {
+	TransferID   *string `form:"transfer_id,omitempty" json:"transfer_id,omitempty" xml:"transfer_id,omitempty"`
+	SubaccountID *string `form:"subaccount_id,omitempty" json:"subaccount_id,omitempty" xml:"subaccount_id,omitempty"`
+	TransferType *string `form:"transfer_type,omitempty" json:"transfer_type,omitempty" xml:"transfer_type,omitempty"`
+	Denom        *string `form:"denom,omitempty" json:"denom,omitempty" xml:"denom,omitempty"`
+	// Positive for deposit, negative for withdrawal
+	Amount     *string `form:"amount,omitempty" json:"amount,omitempty" xml:"amount,omitempty"`
+	ExecutedAt *int64  `form:"executed_at,omitempty" json:"executed_at,omitempty" xml:"executed_at,omitempty"`
+}
+
+// PositionResponseBody is used to define fields on response body types.
+type PositionResponseBody struct {
+	SubaccountID     *string `form:"subaccount_id,omitempty" json:"subaccount_id,omitempty" xml:"subaccount_id,omitempty"`
+	MarketID         *string `form:"market_id,omitempty" json:"market_id,omitempty" xml:"market_id,omitempty"`
+	Ticker           *string `form:"ticker,omitempty" json:"ticker,omitempty" xml:"ticker,omitempty"`
+	QuoteDenom       *string `form:"quote_denom,omitempty" json:"quote_denom,omitempty" xml:"quote_denom,omitempty"`
+	Direction        *string `form:"direction,omitempty" json:"direction,omitempty" xml:"direction,omitempty"`
+	Quantity         *string `form:"quantity,omitempty" json:"quantity,omitempty" xml:"quantity,omitempty"`
+	EntryPrice       *string `form:"entry_price,omitempty" json:"entry_price,omitempty" xml:"entry_price,omitempty"`
+	MarkPrice        *string `form:"mark_price,omitempty" json:"mark_price,omitempty" xml:"mark_price,omitempty"`
+	Margin           *string `form:"margin,omitempty" json:"margin,omitempty" xml:"margin,omitempty"`
+	LiquidationPrice *string `form:"liquidation_price,omitempty" json:"liquidation_price,omitempty" xml:"liquidation_price,omitempty"`
+}
+
+// GuildRiskResponseBody is used to define fields on response body types.
+type GuildRiskResponseBody struct {
+	GuildID *string `form:"guild_id,omitempty" json:"guild_id,omitempty" xml:"guild_id,omitempty"`
+	// Time of first guild snapshot used for drawdown, volatility and sharpe ratio
+	WindowStart   *int64 `form:"window_start,omitempty" json:"window_start,omitempty" xml:"window_start,omitempty"`
+	SnapshotCount *int   `form:"snapshot_count,omitempty" json:"snapshot_count,omitempty" xml:"snapshot_count,omitempty"`
+	// Largest peak to trough decline of time-weighted value, as a positive fraction
+	MaxDrawdown *float64 `form:"max_drawdown,omitempty" json:"max_drawdown,omitempty" xml:"max_drawdown,omitempty"`
+	// Standard deviation of per-snapshot returns
+	Volatility *float64 `form:"volatility,omitempty" json:"volatility,omitempty" xml:"volatility,omitempty"`
+	// Mean over standard deviation of per-snapshot returns, not annualized
+	SharpeRatio *float64 `form:"sharpe_ratio,omitempty" json:"sharpe_ratio,omitempty" xml:"sharpe_ratio,omitempty"`
+	NotionalUsd *float64 `form:"notional_usd,omitempty" json:"notional_usd,omitempty" xml:"notional_usd,omitempty"`
+	EquityUsd   *float64 `form:"equity_usd,omitempty" json:"equity_usd,omitempty" xml:"equity_usd,omitempty"`
+	// Notional over equity
+	Leverage  *float64                      `form:"leverage,omitempty" json:"leverage,omitempty" xml:"leverage,omitempty"`
+	Markets   []*MarketExposureResponseBody `form:"markets,omitempty" json:"markets,omitempty" xml:"markets,omitempty"`
+	UpdatedAt *int64                        `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+}
+
+// MarketExposureResponseBody is used to define fields on response body types.
+type MarketExposureResponseBody struct {
+	MarketID    *string  `form:"market_id,omitempty" json:"market_id,omitempty" xml:"market_id,omitempty"`
+	Ticker      *string  `form:"ticker,omitempty" json:"ticker,omitempty" xml:"ticker,omitempty"`
+	NotionalUsd *float64 `form:"notional_usd,omitempty" json:"notional_usd,omitempty" xml:"notional_usd,omitempty"`
+	// Fraction of guild total notional
+	Share *float64 `form:"share,omitempty" json:"share,omitempty" xml:"share,omitempty"`
+}
+
 // NewEnterGuildRequestBody builds the HTTP request body from the payload of
 // the "EnterGuild" endpoint of the "GuildsService" service.
 func NewEnterGuildRequestBody(p *guildsservice.EnterGuildPayload) *EnterGuildRequestBody {
@@ -666,12 +1469,34 @@ func NewEnterGuildRequestBody(p *guildsservice.EnterGuildPayload) *EnterGuildReq
 		InjectiveAddress: p.InjectiveAddress,
 		Params:           p.Params,
 	}
+	if p.SubaccountIds != nil {
+		body.SubaccountIds = make([]string, len(p.SubaccountIds))
+		for i, val := range p.SubaccountIds {
+			body.SubaccountIds[i] = val
+		}
+	}
 	return body
 }
 
-// NewGetAllGuildsResultOK builds a "GuildsService" service "GetAllGuilds"
-// endpoint result from a HTTP "OK" response.
-func NewGetAllGuildsResultOK(body *GetAllGuildsResponseBody) *guildsservice.GetAllGuildsResult {
+// NewTransferMemberRequestBody builds the HTTP request body from the payload
+// of the "TransferMember" endpoint of the "GuildsService" service.
+func NewTransferMemberRequestBody(p *guildsservice.TransferMemberPayload) *TransferMemberRequestBody {
+	body := &TransferMemberRequestBody{
+		GuildID: p.GuildID,
+		Params:  p.Params,
+	}
+	if p.SubaccountIds != nil {
+		body.SubaccountIds = make([]string, len(p.SubaccountIds))
+		for i, val := range p.SubaccountIds {
+			body.SubaccountIds[i] = val
+		}
+	}
+	return body
+}
+
+// NewGetAllGuildsResultOK builds a "GuildsService" service "GetAllGuilds"
+// endpoint result from a HTTP "OK" response.
+func NewGetAllGuildsResultOK(body *GetAllGuildsResponseBody) *guildsservice.GetAllGuildsResult {
 	v := &guildsservice.GetAllGuildsResult{}
 	if body.Guilds != nil {
 		v.Guilds = make([]*guildsservice.Guild, len(body.Guilds))
@@ -685,14 +1510,19 @@ func NewGetAllGuildsResultOK(body *GetAllGuildsResponseBody) *guildsservice.GetA
 
 // NewGetAllGuildsNotFound builds a GuildsService service GetAllGuilds endpoint
 // not_found error.
-func NewGetAllGuildsNotFound(body *GetAllGuildsNotFoundResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+func NewGetAllGuildsNotFound(body *GetAllGuildsNotFoundResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
 	}
 
 	return v
@@ -700,14 +1530,39 @@ func NewGetAllGuildsNotFound(body *GetAllGuildsNotFoundResponseBody) *goa.Servic
 
 // NewGetAllGuildsInternal builds a GuildsService service GetAllGuilds endpoint
 // internal error.
-func NewGetAllGuildsInternal(body *GetAllGuildsInternalResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+func NewGetAllGuildsInternal(body *GetAllGuildsInternalResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewGetAllGuildsInvalidArg builds a GuildsService service GetAllGuilds
+// endpoint invalid_arg error.
+func NewGetAllGuildsInvalidArg(body *GetAllGuildsInvalidArgResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
 	}
 
 	return v
@@ -726,14 +1581,19 @@ func NewGetSingleGuildResultOK(body *GetSingleGuildResponseBody) *guildsservice.
 
 // NewGetSingleGuildNotFound builds a GuildsService service GetSingleGuild
 // endpoint not_found error.
-func NewGetSingleGuildNotFound(body *GetSingleGuildNotFoundResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+func NewGetSingleGuildNotFound(body *GetSingleGuildNotFoundResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
 	}
 
 	return v
@@ -741,14 +1601,39 @@ func NewGetSingleGuildNotFound(body *GetSingleGuildNotFoundResponseBody) *goa.Se
 
 // NewGetSingleGuildInternal builds a GuildsService service GetSingleGuild
 // endpoint internal error.
-func NewGetSingleGuildInternal(body *GetSingleGuildInternalResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+func NewGetSingleGuildInternal(body *GetSingleGuildInternalResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewGetSingleGuildInvalidArg builds a GuildsService service GetSingleGuild
+// endpoint invalid_arg error.
+func NewGetSingleGuildInvalidArg(body *GetSingleGuildInvalidArgResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
 	}
 
 	return v
@@ -768,16 +1653,41 @@ func NewGetGuildMembersResultOK(body *GetGuildMembersResponseBody) *guildsservic
 	return v
 }
 
+// NewGetGuildMembersInvalidArg builds a GuildsService service GetGuildMembers
+// endpoint invalid_arg error.
+func NewGetGuildMembersInvalidArg(body *GetGuildMembersInvalidArgResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
+	}
+
+	return v
+}
+
 // NewGetGuildMembersNotFound builds a GuildsService service GetGuildMembers
 // endpoint not_found error.
-func NewGetGuildMembersNotFound(body *GetGuildMembersNotFoundResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+func NewGetGuildMembersNotFound(body *GetGuildMembersNotFoundResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
 	}
 
 	return v
@@ -785,14 +1695,19 @@ func NewGetGuildMembersNotFound(body *GetGuildMembersNotFoundResponseBody) *goa.
 
 // NewGetGuildMembersInternal builds a GuildsService service GetGuildMembers
 // endpoint internal error.
-func NewGetGuildMembersInternal(body *GetGuildMembersInternalResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+func NewGetGuildMembersInternal(body *GetGuildMembersInternalResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
 	}
 
 	return v
@@ -810,14 +1725,19 @@ func NewGetGuildMasterAddressResultOK(body *GetGuildMasterAddressResponseBody) *
 
 // NewGetGuildMasterAddressNotFound builds a GuildsService service
 // GetGuildMasterAddress endpoint not_found error.
-func NewGetGuildMasterAddressNotFound(body *GetGuildMasterAddressNotFoundResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+func NewGetGuildMasterAddressNotFound(body *GetGuildMasterAddressNotFoundResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
 	}
 
 	return v
@@ -825,14 +1745,39 @@ func NewGetGuildMasterAddressNotFound(body *GetGuildMasterAddressNotFoundRespons
 
 // NewGetGuildMasterAddressInternal builds a GuildsService service
 // GetGuildMasterAddress endpoint internal error.
-func NewGetGuildMasterAddressInternal(body *GetGuildMasterAddressInternalResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+func NewGetGuildMasterAddressInternal(body *GetGuildMasterAddressInternalResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewGetGuildMasterAddressInvalidArg builds a GuildsService service
+// GetGuildMasterAddress endpoint invalid_arg error.
+func NewGetGuildMasterAddressInvalidArg(body *GetGuildMasterAddressInvalidArgResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
 	}
 
 	return v
@@ -849,16 +1794,41 @@ func NewGetGuildDefaultMemberResultOK(body *GetGuildDefaultMemberResponseBody) *
 	return v
 }
 
+// NewGetGuildDefaultMemberInvalidArg builds a GuildsService service
+// GetGuildDefaultMember endpoint invalid_arg error.
+func NewGetGuildDefaultMemberInvalidArg(body *GetGuildDefaultMemberInvalidArgResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
+	}
+
+	return v
+}
+
 // NewGetGuildDefaultMemberNotFound builds a GuildsService service
 // GetGuildDefaultMember endpoint not_found error.
-func NewGetGuildDefaultMemberNotFound(body *GetGuildDefaultMemberNotFoundResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+func NewGetGuildDefaultMemberNotFound(body *GetGuildDefaultMemberNotFoundResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
 	}
 
 	return v
@@ -866,14 +1836,19 @@ func NewGetGuildDefaultMemberNotFound(body *GetGuildDefaultMemberNotFoundRespons
 
 // NewGetGuildDefaultMemberInternal builds a GuildsService service
 // GetGuildDefaultMember endpoint internal error.
-func NewGetGuildDefaultMemberInternal(body *GetGuildDefaultMemberInternalResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+func NewGetGuildDefaultMemberInternal(body *GetGuildDefaultMemberInternalResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
 	}
 
 	return v
@@ -883,8 +1858,9 @@ func NewGetGuildDefaultMemberInternal(body *GetGuildDefaultMemberInternalRespons
 // result from a HTTP "OK" response.
 func NewEnterGuildResultOK(body *EnterGuildResponseBody) *guildsservice.EnterGuildResult {
 	v := &guildsservice.EnterGuildResult{
-		JoinStatus: body.JoinStatus,
-		Message:    body.Message,
+		JoinStatus:       body.JoinStatus,
+		Message:          body.Message,
+		WaitlistPosition: body.WaitlistPosition,
 	}
 
 	return v
@@ -892,14 +1868,19 @@ func NewEnterGuildResultOK(body *EnterGuildResponseBody) *guildsservice.EnterGui
 
 // NewEnterGuildNotFound builds a GuildsService service EnterGuild endpoint
 // not_found error.
-func NewEnterGuildNotFound(body *EnterGuildNotFoundResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+func NewEnterGuildNotFound(body *EnterGuildNotFoundResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
 	}
 
 	return v
@@ -907,14 +1888,113 @@ func NewEnterGuildNotFound(body *EnterGuildNotFoundResponseBody) *goa.ServiceErr
 
 // NewEnterGuildInternal builds a GuildsService service EnterGuild endpoint
 // internal error.
-func NewEnterGuildInternal(body *EnterGuildInternalResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+func NewEnterGuildInternal(body *EnterGuildInternalResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewEnterGuildInvalidArg builds a GuildsService service EnterGuild endpoint
+// invalid_arg error.
+func NewEnterGuildInvalidArg(body *EnterGuildInvalidArgResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewGetGuildWaitlistResultOK builds a "GuildsService" service
+// "GetGuildWaitlist" endpoint result from a HTTP "OK" response.
+func NewGetGuildWaitlistResultOK(body *GetGuildWaitlistResponseBody) *guildsservice.GetGuildWaitlistResult {
+	v := &guildsservice.GetGuildWaitlistResult{}
+	if body.Waitlist != nil {
+		v.Waitlist = make([]*guildsservice.WaitlistEntry, len(body.Waitlist))
+		for i, val := range body.Waitlist {
+			v.Waitlist[i] = unmarshalWaitlistEntryResponseBodyToGuildsserviceWaitlistEntry(val)
+		}
+	}
+
+	return v
+}
+
+// NewGetGuildWaitlistInvalidArg builds a GuildsService service
+// GetGuildWaitlist endpoint invalid_arg error.
+func NewGetGuildWaitlistInvalidArg(body *GetGuildWaitlistInvalidArgResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewGetGuildWaitlistNotFound builds a GuildsService service GetGuildWaitlist
+// endpoint not_found error.
+func NewGetGuildWaitlistNotFound(body *GetGuildWaitlistNotFoundResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewGetGuildWaitlistInternal builds a GuildsService service GetGuildWaitlist
+// endpoint internal error.
+func NewGetGuildWaitlistInternal(body *GetGuildWaitlistInternalResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
 	}
 
 	return v
@@ -932,14 +2012,19 @@ func NewLeaveGuildResultOK(body *LeaveGuildResponseBody) *guildsservice.LeaveGui
 
 // NewLeaveGuildNotFound builds a GuildsService service LeaveGuild endpoint
 // not_found error.
-func NewLeaveGuildNotFound(body *LeaveGuildNotFoundResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+func NewLeaveGuildNotFound(body *LeaveGuildNotFoundResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
 	}
 
 	return v
@@ -947,14 +2032,110 @@ func NewLeaveGuildNotFound(body *LeaveGuildNotFoundResponseBody) *goa.ServiceErr
 
 // NewLeaveGuildInternal builds a GuildsService service LeaveGuild endpoint
 // internal error.
-func NewLeaveGuildInternal(body *LeaveGuildInternalResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+func NewLeaveGuildInternal(body *LeaveGuildInternalResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewLeaveGuildInvalidArg builds a GuildsService service LeaveGuild endpoint
+// invalid_arg error.
+func NewLeaveGuildInvalidArg(body *LeaveGuildInvalidArgResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewTransferMemberResultOK builds a "GuildsService" service "TransferMember"
+// endpoint result from a HTTP "OK" response.
+func NewTransferMemberResultOK(body *TransferMemberResponseBody) *guildsservice.TransferMemberResult {
+	v := &guildsservice.TransferMemberResult{
+		TransferStatus: body.TransferStatus,
+		FromGuildID:    body.FromGuildID,
+	}
+
+	return v
+}
+
+// NewTransferMemberInvalidArg builds a GuildsService service TransferMember
+// endpoint invalid_arg error.
+func NewTransferMemberInvalidArg(body *TransferMemberInvalidArgResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewTransferMemberNotFound builds a GuildsService service TransferMember
+// endpoint not_found error.
+func NewTransferMemberNotFound(body *TransferMemberNotFoundResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewTransferMemberInternal builds a GuildsService service TransferMember
+// endpoint internal error.
+func NewTransferMemberInternal(body *TransferMemberInternalResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
 	}
 
 	return v
@@ -976,14 +2157,19 @@ func NewGetGuildMarketsResultOK(body *GetGuildMarketsResponseBody) *guildsservic
 
 // NewGetGuildMarketsNotFound builds a GuildsService service GetGuildMarkets
 // endpoint not_found error.
-func NewGetGuildMarketsNotFound(body *GetGuildMarketsNotFoundResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+func NewGetGuildMarketsNotFound(body *GetGuildMarketsNotFoundResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
 	}
 
 	return v
@@ -991,14 +2177,39 @@ func NewGetGuildMarketsNotFound(body *GetGuildMarketsNotFoundResponseBody) *goa.
 
 // NewGetGuildMarketsInternal builds a GuildsService service GetGuildMarkets
 // endpoint internal error.
-func NewGetGuildMarketsInternal(body *GetGuildMarketsInternalResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+func NewGetGuildMarketsInternal(body *GetGuildMarketsInternalResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewGetGuildMarketsInvalidArg builds a GuildsService service GetGuildMarkets
+// endpoint invalid_arg error.
+func NewGetGuildMarketsInvalidArg(body *GetGuildMarketsInvalidArgResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
 	}
 
 	return v
@@ -1014,20 +2225,31 @@ func NewGetGuildPortfoliosResultOK(body *GetGuildPortfoliosResponseBody) *guilds
 			v.Portfolios[i] = unmarshalSingleGuildPortfolioResponseBodyToGuildsserviceSingleGuildPortfolio(val)
 		}
 	}
+	if body.NetFlows != nil {
+		v.NetFlows = make([]*guildsservice.NetFlow, len(body.NetFlows))
+		for i, val := range body.NetFlows {
+			v.NetFlows[i] = unmarshalNetFlowResponseBodyToGuildsserviceNetFlow(val)
+		}
+	}
 
 	return v
 }
 
 // NewGetGuildPortfoliosNotFound builds a GuildsService service
 // GetGuildPortfolios endpoint not_found error.
-func NewGetGuildPortfoliosNotFound(body *GetGuildPortfoliosNotFoundResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+func NewGetGuildPortfoliosNotFound(body *GetGuildPortfoliosNotFoundResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
 	}
 
 	return v
@@ -1035,14 +2257,39 @@ func NewGetGuildPortfoliosNotFound(body *GetGuildPortfoliosNotFoundResponseBody)
 
 // NewGetGuildPortfoliosInternal builds a GuildsService service
 // GetGuildPortfolios endpoint internal error.
-func NewGetGuildPortfoliosInternal(body *GetGuildPortfoliosInternalResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+func NewGetGuildPortfoliosInternal(body *GetGuildPortfoliosInternalResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewGetGuildPortfoliosInvalidArg builds a GuildsService service
+// GetGuildPortfolios endpoint invalid_arg error.
+func NewGetGuildPortfoliosInvalidArg(body *GetGuildPortfoliosInvalidArgResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
 	}
 
 	return v
@@ -1061,14 +2308,19 @@ func NewGetAccountInfoResultOK(body *GetAccountInfoResponseBody) *guildsservice.
 
 // NewGetAccountInfoNotFound builds a GuildsService service GetAccountInfo
 // endpoint not_found error.
-func NewGetAccountInfoNotFound(body *GetAccountInfoNotFoundResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+func NewGetAccountInfoNotFound(body *GetAccountInfoNotFoundResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
 	}
 
 	return v
@@ -1076,14 +2328,39 @@ func NewGetAccountInfoNotFound(body *GetAccountInfoNotFoundResponseBody) *goa.Se
 
 // NewGetAccountInfoInternal builds a GuildsService service GetAccountInfo
 // endpoint internal error.
-func NewGetAccountInfoInternal(body *GetAccountInfoInternalResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+func NewGetAccountInfoInternal(body *GetAccountInfoInternalResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewGetAccountInfoInvalidArg builds a GuildsService service GetAccountInfo
+// endpoint invalid_arg error.
+func NewGetAccountInfoInvalidArg(body *GetAccountInfoInvalidArgResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
 	}
 
 	return v
@@ -1102,14 +2379,19 @@ func NewGetAccountPortfolioResultOK(body *GetAccountPortfolioResponseBody) *guil
 
 // NewGetAccountPortfolioNotFound builds a GuildsService service
 // GetAccountPortfolio endpoint not_found error.
-func NewGetAccountPortfolioNotFound(body *GetAccountPortfolioNotFoundResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+func NewGetAccountPortfolioNotFound(body *GetAccountPortfolioNotFoundResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
 	}
 
 	return v
@@ -1117,14 +2399,39 @@ func NewGetAccountPortfolioNotFound(body *GetAccountPortfolioNotFoundResponseBod
 
 // NewGetAccountPortfolioInternal builds a GuildsService service
 // GetAccountPortfolio endpoint internal error.
-func NewGetAccountPortfolioInternal(body *GetAccountPortfolioInternalResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+func NewGetAccountPortfolioInternal(body *GetAccountPortfolioInternalResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewGetAccountPortfolioInvalidArg builds a GuildsService service
+// GetAccountPortfolio endpoint invalid_arg error.
+func NewGetAccountPortfolioInvalidArg(body *GetAccountPortfolioInvalidArgResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
 	}
 
 	return v
@@ -1140,20 +2447,51 @@ func NewGetAccountPortfoliosResultOK(body *GetAccountPortfoliosResponseBody) *gu
 			v.Portfolios[i] = unmarshalSingleAccountPortfolioResponseBodyToGuildsserviceSingleAccountPortfolio(val)
 		}
 	}
+	if body.NetFlows != nil {
+		v.NetFlows = make([]*guildsservice.NetFlow, len(body.NetFlows))
+		for i, val := range body.NetFlows {
+			v.NetFlows[i] = unmarshalNetFlowResponseBodyToGuildsserviceNetFlow(val)
+		}
+	}
+
+	return v
+}
+
+// NewGetAccountPortfoliosInvalidArg builds a GuildsService service
+// GetAccountPortfolios endpoint invalid_arg error.
+func NewGetAccountPortfoliosInvalidArg(body *GetAccountPortfoliosInvalidArgResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
+	}
 
 	return v
 }
 
 // NewGetAccountPortfoliosNotFound builds a GuildsService service
 // GetAccountPortfolios endpoint not_found error.
-func NewGetAccountPortfoliosNotFound(body *GetAccountPortfoliosNotFoundResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+func NewGetAccountPortfoliosNotFound(body *GetAccountPortfoliosNotFoundResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
 	}
 
 	return v
@@ -1161,14 +2499,93 @@ func NewGetAccountPortfoliosNotFound(body *GetAccountPortfoliosNotFoundResponseB
 
 // NewGetAccountPortfoliosInternal builds a GuildsService service
 // GetAccountPortfolios endpoint internal error.
-func NewGetAccountPortfoliosInternal(body *GetAccountPortfoliosInternalResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+func NewGetAccountPortfoliosInternal(body *GetAccountPortfoliosInternalResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewGetAccountMembershipsResultOK builds a "GuildsService" service
+// "GetAccountMemberships" endpoint result from a HTTP "OK" response.
+func NewGetAccountMembershipsResultOK(body *GetAccountMembershipsResponseBody) *guildsservice.GetAccountMembershipsResult {
+	v := &guildsservice.GetAccountMembershipsResult{}
+	if body.Memberships != nil {
+		v.Memberships = make([]*guildsservice.Membership, len(body.Memberships))
+		for i, val := range body.Memberships {
+			v.Memberships[i] = unmarshalMembershipResponseBodyToGuildsserviceMembership(val)
+		}
+	}
+
+	return v
+}
+
+// NewGetAccountMembershipsInvalidArg builds a GuildsService service
+// GetAccountMemberships endpoint invalid_arg error.
+func NewGetAccountMembershipsInvalidArg(body *GetAccountMembershipsInvalidArgResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewGetAccountMembershipsInternal builds a GuildsService service
+// GetAccountMemberships endpoint internal error.
+func NewGetAccountMembershipsInternal(body *GetAccountMembershipsInternalResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewGetAccountMembershipsNotFound builds a GuildsService service
+// GetAccountMemberships endpoint not_found error.
+func NewGetAccountMembershipsNotFound(body *GetAccountMembershipsNotFoundResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
 	}
 
 	return v
@@ -1190,14 +2607,19 @@ func NewGetAccountMonthlyPortfoliosResultOK(body *GetAccountMonthlyPortfoliosRes
 
 // NewGetAccountMonthlyPortfoliosNotFound builds a GuildsService service
 // GetAccountMonthlyPortfolios endpoint not_found error.
-func NewGetAccountMonthlyPortfoliosNotFound(body *GetAccountMonthlyPortfoliosNotFoundResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+func NewGetAccountMonthlyPortfoliosNotFound(body *GetAccountMonthlyPortfoliosNotFoundResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
 	}
 
 	return v
@@ -1205,761 +2627,2380 @@ func NewGetAccountMonthlyPortfoliosNotFound(body *GetAccountMonthlyPortfoliosNot
 
 // NewGetAccountMonthlyPortfoliosInternal builds a GuildsService service
 // GetAccountMonthlyPortfolios endpoint internal error.
-func NewGetAccountMonthlyPortfoliosInternal(body *GetAccountMonthlyPortfoliosInternalResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+func NewGetAccountMonthlyPortfoliosInternal(body *GetAccountMonthlyPortfoliosInternalResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
 	}
 
 	return v
 }
 
-// ValidateGetAllGuildsResponseBody runs the validations defined on
-// GetAllGuildsResponseBody
-func ValidateGetAllGuildsResponseBody(body *GetAllGuildsResponseBody) (err error) {
-	for _, e := range body.Guilds {
-		if e != nil {
-			if err2 := ValidateGuildResponseBody(e); err2 != nil {
-				err = goa.MergeErrors(err, err2)
-			}
+// NewGetAccountMonthlyPortfoliosInvalidArg builds a GuildsService service
+// GetAccountMonthlyPortfolios endpoint invalid_arg error.
+func NewGetAccountMonthlyPortfoliosInvalidArg(body *GetAccountMonthlyPortfoliosInvalidArgResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
 		}
 	}
-	return
+
+	return v
 }
 
-// ValidateGetSingleGuildResponseBody runs the validations defined on
-// GetSingleGuildResponseBody
-func ValidateGetSingleGuildResponseBody(body *GetSingleGuildResponseBody) (err error) {
-	if body.Guild != nil {
-		if err2 := ValidateGuildResponseBody(body.Guild); err2 != nil {
-			err = goa.MergeErrors(err, err2)
+// NewGetAccountGrantsResultOK builds a "GuildsService" service
+// "GetAccountGrants" endpoint result from a HTTP "OK" response.
+func NewGetAccountGrantsResultOK(body *GetAccountGrantsResponseBody) *guildsservice.GetAccountGrantsResult {
+	v := &guildsservice.GetAccountGrantsResult{}
+	if body.Grants != nil {
+		v.Grants = make([]*guildsservice.Grant, len(body.Grants))
+		for i, val := range body.Grants {
+			v.Grants[i] = unmarshalGrantResponseBodyToGuildsserviceGrant(val)
 		}
 	}
-	return
+
+	return v
 }
 
-// ValidateGetGuildMembersResponseBody runs the validations defined on
-// GetGuildMembersResponseBody
-func ValidateGetGuildMembersResponseBody(body *GetGuildMembersResponseBody) (err error) {
-	for _, e := range body.Members {
-		if e != nil {
-			if err2 := ValidateGuildMemberResponseBody(e); err2 != nil {
-				err = goa.MergeErrors(err, err2)
-			}
+// NewGetAccountGrantsNotFound builds a GuildsService service GetAccountGrants
+// endpoint not_found error.
+func NewGetAccountGrantsNotFound(body *GetAccountGrantsNotFoundResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
 		}
 	}
-	return
+
+	return v
 }
 
-// ValidateGetGuildDefaultMemberResponseBody runs the validations defined on
-// GetGuildDefaultMemberResponseBody
-func ValidateGetGuildDefaultMemberResponseBody(body *GetGuildDefaultMemberResponseBody) (err error) {
-	if body.DefaultMember != nil {
-		if err2 := ValidateGuildMemberResponseBody(body.DefaultMember); err2 != nil {
-			err = goa.MergeErrors(err, err2)
+// NewGetAccountGrantsInvalidArg builds a GuildsService service
+// GetAccountGrants endpoint invalid_arg error.
+func NewGetAccountGrantsInvalidArg(body *GetAccountGrantsInvalidArgResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
 		}
 	}
-	return
+
+	return v
 }
 
-// ValidateGetGuildMarketsResponseBody runs the validations defined on
-// GetGuildMarketsResponseBody
-func ValidateGetGuildMarketsResponseBody(body *GetGuildMarketsResponseBody) (err error) {
-	for _, e := range body.Markets {
-		if e != nil {
-			if err2 := ValidateMarketResponseBody(e); err2 != nil {
-				err = goa.MergeErrors(err, err2)
-			}
+// NewGetAccountGrantsInternal builds a GuildsService service GetAccountGrants
+// endpoint internal error.
+func NewGetAccountGrantsInternal(body *GetAccountGrantsInternalResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
 		}
 	}
-	return
+
+	return v
 }
 
-// ValidateGetGuildPortfoliosResponseBody runs the validations defined on
-// GetGuildPortfoliosResponseBody
-func ValidateGetGuildPortfoliosResponseBody(body *GetGuildPortfoliosResponseBody) (err error) {
-	for _, e := range body.Portfolios {
-		if e != nil {
-			if err2 := ValidateSingleGuildPortfolioResponseBody(e); err2 != nil {
-				err = goa.MergeErrors(err, err2)
-			}
+// NewGetAccountTradesResultOK builds a "GuildsService" service
+// "GetAccountTrades" endpoint result from a HTTP "OK" response.
+func NewGetAccountTradesResultOK(body *GetAccountTradesResponseBody) *guildsservice.GetAccountTradesResult {
+	v := &guildsservice.GetAccountTradesResult{}
+	if body.Trades != nil {
+		v.Trades = make([]*guildsservice.Trade, len(body.Trades))
+		for i, val := range body.Trades {
+			v.Trades[i] = unmarshalTradeResponseBodyToGuildsserviceTrade(val)
 		}
 	}
-	return
+
+	return v
 }
 
-// ValidateGetAccountInfoResponseBody runs the validations defined on
-// GetAccountInfoResponseBody
-func ValidateGetAccountInfoResponseBody(body *GetAccountInfoResponseBody) (err error) {
-	if body.Data != nil {
-		if err2 := ValidateGuildMemberResponseBody(body.Data); err2 != nil {
+// NewGetAccountTradesInvalidArg builds a GuildsService service
+// GetAccountTrades endpoint invalid_arg error.
+func NewGetAccountTradesInvalidArg(body *GetAccountTradesInvalidArgResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewGetAccountTradesInternal builds a GuildsService service GetAccountTrades
+// endpoint internal error.
+func NewGetAccountTradesInternal(body *GetAccountTradesInternalResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewGetAccountTradesNotFound builds a GuildsService service GetAccountTrades
+// endpoint not_found error.
+func NewGetAccountTradesNotFound(body *GetAccountTradesNotFoundResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewGetAccountTradeStatsResultOK builds a "GuildsService" service
+// "GetAccountTradeStats" endpoint result from a HTTP "OK" response.
+func NewGetAccountTradeStatsResultOK(body *GetAccountTradeStatsResponseBody) *guildsservice.GetAccountTradeStatsResult {
+	v := &guildsservice.GetAccountTradeStatsResult{}
+	if body.Stats != nil {
+		v.Stats = make([]*guildsservice.TradeStats, len(body.Stats))
+		for i, val := range body.Stats {
+			v.Stats[i] = unmarshalTradeStatsResponseBodyToGuildsserviceTradeStats(val)
+		}
+	}
+
+	return v
+}
+
+// NewGetAccountTradeStatsInvalidArg builds a GuildsService service
+// GetAccountTradeStats endpoint invalid_arg error.
+func NewGetAccountTradeStatsInvalidArg(body *GetAccountTradeStatsInvalidArgResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewGetAccountTradeStatsInternal builds a GuildsService service
+// GetAccountTradeStats endpoint internal error.
+func NewGetAccountTradeStatsInternal(body *GetAccountTradeStatsInternalResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewGetAccountTradeStatsNotFound builds a GuildsService service
+// GetAccountTradeStats endpoint not_found error.
+func NewGetAccountTradeStatsNotFound(body *GetAccountTradeStatsNotFoundResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewGetGuildTradeStatsResultOK builds a "GuildsService" service
+// "GetGuildTradeStats" endpoint result from a HTTP "OK" response.
+func NewGetGuildTradeStatsResultOK(body *GetGuildTradeStatsResponseBody) *guildsservice.GetGuildTradeStatsResult {
+	v := &guildsservice.GetGuildTradeStatsResult{}
+	if body.Stats != nil {
+		v.Stats = make([]*guildsservice.TradeStats, len(body.Stats))
+		for i, val := range body.Stats {
+			v.Stats[i] = unmarshalTradeStatsResponseBodyToGuildsserviceTradeStats(val)
+		}
+	}
+
+	return v
+}
+
+// NewGetGuildTradeStatsInvalidArg builds a GuildsService service
+// GetGuildTradeStats endpoint invalid_arg error.
+func NewGetGuildTradeStatsInvalidArg(body *GetGuildTradeStatsInvalidArgResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewGetGuildTradeStatsNotFound builds a GuildsService service
+// GetGuildTradeStats endpoint not_found error.
+func NewGetGuildTradeStatsNotFound(body *GetGuildTradeStatsNotFoundResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewGetGuildTradeStatsInternal builds a GuildsService service
+// GetGuildTradeStats endpoint internal error.
+func NewGetGuildTradeStatsInternal(body *GetGuildTradeStatsInternalResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewGetGuildFeesResultOK builds a "GuildsService" service "GetGuildFees"
+// endpoint result from a HTTP "OK" response.
+func NewGetGuildFeesResultOK(body *GetGuildFeesResponseBody) *guildsservice.GetGuildFeesResult {
+	v := &guildsservice.GetGuildFeesResult{
+		MasterAddress: body.MasterAddress,
+	}
+	if body.Totals != nil {
+		v.Totals = make([]*guildsservice.GuildFeeTotal, len(body.Totals))
+		for i, val := range body.Totals {
+			v.Totals[i] = unmarshalGuildFeeTotalResponseBodyToGuildsserviceGuildFeeTotal(val)
+		}
+	}
+	if body.Members != nil {
+		v.Members = make([]*guildsservice.MemberFeeContribution, len(body.Members))
+		for i, val := range body.Members {
+			v.Members[i] = unmarshalMemberFeeContributionResponseBodyToGuildsserviceMemberFeeContribution(val)
+		}
+	}
+	if body.Daily != nil {
+		v.Daily = make([]*guildsservice.DailyGuildFee, len(body.Daily))
+		for i, val := range body.Daily {
+			v.Daily[i] = unmarshalDailyGuildFeeResponseBodyToGuildsserviceDailyGuildFee(val)
+		}
+	}
+
+	return v
+}
+
+// NewGetGuildFeesInvalidArg builds a GuildsService service GetGuildFees
+// endpoint invalid_arg error.
+func NewGetGuildFeesInvalidArg(body *GetGuildFeesInvalidArgResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewGetGuildFeesNotFound builds a GuildsService service GetGuildFees endpoint
+// not_found error.
+func NewGetGuildFeesNotFound(body *GetGuildFeesNotFoundResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewGetGuildFeesInternal builds a GuildsService service GetGuildFees endpoint
+// internal error.
+func NewGetGuildFeesInternal(body *GetGuildFeesInternalResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewGetAccountTransfersResultOK builds a "GuildsService" service
+// "GetAccountTransfers" endpoint result from a HTTP "OK" response.
+func NewGetAccountTransfersResultOK(body *GetAccountTransfersResponseBody) *guildsservice.GetAccountTransfersResult {
+	v := &guildsservice.GetAccountTransfersResult{}
+	if body.Transfers != nil {
+		v.Transfers = make([]*guildsservice.Transfer, len(body.Transfers))
+		for i, val := range body.Transfers {
+			v.Transfers[i] = unmarshalTransferResponseBodyToGuildsserviceTransfer(val)
+		}
+	}
+	if body.NetFlows != nil {
+		v.NetFlows = make([]*guildsservice.NetFlow, len(body.NetFlows))
+		for i, val := range body.NetFlows {
+			v.NetFlows[i] = unmarshalNetFlowResponseBodyToGuildsserviceNetFlow(val)
+		}
+	}
+
+	return v
+}
+
+// NewGetAccountTransfersInvalidArg builds a GuildsService service
+// GetAccountTransfers endpoint invalid_arg error.
+func NewGetAccountTransfersInvalidArg(body *GetAccountTransfersInvalidArgResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewGetAccountTransfersInternal builds a GuildsService service
+// GetAccountTransfers endpoint internal error.
+func NewGetAccountTransfersInternal(body *GetAccountTransfersInternalResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewGetAccountTransfersNotFound builds a GuildsService service
+// GetAccountTransfers endpoint not_found error.
+func NewGetAccountTransfersNotFound(body *GetAccountTransfersNotFoundResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewGetAccountPositionsResultOK builds a "GuildsService" service
+// "GetAccountPositions" endpoint result from a HTTP "OK" response.
+func NewGetAccountPositionsResultOK(body *GetAccountPositionsResponseBody) *guildsservice.GetAccountPositionsResult {
+	v := &guildsservice.GetAccountPositionsResult{
+		UpdatedAt: *body.UpdatedAt,
+	}
+	v.Positions = make([]*guildsservice.Position, len(body.Positions))
+	for i, val := range body.Positions {
+		v.Positions[i] = unmarshalPositionResponseBodyToGuildsservicePosition(val)
+	}
+
+	return v
+}
+
+// NewGetAccountPositionsInvalidArg builds a GuildsService service
+// GetAccountPositions endpoint invalid_arg error.
+func NewGetAccountPositionsInvalidArg(body *GetAccountPositionsInvalidArgResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewGetAccountPositionsNotFound builds a GuildsService service
+// GetAccountPositions endpoint not_found error.
+func NewGetAccountPositionsNotFound(body *GetAccountPositionsNotFoundResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewGetAccountPositionsInternal builds a GuildsService service
+// GetAccountPositions endpoint internal error.
+func NewGetAccountPositionsInternal(body *GetAccountPositionsInternalResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewGetGuildRiskResultOK builds a "GuildsService" service "GetGuildRisk"
+// endpoint result from a HTTP "OK" response.
+func NewGetGuildRiskResultOK(body *GetGuildRiskResponseBody) *guildsservice.GetGuildRiskResult {
+	v := &guildsservice.GetGuildRiskResult{}
+	if body.Data != nil {
+		v.Data = unmarshalGuildRiskResponseBodyToGuildsserviceGuildRisk(body.Data)
+	}
+
+	return v
+}
+
+// NewGetGuildRiskInvalidArg builds a GuildsService service GetGuildRisk
+// endpoint invalid_arg error.
+func NewGetGuildRiskInvalidArg(body *GetGuildRiskInvalidArgResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewGetGuildRiskNotFound builds a GuildsService service GetGuildRisk endpoint
+// not_found error.
+func NewGetGuildRiskNotFound(body *GetGuildRiskNotFoundResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewGetGuildRiskInternal builds a GuildsService service GetGuildRisk endpoint
+// internal error.
+func NewGetGuildRiskInternal(body *GetGuildRiskInternalResponseBody) *guildsservice.APIError {
+	v := &guildsservice.APIError{
+		Name:    *body.Name,
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.Details != nil {
+		v.Details = make(map[string]string, len(body.Details))
+		for key, val := range body.Details {
+			tk := key
+			tv := val
+			v.Details[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// ValidateGetAllGuildsResponseBody runs the validations defined on
+// GetAllGuildsResponseBody
+func ValidateGetAllGuildsResponseBody(body *GetAllGuildsResponseBody) (err error) {
+	for _, e := range body.Guilds {
+		if e != nil {
+			if err2 := ValidateGuildResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateGetSingleGuildResponseBody runs the validations defined on
+// GetSingleGuildResponseBody
+func ValidateGetSingleGuildResponseBody(body *GetSingleGuildResponseBody) (err error) {
+	if body.Guild != nil {
+		if err2 := ValidateGuildResponseBody(body.Guild); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateGetGuildMembersResponseBody runs the validations defined on
+// GetGuildMembersResponseBody
+func ValidateGetGuildMembersResponseBody(body *GetGuildMembersResponseBody) (err error) {
+	for _, e := range body.Members {
+		if e != nil {
+			if err2 := ValidateGuildMemberResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateGetGuildDefaultMemberResponseBody runs the validations defined on
+// GetGuildDefaultMemberResponseBody
+func ValidateGetGuildDefaultMemberResponseBody(body *GetGuildDefaultMemberResponseBody) (err error) {
+	if body.DefaultMember != nil {
+		if err2 := ValidateGuildMemberResponseBody(body.DefaultMember); err2 != nil {
 			err = goa.MergeErrors(err, err2)
 		}
 	}
 	return
 }
 
-// ValidateGetAccountPortfolioResponseBody runs the validations defined on
-// GetAccountPortfolioResponseBody
-func ValidateGetAccountPortfolioResponseBody(body *GetAccountPortfolioResponseBody) (err error) {
-	if body.Data != nil {
-		if err2 := ValidateSingleAccountPortfolioResponseBody(body.Data); err2 != nil {
-			err = goa.MergeErrors(err, err2)
+// ValidateGetGuildWaitlistResponseBody runs the validations defined on
+// GetGuildWaitlistResponseBody
+func ValidateGetGuildWaitlistResponseBody(body *GetGuildWaitlistResponseBody) (err error) {
+	for _, e := range body.Waitlist {
+		if e != nil {
+			if err2 := ValidateWaitlistEntryResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateGetGuildMarketsResponseBody runs the validations defined on
+// GetGuildMarketsResponseBody
+func ValidateGetGuildMarketsResponseBody(body *GetGuildMarketsResponseBody) (err error) {
+	for _, e := range body.Markets {
+		if e != nil {
+			if err2 := ValidateMarketResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateGetGuildPortfoliosResponseBody runs the validations defined on
+// GetGuildPortfoliosResponseBody
+func ValidateGetGuildPortfoliosResponseBody(body *GetGuildPortfoliosResponseBody) (err error) {
+	for _, e := range body.Portfolios {
+		if e != nil {
+			if err2 := ValidateSingleGuildPortfolioResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.NetFlows {
+		if e != nil {
+			if err2 := ValidateNetFlowResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateGetAccountInfoResponseBody runs the validations defined on
+// GetAccountInfoResponseBody
+func ValidateGetAccountInfoResponseBody(body *GetAccountInfoResponseBody) (err error) {
+	if body.Data != nil {
+		if err2 := ValidateGuildMemberResponseBody(body.Data); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateGetAccountPortfolioResponseBody runs the validations defined on
+// GetAccountPortfolioResponseBody
+func ValidateGetAccountPortfolioResponseBody(body *GetAccountPortfolioResponseBody) (err error) {
+	if body.Data != nil {
+		if err2 := ValidateSingleAccountPortfolioResponseBody(body.Data); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateGetAccountPortfoliosResponseBody runs the validations defined on
+// GetAccountPortfoliosResponseBody
+func ValidateGetAccountPortfoliosResponseBody(body *GetAccountPortfoliosResponseBody) (err error) {
+	for _, e := range body.Portfolios {
+		if e != nil {
+			if err2 := ValidateSingleAccountPortfolioResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.NetFlows {
+		if e != nil {
+			if err2 := ValidateNetFlowResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateGetAccountMembershipsResponseBody runs the validations defined on
+// GetAccountMembershipsResponseBody
+func ValidateGetAccountMembershipsResponseBody(body *GetAccountMembershipsResponseBody) (err error) {
+	for _, e := range body.Memberships {
+		if e != nil {
+			if err2 := ValidateMembershipResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateGetAccountMonthlyPortfoliosResponseBody runs the validations defined
+// on GetAccountMonthlyPortfoliosResponseBody
+func ValidateGetAccountMonthlyPortfoliosResponseBody(body *GetAccountMonthlyPortfoliosResponseBody) (err error) {
+	for _, e := range body.Portfolios {
+		if e != nil {
+			if err2 := ValidateMonthlyAccountPortfolioResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateGetAccountGrantsResponseBody runs the validations defined on
+// GetAccountGrantsResponseBody
+func ValidateGetAccountGrantsResponseBody(body *GetAccountGrantsResponseBody) (err error) {
+	for _, e := range body.Grants {
+		if e != nil {
+			if err2 := ValidateGrantResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateGetAccountTradesResponseBody runs the validations defined on
+// GetAccountTradesResponseBody
+func ValidateGetAccountTradesResponseBody(body *GetAccountTradesResponseBody) (err error) {
+	for _, e := range body.Trades {
+		if e != nil {
+			if err2 := ValidateTradeResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateGetAccountTradeStatsResponseBody runs the validations defined on
+// GetAccountTradeStatsResponseBody
+func ValidateGetAccountTradeStatsResponseBody(body *GetAccountTradeStatsResponseBody) (err error) {
+	for _, e := range body.Stats {
+		if e != nil {
+			if err2 := ValidateTradeStatsResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateGetGuildTradeStatsResponseBody runs the validations defined on
+// GetGuildTradeStatsResponseBody
+func ValidateGetGuildTradeStatsResponseBody(body *GetGuildTradeStatsResponseBody) (err error) {
+	for _, e := range body.Stats {
+		if e != nil {
+			if err2 := ValidateTradeStatsResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateGetGuildFeesResponseBody runs the validations defined on
+// GetGuildFeesResponseBody
+func ValidateGetGuildFeesResponseBody(body *GetGuildFeesResponseBody) (err error) {
+	for _, e := range body.Totals {
+		if e != nil {
+			if err2 := ValidateGuildFeeTotalResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.Members {
+		if e != nil {
+			if err2 := ValidateMemberFeeContributionResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.Daily {
+		if e != nil {
+			if err2 := ValidateDailyGuildFeeResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateGetAccountTransfersResponseBody runs the validations defined on
+// GetAccountTransfersResponseBody
+func ValidateGetAccountTransfersResponseBody(body *GetAccountTransfersResponseBody) (err error) {
+	for _, e := range body.Transfers {
+		if e != nil {
+			if err2 := ValidateTransferResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.NetFlows {
+		if e != nil {
+			if err2 := ValidateNetFlowResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateGetAccountPositionsResponseBody runs the validations defined on
+// GetAccountPositionsResponseBody
+func ValidateGetAccountPositionsResponseBody(body *GetAccountPositionsResponseBody) (err error) {
+	if body.Positions == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("positions", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
+	}
+	for _, e := range body.Positions {
+		if e != nil {
+			if err2 := ValidatePositionResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateGetGuildRiskResponseBody runs the validations defined on
+// GetGuildRiskResponseBody
+func ValidateGetGuildRiskResponseBody(body *GetGuildRiskResponseBody) (err error) {
+	if body.Data != nil {
+		if err2 := ValidateGuildRiskResponseBody(body.Data); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateGetAllGuildsNotFoundResponseBody runs the validations defined on
+// GetAllGuilds_not_found_Response_Body
+func ValidateGetAllGuildsNotFoundResponseBody(body *GetAllGuildsNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
+	}
+	return
+}
+
+// ValidateGetAllGuildsInternalResponseBody runs the validations defined on
+// GetAllGuilds_internal_Response_Body
+func ValidateGetAllGuildsInternalResponseBody(body *GetAllGuildsInternalResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
+	}
+	return
+}
+
+// ValidateGetAllGuildsInvalidArgResponseBody runs the validations defined on
+// GetAllGuilds_invalid_arg_Response_Body
+func ValidateGetAllGuildsInvalidArgResponseBody(body *GetAllGuildsInvalidArgResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
+	}
+	return
+}
+
+// ValidateGetSingleGuildNotFoundResponseBody runs the validations defined on
+// GetSingleGuild_not_found_Response_Body
+func ValidateGetSingleGuildNotFoundResponseBody(body *GetSingleGuildNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
+	}
+	return
+}
+
+// ValidateGetSingleGuildInternalResponseBody runs the validations defined on
+// GetSingleGuild_internal_Response_Body
+func ValidateGetSingleGuildInternalResponseBody(body *GetSingleGuildInternalResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
+	}
+	return
+}
+
+// ValidateGetSingleGuildInvalidArgResponseBody runs the validations defined on
+// GetSingleGuild_invalid_arg_Response_Body
+func ValidateGetSingleGuildInvalidArgResponseBody(body *GetSingleGuildInvalidArgResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
+	}
+	return
+}
+
+// ValidateGetGuildMembersInvalidArgResponseBody runs the validations defined
+// on GetGuildMembers_invalid_arg_Response_Body
+func ValidateGetGuildMembersInvalidArgResponseBody(body *GetGuildMembersInvalidArgResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
+	}
+	return
+}
+
+// ValidateGetGuildMembersNotFoundResponseBody runs the validations defined on
+// GetGuildMembers_not_found_Response_Body
+func ValidateGetGuildMembersNotFoundResponseBody(body *GetGuildMembersNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
+	}
+	return
+}
+
+// ValidateGetGuildMembersInternalResponseBody runs the validations defined on
+// GetGuildMembers_internal_Response_Body
+func ValidateGetGuildMembersInternalResponseBody(body *GetGuildMembersInternalResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
+	}
+	return
+}
+
+// ValidateGetGuildMasterAddressNotFoundResponseBody runs the validations
+// defined on GetGuildMasterAddress_not_found_Response_Body
+func ValidateGetGuildMasterAddressNotFoundResponseBody(body *GetGuildMasterAddressNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
+	}
+	return
+}
+
+// ValidateGetGuildMasterAddressInternalResponseBody runs the validations
+// defined on GetGuildMasterAddress_internal_Response_Body
+func ValidateGetGuildMasterAddressInternalResponseBody(body *GetGuildMasterAddressInternalResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
+	}
+	return
+}
+
+// ValidateGetGuildMasterAddressInvalidArgResponseBody runs the validations
+// defined on GetGuildMasterAddress_invalid_arg_Response_Body
+func ValidateGetGuildMasterAddressInvalidArgResponseBody(body *GetGuildMasterAddressInvalidArgResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
+	}
+	return
+}
+
+// ValidateGetGuildDefaultMemberInvalidArgResponseBody runs the validations
+// defined on GetGuildDefaultMember_invalid_arg_Response_Body
+func ValidateGetGuildDefaultMemberInvalidArgResponseBody(body *GetGuildDefaultMemberInvalidArgResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
+	}
+	return
+}
+
+// ValidateGetGuildDefaultMemberNotFoundResponseBody runs the validations
+// defined on GetGuildDefaultMember_not_found_Response_Body
+func ValidateGetGuildDefaultMemberNotFoundResponseBody(body *GetGuildDefaultMemberNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
+	}
+	return
+}
+
+// ValidateGetGuildDefaultMemberInternalResponseBody runs the validations
+// defined on GetGuildDefaultMember_internal_Response_Body
+func ValidateGetGuildDefaultMemberInternalResponseBody(body *GetGuildDefaultMemberInternalResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
+	}
+	return
+}
+
+// ValidateEnterGuildNotFoundResponseBody runs the validations defined on
+// EnterGuild_not_found_Response_Body
+func ValidateEnterGuildNotFoundResponseBody(body *EnterGuildNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
+	}
+	return
+}
+
+// ValidateEnterGuildInternalResponseBody runs the validations defined on
+// EnterGuild_internal_Response_Body
+func ValidateEnterGuildInternalResponseBody(body *EnterGuildInternalResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
+	}
+	return
+}
+
+// ValidateEnterGuildInvalidArgResponseBody runs the validations defined on
+// EnterGuild_invalid_arg_Response_Body
+func ValidateEnterGuildInvalidArgResponseBody(body *EnterGuildInvalidArgResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
+	}
+	return
+}
+
+// ValidateGetGuildWaitlistInvalidArgResponseBody runs the validations defined
+// on GetGuildWaitlist_invalid_arg_Response_Body
+func ValidateGetGuildWaitlistInvalidArgResponseBody(body *GetGuildWaitlistInvalidArgResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
+	}
+	return
+}
+
+// ValidateGetGuildWaitlistNotFoundResponseBody runs the validations defined on
+// GetGuildWaitlist_not_found_Response_Body
+func ValidateGetGuildWaitlistNotFoundResponseBody(body *GetGuildWaitlistNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
+	}
+	return
+}
+
+// ValidateGetGuildWaitlistInternalResponseBody runs the validations defined on
+// GetGuildWaitlist_internal_Response_Body
+func ValidateGetGuildWaitlistInternalResponseBody(body *GetGuildWaitlistInternalResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
+	}
+	return
+}
+
+// ValidateLeaveGuildNotFoundResponseBody runs the validations defined on
+// LeaveGuild_not_found_Response_Body
+func ValidateLeaveGuildNotFoundResponseBody(body *LeaveGuildNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
+	}
+	return
+}
+
+// ValidateLeaveGuildInternalResponseBody runs the validations defined on
+// LeaveGuild_internal_Response_Body
+func ValidateLeaveGuildInternalResponseBody(body *LeaveGuildInternalResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
+	}
+	return
+}
+
+// ValidateLeaveGuildInvalidArgResponseBody runs the validations defined on
+// LeaveGuild_invalid_arg_Response_Body
+func ValidateLeaveGuildInvalidArgResponseBody(body *LeaveGuildInvalidArgResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
+	}
+	return
+}
+
+// ValidateTransferMemberInvalidArgResponseBody runs the validations defined on
+// TransferMember_invalid_arg_Response_Body
+func ValidateTransferMemberInvalidArgResponseBody(body *TransferMemberInvalidArgResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
+	}
+	return
+}
+
+// ValidateTransferMemberNotFoundResponseBody runs the validations defined on
+// TransferMember_not_found_Response_Body
+func ValidateTransferMemberNotFoundResponseBody(body *TransferMemberNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
+	}
+	return
+}
+
+// ValidateTransferMemberInternalResponseBody runs the validations defined on
+// TransferMember_internal_Response_Body
+func ValidateTransferMemberInternalResponseBody(body *TransferMemberInternalResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
+	}
+	return
+}
+
+// ValidateGetGuildMarketsNotFoundResponseBody runs the validations defined on
+// GetGuildMarkets_not_found_Response_Body
+func ValidateGetGuildMarketsNotFoundResponseBody(body *GetGuildMarketsNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
+	}
+	return
+}
+
+// ValidateGetGuildMarketsInternalResponseBody runs the validations defined on
+// GetGuildMarkets_internal_Response_Body
+func ValidateGetGuildMarketsInternalResponseBody(body *GetGuildMarketsInternalResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
+	}
+	return
+}
+
+// ValidateGetGuildMarketsInvalidArgResponseBody runs the validations defined
+// on GetGuildMarkets_invalid_arg_Response_Body
+func ValidateGetGuildMarketsInvalidArgResponseBody(body *GetGuildMarketsInvalidArgResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
+	}
+	return
+}
+
+// ValidateGetGuildPortfoliosNotFoundResponseBody runs the validations defined
+// on GetGuildPortfolios_not_found_Response_Body
+func ValidateGetGuildPortfoliosNotFoundResponseBody(body *GetGuildPortfoliosNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
+	}
+	return
+}
+
+// ValidateGetGuildPortfoliosInternalResponseBody runs the validations defined
+// on GetGuildPortfolios_internal_Response_Body
+func ValidateGetGuildPortfoliosInternalResponseBody(body *GetGuildPortfoliosInternalResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
+	}
+	return
+}
+
+// ValidateGetGuildPortfoliosInvalidArgResponseBody runs the validations
+// defined on GetGuildPortfolios_invalid_arg_Response_Body
+func ValidateGetGuildPortfoliosInvalidArgResponseBody(body *GetGuildPortfoliosInvalidArgResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
+	}
+	return
+}
+
+// ValidateGetAccountInfoNotFoundResponseBody runs the validations defined on
+// GetAccountInfo_not_found_Response_Body
+func ValidateGetAccountInfoNotFoundResponseBody(body *GetAccountInfoNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
+	}
+	return
+}
+
+// ValidateGetAccountInfoInternalResponseBody runs the validations defined on
+// GetAccountInfo_internal_Response_Body
+func ValidateGetAccountInfoInternalResponseBody(body *GetAccountInfoInternalResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
+	}
+	return
+}
+
+// ValidateGetAccountInfoInvalidArgResponseBody runs the validations defined on
+// GetAccountInfo_invalid_arg_Response_Body
+func ValidateGetAccountInfoInvalidArgResponseBody(body *GetAccountInfoInvalidArgResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
 		}
 	}
 	return
 }
 
-// ValidateGetAccountPortfoliosResponseBody runs the validations defined on
-// GetAccountPortfoliosResponseBody
-func ValidateGetAccountPortfoliosResponseBody(body *GetAccountPortfoliosResponseBody) (err error) {
-	for _, e := range body.Portfolios {
-		if e != nil {
-			if err2 := ValidateSingleAccountPortfolioResponseBody(e); err2 != nil {
-				err = goa.MergeErrors(err, err2)
-			}
+// ValidateGetAccountPortfolioNotFoundResponseBody runs the validations defined
+// on GetAccountPortfolio_not_found_Response_Body
+func ValidateGetAccountPortfolioNotFoundResponseBody(body *GetAccountPortfolioNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
 		}
 	}
 	return
 }
 
-// ValidateGetAccountMonthlyPortfoliosResponseBody runs the validations defined
-// on GetAccountMonthlyPortfoliosResponseBody
-func ValidateGetAccountMonthlyPortfoliosResponseBody(body *GetAccountMonthlyPortfoliosResponseBody) (err error) {
-	for _, e := range body.Portfolios {
-		if e != nil {
-			if err2 := ValidateMonthlyAccountPortfolioResponseBody(e); err2 != nil {
-				err = goa.MergeErrors(err, err2)
-			}
+// ValidateGetAccountPortfolioInternalResponseBody runs the validations defined
+// on GetAccountPortfolio_internal_Response_Body
+func ValidateGetAccountPortfolioInternalResponseBody(body *GetAccountPortfolioInternalResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
 		}
 	}
 	return
 }
 
-// ValidateGetAllGuildsNotFoundResponseBody runs the validations defined on
-// GetAllGuilds_not_found_Response_Body
-func ValidateGetAllGuildsNotFoundResponseBody(body *GetAllGuildsNotFoundResponseBody) (err error) {
+// ValidateGetAccountPortfolioInvalidArgResponseBody runs the validations
+// defined on GetAccountPortfolio_invalid_arg_Response_Body
+func ValidateGetAccountPortfolioInvalidArgResponseBody(body *GetAccountPortfolioInvalidArgResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	if body.ID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.Temporary == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
-	}
-	if body.Timeout == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
-	}
-	if body.Fault == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
 	}
 	return
 }
 
-// ValidateGetAllGuildsInternalResponseBody runs the validations defined on
-// GetAllGuilds_internal_Response_Body
-func ValidateGetAllGuildsInternalResponseBody(body *GetAllGuildsInternalResponseBody) (err error) {
+// ValidateGetAccountPortfoliosInvalidArgResponseBody runs the validations
+// defined on GetAccountPortfolios_invalid_arg_Response_Body
+func ValidateGetAccountPortfoliosInvalidArgResponseBody(body *GetAccountPortfoliosInvalidArgResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	if body.ID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.Temporary == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
-	}
-	if body.Timeout == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
-	}
-	if body.Fault == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
 	}
 	return
 }
 
-// ValidateGetSingleGuildNotFoundResponseBody runs the validations defined on
-// GetSingleGuild_not_found_Response_Body
-func ValidateGetSingleGuildNotFoundResponseBody(body *GetSingleGuildNotFoundResponseBody) (err error) {
+// ValidateGetAccountPortfoliosNotFoundResponseBody runs the validations
+// defined on GetAccountPortfolios_not_found_Response_Body
+func ValidateGetAccountPortfoliosNotFoundResponseBody(body *GetAccountPortfoliosNotFoundResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	if body.ID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.Temporary == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
-	}
-	if body.Timeout == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
-	}
-	if body.Fault == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
 	}
 	return
 }
 
-// ValidateGetSingleGuildInternalResponseBody runs the validations defined on
-// GetSingleGuild_internal_Response_Body
-func ValidateGetSingleGuildInternalResponseBody(body *GetSingleGuildInternalResponseBody) (err error) {
+// ValidateGetAccountPortfoliosInternalResponseBody runs the validations
+// defined on GetAccountPortfolios_internal_Response_Body
+func ValidateGetAccountPortfoliosInternalResponseBody(body *GetAccountPortfoliosInternalResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	if body.ID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.Temporary == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
-	}
-	if body.Timeout == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
-	}
-	if body.Fault == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
 	}
 	return
 }
 
-// ValidateGetGuildMembersNotFoundResponseBody runs the validations defined on
-// GetGuildMembers_not_found_Response_Body
-func ValidateGetGuildMembersNotFoundResponseBody(body *GetGuildMembersNotFoundResponseBody) (err error) {
+// ValidateGetAccountMembershipsInvalidArgResponseBody runs the validations
+// defined on GetAccountMemberships_invalid_arg_Response_Body
+func ValidateGetAccountMembershipsInvalidArgResponseBody(body *GetAccountMembershipsInvalidArgResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	if body.ID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.Temporary == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
-	}
-	if body.Timeout == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
-	}
-	if body.Fault == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
 	}
 	return
 }
 
-// ValidateGetGuildMembersInternalResponseBody runs the validations defined on
-// GetGuildMembers_internal_Response_Body
-func ValidateGetGuildMembersInternalResponseBody(body *GetGuildMembersInternalResponseBody) (err error) {
+// ValidateGetAccountMembershipsInternalResponseBody runs the validations
+// defined on GetAccountMemberships_internal_Response_Body
+func ValidateGetAccountMembershipsInternalResponseBody(body *GetAccountMembershipsInternalResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	if body.ID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.Temporary == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
-	}
-	if body.Timeout == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
-	}
-	if body.Fault == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
 	}
 	return
 }
 
-// ValidateGetGuildMasterAddressNotFoundResponseBody runs the validations
-// defined on GetGuildMasterAddress_not_found_Response_Body
-func ValidateGetGuildMasterAddressNotFoundResponseBody(body *GetGuildMasterAddressNotFoundResponseBody) (err error) {
+// ValidateGetAccountMembershipsNotFoundResponseBody runs the validations
+// defined on GetAccountMemberships_not_found_Response_Body
+func ValidateGetAccountMembershipsNotFoundResponseBody(body *GetAccountMembershipsNotFoundResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	if body.ID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.Temporary == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
-	}
-	if body.Timeout == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
-	}
-	if body.Fault == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
 	}
 	return
 }
 
-// ValidateGetGuildMasterAddressInternalResponseBody runs the validations
-// defined on GetGuildMasterAddress_internal_Response_Body
-func ValidateGetGuildMasterAddressInternalResponseBody(body *GetGuildMasterAddressInternalResponseBody) (err error) {
+// ValidateGetAccountMonthlyPortfoliosNotFoundResponseBody runs the validations
+// defined on GetAccountMonthlyPortfolios_not_found_Response_Body
+func ValidateGetAccountMonthlyPortfoliosNotFoundResponseBody(body *GetAccountMonthlyPortfoliosNotFoundResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	if body.ID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.Temporary == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
+	}
+	return
+}
+
+// ValidateGetAccountMonthlyPortfoliosInternalResponseBody runs the validations
+// defined on GetAccountMonthlyPortfolios_internal_Response_Body
+func ValidateGetAccountMonthlyPortfoliosInternalResponseBody(body *GetAccountMonthlyPortfoliosInternalResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-	if body.Timeout == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.Fault == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
 	}
 	return
 }
 
-// ValidateGetGuildDefaultMemberNotFoundResponseBody runs the validations
-// defined on GetGuildDefaultMember_not_found_Response_Body
-func ValidateGetGuildDefaultMemberNotFoundResponseBody(body *GetGuildDefaultMemberNotFoundResponseBody) (err error) {
+// ValidateGetAccountMonthlyPortfoliosInvalidArgResponseBody runs the
+// validations defined on GetAccountMonthlyPortfolios_invalid_arg_Response_Body
+func ValidateGetAccountMonthlyPortfoliosInvalidArgResponseBody(body *GetAccountMonthlyPortfoliosInvalidArgResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	if body.ID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.Temporary == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
+	}
+	return
+}
+
+// ValidateGetAccountGrantsNotFoundResponseBody runs the validations defined on
+// GetAccountGrants_not_found_Response_Body
+func ValidateGetAccountGrantsNotFoundResponseBody(body *GetAccountGrantsNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-	if body.Timeout == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.Fault == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
 	}
 	return
 }
 
-// ValidateGetGuildDefaultMemberInternalResponseBody runs the validations
-// defined on GetGuildDefaultMember_internal_Response_Body
-func ValidateGetGuildDefaultMemberInternalResponseBody(body *GetGuildDefaultMemberInternalResponseBody) (err error) {
+// ValidateGetAccountGrantsInvalidArgResponseBody runs the validations defined
+// on GetAccountGrants_invalid_arg_Response_Body
+func ValidateGetAccountGrantsInvalidArgResponseBody(body *GetAccountGrantsInvalidArgResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	if body.ID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.Temporary == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
+	}
+	return
+}
+
+// ValidateGetAccountGrantsInternalResponseBody runs the validations defined on
+// GetAccountGrants_internal_Response_Body
+func ValidateGetAccountGrantsInternalResponseBody(body *GetAccountGrantsInternalResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-	if body.Timeout == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.Fault == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
 	}
 	return
 }
 
-// ValidateEnterGuildNotFoundResponseBody runs the validations defined on
-// EnterGuild_not_found_Response_Body
-func ValidateEnterGuildNotFoundResponseBody(body *EnterGuildNotFoundResponseBody) (err error) {
+// ValidateGetAccountTradesInvalidArgResponseBody runs the validations defined
+// on GetAccountTrades_invalid_arg_Response_Body
+func ValidateGetAccountTradesInvalidArgResponseBody(body *GetAccountTradesInvalidArgResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	if body.ID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.Temporary == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
+	}
+	return
+}
+
+// ValidateGetAccountTradesInternalResponseBody runs the validations defined on
+// GetAccountTrades_internal_Response_Body
+func ValidateGetAccountTradesInternalResponseBody(body *GetAccountTradesInternalResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-	if body.Timeout == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.Fault == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
 	}
 	return
 }
 
-// ValidateEnterGuildInternalResponseBody runs the validations defined on
-// EnterGuild_internal_Response_Body
-func ValidateEnterGuildInternalResponseBody(body *EnterGuildInternalResponseBody) (err error) {
+// ValidateGetAccountTradesNotFoundResponseBody runs the validations defined on
+// GetAccountTrades_not_found_Response_Body
+func ValidateGetAccountTradesNotFoundResponseBody(body *GetAccountTradesNotFoundResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	if body.ID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.Temporary == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
+	}
+	return
+}
+
+// ValidateGetAccountTradeStatsInvalidArgResponseBody runs the validations
+// defined on GetAccountTradeStats_invalid_arg_Response_Body
+func ValidateGetAccountTradeStatsInvalidArgResponseBody(body *GetAccountTradeStatsInvalidArgResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-	if body.Timeout == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.Fault == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
 	}
 	return
 }
 
-// ValidateLeaveGuildNotFoundResponseBody runs the validations defined on
-// LeaveGuild_not_found_Response_Body
-func ValidateLeaveGuildNotFoundResponseBody(body *LeaveGuildNotFoundResponseBody) (err error) {
+// ValidateGetAccountTradeStatsInternalResponseBody runs the validations
+// defined on GetAccountTradeStats_internal_Response_Body
+func ValidateGetAccountTradeStatsInternalResponseBody(body *GetAccountTradeStatsInternalResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	if body.ID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.Temporary == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
+	}
+	return
+}
+
+// ValidateGetAccountTradeStatsNotFoundResponseBody runs the validations
+// defined on GetAccountTradeStats_not_found_Response_Body
+func ValidateGetAccountTradeStatsNotFoundResponseBody(body *GetAccountTradeStatsNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-	if body.Timeout == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.Fault == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
 	}
 	return
 }
 
-// ValidateLeaveGuildInternalResponseBody runs the validations defined on
-// LeaveGuild_internal_Response_Body
-func ValidateLeaveGuildInternalResponseBody(body *LeaveGuildInternalResponseBody) (err error) {
+// ValidateGetGuildTradeStatsInvalidArgResponseBody runs the validations
+// defined on GetGuildTradeStats_invalid_arg_Response_Body
+func ValidateGetGuildTradeStatsInvalidArgResponseBody(body *GetGuildTradeStatsInvalidArgResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	if body.ID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.Temporary == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
+	}
+	return
+}
+
+// ValidateGetGuildTradeStatsNotFoundResponseBody runs the validations defined
+// on GetGuildTradeStats_not_found_Response_Body
+func ValidateGetGuildTradeStatsNotFoundResponseBody(body *GetGuildTradeStatsNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-	if body.Timeout == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.Fault == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
 	}
 	return
 }
 
-// ValidateGetGuildMarketsNotFoundResponseBody runs the validations defined on
-// GetGuildMarkets_not_found_Response_Body
-func ValidateGetGuildMarketsNotFoundResponseBody(body *GetGuildMarketsNotFoundResponseBody) (err error) {
+// ValidateGetGuildTradeStatsInternalResponseBody runs the validations defined
+// on GetGuildTradeStats_internal_Response_Body
+func ValidateGetGuildTradeStatsInternalResponseBody(body *GetGuildTradeStatsInternalResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	if body.ID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.Temporary == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
+	}
+	return
+}
+
+// ValidateGetGuildFeesInvalidArgResponseBody runs the validations defined on
+// GetGuildFees_invalid_arg_Response_Body
+func ValidateGetGuildFeesInvalidArgResponseBody(body *GetGuildFeesInvalidArgResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-	if body.Timeout == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.Fault == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
 	}
 	return
 }
 
-// ValidateGetGuildMarketsInternalResponseBody runs the validations defined on
-// GetGuildMarkets_internal_Response_Body
-func ValidateGetGuildMarketsInternalResponseBody(body *GetGuildMarketsInternalResponseBody) (err error) {
+// ValidateGetGuildFeesNotFoundResponseBody runs the validations defined on
+// GetGuildFees_not_found_Response_Body
+func ValidateGetGuildFeesNotFoundResponseBody(body *GetGuildFeesNotFoundResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	if body.ID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
-	}
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.Temporary == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
-	}
-	if body.Timeout == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
-	}
-	if body.Fault == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
 	}
 	return
 }
 
-// ValidateGetGuildPortfoliosNotFoundResponseBody runs the validations defined
-// on GetGuildPortfolios_not_found_Response_Body
-func ValidateGetGuildPortfoliosNotFoundResponseBody(body *GetGuildPortfoliosNotFoundResponseBody) (err error) {
+// ValidateGetGuildFeesInternalResponseBody runs the validations defined on
+// GetGuildFees_internal_Response_Body
+func ValidateGetGuildFeesInternalResponseBody(body *GetGuildFeesInternalResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	if body.ID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.Temporary == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
-	}
-	if body.Timeout == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
-	}
-	if body.Fault == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
 	}
 	return
 }
 
-// ValidateGetGuildPortfoliosInternalResponseBody runs the validations defined
-// on GetGuildPortfolios_internal_Response_Body
-func ValidateGetGuildPortfoliosInternalResponseBody(body *GetGuildPortfoliosInternalResponseBody) (err error) {
+// ValidateGetAccountTransfersInvalidArgResponseBody runs the validations
+// defined on GetAccountTransfers_invalid_arg_Response_Body
+func ValidateGetAccountTransfersInvalidArgResponseBody(body *GetAccountTransfersInvalidArgResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	if body.ID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.Temporary == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
-	}
-	if body.Timeout == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
-	}
-	if body.Fault == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
 	}
 	return
 }
 
-// ValidateGetAccountInfoNotFoundResponseBody runs the validations defined on
-// GetAccountInfo_not_found_Response_Body
-func ValidateGetAccountInfoNotFoundResponseBody(body *GetAccountInfoNotFoundResponseBody) (err error) {
+// ValidateGetAccountTransfersInternalResponseBody runs the validations defined
+// on GetAccountTransfers_internal_Response_Body
+func ValidateGetAccountTransfersInternalResponseBody(body *GetAccountTransfersInternalResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	if body.ID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.Temporary == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
-	}
-	if body.Timeout == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
-	}
-	if body.Fault == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
 	}
 	return
 }
 
-// ValidateGetAccountInfoInternalResponseBody runs the validations defined on
-// GetAccountInfo_internal_Response_Body
-func ValidateGetAccountInfoInternalResponseBody(body *GetAccountInfoInternalResponseBody) (err error) {
+// ValidateGetAccountTransfersNotFoundResponseBody runs the validations defined
+// on GetAccountTransfers_not_found_Response_Body
+func ValidateGetAccountTransfersNotFoundResponseBody(body *GetAccountTransfersNotFoundResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	if body.ID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.Temporary == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
-	}
-	if body.Timeout == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
-	}
-	if body.Fault == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
 	}
 	return
 }
 
-// ValidateGetAccountPortfolioNotFoundResponseBody runs the validations defined
-// on GetAccountPortfolio_not_found_Response_Body
-func ValidateGetAccountPortfolioNotFoundResponseBody(body *GetAccountPortfolioNotFoundResponseBody) (err error) {
+// ValidateGetAccountPositionsInvalidArgResponseBody runs the validations
+// defined on GetAccountPositions_invalid_arg_Response_Body
+func ValidateGetAccountPositionsInvalidArgResponseBody(body *GetAccountPositionsInvalidArgResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	if body.ID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.Temporary == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
-	}
-	if body.Timeout == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
-	}
-	if body.Fault == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
 	}
 	return
 }
 
-// ValidateGetAccountPortfolioInternalResponseBody runs the validations defined
-// on GetAccountPortfolio_internal_Response_Body
-func ValidateGetAccountPortfolioInternalResponseBody(body *GetAccountPortfolioInternalResponseBody) (err error) {
+// ValidateGetAccountPositionsNotFoundResponseBody runs the validations defined
+// on GetAccountPositions_not_found_Response_Body
+func ValidateGetAccountPositionsNotFoundResponseBody(body *GetAccountPositionsNotFoundResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	if body.ID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.Temporary == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
-	}
-	if body.Timeout == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
-	}
-	if body.Fault == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
 	}
 	return
 }
 
-// ValidateGetAccountPortfoliosNotFoundResponseBody runs the validations
-// defined on GetAccountPortfolios_not_found_Response_Body
-func ValidateGetAccountPortfoliosNotFoundResponseBody(body *GetAccountPortfoliosNotFoundResponseBody) (err error) {
+// ValidateGetAccountPositionsInternalResponseBody runs the validations defined
+// on GetAccountPositions_internal_Response_Body
+func ValidateGetAccountPositionsInternalResponseBody(body *GetAccountPositionsInternalResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	if body.ID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.Temporary == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
-	}
-	if body.Timeout == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
-	}
-	if body.Fault == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
 	}
 	return
 }
 
-// ValidateGetAccountPortfoliosInternalResponseBody runs the validations
-// defined on GetAccountPortfolios_internal_Response_Body
-func ValidateGetAccountPortfoliosInternalResponseBody(body *GetAccountPortfoliosInternalResponseBody) (err error) {
+// ValidateGetGuildRiskInvalidArgResponseBody runs the validations defined on
+// GetGuildRisk_invalid_arg_Response_Body
+func ValidateGetGuildRiskInvalidArgResponseBody(body *GetGuildRiskInvalidArgResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	if body.ID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.Temporary == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
-	}
-	if body.Timeout == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
-	}
-	if body.Fault == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
 	}
 	return
 }
 
-// ValidateGetAccountMonthlyPortfoliosNotFoundResponseBody runs the validations
-// defined on GetAccountMonthlyPortfolios_not_found_Response_Body
-func ValidateGetAccountMonthlyPortfoliosNotFoundResponseBody(body *GetAccountMonthlyPortfoliosNotFoundResponseBody) (err error) {
+// ValidateGetGuildRiskNotFoundResponseBody runs the validations defined on
+// GetGuildRisk_not_found_Response_Body
+func ValidateGetGuildRiskNotFoundResponseBody(body *GetGuildRiskNotFoundResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	if body.ID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.Temporary == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
-	}
-	if body.Timeout == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
-	}
-	if body.Fault == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
 	}
 	return
 }
 
-// ValidateGetAccountMonthlyPortfoliosInternalResponseBody runs the validations
-// defined on GetAccountMonthlyPortfolios_internal_Response_Body
-func ValidateGetAccountMonthlyPortfoliosInternalResponseBody(body *GetAccountMonthlyPortfoliosInternalResponseBody) (err error) {
+// ValidateGetGuildRiskInternalResponseBody runs the validations defined on
+// GetGuildRisk_internal_Response_Body
+func ValidateGetGuildRiskInternalResponseBody(body *GetGuildRiskInternalResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	if body.ID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.Temporary == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
-	}
-	if body.Timeout == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
-	}
-	if body.Fault == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	if body.Code != nil {
+		if !(*body.Code == "INVALID_ARGUMENT" || *body.Code == "NOT_FOUND" || *body.Code == "INTERNAL" || *body.Code == "GUILD_NOT_FOUND" || *body.Code == "GUILD_FULL" || *body.Code == "GUILD_ARCHIVED" || *body.Code == "ALREADY_MEMBER" || *body.Code == "MEMBER_NOT_FOUND" || *body.Code == "INSUFFICIENT_BALANCE" || *body.Code == "MISSING_GRANT" || *body.Code == "OPEN_ORDERS" || *body.Code == "OPEN_POSITIONS" || *body.Code == "GRANTS_NOT_REVOKED" || *body.Code == "NOT_ALLOWLISTED" || *body.Code == "INVALID_INVITE_CODE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []interface{}{"INVALID_ARGUMENT", "NOT_FOUND", "INTERNAL", "GUILD_NOT_FOUND", "GUILD_FULL", "GUILD_ARCHIVED", "ALREADY_MEMBER", "MEMBER_NOT_FOUND", "INSUFFICIENT_BALANCE", "MISSING_GRANT", "OPEN_ORDERS", "OPEN_POSITIONS", "GRANTS_NOT_REVOKED", "NOT_ALLOWLISTED", "INVALID_INVITE_CODE"}))
+		}
 	}
 	return
 }
@@ -2039,6 +5080,13 @@ func ValidateSingleGuildPortfolioResponseBody(body *SingleGuildPortfolioResponse
 			}
 		}
 	}
+	for _, e := range body.CashFlows {
+		if e != nil {
+			if err2 := ValidateCashFlowResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
 	return
 }
 
@@ -2066,6 +5114,29 @@ func ValidateBalanceResponseBody(body *BalanceResponseBody) (err error) {
 	return
 }
 
+// ValidateCashFlowResponseBody runs the validations defined on
+// CashFlowResponseBody
+func ValidateCashFlowResponseBody(body *CashFlowResponseBody) (err error) {
+	if body.Type == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("type", "body"))
+	}
+	if body.Denom == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("denom", "body"))
+	}
+	if body.Amount == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("amount", "body"))
+	}
+	if body.ExecutedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("executed_at", "body"))
+	}
+	if body.Type != nil {
+		if !(*body.Type == "deposit" || *body.Type == "withdraw" || *body.Type == "transfer_in" || *body.Type == "transfer_out" || *body.Type == "member_joined" || *body.Type == "member_left") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.type", *body.Type, []interface{}{"deposit", "withdraw", "transfer_in", "transfer_out", "member_joined", "member_left"}))
+		}
+	}
+	return
+}
+
 // ValidateGuildMemberResponseBody runs the validations defined on
 // GuildMemberResponseBody
 func ValidateGuildMemberResponseBody(body *GuildMemberResponseBody) (err error) {
@@ -2084,6 +5155,21 @@ func ValidateGuildMemberResponseBody(body *GuildMemberResponseBody) (err error)
 	return
 }
 
+// ValidateWaitlistEntryResponseBody runs the validations defined on
+// WaitlistEntryResponseBody
+func ValidateWaitlistEntryResponseBody(body *WaitlistEntryResponseBody) (err error) {
+	if body.InjectiveAddress == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("injective_address", "body"))
+	}
+	if body.Position == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("position", "body"))
+	}
+	if body.EnqueuedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("enqueued_at", "body"))
+	}
+	return
+}
+
 // ValidateMarketResponseBody runs the validations defined on MarketResponseBody
 func ValidateMarketResponseBody(body *MarketResponseBody) (err error) {
 	if body.MarketID == nil {
@@ -2095,6 +5181,27 @@ func ValidateMarketResponseBody(body *MarketResponseBody) (err error) {
 	return
 }
 
+// ValidateNetFlowResponseBody runs the validations defined on
+// NetFlowResponseBody
+func ValidateNetFlowResponseBody(body *NetFlowResponseBody) (err error) {
+	if body.Denom == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("denom", "body"))
+	}
+	if body.Deposits == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("deposits", "body"))
+	}
+	if body.Withdrawals == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("withdrawals", "body"))
+	}
+	if body.NetFlow == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("net_flow", "body"))
+	}
+	if body.TransferCount == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("transfer_count", "body"))
+	}
+	return
+}
+
 // ValidateSingleAccountPortfolioResponseBody runs the validations defined on
 // SingleAccountPortfolioResponseBody
 func ValidateSingleAccountPortfolioResponseBody(body *SingleAccountPortfolioResponseBody) (err error) {
@@ -2114,6 +5221,28 @@ func ValidateSingleAccountPortfolioResponseBody(body *SingleAccountPortfolioResp
 			}
 		}
 	}
+	for _, e := range body.CashFlows {
+		if e != nil {
+			if err2 := ValidateCashFlowResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateMembershipResponseBody runs the validations defined on
+// MembershipResponseBody
+func ValidateMembershipResponseBody(body *MembershipResponseBody) (err error) {
+	if body.GuildID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("guild_id", "body"))
+	}
+	if body.IsDefaultGuildMember == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("is_default_guild_member", "body"))
+	}
+	if body.Since == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("since", "body"))
+	}
 	return
 }
 
@@ -2141,3 +5270,286 @@ func ValidateMonthlyAccountPortfolioResponseBody(body *MonthlyAccountPortfolioRe
 	}
 	return
 }
+
+// ValidateGrantResponseBody runs the validations defined on GrantResponseBody
+func ValidateGrantResponseBody(body *GrantResponseBody) (err error) {
+	if body.Msg == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("msg", "body"))
+	}
+	if body.IsGranted == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("is_granted", "body"))
+	}
+	return
+}
+
+// ValidateTradeResponseBody runs the validations defined on TradeResponseBody
+func ValidateTradeResponseBody(body *TradeResponseBody) (err error) {
+	if body.TradeID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("trade_id", "body"))
+	}
+	if body.SubaccountID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("subaccount_id", "body"))
+	}
+	if body.MarketID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("market_id", "body"))
+	}
+	if body.IsPerpetual == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("is_perpetual", "body"))
+	}
+	if body.Direction == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("direction", "body"))
+	}
+	if body.ExecutionType == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("execution_type", "body"))
+	}
+	if body.Price == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("price", "body"))
+	}
+	if body.Quantity == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("quantity", "body"))
+	}
+	if body.QuoteDenom == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("quote_denom", "body"))
+	}
+	if body.Fee == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fee", "body"))
+	}
+	if body.Volume == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("volume", "body"))
+	}
+	if body.RealizedPnl == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("realized_pnl", "body"))
+	}
+	if body.ExecutedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("executed_at", "body"))
+	}
+	return
+}
+
+// ValidateTradeStatsResponseBody runs the validations defined on
+// TradeStatsResponseBody
+func ValidateTradeStatsResponseBody(body *TradeStatsResponseBody) (err error) {
+	if body.QuoteDenom == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("quote_denom", "body"))
+	}
+	if body.RealizedPnl == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("realized_pnl", "body"))
+	}
+	if body.Fees == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fees", "body"))
+	}
+	if body.Volume == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("volume", "body"))
+	}
+	if body.TradeCount == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("trade_count", "body"))
+	}
+	return
+}
+
+// ValidateGuildFeeTotalResponseBody runs the validations defined on
+// GuildFeeTotalResponseBody
+func ValidateGuildFeeTotalResponseBody(body *GuildFeeTotalResponseBody) (err error) {
+	if body.QuoteDenom == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("quote_denom", "body"))
+	}
+	if body.FeesPaid == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fees_paid", "body"))
+	}
+	if body.MasterFees == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("master_fees", "body"))
+	}
+	if body.Volume == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("volume", "body"))
+	}
+	if body.TradeCount == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("trade_count", "body"))
+	}
+	if body.UnresolvedFees == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("unresolved_fees", "body"))
+	}
+	return
+}
+
+// ValidateMemberFeeContributionResponseBody runs the validations defined on
+// MemberFeeContributionResponseBody
+func ValidateMemberFeeContributionResponseBody(body *MemberFeeContributionResponseBody) (err error) {
+	if body.InjectiveAddress == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("injective_address", "body"))
+	}
+	if body.QuoteDenom == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("quote_denom", "body"))
+	}
+	if body.FeesPaid == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fees_paid", "body"))
+	}
+	if body.MasterFees == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("master_fees", "body"))
+	}
+	if body.Volume == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("volume", "body"))
+	}
+	if body.TradeCount == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("trade_count", "body"))
+	}
+	if body.Share == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("share", "body"))
+	}
+	if body.UnresolvedFees == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("unresolved_fees", "body"))
+	}
+	return
+}
+
+// ValidateDailyGuildFeeResponseBody runs the validations defined on
+// DailyGuildFeeResponseBody
+func ValidateDailyGuildFeeResponseBody(body *DailyGuildFeeResponseBody) (err error) {
+	if body.Day == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("day", "body"))
+	}
+	if body.QuoteDenom == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("quote_denom", "body"))
+	}
+	if body.FeesPaid == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fees_paid", "body"))
+	}
+	if body.MasterFees == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("master_fees", "body"))
+	}
+	if body.Volume == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("volume", "body"))
+	}
+	if body.TradeCount == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("trade_count", "body"))
+	}
+	if body.UnresolvedFees == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("unresolved_fees", "body"))
+	}
+	return
+}
+
+// ValidateTransferResponseBody runs the validations defined on
+// TransferResponseBody
+func ValidateTransferResponseBody(body *TransferResponseBody) (err error) {
+	if body.TransferID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("transfer_id", "body"))
+	}
+	if body.SubaccountID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("subaccount_id", "body"))
+	}
+	if body.TransferType == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("transfer_type", "body"))
+	}
+	if body.Denom == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("denom", "body"))
+	}
+	if body.Amount == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("amount", "body"))
+	}
+	if body.ExecutedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("executed_at", "body"))
+	}
+	if body.TransferType != nil {
+		if !(*body.TransferType == "deposit" || *body.TransferType == "withdraw") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.transfer_type", *body.TransferType, []interface{}{"deposit", "withdraw"}))
+		}
+	}
+	return
+}
+
+// ValidatePositionResponseBody runs the validations defined on
+// PositionResponseBody
+func ValidatePositionResponseBody(body *PositionResponseBody) (err error) {
+	if body.SubaccountID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("subaccount_id", "body"))
+	}
+	if body.MarketID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("market_id", "body"))
+	}
+	if body.Ticker == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("ticker", "body"))
+	}
+	if body.Direction == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("direction", "body"))
+	}
+	if body.Quantity == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("quantity", "body"))
+	}
+	if body.EntryPrice == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("entry_price", "body"))
+	}
+	if body.MarkPrice == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("mark_price", "body"))
+	}
+	if body.Margin == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("margin", "body"))
+	}
+	if body.LiquidationPrice == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("liquidation_price", "body"))
+	}
+	return
+}
+
+// ValidateGuildRiskResponseBody runs the validations defined on
+// GuildRiskResponseBody
+func ValidateGuildRiskResponseBody(body *GuildRiskResponseBody) (err error) {
+	if body.GuildID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("guild_id", "body"))
+	}
+	if body.WindowStart == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("window_start", "body"))
+	}
+	if body.SnapshotCount == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("snapshot_count", "body"))
+	}
+	if body.MaxDrawdown == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("max_drawdown", "body"))
+	}
+	if body.Volatility == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("volatility", "body"))
+	}
+	if body.SharpeRatio == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("sharpe_ratio", "body"))
+	}
+	if body.NotionalUsd == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("notional_usd", "body"))
+	}
+	if body.EquityUsd == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("equity_usd", "body"))
+	}
+	if body.Leverage == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("leverage", "body"))
+	}
+	if body.Markets == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("markets", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
+	}
+	for _, e := range body.Markets {
+		if e != nil {
+			if err2 := ValidateMarketExposureResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateMarketExposureResponseBody runs the validations defined on
+// MarketExposureResponseBody
+func ValidateMarketExposureResponseBody(body *MarketExposureResponseBody) (err error) {
+	if body.MarketID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("market_id", "body"))
+	}
+	if body.Ticker == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("ticker", "body"))
+	}
+	if body.NotionalUsd == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("notional_usd", "body"))
+	}
+	if body.Share == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("share", "body"))
+	}
+	return
+}
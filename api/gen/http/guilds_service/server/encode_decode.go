@@ -31,6 +31,33 @@ func EncodeGetAllGuildsResponse(encoder func(context.Context, http.ResponseWrite
 	}
 }
 
+// DecodeGetAllGuildsRequest returns a decoder for requests sent to the
+// GuildsService GetAllGuilds endpoint.
+func DecodeGetAllGuildsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (interface{}, error) {
+	return func(r *http.Request) (interface{}, error) {
+		var (
+			includeArchived *bool
+			err             error
+		)
+		{
+			includeArchivedRaw := r.URL.Query().Get("include_archived")
+			if includeArchivedRaw != "" {
+				v, err2 := strconv.ParseBool(includeArchivedRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("includeArchived", includeArchivedRaw, "boolean"))
+				}
+				includeArchived = &v
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetAllGuildsPayload(includeArchived)
+
+		return payload, nil
+	}
+}
+
 // EncodeGetAllGuildsError returns an encoder for errors returned by the
 // GetAllGuilds GuildsService endpoint.
 func EncodeGetAllGuildsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
@@ -42,7 +69,7 @@ func EncodeGetAllGuildsError(encoder func(context.Context, http.ResponseWriter)
 		}
 		switch en.ErrorName() {
 		case "not_found":
-			var res *goa.ServiceError
+			var res *guildsservice.APIError
 			errors.As(v, &res)
 			enc := encoder(ctx, w)
 			var body interface{}
@@ -55,7 +82,7 @@ func EncodeGetAllGuildsError(encoder func(context.Context, http.ResponseWriter)
 			w.WriteHeader(http.StatusNotFound)
 			return enc.Encode(body)
 		case "internal":
-			var res *goa.ServiceError
+			var res *guildsservice.APIError
 			errors.As(v, &res)
 			enc := encoder(ctx, w)
 			var body interface{}
@@ -67,6 +94,19 @@ func EncodeGetAllGuildsError(encoder func(context.Context, http.ResponseWriter)
 			w.Header().Set("goa-error", res.ErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
 			return enc.Encode(body)
+		case "invalid_arg":
+			var res *guildsservice.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body interface{}
+			if formatter != nil {
+				body = formatter(res)
+			} else {
+				body = NewGetAllGuildsInvalidArgResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.ErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
 		default:
 			return encodeError(ctx, w, v)
 		}
@@ -112,7 +152,7 @@ func EncodeGetSingleGuildError(encoder func(context.Context, http.ResponseWriter
 		}
 		switch en.ErrorName() {
 		case "not_found":
-			var res *goa.ServiceError
+			var res *guildsservice.APIError
 			errors.As(v, &res)
 			enc := encoder(ctx, w)
 			var body interface{}
@@ -125,7 +165,7 @@ func EncodeGetSingleGuildError(encoder func(context.Context, http.ResponseWriter
 			w.WriteHeader(http.StatusNotFound)
 			return enc.Encode(body)
 		case "internal":
-			var res *goa.ServiceError
+			var res *guildsservice.APIError
 			errors.As(v, &res)
 			enc := encoder(ctx, w)
 			var body interface{}
@@ -137,6 +177,19 @@ func EncodeGetSingleGuildError(encoder func(context.Context, http.ResponseWriter
 			w.Header().Set("goa-error", res.ErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
 			return enc.Encode(body)
+		case "invalid_arg":
+			var res *guildsservice.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body interface{}
+			if formatter != nil {
+				body = formatter(res)
+			} else {
+				body = NewGetSingleGuildInvalidArgResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.ErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
 		default:
 			return encodeError(ctx, w, v)
 		}
@@ -181,8 +234,21 @@ func EncodeGetGuildMembersError(encoder func(context.Context, http.ResponseWrite
 			return encodeError(ctx, w, v)
 		}
 		switch en.ErrorName() {
+		case "invalid_arg":
+			var res *guildsservice.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body interface{}
+			if formatter != nil {
+				body = formatter(res)
+			} else {
+				body = NewGetGuildMembersInvalidArgResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.ErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
 		case "not_found":
-			var res *goa.ServiceError
+			var res *guildsservice.APIError
 			errors.As(v, &res)
 			enc := encoder(ctx, w)
 			var body interface{}
@@ -195,7 +261,7 @@ func EncodeGetGuildMembersError(encoder func(context.Context, http.ResponseWrite
 			w.WriteHeader(http.StatusNotFound)
 			return enc.Encode(body)
 		case "internal":
-			var res *goa.ServiceError
+			var res *guildsservice.APIError
 			errors.As(v, &res)
 			enc := encoder(ctx, w)
 			var body interface{}
@@ -252,7 +318,7 @@ func EncodeGetGuildMasterAddressError(encoder func(context.Context, http.Respons
 		}
 		switch en.ErrorName() {
 		case "not_found":
-			var res *goa.ServiceError
+			var res *guildsservice.APIError
 			errors.As(v, &res)
 			enc := encoder(ctx, w)
 			var body interface{}
@@ -265,7 +331,7 @@ func EncodeGetGuildMasterAddressError(encoder func(context.Context, http.Respons
 			w.WriteHeader(http.StatusNotFound)
 			return enc.Encode(body)
 		case "internal":
-			var res *goa.ServiceError
+			var res *guildsservice.APIError
 			errors.As(v, &res)
 			enc := encoder(ctx, w)
 			var body interface{}
@@ -277,6 +343,19 @@ func EncodeGetGuildMasterAddressError(encoder func(context.Context, http.Respons
 			w.Header().Set("goa-error", res.ErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
 			return enc.Encode(body)
+		case "invalid_arg":
+			var res *guildsservice.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body interface{}
+			if formatter != nil {
+				body = formatter(res)
+			} else {
+				body = NewGetGuildMasterAddressInvalidArgResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.ErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
 		default:
 			return encodeError(ctx, w, v)
 		}
@@ -321,8 +400,21 @@ func EncodeGetGuildDefaultMemberError(encoder func(context.Context, http.Respons
 			return encodeError(ctx, w, v)
 		}
 		switch en.ErrorName() {
+		case "invalid_arg":
+			var res *guildsservice.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body interface{}
+			if formatter != nil {
+				body = formatter(res)
+			} else {
+				body = NewGetGuildDefaultMemberInvalidArgResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.ErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
 		case "not_found":
-			var res *goa.ServiceError
+			var res *guildsservice.APIError
 			errors.As(v, &res)
 			enc := encoder(ctx, w)
 			var body interface{}
@@ -335,7 +427,7 @@ func EncodeGetGuildDefaultMemberError(encoder func(context.Context, http.Respons
 			w.WriteHeader(http.StatusNotFound)
 			return enc.Encode(body)
 		case "internal":
-			var res *goa.ServiceError
+			var res *guildsservice.APIError
 			errors.As(v, &res)
 			enc := encoder(ctx, w)
 			var body interface{}
@@ -408,7 +500,7 @@ func EncodeEnterGuildError(encoder func(context.Context, http.ResponseWriter) go
 		}
 		switch en.ErrorName() {
 		case "not_found":
-			var res *goa.ServiceError
+			var res *guildsservice.APIError
 			errors.As(v, &res)
 			enc := encoder(ctx, w)
 			var body interface{}
@@ -421,7 +513,7 @@ func EncodeEnterGuildError(encoder func(context.Context, http.ResponseWriter) go
 			w.WriteHeader(http.StatusNotFound)
 			return enc.Encode(body)
 		case "internal":
-			var res *goa.ServiceError
+			var res *guildsservice.APIError
 			errors.As(v, &res)
 			enc := encoder(ctx, w)
 			var body interface{}
@@ -433,6 +525,107 @@ func EncodeEnterGuildError(encoder func(context.Context, http.ResponseWriter) go
 			w.Header().Set("goa-error", res.ErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
 			return enc.Encode(body)
+		case "invalid_arg":
+			var res *guildsservice.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body interface{}
+			if formatter != nil {
+				body = formatter(res)
+			} else {
+				body = NewEnterGuildInvalidArgResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.ErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetGuildWaitlistResponse returns an encoder for responses returned by
+// the GuildsService GetGuildWaitlist endpoint.
+func EncodeGetGuildWaitlistResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, interface{}) error {
+	return func(ctx context.Context, w http.ResponseWriter, v interface{}) error {
+		res, _ := v.(*guildsservice.GetGuildWaitlistResult)
+		enc := encoder(ctx, w)
+		body := NewGetGuildWaitlistResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetGuildWaitlistRequest returns a decoder for requests sent to the
+// GuildsService GetGuildWaitlist endpoint.
+func DecodeGetGuildWaitlistRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (interface{}, error) {
+	return func(r *http.Request) (interface{}, error) {
+		var (
+			guildID          string
+			injectiveAddress *string
+
+			params = mux.Vars(r)
+		)
+		guildID = params["guildID"]
+		injectiveAddressRaw := r.URL.Query().Get("injective_address")
+		if injectiveAddressRaw != "" {
+			injectiveAddress = &injectiveAddressRaw
+		}
+		payload := NewGetGuildWaitlistPayload(guildID, injectiveAddress)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetGuildWaitlistError returns an encoder for errors returned by the
+// GetGuildWaitlist GuildsService endpoint.
+func EncodeGetGuildWaitlistError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en ErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.ErrorName() {
+		case "invalid_arg":
+			var res *guildsservice.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body interface{}
+			if formatter != nil {
+				body = formatter(res)
+			} else {
+				body = NewGetGuildWaitlistInvalidArgResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.ErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res *guildsservice.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body interface{}
+			if formatter != nil {
+				body = formatter(res)
+			} else {
+				body = NewGetGuildWaitlistNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.ErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "internal":
+			var res *guildsservice.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body interface{}
+			if formatter != nil {
+				body = formatter(res)
+			} else {
+				body = NewGetGuildWaitlistInternalResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.ErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
 		default:
 			return encodeError(ctx, w, v)
 		}
@@ -480,7 +673,7 @@ func EncodeLeaveGuildError(encoder func(context.Context, http.ResponseWriter) go
 		}
 		switch en.ErrorName() {
 		case "not_found":
-			var res *goa.ServiceError
+			var res *guildsservice.APIError
 			errors.As(v, &res)
 			enc := encoder(ctx, w)
 			var body interface{}
@@ -493,7 +686,7 @@ func EncodeLeaveGuildError(encoder func(context.Context, http.ResponseWriter) go
 			w.WriteHeader(http.StatusNotFound)
 			return enc.Encode(body)
 		case "internal":
-			var res *goa.ServiceError
+			var res *guildsservice.APIError
 			errors.As(v, &res)
 			enc := encoder(ctx, w)
 			var body interface{}
@@ -505,6 +698,118 @@ func EncodeLeaveGuildError(encoder func(context.Context, http.ResponseWriter) go
 			w.Header().Set("goa-error", res.ErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
 			return enc.Encode(body)
+		case "invalid_arg":
+			var res *guildsservice.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body interface{}
+			if formatter != nil {
+				body = formatter(res)
+			} else {
+				body = NewLeaveGuildInvalidArgResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.ErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeTransferMemberResponse returns an encoder for responses returned by
+// the GuildsService TransferMember endpoint.
+func EncodeTransferMemberResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, interface{}) error {
+	return func(ctx context.Context, w http.ResponseWriter, v interface{}) error {
+		res, _ := v.(*guildsservice.TransferMemberResult)
+		enc := encoder(ctx, w)
+		body := NewTransferMemberResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeTransferMemberRequest returns a decoder for requests sent to the
+// GuildsService TransferMember endpoint.
+func DecodeTransferMemberRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (interface{}, error) {
+	return func(r *http.Request) (interface{}, error) {
+		var (
+			body TransferMemberRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if err == io.EOF {
+				return nil, goa.MissingPayloadError()
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateTransferMemberRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			injectiveAddress string
+
+			params = mux.Vars(r)
+		)
+		injectiveAddress = params["injective_address"]
+		payload := NewTransferMemberPayload(&body, injectiveAddress)
+
+		return payload, nil
+	}
+}
+
+// EncodeTransferMemberError returns an encoder for errors returned by the
+// TransferMember GuildsService endpoint.
+func EncodeTransferMemberError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en ErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.ErrorName() {
+		case "invalid_arg":
+			var res *guildsservice.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body interface{}
+			if formatter != nil {
+				body = formatter(res)
+			} else {
+				body = NewTransferMemberInvalidArgResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.ErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res *guildsservice.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body interface{}
+			if formatter != nil {
+				body = formatter(res)
+			} else {
+				body = NewTransferMemberNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.ErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "internal":
+			var res *guildsservice.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body interface{}
+			if formatter != nil {
+				body = formatter(res)
+			} else {
+				body = NewTransferMemberInternalResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.ErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
 		default:
 			return encodeError(ctx, w, v)
 		}
@@ -550,7 +855,7 @@ func EncodeGetGuildMarketsError(encoder func(context.Context, http.ResponseWrite
 		}
 		switch en.ErrorName() {
 		case "not_found":
-			var res *goa.ServiceError
+			var res *guildsservice.APIError
 			errors.As(v, &res)
 			enc := encoder(ctx, w)
 			var body interface{}
@@ -563,7 +868,7 @@ func EncodeGetGuildMarketsError(encoder func(context.Context, http.ResponseWrite
 			w.WriteHeader(http.StatusNotFound)
 			return enc.Encode(body)
 		case "internal":
-			var res *goa.ServiceError
+			var res *guildsservice.APIError
 			errors.As(v, &res)
 			enc := encoder(ctx, w)
 			var body interface{}
@@ -575,6 +880,19 @@ func EncodeGetGuildMarketsError(encoder func(context.Context, http.ResponseWrite
 			w.Header().Set("goa-error", res.ErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
 			return enc.Encode(body)
+		case "invalid_arg":
+			var res *guildsservice.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body interface{}
+			if formatter != nil {
+				body = formatter(res)
+			} else {
+				body = NewGetGuildMarketsInvalidArgResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.ErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
 		default:
 			return encodeError(ctx, w, v)
 		}
@@ -646,7 +964,7 @@ func EncodeGetGuildPortfoliosError(encoder func(context.Context, http.ResponseWr
 		}
 		switch en.ErrorName() {
 		case "not_found":
-			var res *goa.ServiceError
+			var res *guildsservice.APIError
 			errors.As(v, &res)
 			enc := encoder(ctx, w)
 			var body interface{}
@@ -659,7 +977,7 @@ func EncodeGetGuildPortfoliosError(encoder func(context.Context, http.ResponseWr
 			w.WriteHeader(http.StatusNotFound)
 			return enc.Encode(body)
 		case "internal":
-			var res *goa.ServiceError
+			var res *guildsservice.APIError
 			errors.As(v, &res)
 			enc := encoder(ctx, w)
 			var body interface{}
@@ -671,6 +989,19 @@ func EncodeGetGuildPortfoliosError(encoder func(context.Context, http.ResponseWr
 			w.Header().Set("goa-error", res.ErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
 			return enc.Encode(body)
+		case "invalid_arg":
+			var res *guildsservice.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body interface{}
+			if formatter != nil {
+				body = formatter(res)
+			} else {
+				body = NewGetGuildPortfoliosInvalidArgResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.ErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
 		default:
 			return encodeError(ctx, w, v)
 		}
@@ -716,7 +1047,7 @@ func EncodeGetAccountInfoError(encoder func(context.Context, http.ResponseWriter
 		}
 		switch en.ErrorName() {
 		case "not_found":
-			var res *goa.ServiceError
+			var res *guildsservice.APIError
 			errors.As(v, &res)
 			enc := encoder(ctx, w)
 			var body interface{}
@@ -729,7 +1060,7 @@ func EncodeGetAccountInfoError(encoder func(context.Context, http.ResponseWriter
 			w.WriteHeader(http.StatusNotFound)
 			return enc.Encode(body)
 		case "internal":
-			var res *goa.ServiceError
+			var res *guildsservice.APIError
 			errors.As(v, &res)
 			enc := encoder(ctx, w)
 			var body interface{}
@@ -741,6 +1072,19 @@ func EncodeGetAccountInfoError(encoder func(context.Context, http.ResponseWriter
 			w.Header().Set("goa-error", res.ErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
 			return enc.Encode(body)
+		case "invalid_arg":
+			var res *guildsservice.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body interface{}
+			if formatter != nil {
+				body = formatter(res)
+			} else {
+				body = NewGetAccountInfoInvalidArgResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.ErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
 		default:
 			return encodeError(ctx, w, v)
 		}
@@ -786,7 +1130,7 @@ func EncodeGetAccountPortfolioError(encoder func(context.Context, http.ResponseW
 		}
 		switch en.ErrorName() {
 		case "not_found":
-			var res *goa.ServiceError
+			var res *guildsservice.APIError
 			errors.As(v, &res)
 			enc := encoder(ctx, w)
 			var body interface{}
@@ -799,7 +1143,7 @@ func EncodeGetAccountPortfolioError(encoder func(context.Context, http.ResponseW
 			w.WriteHeader(http.StatusNotFound)
 			return enc.Encode(body)
 		case "internal":
-			var res *goa.ServiceError
+			var res *guildsservice.APIError
 			errors.As(v, &res)
 			enc := encoder(ctx, w)
 			var body interface{}
@@ -811,14 +1155,27 @@ func EncodeGetAccountPortfolioError(encoder func(context.Context, http.ResponseW
 			w.Header().Set("goa-error", res.ErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
 			return enc.Encode(body)
-		default:
-			return encodeError(ctx, w, v)
-		}
-	}
-}
-
-// EncodeGetAccountPortfoliosResponse returns an encoder for responses returned
-// by the GuildsService GetAccountPortfolios endpoint.
+		case "invalid_arg":
+			var res *guildsservice.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body interface{}
+			if formatter != nil {
+				body = formatter(res)
+			} else {
+				body = NewGetAccountPortfolioInvalidArgResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.ErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetAccountPortfoliosResponse returns an encoder for responses returned
+// by the GuildsService GetAccountPortfolios endpoint.
 func EncodeGetAccountPortfoliosResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, interface{}) error {
 	return func(ctx context.Context, w http.ResponseWriter, v interface{}) error {
 		res, _ := v.(*guildsservice.GetAccountPortfoliosResult)
@@ -829,14 +1186,851 @@ func EncodeGetAccountPortfoliosResponse(encoder func(context.Context, http.Respo
 	}
 }
 
-// DecodeGetAccountPortfoliosRequest returns a decoder for requests sent to the
-// GuildsService GetAccountPortfolios endpoint.
-func DecodeGetAccountPortfoliosRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (interface{}, error) {
+// DecodeGetAccountPortfoliosRequest returns a decoder for requests sent to the
+// GuildsService GetAccountPortfolios endpoint.
+func DecodeGetAccountPortfoliosRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (interface{}, error) {
+	return func(r *http.Request) (interface{}, error) {
+		var (
+			injectiveAddress string
+			startTime        *int64
+			endTime          *int64
+			guildID          *string
+			err              error
+
+			params = mux.Vars(r)
+		)
+		injectiveAddress = params["injective_address"]
+		{
+			startTimeRaw := r.URL.Query().Get("start_time")
+			if startTimeRaw != "" {
+				v, err2 := strconv.ParseInt(startTimeRaw, 10, 64)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("startTime", startTimeRaw, "integer"))
+				}
+				startTime = &v
+			}
+		}
+		{
+			endTimeRaw := r.URL.Query().Get("end_time")
+			if endTimeRaw != "" {
+				v, err2 := strconv.ParseInt(endTimeRaw, 10, 64)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("endTime", endTimeRaw, "integer"))
+				}
+				endTime = &v
+			}
+		}
+		guildIDRaw := r.URL.Query().Get("guild_id")
+		if guildIDRaw != "" {
+			guildID = &guildIDRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetAccountPortfoliosPayload(injectiveAddress, startTime, endTime, guildID)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetAccountPortfoliosError returns an encoder for errors returned by
+// the GetAccountPortfolios GuildsService endpoint.
+func EncodeGetAccountPortfoliosError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en ErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.ErrorName() {
+		case "invalid_arg":
+			var res *guildsservice.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body interface{}
+			if formatter != nil {
+				body = formatter(res)
+			} else {
+				body = NewGetAccountPortfoliosInvalidArgResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.ErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res *guildsservice.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body interface{}
+			if formatter != nil {
+				body = formatter(res)
+			} else {
+				body = NewGetAccountPortfoliosNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.ErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "internal":
+			var res *guildsservice.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body interface{}
+			if formatter != nil {
+				body = formatter(res)
+			} else {
+				body = NewGetAccountPortfoliosInternalResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.ErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetAccountMembershipsResponse returns an encoder for responses
+// returned by the GuildsService GetAccountMemberships endpoint.
+func EncodeGetAccountMembershipsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, interface{}) error {
+	return func(ctx context.Context, w http.ResponseWriter, v interface{}) error {
+		res, _ := v.(*guildsservice.GetAccountMembershipsResult)
+		enc := encoder(ctx, w)
+		body := NewGetAccountMembershipsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetAccountMembershipsRequest returns a decoder for requests sent to
+// the GuildsService GetAccountMemberships endpoint.
+func DecodeGetAccountMembershipsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (interface{}, error) {
+	return func(r *http.Request) (interface{}, error) {
+		var (
+			injectiveAddress string
+
+			params = mux.Vars(r)
+		)
+		injectiveAddress = params["injective_address"]
+		payload := NewGetAccountMembershipsPayload(injectiveAddress)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetAccountMembershipsError returns an encoder for errors returned by
+// the GetAccountMemberships GuildsService endpoint.
+func EncodeGetAccountMembershipsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en ErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.ErrorName() {
+		case "invalid_arg":
+			var res *guildsservice.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body interface{}
+			if formatter != nil {
+				body = formatter(res)
+			} else {
+				body = NewGetAccountMembershipsInvalidArgResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.ErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "internal":
+			var res *guildsservice.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body interface{}
+			if formatter != nil {
+				body = formatter(res)
+			} else {
+				body = NewGetAccountMembershipsInternalResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.ErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "not_found":
+			var res *guildsservice.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body interface{}
+			if formatter != nil {
+				body = formatter(res)
+			} else {
+				body = NewGetAccountMembershipsNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.ErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetAccountMonthlyPortfoliosResponse returns an encoder for responses
+// returned by the GuildsService GetAccountMonthlyPortfolios endpoint.
+func EncodeGetAccountMonthlyPortfoliosResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, interface{}) error {
+	return func(ctx context.Context, w http.ResponseWriter, v interface{}) error {
+		res, _ := v.(*guildsservice.GetAccountMonthlyPortfoliosResult)
+		enc := encoder(ctx, w)
+		body := NewGetAccountMonthlyPortfoliosResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetAccountMonthlyPortfoliosRequest returns a decoder for requests sent
+// to the GuildsService GetAccountMonthlyPortfolios endpoint.
+func DecodeGetAccountMonthlyPortfoliosRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (interface{}, error) {
+	return func(r *http.Request) (interface{}, error) {
+		var (
+			injectiveAddress string
+			startTime        *int64
+			endTime          *int64
+			err              error
+
+			params = mux.Vars(r)
+		)
+		injectiveAddress = params["injective_address"]
+		{
+			startTimeRaw := r.URL.Query().Get("start_time")
+			if startTimeRaw != "" {
+				v, err2 := strconv.ParseInt(startTimeRaw, 10, 64)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("startTime", startTimeRaw, "integer"))
+				}
+				startTime = &v
+			}
+		}
+		{
+			endTimeRaw := r.URL.Query().Get("end_time")
+			if endTimeRaw != "" {
+				v, err2 := strconv.ParseInt(endTimeRaw, 10, 64)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("endTime", endTimeRaw, "integer"))
+				}
+				endTime = &v
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetAccountMonthlyPortfoliosPayload(injectiveAddress, startTime, endTime)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetAccountMonthlyPortfoliosError returns an encoder for errors
+// returned by the GetAccountMonthlyPortfolios GuildsService endpoint.
+func EncodeGetAccountMonthlyPortfoliosError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en ErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.ErrorName() {
+		case "not_found":
+			var res *guildsservice.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body interface{}
+			if formatter != nil {
+				body = formatter(res)
+			} else {
+				body = NewGetAccountMonthlyPortfoliosNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.ErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "internal":
+			var res *guildsservice.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body interface{}
+			if formatter != nil {
+				body = formatter(res)
+			} else {
+				body = NewGetAccountMonthlyPortfoliosInternalResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.ErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "invalid_arg":
+			var res *guildsservice.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body interface{}
+			if formatter != nil {
+				body = formatter(res)
+			} else {
+				body = NewGetAccountMonthlyPortfoliosInvalidArgResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.ErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetAccountGrantsResponse returns an encoder for responses returned by
+// the GuildsService GetAccountGrants endpoint.
+func EncodeGetAccountGrantsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, interface{}) error {
+	return func(ctx context.Context, w http.ResponseWriter, v interface{}) error {
+		res, _ := v.(*guildsservice.GetAccountGrantsResult)
+		enc := encoder(ctx, w)
+		body := NewGetAccountGrantsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetAccountGrantsRequest returns a decoder for requests sent to the
+// GuildsService GetAccountGrants endpoint.
+func DecodeGetAccountGrantsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (interface{}, error) {
+	return func(r *http.Request) (interface{}, error) {
+		var (
+			injectiveAddress string
+
+			params = mux.Vars(r)
+		)
+		injectiveAddress = params["injective_address"]
+		payload := NewGetAccountGrantsPayload(injectiveAddress)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetAccountGrantsError returns an encoder for errors returned by the
+// GetAccountGrants GuildsService endpoint.
+func EncodeGetAccountGrantsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en ErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.ErrorName() {
+		case "not_found":
+			var res *guildsservice.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body interface{}
+			if formatter != nil {
+				body = formatter(res)
+			} else {
+				body = NewGetAccountGrantsNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.ErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "invalid_arg":
+			var res *guildsservice.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body interface{}
+			if formatter != nil {
+				body = formatter(res)
+			} else {
+				body = NewGetAccountGrantsInvalidArgResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.ErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "internal":
+			var res *guildsservice.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body interface{}
+			if formatter != nil {
+				body = formatter(res)
+			} else {
+				body = NewGetAccountGrantsInternalResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.ErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetAccountTradesResponse returns an encoder for responses returned by
+// the GuildsService GetAccountTrades endpoint.
+func EncodeGetAccountTradesResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, interface{}) error {
+	return func(ctx context.Context, w http.ResponseWriter, v interface{}) error {
+		res, _ := v.(*guildsservice.GetAccountTradesResult)
+		enc := encoder(ctx, w)
+		body := NewGetAccountTradesResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetAccountTradesRequest returns a decoder for requests sent to the
+// GuildsService GetAccountTrades endpoint.
+func DecodeGetAccountTradesRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (interface{}, error) {
+	return func(r *http.Request) (interface{}, error) {
+		var (
+			injectiveAddress string
+			startTime        *int64
+			endTime          *int64
+			limit            *int64
+			err              error
+
+			params = mux.Vars(r)
+		)
+		injectiveAddress = params["injective_address"]
+		{
+			startTimeRaw := r.URL.Query().Get("start_time")
+			if startTimeRaw != "" {
+				v, err2 := strconv.ParseInt(startTimeRaw, 10, 64)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("startTime", startTimeRaw, "integer"))
+				}
+				startTime = &v
+			}
+		}
+		{
+			endTimeRaw := r.URL.Query().Get("end_time")
+			if endTimeRaw != "" {
+				v, err2 := strconv.ParseInt(endTimeRaw, 10, 64)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("endTime", endTimeRaw, "integer"))
+				}
+				endTime = &v
+			}
+		}
+		{
+			limitRaw := r.URL.Query().Get("limit")
+			if limitRaw != "" {
+				v, err2 := strconv.ParseInt(limitRaw, 10, 64)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("limit", limitRaw, "integer"))
+				}
+				limit = &v
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetAccountTradesPayload(injectiveAddress, startTime, endTime, limit)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetAccountTradesError returns an encoder for errors returned by the
+// GetAccountTrades GuildsService endpoint.
+func EncodeGetAccountTradesError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en ErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.ErrorName() {
+		case "invalid_arg":
+			var res *guildsservice.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body interface{}
+			if formatter != nil {
+				body = formatter(res)
+			} else {
+				body = NewGetAccountTradesInvalidArgResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.ErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "internal":
+			var res *guildsservice.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body interface{}
+			if formatter != nil {
+				body = formatter(res)
+			} else {
+				body = NewGetAccountTradesInternalResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.ErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "not_found":
+			var res *guildsservice.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body interface{}
+			if formatter != nil {
+				body = formatter(res)
+			} else {
+				body = NewGetAccountTradesNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.ErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetAccountTradeStatsResponse returns an encoder for responses returned
+// by the GuildsService GetAccountTradeStats endpoint.
+func EncodeGetAccountTradeStatsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, interface{}) error {
+	return func(ctx context.Context, w http.ResponseWriter, v interface{}) error {
+		res, _ := v.(*guildsservice.GetAccountTradeStatsResult)
+		enc := encoder(ctx, w)
+		body := NewGetAccountTradeStatsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetAccountTradeStatsRequest returns a decoder for requests sent to the
+// GuildsService GetAccountTradeStats endpoint.
+func DecodeGetAccountTradeStatsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (interface{}, error) {
+	return func(r *http.Request) (interface{}, error) {
+		var (
+			injectiveAddress string
+			startTime        *int64
+			endTime          *int64
+			err              error
+
+			params = mux.Vars(r)
+		)
+		injectiveAddress = params["injective_address"]
+		{
+			startTimeRaw := r.URL.Query().Get("start_time")
+			if startTimeRaw != "" {
+				v, err2 := strconv.ParseInt(startTimeRaw, 10, 64)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("startTime", startTimeRaw, "integer"))
+				}
+				startTime = &v
+			}
+		}
+		{
+			endTimeRaw := r.URL.Query().Get("end_time")
+			if endTimeRaw != "" {
+				v, err2 := strconv.ParseInt(endTimeRaw, 10, 64)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("endTime", endTimeRaw, "integer"))
+				}
+				endTime = &v
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetAccountTradeStatsPayload(injectiveAddress, startTime, endTime)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetAccountTradeStatsError returns an encoder for errors returned by
+// the GetAccountTradeStats GuildsService endpoint.
+func EncodeGetAccountTradeStatsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en ErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.ErrorName() {
+		case "invalid_arg":
+			var res *guildsservice.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body interface{}
+			if formatter != nil {
+				body = formatter(res)
+			} else {
+				body = NewGetAccountTradeStatsInvalidArgResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.ErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "internal":
+			var res *guildsservice.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body interface{}
+			if formatter != nil {
+				body = formatter(res)
+			} else {
+				body = NewGetAccountTradeStatsInternalResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.ErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "not_found":
+			var res *guildsservice.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body interface{}
+			if formatter != nil {
+				body = formatter(res)
+			} else {
+				body = NewGetAccountTradeStatsNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.ErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetGuildTradeStatsResponse returns an encoder for responses returned
+// by the GuildsService GetGuildTradeStats endpoint.
+func EncodeGetGuildTradeStatsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, interface{}) error {
+	return func(ctx context.Context, w http.ResponseWriter, v interface{}) error {
+		res, _ := v.(*guildsservice.GetGuildTradeStatsResult)
+		enc := encoder(ctx, w)
+		body := NewGetGuildTradeStatsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetGuildTradeStatsRequest returns a decoder for requests sent to the
+// GuildsService GetGuildTradeStats endpoint.
+func DecodeGetGuildTradeStatsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (interface{}, error) {
+	return func(r *http.Request) (interface{}, error) {
+		var (
+			guildID   string
+			startTime *int64
+			endTime   *int64
+			err       error
+
+			params = mux.Vars(r)
+		)
+		guildID = params["guildID"]
+		{
+			startTimeRaw := r.URL.Query().Get("start_time")
+			if startTimeRaw != "" {
+				v, err2 := strconv.ParseInt(startTimeRaw, 10, 64)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("startTime", startTimeRaw, "integer"))
+				}
+				startTime = &v
+			}
+		}
+		{
+			endTimeRaw := r.URL.Query().Get("end_time")
+			if endTimeRaw != "" {
+				v, err2 := strconv.ParseInt(endTimeRaw, 10, 64)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("endTime", endTimeRaw, "integer"))
+				}
+				endTime = &v
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetGuildTradeStatsPayload(guildID, startTime, endTime)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetGuildTradeStatsError returns an encoder for errors returned by the
+// GetGuildTradeStats GuildsService endpoint.
+func EncodeGetGuildTradeStatsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en ErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.ErrorName() {
+		case "invalid_arg":
+			var res *guildsservice.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body interface{}
+			if formatter != nil {
+				body = formatter(res)
+			} else {
+				body = NewGetGuildTradeStatsInvalidArgResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.ErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res *guildsservice.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body interface{}
+			if formatter != nil {
+				body = formatter(res)
+			} else {
+				body = NewGetGuildTradeStatsNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.ErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "internal":
+			var res *guildsservice.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body interface{}
+			if formatter != nil {
+				body = formatter(res)
+			} else {
+				body = NewGetGuildTradeStatsInternalResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.ErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetGuildFeesResponse returns an encoder for responses returned by the
+// GuildsService GetGuildFees endpoint.
+func EncodeGetGuildFeesResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, interface{}) error {
+	return func(ctx context.Context, w http.ResponseWriter, v interface{}) error {
+		res, _ := v.(*guildsservice.GetGuildFeesResult)
+		enc := encoder(ctx, w)
+		body := NewGetGuildFeesResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetGuildFeesRequest returns a decoder for requests sent to the
+// GuildsService GetGuildFees endpoint.
+func DecodeGetGuildFeesRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (interface{}, error) {
+	return func(r *http.Request) (interface{}, error) {
+		var (
+			guildID   string
+			startTime *int64
+			endTime   *int64
+			err       error
+
+			params = mux.Vars(r)
+		)
+		guildID = params["guildID"]
+		{
+			startTimeRaw := r.URL.Query().Get("start_time")
+			if startTimeRaw != "" {
+				v, err2 := strconv.ParseInt(startTimeRaw, 10, 64)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("startTime", startTimeRaw, "integer"))
+				}
+				startTime = &v
+			}
+		}
+		{
+			endTimeRaw := r.URL.Query().Get("end_time")
+			if endTimeRaw != "" {
+				v, err2 := strconv.ParseInt(endTimeRaw, 10, 64)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("endTime", endTimeRaw, "integer"))
+				}
+				endTime = &v
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetGuildFeesPayload(guildID, startTime, endTime)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetGuildFeesError returns an encoder for errors returned by the
+// GetGuildFees GuildsService endpoint.
+func EncodeGetGuildFeesError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en ErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.ErrorName() {
+		case "invalid_arg":
+			var res *guildsservice.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body interface{}
+			if formatter != nil {
+				body = formatter(res)
+			} else {
+				body = NewGetGuildFeesInvalidArgResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.ErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res *guildsservice.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body interface{}
+			if formatter != nil {
+				body = formatter(res)
+			} else {
+				body = NewGetGuildFeesNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.ErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "internal":
+			var res *guildsservice.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body interface{}
+			if formatter != nil {
+				body = formatter(res)
+			} else {
+				body = NewGetGuildFeesInternalResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.ErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetAccountTransfersResponse returns an encoder for responses returned
+// by the GuildsService GetAccountTransfers endpoint.
+func EncodeGetAccountTransfersResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, interface{}) error {
+	return func(ctx context.Context, w http.ResponseWriter, v interface{}) error {
+		res, _ := v.(*guildsservice.GetAccountTransfersResult)
+		enc := encoder(ctx, w)
+		body := NewGetAccountTransfersResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetAccountTransfersRequest returns a decoder for requests sent to the
+// GuildsService GetAccountTransfers endpoint.
+func DecodeGetAccountTransfersRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (interface{}, error) {
 	return func(r *http.Request) (interface{}, error) {
 		var (
 			injectiveAddress string
 			startTime        *int64
 			endTime          *int64
+			limit            *int64
 			err              error
 
 			params = mux.Vars(r)
@@ -862,18 +2056,28 @@ func DecodeGetAccountPortfoliosRequest(mux goahttp.Muxer, decoder func(*http.Req
 				endTime = &v
 			}
 		}
+		{
+			limitRaw := r.URL.Query().Get("limit")
+			if limitRaw != "" {
+				v, err2 := strconv.ParseInt(limitRaw, 10, 64)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("limit", limitRaw, "integer"))
+				}
+				limit = &v
+			}
+		}
 		if err != nil {
 			return nil, err
 		}
-		payload := NewGetAccountPortfoliosPayload(injectiveAddress, startTime, endTime)
+		payload := NewGetAccountTransfersPayload(injectiveAddress, startTime, endTime, limit)
 
 		return payload, nil
 	}
 }
 
-// EncodeGetAccountPortfoliosError returns an encoder for errors returned by
-// the GetAccountPortfolios GuildsService endpoint.
-func EncodeGetAccountPortfoliosError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeGetAccountTransfersError returns an encoder for errors returned by the
+// GetAccountTransfers GuildsService endpoint.
+func EncodeGetAccountTransfersError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en ErrorNamer
@@ -881,95 +2085,165 @@ func EncodeGetAccountPortfoliosError(encoder func(context.Context, http.Response
 			return encodeError(ctx, w, v)
 		}
 		switch en.ErrorName() {
-		case "not_found":
-			var res *goa.ServiceError
+		case "invalid_arg":
+			var res *guildsservice.APIError
 			errors.As(v, &res)
 			enc := encoder(ctx, w)
 			var body interface{}
 			if formatter != nil {
 				body = formatter(res)
 			} else {
-				body = NewGetAccountPortfoliosNotFoundResponseBody(res)
+				body = NewGetAccountTransfersInvalidArgResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.ErrorName())
-			w.WriteHeader(http.StatusNotFound)
+			w.WriteHeader(http.StatusBadRequest)
 			return enc.Encode(body)
 		case "internal":
-			var res *goa.ServiceError
+			var res *guildsservice.APIError
 			errors.As(v, &res)
 			enc := encoder(ctx, w)
 			var body interface{}
 			if formatter != nil {
 				body = formatter(res)
 			} else {
-				body = NewGetAccountPortfoliosInternalResponseBody(res)
+				body = NewGetAccountTransfersInternalResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.ErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
 			return enc.Encode(body)
+		case "not_found":
+			var res *guildsservice.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body interface{}
+			if formatter != nil {
+				body = formatter(res)
+			} else {
+				body = NewGetAccountTransfersNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.ErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
 		default:
 			return encodeError(ctx, w, v)
 		}
 	}
 }
 
-// EncodeGetAccountMonthlyPortfoliosResponse returns an encoder for responses
-// returned by the GuildsService GetAccountMonthlyPortfolios endpoint.
-func EncodeGetAccountMonthlyPortfoliosResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, interface{}) error {
+// EncodeGetAccountPositionsResponse returns an encoder for responses returned
+// by the GuildsService GetAccountPositions endpoint.
+func EncodeGetAccountPositionsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, interface{}) error {
 	return func(ctx context.Context, w http.ResponseWriter, v interface{}) error {
-		res, _ := v.(*guildsservice.GetAccountMonthlyPortfoliosResult)
+		res, _ := v.(*guildsservice.GetAccountPositionsResult)
 		enc := encoder(ctx, w)
-		body := NewGetAccountMonthlyPortfoliosResponseBody(res)
+		body := NewGetAccountPositionsResponseBody(res)
 		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeGetAccountMonthlyPortfoliosRequest returns a decoder for requests sent
-// to the GuildsService GetAccountMonthlyPortfolios endpoint.
-func DecodeGetAccountMonthlyPortfoliosRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (interface{}, error) {
+// DecodeGetAccountPositionsRequest returns a decoder for requests sent to the
+// GuildsService GetAccountPositions endpoint.
+func DecodeGetAccountPositionsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (interface{}, error) {
 	return func(r *http.Request) (interface{}, error) {
 		var (
 			injectiveAddress string
-			startTime        *int64
-			endTime          *int64
-			err              error
 
 			params = mux.Vars(r)
 		)
 		injectiveAddress = params["injective_address"]
-		{
-			startTimeRaw := r.URL.Query().Get("start_time")
-			if startTimeRaw != "" {
-				v, err2 := strconv.ParseInt(startTimeRaw, 10, 64)
-				if err2 != nil {
-					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("startTime", startTimeRaw, "integer"))
-				}
-				startTime = &v
-			}
+		payload := NewGetAccountPositionsPayload(injectiveAddress)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetAccountPositionsError returns an encoder for errors returned by the
+// GetAccountPositions GuildsService endpoint.
+func EncodeGetAccountPositionsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en ErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
 		}
-		{
-			endTimeRaw := r.URL.Query().Get("end_time")
-			if endTimeRaw != "" {
-				v, err2 := strconv.ParseInt(endTimeRaw, 10, 64)
-				if err2 != nil {
-					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("endTime", endTimeRaw, "integer"))
-				}
-				endTime = &v
+		switch en.ErrorName() {
+		case "invalid_arg":
+			var res *guildsservice.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body interface{}
+			if formatter != nil {
+				body = formatter(res)
+			} else {
+				body = NewGetAccountPositionsInvalidArgResponseBody(res)
 			}
+			w.Header().Set("goa-error", res.ErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res *guildsservice.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body interface{}
+			if formatter != nil {
+				body = formatter(res)
+			} else {
+				body = NewGetAccountPositionsNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.ErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "internal":
+			var res *guildsservice.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body interface{}
+			if formatter != nil {
+				body = formatter(res)
+			} else {
+				body = NewGetAccountPositionsInternalResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.ErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
 		}
-		if err != nil {
-			return nil, err
-		}
-		payload := NewGetAccountMonthlyPortfoliosPayload(injectiveAddress, startTime, endTime)
+	}
+}
+
+// EncodeGetGuildRiskResponse returns an encoder for responses returned by the
+// GuildsService GetGuildRisk endpoint.
+func EncodeGetGuildRiskResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, interface{}) error {
+	return func(ctx context.Context, w http.ResponseWriter, v interface{}) error {
+		res, _ := v.(*guildsservice.GetGuildRiskResult)
+		enc := encoder(ctx, w)
+		body := NewGetGuildRiskResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetGuildRiskRequest returns a decoder for requests sent to the
+// GuildsService GetGuildRisk endpoint.
+func DecodeGetGuildRiskRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (interface{}, error) {
+	return func(r *http.Request) (interface{}, error) {
+		var (
+			guildID string
+
+			params = mux.Vars(r)
+		)
+		guildID = params["guildID"]
+		payload := NewGetGuildRiskPayload(guildID)
 
 		return payload, nil
 	}
 }
 
-// EncodeGetAccountMonthlyPortfoliosError returns an encoder for errors
-// returned by the GetAccountMonthlyPortfolios GuildsService endpoint.
-func EncodeGetAccountMonthlyPortfoliosError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeGetGuildRiskError returns an encoder for errors returned by the
+// GetGuildRisk GuildsService endpoint.
+func EncodeGetGuildRiskError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en ErrorNamer
@@ -977,28 +2251,41 @@ func EncodeGetAccountMonthlyPortfoliosError(encoder func(context.Context, http.R
 			return encodeError(ctx, w, v)
 		}
 		switch en.ErrorName() {
+		case "invalid_arg":
+			var res *guildsservice.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body interface{}
+			if formatter != nil {
+				body = formatter(res)
+			} else {
+				body = NewGetGuildRiskInvalidArgResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.ErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
 		case "not_found":
-			var res *goa.ServiceError
+			var res *guildsservice.APIError
 			errors.As(v, &res)
 			enc := encoder(ctx, w)
 			var body interface{}
 			if formatter != nil {
 				body = formatter(res)
 			} else {
-				body = NewGetAccountMonthlyPortfoliosNotFoundResponseBody(res)
+				body = NewGetGuildRiskNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.ErrorName())
 			w.WriteHeader(http.StatusNotFound)
 			return enc.Encode(body)
 		case "internal":
-			var res *goa.ServiceError
+			var res *guildsservice.APIError
 			errors.As(v, &res)
 			enc := encoder(ctx, w)
 			var body interface{}
 			if formatter != nil {
 				body = formatter(res)
 			} else {
-				body = NewGetAccountMonthlyPortfoliosInternalResponseBody(res)
+				body = NewGetGuildRiskInternalResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.ErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
@@ -1024,6 +2311,9 @@ func marshalGuildsserviceGuildToGuildResponseBody(v *guildsservice.Guild) *Guild
 		Capacity:             v.Capacity,
 		MemberCount:          v.MemberCount,
 		DefaultMemberAddress: v.DefaultMemberAddress,
+		HasInactiveMarkets:   v.HasInactiveMarkets,
+		Archived:             v.Archived,
+		AccessMode:           v.AccessMode,
 	}
 	if v.Requirements != nil {
 		res.Requirements = make([]*RequirementResponseBody, len(v.Requirements))
@@ -1059,8 +2349,12 @@ func marshalGuildsserviceSingleGuildPortfolioToSingleGuildPortfolioResponseBody(
 		return nil
 	}
 	res := &SingleGuildPortfolioResponseBody{
-		GuildID:   v.GuildID,
-		UpdatedAt: v.UpdatedAt,
+		GuildID:    v.GuildID,
+		UpdatedAt:  v.UpdatedAt,
+		ValueUsd:   v.ValueUsd,
+		NetFlowUsd: v.NetFlowUsd,
+		Twr:        v.Twr,
+		Mwr:        v.Mwr,
 	}
 	if v.Balances != nil {
 		res.Balances = make([]*BalanceResponseBody, len(v.Balances))
@@ -1068,6 +2362,12 @@ func marshalGuildsserviceSingleGuildPortfolioToSingleGuildPortfolioResponseBody(
 			res.Balances[i] = marshalGuildsserviceBalanceToBalanceResponseBody(val)
 		}
 	}
+	if v.CashFlows != nil {
+		res.CashFlows = make([]*CashFlowResponseBody, len(v.CashFlows))
+		for i, val := range v.CashFlows {
+			res.CashFlows[i] = marshalGuildsserviceCashFlowToCashFlowResponseBody(val)
+		}
+	}
 
 	return res
 }
@@ -1087,6 +2387,23 @@ func marshalGuildsserviceBalanceToBalanceResponseBody(v *guildsservice.Balance)
 	return res
 }
 
+// marshalGuildsserviceCashFlowToCashFlowResponseBody builds a value of type
+// *CashFlowResponseBody from a value of type *guildsservice.CashFlow.
+func marshalGuildsserviceCashFlowToCashFlowResponseBody(v *guildsservice.CashFlow) *CashFlowResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &CashFlowResponseBody{
+		Type:             v.Type,
+		InjectiveAddress: v.InjectiveAddress,
+		Denom:            v.Denom,
+		Amount:           v.Amount,
+		ExecutedAt:       v.ExecutedAt,
+	}
+
+	return res
+}
+
 // marshalGuildsserviceGuildMemberToGuildMemberResponseBody builds a value of
 // type *GuildMemberResponseBody from a value of type
 // *guildsservice.GuildMember.
@@ -1101,6 +2418,28 @@ func marshalGuildsserviceGuildMemberToGuildMemberResponseBody(v *guildsservice.G
 		GuildID:              v.GuildID,
 		Params:               v.Params,
 	}
+	if v.SubaccountIds != nil {
+		res.SubaccountIds = make([]string, len(v.SubaccountIds))
+		for i, val := range v.SubaccountIds {
+			res.SubaccountIds[i] = val
+		}
+	}
+
+	return res
+}
+
+// marshalGuildsserviceWaitlistEntryToWaitlistEntryResponseBody builds a value
+// of type *WaitlistEntryResponseBody from a value of type
+// *guildsservice.WaitlistEntry.
+func marshalGuildsserviceWaitlistEntryToWaitlistEntryResponseBody(v *guildsservice.WaitlistEntry) *WaitlistEntryResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &WaitlistEntryResponseBody{
+		InjectiveAddress: v.InjectiveAddress,
+		Position:         v.Position,
+		EnqueuedAt:       v.EnqueuedAt,
+	}
 
 	return res
 }
@@ -1112,8 +2451,28 @@ func marshalGuildsserviceMarketToMarketResponseBody(v *guildsservice.Market) *Ma
 		return nil
 	}
 	res := &MarketResponseBody{
-		MarketID:    v.MarketID,
-		IsPerpetual: v.IsPerpetual,
+		MarketID:     v.MarketID,
+		IsPerpetual:  v.IsPerpetual,
+		Status:       v.Status,
+		MakerFeeRate: v.MakerFeeRate,
+		TakerFeeRate: v.TakerFeeRate,
+	}
+
+	return res
+}
+
+// marshalGuildsserviceNetFlowToNetFlowResponseBody builds a value of type
+// *NetFlowResponseBody from a value of type *guildsservice.NetFlow.
+func marshalGuildsserviceNetFlowToNetFlowResponseBody(v *guildsservice.NetFlow) *NetFlowResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &NetFlowResponseBody{
+		Denom:         v.Denom,
+		Deposits:      v.Deposits,
+		Withdrawals:   v.Withdrawals,
+		NetFlow:       v.NetFlow,
+		TransferCount: v.TransferCount,
 	}
 
 	return res
@@ -1129,6 +2488,11 @@ func marshalGuildsserviceSingleAccountPortfolioToSingleAccountPortfolioResponseB
 	res := &SingleAccountPortfolioResponseBody{
 		InjectiveAddress: v.InjectiveAddress,
 		UpdatedAt:        v.UpdatedAt,
+		ValueUsd:         v.ValueUsd,
+		NetFlowUsd:       v.NetFlowUsd,
+		Twr:              v.Twr,
+		Mwr:              v.Mwr,
+		GuildID:          v.GuildID,
 	}
 	if v.Balances != nil {
 		res.Balances = make([]*BalanceResponseBody, len(v.Balances))
@@ -1136,6 +2500,28 @@ func marshalGuildsserviceSingleAccountPortfolioToSingleAccountPortfolioResponseB
 			res.Balances[i] = marshalGuildsserviceBalanceToBalanceResponseBody(val)
 		}
 	}
+	if v.CashFlows != nil {
+		res.CashFlows = make([]*CashFlowResponseBody, len(v.CashFlows))
+		for i, val := range v.CashFlows {
+			res.CashFlows[i] = marshalGuildsserviceCashFlowToCashFlowResponseBody(val)
+		}
+	}
+
+	return res
+}
+
+// marshalGuildsserviceMembershipToMembershipResponseBody builds a value of
+// type *MembershipResponseBody from a value of type *guildsservice.Membership.
+func marshalGuildsserviceMembershipToMembershipResponseBody(v *guildsservice.Membership) *MembershipResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &MembershipResponseBody{
+		GuildID:              v.GuildID,
+		IsDefaultGuildMember: v.IsDefaultGuildMember,
+		Since:                v.Since,
+		EndedAt:              v.EndedAt,
+	}
 
 	return res
 }
@@ -1159,3 +2545,199 @@ func marshalGuildsserviceMonthlyAccountPortfolioToMonthlyAccountPortfolioRespons
 
 	return res
 }
+
+// marshalGuildsserviceGrantToGrantResponseBody builds a value of type
+// *GrantResponseBody from a value of type *guildsservice.Grant.
+func marshalGuildsserviceGrantToGrantResponseBody(v *guildsservice.Grant) *GrantResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &GrantResponseBody{
+		Msg:        v.Msg,
+		IsGranted:  v.IsGranted,
+		Expiration: v.Expiration,
+	}
+
+	return res
+}
+
+// marshalGuildsserviceTradeToTradeResponseBody builds a value of type
+// *TradeResponseBody from a value of type *guildsservice.Trade.
+func marshalGuildsserviceTradeToTradeResponseBody(v *guildsservice.Trade) *TradeResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &TradeResponseBody{
+		TradeID:       v.TradeID,
+		SubaccountID:  v.SubaccountID,
+		MarketID:      v.MarketID,
+		IsPerpetual:   v.IsPerpetual,
+		Direction:     v.Direction,
+		ExecutionType: v.ExecutionType,
+		Price:         v.Price,
+		Quantity:      v.Quantity,
+		QuoteDenom:    v.QuoteDenom,
+		Fee:           v.Fee,
+		Volume:        v.Volume,
+		RealizedPnl:   v.RealizedPnl,
+		ExecutedAt:    v.ExecutedAt,
+	}
+
+	return res
+}
+
+// marshalGuildsserviceTradeStatsToTradeStatsResponseBody builds a value of
+// type *TradeStatsResponseBody from a value of type *guildsservice.TradeStats.
+func marshalGuildsserviceTradeStatsToTradeStatsResponseBody(v *guildsservice.TradeStats) *TradeStatsResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &TradeStatsResponseBody{
+		QuoteDenom:  v.QuoteDenom,
+		RealizedPnl: v.RealizedPnl,
+		Fees:        v.Fees,
+		Volume:      v.Volume,
+		TradeCount:  v.TradeCount,
+	}
+
+	return res
+}
+
+// marshalGuildsserviceGuildFeeTotalToGuildFeeTotalResponseBody builds a value
+// of type *GuildFeeTotalResponseBody from a value of type
+// *guildsservice.GuildFeeTotal.
+func marshalGuildsserviceGuildFeeTotalToGuildFeeTotalResponseBody(v *guildsservice.GuildFeeTotal) *GuildFeeTotalResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &GuildFeeTotalResponseBody{
+		QuoteDenom:     v.QuoteDenom,
+		FeesPaid:       v.FeesPaid,
+		MasterFees:     v.MasterFees,
+		Volume:         v.Volume,
+		TradeCount:     v.TradeCount,
+		UnresolvedFees: v.UnresolvedFees,
+	}
+
+	return res
+}
+
+// marshalGuildsserviceMemberFeeContributionToMemberFeeContributionResponseBody
+// builds a value of type *MemberFeeContributionResponseBody from a value of
+// type *guildsservice.MemberFeeContribution.
+func marshalGuildsserviceMemberFeeContributionToMemberFeeContributionResponseBody(v *guildsservice.MemberFeeContribution) *MemberFeeContributionResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &MemberFeeContributionResponseBody{
+		InjectiveAddress: v.InjectiveAddress,
+		QuoteDenom:       v.QuoteDenom,
+		FeesPaid:         v.FeesPaid,
+		MasterFees:       v.MasterFees,
+		Volume:           v.Volume,
+		TradeCount:       v.TradeCount,
+		Share:            v.Share,
+		UnresolvedFees:   v.UnresolvedFees,
+	}
+
+	return res
+}
+
+// marshalGuildsserviceDailyGuildFeeToDailyGuildFeeResponseBody builds a value
+// of type *DailyGuildFeeResponseBody from a value of type
+// *guildsservice.DailyGuildFee.
+func marshalGuildsserviceDailyGuildFeeToDailyGuildFeeResponseBody(v *guildsservice.DailyGuildFee) *DailyGuildFeeResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &DailyGuildFeeResponseBody{
+		Day:            v.Day,
+		QuoteDenom:     v.QuoteDenom,
+		FeesPaid:       v.FeesPaid,
+		MasterFees:     v.MasterFees,
+		Volume:         v.Volume,
+		TradeCount:     v.TradeCount,
+		UnresolvedFees: v.UnresolvedFees,
+	}
+
+	return res
+}
+
+// marshalGuildsserviceTransferToTransferResponseBody builds a value of type
+// *TransferResponseBody from a value of type *guildsservice.Transfer.
+func marshalGuildsserviceTransferToTransferResponseBody(v *guildsservice.Transfer) *TransferResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &TransferResponseBody{
+		TransferID:   v.TransferID,
+		SubaccountID: v.SubaccountID,
+		TransferType: v.TransferType,
+		Denom:        v.Denom,
+		Amount:       v.Amount,
+		ExecutedAt:   v.ExecutedAt,
+	}
+
+	return res
+}
+
+// marshalGuildsservicePositionToPositionResponseBody builds a value of type
+// *PositionResponseBody from a value of type *guildsservice.Position.
+func marshalGuildsservicePositionToPositionResponseBody(v *guildsservice.Position) *PositionResponseBody {
+	res := &PositionResponseBody{
+		SubaccountID:     v.SubaccountID,
+		MarketID:         v.MarketID,
+		Ticker:           v.Ticker,
+		QuoteDenom:       v.QuoteDenom,
+		Direction:        v.Direction,
+		Quantity:         v.Quantity,
+		EntryPrice:       v.EntryPrice,
+		MarkPrice:        v.MarkPrice,
+		Margin:           v.Margin,
+		LiquidationPrice: v.LiquidationPrice,
+	}
+
+	return res
+}
+
+// marshalGuildsserviceGuildRiskToGuildRiskResponseBody builds a value of type
+// *GuildRiskResponseBody from a value of type *guildsservice.GuildRisk.
+func marshalGuildsserviceGuildRiskToGuildRiskResponseBody(v *guildsservice.GuildRisk) *GuildRiskResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &GuildRiskResponseBody{
+		GuildID:       v.GuildID,
+		WindowStart:   v.WindowStart,
+		SnapshotCount: v.SnapshotCount,
+		MaxDrawdown:   v.MaxDrawdown,
+		Volatility:    v.Volatility,
+		SharpeRatio:   v.SharpeRatio,
+		NotionalUsd:   v.NotionalUsd,
+		EquityUsd:     v.EquityUsd,
+		Leverage:      v.Leverage,
+		UpdatedAt:     v.UpdatedAt,
+	}
+	if v.Markets != nil {
+		res.Markets = make([]*MarketExposureResponseBody, len(v.Markets))
+		for i, val := range v.Markets {
+			res.Markets[i] = marshalGuildsserviceMarketExposureToMarketExposureResponseBody(val)
+		}
+	}
+
+	return res
+}
+
+// marshalGuildsserviceMarketExposureToMarketExposureResponseBody builds a
+// value of type *MarketExposureResponseBody from a value of type
+// *guildsservice.MarketExposure.
+func marshalGuildsserviceMarketExposureToMarketExposureResponseBody(v *guildsservice.MarketExposure) *MarketExposureResponseBody {
+	res := &MarketExposureResponseBody{
+		MarketID:    v.MarketID,
+		Ticker:      v.Ticker,
+		NotionalUsd: v.NotionalUsd,
+		Share:       v.Share,
+	}
+
+	return res
+}
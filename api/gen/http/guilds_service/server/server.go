@@ -26,13 +26,24 @@ type Server struct {
 	GetGuildMasterAddress       http.Handler
 	GetGuildDefaultMember       http.Handler
 	EnterGuild                  http.Handler
+	GetGuildWaitlist            http.Handler
 	LeaveGuild                  http.Handler
+	TransferMember              http.Handler
 	GetGuildMarkets             http.Handler
 	GetGuildPortfolios          http.Handler
 	GetAccountInfo              http.Handler
 	GetAccountPortfolio         http.Handler
 	GetAccountPortfolios        http.Handler
+	GetAccountMemberships       http.Handler
 	GetAccountMonthlyPortfolios http.Handler
+	GetAccountGrants            http.Handler
+	GetAccountTrades            http.Handler
+	GetAccountTradeStats        http.Handler
+	GetGuildTradeStats          http.Handler
+	GetGuildFees                http.Handler
+	GetAccountTransfers         http.Handler
+	GetAccountPositions         http.Handler
+	GetGuildRisk                http.Handler
 	CORS                        http.Handler
 }
 
@@ -75,26 +86,48 @@ func New(
 			{"GetGuildMasterAddress", "GET", "/guilds/{guildID}/guild-master"},
 			{"GetGuildDefaultMember", "GET", "/guilds/{guildID}/default-member"},
 			{"EnterGuild", "POST", "/guilds/{guildID}/member"},
+			{"GetGuildWaitlist", "GET", "/guilds/{guildID}/waitlist"},
 			{"LeaveGuild", "DELETE", "/guilds/{guildID}/member/{injective_address}"},
+			{"TransferMember", "POST", "/members/{injective_address}/transfer"},
 			{"GetGuildMarkets", "GET", "/guilds/{guildID}/markets"},
 			{"GetGuildPortfolios", "GET", "/guilds/{guildID}/portfolios"},
 			{"GetAccountInfo", "GET", "/members/{injective_address}"},
 			{"GetAccountPortfolio", "GET", "/members/{injective_address}/portfolio"},
 			{"GetAccountPortfolios", "GET", "/members/{injective_address}/portfolios"},
+			{"GetAccountMemberships", "GET", "/members/{injective_address}/memberships"},
 			{"GetAccountMonthlyPortfolios", "GET", "/members/{injective_address}/monthly-portfolios"},
+			{"GetAccountGrants", "GET", "/members/{injective_address}/grants"},
+			{"GetAccountTrades", "GET", "/members/{injective_address}/trades"},
+			{"GetAccountTradeStats", "GET", "/members/{injective_address}/trade-stats"},
+			{"GetGuildTradeStats", "GET", "/guilds/{guildID}/trade-stats"},
+			{"GetGuildFees", "GET", "/guilds/{guildID}/fees"},
+			{"GetAccountTransfers", "GET", "/members/{injective_address}/transfers"},
+			{"GetAccountPositions", "GET", "/members/{injective_address}/positions"},
+			{"GetGuildRisk", "GET", "/guilds/{guildID}/risk"},
 			{"CORS", "OPTIONS", "/guilds"},
 			{"CORS", "OPTIONS", "/guilds/{guildID}"},
 			{"CORS", "OPTIONS", "/guilds/{guildID}/members"},
 			{"CORS", "OPTIONS", "/guilds/{guildID}/guild-master"},
 			{"CORS", "OPTIONS", "/guilds/{guildID}/default-member"},
 			{"CORS", "OPTIONS", "/guilds/{guildID}/member"},
+			{"CORS", "OPTIONS", "/guilds/{guildID}/waitlist"},
 			{"CORS", "OPTIONS", "/guilds/{guildID}/member/{injective_address}"},
+			{"CORS", "OPTIONS", "/members/{injective_address}/transfer"},
 			{"CORS", "OPTIONS", "/guilds/{guildID}/markets"},
 			{"CORS", "OPTIONS", "/guilds/{guildID}/portfolios"},
 			{"CORS", "OPTIONS", "/members/{injective_address}"},
 			{"CORS", "OPTIONS", "/members/{injective_address}/portfolio"},
 			{"CORS", "OPTIONS", "/members/{injective_address}/portfolios"},
+			{"CORS", "OPTIONS", "/members/{injective_address}/memberships"},
 			{"CORS", "OPTIONS", "/members/{injective_address}/monthly-portfolios"},
+			{"CORS", "OPTIONS", "/members/{injective_address}/grants"},
+			{"CORS", "OPTIONS", "/members/{injective_address}/trades"},
+			{"CORS", "OPTIONS", "/members/{injective_address}/trade-stats"},
+			{"CORS", "OPTIONS", "/guilds/{guildID}/trade-stats"},
+			{"CORS", "OPTIONS", "/guilds/{guildID}/fees"},
+			{"CORS", "OPTIONS", "/members/{injective_address}/transfers"},
+			{"CORS", "OPTIONS", "/members/{injective_address}/positions"},
+			{"CORS", "OPTIONS", "/guilds/{guildID}/risk"},
 		},
 		GetAllGuilds:                NewGetAllGuildsHandler(e.GetAllGuilds, mux, decoder, encoder, errhandler, formatter),
 		GetSingleGuild:              NewGetSingleGuildHandler(e.GetSingleGuild, mux, decoder, encoder, errhandler, formatter),
@@ -102,13 +135,24 @@ func New(
 		GetGuildMasterAddress:       NewGetGuildMasterAddressHandler(e.GetGuildMasterAddress, mux, decoder, encoder, errhandler, formatter),
 		GetGuildDefaultMember:       NewGetGuildDefaultMemberHandler(e.GetGuildDefaultMember, mux, decoder, encoder, errhandler, formatter),
 		EnterGuild:                  NewEnterGuildHandler(e.EnterGuild, mux, decoder, encoder, errhandler, formatter),
+		GetGuildWaitlist:            NewGetGuildWaitlistHandler(e.GetGuildWaitlist, mux, decoder, encoder, errhandler, formatter),
 		LeaveGuild:                  NewLeaveGuildHandler(e.LeaveGuild, mux, decoder, encoder, errhandler, formatter),
+		TransferMember:              NewTransferMemberHandler(e.TransferMember, mux, decoder, encoder, errhandler, formatter),
 		GetGuildMarkets:             NewGetGuildMarketsHandler(e.GetGuildMarkets, mux, decoder, encoder, errhandler, formatter),
 		GetGuildPortfolios:          NewGetGuildPortfoliosHandler(e.GetGuildPortfolios, mux, decoder, encoder, errhandler, formatter),
 		GetAccountInfo:              NewGetAccountInfoHandler(e.GetAccountInfo, mux, decoder, encoder, errhandler, formatter),
 		GetAccountPortfolio:         NewGetAccountPortfolioHandler(e.GetAccountPortfolio, mux, decoder, encoder, errhandler, formatter),
 		GetAccountPortfolios:        NewGetAccountPortfoliosHandler(e.GetAccountPortfolios, mux, decoder, encoder, errhandler, formatter),
+		GetAccountMemberships:       NewGetAccountMembershipsHandler(e.GetAccountMemberships, mux, decoder, encoder, errhandler, formatter),
 		GetAccountMonthlyPortfolios: NewGetAccountMonthlyPortfoliosHandler(e.GetAccountMonthlyPortfolios, mux, decoder, encoder, errhandler, formatter),
+		GetAccountGrants:            NewGetAccountGrantsHandler(e.GetAccountGrants, mux, decoder, encoder, errhandler, formatter),
+		GetAccountTrades:            NewGetAccountTradesHandler(e.GetAccountTrades, mux, decoder, encoder, errhandler, formatter),
+		GetAccountTradeStats:        NewGetAccountTradeStatsHandler(e.GetAccountTradeStats, mux, decoder, encoder, errhandler, formatter),
+		GetGuildTradeStats:          NewGetGuildTradeStatsHandler(e.GetGuildTradeStats, mux, decoder, encoder, errhandler, formatter),
+		GetGuildFees:                NewGetGuildFeesHandler(e.GetGuildFees, mux, decoder, encoder, errhandler, formatter),
+		GetAccountTransfers:         NewGetAccountTransfersHandler(e.GetAccountTransfers, mux, decoder, encoder, errhandler, formatter),
+		GetAccountPositions:         NewGetAccountPositionsHandler(e.GetAccountPositions, mux, decoder, encoder, errhandler, formatter),
+		GetGuildRisk:                NewGetGuildRiskHandler(e.GetGuildRisk, mux, decoder, encoder, errhandler, formatter),
 		CORS:                        NewCORSHandler(),
 	}
 }
@@ -124,13 +168,24 @@ func (s *Server) Use(m func(http.Handler) http.Handler) {
 	s.GetGuildMasterAddress = m(s.GetGuildMasterAddress)
 	s.GetGuildDefaultMember = m(s.GetGuildDefaultMember)
 	s.EnterGuild = m(s.EnterGuild)
+	s.GetGuildWaitlist = m(s.GetGuildWaitlist)
 	s.LeaveGuild = m(s.LeaveGuild)
+	s.TransferMember = m(s.TransferMember)
 	s.GetGuildMarkets = m(s.GetGuildMarkets)
 	s.GetGuildPortfolios = m(s.GetGuildPortfolios)
 	s.GetAccountInfo = m(s.GetAccountInfo)
 	s.GetAccountPortfolio = m(s.GetAccountPortfolio)
 	s.GetAccountPortfolios = m(s.GetAccountPortfolios)
+	s.GetAccountMemberships = m(s.GetAccountMemberships)
 	s.GetAccountMonthlyPortfolios = m(s.GetAccountMonthlyPortfolios)
+	s.GetAccountGrants = m(s.GetAccountGrants)
+	s.GetAccountTrades = m(s.GetAccountTrades)
+	s.GetAccountTradeStats = m(s.GetAccountTradeStats)
+	s.GetGuildTradeStats = m(s.GetGuildTradeStats)
+	s.GetGuildFees = m(s.GetGuildFees)
+	s.GetAccountTransfers = m(s.GetAccountTransfers)
+	s.GetAccountPositions = m(s.GetAccountPositions)
+	s.GetGuildRisk = m(s.GetGuildRisk)
 	s.CORS = m(s.CORS)
 }
 
@@ -142,13 +197,24 @@ func Mount(mux goahttp.Muxer, h *Server) {
 	MountGetGuildMasterAddressHandler(mux, h.GetGuildMasterAddress)
 	MountGetGuildDefaultMemberHandler(mux, h.GetGuildDefaultMember)
 	MountEnterGuildHandler(mux, h.EnterGuild)
+	MountGetGuildWaitlistHandler(mux, h.GetGuildWaitlist)
 	MountLeaveGuildHandler(mux, h.LeaveGuild)
+	MountTransferMemberHandler(mux, h.TransferMember)
 	MountGetGuildMarketsHandler(mux, h.GetGuildMarkets)
 	MountGetGuildPortfoliosHandler(mux, h.GetGuildPortfolios)
 	MountGetAccountInfoHandler(mux, h.GetAccountInfo)
 	MountGetAccountPortfolioHandler(mux, h.GetAccountPortfolio)
 	MountGetAccountPortfoliosHandler(mux, h.GetAccountPortfolios)
+	MountGetAccountMembershipsHandler(mux, h.GetAccountMemberships)
 	MountGetAccountMonthlyPortfoliosHandler(mux, h.GetAccountMonthlyPortfolios)
+	MountGetAccountGrantsHandler(mux, h.GetAccountGrants)
+	MountGetAccountTradesHandler(mux, h.GetAccountTrades)
+	MountGetAccountTradeStatsHandler(mux, h.GetAccountTradeStats)
+	MountGetGuildTradeStatsHandler(mux, h.GetGuildTradeStats)
+	MountGetGuildFeesHandler(mux, h.GetGuildFees)
+	MountGetAccountTransfersHandler(mux, h.GetAccountTransfers)
+	MountGetAccountPositionsHandler(mux, h.GetAccountPositions)
+	MountGetGuildRiskHandler(mux, h.GetGuildRisk)
 	MountCORSHandler(mux, h.CORS)
 }
 
@@ -180,6 +246,7 @@ func NewGetAllGuildsHandler(
 	formatter func(err error) goahttp.Statuser,
 ) http.Handler {
 	var (
+		decodeRequest  = DecodeGetAllGuildsRequest(mux, decoder)
 		encodeResponse = EncodeGetAllGuildsResponse(encoder)
 		encodeError    = EncodeGetAllGuildsError(encoder, formatter)
 	)
@@ -187,8 +254,14 @@ func NewGetAllGuildsHandler(
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
 		ctx = context.WithValue(ctx, goa.MethodKey, "GetAllGuilds")
 		ctx = context.WithValue(ctx, goa.ServiceKey, "GuildsService")
-		var err error
-		res, err := endpoint(ctx, nil)
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
 		if err != nil {
 			if err := encodeError(ctx, w, err); err != nil {
 				errhandler(ctx, w, err)
@@ -458,6 +531,57 @@ func NewEnterGuildHandler(
 	})
 }
 
+// MountGetGuildWaitlistHandler configures the mux to serve the "GuildsService"
+// service "GetGuildWaitlist" endpoint.
+func MountGetGuildWaitlistHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleGuildsServiceOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/guilds/{guildID}/waitlist", f)
+}
+
+// NewGetGuildWaitlistHandler creates a HTTP handler which loads the HTTP
+// request and calls the "GuildsService" service "GetGuildWaitlist" endpoint.
+func NewGetGuildWaitlistHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetGuildWaitlistRequest(mux, decoder)
+		encodeResponse = EncodeGetGuildWaitlistResponse(encoder)
+		encodeError    = EncodeGetGuildWaitlistError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "GetGuildWaitlist")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "GuildsService")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
 // MountLeaveGuildHandler configures the mux to serve the "GuildsService"
 // service "LeaveGuild" endpoint.
 func MountLeaveGuildHandler(mux goahttp.Muxer, h http.Handler) {
@@ -509,6 +633,57 @@ func NewLeaveGuildHandler(
 	})
 }
 
+// MountTransferMemberHandler configures the mux to serve the "GuildsService"
+// service "TransferMember" endpoint.
+func MountTransferMemberHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleGuildsServiceOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/members/{injective_address}/transfer", f)
+}
+
+// NewTransferMemberHandler creates a HTTP handler which loads the HTTP request
+// and calls the "GuildsService" service "TransferMember" endpoint.
+func NewTransferMemberHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeTransferMemberRequest(mux, decoder)
+		encodeResponse = EncodeTransferMemberResponse(encoder)
+		encodeError    = EncodeTransferMemberError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "TransferMember")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "GuildsService")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
 // MountGetGuildMarketsHandler configures the mux to serve the "GuildsService"
 // service "GetGuildMarkets" endpoint.
 func MountGetGuildMarketsHandler(mux goahttp.Muxer, h http.Handler) {
@@ -765,6 +940,58 @@ func NewGetAccountPortfoliosHandler(
 	})
 }
 
+// MountGetAccountMembershipsHandler configures the mux to serve the
+// "GuildsService" service "GetAccountMemberships" endpoint.
+func MountGetAccountMembershipsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleGuildsServiceOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/members/{injective_address}/memberships", f)
+}
+
+// NewGetAccountMembershipsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "GuildsService" service "GetAccountMemberships"
+// endpoint.
+func NewGetAccountMembershipsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetAccountMembershipsRequest(mux, decoder)
+		encodeResponse = EncodeGetAccountMembershipsResponse(encoder)
+		encodeError    = EncodeGetAccountMembershipsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "GetAccountMemberships")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "GuildsService")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
 // MountGetAccountMonthlyPortfoliosHandler configures the mux to serve the
 // "GuildsService" service "GetAccountMonthlyPortfolios" endpoint.
 func MountGetAccountMonthlyPortfoliosHandler(mux goahttp.Muxer, h http.Handler) {
@@ -817,6 +1044,415 @@ func NewGetAccountMonthlyPortfoliosHandler(
 	})
 }
 
+// MountGetAccountGrantsHandler configures the mux to serve the "GuildsService"
+// service "GetAccountGrants" endpoint.
+func MountGetAccountGrantsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleGuildsServiceOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/members/{injective_address}/grants", f)
+}
+
+// NewGetAccountGrantsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "GuildsService" service "GetAccountGrants" endpoint.
+func NewGetAccountGrantsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetAccountGrantsRequest(mux, decoder)
+		encodeResponse = EncodeGetAccountGrantsResponse(encoder)
+		encodeError    = EncodeGetAccountGrantsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "GetAccountGrants")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "GuildsService")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountGetAccountTradesHandler configures the mux to serve the "GuildsService"
+// service "GetAccountTrades" endpoint.
+func MountGetAccountTradesHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleGuildsServiceOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/members/{injective_address}/trades", f)
+}
+
+// NewGetAccountTradesHandler creates a HTTP handler which loads the HTTP
+// request and calls the "GuildsService" service "GetAccountTrades" endpoint.
+func NewGetAccountTradesHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetAccountTradesRequest(mux, decoder)
+		encodeResponse = EncodeGetAccountTradesResponse(encoder)
+		encodeError    = EncodeGetAccountTradesError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "GetAccountTrades")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "GuildsService")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountGetAccountTradeStatsHandler configures the mux to serve the
+// "GuildsService" service "GetAccountTradeStats" endpoint.
+func MountGetAccountTradeStatsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleGuildsServiceOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/members/{injective_address}/trade-stats", f)
+}
+
+// NewGetAccountTradeStatsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "GuildsService" service "GetAccountTradeStats"
+// endpoint.
+func NewGetAccountTradeStatsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetAccountTradeStatsRequest(mux, decoder)
+		encodeResponse = EncodeGetAccountTradeStatsResponse(encoder)
+		encodeError    = EncodeGetAccountTradeStatsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "GetAccountTradeStats")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "GuildsService")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountGetGuildTradeStatsHandler configures the mux to serve the
+// "GuildsService" service "GetGuildTradeStats" endpoint.
+func MountGetGuildTradeStatsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleGuildsServiceOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/guilds/{guildID}/trade-stats", f)
+}
+
+// NewGetGuildTradeStatsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "GuildsService" service "GetGuildTradeStats" endpoint.
+func NewGetGuildTradeStatsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetGuildTradeStatsRequest(mux, decoder)
+		encodeResponse = EncodeGetGuildTradeStatsResponse(encoder)
+		encodeError    = EncodeGetGuildTradeStatsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "GetGuildTradeStats")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "GuildsService")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountGetGuildFeesHandler configures the mux to serve the "GuildsService"
+// service "GetGuildFees" endpoint.
+func MountGetGuildFeesHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleGuildsServiceOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/guilds/{guildID}/fees", f)
+}
+
+// NewGetGuildFeesHandler creates a HTTP handler which loads the HTTP request
+// and calls the "GuildsService" service "GetGuildFees" endpoint.
+func NewGetGuildFeesHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetGuildFeesRequest(mux, decoder)
+		encodeResponse = EncodeGetGuildFeesResponse(encoder)
+		encodeError    = EncodeGetGuildFeesError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "GetGuildFees")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "GuildsService")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountGetAccountTransfersHandler configures the mux to serve the
+// "GuildsService" service "GetAccountTransfers" endpoint.
+func MountGetAccountTransfersHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleGuildsServiceOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/members/{injective_address}/transfers", f)
+}
+
+// NewGetAccountTransfersHandler creates a HTTP handler which loads the HTTP
+// request and calls the "GuildsService" service "GetAccountTransfers" endpoint.
+func NewGetAccountTransfersHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetAccountTransfersRequest(mux, decoder)
+		encodeResponse = EncodeGetAccountTransfersResponse(encoder)
+		encodeError    = EncodeGetAccountTransfersError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "GetAccountTransfers")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "GuildsService")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountGetAccountPositionsHandler configures the mux to serve the
+// "GuildsService" service "GetAccountPositions" endpoint.
+func MountGetAccountPositionsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleGuildsServiceOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/members/{injective_address}/positions", f)
+}
+
+// NewGetAccountPositionsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "GuildsService" service "GetAccountPositions" endpoint.
+func NewGetAccountPositionsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetAccountPositionsRequest(mux, decoder)
+		encodeResponse = EncodeGetAccountPositionsResponse(encoder)
+		encodeError    = EncodeGetAccountPositionsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "GetAccountPositions")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "GuildsService")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountGetGuildRiskHandler configures the mux to serve the "GuildsService"
+// service "GetGuildRisk" endpoint.
+func MountGetGuildRiskHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleGuildsServiceOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/guilds/{guildID}/risk", f)
+}
+
+// NewGetGuildRiskHandler creates a HTTP handler which loads the HTTP request
+// and calls the "GuildsService" service "GetGuildRisk" endpoint.
+func NewGetGuildRiskHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetGuildRiskRequest(mux, decoder)
+		encodeResponse = EncodeGetGuildRiskResponse(encoder)
+		encodeError    = EncodeGetGuildRiskError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "GetGuildRisk")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "GuildsService")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
 // MountCORSHandler configures the mux to serve the CORS endpoints for the
 // service GuildsService.
 func MountCORSHandler(mux goahttp.Muxer, h http.Handler) {
@@ -827,13 +1463,24 @@ func MountCORSHandler(mux goahttp.Muxer, h http.Handler) {
 	mux.Handle("OPTIONS", "/guilds/{guildID}/guild-master", h.ServeHTTP)
 	mux.Handle("OPTIONS", "/guilds/{guildID}/default-member", h.ServeHTTP)
 	mux.Handle("OPTIONS", "/guilds/{guildID}/member", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/guilds/{guildID}/waitlist", h.ServeHTTP)
 	mux.Handle("OPTIONS", "/guilds/{guildID}/member/{injective_address}", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/members/{injective_address}/transfer", h.ServeHTTP)
 	mux.Handle("OPTIONS", "/guilds/{guildID}/markets", h.ServeHTTP)
 	mux.Handle("OPTIONS", "/guilds/{guildID}/portfolios", h.ServeHTTP)
 	mux.Handle("OPTIONS", "/members/{injective_address}", h.ServeHTTP)
 	mux.Handle("OPTIONS", "/members/{injective_address}/portfolio", h.ServeHTTP)
 	mux.Handle("OPTIONS", "/members/{injective_address}/portfolios", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/members/{injective_address}/memberships", h.ServeHTTP)
 	mux.Handle("OPTIONS", "/members/{injective_address}/monthly-portfolios", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/members/{injective_address}/grants", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/members/{injective_address}/trades", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/members/{injective_address}/trade-stats", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/guilds/{guildID}/trade-stats", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/guilds/{guildID}/fees", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/members/{injective_address}/transfers", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/members/{injective_address}/positions", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/guilds/{guildID}/risk", h.ServeHTTP)
 }
 
 // NewCORSHandler creates a HTTP handler which returns a simple 200 response.
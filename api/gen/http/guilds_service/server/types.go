@@ -17,6 +17,20 @@ import (
 type EnterGuildRequestBody struct {
 	InjectiveAddress *string `form:"injective_address,omitempty" json:"injective_address,omitempty" xml:"injective_address,omitempty"`
 	Params           *string `form:"params,omitempty" json:"params,omitempty" xml:"params,omitempty"`
+	// Non-default subaccounts owned by injective_address to trade from, default
+	// subaccount is always included
+	SubaccountIds []string `form:"subaccount_ids,omitempty" json:"subaccount_ids,omitempty" xml:"subaccount_ids,omitempty"`
+}
+
+// TransferMemberRequestBody is the type of the "GuildsService" service
+// "TransferMember" endpoint HTTP request body.
+type TransferMemberRequestBody struct {
+	// Guild to move the member to
+	GuildID *string `form:"guild_id,omitempty" json:"guild_id,omitempty" xml:"guild_id,omitempty"`
+	Params  *string `form:"params,omitempty" json:"params,omitempty" xml:"params,omitempty"`
+	// Non-default subaccounts to trade from in the new guild, current subaccounts
+	// are kept if omitted
+	SubaccountIds []string `form:"subaccount_ids,omitempty" json:"subaccount_ids,omitempty" xml:"subaccount_ids,omitempty"`
 }
 
 // GetAllGuildsResponseBody is the type of the "GuildsService" service
@@ -55,8 +69,17 @@ type GetGuildDefaultMemberResponseBody struct {
 // EnterGuildResponseBody is the type of the "GuildsService" service
 // "EnterGuild" endpoint HTTP response body.
 type EnterGuildResponseBody struct {
+	// success, or waitlisted if guild is at capacity
 	JoinStatus *string `form:"join_status,omitempty" json:"join_status,omitempty" xml:"join_status,omitempty"`
 	Message    *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// 1-based position in guild waitlist if waitlisted
+	WaitlistPosition *int `form:"waitlist_position,omitempty" json:"waitlist_position,omitempty" xml:"waitlist_position,omitempty"`
+}
+
+// GetGuildWaitlistResponseBody is the type of the "GuildsService" service
+// "GetGuildWaitlist" endpoint HTTP response body.
+type GetGuildWaitlistResponseBody struct {
+	Waitlist []*WaitlistEntryResponseBody `form:"waitlist,omitempty" json:"waitlist,omitempty" xml:"waitlist,omitempty"`
 }
 
 // LeaveGuildResponseBody is the type of the "GuildsService" service
@@ -65,6 +88,13 @@ type LeaveGuildResponseBody struct {
 	LeaveStatus *string `form:"leave_status,omitempty" json:"leave_status,omitempty" xml:"leave_status,omitempty"`
 }
 
+// TransferMemberResponseBody is the type of the "GuildsService" service
+// "TransferMember" endpoint HTTP response body.
+type TransferMemberResponseBody struct {
+	TransferStatus *string `form:"transfer_status,omitempty" json:"transfer_status,omitempty" xml:"transfer_status,omitempty"`
+	FromGuildID    *string `form:"from_guild_id,omitempty" json:"from_guild_id,omitempty" xml:"from_guild_id,omitempty"`
+}
+
 // GetGuildMarketsResponseBody is the type of the "GuildsService" service
 // "GetGuildMarkets" endpoint HTTP response body.
 type GetGuildMarketsResponseBody struct {
@@ -75,6 +105,8 @@ type GetGuildMarketsResponseBody struct {
 // "GetGuildPortfolios" endpoint HTTP response body.
 type GetGuildPortfoliosResponseBody struct {
 	Portfolios []*SingleGuildPortfolioResponseBody `form:"portfolios,omitempty" json:"portfolios,omitempty" xml:"portfolios,omitempty"`
+	// Member deposits and withdrawals in the requested range
+	NetFlows []*NetFlowResponseBody `form:"net_flows,omitempty" json:"net_flows,omitempty" xml:"net_flows,omitempty"`
 }
 
 // GetAccountInfoResponseBody is the type of the "GuildsService" service
@@ -93,6 +125,14 @@ type GetAccountPortfolioResponseBody struct {
 // "GetAccountPortfolios" endpoint HTTP response body.
 type GetAccountPortfoliosResponseBody struct {
 	Portfolios []*SingleAccountPortfolioResponseBody `form:"portfolios,omitempty" json:"portfolios,omitempty" xml:"portfolios,omitempty"`
+	// Deposits and withdrawals in the requested range
+	NetFlows []*NetFlowResponseBody `form:"net_flows,omitempty" json:"net_flows,omitempty" xml:"net_flows,omitempty"`
+}
+
+// GetAccountMembershipsResponseBody is the type of the "GuildsService" service
+// "GetAccountMemberships" endpoint HTTP response body.
+type GetAccountMembershipsResponseBody struct {
+	Memberships []*MembershipResponseBody `form:"memberships,omitempty" json:"memberships,omitempty" xml:"memberships,omitempty"`
 }
 
 // GetAccountMonthlyPortfoliosResponseBody is the type of the "GuildsService"
@@ -101,492 +141,1053 @@ type GetAccountMonthlyPortfoliosResponseBody struct {
 	Portfolios []*MonthlyAccountPortfolioResponseBody `form:"portfolios,omitempty" json:"portfolios,omitempty" xml:"portfolios,omitempty"`
 }
 
+// GetAccountGrantsResponseBody is the type of the "GuildsService" service
+// "GetAccountGrants" endpoint HTTP response body.
+type GetAccountGrantsResponseBody struct {
+	Grants []*GrantResponseBody `form:"grants,omitempty" json:"grants,omitempty" xml:"grants,omitempty"`
+}
+
+// GetAccountTradesResponseBody is the type of the "GuildsService" service
+// "GetAccountTrades" endpoint HTTP response body.
+type GetAccountTradesResponseBody struct {
+	Trades []*TradeResponseBody `form:"trades,omitempty" json:"trades,omitempty" xml:"trades,omitempty"`
+}
+
+// GetAccountTradeStatsResponseBody is the type of the "GuildsService" service
+// "GetAccountTradeStats" endpoint HTTP response body.
+type GetAccountTradeStatsResponseBody struct {
+	Stats []*TradeStatsResponseBody `form:"stats,omitempty" json:"stats,omitempty" xml:"stats,omitempty"`
+}
+
+// GetGuildTradeStatsResponseBody is the type of the "GuildsService" service
+// "GetGuildTradeStats" endpoint HTTP response body.
+type GetGuildTradeStatsResponseBody struct {
+	Stats []*TradeStatsResponseBody `form:"stats,omitempty" json:"stats,omitempty" xml:"stats,omitempty"`
+}
+
+// GetGuildFeesResponseBody is the type of the "GuildsService" service
+// "GetGuildFees" endpoint HTTP response body.
+type GetGuildFeesResponseBody struct {
+	MasterAddress *string                              `form:"master_address,omitempty" json:"master_address,omitempty" xml:"master_address,omitempty"`
+	Totals        []*GuildFeeTotalResponseBody         `form:"totals,omitempty" json:"totals,omitempty" xml:"totals,omitempty"`
+	Members       []*MemberFeeContributionResponseBody `form:"members,omitempty" json:"members,omitempty" xml:"members,omitempty"`
+	Daily         []*DailyGuildFeeResponseBody         `form:"daily,omitempty" json:"daily,omitempty" xml:"daily,omitempty"`
+}
+
+// GetAccountTransfersResponseBody is the type of the "GuildsService" service
+// "GetAccountTransfers" endpoint HTTP response body.
+type GetAccountTransfersResponseBody struct {
+	Transfers []*TransferResponseBody `form:"transfers,omitempty" json:"transfers,omitempty" xml:"transfers,omitempty"`
+	NetFlows  []*NetFlowResponseBody  `form:"net_flows,omitempty" json:"net_flows,omitempty" xml:"net_flows,omitempty"`
+}
+
+// GetAccountPositionsResponseBody is the type of the "GuildsService" service
+// "GetAccountPositions" endpoint HTTP response body.
+type GetAccountPositionsResponseBody struct {
+	Positions []*PositionResponseBody `form:"positions" json:"positions" xml:"positions"`
+	UpdatedAt int64                   `form:"updated_at" json:"updated_at" xml:"updated_at"`
+}
+
+// GetGuildRiskResponseBody is the type of the "GuildsService" service
+// "GetGuildRisk" endpoint HTTP response body.
+type GetGuildRiskResponseBody struct {
+	Data *GuildRiskResponseBody `form:"data,omitempty" json:"data,omitempty" xml:"data,omitempty"`
+}
+
 // GetAllGuildsNotFoundResponseBody is the type of the "GuildsService" service
 // "GetAllGuilds" endpoint HTTP response body for the "not_found" error.
 type GetAllGuildsNotFoundResponseBody struct {
-	// Name is the name of this class of errors.
+	// Name of error: not_found, invalid_arg or internal
 	Name string `form:"name" json:"name" xml:"name"`
-	// ID is a unique identifier for this particular occurrence of the problem.
-	ID string `form:"id" json:"id" xml:"id"`
-	// Message is a human-readable explanation specific to this occurrence of the
-	// problem.
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
 	Message string `form:"message" json:"message" xml:"message"`
-	// Is the error temporary?
-	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
-	// Is the error a timeout?
-	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
-	// Is the error a server-side fault?
-	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
 }
 
 // GetAllGuildsInternalResponseBody is the type of the "GuildsService" service
 // "GetAllGuilds" endpoint HTTP response body for the "internal" error.
 type GetAllGuildsInternalResponseBody struct {
-	// Name is the name of this class of errors.
+	// Name of error: not_found, invalid_arg or internal
 	Name string `form:"name" json:"name" xml:"name"`
-	// ID is a unique identifier for this particular occurrence of the problem.
-	ID string `form:"id" json:"id" xml:"id"`
-	// Message is a human-readable explanation specific to this occurrence of the
-	// problem.
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
 	Message string `form:"message" json:"message" xml:"message"`
-	// Is the error temporary?
-	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
-	// Is the error a timeout?
-	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
-	// Is the error a server-side fault?
-	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetAllGuildsInvalidArgResponseBody is the type of the "GuildsService"
+// service "GetAllGuilds" endpoint HTTP response body for the "invalid_arg"
+// error.
+type GetAllGuildsInvalidArgResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name string `form:"name" json:"name" xml:"name"`
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
+	Message string `form:"message" json:"message" xml:"message"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
 }
 
 // GetSingleGuildNotFoundResponseBody is the type of the "GuildsService"
 // service "GetSingleGuild" endpoint HTTP response body for the "not_found"
 // error.
 type GetSingleGuildNotFoundResponseBody struct {
-	// Name is the name of this class of errors.
+	// Name of error: not_found, invalid_arg or internal
 	Name string `form:"name" json:"name" xml:"name"`
-	// ID is a unique identifier for this particular occurrence of the problem.
-	ID string `form:"id" json:"id" xml:"id"`
-	// Message is a human-readable explanation specific to this occurrence of the
-	// problem.
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
 	Message string `form:"message" json:"message" xml:"message"`
-	// Is the error temporary?
-	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
-	// Is the error a timeout?
-	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
-	// Is the error a server-side fault?
-	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
 }
 
 // GetSingleGuildInternalResponseBody is the type of the "GuildsService"
 // service "GetSingleGuild" endpoint HTTP response body for the "internal"
 // error.
 type GetSingleGuildInternalResponseBody struct {
-	// Name is the name of this class of errors.
+	// Name of error: not_found, invalid_arg or internal
+	Name string `form:"name" json:"name" xml:"name"`
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
+	Message string `form:"message" json:"message" xml:"message"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetSingleGuildInvalidArgResponseBody is the type of the "GuildsService"
+// service "GetSingleGuild" endpoint HTTP response body for the "invalid_arg"
+// error.
+type GetSingleGuildInvalidArgResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name string `form:"name" json:"name" xml:"name"`
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
+	Message string `form:"message" json:"message" xml:"message"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetGuildMembersInvalidArgResponseBody is the type of the "GuildsService"
+// service "GetGuildMembers" endpoint HTTP response body for the "invalid_arg"
+// error.
+type GetGuildMembersInvalidArgResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
 	Name string `form:"name" json:"name" xml:"name"`
-	// ID is a unique identifier for this particular occurrence of the problem.
-	ID string `form:"id" json:"id" xml:"id"`
-	// Message is a human-readable explanation specific to this occurrence of the
-	// problem.
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
 	Message string `form:"message" json:"message" xml:"message"`
-	// Is the error temporary?
-	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
-	// Is the error a timeout?
-	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
-	// Is the error a server-side fault?
-	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
 }
 
 // GetGuildMembersNotFoundResponseBody is the type of the "GuildsService"
 // service "GetGuildMembers" endpoint HTTP response body for the "not_found"
 // error.
 type GetGuildMembersNotFoundResponseBody struct {
-	// Name is the name of this class of errors.
+	// Name of error: not_found, invalid_arg or internal
 	Name string `form:"name" json:"name" xml:"name"`
-	// ID is a unique identifier for this particular occurrence of the problem.
-	ID string `form:"id" json:"id" xml:"id"`
-	// Message is a human-readable explanation specific to this occurrence of the
-	// problem.
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
 	Message string `form:"message" json:"message" xml:"message"`
-	// Is the error temporary?
-	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
-	// Is the error a timeout?
-	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
-	// Is the error a server-side fault?
-	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
 }
 
 // GetGuildMembersInternalResponseBody is the type of the "GuildsService"
 // service "GetGuildMembers" endpoint HTTP response body for the "internal"
 // error.
 type GetGuildMembersInternalResponseBody struct {
-	// Name is the name of this class of errors.
+	// Name of error: not_found, invalid_arg or internal
 	Name string `form:"name" json:"name" xml:"name"`
-	// ID is a unique identifier for this particular occurrence of the problem.
-	ID string `form:"id" json:"id" xml:"id"`
-	// Message is a human-readable explanation specific to this occurrence of the
-	// problem.
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
 	Message string `form:"message" json:"message" xml:"message"`
-	// Is the error temporary?
-	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
-	// Is the error a timeout?
-	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
-	// Is the error a server-side fault?
-	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
 }
 
 // GetGuildMasterAddressNotFoundResponseBody is the type of the "GuildsService"
 // service "GetGuildMasterAddress" endpoint HTTP response body for the
 // "not_found" error.
 type GetGuildMasterAddressNotFoundResponseBody struct {
-	// Name is the name of this class of errors.
+	// Name of error: not_found, invalid_arg or internal
 	Name string `form:"name" json:"name" xml:"name"`
-	// ID is a unique identifier for this particular occurrence of the problem.
-	ID string `form:"id" json:"id" xml:"id"`
-	// Message is a human-readable explanation specific to this occurrence of the
-	// problem.
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
 	Message string `form:"message" json:"message" xml:"message"`
-	// Is the error temporary?
-	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
-	// Is the error a timeout?
-	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
-	// Is the error a server-side fault?
-	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
 }
 
 // GetGuildMasterAddressInternalResponseBody is the type of the "GuildsService"
 // service "GetGuildMasterAddress" endpoint HTTP response body for the
 // "internal" error.
 type GetGuildMasterAddressInternalResponseBody struct {
-	// Name is the name of this class of errors.
+	// Name of error: not_found, invalid_arg or internal
+	Name string `form:"name" json:"name" xml:"name"`
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
+	Message string `form:"message" json:"message" xml:"message"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetGuildMasterAddressInvalidArgResponseBody is the type of the
+// "GuildsService" service "GetGuildMasterAddress" endpoint HTTP response body
+// for the "invalid_arg" error.
+type GetGuildMasterAddressInvalidArgResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name string `form:"name" json:"name" xml:"name"`
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
+	Message string `form:"message" json:"message" xml:"message"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetGuildDefaultMemberInvalidArgResponseBody is the type of the
+// "GuildsService" service "GetGuildDefaultMember" endpoint HTTP response body
+// for the "invalid_arg" error.
+type GetGuildDefaultMemberInvalidArgResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
 	Name string `form:"name" json:"name" xml:"name"`
-	// ID is a unique identifier for this particular occurrence of the problem.
-	ID string `form:"id" json:"id" xml:"id"`
-	// Message is a human-readable explanation specific to this occurrence of the
-	// problem.
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
 	Message string `form:"message" json:"message" xml:"message"`
-	// Is the error temporary?
-	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
-	// Is the error a timeout?
-	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
-	// Is the error a server-side fault?
-	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
 }
 
 // GetGuildDefaultMemberNotFoundResponseBody is the type of the "GuildsService"
 // service "GetGuildDefaultMember" endpoint HTTP response body for the
 // "not_found" error.
 type GetGuildDefaultMemberNotFoundResponseBody struct {
-	// Name is the name of this class of errors.
+	// Name of error: not_found, invalid_arg or internal
 	Name string `form:"name" json:"name" xml:"name"`
-	// ID is a unique identifier for this particular occurrence of the problem.
-	ID string `form:"id" json:"id" xml:"id"`
-	// Message is a human-readable explanation specific to this occurrence of the
-	// problem.
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
 	Message string `form:"message" json:"message" xml:"message"`
-	// Is the error temporary?
-	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
-	// Is the error a timeout?
-	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
-	// Is the error a server-side fault?
-	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
 }
 
 // GetGuildDefaultMemberInternalResponseBody is the type of the "GuildsService"
 // service "GetGuildDefaultMember" endpoint HTTP response body for the
 // "internal" error.
 type GetGuildDefaultMemberInternalResponseBody struct {
-	// Name is the name of this class of errors.
+	// Name of error: not_found, invalid_arg or internal
 	Name string `form:"name" json:"name" xml:"name"`
-	// ID is a unique identifier for this particular occurrence of the problem.
-	ID string `form:"id" json:"id" xml:"id"`
-	// Message is a human-readable explanation specific to this occurrence of the
-	// problem.
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
 	Message string `form:"message" json:"message" xml:"message"`
-	// Is the error temporary?
-	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
-	// Is the error a timeout?
-	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
-	// Is the error a server-side fault?
-	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
 }
 
 // EnterGuildNotFoundResponseBody is the type of the "GuildsService" service
 // "EnterGuild" endpoint HTTP response body for the "not_found" error.
 type EnterGuildNotFoundResponseBody struct {
-	// Name is the name of this class of errors.
+	// Name of error: not_found, invalid_arg or internal
 	Name string `form:"name" json:"name" xml:"name"`
-	// ID is a unique identifier for this particular occurrence of the problem.
-	ID string `form:"id" json:"id" xml:"id"`
-	// Message is a human-readable explanation specific to this occurrence of the
-	// problem.
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
 	Message string `form:"message" json:"message" xml:"message"`
-	// Is the error temporary?
-	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
-	// Is the error a timeout?
-	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
-	// Is the error a server-side fault?
-	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
 }
 
 // EnterGuildInternalResponseBody is the type of the "GuildsService" service
 // "EnterGuild" endpoint HTTP response body for the "internal" error.
 type EnterGuildInternalResponseBody struct {
-	// Name is the name of this class of errors.
+	// Name of error: not_found, invalid_arg or internal
+	Name string `form:"name" json:"name" xml:"name"`
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
+	Message string `form:"message" json:"message" xml:"message"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// EnterGuildInvalidArgResponseBody is the type of the "GuildsService" service
+// "EnterGuild" endpoint HTTP response body for the "invalid_arg" error.
+type EnterGuildInvalidArgResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name string `form:"name" json:"name" xml:"name"`
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
+	Message string `form:"message" json:"message" xml:"message"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetGuildWaitlistInvalidArgResponseBody is the type of the "GuildsService"
+// service "GetGuildWaitlist" endpoint HTTP response body for the "invalid_arg"
+// error.
+type GetGuildWaitlistInvalidArgResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name string `form:"name" json:"name" xml:"name"`
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
+	Message string `form:"message" json:"message" xml:"message"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetGuildWaitlistNotFoundResponseBody is the type of the "GuildsService"
+// service "GetGuildWaitlist" endpoint HTTP response body for the "not_found"
+// error.
+type GetGuildWaitlistNotFoundResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name string `form:"name" json:"name" xml:"name"`
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
+	Message string `form:"message" json:"message" xml:"message"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetGuildWaitlistInternalResponseBody is the type of the "GuildsService"
+// service "GetGuildWaitlist" endpoint HTTP response body for the "internal"
+// error.
+type GetGuildWaitlistInternalResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
 	Name string `form:"name" json:"name" xml:"name"`
-	// ID is a unique identifier for this particular occurrence of the problem.
-	ID string `form:"id" json:"id" xml:"id"`
-	// Message is a human-readable explanation specific to this occurrence of the
-	// problem.
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
 	Message string `form:"message" json:"message" xml:"message"`
-	// Is the error temporary?
-	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
-	// Is the error a timeout?
-	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
-	// Is the error a server-side fault?
-	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
 }
 
 // LeaveGuildNotFoundResponseBody is the type of the "GuildsService" service
 // "LeaveGuild" endpoint HTTP response body for the "not_found" error.
 type LeaveGuildNotFoundResponseBody struct {
-	// Name is the name of this class of errors.
+	// Name of error: not_found, invalid_arg or internal
 	Name string `form:"name" json:"name" xml:"name"`
-	// ID is a unique identifier for this particular occurrence of the problem.
-	ID string `form:"id" json:"id" xml:"id"`
-	// Message is a human-readable explanation specific to this occurrence of the
-	// problem.
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
 	Message string `form:"message" json:"message" xml:"message"`
-	// Is the error temporary?
-	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
-	// Is the error a timeout?
-	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
-	// Is the error a server-side fault?
-	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
 }
 
 // LeaveGuildInternalResponseBody is the type of the "GuildsService" service
 // "LeaveGuild" endpoint HTTP response body for the "internal" error.
 type LeaveGuildInternalResponseBody struct {
-	// Name is the name of this class of errors.
+	// Name of error: not_found, invalid_arg or internal
+	Name string `form:"name" json:"name" xml:"name"`
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
+	Message string `form:"message" json:"message" xml:"message"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// LeaveGuildInvalidArgResponseBody is the type of the "GuildsService" service
+// "LeaveGuild" endpoint HTTP response body for the "invalid_arg" error.
+type LeaveGuildInvalidArgResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name string `form:"name" json:"name" xml:"name"`
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
+	Message string `form:"message" json:"message" xml:"message"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// TransferMemberInvalidArgResponseBody is the type of the "GuildsService"
+// service "TransferMember" endpoint HTTP response body for the "invalid_arg"
+// error.
+type TransferMemberInvalidArgResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name string `form:"name" json:"name" xml:"name"`
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
+	Message string `form:"message" json:"message" xml:"message"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// TransferMemberNotFoundResponseBody is the type of the "GuildsService"
+// service "TransferMember" endpoint HTTP response body for the "not_found"
+// error.
+type TransferMemberNotFoundResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
 	Name string `form:"name" json:"name" xml:"name"`
-	// ID is a unique identifier for this particular occurrence of the problem.
-	ID string `form:"id" json:"id" xml:"id"`
-	// Message is a human-readable explanation specific to this occurrence of the
-	// problem.
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
 	Message string `form:"message" json:"message" xml:"message"`
-	// Is the error temporary?
-	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
-	// Is the error a timeout?
-	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
-	// Is the error a server-side fault?
-	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// TransferMemberInternalResponseBody is the type of the "GuildsService"
+// service "TransferMember" endpoint HTTP response body for the "internal"
+// error.
+type TransferMemberInternalResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name string `form:"name" json:"name" xml:"name"`
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
+	Message string `form:"message" json:"message" xml:"message"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
 }
 
 // GetGuildMarketsNotFoundResponseBody is the type of the "GuildsService"
 // service "GetGuildMarkets" endpoint HTTP response body for the "not_found"
 // error.
 type GetGuildMarketsNotFoundResponseBody struct {
-	// Name is the name of this class of errors.
+	// Name of error: not_found, invalid_arg or internal
 	Name string `form:"name" json:"name" xml:"name"`
-	// ID is a unique identifier for this particular occurrence of the problem.
-	ID string `form:"id" json:"id" xml:"id"`
-	// Message is a human-readable explanation specific to this occurrence of the
-	// problem.
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
 	Message string `form:"message" json:"message" xml:"message"`
-	// Is the error temporary?
-	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
-	// Is the error a timeout?
-	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
-	// Is the error a server-side fault?
-	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
 }
 
 // GetGuildMarketsInternalResponseBody is the type of the "GuildsService"
 // service "GetGuildMarkets" endpoint HTTP response body for the "internal"
 // error.
 type GetGuildMarketsInternalResponseBody struct {
-	// Name is the name of this class of errors.
+	// Name of error: not_found, invalid_arg or internal
+	Name string `form:"name" json:"name" xml:"name"`
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
+	Message string `form:"message" json:"message" xml:"message"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetGuildMarketsInvalidArgResponseBody is the type of the "GuildsService"
+// service "GetGuildMarkets" endpoint HTTP response body for the "invalid_arg"
+// error.
+type GetGuildMarketsInvalidArgResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
 	Name string `form:"name" json:"name" xml:"name"`
-	// ID is a unique identifier for this particular occurrence of the problem.
-	ID string `form:"id" json:"id" xml:"id"`
-	// Message is a human-readable explanation specific to this occurrence of the
-	// problem.
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
 	Message string `form:"message" json:"message" xml:"message"`
-	// Is the error temporary?
-	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
-	// Is the error a timeout?
-	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
-	// Is the error a server-side fault?
-	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
 }
 
 // GetGuildPortfoliosNotFoundResponseBody is the type of the "GuildsService"
 // service "GetGuildPortfolios" endpoint HTTP response body for the "not_found"
 // error.
 type GetGuildPortfoliosNotFoundResponseBody struct {
-	// Name is the name of this class of errors.
+	// Name of error: not_found, invalid_arg or internal
 	Name string `form:"name" json:"name" xml:"name"`
-	// ID is a unique identifier for this particular occurrence of the problem.
-	ID string `form:"id" json:"id" xml:"id"`
-	// Message is a human-readable explanation specific to this occurrence of the
-	// problem.
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
 	Message string `form:"message" json:"message" xml:"message"`
-	// Is the error temporary?
-	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
-	// Is the error a timeout?
-	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
-	// Is the error a server-side fault?
-	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
 }
 
 // GetGuildPortfoliosInternalResponseBody is the type of the "GuildsService"
 // service "GetGuildPortfolios" endpoint HTTP response body for the "internal"
 // error.
 type GetGuildPortfoliosInternalResponseBody struct {
-	// Name is the name of this class of errors.
+	// Name of error: not_found, invalid_arg or internal
+	Name string `form:"name" json:"name" xml:"name"`
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
+	Message string `form:"message" json:"message" xml:"message"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetGuildPortfoliosInvalidArgResponseBody is the type of the "GuildsService"
+// service "GetGuildPortfolios" endpoint HTTP response body for the
+// "invalid_arg" error.
+type GetGuildPortfoliosInvalidArgResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
 	Name string `form:"name" json:"name" xml:"name"`
-	// ID is a unique identifier for this particular occurrence of the problem.
-	ID string `form:"id" json:"id" xml:"id"`
-	// Message is a human-readable explanation specific to this occurrence of the
-	// problem.
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
 	Message string `form:"message" json:"message" xml:"message"`
-	// Is the error temporary?
-	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
-	// Is the error a timeout?
-	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
-	// Is the error a server-side fault?
-	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
 }
 
 // GetAccountInfoNotFoundResponseBody is the type of the "GuildsService"
 // service "GetAccountInfo" endpoint HTTP response body for the "not_found"
 // error.
 type GetAccountInfoNotFoundResponseBody struct {
-	// Name is the name of this class of errors.
+	// Name of error: not_found, invalid_arg or internal
 	Name string `form:"name" json:"name" xml:"name"`
-	// ID is a unique identifier for this particular occurrence of the problem.
-	ID string `form:"id" json:"id" xml:"id"`
-	// Message is a human-readable explanation specific to this occurrence of the
-	// problem.
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
 	Message string `form:"message" json:"message" xml:"message"`
-	// Is the error temporary?
-	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
-	// Is the error a timeout?
-	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
-	// Is the error a server-side fault?
-	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
 }
 
 // GetAccountInfoInternalResponseBody is the type of the "GuildsService"
 // service "GetAccountInfo" endpoint HTTP response body for the "internal"
 // error.
 type GetAccountInfoInternalResponseBody struct {
-	// Name is the name of this class of errors.
+	// Name of error: not_found, invalid_arg or internal
+	Name string `form:"name" json:"name" xml:"name"`
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
+	Message string `form:"message" json:"message" xml:"message"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetAccountInfoInvalidArgResponseBody is the type of the "GuildsService"
+// service "GetAccountInfo" endpoint HTTP response body for the "invalid_arg"
+// error.
+type GetAccountInfoInvalidArgResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
 	Name string `form:"name" json:"name" xml:"name"`
-	// ID is a unique identifier for this particular occurrence of the problem.
-	ID string `form:"id" json:"id" xml:"id"`
-	// Message is a human-readable explanation specific to this occurrence of the
-	// problem.
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
 	Message string `form:"message" json:"message" xml:"message"`
-	// Is the error temporary?
-	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
-	// Is the error a timeout?
-	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
-	// Is the error a server-side fault?
-	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
 }
 
 // GetAccountPortfolioNotFoundResponseBody is the type of the "GuildsService"
 // service "GetAccountPortfolio" endpoint HTTP response body for the
 // "not_found" error.
 type GetAccountPortfolioNotFoundResponseBody struct {
-	// Name is the name of this class of errors.
+	// Name of error: not_found, invalid_arg or internal
 	Name string `form:"name" json:"name" xml:"name"`
-	// ID is a unique identifier for this particular occurrence of the problem.
-	ID string `form:"id" json:"id" xml:"id"`
-	// Message is a human-readable explanation specific to this occurrence of the
-	// problem.
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
 	Message string `form:"message" json:"message" xml:"message"`
-	// Is the error temporary?
-	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
-	// Is the error a timeout?
-	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
-	// Is the error a server-side fault?
-	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
 }
 
 // GetAccountPortfolioInternalResponseBody is the type of the "GuildsService"
 // service "GetAccountPortfolio" endpoint HTTP response body for the "internal"
 // error.
 type GetAccountPortfolioInternalResponseBody struct {
-	// Name is the name of this class of errors.
+	// Name of error: not_found, invalid_arg or internal
+	Name string `form:"name" json:"name" xml:"name"`
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
+	Message string `form:"message" json:"message" xml:"message"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetAccountPortfolioInvalidArgResponseBody is the type of the "GuildsService"
+// service "GetAccountPortfolio" endpoint HTTP response body for the
+// "invalid_arg" error.
+type GetAccountPortfolioInvalidArgResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
 	Name string `form:"name" json:"name" xml:"name"`
-	// ID is a unique identifier for this particular occurrence of the problem.
-	ID string `form:"id" json:"id" xml:"id"`
-	// Message is a human-readable explanation specific to this occurrence of the
-	// problem.
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
 	Message string `form:"message" json:"message" xml:"message"`
-	// Is the error temporary?
-	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
-	// Is the error a timeout?
-	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
-	// Is the error a server-side fault?
-	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetAccountPortfoliosInvalidArgResponseBody is the type of the
+// "GuildsService" service "GetAccountPortfolios" endpoint HTTP response body
+// for the "invalid_arg" error.
+type GetAccountPortfoliosInvalidArgResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name string `form:"name" json:"name" xml:"name"`
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
+	Message string `form:"message" json:"message" xml:"message"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
 }
 
 // GetAccountPortfoliosNotFoundResponseBody is the type of the "GuildsService"
 // service "GetAccountPortfolios" endpoint HTTP response body for the
 // "not_found" error.
 type GetAccountPortfoliosNotFoundResponseBody struct {
-	// Name is the name of this class of errors.
+	// Name of error: not_found, invalid_arg or internal
 	Name string `form:"name" json:"name" xml:"name"`
-	// ID is a unique identifier for this particular occurrence of the problem.
-	ID string `form:"id" json:"id" xml:"id"`
-	// Message is a human-readable explanation specific to this occurrence of the
-	// problem.
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
 	Message string `form:"message" json:"message" xml:"message"`
-	// Is the error temporary?
-	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
-	// Is the error a timeout?
-	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
-	// Is the error a server-side fault?
-	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
 }
 
 // GetAccountPortfoliosInternalResponseBody is the type of the "GuildsService"
 // service "GetAccountPortfolios" endpoint HTTP response body for the
 // "internal" error.
 type GetAccountPortfoliosInternalResponseBody struct {
-	// Name is the name of this class of errors.
+	// Name of error: not_found, invalid_arg or internal
+	Name string `form:"name" json:"name" xml:"name"`
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
+	Message string `form:"message" json:"message" xml:"message"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetAccountMembershipsInvalidArgResponseBody is the type of the
+// "GuildsService" service "GetAccountMemberships" endpoint HTTP response body
+// for the "invalid_arg" error.
+type GetAccountMembershipsInvalidArgResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
 	Name string `form:"name" json:"name" xml:"name"`
-	// ID is a unique identifier for this particular occurrence of the problem.
-	ID string `form:"id" json:"id" xml:"id"`
-	// Message is a human-readable explanation specific to this occurrence of the
-	// problem.
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
 	Message string `form:"message" json:"message" xml:"message"`
-	// Is the error temporary?
-	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
-	// Is the error a timeout?
-	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
-	// Is the error a server-side fault?
-	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetAccountMembershipsInternalResponseBody is the type of the "GuildsService"
+// service "GetAccountMemberships" endpoint HTTP response body for the
+// "internal" error.
+type GetAccountMembershipsInternalResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name string `form:"name" json:"name" xml:"name"`
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
+	Message string `form:"message" json:"message" xml:"message"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetAccountMembershipsNotFoundResponseBody is the type of the "GuildsService"
+// service "GetAccountMemberships" endpoint HTTP response body for the
+// "not_found" error.
+type GetAccountMembershipsNotFoundResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name string `form:"name" json:"name" xml:"name"`
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
+	Message string `form:"message" json:"message" xml:"message"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
 }
 
 // GetAccountMonthlyPortfoliosNotFoundResponseBody is the type of the
 // "GuildsService" service "GetAccountMonthlyPortfolios" endpoint HTTP response
 // body for the "not_found" error.
 type GetAccountMonthlyPortfoliosNotFoundResponseBody struct {
-	// Name is the name of this class of errors.
+	// Name of error: not_found, invalid_arg or internal
 	Name string `form:"name" json:"name" xml:"name"`
-	// ID is a unique identifier for this particular occurrence of the problem.
-	ID string `form:"id" json:"id" xml:"id"`
-	// Message is a human-readable explanation specific to this occurrence of the
-	// problem.
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
 	Message string `form:"message" json:"message" xml:"message"`
-	// Is the error temporary?
-	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
-	// Is the error a timeout?
-	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
-	// Is the error a server-side fault?
-	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
 }
 
 // GetAccountMonthlyPortfoliosInternalResponseBody is the type of the
 // "GuildsService" service "GetAccountMonthlyPortfolios" endpoint HTTP response
 // body for the "internal" error.
 type GetAccountMonthlyPortfoliosInternalResponseBody struct {
-	// Name is the name of this class of errors.
+	// Name of error: not_found, invalid_arg or internal
+	Name string `form:"name" json:"name" xml:"name"`
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
+	Message string `form:"message" json:"message" xml:"message"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetAccountMonthlyPortfoliosInvalidArgResponseBody is the type of the
+// "GuildsService" service "GetAccountMonthlyPortfolios" endpoint HTTP response
+// body for the "invalid_arg" error.
+type GetAccountMonthlyPortfoliosInvalidArgResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name string `form:"name" json:"name" xml:"name"`
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
+	Message string `form:"message" json:"message" xml:"message"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetAccountGrantsNotFoundResponseBody is the type of the "GuildsService"
+// service "GetAccountGrants" endpoint HTTP response body for the "not_found"
+// error.
+type GetAccountGrantsNotFoundResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name string `form:"name" json:"name" xml:"name"`
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
+	Message string `form:"message" json:"message" xml:"message"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetAccountGrantsInvalidArgResponseBody is the type of the "GuildsService"
+// service "GetAccountGrants" endpoint HTTP response body for the "invalid_arg"
+// error.
+type GetAccountGrantsInvalidArgResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name string `form:"name" json:"name" xml:"name"`
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
+	Message string `form:"message" json:"message" xml:"message"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetAccountGrantsInternalResponseBody is the type of the "GuildsService"
+// service "GetAccountGrants" endpoint HTTP response body for the "internal"
+// error.
+type GetAccountGrantsInternalResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name string `form:"name" json:"name" xml:"name"`
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
+	Message string `form:"message" json:"message" xml:"message"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetAccountTradesInvalidArgResponseBody is the type of the "GuildsService"
+// service "GetAccountTrades" endpoint HTTP response body for the "invalid_arg"
+// error.
+type GetAccountTradesInvalidArgResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name string `form:"name" json:"name" xml:"name"`
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
+	Message string `form:"message" json:"message" xml:"message"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetAccountTradesInternalResponseBody is the type of the "GuildsService"
+// service "GetAccountTrades" endpoint HTTP response body for the "internal"
+// error.
+type GetAccountTradesInternalResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name string `form:"name" json:"name" xml:"name"`
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
+	Message string `form:"message" json:"message" xml:"message"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetAccountTradesNotFoundResponseBody is the type of the "GuildsService"
+// service "GetAccountTrades" endpoint HTTP response body for the "not_found"
+// error.
+type GetAccountTradesNotFoundResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name string `form:"name" json:"name" xml:"name"`
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
+	Message string `form:"message" json:"message" xml:"message"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetAccountTradeStatsInvalidArgResponseBody is the type of the
+// "GuildsService" service "GetAccountTradeStats" endpoint HTTP response body
+// for the "invalid_arg" error.
+type GetAccountTradeStatsInvalidArgResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name string `form:"name" json:"name" xml:"name"`
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
+	Message string `form:"message" json:"message" xml:"message"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetAccountTradeStatsInternalResponseBody is the type of the "GuildsService"
+// service "GetAccountTradeStats" endpoint HTTP response body for the
+// "internal" error.
+type GetAccountTradeStatsInternalResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name string `form:"name" json:"name" xml:"name"`
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
+	Message string `form:"message" json:"message" xml:"message"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetAccountTradeStatsNotFoundResponseBody is the type of the "GuildsService"
+// service "GetAccountTradeStats" endpoint HTTP response body for the
+// "not_found" error.
+type GetAccountTradeStatsNotFoundResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name string `form:"name" json:"name" xml:"name"`
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
+	Message string `form:"message" json:"message" xml:"message"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetGuildTradeStatsInvalidArgResponseBody is the type of the "GuildsService"
+// service "GetGuildTradeStats" endpoint HTTP response body for the
+// "invalid_arg" error.
+type GetGuildTradeStatsInvalidArgResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name string `form:"name" json:"name" xml:"name"`
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
+	Message string `form:"message" json:"message" xml:"message"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetGuildTradeStatsNotFoundResponseBody is the type of the "GuildsService"
+// service "GetGuildTradeStats" endpoint HTTP response body for the "not_found"
+// error.
+type GetGuildTradeStatsNotFoundResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name string `form:"name" json:"name" xml:"name"`
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
+	Message string `form:"message" json:"message" xml:"message"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetGuildTradeStatsInternalResponseBody is the type of the "GuildsService"
+// service "GetGuildTradeStats" endpoint HTTP response body for the "internal"
+// error.
+type GetGuildTradeStatsInternalResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name string `form:"name" json:"name" xml:"name"`
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
+	Message string `form:"message" json:"message" xml:"message"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetGuildFeesInvalidArgResponseBody is the type of the "GuildsService"
+// service "GetGuildFees" endpoint HTTP response body for the "invalid_arg"
+// error.
+type GetGuildFeesInvalidArgResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name string `form:"name" json:"name" xml:"name"`
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
+	Message string `form:"message" json:"message" xml:"message"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetGuildFeesNotFoundResponseBody is the type of the "GuildsService" service
+// "GetGuildFees" endpoint HTTP response body for the "not_found" error.
+type GetGuildFeesNotFoundResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name string `form:"name" json:"name" xml:"name"`
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
+	Message string `form:"message" json:"message" xml:"message"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetGuildFeesInternalResponseBody is the type of the "GuildsService" service
+// "GetGuildFees" endpoint HTTP response body for the "internal" error.
+type GetGuildFeesInternalResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name string `form:"name" json:"name" xml:"name"`
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
+	Message string `form:"message" json:"message" xml:"message"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetAccountTransfersInvalidArgResponseBody is the type of the "GuildsService"
+// service "GetAccountTransfers" endpoint HTTP response body for the
+// "invalid_arg" error.
+type GetAccountTransfersInvalidArgResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name string `form:"name" json:"name" xml:"name"`
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
+	Message string `form:"message" json:"message" xml:"message"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetAccountTransfersInternalResponseBody is the type of the "GuildsService"
+// service "GetAccountTransfers" endpoint HTTP response body for the "internal"
+// error.
+type GetAccountTransfersInternalResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name string `form:"name" json:"name" xml:"name"`
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
+	Message string `form:"message" json:"message" xml:"message"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetAccountTransfersNotFoundResponseBody is the type of the "GuildsService"
+// service "GetAccountTransfers" endpoint HTTP response body for the
+// "not_found" error.
+type GetAccountTransfersNotFoundResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name string `form:"name" json:"name" xml:"name"`
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
+	Message string `form:"message" json:"message" xml:"message"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetAccountPositionsInvalidArgResponseBody is the type of the "GuildsService"
+// service "GetAccountPositions" endpoint HTTP response body for the
+// "invalid_arg" error.
+type GetAccountPositionsInvalidArgResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name string `form:"name" json:"name" xml:"name"`
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
+	Message string `form:"message" json:"message" xml:"message"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetAccountPositionsNotFoundResponseBody is the type of the "GuildsService"
+// service "GetAccountPositions" endpoint HTTP response body for the
+// "not_found" error.
+type GetAccountPositionsNotFoundResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name string `form:"name" json:"name" xml:"name"`
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
+	Message string `form:"message" json:"message" xml:"message"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetAccountPositionsInternalResponseBody is the type of the "GuildsService"
+// service "GetAccountPositions" endpoint HTTP response body for the "internal"
+// error.
+type GetAccountPositionsInternalResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name string `form:"name" json:"name" xml:"name"`
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
+	Message string `form:"message" json:"message" xml:"message"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetGuildRiskInvalidArgResponseBody is the type of the "GuildsService"
+// service "GetGuildRisk" endpoint HTTP response body for the "invalid_arg"
+// error.
+type GetGuildRiskInvalidArgResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name string `form:"name" json:"name" xml:"name"`
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
+	Message string `form:"message" json:"message" xml:"message"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetGuildRiskNotFoundResponseBody is the type of the "GuildsService" service
+// "GetGuildRisk" endpoint HTTP response body for the "not_found" error.
+type GetGuildRiskNotFoundResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
+	Name string `form:"name" json:"name" xml:"name"`
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
+	Message string `form:"message" json:"message" xml:"message"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// GetGuildRiskInternalResponseBody is the type of the "GuildsService" service
+// "GetGuildRisk" endpoint HTTP response body for the "internal" error.
+type GetGuildRiskInternalResponseBody struct {
+	// Name of error: not_found, invalid_arg or internal
 	Name string `form:"name" json:"name" xml:"name"`
-	// ID is a unique identifier for this particular occurrence of the problem.
-	ID string `form:"id" json:"id" xml:"id"`
-	// Message is a human-readable explanation specific to this occurrence of the
-	// problem.
+	// Machine-readable error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message in english
 	Message string `form:"message" json:"message" xml:"message"`
-	// Is the error temporary?
-	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
-	// Is the error a timeout?
-	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
-	// Is the error a server-side fault?
-	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Values of the error, e.g. denom and min_amount_usd of INSUFFICIENT_BALANCE
+	Details map[string]string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
 }
 
 // GuildResponseBody is used to define fields on response body types.
@@ -601,6 +1202,13 @@ type GuildResponseBody struct {
 	MemberCount          int                               `form:"member_count" json:"member_count" xml:"member_count"`
 	CurrentPortfolio     *SingleGuildPortfolioResponseBody `form:"current_portfolio,omitempty" json:"current_portfolio,omitempty" xml:"current_portfolio,omitempty"`
 	DefaultMemberAddress string                            `form:"default_member_address" json:"default_member_address" xml:"default_member_address"`
+	// Guild has delisted or paused markets
+	HasInactiveMarkets *bool `form:"has_inactive_markets,omitempty" json:"has_inactive_markets,omitempty" xml:"has_inactive_markets,omitempty"`
+	// Archived guild is closed to new members, its history is kept
+	Archived *bool `form:"archived,omitempty" json:"archived,omitempty" xml:"archived,omitempty"`
+	// open, allowlist or invite_code, invite code is passed in params when
+	// entering guild
+	AccessMode *string `form:"access_mode,omitempty" json:"access_mode,omitempty" xml:"access_mode,omitempty"`
 }
 
 // RequirementResponseBody is used to define fields on response body types.
@@ -616,6 +1224,16 @@ type SingleGuildPortfolioResponseBody struct {
 	GuildID   *string                `form:"guild_id,omitempty" json:"guild_id,omitempty" xml:"guild_id,omitempty"`
 	Balances  []*BalanceResponseBody `form:"balances" json:"balances" xml:"balances"`
 	UpdatedAt int64                  `form:"updated_at" json:"updated_at" xml:"updated_at"`
+	// Usd value of balances, unrealized pnl and bank balances
+	ValueUsd  *float64                `form:"value_usd,omitempty" json:"value_usd,omitempty" xml:"value_usd,omitempty"`
+	CashFlows []*CashFlowResponseBody `form:"cash_flows,omitempty" json:"cash_flows,omitempty" xml:"cash_flows,omitempty"`
+	// Usd value of member transfers and joins/leaves since previous snapshot
+	NetFlowUsd *float64 `form:"net_flow_usd,omitempty" json:"net_flow_usd,omitempty" xml:"net_flow_usd,omitempty"`
+	// Cumulative time-weighted return since first snapshot of the requested range
+	Twr *float64 `form:"twr,omitempty" json:"twr,omitempty" xml:"twr,omitempty"`
+	// Cumulative money-weighted (modified Dietz) return since first snapshot of
+	// the requested range
+	Mwr *float64 `form:"mwr,omitempty" json:"mwr,omitempty" xml:"mwr,omitempty"`
 }
 
 // BalanceResponseBody is used to define fields on response body types.
@@ -628,6 +1246,15 @@ type BalanceResponseBody struct {
 	PriceUsd         float64 `form:"price_usd" json:"price_usd" xml:"price_usd"`
 }
 
+// CashFlowResponseBody is used to define fields on response body types.
+type CashFlowResponseBody struct {
+	Type             string  `form:"type" json:"type" xml:"type"`
+	InjectiveAddress *string `form:"injective_address,omitempty" json:"injective_address,omitempty" xml:"injective_address,omitempty"`
+	Denom            string  `form:"denom" json:"denom" xml:"denom"`
+	Amount           string  `form:"amount" json:"amount" xml:"amount"`
+	ExecutedAt       int64   `form:"executed_at" json:"executed_at" xml:"executed_at"`
+}
+
 // GuildMemberResponseBody is used to define fields on response body types.
 type GuildMemberResponseBody struct {
 	InjectiveAddress     string  `form:"injective_address" json:"injective_address" xml:"injective_address"`
@@ -635,12 +1262,37 @@ type GuildMemberResponseBody struct {
 	Since                int64   `form:"since" json:"since" xml:"since"`
 	GuildID              *string `form:"guild_id,omitempty" json:"guild_id,omitempty" xml:"guild_id,omitempty"`
 	Params               string  `form:"params" json:"params" xml:"params"`
+	// Registered non-default subaccounts, portfolio is aggregated over default
+	// subaccount and these
+	SubaccountIds []string `form:"subaccount_ids,omitempty" json:"subaccount_ids,omitempty" xml:"subaccount_ids,omitempty"`
+}
+
+// WaitlistEntryResponseBody is used to define fields on response body types.
+type WaitlistEntryResponseBody struct {
+	InjectiveAddress string `form:"injective_address" json:"injective_address" xml:"injective_address"`
+	// 1-based position in waitlist
+	Position   int   `form:"position" json:"position" xml:"position"`
+	EnqueuedAt int64 `form:"enqueued_at" json:"enqueued_at" xml:"enqueued_at"`
 }
 
 // MarketResponseBody is used to define fields on response body types.
 type MarketResponseBody struct {
 	MarketID    string `form:"market_id" json:"market_id" xml:"market_id"`
 	IsPerpetual bool   `form:"is_perpetual" json:"is_perpetual" xml:"is_perpetual"`
+	// Market status of exchange, empty if it has not been refreshed
+	Status       *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	MakerFeeRate *string `form:"maker_fee_rate,omitempty" json:"maker_fee_rate,omitempty" xml:"maker_fee_rate,omitempty"`
+	TakerFeeRate *string `form:"taker_fee_rate,omitempty" json:"taker_fee_rate,omitempty" xml:"taker_fee_rate,omitempty"`
+}
+
+// NetFlowResponseBody is used to define fields on response body types.
+type NetFlowResponseBody struct {
+	Denom       string `form:"denom" json:"denom" xml:"denom"`
+	Deposits    string `form:"deposits" json:"deposits" xml:"deposits"`
+	Withdrawals string `form:"withdrawals" json:"withdrawals" xml:"withdrawals"`
+	// deposits - withdrawals
+	NetFlow       string `form:"net_flow" json:"net_flow" xml:"net_flow"`
+	TransferCount int    `form:"transfer_count" json:"transfer_count" xml:"transfer_count"`
 }
 
 // SingleAccountPortfolioResponseBody is used to define fields on response body
@@ -649,6 +1301,27 @@ type SingleAccountPortfolioResponseBody struct {
 	InjectiveAddress string                 `form:"injective_address" json:"injective_address" xml:"injective_address"`
 	Balances         []*BalanceResponseBody `form:"balances" json:"balances" xml:"balances"`
 	UpdatedAt        int64                  `form:"updated_at" json:"updated_at" xml:"updated_at"`
+	// Usd value of balances, unrealized pnl and bank balances
+	ValueUsd  *float64                `form:"value_usd,omitempty" json:"value_usd,omitempty" xml:"value_usd,omitempty"`
+	CashFlows []*CashFlowResponseBody `form:"cash_flows,omitempty" json:"cash_flows,omitempty" xml:"cash_flows,omitempty"`
+	// Usd value of cash flows since previous snapshot
+	NetFlowUsd *float64 `form:"net_flow_usd,omitempty" json:"net_flow_usd,omitempty" xml:"net_flow_usd,omitempty"`
+	// Cumulative time-weighted return since first snapshot of the requested range
+	Twr *float64 `form:"twr,omitempty" json:"twr,omitempty" xml:"twr,omitempty"`
+	// Cumulative money-weighted (modified Dietz) return since first snapshot of
+	// the requested range
+	Mwr *float64 `form:"mwr,omitempty" json:"mwr,omitempty" xml:"mwr,omitempty"`
+	// Guild of the address at the time snapshot was taken
+	GuildID *string `form:"guild_id,omitempty" json:"guild_id,omitempty" xml:"guild_id,omitempty"`
+}
+
+// MembershipResponseBody is used to define fields on response body types.
+type MembershipResponseBody struct {
+	GuildID              string `form:"guild_id" json:"guild_id" xml:"guild_id"`
+	IsDefaultGuildMember bool   `form:"is_default_guild_member" json:"is_default_guild_member" xml:"is_default_guild_member"`
+	Since                int64  `form:"since" json:"since" xml:"since"`
+	// Time member left the guild in milliseconds, omitted for current membership
+	EndedAt *int64 `form:"ended_at,omitempty" json:"ended_at,omitempty" xml:"ended_at,omitempty"`
 }
 
 // MonthlyAccountPortfolioResponseBody is used to define fields on response
@@ -659,6 +1332,136 @@ type MonthlyAccountPortfolioResponseBody struct {
 	EndSnapshot   *SingleAccountPortfolioResponseBody `form:"end_snapshot" json:"end_snapshot" xml:"end_snapshot"`
 }
 
+// GrantResponseBody is used to define fields on response body types.
+type GrantResponseBody struct {
+	Msg       string `form:"msg" json:"msg" xml:"msg"`
+	IsGranted bool   `form:"is_granted" json:"is_granted" xml:"is_granted"`
+	// Grant expiration in milliseconds, omitted if not granted
+	Expiration *int64 `form:"expiration,omitempty" json:"expiration,omitempty" xml:"expiration,omitempty"`
+}
+
+// TradeResponseBody is used to define fields on response body types.
+type TradeResponseBody struct {
+	TradeID       string `form:"trade_id" json:"trade_id" xml:"trade_id"`
+	SubaccountID  string `form:"subaccount_id" json:"subaccount_id" xml:"subaccount_id"`
+	MarketID      string `form:"market_id" json:"market_id" xml:"market_id"`
+	IsPerpetual   bool   `form:"is_perpetual" json:"is_perpetual" xml:"is_perpetual"`
+	Direction     string `form:"direction" json:"direction" xml:"direction"`
+	ExecutionType string `form:"execution_type" json:"execution_type" xml:"execution_type"`
+	Price         string `form:"price" json:"price" xml:"price"`
+	Quantity      string `form:"quantity" json:"quantity" xml:"quantity"`
+	QuoteDenom    string `form:"quote_denom" json:"quote_denom" xml:"quote_denom"`
+	Fee           string `form:"fee" json:"fee" xml:"fee"`
+	// price * quantity in quote denom
+	Volume string `form:"volume" json:"volume" xml:"volume"`
+	// Pnl of closed quantity against average entry price, fee excluded
+	RealizedPnl string `form:"realized_pnl" json:"realized_pnl" xml:"realized_pnl"`
+	ExecutedAt  int64  `form:"executed_at" json:"executed_at" xml:"executed_at"`
+}
+
+// TradeStatsResponseBody is used to define fields on response body types.
+type TradeStatsResponseBody struct {
+	QuoteDenom  string `form:"quote_denom" json:"quote_denom" xml:"quote_denom"`
+	RealizedPnl string `form:"realized_pnl" json:"realized_pnl" xml:"realized_pnl"`
+	Fees        string `form:"fees" json:"fees" xml:"fees"`
+	Volume      string `form:"volume" json:"volume" xml:"volume"`
+	TradeCount  int    `form:"trade_count" json:"trade_count" xml:"trade_count"`
+}
+
+// GuildFeeTotalResponseBody is used to define fields on response body types.
+type GuildFeeTotalResponseBody struct {
+	QuoteDenom string `form:"quote_denom" json:"quote_denom" xml:"quote_denom"`
+	// Trading fees paid by members, maker rebates deducted
+	FeesPaid string `form:"fees_paid" json:"fees_paid" xml:"fees_paid"`
+	// Relayer share of fees earned by guild master address
+	MasterFees string `form:"master_fees" json:"master_fees" xml:"master_fees"`
+	Volume     string `form:"volume" json:"volume" xml:"volume"`
+	TradeCount int    `form:"trade_count" json:"trade_count" xml:"trade_count"`
+	// Relayer share of fees paid on trades whose fee recipient couldn't be
+	// resolved, not counted in master_fees
+	UnresolvedFees string `form:"unresolved_fees" json:"unresolved_fees" xml:"unresolved_fees"`
+}
+
+// MemberFeeContributionResponseBody is used to define fields on response body
+// types.
+type MemberFeeContributionResponseBody struct {
+	InjectiveAddress string `form:"injective_address" json:"injective_address" xml:"injective_address"`
+	QuoteDenom       string `form:"quote_denom" json:"quote_denom" xml:"quote_denom"`
+	FeesPaid         string `form:"fees_paid" json:"fees_paid" xml:"fees_paid"`
+	MasterFees       string `form:"master_fees" json:"master_fees" xml:"master_fees"`
+	Volume           string `form:"volume" json:"volume" xml:"volume"`
+	TradeCount       int    `form:"trade_count" json:"trade_count" xml:"trade_count"`
+	// Member master_fees over guild master_fees in the quote denom, from 0 to 1
+	Share          string `form:"share" json:"share" xml:"share"`
+	UnresolvedFees string `form:"unresolved_fees" json:"unresolved_fees" xml:"unresolved_fees"`
+}
+
+// DailyGuildFeeResponseBody is used to define fields on response body types.
+type DailyGuildFeeResponseBody struct {
+	// Start of UTC day in milliseconds
+	Day            int64  `form:"day" json:"day" xml:"day"`
+	QuoteDenom     string `form:"quote_denom" json:"quote_denom" xml:"quote_denom"`
+	FeesPaid       string `form:"fees_paid" json:"fees_paid" xml:"fees_paid"`
+	MasterFees     string `form:"master_fees" json:"master_fees" xml:"master_fees"`
+	Volume         string `form:"volume" json:"volume" xml:"volume"`
+	TradeCount     int    `form:"trade_count" json:"trade_count" xml:"trade_count"`
+	UnresolvedFees string `form:"unresolved_fees" json:"unresolved_fees" xml:"unresolved_fees"`
+}
+
+// TransferResponseBody is used to define fields on response body types.
+type TransferResponseBody struct {
+	TransferID   string `form:"transfer_id" json:"transfer_id" xml:"transfer_id"`
+	SubaccountID string `form:"subaccount_id" json:"subaccount_id" xml:"subaccount_id"`
+	TransferType string `form:"transfer_type" json:"transfer_type" xml:"transfer_type"`
+	Denom        string `form:"denom" json:"denom" xml:"denom"`
+	// Positive for deposit, negative for withdrawal
+	Amount     string `form:"amount" json:"amount" xml:"amount"`
+	ExecutedAt int64  `form:"executed_at" json:"executed_at" xml:"executed_at"`
+}
+
+// PositionResponseBody is used to define fields on response body types.
+type PositionResponseBody struct {
+	SubaccountID     string  `form:"subaccount_id" json:"subaccount_id" xml:"subaccount_id"`
+	MarketID         string  `form:"market_id" json:"market_id" xml:"market_id"`
+	Ticker           string  `form:"ticker" json:"ticker" xml:"ticker"`
+	QuoteDenom       *string `form:"quote_denom,omitempty" json:"quote_denom,omitempty" xml:"quote_denom,omitempty"`
+	Direction        string  `form:"direction" json:"direction" xml:"direction"`
+	Quantity         string  `form:"quantity" json:"quantity" xml:"quantity"`
+	EntryPrice       string  `form:"entry_price" json:"entry_price" xml:"entry_price"`
+	MarkPrice        string  `form:"mark_price" json:"mark_price" xml:"mark_price"`
+	Margin           string  `form:"margin" json:"margin" xml:"margin"`
+	LiquidationPrice string  `form:"liquidation_price" json:"liquidation_price" xml:"liquidation_price"`
+}
+
+// GuildRiskResponseBody is used to define fields on response body types.
+type GuildRiskResponseBody struct {
+	GuildID string `form:"guild_id" json:"guild_id" xml:"guild_id"`
+	// Time of first guild snapshot used for drawdown, volatility and sharpe ratio
+	WindowStart   int64 `form:"window_start" json:"window_start" xml:"window_start"`
+	SnapshotCount int   `form:"snapshot_count" json:"snapshot_count" xml:"snapshot_count"`
+	// Largest peak to trough decline of time-weighted value, as a positive fraction
+	MaxDrawdown float64 `form:"max_drawdown" json:"max_drawdown" xml:"max_drawdown"`
+	// Standard deviation of per-snapshot returns
+	Volatility float64 `form:"volatility" json:"volatility" xml:"volatility"`
+	// Mean over standard deviation of per-snapshot returns, not annualized
+	SharpeRatio float64 `form:"sharpe_ratio" json:"sharpe_ratio" xml:"sharpe_ratio"`
+	NotionalUsd float64 `form:"notional_usd" json:"notional_usd" xml:"notional_usd"`
+	EquityUsd   float64 `form:"equity_usd" json:"equity_usd" xml:"equity_usd"`
+	// Notional over equity
+	Leverage  float64                       `form:"leverage" json:"leverage" xml:"leverage"`
+	Markets   []*MarketExposureResponseBody `form:"markets" json:"markets" xml:"markets"`
+	UpdatedAt int64                         `form:"updated_at" json:"updated_at" xml:"updated_at"`
+}
+
+// MarketExposureResponseBody is used to define fields on response body types.
+type MarketExposureResponseBody struct {
+	MarketID    string  `form:"market_id" json:"market_id" xml:"market_id"`
+	Ticker      string  `form:"ticker" json:"ticker" xml:"ticker"`
+	NotionalUsd float64 `form:"notional_usd" json:"notional_usd" xml:"notional_usd"`
+	// Fraction of guild total notional
+	Share float64 `form:"share" json:"share" xml:"share"`
+}
+
 // NewGetAllGuildsResponseBody builds the HTTP response body from the result of
 // the "GetAllGuilds" endpoint of the "GuildsService" service.
 func NewGetAllGuildsResponseBody(res *guildsservice.GetAllGuildsResult) *GetAllGuildsResponseBody {
@@ -720,8 +1523,22 @@ func NewGetGuildDefaultMemberResponseBody(res *guildsservice.GetGuildDefaultMemb
 // the "EnterGuild" endpoint of the "GuildsService" service.
 func NewEnterGuildResponseBody(res *guildsservice.EnterGuildResult) *EnterGuildResponseBody {
 	body := &EnterGuildResponseBody{
-		JoinStatus: res.JoinStatus,
-		Message:    res.Message,
+		JoinStatus:       res.JoinStatus,
+		Message:          res.Message,
+		WaitlistPosition: res.WaitlistPosition,
+	}
+	return body
+}
+
+// NewGetGuildWaitlistResponseBody builds the HTTP response body from the
+// result of the "GetGuildWaitlist" endpoint of the "GuildsService" service.
+func NewGetGuildWaitlistResponseBody(res *guildsservice.GetGuildWaitlistResult) *GetGuildWaitlistResponseBody {
+	body := &GetGuildWaitlistResponseBody{}
+	if res.Waitlist != nil {
+		body.Waitlist = make([]*WaitlistEntryResponseBody, len(res.Waitlist))
+		for i, val := range res.Waitlist {
+			body.Waitlist[i] = marshalGuildsserviceWaitlistEntryToWaitlistEntryResponseBody(val)
+		}
 	}
 	return body
 }
@@ -735,7 +1552,17 @@ func NewLeaveGuildResponseBody(res *guildsservice.LeaveGuildResult) *LeaveGuildR
 	return body
 }
 
-// NewGetGuildMarketsResponseBody builds the HTTP response body from the result
+// NewTransferMemberResponseBody builds the HTTP response body from the result
+// of the "TransferMember" endpoint of the "GuildsService" service.
+func NewTransferMemberResponseBody(res *guildsservice.TransferMemberResult) *TransferMemberResponseBody {
+	body := &TransferMemberResponseBody{
+		TransferStatus: res.TransferStatus,
+		FromGuildID:    res.FromGuildID,
+	}
+	return body
+}
+
+// NewGetGuildMarketsResponseBody builds the HTTP response body from the result
 // of the "GetGuildMarkets" endpoint of the "GuildsService" service.
 func NewGetGuildMarketsResponseBody(res *guildsservice.GetGuildMarketsResult) *GetGuildMarketsResponseBody {
 	body := &GetGuildMarketsResponseBody{}
@@ -758,6 +1585,12 @@ func NewGetGuildPortfoliosResponseBody(res *guildsservice.GetGuildPortfoliosResu
 			body.Portfolios[i] = marshalGuildsserviceSingleGuildPortfolioToSingleGuildPortfolioResponseBody(val)
 		}
 	}
+	if res.NetFlows != nil {
+		body.NetFlows = make([]*NetFlowResponseBody, len(res.NetFlows))
+		for i, val := range res.NetFlows {
+			body.NetFlows[i] = marshalGuildsserviceNetFlowToNetFlowResponseBody(val)
+		}
+	}
 	return body
 }
 
@@ -791,6 +1624,26 @@ func NewGetAccountPortfoliosResponseBody(res *guildsservice.GetAccountPortfolios
 			body.Portfolios[i] = marshalGuildsserviceSingleAccountPortfolioToSingleAccountPortfolioResponseBody(val)
 		}
 	}
+	if res.NetFlows != nil {
+		body.NetFlows = make([]*NetFlowResponseBody, len(res.NetFlows))
+		for i, val := range res.NetFlows {
+			body.NetFlows[i] = marshalGuildsserviceNetFlowToNetFlowResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewGetAccountMembershipsResponseBody builds the HTTP response body from the
+// result of the "GetAccountMemberships" endpoint of the "GuildsService"
+// service.
+func NewGetAccountMembershipsResponseBody(res *guildsservice.GetAccountMembershipsResult) *GetAccountMembershipsResponseBody {
+	body := &GetAccountMembershipsResponseBody{}
+	if res.Memberships != nil {
+		body.Memberships = make([]*MembershipResponseBody, len(res.Memberships))
+		for i, val := range res.Memberships {
+			body.Memberships[i] = marshalGuildsserviceMembershipToMembershipResponseBody(val)
+		}
+	}
 	return body
 }
 
@@ -808,86 +1661,296 @@ func NewGetAccountMonthlyPortfoliosResponseBody(res *guildsservice.GetAccountMon
 	return body
 }
 
+// NewGetAccountGrantsResponseBody builds the HTTP response body from the
+// result of the "GetAccountGrants" endpoint of the "GuildsService" service.
+func NewGetAccountGrantsResponseBody(res *guildsservice.GetAccountGrantsResult) *GetAccountGrantsResponseBody {
+	body := &GetAccountGrantsResponseBody{}
+	if res.Grants != nil {
+		body.Grants = make([]*GrantResponseBody, len(res.Grants))
+		for i, val := range res.Grants {
+			body.Grants[i] = marshalGuildsserviceGrantToGrantResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewGetAccountTradesResponseBody builds the HTTP response body from the
+// result of the "GetAccountTrades" endpoint of the "GuildsService" service.
+func NewGetAccountTradesResponseBody(res *guildsservice.GetAccountTradesResult) *GetAccountTradesResponseBody {
+	body := &GetAccountTradesResponseBody{}
+	if res.Trades != nil {
+		body.Trades = make([]*TradeResponseBody, len(res.Trades))
+		for i, val := range res.Trades {
+			body.Trades[i] = marshalGuildsserviceTradeToTradeResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewGetAccountTradeStatsResponseBody builds the HTTP response body from the
+// result of the "GetAccountTradeStats" endpoint of the "GuildsService" service.
+func NewGetAccountTradeStatsResponseBody(res *guildsservice.GetAccountTradeStatsResult) *GetAccountTradeStatsResponseBody {
+	body := &GetAccountTradeStatsResponseBody{}
+	if res.Stats != nil {
+		body.Stats = make([]*TradeStatsResponseBody, len(res.Stats))
+		for i, val := range res.Stats {
+			body.Stats[i] = marshalGuildsserviceTradeStatsToTradeStatsResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewGetGuildTradeStatsResponseBody builds the HTTP response body from the
+// result of the "GetGuildTradeStats" endpoint of the "GuildsService" service.
+func NewGetGuildTradeStatsResponseBody(res *guildsservice.GetGuildTradeStatsResult) *GetGuildTradeStatsResponseBody {
+	body := &GetGuildTradeStatsResponseBody{}
+	if res.Stats != nil {
+		body.Stats = make([]*TradeStatsResponseBody, len(res.Stats))
+		for i, val := range res.Stats {
+			body.Stats[i] = marshalGuildsserviceTradeStatsToTradeStatsResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewGetGuildFeesResponseBody builds the HTTP response body from the result of
+// the "GetGuildFees" endpoint of the "GuildsService" service.
+func NewGetGuildFeesResponseBody(res *guildsservice.GetGuildFeesResult) *GetGuildFeesResponseBody {
+	body := &GetGuildFeesResponseBody{
+		MasterAddress: res.MasterAddress,
+	}
+	if res.Totals != nil {
+		body.Totals = make([]*GuildFeeTotalResponseBody, len(res.Totals))
+		for i, val := range res.Totals {
+			body.Totals[i] = marshalGuildsserviceGuildFeeTotalToGuildFeeTotalResponseBody(val)
+		}
+	}
+	if res.Members != nil {
+		body.Members = make([]*MemberFeeContributionResponseBody, len(res.Members))
+		for i, val := range res.Members {
+			body.Members[i] = marshalGuildsserviceMemberFeeContributionToMemberFeeContributionResponseBody(val)
+		}
+	}
+	if res.Daily != nil {
+		body.Daily = make([]*DailyGuildFeeResponseBody, len(res.Daily))
+		for i, val := range res.Daily {
+			body.Daily[i] = marshalGuildsserviceDailyGuildFeeToDailyGuildFeeResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewGetAccountTransfersResponseBody builds the HTTP response body from the
+// result of the "GetAccountTransfers" endpoint of the "GuildsService" service.
+func NewGetAccountTransfersResponseBody(res *guildsservice.GetAccountTransfersResult) *GetAccountTransfersResponseBody {
+	body := &GetAccountTransfersResponseBody{}
+	if res.Transfers != nil {
+		body.Transfers = make([]*TransferResponseBody, len(res.Transfers))
+		for i, val := range res.Transfers {
+			body.Transfers[i] = marshalGuildsserviceTransferToTransferResponseBody(val)
+		}
+	}
+	if res.NetFlows != nil {
+		body.NetFlows = make([]*NetFlowResponseBody, len(res.NetFlows))
+		for i, val := range res.NetFlows {
+			body.NetFlows[i] = marshalGuildsserviceNetFlowToNetFlowResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewGetAccountPositionsResponseBody builds the HTTP response body from the
+// result of the "GetAccountPositions" endpoint of the "GuildsService" service.
+func NewGetAccountPositionsResponseBody(res *guildsservice.GetAccountPositionsResult) *GetAccountPositionsResponseBody {
+	body := &GetAccountPositionsResponseBody{
+		UpdatedAt: res.UpdatedAt,
+	}
+	if res.Positions != nil {
+		body.Positions = make([]*PositionResponseBody, len(res.Positions))
+		for i, val := range res.Positions {
+			body.Positions[i] = marshalGuildsservicePositionToPositionResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewGetGuildRiskResponseBody builds the HTTP response body from the result of
+// the "GetGuildRisk" endpoint of the "GuildsService" service.
+func NewGetGuildRiskResponseBody(res *guildsservice.GetGuildRiskResult) *GetGuildRiskResponseBody {
+	body := &GetGuildRiskResponseBody{}
+	if res.Data != nil {
+		body.Data = marshalGuildsserviceGuildRiskToGuildRiskResponseBody(res.Data)
+	}
+	return body
+}
+
 // NewGetAllGuildsNotFoundResponseBody builds the HTTP response body from the
 // result of the "GetAllGuilds" endpoint of the "GuildsService" service.
-func NewGetAllGuildsNotFoundResponseBody(res *goa.ServiceError) *GetAllGuildsNotFoundResponseBody {
+func NewGetAllGuildsNotFoundResponseBody(res *guildsservice.APIError) *GetAllGuildsNotFoundResponseBody {
 	body := &GetAllGuildsNotFoundResponseBody{
-		Name:      res.Name,
-		ID:        res.ID,
-		Message:   res.Message,
-		Temporary: res.Temporary,
-		Timeout:   res.Timeout,
-		Fault:     res.Fault,
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
 	}
 	return body
 }
 
 // NewGetAllGuildsInternalResponseBody builds the HTTP response body from the
 // result of the "GetAllGuilds" endpoint of the "GuildsService" service.
-func NewGetAllGuildsInternalResponseBody(res *goa.ServiceError) *GetAllGuildsInternalResponseBody {
+func NewGetAllGuildsInternalResponseBody(res *guildsservice.APIError) *GetAllGuildsInternalResponseBody {
 	body := &GetAllGuildsInternalResponseBody{
-		Name:      res.Name,
-		ID:        res.ID,
-		Message:   res.Message,
-		Temporary: res.Temporary,
-		Timeout:   res.Timeout,
-		Fault:     res.Fault,
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewGetAllGuildsInvalidArgResponseBody builds the HTTP response body from the
+// result of the "GetAllGuilds" endpoint of the "GuildsService" service.
+func NewGetAllGuildsInvalidArgResponseBody(res *guildsservice.APIError) *GetAllGuildsInvalidArgResponseBody {
+	body := &GetAllGuildsInvalidArgResponseBody{
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
 	}
 	return body
 }
 
 // NewGetSingleGuildNotFoundResponseBody builds the HTTP response body from the
 // result of the "GetSingleGuild" endpoint of the "GuildsService" service.
-func NewGetSingleGuildNotFoundResponseBody(res *goa.ServiceError) *GetSingleGuildNotFoundResponseBody {
+func NewGetSingleGuildNotFoundResponseBody(res *guildsservice.APIError) *GetSingleGuildNotFoundResponseBody {
 	body := &GetSingleGuildNotFoundResponseBody{
-		Name:      res.Name,
-		ID:        res.ID,
-		Message:   res.Message,
-		Temporary: res.Temporary,
-		Timeout:   res.Timeout,
-		Fault:     res.Fault,
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
 	}
 	return body
 }
 
 // NewGetSingleGuildInternalResponseBody builds the HTTP response body from the
 // result of the "GetSingleGuild" endpoint of the "GuildsService" service.
-func NewGetSingleGuildInternalResponseBody(res *goa.ServiceError) *GetSingleGuildInternalResponseBody {
+func NewGetSingleGuildInternalResponseBody(res *guildsservice.APIError) *GetSingleGuildInternalResponseBody {
 	body := &GetSingleGuildInternalResponseBody{
-		Name:      res.Name,
-		ID:        res.ID,
-		Message:   res.Message,
-		Temporary: res.Temporary,
-		Timeout:   res.Timeout,
-		Fault:     res.Fault,
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewGetSingleGuildInvalidArgResponseBody builds the HTTP response body from
+// the result of the "GetSingleGuild" endpoint of the "GuildsService" service.
+func NewGetSingleGuildInvalidArgResponseBody(res *guildsservice.APIError) *GetSingleGuildInvalidArgResponseBody {
+	body := &GetSingleGuildInvalidArgResponseBody{
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewGetGuildMembersInvalidArgResponseBody builds the HTTP response body from
+// the result of the "GetGuildMembers" endpoint of the "GuildsService" service.
+func NewGetGuildMembersInvalidArgResponseBody(res *guildsservice.APIError) *GetGuildMembersInvalidArgResponseBody {
+	body := &GetGuildMembersInvalidArgResponseBody{
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
 	}
 	return body
 }
 
 // NewGetGuildMembersNotFoundResponseBody builds the HTTP response body from
 // the result of the "GetGuildMembers" endpoint of the "GuildsService" service.
-func NewGetGuildMembersNotFoundResponseBody(res *goa.ServiceError) *GetGuildMembersNotFoundResponseBody {
+func NewGetGuildMembersNotFoundResponseBody(res *guildsservice.APIError) *GetGuildMembersNotFoundResponseBody {
 	body := &GetGuildMembersNotFoundResponseBody{
-		Name:      res.Name,
-		ID:        res.ID,
-		Message:   res.Message,
-		Temporary: res.Temporary,
-		Timeout:   res.Timeout,
-		Fault:     res.Fault,
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
 	}
 	return body
 }
 
 // NewGetGuildMembersInternalResponseBody builds the HTTP response body from
 // the result of the "GetGuildMembers" endpoint of the "GuildsService" service.
-func NewGetGuildMembersInternalResponseBody(res *goa.ServiceError) *GetGuildMembersInternalResponseBody {
+func NewGetGuildMembersInternalResponseBody(res *guildsservice.APIError) *GetGuildMembersInternalResponseBody {
 	body := &GetGuildMembersInternalResponseBody{
-		Name:      res.Name,
-		ID:        res.ID,
-		Message:   res.Message,
-		Temporary: res.Temporary,
-		Timeout:   res.Timeout,
-		Fault:     res.Fault,
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
 	}
 	return body
 }
@@ -895,14 +1958,19 @@ func NewGetGuildMembersInternalResponseBody(res *goa.ServiceError) *GetGuildMemb
 // NewGetGuildMasterAddressNotFoundResponseBody builds the HTTP response body
 // from the result of the "GetGuildMasterAddress" endpoint of the
 // "GuildsService" service.
-func NewGetGuildMasterAddressNotFoundResponseBody(res *goa.ServiceError) *GetGuildMasterAddressNotFoundResponseBody {
+func NewGetGuildMasterAddressNotFoundResponseBody(res *guildsservice.APIError) *GetGuildMasterAddressNotFoundResponseBody {
 	body := &GetGuildMasterAddressNotFoundResponseBody{
-		Name:      res.Name,
-		ID:        res.ID,
-		Message:   res.Message,
-		Temporary: res.Temporary,
-		Timeout:   res.Timeout,
-		Fault:     res.Fault,
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
 	}
 	return body
 }
@@ -910,14 +1978,59 @@ func NewGetGuildMasterAddressNotFoundResponseBody(res *goa.ServiceError) *GetGui
 // NewGetGuildMasterAddressInternalResponseBody builds the HTTP response body
 // from the result of the "GetGuildMasterAddress" endpoint of the
 // "GuildsService" service.
-func NewGetGuildMasterAddressInternalResponseBody(res *goa.ServiceError) *GetGuildMasterAddressInternalResponseBody {
+func NewGetGuildMasterAddressInternalResponseBody(res *guildsservice.APIError) *GetGuildMasterAddressInternalResponseBody {
 	body := &GetGuildMasterAddressInternalResponseBody{
-		Name:      res.Name,
-		ID:        res.ID,
-		Message:   res.Message,
-		Temporary: res.Temporary,
-		Timeout:   res.Timeout,
-		Fault:     res.Fault,
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewGetGuildMasterAddressInvalidArgResponseBody builds the HTTP response body
+// from the result of the "GetGuildMasterAddress" endpoint of the
+// "GuildsService" service.
+func NewGetGuildMasterAddressInvalidArgResponseBody(res *guildsservice.APIError) *GetGuildMasterAddressInvalidArgResponseBody {
+	body := &GetGuildMasterAddressInvalidArgResponseBody{
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewGetGuildDefaultMemberInvalidArgResponseBody builds the HTTP response body
+// from the result of the "GetGuildDefaultMember" endpoint of the
+// "GuildsService" service.
+func NewGetGuildDefaultMemberInvalidArgResponseBody(res *guildsservice.APIError) *GetGuildDefaultMemberInvalidArgResponseBody {
+	body := &GetGuildDefaultMemberInvalidArgResponseBody{
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
 	}
 	return body
 }
@@ -925,14 +2038,19 @@ func NewGetGuildMasterAddressInternalResponseBody(res *goa.ServiceError) *GetGui
 // NewGetGuildDefaultMemberNotFoundResponseBody builds the HTTP response body
 // from the result of the "GetGuildDefaultMember" endpoint of the
 // "GuildsService" service.
-func NewGetGuildDefaultMemberNotFoundResponseBody(res *goa.ServiceError) *GetGuildDefaultMemberNotFoundResponseBody {
+func NewGetGuildDefaultMemberNotFoundResponseBody(res *guildsservice.APIError) *GetGuildDefaultMemberNotFoundResponseBody {
 	body := &GetGuildDefaultMemberNotFoundResponseBody{
-		Name:      res.Name,
-		ID:        res.ID,
-		Message:   res.Message,
-		Temporary: res.Temporary,
-		Timeout:   res.Timeout,
-		Fault:     res.Fault,
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
 	}
 	return body
 }
@@ -940,98 +2058,304 @@ func NewGetGuildDefaultMemberNotFoundResponseBody(res *goa.ServiceError) *GetGui
 // NewGetGuildDefaultMemberInternalResponseBody builds the HTTP response body
 // from the result of the "GetGuildDefaultMember" endpoint of the
 // "GuildsService" service.
-func NewGetGuildDefaultMemberInternalResponseBody(res *goa.ServiceError) *GetGuildDefaultMemberInternalResponseBody {
+func NewGetGuildDefaultMemberInternalResponseBody(res *guildsservice.APIError) *GetGuildDefaultMemberInternalResponseBody {
 	body := &GetGuildDefaultMemberInternalResponseBody{
-		Name:      res.Name,
-		ID:        res.ID,
-		Message:   res.Message,
-		Temporary: res.Temporary,
-		Timeout:   res.Timeout,
-		Fault:     res.Fault,
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
 	}
 	return body
 }
 
 // NewEnterGuildNotFoundResponseBody builds the HTTP response body from the
 // result of the "EnterGuild" endpoint of the "GuildsService" service.
-func NewEnterGuildNotFoundResponseBody(res *goa.ServiceError) *EnterGuildNotFoundResponseBody {
+func NewEnterGuildNotFoundResponseBody(res *guildsservice.APIError) *EnterGuildNotFoundResponseBody {
 	body := &EnterGuildNotFoundResponseBody{
-		Name:      res.Name,
-		ID:        res.ID,
-		Message:   res.Message,
-		Temporary: res.Temporary,
-		Timeout:   res.Timeout,
-		Fault:     res.Fault,
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
 	}
 	return body
 }
 
 // NewEnterGuildInternalResponseBody builds the HTTP response body from the
 // result of the "EnterGuild" endpoint of the "GuildsService" service.
-func NewEnterGuildInternalResponseBody(res *goa.ServiceError) *EnterGuildInternalResponseBody {
+func NewEnterGuildInternalResponseBody(res *guildsservice.APIError) *EnterGuildInternalResponseBody {
 	body := &EnterGuildInternalResponseBody{
-		Name:      res.Name,
-		ID:        res.ID,
-		Message:   res.Message,
-		Temporary: res.Temporary,
-		Timeout:   res.Timeout,
-		Fault:     res.Fault,
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewEnterGuildInvalidArgResponseBody builds the HTTP response body from the
+// result of the "EnterGuild" endpoint of the "GuildsService" service.
+func NewEnterGuildInvalidArgResponseBody(res *guildsservice.APIError) *EnterGuildInvalidArgResponseBody {
+	body := &EnterGuildInvalidArgResponseBody{
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewGetGuildWaitlistInvalidArgResponseBody builds the HTTP response body from
+// the result of the "GetGuildWaitlist" endpoint of the "GuildsService" service.
+func NewGetGuildWaitlistInvalidArgResponseBody(res *guildsservice.APIError) *GetGuildWaitlistInvalidArgResponseBody {
+	body := &GetGuildWaitlistInvalidArgResponseBody{
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewGetGuildWaitlistNotFoundResponseBody builds the HTTP response body from
+// the result of the "GetGuildWaitlist" endpoint of the "GuildsService" service.
+func NewGetGuildWaitlistNotFoundResponseBody(res *guildsservice.APIError) *GetGuildWaitlistNotFoundResponseBody {
+	body := &GetGuildWaitlistNotFoundResponseBody{
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewGetGuildWaitlistInternalResponseBody builds the HTTP response body from
+// the result of the "GetGuildWaitlist" endpoint of the "GuildsService" service.
+func NewGetGuildWaitlistInternalResponseBody(res *guildsservice.APIError) *GetGuildWaitlistInternalResponseBody {
+	body := &GetGuildWaitlistInternalResponseBody{
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
 	}
 	return body
 }
 
 // NewLeaveGuildNotFoundResponseBody builds the HTTP response body from the
 // result of the "LeaveGuild" endpoint of the "GuildsService" service.
-func NewLeaveGuildNotFoundResponseBody(res *goa.ServiceError) *LeaveGuildNotFoundResponseBody {
+func NewLeaveGuildNotFoundResponseBody(res *guildsservice.APIError) *LeaveGuildNotFoundResponseBody {
 	body := &LeaveGuildNotFoundResponseBody{
-		Name:      res.Name,
-		ID:        res.ID,
-		Message:   res.Message,
-		Temporary: res.Temporary,
-		Timeout:   res.Timeout,
-		Fault:     res.Fault,
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
 	}
 	return body
 }
 
 // NewLeaveGuildInternalResponseBody builds the HTTP response body from the
 // result of the "LeaveGuild" endpoint of the "GuildsService" service.
-func NewLeaveGuildInternalResponseBody(res *goa.ServiceError) *LeaveGuildInternalResponseBody {
+func NewLeaveGuildInternalResponseBody(res *guildsservice.APIError) *LeaveGuildInternalResponseBody {
 	body := &LeaveGuildInternalResponseBody{
-		Name:      res.Name,
-		ID:        res.ID,
-		Message:   res.Message,
-		Temporary: res.Temporary,
-		Timeout:   res.Timeout,
-		Fault:     res.Fault,
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewLeaveGuildInvalidArgResponseBody builds the HTTP response body from the
+// result of the "LeaveGuild" endpoint of the "GuildsService" service.
+func NewLeaveGuildInvalidArgResponseBody(res *guildsservice.APIError) *LeaveGuildInvalidArgResponseBody {
+	body := &LeaveGuildInvalidArgResponseBody{
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewTransferMemberInvalidArgResponseBody builds the HTTP response body from
+// the result of the "TransferMember" endpoint of the "GuildsService" service.
+func NewTransferMemberInvalidArgResponseBody(res *guildsservice.APIError) *TransferMemberInvalidArgResponseBody {
+	body := &TransferMemberInvalidArgResponseBody{
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewTransferMemberNotFoundResponseBody builds the HTTP response body from the
+// result of the "TransferMember" endpoint of the "GuildsService" service.
+func NewTransferMemberNotFoundResponseBody(res *guildsservice.APIError) *TransferMemberNotFoundResponseBody {
+	body := &TransferMemberNotFoundResponseBody{
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewTransferMemberInternalResponseBody builds the HTTP response body from the
+// result of the "TransferMember" endpoint of the "GuildsService" service.
+func NewTransferMemberInternalResponseBody(res *guildsservice.APIError) *TransferMemberInternalResponseBody {
+	body := &TransferMemberInternalResponseBody{
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
 	}
 	return body
 }
 
 // NewGetGuildMarketsNotFoundResponseBody builds the HTTP response body from
 // the result of the "GetGuildMarkets" endpoint of the "GuildsService" service.
-func NewGetGuildMarketsNotFoundResponseBody(res *goa.ServiceError) *GetGuildMarketsNotFoundResponseBody {
+func NewGetGuildMarketsNotFoundResponseBody(res *guildsservice.APIError) *GetGuildMarketsNotFoundResponseBody {
 	body := &GetGuildMarketsNotFoundResponseBody{
-		Name:      res.Name,
-		ID:        res.ID,
-		Message:   res.Message,
-		Temporary: res.Temporary,
-		Timeout:   res.Timeout,
-		Fault:     res.Fault,
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
 	}
 	return body
 }
 
 // NewGetGuildMarketsInternalResponseBody builds the HTTP response body from
 // the result of the "GetGuildMarkets" endpoint of the "GuildsService" service.
-func NewGetGuildMarketsInternalResponseBody(res *goa.ServiceError) *GetGuildMarketsInternalResponseBody {
+func NewGetGuildMarketsInternalResponseBody(res *guildsservice.APIError) *GetGuildMarketsInternalResponseBody {
 	body := &GetGuildMarketsInternalResponseBody{
-		Name:      res.Name,
-		ID:        res.ID,
-		Message:   res.Message,
-		Temporary: res.Temporary,
-		Timeout:   res.Timeout,
-		Fault:     res.Fault,
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewGetGuildMarketsInvalidArgResponseBody builds the HTTP response body from
+// the result of the "GetGuildMarkets" endpoint of the "GuildsService" service.
+func NewGetGuildMarketsInvalidArgResponseBody(res *guildsservice.APIError) *GetGuildMarketsInvalidArgResponseBody {
+	body := &GetGuildMarketsInvalidArgResponseBody{
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
 	}
 	return body
 }
@@ -1039,14 +2363,19 @@ func NewGetGuildMarketsInternalResponseBody(res *goa.ServiceError) *GetGuildMark
 // NewGetGuildPortfoliosNotFoundResponseBody builds the HTTP response body from
 // the result of the "GetGuildPortfolios" endpoint of the "GuildsService"
 // service.
-func NewGetGuildPortfoliosNotFoundResponseBody(res *goa.ServiceError) *GetGuildPortfoliosNotFoundResponseBody {
+func NewGetGuildPortfoliosNotFoundResponseBody(res *guildsservice.APIError) *GetGuildPortfoliosNotFoundResponseBody {
 	body := &GetGuildPortfoliosNotFoundResponseBody{
-		Name:      res.Name,
-		ID:        res.ID,
-		Message:   res.Message,
-		Temporary: res.Temporary,
-		Timeout:   res.Timeout,
-		Fault:     res.Fault,
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
 	}
 	return body
 }
@@ -1054,42 +2383,96 @@ func NewGetGuildPortfoliosNotFoundResponseBody(res *goa.ServiceError) *GetGuildP
 // NewGetGuildPortfoliosInternalResponseBody builds the HTTP response body from
 // the result of the "GetGuildPortfolios" endpoint of the "GuildsService"
 // service.
-func NewGetGuildPortfoliosInternalResponseBody(res *goa.ServiceError) *GetGuildPortfoliosInternalResponseBody {
+func NewGetGuildPortfoliosInternalResponseBody(res *guildsservice.APIError) *GetGuildPortfoliosInternalResponseBody {
 	body := &GetGuildPortfoliosInternalResponseBody{
-		Name:      res.Name,
-		ID:        res.ID,
-		Message:   res.Message,
-		Temporary: res.Temporary,
-		Timeout:   res.Timeout,
-		Fault:     res.Fault,
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewGetGuildPortfoliosInvalidArgResponseBody builds the HTTP response body
+// from the result of the "GetGuildPortfolios" endpoint of the "GuildsService"
+// service.
+func NewGetGuildPortfoliosInvalidArgResponseBody(res *guildsservice.APIError) *GetGuildPortfoliosInvalidArgResponseBody {
+	body := &GetGuildPortfoliosInvalidArgResponseBody{
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
 	}
 	return body
 }
 
 // NewGetAccountInfoNotFoundResponseBody builds the HTTP response body from the
 // result of the "GetAccountInfo" endpoint of the "GuildsService" service.
-func NewGetAccountInfoNotFoundResponseBody(res *goa.ServiceError) *GetAccountInfoNotFoundResponseBody {
+func NewGetAccountInfoNotFoundResponseBody(res *guildsservice.APIError) *GetAccountInfoNotFoundResponseBody {
 	body := &GetAccountInfoNotFoundResponseBody{
-		Name:      res.Name,
-		ID:        res.ID,
-		Message:   res.Message,
-		Temporary: res.Temporary,
-		Timeout:   res.Timeout,
-		Fault:     res.Fault,
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
 	}
 	return body
 }
 
 // NewGetAccountInfoInternalResponseBody builds the HTTP response body from the
 // result of the "GetAccountInfo" endpoint of the "GuildsService" service.
-func NewGetAccountInfoInternalResponseBody(res *goa.ServiceError) *GetAccountInfoInternalResponseBody {
+func NewGetAccountInfoInternalResponseBody(res *guildsservice.APIError) *GetAccountInfoInternalResponseBody {
 	body := &GetAccountInfoInternalResponseBody{
-		Name:      res.Name,
-		ID:        res.ID,
-		Message:   res.Message,
-		Temporary: res.Temporary,
-		Timeout:   res.Timeout,
-		Fault:     res.Fault,
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewGetAccountInfoInvalidArgResponseBody builds the HTTP response body from
+// the result of the "GetAccountInfo" endpoint of the "GuildsService" service.
+func NewGetAccountInfoInvalidArgResponseBody(res *guildsservice.APIError) *GetAccountInfoInvalidArgResponseBody {
+	body := &GetAccountInfoInvalidArgResponseBody{
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
 	}
 	return body
 }
@@ -1097,14 +2480,19 @@ func NewGetAccountInfoInternalResponseBody(res *goa.ServiceError) *GetAccountInf
 // NewGetAccountPortfolioNotFoundResponseBody builds the HTTP response body
 // from the result of the "GetAccountPortfolio" endpoint of the "GuildsService"
 // service.
-func NewGetAccountPortfolioNotFoundResponseBody(res *goa.ServiceError) *GetAccountPortfolioNotFoundResponseBody {
+func NewGetAccountPortfolioNotFoundResponseBody(res *guildsservice.APIError) *GetAccountPortfolioNotFoundResponseBody {
 	body := &GetAccountPortfolioNotFoundResponseBody{
-		Name:      res.Name,
-		ID:        res.ID,
-		Message:   res.Message,
-		Temporary: res.Temporary,
-		Timeout:   res.Timeout,
-		Fault:     res.Fault,
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
 	}
 	return body
 }
@@ -1112,14 +2500,59 @@ func NewGetAccountPortfolioNotFoundResponseBody(res *goa.ServiceError) *GetAccou
 // NewGetAccountPortfolioInternalResponseBody builds the HTTP response body
 // from the result of the "GetAccountPortfolio" endpoint of the "GuildsService"
 // service.
-func NewGetAccountPortfolioInternalResponseBody(res *goa.ServiceError) *GetAccountPortfolioInternalResponseBody {
+func NewGetAccountPortfolioInternalResponseBody(res *guildsservice.APIError) *GetAccountPortfolioInternalResponseBody {
 	body := &GetAccountPortfolioInternalResponseBody{
-		Name:      res.Name,
-		ID:        res.ID,
-		Message:   res.Message,
-		Temporary: res.Temporary,
-		Timeout:   res.Timeout,
-		Fault:     res.Fault,
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewGetAccountPortfolioInvalidArgResponseBody builds the HTTP response body
+// from the result of the "GetAccountPortfolio" endpoint of the "GuildsService"
+// service.
+func NewGetAccountPortfolioInvalidArgResponseBody(res *guildsservice.APIError) *GetAccountPortfolioInvalidArgResponseBody {
+	body := &GetAccountPortfolioInvalidArgResponseBody{
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewGetAccountPortfoliosInvalidArgResponseBody builds the HTTP response body
+// from the result of the "GetAccountPortfolios" endpoint of the
+// "GuildsService" service.
+func NewGetAccountPortfoliosInvalidArgResponseBody(res *guildsservice.APIError) *GetAccountPortfoliosInvalidArgResponseBody {
+	body := &GetAccountPortfoliosInvalidArgResponseBody{
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
 	}
 	return body
 }
@@ -1127,14 +2560,19 @@ func NewGetAccountPortfolioInternalResponseBody(res *goa.ServiceError) *GetAccou
 // NewGetAccountPortfoliosNotFoundResponseBody builds the HTTP response body
 // from the result of the "GetAccountPortfolios" endpoint of the
 // "GuildsService" service.
-func NewGetAccountPortfoliosNotFoundResponseBody(res *goa.ServiceError) *GetAccountPortfoliosNotFoundResponseBody {
+func NewGetAccountPortfoliosNotFoundResponseBody(res *guildsservice.APIError) *GetAccountPortfoliosNotFoundResponseBody {
 	body := &GetAccountPortfoliosNotFoundResponseBody{
-		Name:      res.Name,
-		ID:        res.ID,
-		Message:   res.Message,
-		Temporary: res.Temporary,
-		Timeout:   res.Timeout,
-		Fault:     res.Fault,
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
 	}
 	return body
 }
@@ -1142,14 +2580,79 @@ func NewGetAccountPortfoliosNotFoundResponseBody(res *goa.ServiceError) *GetAcco
 // NewGetAccountPortfoliosInternalResponseBody builds the HTTP response body
 // from the result of the "GetAccountPortfolios" endpoint of the
 // "GuildsService" service.
-func NewGetAccountPortfoliosInternalResponseBody(res *goa.ServiceError) *GetAccountPortfoliosInternalResponseBody {
+func NewGetAccountPortfoliosInternalResponseBody(res *guildsservice.APIError) *GetAccountPortfoliosInternalResponseBody {
 	body := &GetAccountPortfoliosInternalResponseBody{
-		Name:      res.Name,
-		ID:        res.ID,
-		Message:   res.Message,
-		Temporary: res.Temporary,
-		Timeout:   res.Timeout,
-		Fault:     res.Fault,
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewGetAccountMembershipsInvalidArgResponseBody builds the HTTP response body
+// from the result of the "GetAccountMemberships" endpoint of the
+// "GuildsService" service.
+func NewGetAccountMembershipsInvalidArgResponseBody(res *guildsservice.APIError) *GetAccountMembershipsInvalidArgResponseBody {
+	body := &GetAccountMembershipsInvalidArgResponseBody{
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewGetAccountMembershipsInternalResponseBody builds the HTTP response body
+// from the result of the "GetAccountMemberships" endpoint of the
+// "GuildsService" service.
+func NewGetAccountMembershipsInternalResponseBody(res *guildsservice.APIError) *GetAccountMembershipsInternalResponseBody {
+	body := &GetAccountMembershipsInternalResponseBody{
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewGetAccountMembershipsNotFoundResponseBody builds the HTTP response body
+// from the result of the "GetAccountMemberships" endpoint of the
+// "GuildsService" service.
+func NewGetAccountMembershipsNotFoundResponseBody(res *guildsservice.APIError) *GetAccountMembershipsNotFoundResponseBody {
+	body := &GetAccountMembershipsNotFoundResponseBody{
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
 	}
 	return body
 }
@@ -1157,14 +2660,19 @@ func NewGetAccountPortfoliosInternalResponseBody(res *goa.ServiceError) *GetAcco
 // NewGetAccountMonthlyPortfoliosNotFoundResponseBody builds the HTTP response
 // body from the result of the "GetAccountMonthlyPortfolios" endpoint of the
 // "GuildsService" service.
-func NewGetAccountMonthlyPortfoliosNotFoundResponseBody(res *goa.ServiceError) *GetAccountMonthlyPortfoliosNotFoundResponseBody {
+func NewGetAccountMonthlyPortfoliosNotFoundResponseBody(res *guildsservice.APIError) *GetAccountMonthlyPortfoliosNotFoundResponseBody {
 	body := &GetAccountMonthlyPortfoliosNotFoundResponseBody{
-		Name:      res.Name,
-		ID:        res.ID,
-		Message:   res.Message,
-		Temporary: res.Temporary,
-		Timeout:   res.Timeout,
-		Fault:     res.Fault,
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
 	}
 	return body
 }
@@ -1172,18 +2680,520 @@ func NewGetAccountMonthlyPortfoliosNotFoundResponseBody(res *goa.ServiceError) *
 // NewGetAccountMonthlyPortfoliosInternalResponseBody builds the HTTP response
 // body from the result of the "GetAccountMonthlyPortfolios" endpoint of the
 // "GuildsService" service.
-func NewGetAccountMonthlyPortfoliosInternalResponseBody(res *goa.ServiceError) *GetAccountMonthlyPortfoliosInternalResponseBody {
+func NewGetAccountMonthlyPortfoliosInternalResponseBody(res *guildsservice.APIError) *GetAccountMonthlyPortfoliosInternalResponseBody {
 	body := &GetAccountMonthlyPortfoliosInternalResponseBody{
-		Name:      res.Name,
-		ID:        res.ID,
-		Message:   res.Message,
-		Temporary: res.Temporary,
-		Timeout:   res.Timeout,
-		Fault:     res.Fault,
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewGetAccountMonthlyPortfoliosInvalidArgResponseBody builds the HTTP
+// response body from the result of the "GetAccountMonthlyPortfolios" endpoint
+// of the "GuildsService" service.
+func NewGetAccountMonthlyPortfoliosInvalidArgResponseBody(res *guildsservice.APIError) *GetAccountMonthlyPortfoliosInvalidArgResponseBody {
+	body := &GetAccountMonthlyPortfoliosInvalidArgResponseBody{
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewGetAccountGrantsNotFoundResponseBody builds the HTTP response body from
+// the result of the "GetAccountGrants" endpoint of the "GuildsService" service.
+func NewGetAccountGrantsNotFoundResponseBody(res *guildsservice.APIError) *GetAccountGrantsNotFoundResponseBody {
+	body := &GetAccountGrantsNotFoundResponseBody{
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewGetAccountGrantsInvalidArgResponseBody builds the HTTP response body from
+// the result of the "GetAccountGrants" endpoint of the "GuildsService" service.
+func NewGetAccountGrantsInvalidArgResponseBody(res *guildsservice.APIError) *GetAccountGrantsInvalidArgResponseBody {
+	body := &GetAccountGrantsInvalidArgResponseBody{
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewGetAccountGrantsInternalResponseBody builds the HTTP response body from
+// the result of the "GetAccountGrants" endpoint of the "GuildsService" service.
+func NewGetAccountGrantsInternalResponseBody(res *guildsservice.APIError) *GetAccountGrantsInternalResponseBody {
+	body := &GetAccountGrantsInternalResponseBody{
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewGetAccountTradesInvalidArgResponseBody builds the HTTP response body from
+// the result of the "GetAccountTrades" endpoint of the "GuildsService" service.
+func NewGetAccountTradesInvalidArgResponseBody(res *guildsservice.APIError) *GetAccountTradesInvalidArgResponseBody {
+	body := &GetAccountTradesInvalidArgResponseBody{
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewGetAccountTradesInternalResponseBody builds the HTTP response body from
+// the result of the "GetAccountTrades" endpoint of the "GuildsService" service.
+func NewGetAccountTradesInternalResponseBody(res *guildsservice.APIError) *GetAccountTradesInternalResponseBody {
+	body := &GetAccountTradesInternalResponseBody{
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewGetAccountTradesNotFoundResponseBody builds the HTTP response body from
+// the result of the "GetAccountTrades" endpoint of the "GuildsService" service.
+func NewGetAccountTradesNotFoundResponseBody(res *guildsservice.APIError) *GetAccountTradesNotFoundResponseBody {
+	body := &GetAccountTradesNotFoundResponseBody{
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewGetAccountTradeStatsInvalidArgResponseBody builds the HTTP response body
+// from the result of the "GetAccountTradeStats" endpoint of the
+// "GuildsService" service.
+func NewGetAccountTradeStatsInvalidArgResponseBody(res *guildsservice.APIError) *GetAccountTradeStatsInvalidArgResponseBody {
+	body := &GetAccountTradeStatsInvalidArgResponseBody{
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewGetAccountTradeStatsInternalResponseBody builds the HTTP response body
+// from the result of the "GetAccountTradeStats" endpoint of the
+// "GuildsService" service.
+func NewGetAccountTradeStatsInternalResponseBody(res *guildsservice.APIError) *GetAccountTradeStatsInternalResponseBody {
+	body := &GetAccountTradeStatsInternalResponseBody{
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewGetAccountTradeStatsNotFoundResponseBody builds the HTTP response body
+// from the result of the "GetAccountTradeStats" endpoint of the
+// "GuildsService" service.
+func NewGetAccountTradeStatsNotFoundResponseBody(res *guildsservice.APIError) *GetAccountTradeStatsNotFoundResponseBody {
+	body := &GetAccountTradeStatsNotFoundResponseBody{
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewGetGuildTradeStatsInvalidArgResponseBody builds the HTTP response body
+// from the result of the "GetGuildTradeStats" endpoint of the "GuildsService"
+// service.
+func NewGetGuildTradeStatsInvalidArgResponseBody(res *guildsservice.APIError) *GetGuildTradeStatsInvalidArgResponseBody {
+	body := &GetGuildTradeStatsInvalidArgResponseBody{
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewGetGuildTradeStatsNotFoundResponseBody builds the HTTP response body from
+// the result of the "GetGuildTradeStats" endpoint of the "GuildsService"
+// service.
+func NewGetGuildTradeStatsNotFoundResponseBody(res *guildsservice.APIError) *GetGuildTradeStatsNotFoundResponseBody {
+	body := &GetGuildTradeStatsNotFoundResponseBody{
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewGetGuildTradeStatsInternalResponseBody builds the HTTP response body from
+// the result of the "GetGuildTradeStats" endpoint of the "GuildsService"
+// service.
+func NewGetGuildTradeStatsInternalResponseBody(res *guildsservice.APIError) *GetGuildTradeStatsInternalResponseBody {
+	body := &GetGuildTradeStatsInternalResponseBody{
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewGetGuildFeesInvalidArgResponseBody builds the HTTP response body from the
+// result of the "GetGuildFees" endpoint of the "GuildsService" service.
+func NewGetGuildFeesInvalidArgResponseBody(res *guildsservice.APIError) *GetGuildFeesInvalidArgResponseBody {
+	body := &GetGuildFeesInvalidArgResponseBody{
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
 	}
 	return body
 }
 
+// NewGetGuildFeesNotFoundResponseBody builds the HTTP response body from the
+// result of the "GetGuildFees" endpoint of the "GuildsService" service.
+func NewGetGuildFeesNotFoundResponseBody(res *guildsservice.APIError) *GetGuildFeesNotFoundResponseBody {
+	body := &GetGuildFeesNotFoundResponseBody{
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewGetGuildFeesInternalResponseBody builds the HTTP response body from the
+// result of the "GetGuildFees" endpoint of the "GuildsService" service.
+func NewGetGuildFeesInternalResponseBody(res *guildsservice.APIError) *GetGuildFeesInternalResponseBody {
+	body := &GetGuildFeesInternalResponseBody{
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewGetAccountTransfersInvalidArgResponseBody builds the HTTP response body
+// from the result of the "GetAccountTransfers" endpoint of the "GuildsService"
+// service.
+func NewGetAccountTransfersInvalidArgResponseBody(res *guildsservice.APIError) *GetAccountTransfersInvalidArgResponseBody {
+	body := &GetAccountTransfersInvalidArgResponseBody{
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewGetAccountTransfersInternalResponseBody builds the HTTP response body
+// from the result of the "GetAccountTransfers" endpoint of the "GuildsService"
+// service.
+func NewGetAccountTransfersInternalResponseBody(res *guildsservice.APIError) *GetAccountTransfersInternalResponseBody {
+	body := &GetAccountTransfersInternalResponseBody{
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewGetAccountTransfersNotFoundResponseBody builds the HTTP response body
+// from the result of the "GetAccountTransfers" endpoint of the "GuildsService"
+// service.
+func NewGetAccountTransfersNotFoundResponseBody(res *guildsservice.APIError) *GetAccountTransfersNotFoundResponseBody {
+	body := &GetAccountTransfersNotFoundResponseBody{
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewGetAccountPositionsInvalidArgResponseBody builds the HTTP response body
+// from the result of the "GetAccountPositions" endpoint of the "GuildsService"
+// service.
+func NewGetAccountPositionsInvalidArgResponseBody(res *guildsservice.APIError) *GetAccountPositionsInvalidArgResponseBody {
+	body := &GetAccountPositionsInvalidArgResponseBody{
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewGetAccountPositionsNotFoundResponseBody builds the HTTP response body
+// from the result of the "GetAccountPositions" endpoint of the "GuildsService"
+// service.
+func NewGetAccountPositionsNotFoundResponseBody(res *guildsservice.APIError) *GetAccountPositionsNotFoundResponseBody {
+	body := &GetAccountPositionsNotFoundResponseBody{
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewGetAccountPositionsInternalResponseBody builds the HTTP response body
+// from the result of the "GetAccountPositions" endpoint of the "GuildsService"
+// service.
+func NewGetAccountPositionsInternalResponseBody(res *guildsservice.APIError) *GetAccountPositionsInternalResponseBody {
+	body := &GetAccountPositionsInternalResponseBody{
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewGetGuildRiskInvalidArgResponseBody builds the HTTP response body from the
+// result of the "GetGuildRisk" endpoint of the "GuildsService" service.
+func NewGetGuildRiskInvalidArgResponseBody(res *guildsservice.APIError) *GetGuildRiskInvalidArgResponseBody {
+	body := &GetGuildRiskInvalidArgResponseBody{
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewGetGuildRiskNotFoundResponseBody builds the HTTP response body from the
+// result of the "GetGuildRisk" endpoint of the "GuildsService" service.
+func NewGetGuildRiskNotFoundResponseBody(res *guildsservice.APIError) *GetGuildRiskNotFoundResponseBody {
+	body := &GetGuildRiskNotFoundResponseBody{
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewGetGuildRiskInternalResponseBody builds the HTTP response body from the
+// result of the "GetGuildRisk" endpoint of the "GuildsService" service.
+func NewGetGuildRiskInternalResponseBody(res *guildsservice.APIError) *GetGuildRiskInternalResponseBody {
+	body := &GetGuildRiskInternalResponseBody{
+		Name:    res.Name,
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.Details != nil {
+		body.Details = make(map[string]string, len(res.Details))
+		for key, val := range res.Details {
+			tk := key
+			tv := val
+			body.Details[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewGetAllGuildsPayload builds a GuildsService service GetAllGuilds endpoint
+// payload.
+func NewGetAllGuildsPayload(includeArchived *bool) *guildsservice.GetAllGuildsPayload {
+	v := &guildsservice.GetAllGuildsPayload{}
+	v.IncludeArchived = includeArchived
+
+	return v
+}
+
 // NewGetSingleGuildPayload builds a GuildsService service GetSingleGuild
 // endpoint payload.
 func NewGetSingleGuildPayload(guildID string) *guildsservice.GetSingleGuildPayload {
@@ -1227,7 +3237,23 @@ func NewEnterGuildPayload(body *EnterGuildRequestBody, guildID string) *guildsse
 		InjectiveAddress: *body.InjectiveAddress,
 		Params:           body.Params,
 	}
+	if body.SubaccountIds != nil {
+		v.SubaccountIds = make([]string, len(body.SubaccountIds))
+		for i, val := range body.SubaccountIds {
+			v.SubaccountIds[i] = val
+		}
+	}
+	v.GuildID = guildID
+
+	return v
+}
+
+// NewGetGuildWaitlistPayload builds a GuildsService service GetGuildWaitlist
+// endpoint payload.
+func NewGetGuildWaitlistPayload(guildID string, injectiveAddress *string) *guildsservice.GetGuildWaitlistPayload {
+	v := &guildsservice.GetGuildWaitlistPayload{}
 	v.GuildID = guildID
+	v.InjectiveAddress = injectiveAddress
 
 	return v
 }
@@ -1242,6 +3268,24 @@ func NewLeaveGuildPayload(guildID string, injectiveAddress string) *guildsservic
 	return v
 }
 
+// NewTransferMemberPayload builds a GuildsService service TransferMember
+// endpoint payload.
+func NewTransferMemberPayload(body *TransferMemberRequestBody, injectiveAddress string) *guildsservice.TransferMemberPayload {
+	v := &guildsservice.TransferMemberPayload{
+		GuildID: *body.GuildID,
+		Params:  body.Params,
+	}
+	if body.SubaccountIds != nil {
+		v.SubaccountIds = make([]string, len(body.SubaccountIds))
+		for i, val := range body.SubaccountIds {
+			v.SubaccountIds[i] = val
+		}
+	}
+	v.InjectiveAddress = injectiveAddress
+
+	return v
+}
+
 // NewGetGuildMarketsPayload builds a GuildsService service GetGuildMarkets
 // endpoint payload.
 func NewGetGuildMarketsPayload(guildID string) *guildsservice.GetGuildMarketsPayload {
@@ -1282,11 +3326,21 @@ func NewGetAccountPortfolioPayload(injectiveAddress string) *guildsservice.GetAc
 
 // NewGetAccountPortfoliosPayload builds a GuildsService service
 // GetAccountPortfolios endpoint payload.
-func NewGetAccountPortfoliosPayload(injectiveAddress string, startTime *int64, endTime *int64) *guildsservice.GetAccountPortfoliosPayload {
+func NewGetAccountPortfoliosPayload(injectiveAddress string, startTime *int64, endTime *int64, guildID *string) *guildsservice.GetAccountPortfoliosPayload {
 	v := &guildsservice.GetAccountPortfoliosPayload{}
 	v.InjectiveAddress = injectiveAddress
 	v.StartTime = startTime
 	v.EndTime = endTime
+	v.GuildID = guildID
+
+	return v
+}
+
+// NewGetAccountMembershipsPayload builds a GuildsService service
+// GetAccountMemberships endpoint payload.
+func NewGetAccountMembershipsPayload(injectiveAddress string) *guildsservice.GetAccountMembershipsPayload {
+	v := &guildsservice.GetAccountMembershipsPayload{}
+	v.InjectiveAddress = injectiveAddress
 
 	return v
 }
@@ -1302,6 +3356,90 @@ func NewGetAccountMonthlyPortfoliosPayload(injectiveAddress string, startTime *i
 	return v
 }
 
+// NewGetAccountGrantsPayload builds a GuildsService service GetAccountGrants
+// endpoint payload.
+func NewGetAccountGrantsPayload(injectiveAddress string) *guildsservice.GetAccountGrantsPayload {
+	v := &guildsservice.GetAccountGrantsPayload{}
+	v.InjectiveAddress = injectiveAddress
+
+	return v
+}
+
+// NewGetAccountTradesPayload builds a GuildsService service GetAccountTrades
+// endpoint payload.
+func NewGetAccountTradesPayload(injectiveAddress string, startTime *int64, endTime *int64, limit *int64) *guildsservice.GetAccountTradesPayload {
+	v := &guildsservice.GetAccountTradesPayload{}
+	v.InjectiveAddress = injectiveAddress
+	v.StartTime = startTime
+	v.EndTime = endTime
+	v.Limit = limit
+
+	return v
+}
+
+// NewGetAccountTradeStatsPayload builds a GuildsService service
+// GetAccountTradeStats endpoint payload.
+func NewGetAccountTradeStatsPayload(injectiveAddress string, startTime *int64, endTime *int64) *guildsservice.GetAccountTradeStatsPayload {
+	v := &guildsservice.GetAccountTradeStatsPayload{}
+	v.InjectiveAddress = injectiveAddress
+	v.StartTime = startTime
+	v.EndTime = endTime
+
+	return v
+}
+
+// NewGetGuildTradeStatsPayload builds a GuildsService service
+// GetGuildTradeStats endpoint payload.
+func NewGetGuildTradeStatsPayload(guildID string, startTime *int64, endTime *int64) *guildsservice.GetGuildTradeStatsPayload {
+	v := &guildsservice.GetGuildTradeStatsPayload{}
+	v.GuildID = guildID
+	v.StartTime = startTime
+	v.EndTime = endTime
+
+	return v
+}
+
+// NewGetGuildFeesPayload builds a GuildsService service GetGuildFees endpoint
+// payload.
+func NewGetGuildFeesPayload(guildID string, startTime *int64, endTime *int64) *guildsservice.GetGuildFeesPayload {
+	v := &guildsservice.GetGuildFeesPayload{}
+	v.GuildID = guildID
+	v.StartTime = startTime
+	v.EndTime = endTime
+
+	return v
+}
+
+// NewGetAccountTransfersPayload builds a GuildsService service
+// GetAccountTransfers endpoint payload.
+func NewGetAccountTransfersPayload(injectiveAddress string, startTime *int64, endTime *int64, limit *int64) *guildsservice.GetAccountTransfersPayload {
+	v := &guildsservice.GetAccountTransfersPayload{}
+	v.InjectiveAddress = injectiveAddress
+	v.StartTime = startTime
+	v.EndTime = endTime
+	v.Limit = limit
+
+	return v
+}
+
+// NewGetAccountPositionsPayload builds a GuildsService service
+// GetAccountPositions endpoint payload.
+func NewGetAccountPositionsPayload(injectiveAddress string) *guildsservice.GetAccountPositionsPayload {
+	v := &guildsservice.GetAccountPositionsPayload{}
+	v.InjectiveAddress = injectiveAddress
+
+	return v
+}
+
+// NewGetGuildRiskPayload builds a GuildsService service GetGuildRisk endpoint
+// payload.
+func NewGetGuildRiskPayload(guildID string) *guildsservice.GetGuildRiskPayload {
+	v := &guildsservice.GetGuildRiskPayload{}
+	v.GuildID = guildID
+
+	return v
+}
+
 // ValidateEnterGuildRequestBody runs the validations defined on
 // EnterGuildRequestBody
 func ValidateEnterGuildRequestBody(body *EnterGuildRequestBody) (err error) {
@@ -1310,3 +3448,12 @@ func ValidateEnterGuildRequestBody(body *EnterGuildRequestBody) (err error) {
 	}
 	return
 }
+
+// ValidateTransferMemberRequestBody runs the validations defined on
+// TransferMemberRequestBody
+func ValidateTransferMemberRequestBody(body *TransferMemberRequestBody) (err error) {
+	if body.GuildID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("guild_id", "body"))
+	}
+	return
+}
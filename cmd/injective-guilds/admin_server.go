@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/health"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/prom"
+	"github.com/xlab/closer"
+	log "github.com/xlab/suplog"
+	goahttp "goa.design/goa/v3/http"
+)
+
+// mounter registers extra admin routes
+type mounter interface {
+	Mount(mux goahttp.Muxer)
+}
+
+// AdminServer is a small http listener for processes which don't serve the api
+type AdminServer struct {
+	server *http.Server
+}
+
+func NewAdminServer(listenAddress string, checks []health.Check, prometheusEnabled bool, handlers ...mounter) (*AdminServer, error) {
+	address, tls, err := parseListenAddress(listenAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if tls {
+		return nil, fmt.Errorf("admin server doesn't support tls: %s", listenAddress)
+	}
+
+	mux := goahttp.NewMuxer()
+	health.NewHandler(checks...).Mount(mux)
+	if prometheusEnabled {
+		mux.Handle(http.MethodGet, "/metrics", prom.Handler().ServeHTTP)
+	}
+
+	for _, h := range handlers {
+		h.Mount(mux)
+	}
+
+	return &AdminServer{
+		server: &http.Server{Addr: address, Handler: mux},
+	}, nil
+}
+
+func (s *AdminServer) ListenAndServe() {
+	go func() {
+		log.Infoln("admin server listening:", s.server.Addr)
+		err := s.server.ListenAndServe()
+		if err != nil && err != http.ErrServerClosed {
+			log.WithError(err).Errorln("admin listen and serve error")
+
+			// call to gracefully close everything after an error occurs
+			closer.Close()
+		}
+	}()
+}
+
+func (s *AdminServer) GracefullyShutdown() {
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	log.Info("shutting down admin server")
+	if err := s.server.Shutdown(shutdownCtx); err != nil && err != http.ErrServerClosed {
+		log.WithError(err).Error("cannot shutdown admin server")
+	}
+}
@@ -0,0 +1,247 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/mongoimpl"
+	cosmtypes "github.com/cosmos/cosmos-sdk/types"
+	cli "github.com/jawher/mow.cli"
+	log "github.com/xlab/suplog"
+)
+
+const inviteCodeBytes = 8
+
+func parseSetAccessArgs(c *cli.Cmd) {
+	parseGuildIDArg(c)
+
+	accessMode = c.String(cli.StringOpt{
+		Name:  "mode",
+		Desc:  fmt.Sprintf("access mode, one of %s", strings.Join(model.AccessModes, ", ")),
+		Value: model.AccessModeOpen,
+	})
+
+	parseWebhookDBArgs(c)
+}
+
+func setAccessAction() {
+	if !model.IsValidAccessMode(*accessMode) {
+		log.Fatal(fmt.Sprintf("invalid access mode %s", *accessMode))
+	}
+
+	log.Info("connecting database")
+	ctx := context.Background()
+	dbSvc, err := mongoimpl.NewService(ctx, *dbURL, "guilds")
+	panicIf(err)
+
+	err = dbSvc.SetGuildAccessMode(ctx, *guildID, *accessMode)
+	panicIf(err)
+
+	log.Infof("🍺 set access mode of guild %s to %s", *guildID, *accessMode)
+}
+
+func cmdSetAccess(c *cli.Cmd) {
+	// inputs:
+	// guild id: --guild-id
+	// access mode: --mode
+	// db url: --db-url
+	parseSetAccessArgs(c)
+	c.Action = setAccessAction
+}
+
+func parseAllowlistArgs(c *cli.Cmd) {
+	parseGuildIDArg(c)
+
+	allowlistAddresses = c.Strings(cli.StringsOpt{
+		Name:  "address",
+		Desc:  "injective address, can supply many --address",
+		Value: []string{},
+	})
+
+	parseWebhookDBArgs(c)
+}
+
+func allowlistAddressesArg() []model.Address {
+	if len(*allowlistAddresses) == 0 {
+		log.Fatal("no address supplied")
+	}
+
+	addresses := make([]model.Address, 0, len(*allowlistAddresses))
+	for _, a := range *allowlistAddresses {
+		accAddress, err := cosmtypes.AccAddressFromBech32(a)
+		panicIf(err)
+		addresses = append(addresses, model.Address{AccAddress: accAddress})
+	}
+	return addresses
+}
+
+func allowlistAddAction() {
+	addresses := allowlistAddressesArg()
+
+	log.Info("connecting database")
+	ctx := context.Background()
+	dbSvc, err := mongoimpl.NewService(ctx, *dbURL, "guilds")
+	panicIf(err)
+
+	added, err := dbSvc.AddToAllowlist(ctx, *guildID, addresses)
+	panicIf(err)
+
+	log.Infof("🍺 allowlisted %d new addresses in guild %s", added, *guildID)
+}
+
+func allowlistRemoveAction() {
+	addresses := allowlistAddressesArg()
+
+	log.Info("connecting database")
+	ctx := context.Background()
+	dbSvc, err := mongoimpl.NewService(ctx, *dbURL, "guilds")
+	panicIf(err)
+
+	removed, err := dbSvc.RemoveFromAllowlist(ctx, *guildID, addresses)
+	panicIf(err)
+
+	log.Infof("🍺 removed %d addresses from allowlist of guild %s", removed, *guildID)
+}
+
+func cmdAllowlistAdd(c *cli.Cmd) {
+	// inputs:
+	// guild id: --guild-id
+	// addresses (can supply many --address): --address
+	// db url: --db-url
+	parseAllowlistArgs(c)
+	c.Action = allowlistAddAction
+}
+
+func cmdAllowlistRemove(c *cli.Cmd) {
+	// inputs:
+	// guild id: --guild-id
+	// addresses (can supply many --address): --address
+	// db url: --db-url
+	parseAllowlistArgs(c)
+	c.Action = allowlistRemoveAction
+}
+
+func parseCreateInviteCodesArgs(c *cli.Cmd) {
+	parseGuildIDArg(c)
+
+	inviteCodeCount = c.Int(cli.IntOpt{
+		Name:  "count",
+		Desc:  "number of codes to generate",
+		Value: 1,
+	})
+
+	inviteCodeMaxUses = c.Int(cli.IntOpt{
+		Name:  "max-uses",
+		Desc:  "number of members each code can admit, 1 for single-use codes",
+		Value: 1,
+	})
+
+	inviteCodeExpiresIn = c.String(cli.StringOpt{
+		Name:  "expires-in",
+		Desc:  "codes expire after this duration, e.g. 168h, empty means never",
+		Value: "",
+	})
+
+	parseWebhookDBArgs(c)
+}
+
+func newInviteCode() (string, error) {
+	b := make([]byte, inviteCodeBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func createInviteCodesAction() {
+	if *inviteCodeCount <= 0 || *inviteCodeMaxUses <= 0 {
+		log.Fatal("count and max uses must be positive")
+	}
+
+	now := time.Now()
+	var expiresAt *time.Time
+	if *inviteCodeExpiresIn != "" {
+		d, err := time.ParseDuration(*inviteCodeExpiresIn)
+		panicIf(err)
+		t := now.Add(d)
+		expiresAt = &t
+	}
+
+	log.Info("connecting database")
+	ctx := context.Background()
+	dbSvc, err := mongoimpl.NewService(ctx, *dbURL, "guilds")
+	panicIf(err)
+
+	guild, err := dbSvc.GetSingleGuild(ctx, *guildID)
+	panicIf(err)
+
+	if guild.GetAccessMode() != model.AccessModeInviteCode {
+		log.Warningf("guild %s access mode is %s, codes are only checked in %s mode", *guildID, guild.GetAccessMode(), model.AccessModeInviteCode)
+	}
+
+	codes := make([]*model.InviteCode, 0, *inviteCodeCount)
+	for i := 0; i < *inviteCodeCount; i++ {
+		code, err := newInviteCode()
+		panicIf(err)
+
+		codes = append(codes, &model.InviteCode{
+			GuildID:   guild.ID,
+			Code:      code,
+			MaxUses:   *inviteCodeMaxUses,
+			CreatedAt: now,
+			ExpiresAt: expiresAt,
+		})
+	}
+
+	err = dbSvc.AddInviteCodes(ctx, codes)
+	panicIf(err)
+
+	for _, c := range codes {
+		log.Infof("invite code %s max uses %d", c.Code, c.MaxUses)
+	}
+	log.Infof("🍺 created %d invite codes for guild %s", len(codes), *guildID)
+}
+
+func cmdCreateInviteCodes(c *cli.Cmd) {
+	// inputs:
+	// guild id: --guild-id
+	// number of codes: --count
+	// max uses of each code: --max-uses
+	// expiration (optional): --expires-in
+	// db url: --db-url
+	parseCreateInviteCodesArgs(c)
+	c.Action = createInviteCodesAction
+}
+
+func listInviteCodesAction() {
+	log.Info("connecting database")
+	ctx := context.Background()
+	dbSvc, err := mongoimpl.NewService(ctx, *dbURL, "guilds")
+	panicIf(err)
+
+	codes, err := dbSvc.ListInviteCodes(ctx, *guildID)
+	panicIf(err)
+
+	now := time.Now()
+	for _, c := range codes {
+		expiresAt := "never"
+		if c.ExpiresAt != nil {
+			expiresAt = c.ExpiresAt.Format(time.RFC3339)
+		}
+		log.Infof("invite code %s uses %d/%d expires %s usable %t", c.Code, c.Uses, c.MaxUses, expiresAt, c.IsUsable(now))
+	}
+}
+
+func cmdListInviteCodes(c *cli.Cmd) {
+	// inputs:
+	// guild id: --guild-id
+	// db url: --db-url
+	parseGuildIDArg(c)
+	parseWebhookDBArgs(c)
+	c.Action = listInviteCodesAction
+}
@@ -196,7 +196,7 @@ func addGuildAction() {
 		market := marketResp.GetMarket()
 		takerFeeRate, err := primitive.ParseDecimal128(market.GetTakerFeeRate())
 		panicIf(err)
-		marketFeeRate, err := primitive.ParseDecimal128(market.GetMakerFeeRate())
+		makerFeeRate, err := primitive.ParseDecimal128(market.GetMakerFeeRate())
 		panicIf(err)
 
 		markets = append(markets, &model.GuildMarket{
@@ -219,7 +219,7 @@ func addGuildAction() {
 				UpdatedAt: market.GetQuoteTokenMeta().GetUpdatedAt(),
 			},
 			TakerFeeRate: takerFeeRate,
-			MakerFeeRate: marketFeeRate,
+			MakerFeeRate: makerFeeRate,
 		})
 
 		floats, err := toMinAmounts((*spotRequirements)[srIndex])
@@ -240,6 +240,11 @@ func addGuildAction() {
 		panicIf(err)
 
 		market := marketResp.GetMarket()
+		takerFeeRate, err := primitive.ParseDecimal128(market.GetTakerFeeRate())
+		panicIf(err)
+		makerFeeRate, err := primitive.ParseDecimal128(market.GetMakerFeeRate())
+		panicIf(err)
+
 		markets = append(markets, &model.GuildMarket{
 			MarketID:      model.Hash{Hash: common.HexToHash(market.GetMarketId())},
 			IsPerpetual:   true,
@@ -253,6 +258,8 @@ func addGuildAction() {
 				Decimals:  int(market.GetQuoteTokenMeta().GetDecimals()),
 				UpdatedAt: market.GetQuoteTokenMeta().GetUpdatedAt(),
 			},
+			TakerFeeRate: takerFeeRate,
+			MakerFeeRate: makerFeeRate,
 		})
 
 		floats, err := toMinAmounts((*derivativeRequirements)[drIndex])
@@ -304,7 +311,7 @@ func addGuildAction() {
 	}
 
 	log.Info("adding default member")
-	err = dbSvc.AddMember(ctx, id.Hex(), model.Address{AccAddress: defaultMember}, portfolio, true, *memberParams)
+	err = dbSvc.AddMember(ctx, id.Hex(), model.Address{AccAddress: defaultMember}, portfolio, true, *memberParams, nil, "")
 	if err != nil {
 		// TODO: Use transaction
 		log.Error(fmt.Sprintf("adding default member failed: %s. Going to revert ...", err.Error()))
@@ -0,0 +1,180 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/mongoimpl"
+	cli "github.com/jawher/mow.cli"
+	log "github.com/xlab/suplog"
+)
+
+func parseAddAlertRuleArgs(c *cli.Cmd) {
+	guildID = c.String(cli.StringOpt{
+		Name:  "guild-id",
+		Desc:  "guild ID to evaluate rule, empty means all guilds",
+		Value: "",
+	})
+
+	alertKind = c.String(cli.StringOpt{
+		Name:  "kind",
+		Desc:  fmt.Sprintf("rule kind: %s", strings.Join(model.AlertKinds, ", ")),
+		Value: "",
+	})
+
+	alertThreshold = c.Float64(cli.Float64Opt{
+		Name:  "threshold",
+		Desc:  "fraction for equity_drop (0.1 is 10%), member count for member_count_below, leverage for leverage_above",
+		Value: 0,
+	})
+
+	alertWindow = c.String(cli.StringOpt{
+		Name:  "window",
+		Desc:  "duration for equity_drop and capture_stale, e.g 24h",
+		Value: "",
+	})
+
+	alertSinks = c.Strings(cli.StringsOpt{
+		Name:  "sink",
+		Desc:  "notifier to fire through (log, webhook, slack, email, telegram), can supply many. Empty means all configured notifiers",
+		Value: []string{},
+	})
+
+	parseWebhookDBArgs(c)
+}
+
+func addAlertRuleAction() {
+	if !model.IsValidAlertKind(*alertKind) {
+		log.Fatal("unsupported alert kind: ", *alertKind)
+	}
+
+	var window time.Duration
+	if *alertWindow != "" {
+		var err error
+		window, err = time.ParseDuration(*alertWindow)
+		panicIf(err)
+	}
+
+	if (*alertKind == model.AlertKindEquityDrop || *alertKind == model.AlertKindCaptureStale) && window <= 0 {
+		log.Fatal("window is required for ", *alertKind)
+	}
+
+	if *alertKind == model.AlertKindEquityDrop && (*alertThreshold <= 0 || *alertThreshold > 1) {
+		log.Fatal("threshold of equity_drop must be a fraction in (0, 1], got ", *alertThreshold)
+	}
+
+	log.Info("connecting database")
+	ctx := context.Background()
+	dbSvc, err := mongoimpl.NewService(ctx, *dbURL, "guilds")
+	panicIf(err)
+
+	rule := &model.AlertRule{
+		Kind:      *alertKind,
+		Threshold: *alertThreshold,
+		Window:    window,
+		Sinks:     *alertSinks,
+		CreatedAt: time.Now(),
+	}
+
+	if *guildID != "" {
+		guild, err := dbSvc.GetSingleGuild(ctx, *guildID)
+		panicIf(err)
+		rule.GuildID = &guild.ID
+	}
+
+	id, err := dbSvc.AddAlertRule(ctx, rule)
+	panicIf(err)
+
+	log.Infof("🍺 added alert rule %s", id.Hex())
+}
+
+func cmdAddAlertRule(c *cli.Cmd) {
+	// inputs:
+	// guild id (optional): --guild-id
+	// kind: --kind
+	// threshold: --threshold
+	// window: --window
+	// sinks (can supply many --sink): --sink
+	// db url: --db-url
+	parseAddAlertRuleArgs(c)
+	c.Action = addAlertRuleAction
+}
+
+func parseDeleteAlertRuleArgs(c *cli.Cmd) {
+	alertRuleID = c.String(cli.StringOpt{
+		Name:  "alert-rule-id",
+		Desc:  "alert rule ID to delete",
+		Value: "",
+	})
+
+	parseWebhookDBArgs(c)
+}
+
+func deleteAlertRuleAction() {
+	log.Info("connecting database")
+	ctx := context.Background()
+	dbSvc, err := mongoimpl.NewService(ctx, *dbURL, "guilds")
+	panicIf(err)
+
+	err = dbSvc.DeleteAlertRule(ctx, *alertRuleID)
+	panicIf(err)
+
+	log.Info("🍺 deleted alert rule ", *alertRuleID)
+}
+
+func cmdDeleteAlertRule(c *cli.Cmd) {
+	// inputs:
+	// alert rule id: --alert-rule-id
+	// db url: --db-url
+	parseDeleteAlertRuleArgs(c)
+	c.Action = deleteAlertRuleAction
+}
+
+func parseListAlertRulesArgs(c *cli.Cmd) {
+	guildID = c.String(cli.StringOpt{
+		Name:  "guild-id",
+		Desc:  "guild ID to list alert rules, empty means all rules",
+		Value: "",
+	})
+
+	parseWebhookDBArgs(c)
+}
+
+func listAlertRulesAction() {
+	log.Info("connecting database")
+	ctx := context.Background()
+	dbSvc, err := mongoimpl.NewService(ctx, *dbURL, "guilds")
+	panicIf(err)
+
+	filter := model.AlertRuleFilter{}
+	if *guildID != "" {
+		filter.GuildID = guildID
+	}
+
+	rules, err := dbSvc.ListAlertRules(ctx, filter)
+	panicIf(err)
+
+	for _, r := range rules {
+		guild := "all"
+		if r.GuildID != nil {
+			guild = r.GuildID.Hex()
+		}
+
+		sinks := "all"
+		if len(r.Sinks) > 0 {
+			sinks = strings.Join(r.Sinks, ",")
+		}
+		log.Infof("alert rule %s guild %s kind %s threshold %v window %s sinks %s", r.ID.Hex(), guild, r.Kind, r.Threshold, r.Window, sinks)
+	}
+}
+
+func cmdListAlertRules(c *cli.Cmd) {
+	// inputs:
+	// guild id (optional): --guild-id
+	// db url: --db-url
+	parseListAlertRulesArgs(c)
+	c.Action = listAlertRulesAction
+}
@@ -2,30 +2,43 @@ package main
 
 import (
 	"context"
-	"fmt"
+	"net"
 	"net/http"
-	"strings"
 	"time"
 
+	guildsapipb "github.com/InjectiveLabs/injective-guilds-service/api/gen/grpc/guilds_service/pb"
+	guildsapigrpcsvr "github.com/InjectiveLabs/injective-guilds-service/api/gen/grpc/guilds_service/server"
 	guildsapisvc "github.com/InjectiveLabs/injective-guilds-service/api/gen/guilds_service"
 	guildsapisvr "github.com/InjectiveLabs/injective-guilds-service/api/gen/http/guilds_service/server"
 	"github.com/InjectiveLabs/injective-guilds-service/internal/config"
 	"github.com/InjectiveLabs/injective-guilds-service/internal/db"
 	"github.com/InjectiveLabs/injective-guilds-service/internal/db/mongoimpl"
 	"github.com/InjectiveLabs/injective-guilds-service/internal/exchange"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/health"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/prom"
 	guildsapi "github.com/InjectiveLabs/injective-guilds-service/internal/service/guilds-api"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/tracing"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/webhook"
 	cli "github.com/jawher/mow.cli"
 	"github.com/xlab/closer"
 	log "github.com/xlab/suplog"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	goahttp "goa.design/goa/v3/http"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
 )
 
 type APIServer struct {
-	cfg      config.GuildsAPIServerConfig
-	dbSvc    db.DBService
-	exchange exchange.DataProvider
-	handlers http.Handler
-	server   *http.Server
+	cfg        config.GuildsAPIServerConfig
+	dbSvc      db.DBService
+	exchange   exchange.DataProvider
+	handlers   http.Handler
+	dispatcher *webhook.Dispatcher
+	stream     *guildsapi.StreamHandler
+	hub        *guildsapi.PortfolioUpdateHub
+	stopHub    context.CancelFunc
+	server     *http.Server
+	grpcServer *grpc.Server
 }
 
 func NewServer(cfg config.GuildsAPIServerConfig) (*APIServer, error) {
@@ -43,14 +56,24 @@ func NewServer(cfg config.GuildsAPIServerConfig) (*APIServer, error) {
 		return nil, err
 	}
 
+	if cfg.PrometheusEnabled {
+		s.exchange = exchange.NewInstrumentedProvider(s.exchange)
+	}
+
 	// prepare service implementations
-	guildsApi, err := guildsapi.NewService(ctx, s.dbSvc, s.exchange)
+	s.dispatcher = webhook.NewDispatcher(s.dbSvc, log.WithField("svc", "guilds_api"))
+	s.hub = guildsapi.NewPortfolioUpdateHub(s.dbSvc)
+	guildsApi, err := guildsapi.NewService(ctx, s.dbSvc, s.exchange, s.dispatcher, s.hub)
 	if err != nil {
 		return nil, err
 	}
 
 	// prepare endpoints
 	guildsServiceEndpoints := guildsapisvc.NewEndpoints(guildsApi)
+	if cfg.PrometheusEnabled {
+		guildsServiceEndpoints.Use(prom.EndpointMiddleware)
+	}
+	guildsServiceEndpoints.Use(tracing.EndpointMiddleware)
 
 	var (
 		dec                 = goahttp.RequestDecoder
@@ -63,30 +86,40 @@ func NewServer(cfg config.GuildsAPIServerConfig) (*APIServer, error) {
 
 	// mounts
 	guildsapisvr.Mount(mux, guildsServiceServer)
-	s.handlers = mux
+	s.stream = guildsapi.NewStreamHandler(s.dbSvc, s.hub)
+	s.stream.Mount(mux)
+	health.NewHandler(health.ServiceChecks(s.dbSvc, s.exchange)...).Mount(mux)
+	if cfg.PrometheusEnabled {
+		mux.Handle(http.MethodGet, "/metrics", prom.Handler().ServeHTTP)
+	}
+	s.handlers = tracing.HTTPHandler(mux, "guilds_api")
+
+	// grpc serves the same endpoints, reflection lets grpcurl and similar clients list methods
+	s.grpcServer = grpc.NewServer(
+		grpc.UnaryInterceptor(otelgrpc.UnaryServerInterceptor()),
+		grpc.StreamInterceptor(otelgrpc.StreamServerInterceptor()),
+	)
+	guildsapipb.RegisterGuildsServiceServer(s.grpcServer, guildsapigrpcsvr.New(guildsServiceEndpoints, nil, nil))
+	reflection.Register(s.grpcServer)
 
 	return s, nil
 }
 
 func (s *APIServer) ListenAndServe(ctx context.Context) error {
-	var (
-		address string
-		tls     bool
-	)
-
-	switch {
-	case strings.HasPrefix(s.cfg.ListenAddress, "http://"):
-		address = strings.TrimPrefix(s.cfg.ListenAddress, "http://")
-		tls = false
-	case strings.HasPrefix(s.cfg.ListenAddress, "https://"):
-		address = strings.TrimPrefix(s.cfg.ListenAddress, "https://")
-		tls = true
-	default:
-		return fmt.Errorf("unsupported protocol with address: %s, need http or https", s.cfg.ListenAddress)
+	address, tls, err := parseListenAddress(s.cfg.ListenAddress)
+	if err != nil {
+		return err
 	}
 
+	// one change stream feeds all portfolio update streams
+	hubCtx, stopHub := context.WithCancel(context.Background())
+	s.stopHub = stopHub
+	go s.hub.Run(hubCtx)
+
 	// new server + listenFn
 	s.server = &http.Server{Addr: address, Handler: s.handlers}
+	// open streams would hold shutdown until timeout
+	s.server.RegisterOnShutdown(s.stream.Close)
 	go func() {
 		var err error
 		if tls {
@@ -107,6 +140,23 @@ func (s *APIServer) ListenAndServe(ctx context.Context) error {
 		}
 	}()
 
+	if s.cfg.GRPCListenAddress == "" {
+		return nil
+	}
+
+	listener, err := net.Listen("tcp", s.cfg.GRPCListenAddress)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		log.Infoln("grpc listening:", s.cfg.GRPCListenAddress)
+		if err := s.grpcServer.Serve(listener); err != nil {
+			log.WithError(err).Errorln("grpc serve error")
+			closer.Close()
+		}
+	}()
+
 	return nil
 }
 
@@ -121,6 +171,19 @@ func (s *APIServer) GracefullyShutdown() {
 		log.WithError(err).Error("cannot shutdown server")
 	}
 
+	// open streams would hold graceful stop, they are cancelled after timeout
+	log.Info("shutting down grpc server")
+	s.stopGRPC(shutdownCtx)
+
+	// webhook deliveries need db, wait for them before closing it
+	log.Info("waiting for webhook deliveries")
+	if err := s.dispatcher.Wait(shutdownCtx); err != nil {
+		log.WithError(err).Warning("webhook deliveries in flight are dropped")
+	}
+
+	// stop change stream before closing db
+	s.stopHub()
+
 	// close db
 	log.Info("closing db connection")
 	if err := s.dbSvc.Disconnect(shutdownCtx); err != nil {
@@ -136,6 +199,20 @@ func (s *APIServer) GracefullyShutdown() {
 	log.Info("server stopped")
 }
 
+func (s *APIServer) stopGRPC(ctx context.Context) {
+	stopped := make(chan struct{})
+	go func() {
+		s.grpcServer.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		s.grpcServer.Stop()
+	}
+}
+
 func cmdApi(c *cli.Cmd) {
 	c.Action = func() {
 		cfg := config.LoadGuildsAPIServerConfig()
@@ -153,6 +230,9 @@ func cmdApi(c *cli.Cmd) {
 		// setup logger
 		log.DefaultLogger.SetLevel(getLogLevel(cfg.LogLevel))
 
+		shutdownTracing, err := tracing.Setup(context.Background(), cfg.TracingConfig)
+		panicIf(err)
+
 		apiServer, err := NewServer(cfg)
 		panicIf(err)
 
@@ -161,6 +241,7 @@ func cmdApi(c *cli.Cmd) {
 
 		closer.Bind(func() {
 			apiServer.GracefullyShutdown()
+			shutdownTracingWithTimeout(shutdownTracing)
 		})
 		// wait until os signal then gracefully shutdown
 		closer.Hold()
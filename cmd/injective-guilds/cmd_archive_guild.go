@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/mongoimpl"
+	cli "github.com/jawher/mow.cli"
+	log "github.com/xlab/suplog"
+)
+
+func parseArchiveGuildArgs(c *cli.Cmd) {
+	guildID = c.String(cli.StringOpt{
+		Name:  "guild-id",
+		Desc:  "guild ID to archive or unarchive",
+		Value: "",
+	})
+
+	parseWebhookDBArgs(c)
+}
+
+func setGuildArchived(archived bool) {
+	log.Info("connecting database")
+	ctx := context.Background()
+	dbSvc, err := mongoimpl.NewService(ctx, *dbURL, "guilds")
+	panicIf(err)
+
+	err = dbSvc.SetGuildArchived(ctx, *guildID, archived)
+	panicIf(err)
+}
+
+func archiveGuildAction() {
+	setGuildArchived(true)
+	log.Info("🍺 archived guild ", *guildID)
+}
+
+func unarchiveGuildAction() {
+	setGuildArchived(false)
+	log.Info("🍺 unarchived guild ", *guildID)
+}
+
+func cmdArchiveGuild(c *cli.Cmd) {
+	// inputs:
+	// guild id: --guild-id
+	// db url: --db-url
+	parseArchiveGuildArgs(c)
+	c.Action = archiveGuildAction
+}
+
+func cmdUnarchiveGuild(c *cli.Cmd) {
+	// inputs:
+	// guild id: --guild-id
+	// db url: --db-url
+	parseArchiveGuildArgs(c)
+	c.Action = unarchiveGuildAction
+}
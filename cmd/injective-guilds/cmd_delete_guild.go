@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"errors"
 
 	"github.com/InjectiveLabs/injective-guilds-service/internal/db/mongoimpl"
 	cli "github.com/jawher/mow.cli"
@@ -20,9 +21,28 @@ func parseDeleteGuildArgs(c *cli.Cmd) {
 		Desc:  "database url",
 		Value: "mongodb://localhost:27017",
 	})
+
+	purge = c.Bool(cli.BoolOpt{
+		Name:  "purge",
+		Desc:  "confirm deleting guild with all members and history, use archive-guild to keep history",
+		Value: false,
+	})
+}
+
+var errPurgeNotConfirmed = errors.New("delete-guild wipes all members and history, confirm with --purge or use archive-guild instead")
+
+func checkPurgeConfirmed(purge bool) error {
+	if !purge {
+		return errPurgeNotConfirmed
+	}
+	return nil
 }
 
 func deleteGuildAction() {
+	if err := checkPurgeConfirmed(*purge); err != nil {
+		log.Fatal(err)
+	}
+
 	log.Info("connecting database")
 	ctx := context.Background()
 	dbSvc, err := mongoimpl.NewService(ctx, *dbURL, "guilds")
@@ -36,6 +56,7 @@ func deleteGuildAction() {
 func cmdDeleteGuild(c *cli.Cmd) {
 	// inputs:
 	// guild id: --guild-id
+	// purge confirmation: --purge
 	parseDeleteGuildArgs(c)
 	c.Action = deleteGuildAction
 }
@@ -0,0 +1,12 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckPurgeConfirmed(t *testing.T) {
+	assert.ErrorIs(t, checkPurgeConfirmed(false), errPurgeNotConfirmed)
+	assert.NoError(t, checkPurgeConfirmed(true))
+}
@@ -0,0 +1,287 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/mongoimpl"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/exchange"
+	guildsprocess "github.com/InjectiveLabs/injective-guilds-service/internal/service/guilds-process"
+	cli "github.com/jawher/mow.cli"
+	log "github.com/xlab/suplog"
+)
+
+func parseGuildIDArg(c *cli.Cmd) {
+	guildID = c.String(cli.StringOpt{
+		Name:  "guild-id",
+		Desc:  "guild ID to edit",
+		Value: "",
+	})
+}
+
+func parseAddMarketArgs(c *cli.Cmd) {
+	parseGuildIDArg(c)
+
+	spotIDs = c.Strings(cli.StringsOpt{
+		Name:  "spot-id",
+		Desc:  "spot marketID",
+		Value: []string{},
+	})
+
+	derivativeIDs = c.Strings(cli.StringsOpt{
+		Name:  "derivative-id",
+		Desc:  "derivative marketID",
+		Value: []string{},
+	})
+
+	spotRequirements = c.Strings(cli.StringsOpt{
+		Name:  "spot-require",
+		Desc:  "minimum requirements added for each spot market. BaseRequirement/QuoteRequirement",
+		Value: []string{},
+	})
+
+	derivativeRequirements = c.Strings(cli.StringsOpt{
+		Name:  "derivative-require",
+		Desc:  "minimum requirements added for each derivative market. QuoteRequirement",
+		Value: []string{},
+	})
+
+	parseWebhookDBArgs(c)
+
+	exchangeURL = c.String(cli.StringOpt{
+		Name:  "exchange-url",
+		Desc:  "exchange grpc api url",
+		Value: "localhost:9910",
+	})
+}
+
+func addMarketAction() {
+	if len(*spotIDs) == 0 && len(*derivativeIDs) == 0 {
+		log.Fatal("no market to add")
+	}
+
+	if len(*spotIDs) != len(*spotRequirements) || len(*derivativeIDs) != len(*derivativeRequirements) {
+		log.Fatal("each market must have its requirement")
+	}
+
+	ctx := context.Background()
+	log.Info("connecting database")
+	dbSvc, err := mongoimpl.NewService(ctx, *dbURL, "guilds")
+	panicIf(err)
+
+	log.Info("connecting exchange api at ", *exchangeURL)
+	// lcd and asset price are not used to get markets
+	exchangeProvider, err := exchange.NewExchangeProvider(*exchangeURL, "", "")
+	panicIf(err)
+
+	markets := make([]*model.GuildMarket, 0)
+	requirements := make([]*model.DenomRequirement, 0)
+	for i, m := range *spotIDs {
+		log.Info(fmt.Sprintf(">>> checking spot market id: %s", m))
+		info, err := exchangeProvider.GetSpotMarket(ctx, m)
+		panicIf(err)
+
+		market, err := guildsprocess.NewGuildMarket(info, false)
+		panicIf(err)
+		markets = append(markets, market)
+
+		floats, err := toMinAmounts((*spotRequirements)[i])
+		panicIf(err)
+		if len(floats) != 2 {
+			log.Fatal("spot requirement must be BaseRequirement/QuoteRequirement")
+		}
+
+		requirements = append(requirements,
+			&model.DenomRequirement{Denom: market.BaseDenom, MinAmountUSD: floats[0]},
+			&model.DenomRequirement{Denom: market.QuoteDenom, MinAmountUSD: floats[1]},
+		)
+	}
+
+	for i, m := range *derivativeIDs {
+		log.Info(fmt.Sprintf(">>> checking derivative market id: %s", m))
+		info, err := exchangeProvider.GetDerivativeMarket(ctx, m)
+		panicIf(err)
+
+		market, err := guildsprocess.NewGuildMarket(info, true)
+		panicIf(err)
+		markets = append(markets, market)
+
+		floats, err := toMinAmounts((*derivativeRequirements)[i])
+		panicIf(err)
+		requirements = append(requirements, &model.DenomRequirement{Denom: market.QuoteDenom, MinAmountUSD: floats[0]})
+	}
+
+	for _, m := range markets {
+		if !m.IsActive() {
+			log.Fatal(fmt.Sprintf("market %s is %s", m.MarketID.Hex(), m.Status))
+		}
+	}
+
+	err = dbSvc.AddGuildMarkets(ctx, *guildID, markets, requirements)
+	panicIf(err)
+
+	log.Infof("🍺 added %d markets to guild %s", len(markets), *guildID)
+	revalidateMembers(ctx, dbSvc, *guildID)
+}
+
+func cmdAddMarket(c *cli.Cmd) {
+	// inputs:
+	// guild id: --guild-id
+	// spot market ids (can supply many --spot-id): --spot-id
+	// derivative market ids (can supply many --derivative-id): --derivative-id
+	// requirement of each spot market: --spot-require
+	// requirement of each derivative market: --derivative-require
+	// db url: --db-url
+	// exchange url: --exchange-url
+	parseAddMarketArgs(c)
+	c.Action = addMarketAction
+}
+
+func parseRemoveMarketArgs(c *cli.Cmd) {
+	parseGuildIDArg(c)
+
+	marketID = c.String(cli.StringOpt{
+		Name:  "market-id",
+		Desc:  "spot or derivative marketID to remove",
+		Value: "",
+	})
+
+	parseWebhookDBArgs(c)
+}
+
+func removeMarketAction() {
+	log.Info("connecting database")
+	ctx := context.Background()
+	dbSvc, err := mongoimpl.NewService(ctx, *dbURL, "guilds")
+	panicIf(err)
+
+	err = dbSvc.RemoveGuildMarket(ctx, *guildID, *marketID)
+	if err == db.ErrNotFound {
+		log.Fatal(fmt.Sprintf("guild %s or its market %s is not found", *guildID, *marketID))
+	}
+	panicIf(err)
+
+	log.Infof("🍺 removed market %s from guild %s", *marketID, *guildID)
+	revalidateMembers(ctx, dbSvc, *guildID)
+}
+
+func cmdRemoveMarket(c *cli.Cmd) {
+	// inputs:
+	// guild id: --guild-id
+	// market id: --market-id
+	// db url: --db-url
+	parseRemoveMarketArgs(c)
+	c.Action = removeMarketAction
+}
+
+func parseSetRequirementArgs(c *cli.Cmd) {
+	parseGuildIDArg(c)
+
+	requirementDenom = c.String(cli.StringOpt{
+		Name:  "denom",
+		Desc:  "denom of a guild market",
+		Value: "",
+	})
+
+	requirementMinAmountUSD = c.Float64(cli.Float64Opt{
+		Name:  "min-amount-usd",
+		Desc:  "minimum available balance in USD to join the guild, 0 removes the requirement",
+		Value: 0,
+	})
+
+	parseWebhookDBArgs(c)
+}
+
+func setRequirementAction() {
+	if *requirementMinAmountUSD < 0 {
+		log.Fatal("min amount usd must not be negative")
+	}
+
+	log.Info("connecting database")
+	ctx := context.Background()
+	dbSvc, err := mongoimpl.NewService(ctx, *dbURL, "guilds")
+	panicIf(err)
+
+	err = dbSvc.SetGuildRequirement(ctx, *guildID, &model.DenomRequirement{
+		Denom:        *requirementDenom,
+		MinAmountUSD: *requirementMinAmountUSD,
+	})
+	panicIf(err)
+
+	log.Infof("🍺 set requirement of %s to %.2f USD in guild %s", *requirementDenom, *requirementMinAmountUSD, *guildID)
+	revalidateMembers(ctx, dbSvc, *guildID)
+}
+
+func cmdSetRequirement(c *cli.Cmd) {
+	// inputs:
+	// guild id: --guild-id
+	// denom: --denom
+	// min amount usd: --min-amount-usd
+	// db url: --db-url
+	parseSetRequirementArgs(c)
+	c.Action = setRequirementAction
+}
+
+// revalidateMembers checks latest snapshots of members against current guild requirements and logs a report,
+// members are not removed
+func revalidateMembers(ctx context.Context, dbSvc db.DBService, guildID string) {
+	log.Info("revalidating members against guild requirements")
+	guild, err := dbSvc.GetSingleGuild(ctx, guildID)
+	panicIf(err)
+
+	limit := int64(1)
+	guildPortfolios, err := dbSvc.ListGuildPortfolios(ctx, model.GuildPortfoliosFilter{
+		GuildID: guildID,
+		Limit:   &limit,
+	})
+	panicIf(err)
+
+	if len(guildPortfolios) == 0 {
+		log.Warning("no guild portfolio to get denom prices, skip revalidation")
+		return
+	}
+	denomToUsdPrice := guildsprocess.DenomPricesUSD(guildPortfolios[0])
+
+	members, err := dbSvc.ListGuildMembers(ctx, model.MemberFilter{
+		GuildID: &guildID,
+	})
+	panicIf(err)
+
+	countQualified, countUnqualified, countUnchecked := 0, 0, 0
+	for _, m := range members {
+		address := m.InjectiveAddress.String()
+		snapshots, err := dbSvc.ListAccountPortfolios(ctx, model.AccountPortfoliosFilter{
+			InjectiveAddress: m.InjectiveAddress,
+			GuildID:          &guildID,
+			Limit:            &limit,
+		})
+		if err != nil || len(snapshots) == 0 {
+			countUnchecked++
+			log.Warningf("member %s: cannot get snapshot: %v", address, err)
+			continue
+		}
+		snapshot := snapshots[0]
+
+		unmet, err := guildsprocess.CheckBalanceRequirements(guild, snapshot, denomToUsdPrice)
+		if err != nil {
+			// prices of new denoms are known after next portfolio capture
+			countUnchecked++
+			log.Warningf("member %s: cannot check: %s", address, err.Error())
+			continue
+		}
+
+		if unmet != nil {
+			countUnqualified++
+			log.Warningf("member %s: unqualified: %s", address, unmet.String())
+			continue
+		}
+		countQualified++
+	}
+
+	log.Infof(
+		"revalidation report of guild %s: %d members, %d qualified, %d unqualified, %d unchecked",
+		guildID, len(members), countQualified, countUnqualified, countUnchecked,
+	)
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/InjectiveLabs/injective-guilds-service/internal/config"
 	guildsprocess "github.com/InjectiveLabs/injective-guilds-service/internal/service/guilds-process"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/tracing"
 	cli "github.com/jawher/mow.cli"
 	"github.com/xlab/closer"
 	log "github.com/xlab/suplog"
@@ -28,6 +29,9 @@ func cmdProcess(c *cli.Cmd) {
 		// setup logger
 		log.DefaultLogger.SetLevel(getLogLevel(cfg.LogLevel))
 
+		shutdownTracing, err := tracing.Setup(context.Background(), cfg.TracingConfig)
+		panicIf(err)
+
 		guildsProcess, err := guildsprocess.NewProcess(cfg)
 		panicIf(err)
 		// run process(es) and hold until interrupt
@@ -35,9 +39,40 @@ func cmdProcess(c *cli.Cmd) {
 		cancelCtx, cancelFn := context.WithCancel(ctx)
 		guildsProcess.Run(cancelCtx)
 
+		var adminServer *AdminServer
+		if cfg.AdminListenAddress != "" {
+			adminServer, err = NewAdminServer(
+				cfg.AdminListenAddress,
+				guildsProcess.HealthChecks(),
+				cfg.PrometheusEnabled,
+			)
+			panicIf(err)
+			adminServer.ListenAndServe()
+		}
+
+		// rewards are served on a separate listener, bound to localhost by default
+		var rewardsServer *AdminServer
+		if cfg.RewardsListenAddress != "" {
+			rewardsServer, err = NewAdminServer(
+				cfg.RewardsListenAddress,
+				nil,
+				false,
+				guildsProcess.RewardsHandler(),
+			)
+			panicIf(err)
+			rewardsServer.ListenAndServe()
+		}
+
 		closer.Bind(func() {
 			cancelFn()
+			if adminServer != nil {
+				adminServer.GracefullyShutdown()
+			}
+			if rewardsServer != nil {
+				rewardsServer.GracefullyShutdown()
+			}
 			guildsProcess.GracefullyShutdown(ctx)
+			shutdownTracingWithTimeout(shutdownTracing)
 		})
 
 		closer.Hold()
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/mongoimpl"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/rewards"
+	cli "github.com/jawher/mow.cli"
+	"github.com/shopspring/decimal"
+	log "github.com/xlab/suplog"
+)
+
+func parseComputeRewardsArgs(c *cli.Cmd) {
+	guildID = c.String(cli.StringOpt{
+		Name:  "guild-id",
+		Desc:  "guild ID to compute rewards",
+		Value: "",
+	})
+
+	rewardsFrom = c.String(cli.StringOpt{
+		Name:  "from",
+		Desc:  "period start, RFC3339 or YYYY-MM-DD",
+		Value: "",
+	})
+
+	rewardsTo = c.String(cli.StringOpt{
+		Name:  "to",
+		Desc:  "period end (exclusive), RFC3339 or YYYY-MM-DD",
+		Value: "",
+	})
+
+	rewardsFormula = c.String(cli.StringOpt{
+		Name:  "formula",
+		Desc:  "share formula: balance (time-weighted usd balance), volume or pnl",
+		Value: string(rewards.FormulaBalance),
+	})
+
+	excludeDefaultMember = c.Bool(cli.BoolOpt{
+		Name:  "exclude-default-member",
+		Desc:  "don't give rewards to default guild member",
+		Value: false,
+	})
+
+	rewardsDenom = c.String(cli.StringOpt{
+		Name:  "denom",
+		Desc:  "reward denom",
+		Value: "inj",
+	})
+
+	rewardsAmount = c.String(cli.StringOpt{
+		Name:  "amount",
+		Desc:  "reward pool in base unit of denom, leave empty to compute shares only",
+		Value: "",
+	})
+
+	rewardsOutput = c.String(cli.StringOpt{
+		Name:  "output",
+		Desc:  "output file prefix, writes <output>.csv and <output>.multisend.json",
+		Value: "rewards",
+	})
+
+	dbURL = c.String(cli.StringOpt{
+		Name:  "db-url",
+		Desc:  "database url",
+		Value: "mongodb://localhost:27017",
+	})
+}
+
+func computeRewardsAction() {
+	from, err := rewards.ParseTime(*rewardsFrom)
+	panicIf(err)
+
+	to, err := rewards.ParseTime(*rewardsTo)
+	panicIf(err)
+
+	formula, err := rewards.ParseFormula(*rewardsFormula)
+	panicIf(err)
+
+	amount := decimal.Zero
+	if *rewardsAmount != "" {
+		amount, err = decimal.NewFromString(*rewardsAmount)
+		panicIf(err)
+	}
+
+	log.Info("connecting database")
+	ctx := context.Background()
+	dbSvc, err := mongoimpl.NewService(ctx, *dbURL, "guilds")
+	panicIf(err)
+
+	result, err := rewards.NewCalculator(dbSvc).Compute(ctx, rewards.Params{
+		GuildID:              *guildID,
+		From:                 from,
+		To:                   to,
+		Formula:              formula,
+		ExcludeDefaultMember: *excludeDefaultMember,
+		Denom:                *rewardsDenom,
+		Amount:               amount,
+	})
+	panicIf(err)
+
+	csvFile, err := os.Create(fmt.Sprintf("%s.csv", *rewardsOutput))
+	panicIf(err)
+	defer csvFile.Close()
+
+	err = rewards.WriteCSV(csvFile, result)
+	panicIf(err)
+	log.Infof("🍺 wrote %d member shares to %s", len(result.Shares), csvFile.Name())
+
+	tx, err := rewards.MultiSendDraft(result)
+	if err == rewards.ErrNoPayout {
+		log.Warning("no payout allocated, skip MsgMultiSend draft")
+		return
+	}
+	panicIf(err)
+
+	txJSON, err := json.MarshalIndent(tx, "", "  ")
+	panicIf(err)
+
+	txFile := fmt.Sprintf("%s.multisend.json", *rewardsOutput)
+	err = os.WriteFile(txFile, txJSON, 0644)
+	panicIf(err)
+	log.Infof("🍺 wrote unsigned MsgMultiSend from %s to %s, sign it with guild master key", result.MasterAddress, txFile)
+}
+
+func cmdComputeRewards(c *cli.Cmd) {
+	// inputs:
+	// guild id: --guild-id
+	// period: --from, --to
+	// formula: --formula
+	// exclude default member: --exclude-default-member
+	// reward pool: --denom, --amount
+	// output prefix: --output
+	// db url: --db-url
+	parseComputeRewardsArgs(c)
+	c.Action = computeRewardsAction
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/InjectiveLabs/injective-guilds-service/internal/db/mongoimpl"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/webhook"
 	cli "github.com/jawher/mow.cli"
 	log "github.com/xlab/suplog"
 )
@@ -46,6 +47,13 @@ func setCapacityAction() {
 	err = dbSvc.SetGuildCap(ctx, guild.ID.Hex(), *capacity)
 	panicIf(err)
 
+	log.Info("delivering capacity change to webhooks")
+	dispatcher := webhook.NewDispatcher(dbSvc, log.WithField("svc", "set_capacity"))
+	dispatcher.DispatchSync(ctx, guild.ID.Hex(), webhook.EventCapacityChanged, &webhook.CapacityData{
+		Capacity:    *capacity,
+		MemberCount: guild.MemberCount,
+	})
+
 	log.Infof("🍺 updated guild %s (%s) member capacity to %d", guild.Name, guild.ID, *capacity)
 }
 
@@ -0,0 +1,160 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/mongoimpl"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/webhook"
+	cli "github.com/jawher/mow.cli"
+	log "github.com/xlab/suplog"
+)
+
+func parseWebhookDBArgs(c *cli.Cmd) {
+	dbURL = c.String(cli.StringOpt{
+		Name:  "db-url",
+		Desc:  "database url",
+		Value: "mongodb://localhost:27017",
+	})
+}
+
+func parseAddWebhookArgs(c *cli.Cmd) {
+	guildID = c.String(cli.StringOpt{
+		Name:  "guild-id",
+		Desc:  "guild ID to subscribe events",
+		Value: "",
+	})
+
+	webhookURL = c.String(cli.StringOpt{
+		Name:  "url",
+		Desc:  "url to deliver events",
+		Value: "",
+	})
+
+	webhookEvents = c.Strings(cli.StringsOpt{
+		Name:  "event",
+		Desc:  fmt.Sprintf("event to subscribe, can supply many. Empty means all events: %s", strings.Join(webhook.Events, ", ")),
+		Value: []string{},
+	})
+
+	parseWebhookDBArgs(c)
+}
+
+func addWebhookAction() {
+	if *webhookURL == "" {
+		log.Fatal("webhook url is required")
+	}
+
+	for _, e := range *webhookEvents {
+		if !webhook.IsValidEvent(e) {
+			log.Fatal("unsupported event: ", e)
+		}
+	}
+
+	log.Info("connecting database")
+	ctx := context.Background()
+	dbSvc, err := mongoimpl.NewService(ctx, *dbURL, "guilds")
+	panicIf(err)
+
+	guild, err := dbSvc.GetSingleGuild(ctx, *guildID)
+	panicIf(err)
+
+	secret, err := webhook.NewSecret()
+	panicIf(err)
+
+	id, err := dbSvc.AddWebhook(ctx, &model.Webhook{
+		GuildID:   guild.ID,
+		URL:       *webhookURL,
+		Secret:    secret,
+		Events:    *webhookEvents,
+		CreatedAt: time.Now(),
+	})
+	panicIf(err)
+
+	log.Infof("🍺 added webhook %s for guild %s (%s)", id.Hex(), guild.Name, guild.ID.Hex())
+	log.Infof("signing secret (keep it safe, it is shown once): %s", secret)
+}
+
+func cmdAddWebhook(c *cli.Cmd) {
+	// inputs:
+	// guild id: --guild-id
+	// url: --url
+	// events (can supply many --event): --event
+	// db url: --db-url
+	parseAddWebhookArgs(c)
+	c.Action = addWebhookAction
+}
+
+func parseDeleteWebhookArgs(c *cli.Cmd) {
+	webhookID = c.String(cli.StringOpt{
+		Name:  "webhook-id",
+		Desc:  "webhook ID to delete",
+		Value: "",
+	})
+
+	parseWebhookDBArgs(c)
+}
+
+func deleteWebhookAction() {
+	log.Info("connecting database")
+	ctx := context.Background()
+	dbSvc, err := mongoimpl.NewService(ctx, *dbURL, "guilds")
+	panicIf(err)
+
+	err = dbSvc.DeleteWebhook(ctx, *webhookID)
+	panicIf(err)
+
+	log.Info("🍺 deleted webhook ", *webhookID)
+}
+
+func cmdDeleteWebhook(c *cli.Cmd) {
+	// inputs:
+	// webhook id: --webhook-id
+	// db url: --db-url
+	parseDeleteWebhookArgs(c)
+	c.Action = deleteWebhookAction
+}
+
+func parseListWebhooksArgs(c *cli.Cmd) {
+	guildID = c.String(cli.StringOpt{
+		Name:  "guild-id",
+		Desc:  "guild ID to list webhooks",
+		Value: "",
+	})
+
+	parseWebhookDBArgs(c)
+}
+
+func listWebhooksAction() {
+	log.Info("connecting database")
+	ctx := context.Background()
+	dbSvc, err := mongoimpl.NewService(ctx, *dbURL, "guilds")
+	panicIf(err)
+
+	filter := model.WebhookFilter{}
+	if *guildID != "" {
+		filter.GuildID = guildID
+	}
+
+	webhooks, err := dbSvc.ListWebhooks(ctx, filter)
+	panicIf(err)
+
+	for _, w := range webhooks {
+		events := "all"
+		if len(w.Events) > 0 {
+			events = strings.Join(w.Events, ",")
+		}
+		log.Infof("webhook %s guild %s url %s events %s", w.ID.Hex(), w.GuildID.Hex(), w.URL, events)
+	}
+}
+
+func cmdListWebhooks(c *cli.Cmd) {
+	// inputs:
+	// guild id (optional): --guild-id
+	// db url: --db-url
+	parseListWebhooksArgs(c)
+	c.Action = listWebhooksAction
+}
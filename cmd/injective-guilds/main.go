@@ -18,6 +18,33 @@ var (
 	masterAddr        *string
 	defaultMemberAddr *string
 	memberParams      *string
+	webhookID         *string
+	webhookURL        *string
+	webhookEvents     *[]string
+	alertRuleID       *string
+	alertKind         *string
+	alertThreshold    *float64
+	alertWindow       *string
+	alertSinks        *[]string
+	marketID          *string
+	purge             *bool
+
+	requirementDenom        *string
+	requirementMinAmountUSD *float64
+
+	accessMode          *string
+	allowlistAddresses  *[]string
+	inviteCodeCount     *int
+	inviteCodeMaxUses   *int
+	inviteCodeExpiresIn *string
+
+	rewardsFrom          *string
+	rewardsTo            *string
+	rewardsFormula       *string
+	rewardsDenom         *string
+	rewardsAmount        *string
+	rewardsOutput        *string
+	excludeDefaultMember *bool
 
 	spotRequirements       *[]string
 	derivativeRequirements *[]string
@@ -39,8 +66,29 @@ func main() {
 	app.Command("api", "start Guilds service HTTP API server", cmdApi)
 	app.Command("process", "start Guilds process, which takes portfolios snapshots and handle disqualification", cmdProcess)
 	app.Command("add-guild", "add a guild", cmdAddGuild)
-	app.Command("delete-guild", "delete a guild", cmdDeleteGuild)
+	app.Command("delete-guild", "delete a guild with all members and history", cmdDeleteGuild)
+	app.Command("archive-guild", "archive a guild, it's closed to new members and not captured, history is kept", cmdArchiveGuild)
+	app.Command("unarchive-guild", "reopen an archived guild", cmdUnarchiveGuild)
 	app.Command("set-capacity", "set member capacity of a guild", cmdSetCapacity)
+	app.Command("add-webhook", "register a webhook to receive guild events", cmdAddWebhook)
+	app.Command("delete-webhook", "delete a webhook", cmdDeleteWebhook)
+	app.Command("list-webhooks", "list registered webhooks", cmdListWebhooks)
+	app.Command("add-alert-rule", "add an alert rule evaluated after each portfolio capture cycle", cmdAddAlertRule)
+	app.Command("delete-alert-rule", "delete an alert rule", cmdDeleteAlertRule)
+	app.Command("list-alert-rules", "list alert rules", cmdListAlertRules)
+	app.Command("guild", "edit markets, requirements and access of a guild", func(c *cli.Cmd) {
+		c.Command("add-market", "add markets to a guild and add their requirements", cmdAddMarket)
+		c.Command("remove-market", "remove a market from a guild", cmdRemoveMarket)
+		c.Command("set-requirement", "set minimum USD requirement of a denom", cmdSetRequirement)
+		c.Command("set-access", "set access mode of a guild: open, allowlist or invite_code", cmdSetAccess)
+		c.Command("allowlist-add", "allow addresses to enter an allowlist guild", cmdAllowlistAdd)
+		c.Command("allowlist-remove", "remove addresses from allowlist of a guild", cmdAllowlistRemove)
+		c.Command("create-invite-codes", "generate single-use or limited-use invite codes", cmdCreateInviteCodes)
+		c.Command("list-invite-codes", "list invite codes of a guild with their uses", cmdListInviteCodes)
+	})
+	app.Command("rewards", "reward distribution tools", func(c *cli.Cmd) {
+		c.Command("compute", "compute member reward shares of a guild for a period and export payout files", cmdComputeRewards)
+	})
 
 	_ = app.Run(os.Args)
 }
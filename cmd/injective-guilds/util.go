@@ -57,6 +57,18 @@ func connectStatServerWithRetry(
 	return fmt.Errorf("failed to connect stat server, last error: %w", err)
 }
 
+// parseListenAddress splits listen address into host:port and whether tls is used
+func parseListenAddress(listenAddress string) (address string, tls bool, err error) {
+	switch {
+	case strings.HasPrefix(listenAddress, "http://"):
+		return strings.TrimPrefix(listenAddress, "http://"), false, nil
+	case strings.HasPrefix(listenAddress, "https://"):
+		return strings.TrimPrefix(listenAddress, "https://"), true, nil
+	default:
+		return "", false, fmt.Errorf("unsupported protocol with address: %s, need http or https", listenAddress)
+	}
+}
+
 func getLogLevel(s string) log.Level {
 	switch strings.ToLower(s) {
 	case "1", "error":
@@ -71,3 +83,13 @@ func getLogLevel(s string) log.Level {
 		return log.FatalLevel
 	}
 }
+
+// shutdownTracingWithTimeout flushes pending spans before exit
+func shutdownTracingWithTimeout(shutdown func(context.Context) error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := shutdown(ctx); err != nil {
+		log.WithError(err).Error("cannot shutdown tracer provider")
+	}
+}
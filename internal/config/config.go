@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/joho/godotenv"
@@ -84,6 +85,58 @@ func loadStatsdConfig(envPrefix string) StatsdConfig {
 	}
 }
 
+type TracingConfig struct {
+	Enabled bool
+	// OTLPEndpoint is host:port of an OTLP gRPC collector
+	OTLPEndpoint string
+	Insecure     bool
+	ServiceName  string
+	SampleRatio  float64
+}
+
+func loadTracingConfig(envPrefix, defaultServiceName string) TracingConfig {
+	ratio, err := strconv.ParseFloat(LoadEnvString(fmt.Sprintf("%s_TRACING_SAMPLE_RATIO", envPrefix), "1"), 64)
+	panicIf(err)
+
+	return TracingConfig{
+		Enabled:      LoadEnvBool(fmt.Sprintf("%s_TRACING_ENABLED", envPrefix), false),
+		OTLPEndpoint: LoadEnvString(fmt.Sprintf("%s_TRACING_OTLP_ENDPOINT", envPrefix), "localhost:4317"),
+		Insecure:     LoadEnvBool(fmt.Sprintf("%s_TRACING_INSECURE", envPrefix), true),
+		ServiceName:  LoadEnvString(fmt.Sprintf("%s_TRACING_SERVICE_NAME", envPrefix), defaultServiceName),
+		SampleRatio:  ratio,
+	}
+}
+
+type NotifierConfig struct {
+	WebhookURL string
+	// SlackWebhookURL is a Slack-compatible incoming webhook
+	SlackWebhookURL string
+
+	SMTPAddr string
+	MailFrom string
+	MailTo   []string
+
+	TelegramBotToken string
+	TelegramChatID   string
+}
+
+func loadNotifierConfig(envPrefix string) NotifierConfig {
+	var mailTo []string
+	if to := LoadEnvString(fmt.Sprintf("%s_NOTIFIER_MAIL_TO", envPrefix), ""); to != "" {
+		mailTo = strings.Split(to, ",")
+	}
+
+	return NotifierConfig{
+		WebhookURL:       LoadEnvString(fmt.Sprintf("%s_NOTIFIER_WEBHOOK_URL", envPrefix), ""),
+		SlackWebhookURL:  LoadEnvString(fmt.Sprintf("%s_NOTIFIER_SLACK_WEBHOOK_URL", envPrefix), ""),
+		SMTPAddr:         LoadEnvString(fmt.Sprintf("%s_NOTIFIER_SMTP_ADDR", envPrefix), ""),
+		MailFrom:         LoadEnvString(fmt.Sprintf("%s_NOTIFIER_MAIL_FROM", envPrefix), ""),
+		MailTo:           mailTo,
+		TelegramBotToken: LoadEnvString(fmt.Sprintf("%s_NOTIFIER_TELEGRAM_BOT_TOKEN", envPrefix), ""),
+		TelegramChatID:   LoadEnvString(fmt.Sprintf("%s_NOTIFIER_TELEGRAM_CHAT_ID", envPrefix), ""),
+	}
+}
+
 type GuildsAPIServerConfig struct {
 	EnvName  string
 	LogLevel string
@@ -91,6 +144,8 @@ type GuildsAPIServerConfig struct {
 	ListenAddress   string
 	TLSCertFilePath string
 	TLSKeyFilePath  string
+	// GRPCListenAddress serves the grpc api as host:port, leave empty to disable
+	GRPCListenAddress string
 
 	DBName          string
 	DBConnectionURL string
@@ -100,6 +155,9 @@ type GuildsAPIServerConfig struct {
 	AssetPriceURL   string
 
 	StatsdConfig StatsdConfig
+	// PrometheusEnabled exposes /metrics endpoint
+	PrometheusEnabled bool
+	TracingConfig     TracingConfig
 }
 
 func (c GuildsAPIServerConfig) Validate() error {
@@ -116,15 +174,18 @@ func LoadGuildsAPIServerConfig() GuildsAPIServerConfig {
 		EnvName:  LoadEnvString(fmt.Sprintf("%s_ENV", apiEnvPrefix), "local"),
 		LogLevel: LoadEnvString(fmt.Sprintf("%s_LOG_LEVEL", apiEnvPrefix), "DEBUG"),
 
-		ListenAddress:   LoadEnvString(fmt.Sprintf("%s_LISTEN_ADDRESS", apiEnvPrefix), "http://localhost:9900"),
-		DBName:          LoadEnvString(fmt.Sprintf("%s_DB_NAME", apiEnvPrefix), "asset_price"),
-		DBConnectionURL: LoadEnvString(fmt.Sprintf("%s_DB_CONNECTION_URL", apiEnvPrefix), ""),
+		ListenAddress:     LoadEnvString(fmt.Sprintf("%s_LISTEN_ADDRESS", apiEnvPrefix), "http://localhost:9900"),
+		GRPCListenAddress: LoadEnvString(fmt.Sprintf("%s_GRPC_LISTEN_ADDRESS", apiEnvPrefix), "localhost:9933"),
+		DBName:            LoadEnvString(fmt.Sprintf("%s_DB_NAME", apiEnvPrefix), "asset_price"),
+		DBConnectionURL:   LoadEnvString(fmt.Sprintf("%s_DB_CONNECTION_URL", apiEnvPrefix), ""),
 
 		ExchangeGRPCURL: LoadEnvString(fmt.Sprintf("%s_EXCHANGE_GRPC_URL", apiEnvPrefix), "http://localhost:9910"),
 		LcdURL:          LoadEnvString(fmt.Sprintf("%s_LCD_URL", apiEnvPrefix), ""),
 		AssetPriceURL:   LoadEnvString(fmt.Sprintf("%s_ASSET_PRICE_URL", apiEnvPrefix), ""),
 
-		StatsdConfig: loadStatsdConfig(apiEnvPrefix),
+		StatsdConfig:      loadStatsdConfig(apiEnvPrefix),
+		PrometheusEnabled: LoadEnvBool(fmt.Sprintf("%s_PROMETHEUS_ENABLED", apiEnvPrefix), false),
+		TracingConfig:     loadTracingConfig(apiEnvPrefix, "guilds-api"),
 	}
 }
 
@@ -132,17 +193,41 @@ type GuildProcessConfig struct {
 	EnvName  string
 	LogLevel string
 
+	// AdminListenAddress serves health and version endpoints, leave empty to disable
+	AdminListenAddress string
+	// RewardsListenAddress serves reward computation, local only by default, leave empty to disable
+	RewardsListenAddress string
+
 	DBName          string
 	DBConnectionURL string
 
-	PortfolioUpdateInterval time.Duration
-	DisqualifyInterval      time.Duration
+	PortfolioUpdateInterval  time.Duration
+	DisqualifyInterval       time.Duration
+	GrantExpiryCheckInterval time.Duration
+	TradeIngestInterval      time.Duration
+	FeeAccountingInterval    time.Duration
+	TransferIngestInterval   time.Duration
+	MarketRefreshInterval    time.Duration
+	WaitlistInterval         time.Duration
+	AlertInterval            time.Duration
+	// RiskWindow is how far back guild portfolio history is used for drawdown, volatility and sharpe ratio
+	RiskWindow time.Duration
+	// fee accounting recomputes daily totals of this many recent days, trades ingested late are counted
+	FeeAccountingLookbackDays int
+	// RelayerFeeShareRate is share of trading fee paid to fee recipient, decimal string
+	RelayerFeeShareRate string
+	// members are notified when their grants expire within this window
+	GrantExpiryWindow time.Duration
 
 	ExchangeGRPCURL string
 	AssetPriceURL   string
 	LcdURL          string
 
-	StatsdConfig StatsdConfig
+	StatsdConfig   StatsdConfig
+	NotifierConfig NotifierConfig
+	// PrometheusEnabled exposes /metrics endpoint on admin listener
+	PrometheusEnabled bool
+	TracingConfig     TracingConfig
 }
 
 func (c GuildProcessConfig) Validate() error {
@@ -159,13 +244,30 @@ func LoadGuildsProcessConfig() GuildProcessConfig {
 		EnvName:  LoadEnvString(fmt.Sprintf("%s_ENV", processEnvPrefix), "local"),
 		LogLevel: LoadEnvString(fmt.Sprintf("%s_LOG_LEVEL", processEnvPrefix), "DEBUG"),
 
+		AdminListenAddress:   LoadEnvString(fmt.Sprintf("%s_ADMIN_LISTEN_ADDRESS", processEnvPrefix), "http://0.0.0.0:9931"),
+		RewardsListenAddress: LoadEnvString(fmt.Sprintf("%s_REWARDS_LISTEN_ADDRESS", processEnvPrefix), "http://127.0.0.1:9932"),
+
 		DBName:          LoadEnvString(fmt.Sprintf("%s_DB_NAME", processEnvPrefix), "asset_price"),
 		DBConnectionURL: LoadEnvString(fmt.Sprintf("%s_DB_CONNECTION_URL", processEnvPrefix), ""),
 
 		// TODO: Discuss + Update interval
-		PortfolioUpdateInterval: LoadEnvDuration(fmt.Sprintf("%s_PORTFOLIO_UPDATE_INTERVAL", processEnvPrefix), time.Hour),
-		DisqualifyInterval:      LoadEnvDuration(fmt.Sprintf("%s_DISQUALIFY_INTERVAL", processEnvPrefix), 6*time.Hour),
-		StatsdConfig:            loadStatsdConfig(processEnvPrefix),
+		PortfolioUpdateInterval:   LoadEnvDuration(fmt.Sprintf("%s_PORTFOLIO_UPDATE_INTERVAL", processEnvPrefix), time.Hour),
+		DisqualifyInterval:        LoadEnvDuration(fmt.Sprintf("%s_DISQUALIFY_INTERVAL", processEnvPrefix), 6*time.Hour),
+		GrantExpiryCheckInterval:  LoadEnvDuration(fmt.Sprintf("%s_GRANT_EXPIRY_CHECK_INTERVAL", processEnvPrefix), 6*time.Hour),
+		GrantExpiryWindow:         LoadEnvDuration(fmt.Sprintf("%s_GRANT_EXPIRY_WINDOW", processEnvPrefix), 72*time.Hour),
+		TradeIngestInterval:       LoadEnvDuration(fmt.Sprintf("%s_TRADE_INGEST_INTERVAL", processEnvPrefix), 15*time.Minute),
+		FeeAccountingInterval:     LoadEnvDuration(fmt.Sprintf("%s_FEE_ACCOUNTING_INTERVAL", processEnvPrefix), time.Hour),
+		TransferIngestInterval:    LoadEnvDuration(fmt.Sprintf("%s_TRANSFER_INGEST_INTERVAL", processEnvPrefix), 15*time.Minute),
+		MarketRefreshInterval:     LoadEnvDuration(fmt.Sprintf("%s_MARKET_REFRESH_INTERVAL", processEnvPrefix), time.Hour),
+		WaitlistInterval:          LoadEnvDuration(fmt.Sprintf("%s_WAITLIST_INTERVAL", processEnvPrefix), 5*time.Minute),
+		AlertInterval:             LoadEnvDuration(fmt.Sprintf("%s_ALERT_INTERVAL", processEnvPrefix), 5*time.Minute),
+		RiskWindow:                LoadEnvDuration(fmt.Sprintf("%s_RISK_WINDOW", processEnvPrefix), 30*24*time.Hour),
+		FeeAccountingLookbackDays: LoadEnvInt(fmt.Sprintf("%s_FEE_ACCOUNTING_LOOKBACK_DAYS", processEnvPrefix), 2),
+		RelayerFeeShareRate:       LoadEnvString(fmt.Sprintf("%s_RELAYER_FEE_SHARE_RATE", processEnvPrefix), "0.4"),
+		StatsdConfig:              loadStatsdConfig(processEnvPrefix),
+		NotifierConfig:            loadNotifierConfig(processEnvPrefix),
+		PrometheusEnabled:         LoadEnvBool(fmt.Sprintf("%s_PROMETHEUS_ENABLED", processEnvPrefix), false),
+		TracingConfig:             loadTracingConfig(processEnvPrefix, "guilds-process"),
 
 		ExchangeGRPCURL: LoadEnvString(fmt.Sprintf("%s_EXCHANGE_GRPC_URL", processEnvPrefix), "http://localhost:9910"),
 		LcdURL:          LoadEnvString(fmt.Sprintf("%s_LCD_URL", processEnvPrefix), ""),
@@ -9,28 +9,128 @@ import (
 )
 
 var (
-	ErrNotFound        = errors.New("not found")
-	ErrMemberExceedCap = errors.New("max guild capacity has been reached")
-	ErrAlreadyMember   = errors.New("already member")
+	ErrNotFound          = errors.New("not found")
+	ErrMemberExceedCap   = errors.New("max guild capacity has been reached")
+	ErrAlreadyMember     = errors.New("already member")
+	ErrMarketExists      = errors.New("market already exists in guild")
+	ErrLastMarket        = errors.New("cannot remove the last market of guild")
+	ErrDenomNotInGuild   = errors.New("denom is not traded in guild markets")
+	ErrGuildArchived     = errors.New("guild is archived")
+	ErrDefaultMember     = errors.New("default guild member cannot be transferred")
+	ErrAlreadyWaitlisted = errors.New("already in waitlist")
+	ErrInvalidInviteCode = errors.New("invite code is invalid, used up or expired")
+	ErrHistoryLost       = errors.New("change stream history is lost, resume token is too old")
 )
 
 type DBService interface {
-	ListAllGuilds(ctx context.Context) ([]*model.Guild, error)
+	ListAllGuilds(ctx context.Context, includeArchived bool) ([]*model.Guild, error)
 	GetSingleGuild(ctx context.Context, guildID string) (*model.Guild, error)
 	ListGuildPortfolios(ctx context.Context, filter model.GuildPortfoliosFilter) ([]*model.GuildPortfolio, error)
 	// TODO: *primitive.ObjectID -> string
 	AddGuild(ctx context.Context, guild *model.Guild) (*primitive.ObjectID, error)
 	SetGuildCap(ctx context.Context, guildID string, cap int) error
+	// SetGuildArchived archives or unarchives guild, returns ErrNotFound if guild doesn't exist.
+	// Members of archived guild are kept, they can still leave but aren't disqualified
+	SetGuildArchived(ctx context.Context, guildID string, archived bool) error
+	// UpdateGuildMarkets replaces markets of guild with the same market ID and flags guild if any market is inactive
+	UpdateGuildMarkets(ctx context.Context, guildID string, markets []*model.GuildMarket) error
+	// AddGuildMarkets adds markets and adds requirements to min amounts of their denoms,
+	// returns ErrMarketExists if guild already has any of the markets
+	AddGuildMarkets(ctx context.Context, guildID string, markets []*model.GuildMarket, requirements []*model.DenomRequirement) error
+	// RemoveGuildMarket also drops requirements of denoms not traded in remaining markets,
+	// returns ErrNotFound if guild doesn't have the market
+	RemoveGuildMarket(ctx context.Context, guildID string, marketID string) error
+	// SetGuildRequirement replaces requirement of denom, requirement with zero amount is removed
+	SetGuildRequirement(ctx context.Context, guildID string, requirement *model.DenomRequirement) error
 	AddGuildPortfolios(ctx context.Context, portfolios []*model.GuildPortfolio) error
 	DeleteGuild(ctx context.Context, guildID string) error
 
 	// members
 	ListGuildMembers(ctx context.Context, filter model.MemberFilter) ([]*model.GuildMember, error)
-	AddMember(ctx context.Context, guildID string, address model.Address, initialPortfolio *model.AccountPortfolio, isDefaultMember bool, params string) error
+	// AddMember takes one use of inviteCode in the same transaction unless it's empty or reserved by waitlist entry
+	// of address, returns ErrInvalidInviteCode if code can't be used
+	AddMember(ctx context.Context, guildID string, address model.Address, initialPortfolio *model.AccountPortfolio, isDefaultMember bool, params string, subaccountIDs []string, inviteCode string) error
 	RemoveMember(ctx context.Context, guildID string, address model.Address) error
+	// SetGuildAccessMode returns ErrNotFound if guild doesn't exist
+	SetGuildAccessMode(ctx context.Context, guildID string, mode string) error
+	// AddToAllowlist returns number of addresses newly allowlisted
+	AddToAllowlist(ctx context.Context, guildID string, addresses []model.Address) (int, error)
+	// RemoveFromAllowlist returns number of addresses removed
+	RemoveFromAllowlist(ctx context.Context, guildID string, addresses []model.Address) (int, error)
+	IsAllowlisted(ctx context.Context, guildID string, address model.Address) (bool, error)
+	AddInviteCodes(ctx context.Context, codes []*model.InviteCode) error
+	ListInviteCodes(ctx context.Context, guildID string) ([]*model.InviteCode, error)
+	// GetInviteCode returns ErrNotFound if guild doesn't have the code
+	GetInviteCode(ctx context.Context, guildID string, code string) (*model.InviteCode, error)
+	// AddToWaitlist returns ErrAlreadyWaitlisted if address is already in waitlist of the guild.
+	// A use of entry InviteCode is reserved in the same transaction, ErrInvalidInviteCode is returned if it can't be used
+	AddToWaitlist(ctx context.Context, entry *model.WaitlistEntry) error
+	// ListWaitlist returns waitlist of guild, head of the queue first
+	ListWaitlist(ctx context.Context, guildID string) ([]*model.WaitlistEntry, error)
+	// GetWaitlistEntry returns ErrNotFound if address is not in waitlist of the guild
+	GetWaitlistEntry(ctx context.Context, guildID string, address model.Address) (*model.WaitlistEntry, error)
+	// RemoveFromWaitlist returns ErrNotFound if address is not in waitlist of the guild, reserved invite code use is released
+	RemoveFromWaitlist(ctx context.Context, guildID string, address model.Address) error
+	// TransferMember removes member from fromGuildID and adds it to toGuildID in a single transaction,
+	// returns ErrNotFound if address is not member of fromGuildID. inviteCode is used like AddMember
+	TransferMember(ctx context.Context, fromGuildID string, toGuildID string, address model.Address, initialPortfolio *model.AccountPortfolio, params string, subaccountIDs []string, inviteCode string) error
 	GetAccountPortfolio(ctx context.Context, address model.Address) (*model.AccountPortfolio, error)
 	ListAccountPortfolios(ctx context.Context, filter model.AccountPortfoliosFilter) ([]*model.AccountPortfolio, error)
 	AddAccountPortfolios(ctx context.Context, portfolios []*model.AccountPortfolio) error
+	// ListMemberships returns past memberships of address, latest ended first
+	ListMemberships(ctx context.Context, address model.Address) ([]*model.Membership, error)
+	// WatchPortfolioUpdates calls handler with portfolio snapshots and membership changes of all guilds as they are written,
+	// starting after resumeToken (update ID) if it's set, ErrHistoryLost is returned if it can't be resumed.
+	// It blocks until ctx is done or handler returns an error
+	WatchPortfolioUpdates(ctx context.Context, resumeToken string, handler func(*model.PortfolioUpdate) error) error
 
+	// grants
+	// ListGrantNotifications returns expiring grants address was notified about
+	ListGrantNotifications(ctx context.Context, address model.Address) ([]*model.GrantNotification, error)
+	// AddGrantNotifications records notified grants, already recorded ones are kept
+	AddGrantNotifications(ctx context.Context, notifications []*model.GrantNotification) error
+
+	// webhooks
+	AddWebhook(ctx context.Context, webhook *model.Webhook) (*primitive.ObjectID, error)
+	ListWebhooks(ctx context.Context, filter model.WebhookFilter) ([]*model.Webhook, error)
+	DeleteWebhook(ctx context.Context, webhookID string) error
+	AddWebhookDelivery(ctx context.Context, delivery *model.WebhookDelivery) error
+
+	// trades
+	// AddTrades stores new trades and ingestion cursor atomically, duplicated trades are skipped
+	AddTrades(ctx context.Context, trades []*model.Trade, cursor *model.TradeCursor) error
+	ListTrades(ctx context.Context, filter model.TradeFilter) ([]*model.Trade, error)
+	GetTradeStats(ctx context.Context, filter model.TradeFilter) ([]*model.TradeStats, error)
+	GetMemberTradeStats(ctx context.Context, filter model.TradeFilter) ([]*model.MemberTradeStats, error)
+	// GetOrderFeeRecipients returns fee recipient by order hash from ingested trades of the orders,
+	// orders without trade or with unknown fee recipient are not included
+	GetOrderFeeRecipients(ctx context.Context, orderHashes []string) (map[string]string, error)
+	// GetTradeCursor returns ErrNotFound if subaccount has not been ingested in the market
+	GetTradeCursor(ctx context.Context, subaccountID, marketID string) (*model.TradeCursor, error)
+
+	// fees
+	UpsertDailyFees(ctx context.Context, fees []*model.DailyFee) error
+	ListDailyFees(ctx context.Context, filter model.DailyFeeFilter) ([]*model.DailyFee, error)
+
+	// transfers
+	// AddTransfers stores new transfers, duplicated transfers are skipped
+	AddTransfers(ctx context.Context, transfers []*model.Transfer) error
+	ListTransfers(ctx context.Context, filter model.TransferFilter) ([]*model.Transfer, error)
+	GetNetFlows(ctx context.Context, filter model.TransferFilter) ([]*model.NetFlow, error)
+
+	// risk
+	AddGuildRisk(ctx context.Context, risk *model.GuildRisk) error
+	// GetLatestGuildRisk returns ErrNotFound if risk of guild has not been computed
+	GetLatestGuildRisk(ctx context.Context, guildID string) (*model.GuildRisk, error)
+
+	// alerts
+	AddAlertRule(ctx context.Context, rule *model.AlertRule) (*primitive.ObjectID, error)
+	ListAlertRules(ctx context.Context, filter model.AlertRuleFilter) ([]*model.AlertRule, error)
+	// DeleteAlertRule also deletes states of the rule
+	DeleteAlertRule(ctx context.Context, ruleID string) error
+	ListAlertStates(ctx context.Context) ([]*model.AlertState, error)
+	UpsertAlertState(ctx context.Context, state *model.AlertState) error
+
+	Ping(ctx context.Context) error
 	Disconnect(ctx context.Context) error
 }
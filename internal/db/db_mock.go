@@ -0,0 +1,879 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/db/db.go
+
+// Package db is a generated GoMock package.
+package db
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
+	gomock "github.com/golang/mock/gomock"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MockDBService is a mock of DBService interface.
+type MockDBService struct {
+	ctrl     *gomock.Controller
+	recorder *MockDBServiceMockRecorder
+}
+
+// MockDBServiceMockRecorder is the mock recorder for MockDBService.
+type MockDBServiceMockRecorder struct {
+	mock *MockDBService
+}
+
+// NewMockDBService creates a new mock instance.
+func NewMockDBService(ctrl *gomock.Controller) *MockDBService {
+	mock := &MockDBService{ctrl: ctrl}
+	mock.recorder = &MockDBServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDBService) EXPECT() *MockDBServiceMockRecorder {
+	return m.recorder
+}
+
+// AddAccountPortfolios mocks base method.
+func (m *MockDBService) AddAccountPortfolios(ctx context.Context, portfolios []*model.AccountPortfolio) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAccountPortfolios", ctx, portfolios)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddAccountPortfolios indicates an expected call of AddAccountPortfolios.
+func (mr *MockDBServiceMockRecorder) AddAccountPortfolios(ctx, portfolios interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAccountPortfolios", reflect.TypeOf((*MockDBService)(nil).AddAccountPortfolios), ctx, portfolios)
+}
+
+// AddAlertRule mocks base method.
+func (m *MockDBService) AddAlertRule(ctx context.Context, rule *model.AlertRule) (*primitive.ObjectID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAlertRule", ctx, rule)
+	ret0, _ := ret[0].(*primitive.ObjectID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddAlertRule indicates an expected call of AddAlertRule.
+func (mr *MockDBServiceMockRecorder) AddAlertRule(ctx, rule interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAlertRule", reflect.TypeOf((*MockDBService)(nil).AddAlertRule), ctx, rule)
+}
+
+// AddGrantNotifications mocks base method.
+func (m *MockDBService) AddGrantNotifications(ctx context.Context, notifications []*model.GrantNotification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddGrantNotifications", ctx, notifications)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddGrantNotifications indicates an expected call of AddGrantNotifications.
+func (mr *MockDBServiceMockRecorder) AddGrantNotifications(ctx, notifications interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGrantNotifications", reflect.TypeOf((*MockDBService)(nil).AddGrantNotifications), ctx, notifications)
+}
+
+// AddGuild mocks base method.
+func (m *MockDBService) AddGuild(ctx context.Context, guild *model.Guild) (*primitive.ObjectID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddGuild", ctx, guild)
+	ret0, _ := ret[0].(*primitive.ObjectID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddGuild indicates an expected call of AddGuild.
+func (mr *MockDBServiceMockRecorder) AddGuild(ctx, guild interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGuild", reflect.TypeOf((*MockDBService)(nil).AddGuild), ctx, guild)
+}
+
+// AddGuildMarkets mocks base method.
+func (m *MockDBService) AddGuildMarkets(ctx context.Context, guildID string, markets []*model.GuildMarket, requirements []*model.DenomRequirement) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddGuildMarkets", ctx, guildID, markets, requirements)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddGuildMarkets indicates an expected call of AddGuildMarkets.
+func (mr *MockDBServiceMockRecorder) AddGuildMarkets(ctx, guildID, markets, requirements interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGuildMarkets", reflect.TypeOf((*MockDBService)(nil).AddGuildMarkets), ctx, guildID, markets, requirements)
+}
+
+// AddGuildPortfolios mocks base method.
+func (m *MockDBService) AddGuildPortfolios(ctx context.Context, portfolios []*model.GuildPortfolio) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddGuildPortfolios", ctx, portfolios)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddGuildPortfolios indicates an expected call of AddGuildPortfolios.
+func (mr *MockDBServiceMockRecorder) AddGuildPortfolios(ctx, portfolios interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGuildPortfolios", reflect.TypeOf((*MockDBService)(nil).AddGuildPortfolios), ctx, portfolios)
+}
+
+// AddGuildRisk mocks base method.
+func (m *MockDBService) AddGuildRisk(ctx context.Context, risk *model.GuildRisk) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddGuildRisk", ctx, risk)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddGuildRisk indicates an expected call of AddGuildRisk.
+func (mr *MockDBServiceMockRecorder) AddGuildRisk(ctx, risk interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGuildRisk", reflect.TypeOf((*MockDBService)(nil).AddGuildRisk), ctx, risk)
+}
+
+// AddInviteCodes mocks base method.
+func (m *MockDBService) AddInviteCodes(ctx context.Context, codes []*model.InviteCode) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddInviteCodes", ctx, codes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddInviteCodes indicates an expected call of AddInviteCodes.
+func (mr *MockDBServiceMockRecorder) AddInviteCodes(ctx, codes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddInviteCodes", reflect.TypeOf((*MockDBService)(nil).AddInviteCodes), ctx, codes)
+}
+
+// AddMember mocks base method.
+func (m *MockDBService) AddMember(ctx context.Context, guildID string, address model.Address, initialPortfolio *model.AccountPortfolio, isDefaultMember bool, params string, subaccountIDs []string, inviteCode string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMember", ctx, guildID, address, initialPortfolio, isDefaultMember, params, subaccountIDs, inviteCode)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddMember indicates an expected call of AddMember.
+func (mr *MockDBServiceMockRecorder) AddMember(ctx, guildID, address, initialPortfolio, isDefaultMember, params, subaccountIDs, inviteCode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMember", reflect.TypeOf((*MockDBService)(nil).AddMember), ctx, guildID, address, initialPortfolio, isDefaultMember, params, subaccountIDs, inviteCode)
+}
+
+// AddToAllowlist mocks base method.
+func (m *MockDBService) AddToAllowlist(ctx context.Context, guildID string, addresses []model.Address) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddToAllowlist", ctx, guildID, addresses)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddToAllowlist indicates an expected call of AddToAllowlist.
+func (mr *MockDBServiceMockRecorder) AddToAllowlist(ctx, guildID, addresses interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddToAllowlist", reflect.TypeOf((*MockDBService)(nil).AddToAllowlist), ctx, guildID, addresses)
+}
+
+// AddToWaitlist mocks base method.
+func (m *MockDBService) AddToWaitlist(ctx context.Context, entry *model.WaitlistEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddToWaitlist", ctx, entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddToWaitlist indicates an expected call of AddToWaitlist.
+func (mr *MockDBServiceMockRecorder) AddToWaitlist(ctx, entry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddToWaitlist", reflect.TypeOf((*MockDBService)(nil).AddToWaitlist), ctx, entry)
+}
+
+// AddTrades mocks base method.
+func (m *MockDBService) AddTrades(ctx context.Context, trades []*model.Trade, cursor *model.TradeCursor) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTrades", ctx, trades, cursor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddTrades indicates an expected call of AddTrades.
+func (mr *MockDBServiceMockRecorder) AddTrades(ctx, trades, cursor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTrades", reflect.TypeOf((*MockDBService)(nil).AddTrades), ctx, trades, cursor)
+}
+
+// AddTransfers mocks base method.
+func (m *MockDBService) AddTransfers(ctx context.Context, transfers []*model.Transfer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTransfers", ctx, transfers)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddTransfers indicates an expected call of AddTransfers.
+func (mr *MockDBServiceMockRecorder) AddTransfers(ctx, transfers interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTransfers", reflect.TypeOf((*MockDBService)(nil).AddTransfers), ctx, transfers)
+}
+
+// AddWebhook mocks base method.
+func (m *MockDBService) AddWebhook(ctx context.Context, webhook *model.Webhook) (*primitive.ObjectID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWebhook", ctx, webhook)
+	ret0, _ := ret[0].(*primitive.ObjectID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddWebhook indicates an expected call of AddWebhook.
+func (mr *MockDBServiceMockRecorder) AddWebhook(ctx, webhook interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWebhook", reflect.TypeOf((*MockDBService)(nil).AddWebhook), ctx, webhook)
+}
+
+// AddWebhookDelivery mocks base method.
+func (m *MockDBService) AddWebhookDelivery(ctx context.Context, delivery *model.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWebhookDelivery", ctx, delivery)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddWebhookDelivery indicates an expected call of AddWebhookDelivery.
+func (mr *MockDBServiceMockRecorder) AddWebhookDelivery(ctx, delivery interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWebhookDelivery", reflect.TypeOf((*MockDBService)(nil).AddWebhookDelivery), ctx, delivery)
+}
+
+// DeleteAlertRule mocks base method.
+func (m *MockDBService) DeleteAlertRule(ctx context.Context, ruleID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAlertRule", ctx, ruleID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAlertRule indicates an expected call of DeleteAlertRule.
+func (mr *MockDBServiceMockRecorder) DeleteAlertRule(ctx, ruleID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAlertRule", reflect.TypeOf((*MockDBService)(nil).DeleteAlertRule), ctx, ruleID)
+}
+
+// DeleteGuild mocks base method.
+func (m *MockDBService) DeleteGuild(ctx context.Context, guildID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGuild", ctx, guildID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteGuild indicates an expected call of DeleteGuild.
+func (mr *MockDBServiceMockRecorder) DeleteGuild(ctx, guildID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGuild", reflect.TypeOf((*MockDBService)(nil).DeleteGuild), ctx, guildID)
+}
+
+// DeleteWebhook mocks base method.
+func (m *MockDBService) DeleteWebhook(ctx context.Context, webhookID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhook", ctx, webhookID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWebhook indicates an expected call of DeleteWebhook.
+func (mr *MockDBServiceMockRecorder) DeleteWebhook(ctx, webhookID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhook", reflect.TypeOf((*MockDBService)(nil).DeleteWebhook), ctx, webhookID)
+}
+
+// Disconnect mocks base method.
+func (m *MockDBService) Disconnect(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Disconnect", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Disconnect indicates an expected call of Disconnect.
+func (mr *MockDBServiceMockRecorder) Disconnect(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Disconnect", reflect.TypeOf((*MockDBService)(nil).Disconnect), ctx)
+}
+
+// GetAccountPortfolio mocks base method.
+func (m *MockDBService) GetAccountPortfolio(ctx context.Context, address model.Address) (*model.AccountPortfolio, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountPortfolio", ctx, address)
+	ret0, _ := ret[0].(*model.AccountPortfolio)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountPortfolio indicates an expected call of GetAccountPortfolio.
+func (mr *MockDBServiceMockRecorder) GetAccountPortfolio(ctx, address interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountPortfolio", reflect.TypeOf((*MockDBService)(nil).GetAccountPortfolio), ctx, address)
+}
+
+// GetInviteCode mocks base method.
+func (m *MockDBService) GetInviteCode(ctx context.Context, guildID, code string) (*model.InviteCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInviteCode", ctx, guildID, code)
+	ret0, _ := ret[0].(*model.InviteCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInviteCode indicates an expected call of GetInviteCode.
+func (mr *MockDBServiceMockRecorder) GetInviteCode(ctx, guildID, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInviteCode", reflect.TypeOf((*MockDBService)(nil).GetInviteCode), ctx, guildID, code)
+}
+
+// GetLatestGuildRisk mocks base method.
+func (m *MockDBService) GetLatestGuildRisk(ctx context.Context, guildID string) (*model.GuildRisk, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestGuildRisk", ctx, guildID)
+	ret0, _ := ret[0].(*model.GuildRisk)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestGuildRisk indicates an expected call of GetLatestGuildRisk.
+func (mr *MockDBServiceMockRecorder) GetLatestGuildRisk(ctx, guildID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestGuildRisk", reflect.TypeOf((*MockDBService)(nil).GetLatestGuildRisk), ctx, guildID)
+}
+
+// GetMemberTradeStats mocks base method.
+func (m *MockDBService) GetMemberTradeStats(ctx context.Context, filter model.TradeFilter) ([]*model.MemberTradeStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMemberTradeStats", ctx, filter)
+	ret0, _ := ret[0].([]*model.MemberTradeStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMemberTradeStats indicates an expected call of GetMemberTradeStats.
+func (mr *MockDBServiceMockRecorder) GetMemberTradeStats(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMemberTradeStats", reflect.TypeOf((*MockDBService)(nil).GetMemberTradeStats), ctx, filter)
+}
+
+// GetNetFlows mocks base method.
+func (m *MockDBService) GetNetFlows(ctx context.Context, filter model.TransferFilter) ([]*model.NetFlow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNetFlows", ctx, filter)
+	ret0, _ := ret[0].([]*model.NetFlow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNetFlows indicates an expected call of GetNetFlows.
+func (mr *MockDBServiceMockRecorder) GetNetFlows(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetFlows", reflect.TypeOf((*MockDBService)(nil).GetNetFlows), ctx, filter)
+}
+
+// GetOrderFeeRecipients mocks base method.
+func (m *MockDBService) GetOrderFeeRecipients(ctx context.Context, orderHashes []string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderFeeRecipients", ctx, orderHashes)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderFeeRecipients indicates an expected call of GetOrderFeeRecipients.
+func (mr *MockDBServiceMockRecorder) GetOrderFeeRecipients(ctx, orderHashes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderFeeRecipients", reflect.TypeOf((*MockDBService)(nil).GetOrderFeeRecipients), ctx, orderHashes)
+}
+
+// GetSingleGuild mocks base method.
+func (m *MockDBService) GetSingleGuild(ctx context.Context, guildID string) (*model.Guild, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSingleGuild", ctx, guildID)
+	ret0, _ := ret[0].(*model.Guild)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSingleGuild indicates an expected call of GetSingleGuild.
+func (mr *MockDBServiceMockRecorder) GetSingleGuild(ctx, guildID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSingleGuild", reflect.TypeOf((*MockDBService)(nil).GetSingleGuild), ctx, guildID)
+}
+
+// GetTradeCursor mocks base method.
+func (m *MockDBService) GetTradeCursor(ctx context.Context, subaccountID, marketID string) (*model.TradeCursor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTradeCursor", ctx, subaccountID, marketID)
+	ret0, _ := ret[0].(*model.TradeCursor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTradeCursor indicates an expected call of GetTradeCursor.
+func (mr *MockDBServiceMockRecorder) GetTradeCursor(ctx, subaccountID, marketID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTradeCursor", reflect.TypeOf((*MockDBService)(nil).GetTradeCursor), ctx, subaccountID, marketID)
+}
+
+// GetTradeStats mocks base method.
+func (m *MockDBService) GetTradeStats(ctx context.Context, filter model.TradeFilter) ([]*model.TradeStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTradeStats", ctx, filter)
+	ret0, _ := ret[0].([]*model.TradeStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTradeStats indicates an expected call of GetTradeStats.
+func (mr *MockDBServiceMockRecorder) GetTradeStats(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTradeStats", reflect.TypeOf((*MockDBService)(nil).GetTradeStats), ctx, filter)
+}
+
+// GetWaitlistEntry mocks base method.
+func (m *MockDBService) GetWaitlistEntry(ctx context.Context, guildID string, address model.Address) (*model.WaitlistEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWaitlistEntry", ctx, guildID, address)
+	ret0, _ := ret[0].(*model.WaitlistEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWaitlistEntry indicates an expected call of GetWaitlistEntry.
+func (mr *MockDBServiceMockRecorder) GetWaitlistEntry(ctx, guildID, address interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWaitlistEntry", reflect.TypeOf((*MockDBService)(nil).GetWaitlistEntry), ctx, guildID, address)
+}
+
+// IsAllowlisted mocks base method.
+func (m *MockDBService) IsAllowlisted(ctx context.Context, guildID string, address model.Address) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsAllowlisted", ctx, guildID, address)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsAllowlisted indicates an expected call of IsAllowlisted.
+func (mr *MockDBServiceMockRecorder) IsAllowlisted(ctx, guildID, address interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsAllowlisted", reflect.TypeOf((*MockDBService)(nil).IsAllowlisted), ctx, guildID, address)
+}
+
+// ListAccountPortfolios mocks base method.
+func (m *MockDBService) ListAccountPortfolios(ctx context.Context, filter model.AccountPortfoliosFilter) ([]*model.AccountPortfolio, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccountPortfolios", ctx, filter)
+	ret0, _ := ret[0].([]*model.AccountPortfolio)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountPortfolios indicates an expected call of ListAccountPortfolios.
+func (mr *MockDBServiceMockRecorder) ListAccountPortfolios(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountPortfolios", reflect.TypeOf((*MockDBService)(nil).ListAccountPortfolios), ctx, filter)
+}
+
+// ListAlertRules mocks base method.
+func (m *MockDBService) ListAlertRules(ctx context.Context, filter model.AlertRuleFilter) ([]*model.AlertRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAlertRules", ctx, filter)
+	ret0, _ := ret[0].([]*model.AlertRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAlertRules indicates an expected call of ListAlertRules.
+func (mr *MockDBServiceMockRecorder) ListAlertRules(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAlertRules", reflect.TypeOf((*MockDBService)(nil).ListAlertRules), ctx, filter)
+}
+
+// ListAlertStates mocks base method.
+func (m *MockDBService) ListAlertStates(ctx context.Context) ([]*model.AlertState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAlertStates", ctx)
+	ret0, _ := ret[0].([]*model.AlertState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAlertStates indicates an expected call of ListAlertStates.
+func (mr *MockDBServiceMockRecorder) ListAlertStates(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAlertStates", reflect.TypeOf((*MockDBService)(nil).ListAlertStates), ctx)
+}
+
+// ListAllGuilds mocks base method.
+func (m *MockDBService) ListAllGuilds(ctx context.Context, includeArchived bool) ([]*model.Guild, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAllGuilds", ctx, includeArchived)
+	ret0, _ := ret[0].([]*model.Guild)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAllGuilds indicates an expected call of ListAllGuilds.
+func (mr *MockDBServiceMockRecorder) ListAllGuilds(ctx, includeArchived interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllGuilds", reflect.TypeOf((*MockDBService)(nil).ListAllGuilds), ctx, includeArchived)
+}
+
+// ListDailyFees mocks base method.
+func (m *MockDBService) ListDailyFees(ctx context.Context, filter model.DailyFeeFilter) ([]*model.DailyFee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDailyFees", ctx, filter)
+	ret0, _ := ret[0].([]*model.DailyFee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDailyFees indicates an expected call of ListDailyFees.
+func (mr *MockDBServiceMockRecorder) ListDailyFees(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDailyFees", reflect.TypeOf((*MockDBService)(nil).ListDailyFees), ctx, filter)
+}
+
+// ListGrantNotifications mocks base method.
+func (m *MockDBService) ListGrantNotifications(ctx context.Context, address model.Address) ([]*model.GrantNotification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGrantNotifications", ctx, address)
+	ret0, _ := ret[0].([]*model.GrantNotification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGrantNotifications indicates an expected call of ListGrantNotifications.
+func (mr *MockDBServiceMockRecorder) ListGrantNotifications(ctx, address interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGrantNotifications", reflect.TypeOf((*MockDBService)(nil).ListGrantNotifications), ctx, address)
+}
+
+// ListGuildMembers mocks base method.
+func (m *MockDBService) ListGuildMembers(ctx context.Context, filter model.MemberFilter) ([]*model.GuildMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGuildMembers", ctx, filter)
+	ret0, _ := ret[0].([]*model.GuildMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGuildMembers indicates an expected call of ListGuildMembers.
+func (mr *MockDBServiceMockRecorder) ListGuildMembers(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGuildMembers", reflect.TypeOf((*MockDBService)(nil).ListGuildMembers), ctx, filter)
+}
+
+// ListGuildPortfolios mocks base method.
+func (m *MockDBService) ListGuildPortfolios(ctx context.Context, filter model.GuildPortfoliosFilter) ([]*model.GuildPortfolio, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGuildPortfolios", ctx, filter)
+	ret0, _ := ret[0].([]*model.GuildPortfolio)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGuildPortfolios indicates an expected call of ListGuildPortfolios.
+func (mr *MockDBServiceMockRecorder) ListGuildPortfolios(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGuildPortfolios", reflect.TypeOf((*MockDBService)(nil).ListGuildPortfolios), ctx, filter)
+}
+
+// ListInviteCodes mocks base method.
+func (m *MockDBService) ListInviteCodes(ctx context.Context, guildID string) ([]*model.InviteCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInviteCodes", ctx, guildID)
+	ret0, _ := ret[0].([]*model.InviteCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInviteCodes indicates an expected call of ListInviteCodes.
+func (mr *MockDBServiceMockRecorder) ListInviteCodes(ctx, guildID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInviteCodes", reflect.TypeOf((*MockDBService)(nil).ListInviteCodes), ctx, guildID)
+}
+
+// ListMemberships mocks base method.
+func (m *MockDBService) ListMemberships(ctx context.Context, address model.Address) ([]*model.Membership, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMemberships", ctx, address)
+	ret0, _ := ret[0].([]*model.Membership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMemberships indicates an expected call of ListMemberships.
+func (mr *MockDBServiceMockRecorder) ListMemberships(ctx, address interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMemberships", reflect.TypeOf((*MockDBService)(nil).ListMemberships), ctx, address)
+}
+
+// ListTrades mocks base method.
+func (m *MockDBService) ListTrades(ctx context.Context, filter model.TradeFilter) ([]*model.Trade, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTrades", ctx, filter)
+	ret0, _ := ret[0].([]*model.Trade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTrades indicates an expected call of ListTrades.
+func (mr *MockDBServiceMockRecorder) ListTrades(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrades", reflect.TypeOf((*MockDBService)(nil).ListTrades), ctx, filter)
+}
+
+// ListTransfers mocks base method.
+func (m *MockDBService) ListTransfers(ctx context.Context, filter model.TransferFilter) ([]*model.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTransfers", ctx, filter)
+	ret0, _ := ret[0].([]*model.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTransfers indicates an expected call of ListTransfers.
+func (mr *MockDBServiceMockRecorder) ListTransfers(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransfers", reflect.TypeOf((*MockDBService)(nil).ListTransfers), ctx, filter)
+}
+
+// ListWaitlist mocks base method.
+func (m *MockDBService) ListWaitlist(ctx context.Context, guildID string) ([]*model.WaitlistEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWaitlist", ctx, guildID)
+	ret0, _ := ret[0].([]*model.WaitlistEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWaitlist indicates an expected call of ListWaitlist.
+func (mr *MockDBServiceMockRecorder) ListWaitlist(ctx, guildID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWaitlist", reflect.TypeOf((*MockDBService)(nil).ListWaitlist), ctx, guildID)
+}
+
+// ListWebhooks mocks base method.
+func (m *MockDBService) ListWebhooks(ctx context.Context, filter model.WebhookFilter) ([]*model.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWebhooks", ctx, filter)
+	ret0, _ := ret[0].([]*model.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhooks indicates an expected call of ListWebhooks.
+func (mr *MockDBServiceMockRecorder) ListWebhooks(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhooks", reflect.TypeOf((*MockDBService)(nil).ListWebhooks), ctx, filter)
+}
+
+// Ping mocks base method.
+func (m *MockDBService) Ping(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ping", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Ping indicates an expected call of Ping.
+func (mr *MockDBServiceMockRecorder) Ping(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockDBService)(nil).Ping), ctx)
+}
+
+// RemoveFromAllowlist mocks base method.
+func (m *MockDBService) RemoveFromAllowlist(ctx context.Context, guildID string, addresses []model.Address) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveFromAllowlist", ctx, guildID, addresses)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveFromAllowlist indicates an expected call of RemoveFromAllowlist.
+func (mr *MockDBServiceMockRecorder) RemoveFromAllowlist(ctx, guildID, addresses interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFromAllowlist", reflect.TypeOf((*MockDBService)(nil).RemoveFromAllowlist), ctx, guildID, addresses)
+}
+
+// RemoveFromWaitlist mocks base method.
+func (m *MockDBService) RemoveFromWaitlist(ctx context.Context, guildID string, address model.Address) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveFromWaitlist", ctx, guildID, address)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveFromWaitlist indicates an expected call of RemoveFromWaitlist.
+func (mr *MockDBServiceMockRecorder) RemoveFromWaitlist(ctx, guildID, address interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFromWaitlist", reflect.TypeOf((*MockDBService)(nil).RemoveFromWaitlist), ctx, guildID, address)
+}
+
+// RemoveGuildMarket mocks base method.
+func (m *MockDBService) RemoveGuildMarket(ctx context.Context, guildID, marketID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveGuildMarket", ctx, guildID, marketID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveGuildMarket indicates an expected call of RemoveGuildMarket.
+func (mr *MockDBServiceMockRecorder) RemoveGuildMarket(ctx, guildID, marketID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveGuildMarket", reflect.TypeOf((*MockDBService)(nil).RemoveGuildMarket), ctx, guildID, marketID)
+}
+
+// RemoveMember mocks base method.
+func (m *MockDBService) RemoveMember(ctx context.Context, guildID string, address model.Address) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMember", ctx, guildID, address)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveMember indicates an expected call of RemoveMember.
+func (mr *MockDBServiceMockRecorder) RemoveMember(ctx, guildID, address interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMember", reflect.TypeOf((*MockDBService)(nil).RemoveMember), ctx, guildID, address)
+}
+
+// SetGuildAccessMode mocks base method.
+func (m *MockDBService) SetGuildAccessMode(ctx context.Context, guildID, mode string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetGuildAccessMode", ctx, guildID, mode)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetGuildAccessMode indicates an expected call of SetGuildAccessMode.
+func (mr *MockDBServiceMockRecorder) SetGuildAccessMode(ctx, guildID, mode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetGuildAccessMode", reflect.TypeOf((*MockDBService)(nil).SetGuildAccessMode), ctx, guildID, mode)
+}
+
+// SetGuildArchived mocks base method.
+func (m *MockDBService) SetGuildArchived(ctx context.Context, guildID string, archived bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetGuildArchived", ctx, guildID, archived)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetGuildArchived indicates an expected call of SetGuildArchived.
+func (mr *MockDBServiceMockRecorder) SetGuildArchived(ctx, guildID, archived interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetGuildArchived", reflect.TypeOf((*MockDBService)(nil).SetGuildArchived), ctx, guildID, archived)
+}
+
+// SetGuildCap mocks base method.
+func (m *MockDBService) SetGuildCap(ctx context.Context, guildID string, cap int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetGuildCap", ctx, guildID, cap)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetGuildCap indicates an expected call of SetGuildCap.
+func (mr *MockDBServiceMockRecorder) SetGuildCap(ctx, guildID, cap interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetGuildCap", reflect.TypeOf((*MockDBService)(nil).SetGuildCap), ctx, guildID, cap)
+}
+
+// SetGuildRequirement mocks base method.
+func (m *MockDBService) SetGuildRequirement(ctx context.Context, guildID string, requirement *model.DenomRequirement) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetGuildRequirement", ctx, guildID, requirement)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetGuildRequirement indicates an expected call of SetGuildRequirement.
+func (mr *MockDBServiceMockRecorder) SetGuildRequirement(ctx, guildID, requirement interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetGuildRequirement", reflect.TypeOf((*MockDBService)(nil).SetGuildRequirement), ctx, guildID, requirement)
+}
+
+// TransferMember mocks base method.
+func (m *MockDBService) TransferMember(ctx context.Context, fromGuildID, toGuildID string, address model.Address, initialPortfolio *model.AccountPortfolio, params string, subaccountIDs []string, inviteCode string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferMember", ctx, fromGuildID, toGuildID, address, initialPortfolio, params, subaccountIDs, inviteCode)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TransferMember indicates an expected call of TransferMember.
+func (mr *MockDBServiceMockRecorder) TransferMember(ctx, fromGuildID, toGuildID, address, initialPortfolio, params, subaccountIDs, inviteCode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferMember", reflect.TypeOf((*MockDBService)(nil).TransferMember), ctx, fromGuildID, toGuildID, address, initialPortfolio, params, subaccountIDs, inviteCode)
+}
+
+// UpdateGuildMarkets mocks base method.
+func (m *MockDBService) UpdateGuildMarkets(ctx context.Context, guildID string, markets []*model.GuildMarket) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGuildMarkets", ctx, guildID, markets)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateGuildMarkets indicates an expected call of UpdateGuildMarkets.
+func (mr *MockDBServiceMockRecorder) UpdateGuildMarkets(ctx, guildID, markets interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGuildMarkets", reflect.TypeOf((*MockDBService)(nil).UpdateGuildMarkets), ctx, guildID, markets)
+}
+
+// UpsertAlertState mocks base method.
+func (m *MockDBService) UpsertAlertState(ctx context.Context, state *model.AlertState) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertAlertState", ctx, state)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertAlertState indicates an expected call of UpsertAlertState.
+func (mr *MockDBServiceMockRecorder) UpsertAlertState(ctx, state interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertAlertState", reflect.TypeOf((*MockDBService)(nil).UpsertAlertState), ctx, state)
+}
+
+// UpsertDailyFees mocks base method.
+func (m *MockDBService) UpsertDailyFees(ctx context.Context, fees []*model.DailyFee) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertDailyFees", ctx, fees)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertDailyFees indicates an expected call of UpsertDailyFees.
+func (mr *MockDBServiceMockRecorder) UpsertDailyFees(ctx, fees interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertDailyFees", reflect.TypeOf((*MockDBService)(nil).UpsertDailyFees), ctx, fees)
+}
+
+// WatchPortfolioUpdates mocks base method.
+func (m *MockDBService) WatchPortfolioUpdates(ctx context.Context, resumeToken string, handler func(*model.PortfolioUpdate) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchPortfolioUpdates", ctx, resumeToken, handler)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WatchPortfolioUpdates indicates an expected call of WatchPortfolioUpdates.
+func (mr *MockDBServiceMockRecorder) WatchPortfolioUpdates(ctx, resumeToken, handler interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchPortfolioUpdates", reflect.TypeOf((*MockDBService)(nil).WatchPortfolioUpdates), ctx, resumeToken, handler)
+}
@@ -0,0 +1,58 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	// AccessModeOpen lets any qualified address enter guild
+	AccessModeOpen = "open"
+	// AccessModeAllowlist only lets allowlisted addresses enter guild
+	AccessModeAllowlist = "allowlist"
+	// AccessModeInviteCode requires an invite code of the guild in params to enter guild
+	AccessModeInviteCode = "invite_code"
+)
+
+// AccessModes are supported guild access modes
+var AccessModes = []string{AccessModeOpen, AccessModeAllowlist, AccessModeInviteCode}
+
+func IsValidAccessMode(mode string) bool {
+	for _, m := range AccessModes {
+		if m == mode {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowlistEntry is an address allowed to enter an allowlist guild
+type AllowlistEntry struct {
+	ID      primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	GuildID primitive.ObjectID `bson:"guild_id" json:"guild_id"`
+
+	InjectiveAddress Address   `bson:"injective_address" json:"injective_address"`
+	AddedAt          time.Time `bson:"added_at" json:"added_at"`
+}
+
+// InviteCode lets addresses enter an invite-only guild, a code can be used MaxUses times
+type InviteCode struct {
+	ID      primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	GuildID primitive.ObjectID `bson:"guild_id" json:"guild_id"`
+
+	Code    string `bson:"code" json:"code"`
+	MaxUses int    `bson:"max_uses" json:"max_uses"`
+	Uses    int    `bson:"uses" json:"uses"`
+
+	CreatedAt time.Time  `bson:"created_at" json:"created_at"`
+	ExpiresAt *time.Time `bson:"expires_at,omitempty" json:"expires_at,omitempty"`
+}
+
+// IsUsable returns true if code has uses left and isn't expired at given time
+func (c *InviteCode) IsUsable(now time.Time) bool {
+	if c.ExpiresAt != nil && !c.ExpiresAt.After(now) {
+		return false
+	}
+	return c.Uses < c.MaxUses
+}
@@ -0,0 +1,61 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	// AlertKindEquityDrop fires when guild usd value dropped more than threshold (fraction) within window
+	AlertKindEquityDrop = "equity_drop"
+	// AlertKindMemberCountBelow fires when guild member count is below threshold
+	AlertKindMemberCountBelow = "member_count_below"
+	// AlertKindCaptureStale fires when no guild portfolio has been captured within window
+	AlertKindCaptureStale = "capture_stale"
+	// AlertKindLeverageAbove fires when latest guild leverage is above threshold
+	AlertKindLeverageAbove = "leverage_above"
+)
+
+var AlertKinds = []string{
+	AlertKindEquityDrop,
+	AlertKindMemberCountBelow,
+	AlertKindCaptureStale,
+	AlertKindLeverageAbove,
+}
+
+func IsValidAlertKind(kind string) bool {
+	for _, k := range AlertKinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// AlertRule is evaluated for its guild, or every guild if GuildID is nil, after each capture cycle
+type AlertRule struct {
+	ID      primitive.ObjectID  `bson:"_id,omitempty" json:"id"`
+	GuildID *primitive.ObjectID `bson:"guild_id,omitempty" json:"guild_id"`
+
+	Kind      string  `bson:"kind" json:"kind"`
+	Threshold float64 `bson:"threshold" json:"threshold"`
+	// Window is used by equity_drop and capture_stale rules
+	Window time.Duration `bson:"window" json:"window"`
+	// Sinks are notifier names to fire through, empty means all configured notifiers
+	Sinks     []string  `bson:"sinks" json:"sinks"`
+	CreatedAt time.Time `bson:"created_at" json:"created_at"`
+}
+
+// AlertState is last evaluation of a rule for a guild, notifications are sent when Firing changes
+type AlertState struct {
+	RuleID  primitive.ObjectID `bson:"rule_id" json:"rule_id"`
+	GuildID primitive.ObjectID `bson:"guild_id" json:"guild_id"`
+
+	Firing  bool    `bson:"firing" json:"firing"`
+	Value   float64 `bson:"value" json:"value"`
+	Message string  `bson:"message" json:"message"`
+	// Since is when alert started firing or got resolved
+	Since     time.Time `bson:"since" json:"since"`
+	UpdatedAt time.Time `bson:"updated_at" json:"updated_at"`
+}
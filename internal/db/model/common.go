@@ -139,14 +139,67 @@ type MemberFilter struct {
 
 type AccountPortfoliosFilter struct {
 	InjectiveAddress Address
+	// GuildID filters snapshots taken while address was member of the guild
+	GuildID   *string
+	StartTime *time.Time
+	EndTime   *time.Time
+	Limit     *int64
+}
+
+type GuildPortfoliosFilter struct {
+	GuildID   string
+	StartTime *time.Time
+	EndTime   *time.Time
+	Limit     *int64
+}
+
+// PortfolioUpdateFilter keeps updates matching all set fields,
+// guild portfolio updates are dropped when InjectiveAddress is set
+type PortfolioUpdateFilter struct {
+	GuildID          *string
+	InjectiveAddress *Address
+}
+
+func (f PortfolioUpdateFilter) Match(update *PortfolioUpdate) bool {
+	if f.GuildID != nil && update.GuildID.Hex() != *f.GuildID {
+		return false
+	}
+
+	if f.InjectiveAddress != nil && !update.InjectiveAddress.Equals(f.InjectiveAddress.AccAddress) {
+		return false
+	}
+	return true
+}
+
+// AlertRuleFilter with nil GuildID lists all rules
+type AlertRuleFilter struct {
+	GuildID *string
+}
+
+type WebhookFilter struct {
+	GuildID *string
+	Event   *string
+}
+
+type TradeFilter struct {
+	GuildID          *string
+	InjectiveAddress *Address
+	FeeRecipient     *string
 	StartTime        *time.Time
 	EndTime          *time.Time
 	Limit            *int64
 }
 
-type GuildPortfoliosFilter struct {
+type DailyFeeFilter struct {
 	GuildID   string
 	StartTime *time.Time
 	EndTime   *time.Time
-	Limit     *int64
+}
+
+type TransferFilter struct {
+	GuildID          *string
+	InjectiveAddress *Address
+	StartTime        *time.Time
+	EndTime          *time.Time
+	Limit            *int64
 }
@@ -0,0 +1,27 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// DailyFee is fee routed to guild master from a member's trades in a UTC day
+type DailyFee struct {
+	GuildID          primitive.ObjectID `bson:"guild_id" json:"guild_id"`
+	Day              time.Time          `bson:"day" json:"day"`
+	InjectiveAddress string             `bson:"injective_address" json:"injective_address"`
+	QuoteDenom       string             `bson:"quote_denom" json:"quote_denom"`
+
+	// FeesPaid is total trading fee paid by member
+	FeesPaid primitive.Decimal128 `bson:"fees_paid" json:"fees_paid"`
+	// MasterFees is relayer share of FeesPaid earned by guild master as fee recipient
+	MasterFees primitive.Decimal128 `bson:"master_fees" json:"master_fees"`
+	// UnresolvedFees is relayer share of fees paid on trades with unknown fee recipient,
+	// guild master may have earned it but it's not counted in MasterFees
+	UnresolvedFees primitive.Decimal128 `bson:"unresolved_fees" json:"unresolved_fees"`
+	Volume         primitive.Decimal128 `bson:"volume" json:"volume"`
+	TradeCount     int                  `bson:"trade_count" json:"trade_count"`
+
+	UpdatedAt time.Time `bson:"updated_at" json:"updated_at"`
+}
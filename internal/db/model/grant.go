@@ -0,0 +1,14 @@
+package model
+
+import (
+	"time"
+)
+
+// GrantNotification records that address was notified about a grant expiring at Expiration,
+// a renewed grant has a new expiration and is notified again
+type GrantNotification struct {
+	InjectiveAddress Address   `bson:"injective_address" json:"injective_address"`
+	Msg              string    `bson:"msg" json:"msg"`
+	Expiration       time.Time `bson:"expiration" json:"expiration"`
+	NotifiedAt       time.Time `bson:"notified_at" json:"notified_at"`
+}
@@ -20,6 +20,20 @@ type GuildMarket struct {
 	// these rate may be changed through a proposal
 	MakerFeeRate primitive.Decimal128 `bson:"maker_fee_rate" json:"maker_fee_rate"`
 	TakerFeeRate primitive.Decimal128 `bson:"taker_fee_rate" json:"taker_fee_rate"`
+
+	// Status is market status of exchange api, refreshed by guilds process. Empty if never refreshed
+	Status string `bson:"status,omitempty" json:"status,omitempty"`
+}
+
+const (
+	MarketStatusActive = "active"
+	// MarketStatusDelisted is set when exchange api doesn't know the market anymore
+	MarketStatusDelisted = "delisted"
+)
+
+// IsActive returns false if market is delisted, paused or in any status other than active
+func (m *GuildMarket) IsActive() bool {
+	return m.Status == "" || m.Status == MarketStatusActive
 }
 
 type DenomCoinID struct {
@@ -55,6 +69,23 @@ type Guild struct {
 
 	// since number of markets is limited, we can embeded here:
 	Markets []*GuildMarket `bson:"markets" json:"markets"`
+	// HasInactiveMarkets flags guilds having delisted or paused markets
+	HasInactiveMarkets bool `bson:"has_inactive_markets" json:"has_inactive_markets"`
+
+	// Archived guilds are closed to new members and not captured anymore, their history is kept
+	Archived   bool       `bson:"archived" json:"archived"`
+	ArchivedAt *time.Time `bson:"archived_at,omitempty" json:"archived_at,omitempty"`
+
+	// AccessMode is one of AccessMode constants, empty means open
+	AccessMode string `bson:"access_mode,omitempty" json:"access_mode,omitempty"`
+}
+
+// GetAccessMode returns access mode of guild, guilds created before access modes are open
+func (g *Guild) GetAccessMode() string {
+	if g.AccessMode == "" {
+		return AccessModeOpen
+	}
+	return g.AccessMode
 }
 
 // AccountPortfolio snapshot
@@ -75,6 +106,41 @@ type BankBalance struct {
 	Balance primitive.Decimal128 `bson:"balance" json:"balance"`
 }
 
+const (
+	CashFlowDeposit      = "deposit"
+	CashFlowWithdraw     = "withdraw"
+	CashFlowTransferIn   = "transfer_in"
+	CashFlowTransferOut  = "transfer_out"
+	CashFlowMemberJoined = "member_joined"
+	CashFlowMemberLeft   = "member_left"
+)
+
+// CashFlow is an external flow into (positive amount) or out of (negative amount) a portfolio,
+// it happened between previous snapshot and the snapshot holding it
+type CashFlow struct {
+	Type             string               `bson:"type" json:"type"`
+	InjectiveAddress Address              `bson:"injective_address,omitempty" json:"injective_address"`
+	Denom            string               `bson:"denom" json:"denom"`
+	Amount           primitive.Decimal128 `bson:"amount" json:"amount"`
+	ExecutedAt       time.Time            `bson:"executed_at" json:"executed_at"`
+}
+
+// Position is a derivative position held by a trading subaccount at snapshot time
+type Position struct {
+	SubaccountID string `bson:"subaccount_id" json:"subaccount_id"`
+	MarketID     string `bson:"market_id" json:"market_id"`
+	Ticker       string `bson:"ticker" json:"ticker"`
+	// QuoteDenom is empty if market is not in guild
+	QuoteDenom string `bson:"quote_denom,omitempty" json:"quote_denom"`
+	Direction  string `bson:"direction" json:"direction"`
+
+	Quantity         primitive.Decimal128 `bson:"quantity" json:"quantity"`
+	EntryPrice       primitive.Decimal128 `bson:"entry_price" json:"entry_price"`
+	MarkPrice        primitive.Decimal128 `bson:"mark_price" json:"mark_price"`
+	Margin           primitive.Decimal128 `bson:"margin" json:"margin"`
+	LiquidationPrice primitive.Decimal128 `bson:"liquidation_price" json:"liquidation_price"`
+}
+
 type AccountPortfolio struct {
 	ID primitive.ObjectID `bson:"_id,omitempty"`
 
@@ -87,6 +153,10 @@ type AccountPortfolio struct {
 	Balances []*Balance `bson:"balances" json:"balances"`
 	// Store account's inj amount atm
 	BankBalances []*BankBalance `bson:"bank_balances" json:"bank_balances"`
+	// CashFlows are deposits, withdrawals and transfers since previous snapshot
+	CashFlows []*CashFlow `bson:"cash_flows,omitempty" json:"cash_flows"`
+	// Positions are open derivative positions of all trading subaccounts
+	Positions []*Position `bson:"positions,omitempty" json:"positions"`
 	// timestamp when this gets update
 	UpdatedAt time.Time `bson:"updated_at" json:"updated_at"`
 }
@@ -97,6 +167,8 @@ type GuildPortfolio struct {
 	Balances    []*Balance         `bson:"balances" json:"balances"`
 	// Store account's inj amount atm
 	BankBalances []*BankBalance `bson:"bank_balances" json:"bank_balances"`
+	// CashFlows are member transfers and joins/leaves since previous snapshot
+	CashFlows []*CashFlow `bson:"cash_flows,omitempty" json:"cash_flows"`
 	// timestamp when this gets update
 	UpdatedAt time.Time `bson:"updated_at" json:"updated_at"`
 }
@@ -109,6 +181,23 @@ type GuildMember struct {
 	InjectiveAddress     Address   `bson:"injective_address" json:"injective_address"`
 	IsDefaultGuildMember bool      `bson:"is_default_guild_member" json:"is_default_guild_member"` // json might not need here
 	Since                time.Time `bson:"since" json:"since"`
+	// SubaccountIDs are non-default subaccounts registered by member, in lowercase hex
+	SubaccountIDs []string `bson:"subaccount_ids,omitempty" json:"subaccount_ids"`
+}
+
+// Membership is a past membership of an address, it's recorded when member leaves or is disqualified.
+// Snapshots taken during membership are kept in account portfolios with guild ID
+type Membership struct {
+	GuildID              primitive.ObjectID `bson:"guild_id" json:"guild_id"`
+	InjectiveAddress     Address            `bson:"injective_address" json:"injective_address"`
+	IsDefaultGuildMember bool               `bson:"is_default_guild_member" json:"is_default_guild_member"`
+	Since                time.Time          `bson:"since" json:"since"`
+	EndedAt              time.Time          `bson:"ended_at" json:"ended_at"`
+}
+
+// TradingSubaccountIDs returns default subaccount followed by registered subaccounts
+func (m *GuildMember) TradingSubaccountIDs() []string {
+	return append([]string{DefaultSubaccountID(m.InjectiveAddress)}, m.SubaccountIDs...)
 }
 
 func GetGuildDenoms(guild *Guild) []string {
@@ -135,6 +224,8 @@ func (a *AccountPortfolio) Copy() *AccountPortfolio {
 		UpdatedAt:        a.UpdatedAt,
 		Balances:         make([]*Balance, len(a.Balances)),
 		BankBalances:     make([]*BankBalance, len(a.BankBalances)),
+		CashFlows:        a.CashFlows,
+		Positions:        a.Positions,
 	}
 
 	for i, b := range a.Balances {
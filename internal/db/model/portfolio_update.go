@@ -0,0 +1,28 @@
+package model
+
+import (
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	PortfolioUpdateGuildPortfolio   = "guild_portfolio"
+	PortfolioUpdateAccountPortfolio = "account_portfolio"
+	PortfolioUpdateMemberJoined     = "member_joined"
+	PortfolioUpdateMemberLeft       = "member_left"
+)
+
+// PortfolioUpdate is a snapshot or membership change written to db, only the field of its Kind is set:
+// GuildPortfolio, AccountPortfolio, Member (joined) or Membership (left)
+type PortfolioUpdate struct {
+	// ID is the change stream resume token of the update
+	ID      string
+	Kind    string
+	GuildID primitive.ObjectID
+	// InjectiveAddress is empty for guild portfolio
+	InjectiveAddress Address
+
+	GuildPortfolio   *GuildPortfolio
+	AccountPortfolio *AccountPortfolio
+	Member           *GuildMember
+	Membership       *Membership
+}
@@ -0,0 +1,40 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MarketExposure is usd notional of guild positions in a derivative market
+type MarketExposure struct {
+	MarketID    string  `bson:"market_id" json:"market_id"`
+	Ticker      string  `bson:"ticker" json:"ticker"`
+	NotionalUSD float64 `bson:"notional_usd" json:"notional_usd"`
+	// Share is fraction of guild total notional
+	Share float64 `bson:"share" json:"share"`
+}
+
+// GuildRisk is computed from guild portfolio history at every capture cycle
+type GuildRisk struct {
+	ID      primitive.ObjectID `bson:"_id,omitempty" json:"-"`
+	GuildID primitive.ObjectID `bson:"guild_id" json:"guild_id"`
+
+	// WindowStart is time of first guild snapshot used for history based metrics
+	WindowStart   time.Time `bson:"window_start" json:"window_start"`
+	SnapshotCount int       `bson:"snapshot_count" json:"snapshot_count"`
+	// MaxDrawdown is the largest peak to trough decline of time-weighted value, as a positive fraction
+	MaxDrawdown float64 `bson:"max_drawdown" json:"max_drawdown"`
+	// Volatility is standard deviation of per-snapshot returns
+	Volatility float64 `bson:"volatility" json:"volatility"`
+	// SharpeRatio is mean over standard deviation of per-snapshot returns, risk free rate is zero
+	SharpeRatio float64 `bson:"sharpe_ratio" json:"sharpe_ratio"`
+
+	NotionalUSD float64 `bson:"notional_usd" json:"notional_usd"`
+	EquityUSD   float64 `bson:"equity_usd" json:"equity_usd"`
+	// Leverage is notional over equity, zero if guild has no equity
+	Leverage float64           `bson:"leverage" json:"leverage"`
+	Markets  []*MarketExposure `bson:"markets" json:"markets"`
+
+	UpdatedAt time.Time `bson:"updated_at" json:"updated_at"`
+}
@@ -0,0 +1,47 @@
+package model
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+// subaccount id is 0x + 20 bytes owner eth address + 12 bytes nonce
+const (
+	subaccountIDHexLen   = 2 + 64
+	subaccountOwnerEnd   = 2 + 40
+	defaultSubaccountHex = "000000000000000000000000"
+)
+
+// DefaultSubaccountID returns subaccount with nonce 0 of given address
+func DefaultSubaccountID(address Address) string {
+	ethAddr := common.BytesToAddress(address.Bytes())
+	return ethAddr.Hex() + defaultSubaccountHex
+}
+
+// ParseOwnedSubaccountID validates subaccountID format and that it belongs to address,
+// returns subaccountID in lowercase
+func ParseOwnedSubaccountID(address Address, subaccountID string) (string, error) {
+	id := strings.ToLower(subaccountID)
+	if len(id) != subaccountIDHexLen || !strings.HasPrefix(id, "0x") {
+		return "", errors.Errorf("invalid subaccount id: %s", subaccountID)
+	}
+
+	if _, err := hex.DecodeString(id[2:]); err != nil {
+		return "", errors.Wrapf(err, "invalid subaccount id: %s", subaccountID)
+	}
+
+	owner := common.HexToAddress(id[:subaccountOwnerEnd])
+	if owner != common.BytesToAddress(address.Bytes()) {
+		return "", errors.Errorf("subaccount %s is not owned by %s", subaccountID, address.String())
+	}
+
+	return id, nil
+}
+
+// IsDefaultSubaccountID returns true if subaccountID has nonce 0
+func IsDefaultSubaccountID(subaccountID string) bool {
+	return strings.HasSuffix(strings.ToLower(subaccountID), defaultSubaccountHex)
+}
@@ -0,0 +1,79 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// FeeRecipientUnknown is fee recipient of trades whose order was closed before it could be resolved
+const FeeRecipientUnknown = "unknown"
+
+// Trade is a member fill ingested from exchange api
+type Trade struct {
+	ID primitive.ObjectID `bson:"_id,omitempty" json:"-"`
+	// TradeID is derived from fill fields since exchange api doesn't provide one, used to skip duplicates
+	TradeID string `bson:"trade_id" json:"trade_id"`
+
+	GuildID          primitive.ObjectID `bson:"guild_id" json:"guild_id"`
+	InjectiveAddress Address            `bson:"injective_address" json:"injective_address"`
+	SubaccountID     string             `bson:"subaccount_id" json:"subaccount_id"`
+	MarketID         string             `bson:"market_id" json:"market_id"`
+	IsPerpetual      bool               `bson:"is_perpetual" json:"is_perpetual"`
+	OrderHash        string             `bson:"order_hash" json:"order_hash"`
+	Direction        string             `bson:"direction" json:"direction"`
+	ExecutionType    string             `bson:"execution_type" json:"execution_type"`
+	IsLiquidation    bool               `bson:"is_liquidation" json:"is_liquidation"`
+	// FeeRecipient of the filled order, lower case. Exchange api trades don't have it,
+	// it's taken from open orders or earlier trades of the order when ingested,
+	// FeeRecipientUnknown if neither has it
+	FeeRecipient string `bson:"fee_recipient" json:"fee_recipient"`
+
+	Price    primitive.Decimal128 `bson:"price" json:"price"`
+	Quantity primitive.Decimal128 `bson:"quantity" json:"quantity"`
+
+	// QuoteDenom is denom of fee, volume and realized pnl
+	QuoteDenom  string               `bson:"quote_denom" json:"quote_denom"`
+	Fee         primitive.Decimal128 `bson:"fee" json:"fee"`
+	Volume      primitive.Decimal128 `bson:"volume" json:"volume"`
+	RealizedPNL primitive.Decimal128 `bson:"realized_pnl" json:"realized_pnl"`
+
+	ExecutedAt time.Time `bson:"executed_at" json:"executed_at"`
+}
+
+// TradeCursor stores ingestion progress and cost basis of a subaccount in a market
+type TradeCursor struct {
+	SubaccountID   string    `bson:"subaccount_id" json:"subaccount_id"`
+	MarketID       string    `bson:"market_id" json:"market_id"`
+	LastExecutedAt time.Time `bson:"last_executed_at" json:"last_executed_at"`
+	// LastTradeIDs are ids of ingested trades executed at LastExecutedAt,
+	// trades of the same millisecond can be fetched again and are skipped by id
+	LastTradeIDs []string `bson:"last_trade_ids" json:"last_trade_ids"`
+	// ResumeSkip is set when a run reached max pages before LastExecutedAt,
+	// next run resumes paging from there to ingest the oldest trades first
+	ResumeSkip uint64 `bson:"resume_skip" json:"resume_skip"`
+
+	// PositionQuantity is signed, negative for short
+	PositionQuantity primitive.Decimal128 `bson:"position_quantity" json:"position_quantity"`
+	AvgEntryPrice    primitive.Decimal128 `bson:"avg_entry_price" json:"avg_entry_price"`
+}
+
+// TradeStats aggregates trades per quote denom
+type TradeStats struct {
+	QuoteDenom  string               `bson:"_id" json:"quote_denom"`
+	RealizedPNL primitive.Decimal128 `bson:"realized_pnl" json:"realized_pnl"`
+	Fees        primitive.Decimal128 `bson:"fees" json:"fees"`
+	Volume      primitive.Decimal128 `bson:"volume" json:"volume"`
+	TradeCount  int                  `bson:"trade_count" json:"trade_count"`
+}
+
+// MemberTradeStats aggregates trades per member and quote denom
+type MemberTradeStats struct {
+	InjectiveAddress string               `bson:"injective_address" json:"injective_address"`
+	QuoteDenom       string               `bson:"quote_denom" json:"quote_denom"`
+	Fees             primitive.Decimal128 `bson:"fees" json:"fees"`
+	// ChargedFees sums positive fees only, negative maker fees are rebates paid by exchange
+	ChargedFees primitive.Decimal128 `bson:"charged_fees" json:"charged_fees"`
+	Volume      primitive.Decimal128 `bson:"volume" json:"volume"`
+	TradeCount  int                  `bson:"trade_count" json:"trade_count"`
+}
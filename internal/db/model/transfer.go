@@ -0,0 +1,35 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Transfer is a deposit into or withdrawal from a member subaccount,
+// or a transfer between a member subaccount and a subaccount not tracked in the guild
+type Transfer struct {
+	ID primitive.ObjectID `bson:"_id,omitempty" json:"-"`
+	// TransferID is derived from transfer fields since exchange api doesn't provide one, used to skip duplicates
+	TransferID string `bson:"transfer_id" json:"transfer_id"`
+
+	GuildID          primitive.ObjectID `bson:"guild_id" json:"guild_id"`
+	InjectiveAddress Address            `bson:"injective_address" json:"injective_address"`
+	SubaccountID     string             `bson:"subaccount_id" json:"subaccount_id"`
+	TransferType     string             `bson:"transfer_type" json:"transfer_type"`
+	SrcSubaccountID  string             `bson:"src_subaccount_id,omitempty" json:"src_subaccount_id,omitempty"`
+	DstSubaccountID  string             `bson:"dst_subaccount_id,omitempty" json:"dst_subaccount_id,omitempty"`
+	Denom            string             `bson:"denom" json:"denom"`
+	// Amount is positive for deposit and transfer in, negative for withdrawal and transfer out
+	Amount     primitive.Decimal128 `bson:"amount" json:"amount"`
+	ExecutedAt time.Time            `bson:"executed_at" json:"executed_at"`
+}
+
+// NetFlow aggregates transfers per denom
+type NetFlow struct {
+	Denom         string               `bson:"_id" json:"denom"`
+	Deposits      primitive.Decimal128 `bson:"deposits" json:"deposits"`
+	Withdrawals   primitive.Decimal128 `bson:"withdrawals" json:"withdrawals"`
+	NetFlow       primitive.Decimal128 `bson:"net_flow" json:"net_flow"`
+	TransferCount int                  `bson:"transfer_count" json:"transfer_count"`
+}
@@ -0,0 +1,22 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// WaitlistEntry is an address qualified to enter a guild which was at capacity,
+// entries of a guild are admitted in order of EnqueuedAt when slots open
+type WaitlistEntry struct {
+	ID      primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	GuildID primitive.ObjectID `bson:"guild_id" json:"guild_id"`
+
+	InjectiveAddress Address  `bson:"injective_address" json:"injective_address"`
+	Params           string   `bson:"params" json:"params"`
+	SubaccountIDs    []string `bson:"subaccount_ids,omitempty" json:"subaccount_ids,omitempty"`
+	// InviteCode has one use reserved for the address, it's taken when address is admitted
+	InviteCode string `bson:"invite_code,omitempty" json:"invite_code,omitempty"`
+
+	EnqueuedAt time.Time `bson:"enqueued_at" json:"enqueued_at"`
+}
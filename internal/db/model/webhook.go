@@ -0,0 +1,35 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Webhook is a guild's subscription to lifecycle events
+type Webhook struct {
+	ID      primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	GuildID primitive.ObjectID `bson:"guild_id" json:"guild_id"`
+
+	URL string `bson:"url" json:"url"`
+	// Secret is used to sign payload (HMAC-SHA256)
+	Secret string `bson:"secret" json:"-"`
+	// Events to deliver, empty means all events
+	Events    []string  `bson:"events" json:"events"`
+	CreatedAt time.Time `bson:"created_at" json:"created_at"`
+}
+
+// WebhookDelivery logs a delivery of an event to a webhook
+type WebhookDelivery struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	WebhookID primitive.ObjectID `bson:"webhook_id" json:"webhook_id"`
+	GuildID   primitive.ObjectID `bson:"guild_id" json:"guild_id"`
+
+	Event      string    `bson:"event" json:"event"`
+	Payload    string    `bson:"payload" json:"payload"`
+	Attempts   int       `bson:"attempts" json:"attempts"`
+	StatusCode int       `bson:"status_code" json:"status_code"`
+	Success    bool      `bson:"success" json:"success"`
+	Error      string    `bson:"error,omitempty" json:"error,omitempty"`
+	CreatedAt  time.Time `bson:"created_at" json:"created_at"`
+}
@@ -0,0 +1,274 @@
+package mongoimpl
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
+	"github.com/InjectiveLabs/metrics"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func (s *MongoImpl) SetGuildAccessMode(ctx context.Context, guildID string, mode string) error {
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(s.svcTags)
+
+	guildObjectID, err := primitive.ObjectIDFromHex(guildID)
+	if err != nil {
+		return fmt.Errorf("cannot parse guildID: %w", err)
+	}
+
+	filter := bson.M{
+		"_id": guildObjectID,
+	}
+
+	upd := bson.M{
+		"$set": bson.M{
+			"access_mode": mode,
+		},
+	}
+
+	updateRes, err := s.guildCollection.UpdateOne(ctx, filter, upd)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return err
+	}
+
+	if updateRes.MatchedCount == 0 {
+		return db.ErrNotFound
+	}
+
+	return nil
+}
+
+func (s *MongoImpl) AddToAllowlist(ctx context.Context, guildID string, addresses []model.Address) (int, error) {
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(s.svcTags)
+
+	guildObjectID, err := primitive.ObjectIDFromHex(guildID)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return 0, fmt.Errorf("cannot parse guildID: %w", err)
+	}
+
+	now := time.Now()
+	added := 0
+	for _, address := range addresses {
+		filter := bson.M{
+			"guild_id":          guildObjectID,
+			"injective_address": address.String(),
+		}
+
+		// addresses already allowlisted keep their added_at
+		upd := bson.M{
+			"$setOnInsert": &model.AllowlistEntry{
+				GuildID:          guildObjectID,
+				InjectiveAddress: address,
+				AddedAt:          now,
+			},
+		}
+		updOpt := &options.UpdateOptions{}
+		updOpt.SetUpsert(true)
+
+		res, err := s.allowlistCollection.UpdateOne(ctx, filter, upd, updOpt)
+		if err != nil {
+			metrics.ReportFuncError(s.svcTags)
+			return added, err
+		}
+		added += int(res.UpsertedCount)
+	}
+
+	return added, nil
+}
+
+func (s *MongoImpl) RemoveFromAllowlist(ctx context.Context, guildID string, addresses []model.Address) (int, error) {
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(s.svcTags)
+
+	guildObjectID, err := primitive.ObjectIDFromHex(guildID)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return 0, fmt.Errorf("cannot parse guildID: %w", err)
+	}
+
+	strAddresses := make([]string, 0, len(addresses))
+	for _, address := range addresses {
+		strAddresses = append(strAddresses, address.String())
+	}
+
+	filter := bson.M{
+		"guild_id":          guildObjectID,
+		"injective_address": bson.M{"$in": strAddresses},
+	}
+
+	res, err := s.allowlistCollection.DeleteMany(ctx, filter)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return 0, err
+	}
+
+	return int(res.DeletedCount), nil
+}
+
+func (s *MongoImpl) IsAllowlisted(ctx context.Context, guildID string, address model.Address) (bool, error) {
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(s.svcTags)
+
+	guildObjectID, err := primitive.ObjectIDFromHex(guildID)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return false, fmt.Errorf("cannot parse guildID: %w", err)
+	}
+
+	filter := bson.M{
+		"guild_id":          guildObjectID,
+		"injective_address": address.String(),
+	}
+
+	count, err := s.allowlistCollection.CountDocuments(ctx, filter)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (s *MongoImpl) AddInviteCodes(ctx context.Context, codes []*model.InviteCode) error {
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(s.svcTags)
+
+	docs := make([]interface{}, 0, len(codes))
+	for _, c := range codes {
+		docs = append(docs, c)
+	}
+
+	_, err := s.inviteCodeCollection.InsertMany(ctx, docs)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return err
+	}
+
+	return nil
+}
+
+func (s *MongoImpl) ListInviteCodes(ctx context.Context, guildID string) (result []*model.InviteCode, err error) {
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(s.svcTags)
+
+	guildObjectID, err := primitive.ObjectIDFromHex(guildID)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return nil, fmt.Errorf("cannot parse guildID: %w", err)
+	}
+
+	filter := bson.M{
+		"guild_id": guildObjectID,
+	}
+
+	opts := &options.FindOptions{}
+	opts.SetSort(bson.M{"created_at": -1})
+
+	cur, err := s.inviteCodeCollection.Find(ctx, filter, opts)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	for cur.Next(ctx) {
+		var code model.InviteCode
+		if err := cur.Decode(&code); err != nil {
+			metrics.ReportFuncError(s.svcTags)
+			return nil, err
+		}
+
+		result = append(result, &code)
+	}
+
+	return result, nil
+}
+
+func (s *MongoImpl) GetInviteCode(ctx context.Context, guildID string, code string) (*model.InviteCode, error) {
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(s.svcTags)
+
+	guildObjectID, err := primitive.ObjectIDFromHex(guildID)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return nil, fmt.Errorf("cannot parse guildID: %w", err)
+	}
+
+	filter := bson.M{
+		"guild_id": guildObjectID,
+		"code":     code,
+	}
+
+	var inviteCode model.InviteCode
+	if err := s.inviteCodeCollection.FindOne(ctx, filter).Decode(&inviteCode); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, db.ErrNotFound
+		}
+		metrics.ReportFuncError(s.svcTags)
+		return nil, err
+	}
+
+	return &inviteCode, nil
+}
+
+// useInviteCode takes one use of code atomically, so concurrent joins can't exceed max uses.
+// It's expected to be called in the transaction adding member or waitlist entry
+func (s *MongoImpl) useInviteCode(ctx context.Context, guildID primitive.ObjectID, code string) error {
+	filter := bson.M{
+		"guild_id": guildID,
+		"code":     code,
+		"$expr":    bson.M{"$lt": bson.A{"$uses", "$max_uses"}},
+		"$or": bson.A{
+			bson.M{"expires_at": bson.M{"$exists": false}},
+			bson.M{"expires_at": bson.M{"$gt": time.Now()}},
+		},
+	}
+
+	upd := bson.M{
+		"$inc": bson.M{"uses": 1},
+	}
+
+	res, err := s.inviteCodeCollection.UpdateOne(ctx, filter, upd)
+	if err != nil {
+		return err
+	}
+
+	if res.MatchedCount == 0 {
+		return db.ErrInvalidInviteCode
+	}
+
+	return nil
+}
+
+// releaseInviteCode gives back a use reserved for a waitlist entry which is removed
+func (s *MongoImpl) releaseInviteCode(ctx context.Context, guildID primitive.ObjectID, code string) error {
+	filter := bson.M{
+		"guild_id": guildID,
+		"code":     code,
+		"uses":     bson.M{"$gt": 0},
+	}
+
+	upd := bson.M{
+		"$inc": bson.M{"uses": -1},
+	}
+
+	_, err := s.inviteCodeCollection.UpdateOne(ctx, filter, upd)
+	return err
+}
@@ -0,0 +1,139 @@
+package mongoimpl
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
+	"github.com/InjectiveLabs/metrics"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func (s *MongoImpl) AddAlertRule(ctx context.Context, rule *model.AlertRule) (*primitive.ObjectID, error) {
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(s.svcTags)
+
+	insertOneRes, err := s.alertRuleCollection.InsertOne(ctx, rule)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return nil, err
+	}
+
+	objID := insertOneRes.InsertedID.(primitive.ObjectID)
+	return &objID, nil
+}
+
+func (s *MongoImpl) ListAlertRules(ctx context.Context, ruleFilter model.AlertRuleFilter) (result []*model.AlertRule, err error) {
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(s.svcTags)
+
+	filter := bson.M{}
+	if ruleFilter.GuildID != nil {
+		guildObjectID, err := primitive.ObjectIDFromHex(*ruleFilter.GuildID)
+		if err != nil {
+			metrics.ReportFuncError(s.svcTags)
+			return nil, fmt.Errorf("cannot parse guildID: %w", err)
+		}
+		filter["guild_id"] = guildObjectID
+	}
+
+	cur, err := s.alertRuleCollection.Find(ctx, filter)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	for cur.Next(ctx) {
+		var rule model.AlertRule
+		if err := cur.Decode(&rule); err != nil {
+			metrics.ReportFuncError(s.svcTags)
+			return nil, err
+		}
+
+		result = append(result, &rule)
+	}
+
+	return result, nil
+}
+
+func (s *MongoImpl) DeleteAlertRule(ctx context.Context, ruleID string) error {
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(s.svcTags)
+
+	ruleObjectID, err := primitive.ObjectIDFromHex(ruleID)
+	if err != nil {
+		return fmt.Errorf("cannot parse ruleID: %w", err)
+	}
+
+	err = s.withTransaction(ctx, func(sessCtx mongo.SessionContext) (interface{}, error) {
+		deleteRes, err := s.alertRuleCollection.DeleteOne(sessCtx, bson.M{"_id": ruleObjectID})
+		if err != nil {
+			return nil, err
+		}
+
+		if deleteRes.DeletedCount == 0 {
+			return nil, fmt.Errorf("not found alert rule to delete")
+		}
+
+		_, err = s.alertStateCollection.DeleteMany(sessCtx, bson.M{"rule_id": ruleObjectID})
+		return nil, err
+	})
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return err
+	}
+
+	return nil
+}
+
+func (s *MongoImpl) ListAlertStates(ctx context.Context) (result []*model.AlertState, err error) {
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(s.svcTags)
+
+	cur, err := s.alertStateCollection.Find(ctx, bson.M{})
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	for cur.Next(ctx) {
+		var state model.AlertState
+		if err := cur.Decode(&state); err != nil {
+			metrics.ReportFuncError(s.svcTags)
+			return nil, err
+		}
+
+		result = append(result, &state)
+	}
+
+	return result, nil
+}
+
+func (s *MongoImpl) UpsertAlertState(ctx context.Context, state *model.AlertState) error {
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(s.svcTags)
+
+	filter := bson.M{
+		"rule_id":  state.RuleID,
+		"guild_id": state.GuildID,
+	}
+	opts := &options.ReplaceOptions{}
+	opts.SetUpsert(true)
+
+	if _, err := s.alertStateCollection.ReplaceOne(ctx, filter, state, opts); err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return err
+	}
+
+	return nil
+}
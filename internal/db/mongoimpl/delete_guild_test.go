@@ -0,0 +1,53 @@
+package mongoimpl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
+	cosmtypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestDeleteGuildPurgesGuildData(t *testing.T) {
+	s := newTestDB(t)
+	ctx := context.Background()
+
+	guildID, err := s.AddGuild(ctx, &model.Guild{Name: "purged", Capacity: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	address := model.Address{AccAddress: cosmtypes.AccAddress("delete_guild________")}
+	err = s.AddMember(ctx, guildID.Hex(), address, &model.AccountPortfolio{
+		InjectiveAddress: address,
+		UpdatedAt:        time.Now(),
+	}, false, "", nil, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = s.AddWebhookDelivery(ctx, &model.WebhookDelivery{GuildID: *guildID, Event: "member_joined", CreatedAt: time.Now()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.NoError(t, s.DeleteGuild(ctx, guildID.Hex()))
+
+	count, err := s.guildCollection.CountDocuments(ctx, bson.M{"_id": guildID})
+	assert.NoError(t, err)
+	assert.Zero(t, count)
+
+	for _, coll := range []string{
+		MemberCollectionName,
+		AccountPortfolioCollectionName,
+		GuildPortfolioCollectionName,
+		WebhookDeliveryCollectionName,
+	} {
+		count, err := s.guildCollection.Database().Collection(coll).CountDocuments(ctx, bson.M{"guild_id": guildID})
+		assert.NoError(t, err)
+		assert.Zero(t, count, coll)
+	}
+}
@@ -0,0 +1,96 @@
+package mongoimpl
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
+	"github.com/InjectiveLabs/metrics"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func (s *MongoImpl) UpsertDailyFees(ctx context.Context, fees []*model.DailyFee) error {
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(s.svcTags)
+
+	if len(fees) == 0 {
+		return nil
+	}
+
+	writes := make([]mongo.WriteModel, 0, len(fees))
+	for _, fee := range fees {
+		writes = append(writes, mongo.NewReplaceOneModel().
+			SetFilter(bson.M{
+				"guild_id":          fee.GuildID,
+				"day":               fee.Day,
+				"injective_address": fee.InjectiveAddress,
+				"quote_denom":       fee.QuoteDenom,
+			}).
+			SetReplacement(fee).
+			SetUpsert(true),
+		)
+	}
+
+	if _, err := s.dailyFeeCollection.BulkWrite(ctx, writes); err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return err
+	}
+
+	return nil
+}
+
+func (s *MongoImpl) ListDailyFees(ctx context.Context, filter model.DailyFeeFilter) (result []*model.DailyFee, err error) {
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(s.svcTags)
+
+	guildObjectID, err := primitive.ObjectIDFromHex(filter.GuildID)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return nil, fmt.Errorf("cannot parse guildID: %w", err)
+	}
+
+	feeFilter := bson.M{
+		"guild_id": guildObjectID,
+	}
+
+	var dayFilter = make(bson.M)
+	if filter.StartTime != nil {
+		dayFilter["$gte"] = *filter.StartTime
+	}
+
+	if filter.EndTime != nil {
+		dayFilter["$lt"] = *filter.EndTime
+	}
+
+	if len(dayFilter) > 0 {
+		feeFilter["day"] = dayFilter
+	}
+
+	opt := &options.FindOptions{}
+	opt.SetSort(bson.D{{Key: "day", Value: 1}, {Key: "injective_address", Value: 1}})
+
+	cur, err := s.dailyFeeCollection.Find(ctx, feeFilter, opt)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	for cur.Next(ctx) {
+		var fee model.DailyFee
+		err := cur.Decode(&fee)
+		if err != nil {
+			metrics.ReportFuncError(s.svcTags)
+			return nil, err
+		}
+
+		result = append(result, &fee)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,69 @@
+package mongoimpl
+
+import (
+	"context"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
+	"github.com/InjectiveLabs/metrics"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func (s *MongoImpl) ListGrantNotifications(ctx context.Context, address model.Address) (result []*model.GrantNotification, err error) {
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(s.svcTags)
+
+	filter := bson.M{
+		"injective_address": address.String(),
+	}
+
+	cur, err := s.grantNotificationCollection.Find(ctx, filter)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	for cur.Next(ctx) {
+		var notification model.GrantNotification
+		if err := cur.Decode(&notification); err != nil {
+			metrics.ReportFuncError(s.svcTags)
+			return nil, err
+		}
+
+		result = append(result, &notification)
+	}
+	return result, nil
+}
+
+func (s *MongoImpl) AddGrantNotifications(ctx context.Context, notifications []*model.GrantNotification) error {
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(s.svcTags)
+
+	if len(notifications) == 0 {
+		return nil
+	}
+
+	// insert if not exist, notifying twice the same expiration keeps the first record
+	writes := make([]mongo.WriteModel, 0, len(notifications))
+	for _, n := range notifications {
+		writes = append(writes, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{
+				"injective_address": n.InjectiveAddress.String(),
+				"msg":               n.Msg,
+				"expiration":        n.Expiration,
+			}).
+			SetUpdate(bson.M{"$setOnInsert": n}).
+			SetUpsert(true),
+		)
+	}
+
+	if _, err := s.grantNotificationCollection.BulkWrite(ctx, writes); err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,217 @@
+package mongoimpl
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
+	"github.com/InjectiveLabs/metrics"
+	"github.com/ethereum/go-ethereum/common"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func hasInactiveMarkets(markets []*model.GuildMarket) bool {
+	for _, m := range markets {
+		if !m.IsActive() {
+			return true
+		}
+	}
+	return false
+}
+
+// updateGuildMarkets reads guild, lets editFn change its markets and requirements and writes them back in a transaction,
+// snapshots of members and guild are untouched
+func (s *MongoImpl) updateGuildMarkets(ctx context.Context, guildID string, editFn func(guild *model.Guild) error) error {
+	guildObjectID, err := primitive.ObjectIDFromHex(guildID)
+	if err != nil {
+		return fmt.Errorf("cannot parse guildID: %w", err)
+	}
+
+	err = s.withTransaction(ctx, func(sessCtx mongo.SessionContext) (interface{}, error) {
+		filter := bson.M{
+			"_id": guildObjectID,
+		}
+
+		var guild model.Guild
+		if err := s.guildCollection.FindOne(sessCtx, filter).Decode(&guild); err != nil {
+			if err == mongo.ErrNoDocuments {
+				return nil, db.ErrNotFound
+			}
+			return nil, err
+		}
+
+		if err := editFn(&guild); err != nil {
+			return nil, err
+		}
+
+		upd := bson.M{
+			"$set": bson.M{
+				"markets":              guild.Markets,
+				"denom_requirements":   guild.Requirements,
+				"has_inactive_markets": hasInactiveMarkets(guild.Markets),
+			},
+		}
+		return s.guildCollection.UpdateOne(sessCtx, filter, upd)
+	})
+
+	return err
+}
+
+func (s *MongoImpl) UpdateGuildMarkets(ctx context.Context, guildID string, markets []*model.GuildMarket) error {
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(s.svcTags)
+
+	idToMarket := make(map[string]*model.GuildMarket)
+	for _, m := range markets {
+		idToMarket[m.MarketID.Hex()] = m
+	}
+
+	err := s.updateGuildMarkets(ctx, guildID, func(guild *model.Guild) error {
+		for i, m := range guild.Markets {
+			if updated, exist := idToMarket[m.MarketID.Hex()]; exist {
+				guild.Markets[i] = updated
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return err
+	}
+
+	return nil
+}
+
+func (s *MongoImpl) AddGuildMarkets(
+	ctx context.Context,
+	guildID string,
+	markets []*model.GuildMarket,
+	requirements []*model.DenomRequirement,
+) error {
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(s.svcTags)
+
+	err := s.updateGuildMarkets(ctx, guildID, func(guild *model.Guild) error {
+		existing := make(map[string]bool)
+		for _, m := range guild.Markets {
+			existing[m.MarketID.Hex()] = true
+		}
+
+		for _, m := range markets {
+			if existing[m.MarketID.Hex()] {
+				return db.ErrMarketExists
+			}
+			existing[m.MarketID.Hex()] = true
+		}
+		guild.Markets = append(guild.Markets, markets...)
+
+		// like add-guild, min amounts of markets sharing a denom are summed up
+		denomToMinAmount := make(map[string]float64)
+		for _, req := range guild.Requirements {
+			denomToMinAmount[req.Denom] = req.MinAmountUSD
+		}
+
+		for _, req := range requirements {
+			denomToMinAmount[req.Denom] += req.MinAmountUSD
+			guild.Requirements = setRequirement(guild.Requirements, &model.DenomRequirement{
+				Denom:        req.Denom,
+				MinAmountUSD: denomToMinAmount[req.Denom],
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return err
+	}
+
+	return nil
+}
+
+func (s *MongoImpl) RemoveGuildMarket(ctx context.Context, guildID string, marketID string) error {
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(s.svcTags)
+
+	marketHex := common.HexToHash(marketID).Hex()
+	err := s.updateGuildMarkets(ctx, guildID, func(guild *model.Guild) error {
+		markets := make([]*model.GuildMarket, 0, len(guild.Markets))
+		for _, m := range guild.Markets {
+			if m.MarketID.Hex() != marketHex {
+				markets = append(markets, m)
+			}
+		}
+
+		if len(markets) == len(guild.Markets) {
+			return db.ErrNotFound
+		}
+
+		if len(markets) == 0 {
+			return db.ErrLastMarket
+		}
+
+		guild.Markets = markets
+		// requirements of denoms which are not traded in remaining markets are dropped
+		denoms := make(map[string]bool)
+		for _, denom := range model.GetGuildDenoms(guild) {
+			denoms[denom] = true
+		}
+
+		requirements := make([]*model.DenomRequirement, 0, len(guild.Requirements))
+		for _, req := range guild.Requirements {
+			if denoms[req.Denom] {
+				requirements = append(requirements, req)
+			}
+		}
+		guild.Requirements = requirements
+		return nil
+	})
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return err
+	}
+
+	return nil
+}
+
+func (s *MongoImpl) SetGuildRequirement(ctx context.Context, guildID string, requirement *model.DenomRequirement) error {
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(s.svcTags)
+
+	err := s.updateGuildMarkets(ctx, guildID, func(guild *model.Guild) error {
+		for _, denom := range model.GetGuildDenoms(guild) {
+			if denom == requirement.Denom {
+				guild.Requirements = setRequirement(guild.Requirements, requirement)
+				return nil
+			}
+		}
+		return db.ErrDenomNotInGuild
+	})
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return err
+	}
+
+	return nil
+}
+
+// setRequirement replaces requirement of the same denom, requirement with zero amount is removed
+func setRequirement(requirements []*model.DenomRequirement, req *model.DenomRequirement) []*model.DenomRequirement {
+	result := make([]*model.DenomRequirement, 0, len(requirements)+1)
+	for _, r := range requirements {
+		if r.Denom != req.Denom {
+			result = append(result, r)
+		}
+	}
+
+	if req.MinAmountUSD > 0 {
+		result = append(result, req)
+	}
+	return result
+}
@@ -0,0 +1,66 @@
+package mongoimpl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
+	cosmtypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestAddMemberUpdatesOwnGuildPortfolio(t *testing.T) {
+	s := newTestDB(t)
+	ctx := context.Background()
+
+	guildID, err := s.AddGuild(ctx, &model.Guild{Name: "guild", Capacity: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherGuildID, err := s.AddGuild(ctx, &model.Guild{Name: "other", Capacity: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// portfolios of both guilds are snapshotted at the same time
+	zero := primitive.NewDecimal128(0, 0)
+	updatedAt := time.Now().UTC().Truncate(time.Millisecond)
+	newGuildPortfolio := func(id primitive.ObjectID) *model.GuildPortfolio {
+		return &model.GuildPortfolio{
+			GuildID:      id,
+			BankBalances: []*model.BankBalance{{Denom: "inj", Balance: zero}},
+			UpdatedAt:    updatedAt,
+		}
+	}
+	err = s.AddGuildPortfolios(ctx, []*model.GuildPortfolio{newGuildPortfolio(*guildID), newGuildPortfolio(*otherGuildID)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	balance, _ := primitive.ParseDecimal128("5")
+	address := model.Address{AccAddress: cosmtypes.AccAddress("guild_portfolio_____")}
+	err = s.AddMember(ctx, guildID.Hex(), address, &model.AccountPortfolio{
+		InjectiveAddress: address,
+		BankBalances:     []*model.BankBalance{{Denom: "inj", Balance: balance}},
+		UpdatedAt:        time.Now(),
+	}, false, "", nil, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	limit := int64(1)
+	portfolios, err := s.ListGuildPortfolios(ctx, model.GuildPortfoliosFilter{GuildID: guildID.Hex(), Limit: &limit})
+	assert.NoError(t, err)
+	if assert.Len(t, portfolios, 1) {
+		assert.Equal(t, "5", portfolios[0].BankBalances[0].Balance.String())
+	}
+
+	portfolios, err = s.ListGuildPortfolios(ctx, model.GuildPortfoliosFilter{GuildID: otherGuildID.Hex(), Limit: &limit})
+	assert.NoError(t, err)
+	if assert.Len(t, portfolios, 1) {
+		assert.Equal(t, zero.String(), portfolios[0].BankBalances[0].Balance.String())
+		assert.Empty(t, portfolios[0].CashFlows)
+	}
+}
@@ -0,0 +1,99 @@
+package mongoimpl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
+	cosmtypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInviteCodeReservedByWaitlist(t *testing.T) {
+	s := newTestDB(t)
+	ctx := context.Background()
+
+	guildID, err := s.AddGuild(ctx, &model.Guild{Name: "invite only", Capacity: 10, AccessMode: model.AccessModeInviteCode})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const code = "a1b2c3"
+	err = s.AddInviteCodes(ctx, []*model.InviteCode{{GuildID: *guildID, Code: code, MaxUses: 1, CreatedAt: time.Now()}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	waitlisted := model.Address{AccAddress: cosmtypes.AccAddress("invite_waitlisted___")}
+	other := model.Address{AccAddress: cosmtypes.AccAddress("invite_other________")}
+	newPortfolio := func(address model.Address) *model.AccountPortfolio {
+		return &model.AccountPortfolio{InjectiveAddress: address, UpdatedAt: time.Now()}
+	}
+	getUses := func() int {
+		inviteCode, err := s.GetInviteCode(ctx, guildID.Hex(), code)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return inviteCode.Uses
+	}
+
+	// the only use is reserved when address is waitlisted
+	err = s.AddToWaitlist(ctx, &model.WaitlistEntry{
+		GuildID:          *guildID,
+		InjectiveAddress: waitlisted,
+		InviteCode:       code,
+		EnqueuedAt:       time.Now(),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, getUses())
+
+	// nobody else can take it, failed use rolls back the whole join
+	err = s.AddMember(ctx, guildID.Hex(), other, newPortfolio(other), false, code, nil, code)
+	assert.ErrorIs(t, err, db.ErrInvalidInviteCode)
+	members, err := s.ListGuildMembers(ctx, model.MemberFilter{InjectiveAddress: &other})
+	assert.NoError(t, err)
+	assert.Empty(t, members)
+
+	// waitlisted address is admitted with its reserved use
+	err = s.AddMember(ctx, guildID.Hex(), waitlisted, newPortfolio(waitlisted), false, code, nil, code)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, getUses())
+
+	entries, err := s.ListWaitlist(ctx, guildID.Hex())
+	assert.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestInviteCodeReleasedWithWaitlistEntry(t *testing.T) {
+	s := newTestDB(t)
+	ctx := context.Background()
+
+	guildID, err := s.AddGuild(ctx, &model.Guild{Name: "invite only", Capacity: 10, AccessMode: model.AccessModeInviteCode})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const code = "a1b2c3"
+	err = s.AddInviteCodes(ctx, []*model.InviteCode{{GuildID: *guildID, Code: code, MaxUses: 1, CreatedAt: time.Now()}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	address := model.Address{AccAddress: cosmtypes.AccAddress("invite_waitlisted___")}
+	err = s.AddToWaitlist(ctx, &model.WaitlistEntry{
+		GuildID:          *guildID,
+		InjectiveAddress: address,
+		InviteCode:       code,
+		EnqueuedAt:       time.Now(),
+	})
+	assert.NoError(t, err)
+
+	assert.NoError(t, s.RemoveFromWaitlist(ctx, guildID.Hex(), address))
+	inviteCode, err := s.GetInviteCode(ctx, guildID.Hex(), code)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, inviteCode.Uses)
+
+	assert.ErrorIs(t, s.RemoveFromWaitlist(ctx, guildID.Hex(), address), db.ErrNotFound)
+}
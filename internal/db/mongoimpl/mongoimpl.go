@@ -2,7 +2,6 @@ package mongoimpl
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"time"
 
@@ -15,36 +14,68 @@ import (
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.mongodb.org/mongo-driver/mongo/readpref"
+	"go.opentelemetry.io/contrib/instrumentation/go.mongodb.org/mongo-driver/mongo/otelmongo"
 )
 
 const (
-	connectionTimeout              = 20 * time.Second
-	GuildCollectionName            = "guilds"
-	MemberCollectionName           = "members"
-	AccountPortfolioCollectionName = "account_portfolios"
-	GuildPortfolioCollectionName   = "guild_portfolios"
-	DenomCollectionName            = "denoms"
+	connectionTimeout               = 20 * time.Second
+	GuildCollectionName             = "guilds"
+	MemberCollectionName            = "members"
+	AccountPortfolioCollectionName  = "account_portfolios"
+	GuildPortfolioCollectionName    = "guild_portfolios"
+	DenomCollectionName             = "denoms"
+	WebhookCollectionName           = "webhooks"
+	WebhookDeliveryCollectionName   = "webhook_deliveries"
+	TradeCollectionName             = "trades"
+	TradeCursorCollectionName       = "trade_cursors"
+	DailyFeeCollectionName          = "daily_fees"
+	TransferCollectionName          = "transfers"
+	GuildRiskCollectionName         = "guild_risks"
+	AlertRuleCollectionName         = "alert_rules"
+	AlertStateCollectionName        = "alert_states"
+	MembershipCollectionName        = "memberships"
+	WaitlistCollectionName          = "waitlist"
+	AllowlistCollectionName         = "allowlist"
+	InviteCodeCollectionName        = "invite_codes"
+	GrantNotificationCollectionName = "grant_notifications"
 )
 
+// guildRiskRetention is how long computed guild risks are kept
+const guildRiskRetention = 30 * 24 * time.Hour
+
 type MongoImpl struct {
 	db.DBService
 
-	client  *mongo.Client
-	session mongo.Session
-
-	guildCollection            *mongo.Collection
-	memberCollection           *mongo.Collection
-	accountPortfolioCollection *mongo.Collection
-	guildPortfolioCollection   *mongo.Collection
-	denomCollection            *mongo.Collection
-	svcTags                    metrics.Tags
+	client *mongo.Client
+
+	guildCollection             *mongo.Collection
+	memberCollection            *mongo.Collection
+	accountPortfolioCollection  *mongo.Collection
+	guildPortfolioCollection    *mongo.Collection
+	denomCollection             *mongo.Collection
+	webhookCollection           *mongo.Collection
+	webhookDeliveryCollection   *mongo.Collection
+	tradeCollection             *mongo.Collection
+	tradeCursorCollection       *mongo.Collection
+	dailyFeeCollection          *mongo.Collection
+	transferCollection          *mongo.Collection
+	guildRiskCollection         *mongo.Collection
+	alertRuleCollection         *mongo.Collection
+	alertStateCollection        *mongo.Collection
+	membershipCollection        *mongo.Collection
+	waitlistCollection          *mongo.Collection
+	allowlistCollection         *mongo.Collection
+	inviteCodeCollection        *mongo.Collection
+	grantNotificationCollection *mongo.Collection
+	svcTags                     metrics.Tags
 }
 
 func NewService(ctx context.Context, connectionURL, databaseName string) (db.DBService, error) {
 	ctx, cancel := context.WithTimeout(ctx, connectionTimeout)
 	defer cancel()
 
-	client, err := mongo.Connect(ctx, options.Client().ApplyURI(connectionURL))
+	// monitor creates a span per mongo command, no-op unless tracing is set up
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(connectionURL).SetMonitor(otelmongo.NewMonitor()))
 	if err != nil {
 		return nil, fmt.Errorf("connect mongo err: %w", err)
 	}
@@ -54,25 +85,41 @@ func NewService(ctx context.Context, connectionURL, databaseName string) (db.DBS
 		return nil, err
 	}
 
-	session, err := client.StartSession()
-	if err != nil {
-		return nil, fmt.Errorf("new session err: %w", err)
-	}
-
 	return &MongoImpl{
-		client:                     client,
-		session:                    session,
-		guildCollection:            client.Database(databaseName).Collection(GuildCollectionName),
-		memberCollection:           client.Database(databaseName).Collection(MemberCollectionName),
-		accountPortfolioCollection: client.Database(databaseName).Collection(AccountPortfolioCollectionName),
-		guildPortfolioCollection:   client.Database(databaseName).Collection(GuildPortfolioCollectionName),
-		denomCollection:            client.Database(databaseName).Collection(DenomCollectionName),
+		client:                      client,
+		guildCollection:             client.Database(databaseName).Collection(GuildCollectionName),
+		memberCollection:            client.Database(databaseName).Collection(MemberCollectionName),
+		accountPortfolioCollection:  client.Database(databaseName).Collection(AccountPortfolioCollectionName),
+		guildPortfolioCollection:    client.Database(databaseName).Collection(GuildPortfolioCollectionName),
+		denomCollection:             client.Database(databaseName).Collection(DenomCollectionName),
+		webhookCollection:           client.Database(databaseName).Collection(WebhookCollectionName),
+		webhookDeliveryCollection:   client.Database(databaseName).Collection(WebhookDeliveryCollectionName),
+		tradeCollection:             client.Database(databaseName).Collection(TradeCollectionName),
+		tradeCursorCollection:       client.Database(databaseName).Collection(TradeCursorCollectionName),
+		dailyFeeCollection:          client.Database(databaseName).Collection(DailyFeeCollectionName),
+		transferCollection:          client.Database(databaseName).Collection(TransferCollectionName),
+		guildRiskCollection:         client.Database(databaseName).Collection(GuildRiskCollectionName),
+		alertRuleCollection:         client.Database(databaseName).Collection(AlertRuleCollectionName),
+		alertStateCollection:        client.Database(databaseName).Collection(AlertStateCollectionName),
+		membershipCollection:        client.Database(databaseName).Collection(MembershipCollectionName),
+		waitlistCollection:          client.Database(databaseName).Collection(WaitlistCollectionName),
+		allowlistCollection:         client.Database(databaseName).Collection(AllowlistCollectionName),
+		inviteCodeCollection:        client.Database(databaseName).Collection(InviteCodeCollectionName),
+		grantNotificationCollection: client.Database(databaseName).Collection(GrantNotificationCollectionName),
 		svcTags: metrics.Tags{
 			"svc": "db_svc",
 		},
 	}, nil
 }
 
+// withTransaction runs fn in a transaction of a new session, sessions must not be shared by goroutines
+func (s *MongoImpl) withTransaction(ctx context.Context, fn func(sessCtx mongo.SessionContext) (interface{}, error)) error {
+	return s.client.UseSession(ctx, func(sessCtx mongo.SessionContext) error {
+		_, err := sessCtx.WithTransaction(sessCtx, fn)
+		return err
+	})
+}
+
 func makeIndex(unique bool, keys interface{}) mongo.IndexModel {
 	idx := mongo.IndexModel{
 		Keys:    keys,
@@ -116,6 +163,121 @@ func (s *MongoImpl) EnsureIndex(ctx context.Context) error {
 		return err
 	}
 
+	_, err = s.webhookCollection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		makeIndex(false, bson.D{{Key: "guild_id", Value: 1}}),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = s.webhookDeliveryCollection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		makeIndex(false, bson.D{{Key: "webhook_id", Value: 1}, {Key: "created_at", Value: -1}}),
+		makeIndex(false, bson.D{{Key: "guild_id", Value: 1}, {Key: "created_at", Value: -1}}),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = s.tradeCollection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		makeIndex(true, bson.D{{Key: "trade_id", Value: 1}}),
+		makeIndex(false, bson.D{{Key: "injective_address", Value: 1}, {Key: "executed_at", Value: -1}}),
+		makeIndex(false, bson.D{{Key: "guild_id", Value: 1}, {Key: "executed_at", Value: -1}}),
+		makeIndex(false, bson.D{{Key: "order_hash", Value: 1}}),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = s.tradeCursorCollection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		makeIndex(true, bson.D{{Key: "subaccount_id", Value: 1}, {Key: "market_id", Value: 1}}),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = s.dailyFeeCollection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		makeIndex(true, bson.D{
+			{Key: "guild_id", Value: 1},
+			{Key: "day", Value: -1},
+			{Key: "injective_address", Value: 1},
+			{Key: "quote_denom", Value: 1},
+		}),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = s.transferCollection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		makeIndex(true, bson.D{{Key: "transfer_id", Value: 1}}),
+		makeIndex(false, bson.D{{Key: "injective_address", Value: 1}, {Key: "executed_at", Value: -1}}),
+		makeIndex(false, bson.D{{Key: "guild_id", Value: 1}, {Key: "executed_at", Value: -1}}),
+		makeIndex(false, bson.D{{Key: "order_hash", Value: 1}}),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = s.guildRiskCollection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		makeIndex(false, bson.D{{Key: "guild_id", Value: 1}, {Key: "updated_at", Value: -1}}),
+		// risks are recomputed every capture cycle, only recent ones are kept
+		{
+			Keys:    bson.D{{Key: "updated_at", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(int32(guildRiskRetention.Seconds())),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = s.alertStateCollection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		makeIndex(true, bson.D{{Key: "rule_id", Value: 1}, {Key: "guild_id", Value: 1}}),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = s.membershipCollection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		makeIndex(false, bson.D{{Key: "injective_address", Value: 1}, {Key: "ended_at", Value: -1}}),
+		makeIndex(false, bson.D{{Key: "guild_id", Value: 1}}),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = s.waitlistCollection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		makeIndex(true, bson.D{{Key: "guild_id", Value: 1}, {Key: "injective_address", Value: 1}}),
+		makeIndex(false, bson.D{{Key: "guild_id", Value: 1}, {Key: "enqueued_at", Value: 1}}),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = s.allowlistCollection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		makeIndex(true, bson.D{{Key: "guild_id", Value: 1}, {Key: "injective_address", Value: 1}}),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = s.inviteCodeCollection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		makeIndex(true, bson.D{{Key: "guild_id", Value: 1}, {Key: "code", Value: 1}}),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = s.grantNotificationCollection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		makeIndex(true, bson.D{{Key: "injective_address", Value: 1}, {Key: "msg", Value: 1}, {Key: "expiration", Value: 1}}),
+		// records are useless once grant expired, mongo removes them
+		{
+			Keys:    bson.D{{Key: "expiration", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(0),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -202,7 +364,7 @@ func (s *MongoImpl) DeleteGuild(ctx context.Context, guildID string) error {
 		return fmt.Errorf("cannot parse guildID: %w", err)
 	}
 
-	_, err = s.session.WithTransaction(ctx, func(sessCtx mongo.SessionContext) (interface{}, error) {
+	err = s.withTransaction(ctx, func(sessCtx mongo.SessionContext) (interface{}, error) {
 		filter := bson.M{
 			"_id": guildObjectID,
 		}
@@ -230,6 +392,66 @@ func (s *MongoImpl) DeleteGuild(ctx context.Context, guildID string) error {
 			return nil, err
 		}
 
+		_, err = s.webhookCollection.DeleteMany(sessCtx, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = s.webhookDeliveryCollection.DeleteMany(sessCtx, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = s.tradeCollection.DeleteMany(sessCtx, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = s.dailyFeeCollection.DeleteMany(sessCtx, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = s.transferCollection.DeleteMany(sessCtx, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = s.guildRiskCollection.DeleteMany(sessCtx, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = s.alertRuleCollection.DeleteMany(sessCtx, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = s.alertStateCollection.DeleteMany(sessCtx, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = s.membershipCollection.DeleteMany(sessCtx, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = s.waitlistCollection.DeleteMany(sessCtx, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = s.allowlistCollection.DeleteMany(sessCtx, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = s.inviteCodeCollection.DeleteMany(sessCtx, filter)
+		if err != nil {
+			return nil, err
+		}
+
 		return nil, nil
 	})
 
@@ -241,13 +463,21 @@ func (s *MongoImpl) DeleteGuild(ctx context.Context, guildID string) error {
 	return nil
 }
 
-func (s *MongoImpl) ListAllGuilds(ctx context.Context) (result []*model.Guild, err error) {
+func listGuildsFilter(includeArchived bool) bson.M {
+	filter := bson.M{}
+	if !includeArchived {
+		// guilds created before archiving was introduced have no archived field
+		filter["archived"] = bson.M{"$ne": true}
+	}
+	return filter
+}
+
+func (s *MongoImpl) ListAllGuilds(ctx context.Context, includeArchived bool) (result []*model.Guild, err error) {
 	doneFn := metrics.ReportFuncTiming(s.svcTags)
 	defer doneFn()
 	metrics.ReportFuncCall(s.svcTags)
 
-	filter := bson.M{}
-	cur, err := s.guildCollection.Find(ctx, filter)
+	cur, err := s.guildCollection.Find(ctx, listGuildsFilter(includeArchived))
 	if err != nil {
 		return nil, err
 	}
@@ -332,6 +562,46 @@ func (s *MongoImpl) SetGuildCap(ctx context.Context, guildID string, cap int) er
 	return nil
 }
 
+func (s *MongoImpl) SetGuildArchived(ctx context.Context, guildID string, archived bool) error {
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(s.svcTags)
+
+	guildObjectID, err := primitive.ObjectIDFromHex(guildID)
+	if err != nil {
+		return fmt.Errorf("cannot parse guildID: %w", err)
+	}
+
+	filter := bson.M{
+		"_id": guildObjectID,
+	}
+
+	upd := bson.M{
+		"$set": bson.M{
+			"archived":    true,
+			"archived_at": time.Now(),
+		},
+	}
+	if !archived {
+		upd = bson.M{
+			"$set":   bson.M{"archived": false},
+			"$unset": bson.M{"archived_at": ""},
+		}
+	}
+
+	updateRes, err := s.guildCollection.UpdateOne(ctx, filter, upd)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return err
+	}
+
+	if updateRes.MatchedCount == 0 {
+		return db.ErrNotFound
+	}
+
+	return nil
+}
+
 func (s *MongoImpl) AddGuildPortfolios(ctx context.Context, portfolios []*model.GuildPortfolio) error {
 	doneFn := metrics.ReportFuncTiming(s.svcTags)
 	defer doneFn()
@@ -399,6 +669,7 @@ func (s *MongoImpl) upsertMember(
 	address model.Address,
 	isDefaultMember bool,
 	params string,
+	subaccountIDs []string,
 ) (*mongo.UpdateResult, error) {
 	doneFn := metrics.ReportFuncTiming(s.svcTags)
 	defer doneFn()
@@ -413,6 +684,7 @@ func (s *MongoImpl) upsertMember(
 			"is_default_guild_member": isDefaultMember,
 			"since":                   time.Now(),
 			"params":                  params,
+			"subaccount_ids":          subaccountIDs,
 		},
 	}
 	updOpt := &options.UpdateOptions{}
@@ -421,31 +693,22 @@ func (s *MongoImpl) upsertMember(
 	return s.memberCollection.UpdateOne(ctx, filter, upd, updOpt)
 }
 
+// deleteMember returns deleted member, or ErrNoDocuments if there is no such member
 func (s *MongoImpl) deleteMember(
 	ctx context.Context,
 	guildID primitive.ObjectID,
 	address model.Address,
-) (*mongo.DeleteResult, error) {
+) (*model.GuildMember, error) {
 	filter := bson.M{
 		"guild_id":          guildID,
 		"injective_address": address.String(),
 	}
 
-	return s.memberCollection.DeleteOne(ctx, filter)
-}
-
-// do we want to keep data for future analyze?
-func (s *MongoImpl) deletePortfolios(
-	ctx context.Context,
-	guildID primitive.ObjectID,
-	address model.Address,
-) (*mongo.DeleteResult, error) {
-	filter := bson.M{
-		"guild_id":          guildID,
-		"injective_address": address.String(),
+	var member model.GuildMember
+	if err := s.memberCollection.FindOneAndDelete(ctx, filter).Decode(&member); err != nil {
+		return nil, err
 	}
-
-	return s.accountPortfolioCollection.DeleteMany(ctx, filter)
+	return &member, nil
 }
 
 func (s *MongoImpl) adjustMemberCount(
@@ -470,6 +733,8 @@ func (s *MongoImpl) AddMember(
 	address model.Address,
 	initialPortfolio *model.AccountPortfolio, isDefaultMember bool,
 	params string,
+	subaccountIDs []string,
+	inviteCode string,
 ) error {
 	doneFn := metrics.ReportFuncTiming(s.svcTags)
 	defer doneFn()
@@ -481,72 +746,122 @@ func (s *MongoImpl) AddMember(
 		return fmt.Errorf("cannot parse guildID: %w", err)
 	}
 
-	_, err = s.session.WithTransaction(ctx, func(sessCtx mongo.SessionContext) (interface{}, error) {
-		guild, err := s.GetSingleGuild(sessCtx, guildID)
-		if err != nil {
-			return nil, err
-		}
+	err = s.withTransaction(ctx, func(sessCtx mongo.SessionContext) (interface{}, error) {
+		return nil, s.addMember(sessCtx, guildObjectID, address, initialPortfolio, isDefaultMember, params, subaccountIDs, inviteCode)
+	})
 
-		if guild.MemberCount >= guild.Capacity {
-			return nil, db.ErrMemberExceedCap
-		}
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return err
+	}
 
-		_, err = s.adjustMemberCount(sessCtx, guildObjectID, 1)
-		if err != nil {
-			return nil, err
-		}
+	return nil
+}
 
-		upsertRes, err := s.upsertMember(sessCtx, guildObjectID, address, isDefaultMember, params)
-		if err != nil {
-			return nil, err
-		}
+// checkGuildOpen returns error if guild doesn't take new members
+func checkGuildOpen(guild *model.Guild) error {
+	if guild.Archived {
+		return db.ErrGuildArchived
+	}
 
-		// duplicate member, revert transaction
-		if upsertRes.UpsertedCount < 1 {
-			return nil, db.ErrAlreadyMember
-		}
+	if guild.MemberCount >= guild.Capacity {
+		return db.ErrMemberExceedCap
+	}
+	return nil
+}
 
-		limit := int64(1)
-		latestGuildPortfolios, err := s.ListGuildPortfolios(sessCtx, model.GuildPortfoliosFilter{
-			GuildID: guildID,
-			Limit:   &limit,
-		})
-		if err != nil {
-			return nil, err
-		}
+// addMember adds member and its initial portfolio to guild, it's expected to be called in a transaction
+func (s *MongoImpl) addMember(
+	sessCtx mongo.SessionContext,
+	guildObjectID primitive.ObjectID,
+	address model.Address,
+	initialPortfolio *model.AccountPortfolio, isDefaultMember bool,
+	params string,
+	subaccountIDs []string,
+	inviteCode string,
+) error {
+	guildID := guildObjectID.Hex()
+	guild, err := s.GetSingleGuild(sessCtx, guildID)
+	if err != nil {
+		return err
+	}
 
-		if len(latestGuildPortfolios) > 0 {
-			err = s.updateGuildPortfolio(sessCtx, latestGuildPortfolios[0], initialPortfolio, true)
-		} else {
-			guildPortfolio := &model.GuildPortfolio{
-				GuildID:      guildObjectID,
-				Balances:     initialPortfolio.Balances,
-				BankBalances: initialPortfolio.BankBalances,
-				UpdatedAt:    initialPortfolio.UpdatedAt,
+	if err := checkGuildOpen(guild); err != nil {
+		return err
+	}
+
+	_, err = s.adjustMemberCount(sessCtx, guildObjectID, 1)
+	if err != nil {
+		return err
+	}
+
+	upsertRes, err := s.upsertMember(sessCtx, guildObjectID, address, isDefaultMember, params, subaccountIDs)
+	if err != nil {
+		return err
+	}
+
+	// duplicate member, revert transaction
+	if upsertRes.UpsertedCount < 1 {
+		return db.ErrAlreadyMember
+	}
+
+	// address leaves waitlist of guild once it's admitted, invite code use reserved for it is kept
+	entry, err := s.deleteWaitlistEntry(sessCtx, guildObjectID, address)
+	if err != nil {
+		return err
+	}
+
+	reservedCode := ""
+	if entry != nil {
+		reservedCode = entry.InviteCode
+	}
+
+	if inviteCode != reservedCode {
+		if inviteCode != "" {
+			if err := s.useInviteCode(sessCtx, guildObjectID, inviteCode); err != nil {
+				return err
 			}
-			err = s.AddGuildPortfolios(sessCtx, []*model.GuildPortfolio{guildPortfolio})
-		}
-		if err != nil {
-			return nil, err
 		}
 
-		initialPortfolio.GuildID = guildObjectID
-		err = s.AddAccountPortfolios(sessCtx, []*model.AccountPortfolio{initialPortfolio})
-		if err != nil {
-			return nil, err
+		// joined without the reserved code, e.g. guild isn't invite-only anymore
+		if reservedCode != "" {
+			if err := s.releaseInviteCode(sessCtx, guildObjectID, reservedCode); err != nil {
+				return err
+			}
 		}
+	}
 
-		return nil, nil
+	limit := int64(1)
+	latestGuildPortfolios, err := s.ListGuildPortfolios(sessCtx, model.GuildPortfoliosFilter{
+		GuildID: guildID,
+		Limit:   &limit,
 	})
+	if err != nil {
+		return err
+	}
 
+	if len(latestGuildPortfolios) > 0 {
+		err = s.updateGuildPortfolio(sessCtx, latestGuildPortfolios[0], initialPortfolio, true)
+	} else {
+		guildPortfolio := &model.GuildPortfolio{
+			GuildID:      guildObjectID,
+			Balances:     initialPortfolio.Balances,
+			BankBalances: initialPortfolio.BankBalances,
+			UpdatedAt:    initialPortfolio.UpdatedAt,
+		}
+		err = s.AddGuildPortfolios(sessCtx, []*model.GuildPortfolio{guildPortfolio})
+	}
 	if err != nil {
-		metrics.ReportFuncError(s.svcTags)
 		return err
 	}
 
-	return nil
+	initialPortfolio.GuildID = guildObjectID
+	return s.AddAccountPortfolios(sessCtx, []*model.AccountPortfolio{initialPortfolio})
 }
 
+// decimalZero keeps $sum results in decimal when no document contributes
+var decimalZero = primitive.NewDecimal128(0, 0)
+
 // return a + coef * b
 func sumMul(a, b primitive.Decimal128, coef decimal.Decimal) primitive.Decimal128 {
 	dA, _ := decimal.NewFromString(a.String())
@@ -561,8 +876,22 @@ func (s *MongoImpl) updateGuildPortfolio(
 	accountPorfolio *model.AccountPortfolio, isAddition bool,
 ) error {
 	coef := decimal.NewFromInt(1)
+	flowType := model.CashFlowMemberJoined
 	if !isAddition {
 		coef = decimal.NewFromInt(-1)
+		flowType = model.CashFlowMemberLeft
+	}
+
+	// member portfolio moving in or out is recorded as cash flow for return computation
+	now := time.Now()
+	addFlow := func(denom string, amount primitive.Decimal128) {
+		guildPorfolio.CashFlows = append(guildPorfolio.CashFlows, &model.CashFlow{
+			Type:             flowType,
+			InjectiveAddress: accountPorfolio.InjectiveAddress,
+			Denom:            denom,
+			Amount:           sumMul(primitive.NewDecimal128(0, 0), amount, coef),
+			ExecutedAt:       now,
+		})
 	}
 
 	// at most 6 denoms -> 36 loop times
@@ -573,6 +902,7 @@ func (s *MongoImpl) updateGuildPortfolio(
 				guildBalance.TotalBalance = sumMul(guildBalance.TotalBalance, accBalance.TotalBalance, coef)
 				guildBalance.MarginHold = sumMul(guildBalance.MarginHold, accBalance.MarginHold, coef)
 				guildBalance.UnrealizedPNL = sumMul(guildBalance.UnrealizedPNL, accBalance.UnrealizedPNL, coef)
+				addFlow(accBalance.Denom, sumMul(accBalance.TotalBalance, accBalance.UnrealizedPNL, decimal.NewFromInt(1)))
 			}
 		}
 	}
@@ -581,11 +911,14 @@ func (s *MongoImpl) updateGuildPortfolio(
 		for _, accBalance := range accountPorfolio.BankBalances {
 			if guildBalance.Denom == accBalance.Denom {
 				guildBalance.Balance = sumMul(guildBalance.Balance, accBalance.Balance, coef)
+				addFlow(accBalance.Denom, accBalance.Balance)
 			}
 		}
 	}
 
+	// portfolios of different guilds can share the same updated_at
 	filter := bson.M{
+		"guild_id":   guildPorfolio.GuildID,
 		"updated_at": guildPorfolio.UpdatedAt,
 	}
 
@@ -613,55 +946,126 @@ func (s *MongoImpl) RemoveMember(ctx context.Context, guildID string, address mo
 		return fmt.Errorf("cannot parse guildID: %w", err)
 	}
 
-	_, err = s.session.WithTransaction(ctx, func(sessCtx mongo.SessionContext) (interface{}, error) {
-		deleteRes, err := s.deleteMember(sessCtx, guildObjectID, address)
-		if err != nil {
-			return nil, err
-		}
+	err = s.withTransaction(ctx, func(sessCtx mongo.SessionContext) (interface{}, error) {
+		_, err := s.removeMember(sessCtx, guildObjectID, address)
+		return nil, err
+	})
 
-		// expected to have 1 account deleted
-		if deleteRes.DeletedCount != 1 {
-			return nil, errors.New("cannot delete: no such member")
-		}
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return err
+	}
 
-		_, err = s.adjustMemberCount(sessCtx, guildObjectID, -1)
-		if err != nil {
-			return nil, err
+	return nil
+}
+
+// removeMember removes member from guild and records its membership, it's expected to be called in a transaction.
+// It returns removed member, or ErrNotFound if address is not member of guild
+func (s *MongoImpl) removeMember(
+	sessCtx mongo.SessionContext,
+	guildObjectID primitive.ObjectID,
+	address model.Address,
+) (*model.GuildMember, error) {
+	guildID := guildObjectID.Hex()
+	member, err := s.deleteMember(sessCtx, guildObjectID, address)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, db.ErrNotFound
 		}
+		return nil, err
+	}
 
-		limit := int64(1)
-		latestAccountPortfolios, err := s.ListAccountPortfolios(sessCtx, model.AccountPortfoliosFilter{
-			InjectiveAddress: address,
-			Limit:            &limit,
+	// snapshots are kept, membership is recorded to find them later
+	_, err = s.membershipCollection.InsertOne(sessCtx, &model.Membership{
+		GuildID:              guildObjectID,
+		InjectiveAddress:     member.InjectiveAddress,
+		IsDefaultGuildMember: member.IsDefaultGuildMember,
+		Since:                member.Since,
+		EndedAt:              time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.adjustMemberCount(sessCtx, guildObjectID, -1)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int64(1)
+	latestAccountPortfolios, err := s.ListAccountPortfolios(sessCtx, model.AccountPortfoliosFilter{
+		InjectiveAddress: address,
+		GuildID:          &guildID,
+		Limit:            &limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(latestAccountPortfolios) > 0 {
+		latestGuildPortfolios, err := s.ListGuildPortfolios(sessCtx, model.GuildPortfoliosFilter{
+			GuildID: guildID,
+			Limit:   &limit,
 		})
 		if err != nil {
 			return nil, err
 		}
 
-		if len(latestAccountPortfolios) > 0 {
-			latestGuildPortfolios, err := s.ListGuildPortfolios(sessCtx, model.GuildPortfoliosFilter{
-				GuildID: guildID,
-				Limit:   &limit,
-			})
+		// TODO: Update snapshot timestamp upon join guild
+		if len(latestGuildPortfolios) > 0 {
+			err = s.updateGuildPortfolio(sessCtx, latestGuildPortfolios[0], latestAccountPortfolios[0], false)
 			if err != nil {
 				return nil, err
 			}
-
-			// TODO: Update snapshot timestamp upon join guild
-			if len(latestGuildPortfolios) > 0 {
-				err = s.updateGuildPortfolio(sessCtx, latestGuildPortfolios[0], latestAccountPortfolios[0], false)
-				if err != nil {
-					return nil, err
-				}
-			}
 		}
+	}
+
+	return member, nil
+}
+
+func (s *MongoImpl) TransferMember(
+	ctx context.Context,
+	fromGuildID string,
+	toGuildID string,
+	address model.Address,
+	initialPortfolio *model.AccountPortfolio,
+	params string,
+	subaccountIDs []string,
+	inviteCode string,
+) error {
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(s.svcTags)
+
+	fromGuildObjectID, err := primitive.ObjectIDFromHex(fromGuildID)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return fmt.Errorf("cannot parse fromGuildID: %w", err)
+	}
+
+	toGuildObjectID, err := primitive.ObjectIDFromHex(toGuildID)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return fmt.Errorf("cannot parse toGuildID: %w", err)
+	}
+
+	if fromGuildObjectID == toGuildObjectID {
+		metrics.ReportFuncError(s.svcTags)
+		return db.ErrAlreadyMember
+	}
 
-		_, err = s.deletePortfolios(sessCtx, guildObjectID, address)
+	// member is either in old guild or in new guild, never in both or none
+	err = s.withTransaction(ctx, func(sessCtx mongo.SessionContext) (interface{}, error) {
+		member, err := s.removeMember(sessCtx, fromGuildObjectID, address)
 		if err != nil {
 			return nil, err
 		}
 
-		return nil, nil
+		if member.IsDefaultGuildMember {
+			return nil, db.ErrDefaultMember
+		}
+
+		return nil, s.addMember(sessCtx, toGuildObjectID, address, initialPortfolio, false, params, subaccountIDs, inviteCode)
 	})
 
 	if err != nil {
@@ -689,6 +1093,10 @@ func (s *MongoImpl) GetAccountPortfolio(ctx context.Context, address model.Addre
 	singleRow := s.accountPortfolioCollection.FindOne(ctx, filter, opts)
 	if err := singleRow.Err(); err != nil {
 		metrics.ReportFuncError(s.svcTags)
+
+		if err == mongo.ErrNoDocuments {
+			return nil, db.ErrNotFound
+		}
 		return nil, err
 	}
 
@@ -701,18 +1109,19 @@ func (s *MongoImpl) GetAccountPortfolio(ctx context.Context, address model.Addre
 	return &portfolio, nil
 }
 
-func (s *MongoImpl) ListAccountPortfolios(
-	ctx context.Context,
-	filter model.AccountPortfoliosFilter,
-) (result []*model.AccountPortfolio, err error) {
-	doneFn := metrics.ReportFuncTiming(s.svcTags)
-	defer doneFn()
-	metrics.ReportFuncCall(s.svcTags)
-
+func accountPortfoliosFilter(filter model.AccountPortfoliosFilter) (bson.M, error) {
 	portfolioFilter := bson.M{
 		"injective_address": filter.InjectiveAddress.String(),
 	}
 
+	if filter.GuildID != nil {
+		guildObjectID, err := primitive.ObjectIDFromHex(*filter.GuildID)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse guildID: %w", err)
+		}
+		portfolioFilter["guild_id"] = guildObjectID
+	}
+
 	var updatedAtFilter = make(bson.M)
 	if filter.StartTime != nil {
 		updatedAtFilter["$gte"] = *filter.StartTime
@@ -725,6 +1134,22 @@ func (s *MongoImpl) ListAccountPortfolios(
 	if len(updatedAtFilter) > 0 {
 		portfolioFilter["updated_at"] = updatedAtFilter
 	}
+	return portfolioFilter, nil
+}
+
+func (s *MongoImpl) ListAccountPortfolios(
+	ctx context.Context,
+	filter model.AccountPortfoliosFilter,
+) (result []*model.AccountPortfolio, err error) {
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(s.svcTags)
+
+	portfolioFilter, err := accountPortfoliosFilter(filter)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return nil, err
+	}
 
 	opts := &options.FindOptions{}
 	opts.SetSort(bson.M{"updated_at": -1})
@@ -774,6 +1199,41 @@ func (s *MongoImpl) AddAccountPortfolios(
 	return nil
 }
 
+func (s *MongoImpl) ListMemberships(ctx context.Context, address model.Address) (result []*model.Membership, err error) {
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(s.svcTags)
+
+	filter := bson.M{
+		"injective_address": address.String(),
+	}
+
+	opts := &options.FindOptions{}
+	opts.SetSort(bson.M{"ended_at": -1})
+
+	cur, err := s.membershipCollection.Find(ctx, filter, opts)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	for cur.Next(ctx) {
+		var membership model.Membership
+		if err := cur.Decode(&membership); err != nil {
+			metrics.ReportFuncError(s.svcTags)
+			return nil, err
+		}
+
+		result = append(result, &membership)
+	}
+	return result, nil
+}
+
+func (s *MongoImpl) Ping(ctx context.Context) error {
+	return s.client.Ping(ctx, readpref.Primary())
+}
+
 func (s *MongoImpl) Disconnect(ctx context.Context) error {
 	return s.client.Disconnect(ctx)
 }
@@ -0,0 +1,84 @@
+package mongoimpl
+
+import (
+	"testing"
+	"time"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
+	cosmtypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestListGuildsFilter(t *testing.T) {
+	assert.Equal(t, bson.M{"archived": bson.M{"$ne": true}}, listGuildsFilter(false))
+	assert.Equal(t, bson.M{}, listGuildsFilter(true))
+}
+
+func TestCheckGuildOpen(t *testing.T) {
+	testCases := []struct {
+		name  string
+		guild *model.Guild
+		err   error
+	}{
+		{
+			name:  "open",
+			guild: &model.Guild{Capacity: 2, MemberCount: 1},
+		},
+		{
+			name:  "archived",
+			guild: &model.Guild{Capacity: 2, MemberCount: 1, Archived: true},
+			err:   db.ErrGuildArchived,
+		},
+		{
+			name:  "full",
+			guild: &model.Guild{Capacity: 2, MemberCount: 2},
+			err:   db.ErrMemberExceedCap,
+		},
+		{
+			name:  "archived and full",
+			guild: &model.Guild{Capacity: 2, MemberCount: 2, Archived: true},
+			err:   db.ErrGuildArchived,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.err, checkGuildOpen(tc.guild))
+		})
+	}
+}
+
+func TestAccountPortfoliosFilter(t *testing.T) {
+	address := model.Address{AccAddress: cosmtypes.AccAddress("account_portfolios__")}
+	guildID := primitive.NewObjectID()
+	guildIDHex := guildID.Hex()
+	startTime := time.UnixMilli(1650000000000)
+
+	filter, err := accountPortfoliosFilter(model.AccountPortfoliosFilter{
+		InjectiveAddress: address,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, bson.M{"injective_address": address.String()}, filter)
+
+	filter, err = accountPortfoliosFilter(model.AccountPortfoliosFilter{
+		InjectiveAddress: address,
+		GuildID:          &guildIDHex,
+		StartTime:        &startTime,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, bson.M{
+		"injective_address": address.String(),
+		"guild_id":          guildID,
+		"updated_at":        bson.M{"$gte": startTime},
+	}, filter)
+
+	invalidGuildID := "not-a-guild-id"
+	_, err = accountPortfoliosFilter(model.AccountPortfoliosFilter{
+		InjectiveAddress: address,
+		GuildID:          &invalidGuildID,
+	})
+	assert.Error(t, err)
+}
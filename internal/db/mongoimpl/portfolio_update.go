@@ -0,0 +1,181 @@
+package mongoimpl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
+	"github.com/InjectiveLabs/metrics"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// changeStreamHistoryLostCode is returned when resume token is no longer in oplog
+const changeStreamHistoryLostCode = 286
+
+// changeEvent is the part of change stream event we need
+type changeEvent struct {
+	ID struct {
+		Data string `bson:"_data"`
+	} `bson:"_id"`
+	OperationType string `bson:"operationType"`
+	NS            struct {
+		Coll string `bson:"coll"`
+	} `bson:"ns"`
+	// FullDocument of update is looked up when event is read, it's empty if document was deleted since
+	FullDocument bson.Raw `bson:"fullDocument"`
+}
+
+// portfolioUpdatePipeline matches inserts of snapshots and membership changes and updates of guild portfolios,
+// they are rewritten when members join or leave. Members joined are inserts to members collection,
+// members left are inserts to memberships collection
+func portfolioUpdatePipeline() mongo.Pipeline {
+	return mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{
+			"$or": bson.A{
+				bson.M{
+					"operationType": "insert",
+					"ns.coll": bson.M{"$in": bson.A{
+						GuildPortfolioCollectionName,
+						AccountPortfolioCollectionName,
+						MemberCollectionName,
+						MembershipCollectionName,
+					}},
+				},
+				bson.M{
+					"operationType": bson.M{"$in": bson.A{"update", "replace"}},
+					"ns.coll":       GuildPortfolioCollectionName,
+				},
+			},
+		}}},
+	}
+}
+
+// WatchPortfolioUpdates opens a change stream on the database, it requires mongo to run as a replica set
+func (s *MongoImpl) WatchPortfolioUpdates(
+	ctx context.Context,
+	resumeToken string,
+	handler func(*model.PortfolioUpdate) error,
+) error {
+	// no timing, watch lasts as long as the subscriber
+	metrics.ReportFuncCall(s.svcTags)
+
+	opts := options.ChangeStream().SetFullDocument(options.UpdateLookup)
+	if resumeToken != "" {
+		opts.SetResumeAfter(bson.M{"_data": resumeToken})
+	}
+
+	stream, err := s.guildCollection.Database().Watch(ctx, portfolioUpdatePipeline(), opts)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		if isHistoryLost(err) {
+			return db.ErrHistoryLost
+		}
+		return fmt.Errorf("watch err: %w", err)
+	}
+	defer stream.Close(context.Background())
+
+	for stream.Next(ctx) {
+		var event changeEvent
+		if err := stream.Decode(&event); err != nil {
+			metrics.ReportFuncError(s.svcTags)
+			return fmt.Errorf("decode change event err: %w", err)
+		}
+
+		update, err := decodePortfolioUpdate(&event)
+		if err != nil {
+			metrics.ReportFuncError(s.svcTags)
+			return err
+		}
+
+		// updated document was deleted before lookup
+		if update == nil {
+			continue
+		}
+
+		if err := handler(update); err != nil {
+			return err
+		}
+	}
+
+	// stream ends when ctx is done, that's not an error
+	if ctx.Err() != nil {
+		return nil
+	}
+
+	if err := stream.Err(); err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		if isHistoryLost(err) {
+			return db.ErrHistoryLost
+		}
+		return fmt.Errorf("change stream err: %w", err)
+	}
+	return nil
+}
+
+func isHistoryLost(err error) bool {
+	var serverErr mongo.ServerError
+	return errors.As(err, &serverErr) && serverErr.HasErrorCode(changeStreamHistoryLostCode)
+}
+
+// decodePortfolioUpdate returns nil update if event has no full document
+func decodePortfolioUpdate(event *changeEvent) (*model.PortfolioUpdate, error) {
+	if len(event.FullDocument) == 0 {
+		return nil, nil
+	}
+
+	var update *model.PortfolioUpdate
+	switch event.NS.Coll {
+	case GuildPortfolioCollectionName:
+		var portfolio model.GuildPortfolio
+		if err := bson.Unmarshal(event.FullDocument, &portfolio); err != nil {
+			return nil, fmt.Errorf("decode guild portfolio err: %w", err)
+		}
+		update = &model.PortfolioUpdate{
+			Kind:           model.PortfolioUpdateGuildPortfolio,
+			GuildID:        portfolio.GuildID,
+			GuildPortfolio: &portfolio,
+		}
+	case AccountPortfolioCollectionName:
+		var portfolio model.AccountPortfolio
+		if err := bson.Unmarshal(event.FullDocument, &portfolio); err != nil {
+			return nil, fmt.Errorf("decode account portfolio err: %w", err)
+		}
+		update = &model.PortfolioUpdate{
+			Kind:             model.PortfolioUpdateAccountPortfolio,
+			GuildID:          portfolio.GuildID,
+			InjectiveAddress: portfolio.InjectiveAddress,
+			AccountPortfolio: &portfolio,
+		}
+	case MemberCollectionName:
+		var member model.GuildMember
+		if err := bson.Unmarshal(event.FullDocument, &member); err != nil {
+			return nil, fmt.Errorf("decode member err: %w", err)
+		}
+		update = &model.PortfolioUpdate{
+			Kind:             model.PortfolioUpdateMemberJoined,
+			GuildID:          member.GuildID,
+			InjectiveAddress: member.InjectiveAddress,
+			Member:           &member,
+		}
+	case MembershipCollectionName:
+		var membership model.Membership
+		if err := bson.Unmarshal(event.FullDocument, &membership); err != nil {
+			return nil, fmt.Errorf("decode membership err: %w", err)
+		}
+		update = &model.PortfolioUpdate{
+			Kind:             model.PortfolioUpdateMemberLeft,
+			GuildID:          membership.GuildID,
+			InjectiveAddress: membership.InjectiveAddress,
+			Membership:       &membership,
+		}
+	default:
+		return nil, fmt.Errorf("unexpected collection: %s", event.NS.Coll)
+	}
+
+	update.ID = event.ID.Data
+	return update, nil
+}
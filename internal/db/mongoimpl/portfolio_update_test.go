@@ -0,0 +1,99 @@
+package mongoimpl
+
+import (
+	"testing"
+	"time"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
+	cosmtypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func newChangeEvent(t *testing.T, coll string, doc interface{}) *changeEvent {
+	event := &changeEvent{}
+	event.ID.Data = "8262"
+	event.NS.Coll = coll
+	if doc != nil {
+		raw, err := bson.Marshal(doc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		event.FullDocument = raw
+	}
+	return event
+}
+
+func TestDecodePortfolioUpdate(t *testing.T) {
+	cosmtypes.GetConfig().SetBech32PrefixForAccount("inj", "injpub")
+	address := model.Address{AccAddress: cosmtypes.AccAddress("portfolio_update____")}
+	guildID := primitive.NewObjectID()
+	// bson keeps milliseconds in utc
+	now := time.Now().UTC().Truncate(time.Millisecond)
+
+	testCases := []struct {
+		coll   string
+		doc    interface{}
+		update *model.PortfolioUpdate
+	}{
+		{
+			coll: GuildPortfolioCollectionName,
+			doc:  &model.GuildPortfolio{GuildID: guildID, MemberCount: 2, UpdatedAt: now},
+			update: &model.PortfolioUpdate{
+				ID:             "8262",
+				Kind:           model.PortfolioUpdateGuildPortfolio,
+				GuildID:        guildID,
+				GuildPortfolio: &model.GuildPortfolio{GuildID: guildID, MemberCount: 2, UpdatedAt: now},
+			},
+		},
+		{
+			coll: AccountPortfolioCollectionName,
+			doc:  &model.AccountPortfolio{GuildID: guildID, InjectiveAddress: address, UpdatedAt: now},
+			update: &model.PortfolioUpdate{
+				ID:               "8262",
+				Kind:             model.PortfolioUpdateAccountPortfolio,
+				GuildID:          guildID,
+				InjectiveAddress: address,
+				AccountPortfolio: &model.AccountPortfolio{GuildID: guildID, InjectiveAddress: address, UpdatedAt: now},
+			},
+		},
+		{
+			coll: MemberCollectionName,
+			doc:  &model.GuildMember{GuildID: guildID, InjectiveAddress: address, Since: now},
+			update: &model.PortfolioUpdate{
+				ID:               "8262",
+				Kind:             model.PortfolioUpdateMemberJoined,
+				GuildID:          guildID,
+				InjectiveAddress: address,
+				Member:           &model.GuildMember{GuildID: guildID, InjectiveAddress: address, Since: now},
+			},
+		},
+		{
+			coll: MembershipCollectionName,
+			doc:  &model.Membership{GuildID: guildID, InjectiveAddress: address, Since: now, EndedAt: now},
+			update: &model.PortfolioUpdate{
+				ID:               "8262",
+				Kind:             model.PortfolioUpdateMemberLeft,
+				GuildID:          guildID,
+				InjectiveAddress: address,
+				Membership:       &model.Membership{GuildID: guildID, InjectiveAddress: address, Since: now, EndedAt: now},
+			},
+		},
+		{
+			// updated guild portfolio deleted before lookup
+			coll: GuildPortfolioCollectionName,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.coll, func(t *testing.T) {
+			update, err := decodePortfolioUpdate(newChangeEvent(t, tc.coll, tc.doc))
+			assert.NoError(t, err)
+			assert.Equal(t, tc.update, update)
+		})
+	}
+
+	_, err := decodePortfolioUpdate(newChangeEvent(t, GuildCollectionName, &model.Guild{}))
+	assert.Error(t, err)
+}
@@ -0,0 +1,60 @@
+package mongoimpl
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
+	"github.com/InjectiveLabs/metrics"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func (s *MongoImpl) AddGuildRisk(ctx context.Context, risk *model.GuildRisk) error {
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(s.svcTags)
+
+	if _, err := s.guildRiskCollection.InsertOne(ctx, risk); err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return err
+	}
+
+	return nil
+}
+
+func (s *MongoImpl) GetLatestGuildRisk(ctx context.Context, guildID string) (*model.GuildRisk, error) {
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(s.svcTags)
+
+	guildObjectID, err := primitive.ObjectIDFromHex(guildID)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return nil, fmt.Errorf("cannot parse guildID: %w", err)
+	}
+
+	opts := &options.FindOneOptions{}
+	opts.SetSort(bson.M{"updated_at": -1})
+
+	res := s.guildRiskCollection.FindOne(ctx, bson.M{"guild_id": guildObjectID}, opts)
+	if err := res.Err(); err != nil {
+		metrics.ReportFuncError(s.svcTags)
+
+		if err == mongo.ErrNoDocuments {
+			return nil, db.ErrNotFound
+		}
+		return nil, err
+	}
+
+	var risk model.GuildRisk
+	if err := res.Decode(&risk); err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return nil, err
+	}
+
+	return &risk, nil
+}
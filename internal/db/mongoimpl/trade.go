@@ -0,0 +1,303 @@
+package mongoimpl
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
+	"github.com/InjectiveLabs/metrics"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func (s *MongoImpl) AddTrades(ctx context.Context, trades []*model.Trade, cursor *model.TradeCursor) error {
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(s.svcTags)
+
+	err := s.withTransaction(ctx, func(sessCtx mongo.SessionContext) (interface{}, error) {
+		if len(trades) > 0 {
+			// insert if not exist, duplicate key errors would abort the transaction
+			writes := make([]mongo.WriteModel, 0, len(trades))
+			for _, trade := range trades {
+				writes = append(writes, mongo.NewUpdateOneModel().
+					SetFilter(bson.M{"trade_id": trade.TradeID}).
+					SetUpdate(bson.M{"$setOnInsert": trade}).
+					SetUpsert(true),
+				)
+			}
+
+			if _, err := s.tradeCollection.BulkWrite(sessCtx, writes); err != nil {
+				return nil, err
+			}
+		}
+
+		filter := bson.M{
+			"subaccount_id": cursor.SubaccountID,
+			"market_id":     cursor.MarketID,
+		}
+		upd := bson.M{"$set": cursor}
+		updOpt := &options.UpdateOptions{}
+		updOpt.SetUpsert(true)
+
+		_, err := s.tradeCursorCollection.UpdateOne(sessCtx, filter, upd, updOpt)
+		return nil, err
+	})
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return err
+	}
+
+	return nil
+}
+
+func tradeFilterToBson(filter model.TradeFilter) (bson.M, error) {
+	result := bson.M{}
+	if filter.GuildID != nil {
+		guildObjectID, err := primitive.ObjectIDFromHex(*filter.GuildID)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse guildID: %w", err)
+		}
+		result["guild_id"] = guildObjectID
+	}
+
+	if filter.InjectiveAddress != nil {
+		result["injective_address"] = filter.InjectiveAddress.String()
+	}
+
+	if filter.FeeRecipient != nil {
+		result["fee_recipient"] = *filter.FeeRecipient
+	}
+
+	var executedAtFilter = make(bson.M)
+	if filter.StartTime != nil {
+		executedAtFilter["$gte"] = *filter.StartTime
+	}
+
+	if filter.EndTime != nil {
+		executedAtFilter["$lt"] = *filter.EndTime
+	}
+
+	if len(executedAtFilter) > 0 {
+		result["executed_at"] = executedAtFilter
+	}
+
+	return result, nil
+}
+
+func (s *MongoImpl) ListTrades(ctx context.Context, filter model.TradeFilter) (result []*model.Trade, err error) {
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(s.svcTags)
+
+	tradeFilter, err := tradeFilterToBson(filter)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return nil, err
+	}
+
+	opt := &options.FindOptions{}
+	opt.SetSort(bson.M{"executed_at": -1})
+	if filter.Limit != nil {
+		opt.SetLimit(*filter.Limit)
+	}
+
+	cur, err := s.tradeCollection.Find(ctx, tradeFilter, opt)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	for cur.Next(ctx) {
+		var trade model.Trade
+		err := cur.Decode(&trade)
+		if err != nil {
+			metrics.ReportFuncError(s.svcTags)
+			return nil, err
+		}
+
+		result = append(result, &trade)
+	}
+
+	return result, nil
+}
+
+func (s *MongoImpl) GetTradeStats(ctx context.Context, filter model.TradeFilter) (result []*model.TradeStats, err error) {
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(s.svcTags)
+
+	tradeFilter, err := tradeFilterToBson(filter)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return nil, err
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: tradeFilter}},
+		{{Key: "$group", Value: bson.M{
+			"_id":          "$quote_denom",
+			"realized_pnl": bson.M{"$sum": "$realized_pnl"},
+			"fees":         bson.M{"$sum": "$fee"},
+			"volume":       bson.M{"$sum": "$volume"},
+			"trade_count":  bson.M{"$sum": 1},
+		}}},
+		{{Key: "$sort", Value: bson.M{"_id": 1}}},
+	}
+
+	cur, err := s.tradeCollection.Aggregate(ctx, pipeline)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	for cur.Next(ctx) {
+		var stats model.TradeStats
+		err := cur.Decode(&stats)
+		if err != nil {
+			metrics.ReportFuncError(s.svcTags)
+			return nil, err
+		}
+
+		result = append(result, &stats)
+	}
+
+	return result, nil
+}
+
+func (s *MongoImpl) GetMemberTradeStats(ctx context.Context, filter model.TradeFilter) (result []*model.MemberTradeStats, err error) {
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(s.svcTags)
+
+	tradeFilter, err := tradeFilterToBson(filter)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return nil, err
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: tradeFilter}},
+		{{Key: "$group", Value: bson.M{
+			"_id": bson.M{
+				"injective_address": "$injective_address",
+				"quote_denom":       "$quote_denom",
+			},
+			"fees": bson.M{"$sum": "$fee"},
+			"charged_fees": bson.M{"$sum": bson.M{
+				"$cond": bson.A{bson.M{"$gt": bson.A{"$fee", 0}}, "$fee", decimalZero},
+			}},
+			"volume":      bson.M{"$sum": "$volume"},
+			"trade_count": bson.M{"$sum": 1},
+		}}},
+		{{Key: "$project", Value: bson.M{
+			"_id":               0,
+			"injective_address": "$_id.injective_address",
+			"quote_denom":       "$_id.quote_denom",
+			"fees":              1,
+			"charged_fees":      1,
+			"volume":            1,
+			"trade_count":       1,
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "injective_address", Value: 1}, {Key: "quote_denom", Value: 1}}}},
+	}
+
+	cur, err := s.tradeCollection.Aggregate(ctx, pipeline)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	for cur.Next(ctx) {
+		var stats model.MemberTradeStats
+		err := cur.Decode(&stats)
+		if err != nil {
+			metrics.ReportFuncError(s.svcTags)
+			return nil, err
+		}
+
+		result = append(result, &stats)
+	}
+
+	return result, nil
+}
+
+func (s *MongoImpl) GetOrderFeeRecipients(ctx context.Context, orderHashes []string) (map[string]string, error) {
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(s.svcTags)
+
+	result := make(map[string]string)
+	if len(orderHashes) == 0 {
+		return result, nil
+	}
+
+	filter := bson.M{
+		"order_hash":    bson.M{"$in": orderHashes},
+		"fee_recipient": bson.M{"$nin": bson.A{"", model.FeeRecipientUnknown}},
+	}
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: filter}},
+		{{Key: "$group", Value: bson.M{
+			"_id":           "$order_hash",
+			"fee_recipient": bson.M{"$first": "$fee_recipient"},
+		}}},
+	}
+
+	cur, err := s.tradeCollection.Aggregate(ctx, pipeline)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	for cur.Next(ctx) {
+		var order struct {
+			OrderHash    string `bson:"_id"`
+			FeeRecipient string `bson:"fee_recipient"`
+		}
+		if err := cur.Decode(&order); err != nil {
+			metrics.ReportFuncError(s.svcTags)
+			return nil, err
+		}
+
+		result[order.OrderHash] = order.FeeRecipient
+	}
+
+	return result, nil
+}
+
+func (s *MongoImpl) GetTradeCursor(ctx context.Context, subaccountID, marketID string) (*model.TradeCursor, error) {
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(s.svcTags)
+
+	filter := bson.M{
+		"subaccount_id": subaccountID,
+		"market_id":     marketID,
+	}
+
+	res := s.tradeCursorCollection.FindOne(ctx, filter)
+	if err := res.Err(); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, db.ErrNotFound
+		}
+
+		metrics.ReportFuncError(s.svcTags)
+		return nil, err
+	}
+
+	var cursor model.TradeCursor
+	if err := res.Decode(&cursor); err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return nil, err
+	}
+
+	return &cursor, nil
+}
@@ -0,0 +1,156 @@
+package mongoimpl
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
+	"github.com/InjectiveLabs/metrics"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func (s *MongoImpl) AddTransfers(ctx context.Context, transfers []*model.Transfer) error {
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(s.svcTags)
+
+	if len(transfers) == 0 {
+		return nil
+	}
+
+	// insert if not exist
+	writes := make([]mongo.WriteModel, 0, len(transfers))
+	for _, transfer := range transfers {
+		writes = append(writes, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"transfer_id": transfer.TransferID}).
+			SetUpdate(bson.M{"$setOnInsert": transfer}).
+			SetUpsert(true),
+		)
+	}
+
+	if _, err := s.transferCollection.BulkWrite(ctx, writes); err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return err
+	}
+
+	return nil
+}
+
+func transferFilterToBson(filter model.TransferFilter) (bson.M, error) {
+	result := bson.M{}
+	if filter.GuildID != nil {
+		guildObjectID, err := primitive.ObjectIDFromHex(*filter.GuildID)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse guildID: %w", err)
+		}
+		result["guild_id"] = guildObjectID
+	}
+
+	if filter.InjectiveAddress != nil {
+		result["injective_address"] = filter.InjectiveAddress.String()
+	}
+
+	var executedAtFilter = make(bson.M)
+	if filter.StartTime != nil {
+		executedAtFilter["$gte"] = *filter.StartTime
+	}
+
+	if filter.EndTime != nil {
+		executedAtFilter["$lt"] = *filter.EndTime
+	}
+
+	if len(executedAtFilter) > 0 {
+		result["executed_at"] = executedAtFilter
+	}
+
+	return result, nil
+}
+
+func (s *MongoImpl) ListTransfers(ctx context.Context, filter model.TransferFilter) (result []*model.Transfer, err error) {
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(s.svcTags)
+
+	transferFilter, err := transferFilterToBson(filter)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return nil, err
+	}
+
+	opt := &options.FindOptions{}
+	opt.SetSort(bson.M{"executed_at": -1})
+	if filter.Limit != nil {
+		opt.SetLimit(*filter.Limit)
+	}
+
+	cur, err := s.transferCollection.Find(ctx, transferFilter, opt)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	for cur.Next(ctx) {
+		var transfer model.Transfer
+		err := cur.Decode(&transfer)
+		if err != nil {
+			metrics.ReportFuncError(s.svcTags)
+			return nil, err
+		}
+
+		result = append(result, &transfer)
+	}
+
+	return result, nil
+}
+
+func (s *MongoImpl) GetNetFlows(ctx context.Context, filter model.TransferFilter) (result []*model.NetFlow, err error) {
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(s.svcTags)
+
+	transferFilter, err := transferFilterToBson(filter)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return nil, err
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: transferFilter}},
+		{{Key: "$group", Value: bson.M{
+			"_id": "$denom",
+			"deposits": bson.M{"$sum": bson.M{
+				"$cond": bson.A{bson.M{"$gt": bson.A{"$amount", 0}}, "$amount", decimalZero},
+			}},
+			"withdrawals": bson.M{"$sum": bson.M{
+				"$cond": bson.A{bson.M{"$lt": bson.A{"$amount", 0}}, bson.M{"$abs": "$amount"}, decimalZero},
+			}},
+			"net_flow":       bson.M{"$sum": "$amount"},
+			"transfer_count": bson.M{"$sum": 1},
+		}}},
+		{{Key: "$sort", Value: bson.M{"_id": 1}}},
+	}
+
+	cur, err := s.transferCollection.Aggregate(ctx, pipeline)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	for cur.Next(ctx) {
+		var flow model.NetFlow
+		err := cur.Decode(&flow)
+		if err != nil {
+			metrics.ReportFuncError(s.svcTags)
+			return nil, err
+		}
+
+		result = append(result, &flow)
+	}
+
+	return result, nil
+}
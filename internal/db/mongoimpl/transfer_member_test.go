@@ -0,0 +1,84 @@
+package mongoimpl
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
+	cosmtypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// newTestDB connects to GUILDS_TEST_DB_URL, it must be a replica set for transactions (make dev)
+func newTestDB(t *testing.T) *MongoImpl {
+	dbURL := os.Getenv("GUILDS_TEST_DB_URL")
+	if dbURL == "" {
+		t.Skip("integration test, set GUILDS_TEST_DB_URL to run it")
+	}
+
+	ctx := context.Background()
+	dbName := "guilds_test_" + primitive.NewObjectID().Hex()
+	dbSvc, err := NewService(ctx, dbURL, dbName)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mongoImpl := dbSvc.(*MongoImpl)
+	t.Cleanup(func() {
+		_ = mongoImpl.client.Database(dbName).Drop(ctx)
+		_ = mongoImpl.Disconnect(ctx)
+	})
+
+	// collections are created up front, they can't be created in transactions on older mongo
+	if err := mongoImpl.EnsureIndex(ctx); err != nil {
+		t.Fatal(err)
+	}
+	return mongoImpl
+}
+
+func TestTransferMemberRollback(t *testing.T) {
+	s := newTestDB(t)
+	ctx := context.Background()
+
+	fromGuildID, err := s.AddGuild(ctx, &model.Guild{Name: "from", Capacity: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// target is full, addMember fails after member is removed from old guild
+	toGuildID, err := s.AddGuild(ctx, &model.Guild{Name: "to", Capacity: 1, MemberCount: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	address := model.Address{AccAddress: cosmtypes.AccAddress("transfer_rollback___")}
+	newPortfolio := func() *model.AccountPortfolio {
+		return &model.AccountPortfolio{InjectiveAddress: address, UpdatedAt: time.Now()}
+	}
+
+	err = s.AddMember(ctx, fromGuildID.Hex(), address, newPortfolio(), false, "", nil, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = s.TransferMember(ctx, fromGuildID.Hex(), toGuildID.Hex(), address, newPortfolio(), "", nil, "")
+	assert.ErrorIs(t, err, db.ErrMemberExceedCap)
+
+	// removal is rolled back with the failed addition
+	members, err := s.ListGuildMembers(ctx, model.MemberFilter{InjectiveAddress: &address})
+	assert.NoError(t, err)
+	if assert.Len(t, members, 1) {
+		assert.Equal(t, *fromGuildID, members[0].GuildID)
+	}
+
+	fromGuild, err := s.GetSingleGuild(ctx, fromGuildID.Hex())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, fromGuild.MemberCount)
+
+	memberships, err := s.ListMemberships(ctx, address)
+	assert.NoError(t, err)
+	assert.Empty(t, memberships)
+}
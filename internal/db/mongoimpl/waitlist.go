@@ -0,0 +1,170 @@
+package mongoimpl
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
+	"github.com/InjectiveLabs/metrics"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func (s *MongoImpl) AddToWaitlist(ctx context.Context, entry *model.WaitlistEntry) error {
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(s.svcTags)
+
+	// waitlisted address keeps a use of invite code so it isn't used up by the time address is admitted
+	err := s.withTransaction(ctx, func(sessCtx mongo.SessionContext) (interface{}, error) {
+		_, err := s.waitlistCollection.InsertOne(sessCtx, entry)
+		if err != nil {
+			if mongo.IsDuplicateKeyError(err) {
+				return nil, db.ErrAlreadyWaitlisted
+			}
+			return nil, err
+		}
+
+		if entry.InviteCode != "" {
+			return nil, s.useInviteCode(sessCtx, entry.GuildID, entry.InviteCode)
+		}
+		return nil, nil
+	})
+
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return err
+	}
+
+	return nil
+}
+
+func (s *MongoImpl) ListWaitlist(ctx context.Context, guildID string) (result []*model.WaitlistEntry, err error) {
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(s.svcTags)
+
+	guildObjectID, err := primitive.ObjectIDFromHex(guildID)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return nil, fmt.Errorf("cannot parse guildID: %w", err)
+	}
+
+	filter := bson.M{
+		"guild_id": guildObjectID,
+	}
+
+	opts := &options.FindOptions{}
+	opts.SetSort(bson.D{{Key: "enqueued_at", Value: 1}, {Key: "_id", Value: 1}})
+
+	cur, err := s.waitlistCollection.Find(ctx, filter, opts)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	for cur.Next(ctx) {
+		var entry model.WaitlistEntry
+		if err := cur.Decode(&entry); err != nil {
+			metrics.ReportFuncError(s.svcTags)
+			return nil, err
+		}
+
+		result = append(result, &entry)
+	}
+
+	return result, nil
+}
+
+func (s *MongoImpl) GetWaitlistEntry(ctx context.Context, guildID string, address model.Address) (*model.WaitlistEntry, error) {
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(s.svcTags)
+
+	guildObjectID, err := primitive.ObjectIDFromHex(guildID)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return nil, fmt.Errorf("cannot parse guildID: %w", err)
+	}
+
+	filter := bson.M{
+		"guild_id":          guildObjectID,
+		"injective_address": address.String(),
+	}
+
+	var entry model.WaitlistEntry
+	if err := s.waitlistCollection.FindOne(ctx, filter).Decode(&entry); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, db.ErrNotFound
+		}
+
+		metrics.ReportFuncError(s.svcTags)
+		return nil, err
+	}
+
+	return &entry, nil
+}
+
+func (s *MongoImpl) RemoveFromWaitlist(ctx context.Context, guildID string, address model.Address) error {
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(s.svcTags)
+
+	guildObjectID, err := primitive.ObjectIDFromHex(guildID)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return fmt.Errorf("cannot parse guildID: %w", err)
+	}
+
+	err = s.withTransaction(ctx, func(sessCtx mongo.SessionContext) (interface{}, error) {
+		entry, err := s.deleteWaitlistEntry(sessCtx, guildObjectID, address)
+		if err != nil {
+			return nil, err
+		}
+
+		if entry == nil {
+			return nil, db.ErrNotFound
+		}
+
+		if entry.InviteCode != "" {
+			return nil, s.releaseInviteCode(sessCtx, guildObjectID, entry.InviteCode)
+		}
+		return nil, nil
+	})
+
+	if err != nil {
+		if err != db.ErrNotFound {
+			metrics.ReportFuncError(s.svcTags)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// deleteWaitlistEntry returns deleted entry, nil if address is not in waitlist
+func (s *MongoImpl) deleteWaitlistEntry(
+	ctx context.Context,
+	guildID primitive.ObjectID,
+	address model.Address,
+) (*model.WaitlistEntry, error) {
+	filter := bson.M{
+		"guild_id":          guildID,
+		"injective_address": address.String(),
+	}
+
+	var entry model.WaitlistEntry
+	err := s.waitlistCollection.FindOneAndDelete(ctx, filter).Decode(&entry)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &entry, nil
+}
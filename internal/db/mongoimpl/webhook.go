@@ -0,0 +1,107 @@
+package mongoimpl
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
+	"github.com/InjectiveLabs/metrics"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func (s *MongoImpl) AddWebhook(ctx context.Context, webhook *model.Webhook) (*primitive.ObjectID, error) {
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(s.svcTags)
+
+	insertOneRes, err := s.webhookCollection.InsertOne(ctx, webhook)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return nil, err
+	}
+
+	objID := insertOneRes.InsertedID.(primitive.ObjectID)
+	return &objID, nil
+}
+
+func (s *MongoImpl) ListWebhooks(ctx context.Context, webhookFilter model.WebhookFilter) (result []*model.Webhook, err error) {
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(s.svcTags)
+
+	filter := bson.M{}
+	if webhookFilter.GuildID != nil {
+		guildObjectID, err := primitive.ObjectIDFromHex(*webhookFilter.GuildID)
+		if err != nil {
+			metrics.ReportFuncError(s.svcTags)
+			return nil, fmt.Errorf("cannot parse guildID: %w", err)
+		}
+		filter["guild_id"] = guildObjectID
+	}
+
+	if webhookFilter.Event != nil {
+		// empty events means subscribe all
+		filter["$or"] = bson.A{
+			bson.M{"events": *webhookFilter.Event},
+			bson.M{"events": bson.M{"$size": 0}},
+			bson.M{"events": nil},
+		}
+	}
+
+	cur, err := s.webhookCollection.Find(ctx, filter)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	for cur.Next(ctx) {
+		var webhook model.Webhook
+		err := cur.Decode(&webhook)
+		if err != nil {
+			metrics.ReportFuncError(s.svcTags)
+			return nil, err
+		}
+
+		result = append(result, &webhook)
+	}
+
+	return result, nil
+}
+
+func (s *MongoImpl) DeleteWebhook(ctx context.Context, webhookID string) error {
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(s.svcTags)
+
+	webhookObjectID, err := primitive.ObjectIDFromHex(webhookID)
+	if err != nil {
+		return fmt.Errorf("cannot parse webhookID: %w", err)
+	}
+
+	deleteRes, err := s.webhookCollection.DeleteOne(ctx, bson.M{"_id": webhookObjectID})
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return err
+	}
+
+	if deleteRes.DeletedCount == 0 {
+		return fmt.Errorf("not found webhook to delete")
+	}
+
+	return nil
+}
+
+func (s *MongoImpl) AddWebhookDelivery(ctx context.Context, delivery *model.WebhookDelivery) error {
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(s.svcTags)
+
+	if _, err := s.webhookDeliveryCollection.InsertOne(ctx, delivery); err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return err
+	}
+
+	return nil
+}
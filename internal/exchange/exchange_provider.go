@@ -17,11 +17,18 @@ import (
 	"github.com/pkg/errors"
 	"github.com/shopspring/decimal"
 	log "github.com/xlab/suplog"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
+var ErrMarketNotFound = errors.New("market not found")
+
 // TODO: improve sdk-go with new functions
 // NOW:
 // - Decided to use a stable release now, v1.31.0
@@ -77,21 +84,28 @@ func NewExchangeProvider(
 		}
 	}
 
-	var conn *grpc.ClientConn
-	var err error
+	// interceptors create client spans and inject trace context, no-op unless tracing is set up
+	dialOpts := []grpc.DialOption{
+		grpc.WithContextDialer(DialerFunc),
+		grpc.WithUnaryInterceptor(otelgrpc.UnaryClientInterceptor()),
+		grpc.WithStreamInterceptor(otelgrpc.StreamClientInterceptor()),
+	}
 	if opts.TLSCert != nil {
-		conn, err = grpc.Dial(exchangeAddr, grpc.WithTransportCredentials(opts.TLSCert), grpc.WithContextDialer(DialerFunc))
+		dialOpts = append(dialOpts, grpc.WithTransportCredentials(opts.TLSCert))
 	} else {
-		conn, err = grpc.Dial(exchangeAddr, grpc.WithInsecure(), grpc.WithContextDialer(DialerFunc))
+		dialOpts = append(dialOpts, grpc.WithInsecure())
 	}
 
+	conn, err := grpc.Dial(exchangeAddr, dialOpts...)
+
 	if err != nil {
 		err := errors.Wrapf(err, "failed to connect to the gRPC: %s", exchangeAddr)
 		return nil, err
 	}
 
 	httpClient := &http.Client{
-		Timeout: 30 * time.Second,
+		Timeout:   30 * time.Second,
+		Transport: otelhttp.NewTransport(http.DefaultTransport),
 	}
 
 	cc := &exchangeProvider{
@@ -150,6 +164,158 @@ func (p *exchangeProvider) GetSubaccountBalances(ctx context.Context, subaccount
 	return result, nil
 }
 
+func (p *exchangeProvider) GetSubaccountHistory(ctx context.Context, subaccount string) (result []*SubaccountTransfer, err error) {
+	doneFn := metrics.ReportFuncTiming(p.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(p.svcTags)
+
+	req := &accountPB.SubaccountHistoryRequest{
+		SubaccountId: subaccount,
+	}
+
+	res, err := p.accountClient.SubaccountHistory(ctx, req)
+	if err != nil {
+		metrics.ReportFuncError(p.svcTags)
+		return nil, fmt.Errorf("subaccount history err: %w", err)
+	}
+
+	for _, t := range res.GetTransfers() {
+		amount, err := decimal.NewFromString(t.GetAmount().GetAmount())
+		if err != nil {
+			metrics.ReportFuncError(p.svcTags)
+			return nil, fmt.Errorf("parse transfer amount err: %w", err)
+		}
+
+		result = append(result, &SubaccountTransfer{
+			TransferType:      t.GetTransferType(),
+			SrcSubaccountID:   t.GetSrcSubaccountId(),
+			SrcAccountAddress: t.GetSrcAccountAddress(),
+			DstSubaccountID:   t.GetDstSubaccountId(),
+			DstAccountAddress: t.GetDstAccountAddress(),
+			Denom:             t.GetAmount().GetDenom(),
+			Amount:            amount,
+			ExecutedAt:        t.GetExecutedAt(),
+		})
+	}
+
+	return result, nil
+}
+
+func (p *exchangeProvider) GetSpotMarket(ctx context.Context, marketID string) (*MarketInfo, error) {
+	doneFn := metrics.ReportFuncTiming(p.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(p.svcTags)
+
+	req := &spotExchangePB.MarketRequest{
+		MarketId: marketID,
+	}
+
+	res, err := p.spotExchangeClient.Market(ctx, req)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, ErrMarketNotFound
+		}
+		metrics.ReportFuncError(p.svcTags)
+		return nil, fmt.Errorf("get spot market err: %w", err)
+	}
+
+	market := res.GetMarket()
+	makerFeeRate, err := decimal.NewFromString(market.GetMakerFeeRate())
+	if err != nil {
+		metrics.ReportFuncError(p.svcTags)
+		return nil, fmt.Errorf("parse maker fee rate err: %w", err)
+	}
+
+	takerFeeRate, err := decimal.NewFromString(market.GetTakerFeeRate())
+	if err != nil {
+		metrics.ReportFuncError(p.svcTags)
+		return nil, fmt.Errorf("parse taker fee rate err: %w", err)
+	}
+
+	result := &MarketInfo{
+		MarketID:     market.GetMarketId(),
+		MarketStatus: market.GetMarketStatus(),
+		BaseDenom:    market.GetBaseDenom(),
+		QuoteDenom:   market.GetQuoteDenom(),
+		MakerFeeRate: makerFeeRate,
+		TakerFeeRate: takerFeeRate,
+	}
+
+	if meta := market.GetBaseTokenMeta(); meta != nil {
+		result.BaseTokenMeta = &TokenMeta{
+			Name:      meta.GetName(),
+			Address:   meta.GetAddress(),
+			Symbol:    meta.GetSymbol(),
+			Decimals:  int(meta.GetDecimals()),
+			UpdatedAt: meta.GetUpdatedAt(),
+		}
+	}
+
+	if meta := market.GetQuoteTokenMeta(); meta != nil {
+		result.QuoteTokenMeta = &TokenMeta{
+			Name:      meta.GetName(),
+			Address:   meta.GetAddress(),
+			Symbol:    meta.GetSymbol(),
+			Decimals:  int(meta.GetDecimals()),
+			UpdatedAt: meta.GetUpdatedAt(),
+		}
+	}
+
+	return result, nil
+}
+
+func (p *exchangeProvider) GetDerivativeMarket(ctx context.Context, marketID string) (*MarketInfo, error) {
+	doneFn := metrics.ReportFuncTiming(p.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(p.svcTags)
+
+	req := &derivativeExchangePB.MarketRequest{
+		MarketId: marketID,
+	}
+
+	res, err := p.derivativeExchangeClient.Market(ctx, req)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, ErrMarketNotFound
+		}
+		metrics.ReportFuncError(p.svcTags)
+		return nil, fmt.Errorf("get derivative market err: %w", err)
+	}
+
+	market := res.GetMarket()
+	makerFeeRate, err := decimal.NewFromString(market.GetMakerFeeRate())
+	if err != nil {
+		metrics.ReportFuncError(p.svcTags)
+		return nil, fmt.Errorf("parse maker fee rate err: %w", err)
+	}
+
+	takerFeeRate, err := decimal.NewFromString(market.GetTakerFeeRate())
+	if err != nil {
+		metrics.ReportFuncError(p.svcTags)
+		return nil, fmt.Errorf("parse taker fee rate err: %w", err)
+	}
+
+	result := &MarketInfo{
+		MarketID:     market.GetMarketId(),
+		MarketStatus: market.GetMarketStatus(),
+		QuoteDenom:   market.GetQuoteDenom(),
+		MakerFeeRate: makerFeeRate,
+		TakerFeeRate: takerFeeRate,
+	}
+
+	if meta := market.GetQuoteTokenMeta(); meta != nil {
+		result.QuoteTokenMeta = &TokenMeta{
+			Name:      meta.GetName(),
+			Address:   meta.GetAddress(),
+			Symbol:    meta.GetSymbol(),
+			Decimals:  int(meta.GetDecimals()),
+			UpdatedAt: meta.GetUpdatedAt(),
+		}
+	}
+
+	return result, nil
+}
+
 func (p *exchangeProvider) GetSpotOrders(ctx context.Context, marketIDs []string, subaccount string) (result []*SpotOrder, err error) {
 	doneFn := metrics.ReportFuncTiming(p.svcTags)
 	defer doneFn()
@@ -248,11 +414,35 @@ func (p *exchangeProvider) GetDerivativeOrders(ctx context.Context, marketIDs []
 			return nil, fmt.Errorf("parse margin err: %w", err)
 		}
 
+		price, err := decimal.NewFromString(o.GetPrice())
+		if err != nil {
+			metrics.ReportFuncError(p.svcTags)
+			return nil, fmt.Errorf("parse price err: %w", err)
+		}
+
+		quantity, err := decimal.NewFromString(o.GetQuantity())
+		if err != nil {
+			metrics.ReportFuncError(p.svcTags)
+			return nil, fmt.Errorf("parse quantity err: %w", err)
+		}
+
+		unfilledQuantity, err := decimal.NewFromString(o.GetUnfilledQuantity())
+		if err != nil {
+			metrics.ReportFuncError(p.svcTags)
+			return nil, fmt.Errorf("parse unfilled quantity err: %w", err)
+		}
+
 		result = append(result, &DerivativeOrder{
 			MarketID:     o.GetMarketId(),
 			OrderHash:    o.GetOrderHash(),
 			FeeRecipient: o.GetFeeRecipient(),
-			Margin:       margin,
+			OrderSide:    o.GetOrderSide(),
+			IsReduceOnly: o.GetIsReduceOnly(),
+
+			Margin:           margin,
+			Price:            price,
+			Quantity:         quantity,
+			UnfilledQuantity: unfilledQuantity,
 		})
 	}
 
@@ -300,13 +490,145 @@ func (p *exchangeProvider) GetPositions(ctx context.Context, subaccount string)
 			return nil, fmt.Errorf("parse mark price err: %w", err)
 		}
 
+		liquidationPrice := decimal.Zero
+		if pos.GetLiquidationPrice() != "" {
+			liquidationPrice, err = decimal.NewFromString(pos.GetLiquidationPrice())
+			if err != nil {
+				metrics.ReportFuncError(p.svcTags)
+				return nil, fmt.Errorf("parse liquidation price err: %w", err)
+			}
+		}
+
 		result = append(result, &DerivativePosition{
-			MarketID:   pos.GetMarketId(),
-			Direction:  pos.GetDirection(),
-			Quantity:   quantity,
-			Margin:     margin,
-			EntryPrice: entryPrice,
-			MarkPrice:  markPrice,
+			Ticker:           pos.GetTicker(),
+			MarketID:         pos.GetMarketId(),
+			Direction:        pos.GetDirection(),
+			Quantity:         quantity,
+			Margin:           margin,
+			EntryPrice:       entryPrice,
+			MarkPrice:        markPrice,
+			LiquidationPrice: liquidationPrice,
+		})
+	}
+
+	return result, nil
+}
+
+func (p *exchangeProvider) GetSpotTrades(
+	ctx context.Context,
+	marketID string,
+	subaccount string,
+	skip uint64,
+	limit int32,
+) (result []*Trade, err error) {
+	doneFn := metrics.ReportFuncTiming(p.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(p.svcTags)
+
+	req := &spotExchangePB.TradesRequest{
+		MarketId:     marketID,
+		SubaccountId: subaccount,
+		Skip:         skip,
+		Limit:        limit,
+	}
+
+	var header metadata.MD
+	res, err := p.spotExchangeClient.Trades(ctx, req, grpc.Header(&header))
+	if err != nil {
+		metrics.ReportFuncError(p.svcTags)
+		return nil, fmt.Errorf("get spot trades err: %w", err)
+	}
+
+	for _, t := range res.GetTrades() {
+		price, err := decimal.NewFromString(t.GetPrice().GetPrice())
+		if err != nil {
+			metrics.ReportFuncError(p.svcTags)
+			return nil, fmt.Errorf("parse price err: %w", err)
+		}
+
+		quantity, err := decimal.NewFromString(t.GetPrice().GetQuantity())
+		if err != nil {
+			metrics.ReportFuncError(p.svcTags)
+			return nil, fmt.Errorf("parse quantity err: %w", err)
+		}
+
+		fee, err := decimal.NewFromString(t.GetFee())
+		if err != nil {
+			metrics.ReportFuncError(p.svcTags)
+			return nil, fmt.Errorf("parse fee err: %w", err)
+		}
+
+		result = append(result, &Trade{
+			MarketID:      t.GetMarketId(),
+			SubaccountID:  t.GetSubaccountId(),
+			OrderHash:     t.GetOrderHash(),
+			ExecutionType: t.GetTradeExecutionType(),
+			Direction:     t.GetTradeDirection(),
+			Price:         price,
+			Quantity:      quantity,
+			Fee:           fee,
+			ExecutedAt:    t.GetExecutedAt(),
+		})
+	}
+
+	return result, nil
+}
+
+func (p *exchangeProvider) GetDerivativeTrades(
+	ctx context.Context,
+	marketID string,
+	subaccount string,
+	skip uint64,
+	limit int32,
+) (result []*Trade, err error) {
+	doneFn := metrics.ReportFuncTiming(p.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(p.svcTags)
+
+	req := &derivativeExchangePB.TradesRequest{
+		MarketId:     marketID,
+		SubaccountId: subaccount,
+		Skip:         skip,
+		Limit:        limit,
+	}
+
+	var header metadata.MD
+	res, err := p.derivativeExchangeClient.Trades(ctx, req, grpc.Header(&header))
+	if err != nil {
+		metrics.ReportFuncError(p.svcTags)
+		return nil, fmt.Errorf("get derivative trades err: %w", err)
+	}
+
+	for _, t := range res.GetTrades() {
+		price, err := decimal.NewFromString(t.GetPositionDelta().GetExecutionPrice())
+		if err != nil {
+			metrics.ReportFuncError(p.svcTags)
+			return nil, fmt.Errorf("parse execution price err: %w", err)
+		}
+
+		quantity, err := decimal.NewFromString(t.GetPositionDelta().GetExecutionQuantity())
+		if err != nil {
+			metrics.ReportFuncError(p.svcTags)
+			return nil, fmt.Errorf("parse execution quantity err: %w", err)
+		}
+
+		fee, err := decimal.NewFromString(t.GetFee())
+		if err != nil {
+			metrics.ReportFuncError(p.svcTags)
+			return nil, fmt.Errorf("parse fee err: %w", err)
+		}
+
+		result = append(result, &Trade{
+			MarketID:      t.GetMarketId(),
+			SubaccountID:  t.GetSubaccountId(),
+			OrderHash:     t.GetOrderHash(),
+			ExecutionType: t.GetTradeExecutionType(),
+			Direction:     t.GetPositionDelta().GetTradeDirection(),
+			IsLiquidation: t.GetIsLiquidation(),
+			Price:         price,
+			Quantity:      quantity,
+			Fee:           fee,
+			ExecutedAt:    t.GetExecutedAt(),
 		})
 	}
 
@@ -323,7 +645,7 @@ func (p *exchangeProvider) GetGrants(ctx context.Context, granter, grantee strin
 		"%s/cosmos/authz/v1beta1/grants?granter=%s&grantee=%s&pagination.limit=100",
 		p.lcdAddr, granter, grantee,
 	)
-	resp, err := p.httpClient.Get(url)
+	resp, err := p.get(ctx, url)
 	if err != nil {
 		metrics.ReportFuncError(p.svcTags)
 		return nil, fmt.Errorf("request err: %w", err)
@@ -361,7 +683,7 @@ func (p *exchangeProvider) GetPriceUSD(ctx context.Context, coinIDs []string) ([
 		"%s/asset-price/v1/coin/price?coinIds=%s&currency=usd",
 		p.assetPriceAddr, coinList,
 	)
-	resp, err := p.httpClient.Get(url)
+	resp, err := p.get(ctx, url)
 	if err != nil {
 		metrics.ReportFuncError(p.svcTags)
 		return nil, fmt.Errorf("request err: %w", err)
@@ -396,7 +718,7 @@ func (p *exchangeProvider) GetBankBalance(ctx context.Context, address string) (
 		"%s/cosmos/bank/v1beta1/balances/%s?pagination.limit=1000",
 		p.lcdAddr, address,
 	)
-	resp, err := p.httpClient.Get(url)
+	resp, err := p.get(ctx, url)
 	if err != nil {
 		metrics.ReportFuncError(p.svcTags)
 		return nil, fmt.Errorf("request err: %w", err)
@@ -423,6 +745,55 @@ func (p *exchangeProvider) GetBankBalance(ctx context.Context, address string) (
 	return &res, nil
 }
 
+// CheckExchangeConn returns error when exchange grpc connection is not usable
+func (p *exchangeProvider) CheckExchangeConn(ctx context.Context) error {
+	state := p.conn.GetState()
+	switch state {
+	case connectivity.Ready:
+		return nil
+	case connectivity.Idle:
+		// connection is lazily established, trigger it for next check
+		p.conn.Connect()
+		return nil
+	default:
+		return fmt.Errorf("exchange grpc connection state: %s", state.String())
+	}
+}
+
+func (p *exchangeProvider) CheckLCD(ctx context.Context) error {
+	return p.checkReachable(ctx, fmt.Sprintf("%s/cosmos/base/tendermint/v1beta1/node_info", p.lcdAddr))
+}
+
+func (p *exchangeProvider) CheckAssetPrice(ctx context.Context) error {
+	return p.checkReachable(ctx, fmt.Sprintf(
+		"%s/asset-price/v1/coin/price?coinIds=injective-protocol&currency=usd", p.assetPriceAddr,
+	))
+}
+
+// get binds request to ctx so that cancellation and trace context are propagated
+func (p *exchangeProvider) get(ctx context.Context, url string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("new request err: %w", err)
+	}
+
+	return p.httpClient.Do(req)
+}
+
+func (p *exchangeProvider) checkReachable(ctx context.Context, url string) error {
+	resp, err := p.get(ctx, url)
+	if err != nil {
+		return fmt.Errorf("request err: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("response bad status: %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
 func (p *exchangeProvider) GetExchangeConn() *grpc.ClientConn {
 	return p.conn
 }
@@ -0,0 +1,117 @@
+package exchange
+
+import (
+	"context"
+	"time"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/prom"
+	"google.golang.org/grpc"
+)
+
+// instrumentedProvider reports latency and errors of each DataProvider call to prometheus
+type instrumentedProvider struct {
+	provider DataProvider
+}
+
+func NewInstrumentedProvider(provider DataProvider) DataProvider {
+	return &instrumentedProvider{provider: provider}
+}
+
+func observe(method string, start time.Time, err error) {
+	prom.UpstreamCallDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+	if err != nil {
+		prom.UpstreamCallErrors.WithLabelValues(method).Inc()
+	}
+}
+
+func (p *instrumentedProvider) GetSubaccountBalances(ctx context.Context, subaccount string) (result []*Balance, err error) {
+	defer func(start time.Time) { observe("GetSubaccountBalances", start, err) }(time.Now())
+	return p.provider.GetSubaccountBalances(ctx, subaccount)
+}
+
+func (p *instrumentedProvider) GetSpotOrders(ctx context.Context, marketIDs []string, subaccount string) (result []*SpotOrder, err error) {
+	defer func(start time.Time) { observe("GetSpotOrders", start, err) }(time.Now())
+	return p.provider.GetSpotOrders(ctx, marketIDs, subaccount)
+}
+
+func (p *instrumentedProvider) GetDerivativeOrders(ctx context.Context, marketIDs []string, subaccount string) (result []*DerivativeOrder, err error) {
+	defer func(start time.Time) { observe("GetDerivativeOrders", start, err) }(time.Now())
+	return p.provider.GetDerivativeOrders(ctx, marketIDs, subaccount)
+}
+
+func (p *instrumentedProvider) GetPositions(ctx context.Context, subaccount string) (result []*DerivativePosition, err error) {
+	defer func(start time.Time) { observe("GetPositions", start, err) }(time.Now())
+	return p.provider.GetPositions(ctx, subaccount)
+}
+
+func (p *instrumentedProvider) GetSubaccountHistory(ctx context.Context, subaccount string) (result []*SubaccountTransfer, err error) {
+	defer func(start time.Time) { observe("GetSubaccountHistory", start, err) }(time.Now())
+	return p.provider.GetSubaccountHistory(ctx, subaccount)
+}
+
+func (p *instrumentedProvider) GetSpotMarket(ctx context.Context, marketID string) (result *MarketInfo, err error) {
+	defer func(start time.Time) { observe("GetSpotMarket", start, err) }(time.Now())
+	return p.provider.GetSpotMarket(ctx, marketID)
+}
+
+func (p *instrumentedProvider) GetDerivativeMarket(ctx context.Context, marketID string) (result *MarketInfo, err error) {
+	defer func(start time.Time) { observe("GetDerivativeMarket", start, err) }(time.Now())
+	return p.provider.GetDerivativeMarket(ctx, marketID)
+}
+
+func (p *instrumentedProvider) GetSpotTrades(
+	ctx context.Context,
+	marketID string,
+	subaccount string,
+	skip uint64,
+	limit int32,
+) (result []*Trade, err error) {
+	defer func(start time.Time) { observe("GetSpotTrades", start, err) }(time.Now())
+	return p.provider.GetSpotTrades(ctx, marketID, subaccount, skip, limit)
+}
+
+func (p *instrumentedProvider) GetDerivativeTrades(
+	ctx context.Context,
+	marketID string,
+	subaccount string,
+	skip uint64,
+	limit int32,
+) (result []*Trade, err error) {
+	defer func(start time.Time) { observe("GetDerivativeTrades", start, err) }(time.Now())
+	return p.provider.GetDerivativeTrades(ctx, marketID, subaccount, skip, limit)
+}
+
+func (p *instrumentedProvider) GetGrants(ctx context.Context, granter, grantee string) (result *Grants, err error) {
+	defer func(start time.Time) { observe("GetGrants", start, err) }(time.Now())
+	return p.provider.GetGrants(ctx, granter, grantee)
+}
+
+func (p *instrumentedProvider) GetBankBalance(ctx context.Context, address string) (result *BankAccountBalances, err error) {
+	defer func(start time.Time) { observe("GetBankBalance", start, err) }(time.Now())
+	return p.provider.GetBankBalance(ctx, address)
+}
+
+func (p *instrumentedProvider) GetPriceUSD(ctx context.Context, coinIDs []string) (result []*CoinPrice, err error) {
+	defer func(start time.Time) { observe("GetPriceUSD", start, err) }(time.Now())
+	return p.provider.GetPriceUSD(ctx, coinIDs)
+}
+
+func (p *instrumentedProvider) CheckExchangeConn(ctx context.Context) error {
+	return p.provider.CheckExchangeConn(ctx)
+}
+
+func (p *instrumentedProvider) CheckLCD(ctx context.Context) error {
+	return p.provider.CheckLCD(ctx)
+}
+
+func (p *instrumentedProvider) CheckAssetPrice(ctx context.Context) error {
+	return p.provider.CheckAssetPrice(ctx)
+}
+
+func (p *instrumentedProvider) GetExchangeConn() *grpc.ClientConn {
+	return p.provider.GetExchangeConn()
+}
+
+func (p *instrumentedProvider) Close() error {
+	return p.provider.Close()
+}
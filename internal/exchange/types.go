@@ -30,18 +30,43 @@ type DerivativeOrder struct {
 	MarketID     string
 	OrderHash    string
 	FeeRecipient string
+	OrderSide    string
+	IsReduceOnly bool
 
-	Margin decimal.Decimal
+	// Margin is margin of whole order quantity
+	Margin           decimal.Decimal
+	Price            decimal.Decimal
+	Quantity         decimal.Decimal
+	UnfilledQuantity decimal.Decimal
 }
 
 // to calculate unrealized pnl
 type DerivativePosition struct {
+	Ticker     string
 	MarketID   string
 	Direction  string
 	Quantity   decimal.Decimal
 	Margin     decimal.Decimal
 	EntryPrice decimal.Decimal
 	MarkPrice  decimal.Decimal
+	// LiquidationPrice is zero when exchange doesn't provide it
+	LiquidationPrice decimal.Decimal
+}
+
+// Trade is a fill of spot or derivative order
+type Trade struct {
+	MarketID      string
+	SubaccountID  string
+	OrderHash     string
+	ExecutionType string
+	Direction     string
+	IsLiquidation bool
+
+	Price    decimal.Decimal
+	Quantity decimal.Decimal
+	Fee      decimal.Decimal
+	// unix milliseconds
+	ExecutedAt int64
 }
 
 type Grants struct {
@@ -102,16 +127,78 @@ type CoinPriceResult struct {
 	Data []*CoinPrice `json:"data"`
 }
 
+const (
+	TransferTypeDeposit  = "deposit"
+	TransferTypeWithdraw = "withdraw"
+	TransferTypeInternal = "internal"
+	TransferTypeExternal = "external"
+)
+
+// SubaccountTransfer is a balance transfer in or out of a subaccount
+type SubaccountTransfer struct {
+	TransferType      string
+	SrcSubaccountID   string
+	SrcAccountAddress string
+	DstSubaccountID   string
+	DstAccountAddress string
+	Denom             string
+	Amount            decimal.Decimal
+	// ExecutedAt is unix timestamp in milliseconds
+	ExecutedAt int64
+}
+
+// market_status values of exchange api
+const (
+	MarketStatusActive     = "active"
+	MarketStatusPaused     = "paused"
+	MarketStatusSuspended  = "suspended"
+	MarketStatusDemolished = "demolished"
+	MarketStatusExpired    = "expired"
+)
+
+type TokenMeta struct {
+	Name      string
+	Address   string
+	Symbol    string
+	Decimals  int
+	UpdatedAt int64
+}
+
+// MarketInfo is metadata of a market, base denom and token meta are empty for derivative markets
+type MarketInfo struct {
+	MarketID       string
+	MarketStatus   string
+	BaseDenom      string
+	BaseTokenMeta  *TokenMeta
+	QuoteDenom     string
+	QuoteTokenMeta *TokenMeta
+	MakerFeeRate   decimal.Decimal
+	TakerFeeRate   decimal.Decimal
+}
+
 type DataProvider interface {
 	GetSubaccountBalances(ctx context.Context, subaccount string) ([]*Balance, error)
 	GetSpotOrders(ctx context.Context, marketIDs []string, subaccount string) ([]*SpotOrder, error)
 	GetDerivativeOrders(ctx context.Context, marketIDs []string, subaccount string) ([]*DerivativeOrder, error)
 	GetPositions(ctx context.Context, subaccount string) ([]*DerivativePosition, error)
+	// trades are returned newest first
+	GetSpotTrades(ctx context.Context, marketID string, subaccount string, skip uint64, limit int32) ([]*Trade, error)
+	GetDerivativeTrades(ctx context.Context, marketID string, subaccount string, skip uint64, limit int32) ([]*Trade, error)
+	// GetSubaccountHistory returns deposits, withdrawals and transfers of a subaccount
+	GetSubaccountHistory(ctx context.Context, subaccount string) ([]*SubaccountTransfer, error)
+	// GetSpotMarket and GetDerivativeMarket return ErrMarketNotFound if market doesn't exist
+	GetSpotMarket(ctx context.Context, marketID string) (*MarketInfo, error)
+	GetDerivativeMarket(ctx context.Context, marketID string) (*MarketInfo, error)
 
 	GetGrants(ctx context.Context, granter, grantee string) (*Grants, error)
 	GetBankBalance(ctx context.Context, address string) (*BankAccountBalances, error)
 	GetPriceUSD(ctx context.Context, coinIDs []string) ([]*CoinPrice, error)
 
+	// health checks
+	CheckExchangeConn(ctx context.Context) error
+	CheckLCD(ctx context.Context) error
+	CheckAssetPrice(ctx context.Context) error
+
 	GetExchangeConn() *grpc.ClientConn
 	// close provider
 	Close() error
@@ -35,6 +35,48 @@ func (m *MockDataProvider) EXPECT() *MockDataProviderMockRecorder {
 	return m.recorder
 }
 
+// CheckAssetPrice mocks base method.
+func (m *MockDataProvider) CheckAssetPrice(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckAssetPrice", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckAssetPrice indicates an expected call of CheckAssetPrice.
+func (mr *MockDataProviderMockRecorder) CheckAssetPrice(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAssetPrice", reflect.TypeOf((*MockDataProvider)(nil).CheckAssetPrice), ctx)
+}
+
+// CheckExchangeConn mocks base method.
+func (m *MockDataProvider) CheckExchangeConn(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckExchangeConn", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckExchangeConn indicates an expected call of CheckExchangeConn.
+func (mr *MockDataProviderMockRecorder) CheckExchangeConn(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckExchangeConn", reflect.TypeOf((*MockDataProvider)(nil).CheckExchangeConn), ctx)
+}
+
+// CheckLCD mocks base method.
+func (m *MockDataProvider) CheckLCD(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckLCD", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckLCD indicates an expected call of CheckLCD.
+func (mr *MockDataProviderMockRecorder) CheckLCD(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckLCD", reflect.TypeOf((*MockDataProvider)(nil).CheckLCD), ctx)
+}
+
 // Close mocks base method.
 func (m *MockDataProvider) Close() error {
 	m.ctrl.T.Helper()
@@ -64,6 +106,21 @@ func (mr *MockDataProviderMockRecorder) GetBankBalance(ctx, address interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBankBalance", reflect.TypeOf((*MockDataProvider)(nil).GetBankBalance), ctx, address)
 }
 
+// GetDerivativeMarket mocks base method.
+func (m *MockDataProvider) GetDerivativeMarket(ctx context.Context, marketID string) (*MarketInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDerivativeMarket", ctx, marketID)
+	ret0, _ := ret[0].(*MarketInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDerivativeMarket indicates an expected call of GetDerivativeMarket.
+func (mr *MockDataProviderMockRecorder) GetDerivativeMarket(ctx, marketID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDerivativeMarket", reflect.TypeOf((*MockDataProvider)(nil).GetDerivativeMarket), ctx, marketID)
+}
+
 // GetDerivativeOrders mocks base method.
 func (m *MockDataProvider) GetDerivativeOrders(ctx context.Context, marketIDs []string, subaccount string) ([]*DerivativeOrder, error) {
 	m.ctrl.T.Helper()
@@ -79,6 +136,21 @@ func (mr *MockDataProviderMockRecorder) GetDerivativeOrders(ctx, marketIDs, suba
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDerivativeOrders", reflect.TypeOf((*MockDataProvider)(nil).GetDerivativeOrders), ctx, marketIDs, subaccount)
 }
 
+// GetDerivativeTrades mocks base method.
+func (m *MockDataProvider) GetDerivativeTrades(ctx context.Context, marketID, subaccount string, skip uint64, limit int32) ([]*Trade, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDerivativeTrades", ctx, marketID, subaccount, skip, limit)
+	ret0, _ := ret[0].([]*Trade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDerivativeTrades indicates an expected call of GetDerivativeTrades.
+func (mr *MockDataProviderMockRecorder) GetDerivativeTrades(ctx, marketID, subaccount, skip, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDerivativeTrades", reflect.TypeOf((*MockDataProvider)(nil).GetDerivativeTrades), ctx, marketID, subaccount, skip, limit)
+}
+
 // GetExchangeConn mocks base method.
 func (m *MockDataProvider) GetExchangeConn() *grpc.ClientConn {
 	m.ctrl.T.Helper()
@@ -138,6 +210,21 @@ func (mr *MockDataProviderMockRecorder) GetPriceUSD(ctx, coinIDs interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPriceUSD", reflect.TypeOf((*MockDataProvider)(nil).GetPriceUSD), ctx, coinIDs)
 }
 
+// GetSpotMarket mocks base method.
+func (m *MockDataProvider) GetSpotMarket(ctx context.Context, marketID string) (*MarketInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSpotMarket", ctx, marketID)
+	ret0, _ := ret[0].(*MarketInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSpotMarket indicates an expected call of GetSpotMarket.
+func (mr *MockDataProviderMockRecorder) GetSpotMarket(ctx, marketID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSpotMarket", reflect.TypeOf((*MockDataProvider)(nil).GetSpotMarket), ctx, marketID)
+}
+
 // GetSpotOrders mocks base method.
 func (m *MockDataProvider) GetSpotOrders(ctx context.Context, marketIDs []string, subaccount string) ([]*SpotOrder, error) {
 	m.ctrl.T.Helper()
@@ -153,6 +240,21 @@ func (mr *MockDataProviderMockRecorder) GetSpotOrders(ctx, marketIDs, subaccount
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSpotOrders", reflect.TypeOf((*MockDataProvider)(nil).GetSpotOrders), ctx, marketIDs, subaccount)
 }
 
+// GetSpotTrades mocks base method.
+func (m *MockDataProvider) GetSpotTrades(ctx context.Context, marketID, subaccount string, skip uint64, limit int32) ([]*Trade, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSpotTrades", ctx, marketID, subaccount, skip, limit)
+	ret0, _ := ret[0].([]*Trade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSpotTrades indicates an expected call of GetSpotTrades.
+func (mr *MockDataProviderMockRecorder) GetSpotTrades(ctx, marketID, subaccount, skip, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSpotTrades", reflect.TypeOf((*MockDataProvider)(nil).GetSpotTrades), ctx, marketID, subaccount, skip, limit)
+}
+
 // GetSubaccountBalances mocks base method.
 func (m *MockDataProvider) GetSubaccountBalances(ctx context.Context, subaccount string) ([]*Balance, error) {
 	m.ctrl.T.Helper()
@@ -167,3 +269,18 @@ func (mr *MockDataProviderMockRecorder) GetSubaccountBalances(ctx, subaccount in
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubaccountBalances", reflect.TypeOf((*MockDataProvider)(nil).GetSubaccountBalances), ctx, subaccount)
 }
+
+// GetSubaccountHistory mocks base method.
+func (m *MockDataProvider) GetSubaccountHistory(ctx context.Context, subaccount string) ([]*SubaccountTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubaccountHistory", ctx, subaccount)
+	ret0, _ := ret[0].([]*SubaccountTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubaccountHistory indicates an expected call of GetSubaccountHistory.
+func (mr *MockDataProviderMockRecorder) GetSubaccountHistory(ctx, subaccount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubaccountHistory", reflect.TypeOf((*MockDataProvider)(nil).GetSubaccountHistory), ctx, subaccount)
+}
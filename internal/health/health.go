@@ -0,0 +1,108 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/exchange"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/version"
+	goahttp "goa.design/goa/v3/http"
+)
+
+const (
+	StatusOK       = "ok"
+	StatusFail     = "fail"
+	StatusDegraded = "degraded"
+
+	checkTimeout = 5 * time.Second
+)
+
+// Check is a named dependency check used by readiness probe.
+// Only critical checks make the service not ready, others report it degraded
+type Check struct {
+	Name     string
+	Check    func(ctx context.Context) error
+	Critical bool
+}
+
+type readyResponse struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks"`
+}
+
+// Handler serves liveness, readiness and build info endpoints
+type Handler struct {
+	checks []Check
+}
+
+func NewHandler(checks ...Check) *Handler {
+	return &Handler{checks: checks}
+}
+
+// Mount registers /healthz, /readyz and /version into mux
+func (h *Handler) Mount(mux goahttp.Muxer) {
+	mux.Handle(http.MethodGet, "/healthz", h.Healthz)
+	mux.Handle(http.MethodGet, "/readyz", h.Readyz)
+	mux.Handle(http.MethodGet, "/version", h.Version)
+}
+
+// Healthz reports the process is up
+func (h *Handler) Healthz(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]string{"status": StatusOK})
+}
+
+// Readyz runs all checks, returns 503 if any critical check fails.
+// Failing non critical checks are reported with degraded status and 200
+func (h *Handler) Readyz(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), checkTimeout)
+	defer cancel()
+
+	res := readyResponse{
+		Status: StatusOK,
+		Checks: make(map[string]string),
+	}
+
+	for _, c := range h.checks {
+		if err := c.Check(ctx); err != nil {
+			if c.Critical {
+				res.Status = StatusFail
+			} else if res.Status == StatusOK {
+				res.Status = StatusDegraded
+			}
+			res.Checks[c.Name] = err.Error()
+			continue
+		}
+		res.Checks[c.Name] = StatusOK
+	}
+
+	code := http.StatusOK
+	if res.Status == StatusFail {
+		code = http.StatusServiceUnavailable
+	}
+	writeJSON(w, code, res)
+}
+
+// Version returns build info
+func (h *Handler) Version(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, version.GetInfo())
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// ServiceChecks returns checks of dependencies which guilds services rely on.
+// lcd and asset price are external apis, their outages don't take the service out of rotation
+func ServiceChecks(dbSvc db.DBService, provider exchange.DataProvider) []Check {
+	return []Check{
+		{Name: "mongo", Check: dbSvc.Ping, Critical: true},
+		{Name: "exchange_grpc", Check: provider.CheckExchangeConn, Critical: true},
+		{Name: "lcd", Check: provider.CheckLCD},
+		{Name: "asset_price", Check: provider.CheckAssetPrice},
+	}
+}
@@ -0,0 +1,48 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadyz(t *testing.T) {
+	h := NewHandler(
+		Check{Name: "up", Check: func(ctx context.Context) error { return nil }, Critical: true},
+		Check{Name: "down", Check: func(ctx context.Context) error { return errors.New("unreachable") }, Critical: true},
+	)
+
+	rec := httptest.NewRecorder()
+	h.Readyz(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+
+	var res readyResponse
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	assert.Equal(t, StatusFail, res.Status)
+	assert.Equal(t, map[string]string{"up": StatusOK, "down": "unreachable"}, res.Checks)
+
+	rec = httptest.NewRecorder()
+	h.Healthz(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestReadyzDegraded(t *testing.T) {
+	h := NewHandler(
+		Check{Name: "up", Check: func(ctx context.Context) error { return nil }, Critical: true},
+		Check{Name: "external", Check: func(ctx context.Context) error { return errors.New("unreachable") }},
+	)
+
+	rec := httptest.NewRecorder()
+	h.Readyz(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var res readyResponse
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	assert.Equal(t, StatusDegraded, res.Status)
+	assert.Equal(t, map[string]string{"up": StatusOK, "external": "unreachable"}, res.Checks)
+}
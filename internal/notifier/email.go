@@ -0,0 +1,44 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+	"net/smtp"
+	"strings"
+)
+
+type emailNotifier struct {
+	addr string
+	from string
+	to   []string
+}
+
+// NewEmailNotifier sends plain text email through a smtp server (e.g local postfix),
+// no authentication is used
+func NewEmailNotifier(addr, from string, to []string) Notifier {
+	return &emailNotifier{
+		addr: addr,
+		from: from,
+		to:   to,
+	}
+}
+
+func (e *emailNotifier) Name() string {
+	return "email"
+}
+
+func (e *emailNotifier) Notify(ctx context.Context, n *Notification) error {
+	msg := strings.Join([]string{
+		fmt.Sprintf("From: %s", e.from),
+		fmt.Sprintf("To: %s", strings.Join(e.to, ", ")),
+		fmt.Sprintf("Subject: %s", n.Title),
+		"",
+		n.Message,
+	}, "\r\n")
+
+	if err := smtp.SendMail(e.addr, nil, e.from, e.to, []byte(msg)); err != nil {
+		return fmt.Errorf("send mail err: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package notifier
+
+import (
+	"context"
+
+	log "github.com/xlab/suplog"
+)
+
+type logNotifier struct {
+	logger log.Logger
+}
+
+// NewLogNotifier writes notification as a warning log line
+func NewLogNotifier(logger log.Logger) Notifier {
+	return &logNotifier{logger: logger}
+}
+
+func (l *logNotifier) Name() string {
+	return "log"
+}
+
+func (l *logNotifier) Notify(ctx context.Context, n *Notification) error {
+	l.logger.WithFields(log.Fields{
+		"kind":              n.Kind,
+		"guild_id":          n.GuildID,
+		"injective_address": n.InjectiveAddress,
+	}).Warningln(n.Title, ": ", n.Message)
+	return nil
+}
@@ -0,0 +1,94 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/config"
+	log "github.com/xlab/suplog"
+)
+
+const (
+	KindGrantExpiring = "grant_expiring"
+	KindAlertFiring   = "alert_firing"
+	KindAlertResolved = "alert_resolved"
+)
+
+// Notification is the message sent through notifiers
+type Notification struct {
+	Kind             string    `json:"kind"`
+	GuildID          string    `json:"guild_id"`
+	InjectiveAddress string    `json:"injective_address"`
+	Title            string    `json:"title"`
+	Message          string    `json:"message"`
+	CreatedAt        time.Time `json:"created_at"`
+}
+
+type Notifier interface {
+	Notify(ctx context.Context, n *Notification) error
+	Name() string
+}
+
+// multiNotifier fans out a notification to all notifiers,
+// it tries every notifier and returns the last error
+type multiNotifier struct {
+	notifiers []Notifier
+}
+
+func NewMultiNotifier(notifiers ...Notifier) Notifier {
+	return &multiNotifier{notifiers: notifiers}
+}
+
+func (m *multiNotifier) Name() string {
+	return "multi"
+}
+
+func (m *multiNotifier) Notify(ctx context.Context, n *Notification) error {
+	var lastErr error
+	for _, notifier := range m.notifiers {
+		if err := notifier.Notify(ctx, n); err != nil {
+			lastErr = fmt.Errorf("%s notify err: %w", notifier.Name(), err)
+		}
+	}
+	return lastErr
+}
+
+// NewFromConfig builds notifiers from config, returns nil when no notifier is configured
+func NewFromConfig(cfg config.NotifierConfig) Notifier {
+	notifiers := newConfigured(cfg)
+	if len(notifiers) == 0 {
+		return nil
+	}
+	return NewMultiNotifier(notifiers...)
+}
+
+// NewNamedFromConfig returns configured notifiers and a log notifier by name
+func NewNamedFromConfig(cfg config.NotifierConfig, logger log.Logger) map[string]Notifier {
+	result := make(map[string]Notifier)
+	for _, n := range append(newConfigured(cfg), NewLogNotifier(logger)) {
+		result[n.Name()] = n
+	}
+	return result
+}
+
+func newConfigured(cfg config.NotifierConfig) []Notifier {
+	notifiers := make([]Notifier, 0)
+	if cfg.WebhookURL != "" {
+		notifiers = append(notifiers, NewWebhookNotifier(cfg.WebhookURL))
+	}
+
+	if cfg.SlackWebhookURL != "" {
+		notifiers = append(notifiers, NewSlackNotifier(cfg.SlackWebhookURL))
+	}
+
+	if cfg.SMTPAddr != "" && len(cfg.MailTo) > 0 {
+		notifiers = append(notifiers, NewEmailNotifier(cfg.SMTPAddr, cfg.MailFrom, cfg.MailTo))
+	}
+
+	if cfg.TelegramBotToken != "" && cfg.TelegramChatID != "" {
+		notifiers = append(notifiers, NewTelegramNotifier(cfg.TelegramBotToken, cfg.TelegramChatID))
+	}
+
+	return notifiers
+}
@@ -0,0 +1,62 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const telegramAPIURL = "https://api.telegram.org"
+
+type telegramNotifier struct {
+	botToken   string
+	chatID     string
+	httpClient *http.Client
+}
+
+// NewTelegramNotifier sends notification to a telegram chat through bot api
+func NewTelegramNotifier(botToken, chatID string) Notifier {
+	return &telegramNotifier{
+		botToken: botToken,
+		chatID:   chatID,
+		httpClient: &http.Client{
+			Timeout: 10 * time.Second,
+		},
+	}
+}
+
+func (t *telegramNotifier) Name() string {
+	return "telegram"
+}
+
+func (t *telegramNotifier) Notify(ctx context.Context, n *Notification) error {
+	body, err := json.Marshal(map[string]string{
+		"chat_id": t.chatID,
+		"text":    fmt.Sprintf("%s\n%s", n.Title, n.Message),
+	})
+	if err != nil {
+		return fmt.Errorf("marshal message err: %w", err)
+	}
+
+	url := fmt.Sprintf("%s/bot%s/sendMessage", telegramAPIURL, t.botToken)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("new request err: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := t.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("request err: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("response bad status: %d", resp.StatusCode)
+	}
+
+	return nil
+}
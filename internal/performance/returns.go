@@ -0,0 +1,62 @@
+package performance
+
+import (
+	"time"
+)
+
+// Point is portfolio value at a snapshot with net external flow since previous snapshot.
+// Flows are assumed to happen right before the snapshot
+type Point struct {
+	Time    time.Time
+	Value   float64
+	NetFlow float64
+}
+
+// Return is cumulative return from the first point
+type Return struct {
+	// TWR chains sub-period returns, removing effect of flows
+	TWR float64
+	// MWR is modified Dietz return, weighting flows by time they stay invested
+	MWR float64
+}
+
+// Returns computes cumulative returns of points sorted by time ascending.
+// Flow of the first point is ignored as it is the starting value
+func Returns(points []Point) []Return {
+	result := make([]Return, len(points))
+	if len(points) == 0 {
+		return result
+	}
+
+	growth := 1.0
+	for i := 1; i < len(points); i++ {
+		prev, cur := points[i-1], points[i]
+		// sub-period with nothing invested doesn't contribute
+		if prev.Value > 0 {
+			growth *= (cur.Value - cur.NetFlow) / prev.Value
+		}
+		result[i].TWR = growth - 1
+		result[i].MWR = modifiedDietz(points[:i+1])
+	}
+	return result
+}
+
+func modifiedDietz(points []Point) float64 {
+	first, last := points[0], points[len(points)-1]
+	period := last.Time.Sub(first.Time).Seconds()
+	if period <= 0 {
+		return 0
+	}
+
+	netFlow, weightedFlow := 0.0, 0.0
+	for _, p := range points[1:] {
+		netFlow += p.NetFlow
+		weightedFlow += p.NetFlow * last.Time.Sub(p.Time).Seconds() / period
+	}
+
+	invested := first.Value + weightedFlow
+	if invested <= 0 {
+		return 0
+	}
+	return (last.Value - first.Value - netFlow) / invested
+}
@@ -0,0 +1,59 @@
+package performance
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReturns(t *testing.T) {
+	start := time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+	at := func(hours int) time.Time {
+		return start.Add(time.Duration(hours) * time.Hour)
+	}
+
+	testCases := []struct {
+		name        string
+		points      []Point
+		expectedTWR []float64
+		expectedMWR []float64
+	}{
+		{
+			name:        "no flow",
+			points:      []Point{{at(0), 100, 0}, {at(1), 110, 0}, {at(2), 121, 0}},
+			expectedTWR: []float64{0, 0.1, 0.21},
+			expectedMWR: []float64{0, 0.1, 0.21},
+		},
+		{
+			name: "deposit is not a profit",
+			// +10% then 100 deposited, then flat
+			points:      []Point{{at(0), 100, 0}, {at(1), 210, 100}, {at(2), 210, 0}},
+			expectedTWR: []float64{0, 0.1, 0.1},
+			expectedMWR: []float64{0, 0.1, 0.0666666},
+		},
+		{
+			name:        "member leaves",
+			points:      []Point{{at(0), 200, 0}, {at(1), 100, -100}},
+			expectedTWR: []float64{0, 0},
+			expectedMWR: []float64{0, 0},
+		},
+		{
+			name:        "empty start",
+			points:      []Point{{at(0), 0, 0}, {at(1), 100, 100}, {at(2), 150, 0}},
+			expectedTWR: []float64{0, 0, 0.5},
+			expectedMWR: []float64{0, 0, 1},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			returns := Returns(tc.points)
+			assert.Len(t, returns, len(tc.points))
+			for i, r := range returns {
+				assert.InDelta(t, tc.expectedTWR[i], r.TWR, 1e-6, "twr at %d", i)
+				assert.InDelta(t, tc.expectedMWR[i], r.MWR, 1e-6, "mwr at %d", i)
+			}
+		})
+	}
+}
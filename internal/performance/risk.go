@@ -0,0 +1,114 @@
+package performance
+
+import (
+	"math"
+	"sort"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
+	"github.com/shopspring/decimal"
+)
+
+// PeriodReturns computes return of each sub-period between consecutive points sorted by time ascending,
+// flows are excluded. Sub-periods starting with nothing invested are skipped
+func PeriodReturns(points []Point) []float64 {
+	var result []float64
+	for i := 1; i < len(points); i++ {
+		prev, cur := points[i-1], points[i]
+		if prev.Value <= 0 {
+			continue
+		}
+		result = append(result, (cur.Value-cur.NetFlow)/prev.Value-1)
+	}
+	return result
+}
+
+// MaxDrawdown returns the largest peak to trough decline of compounded returns as a positive fraction
+func MaxDrawdown(returns []float64) float64 {
+	growth, peak, result := 1.0, 1.0, 0.0
+	for _, r := range returns {
+		growth *= 1 + r
+		if growth > peak {
+			peak = growth
+		}
+
+		if drawdown := (peak - growth) / peak; drawdown > result {
+			result = drawdown
+		}
+	}
+	return result
+}
+
+// Volatility is sample standard deviation of returns
+func Volatility(returns []float64) float64 {
+	if len(returns) < 2 {
+		return 0
+	}
+
+	mean := mean(returns)
+	variance := 0.0
+	for _, r := range returns {
+		variance += (r - mean) * (r - mean)
+	}
+	return math.Sqrt(variance / float64(len(returns)-1))
+}
+
+// SharpeRatio is mean return over volatility with zero risk free rate, not annualized
+func SharpeRatio(returns []float64) float64 {
+	volatility := Volatility(returns)
+	if volatility == 0 {
+		return 0
+	}
+	return mean(returns) / volatility
+}
+
+func mean(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+
+	total := 0.0
+	for _, v := range values {
+		total += v
+	}
+	return total / float64(len(values))
+}
+
+// PositionsExposure sums usd notional (quantity * mark price) of positions per market, sorted by notional descending.
+// Positions without known quote denom are valued zero
+func PositionsExposure(
+	positions []*model.Position,
+	prices map[string]float64,
+	decimals map[string]int,
+) (decimal.Decimal, []*model.MarketExposure) {
+	total := decimal.Zero
+	notionals := make(map[string]decimal.Decimal)
+	var markets []*model.MarketExposure
+	idToMarket := make(map[string]*model.MarketExposure)
+	for _, p := range positions {
+		quantity, _ := decimal.NewFromString(p.Quantity.String())
+		markPrice, _ := decimal.NewFromString(p.MarkPrice.String())
+		notional := ValueUSD(quantity.Mul(markPrice).Abs(), p.QuoteDenom, prices[p.QuoteDenom], decimals)
+
+		if _, exist := idToMarket[p.MarketID]; !exist {
+			idToMarket[p.MarketID] = &model.MarketExposure{
+				MarketID: p.MarketID,
+				Ticker:   p.Ticker,
+			}
+			markets = append(markets, idToMarket[p.MarketID])
+		}
+		notionals[p.MarketID] = notionals[p.MarketID].Add(notional)
+		total = total.Add(notional)
+	}
+
+	for _, m := range markets {
+		m.NotionalUSD, _ = notionals[m.MarketID].Float64()
+		if total.IsPositive() {
+			m.Share, _ = notionals[m.MarketID].Div(total).Float64()
+		}
+	}
+
+	sort.SliceStable(markets, func(i, j int) bool {
+		return markets[i].NotionalUSD > markets[j].NotionalUSD
+	})
+	return total, markets
+}
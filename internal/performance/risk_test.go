@@ -0,0 +1,56 @@
+package performance
+
+import (
+	"testing"
+	"time"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestRiskMetrics(t *testing.T) {
+	start := time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+	at := func(hours int) time.Time {
+		return start.Add(time.Duration(hours) * time.Hour)
+	}
+
+	// +10%, 100 deposited then -20%, +25%
+	points := []Point{{at(0), 100, 0}, {at(1), 110, 0}, {at(2), 210, 100}, {at(3), 168, 0}, {at(4), 210, 0}}
+	returns := PeriodReturns(points)
+	assert.InDeltaSlice(t, []float64{0.1, 0, -0.2, 0.25}, returns, 1e-9)
+	assert.InDelta(t, 0.2, MaxDrawdown(returns), 1e-9)
+	assert.InDelta(t, 0.188746, Volatility(returns), 1e-6)
+	assert.InDelta(t, 0.198680, SharpeRatio(returns), 1e-6)
+
+	// sub-period with nothing invested is skipped
+	assert.Empty(t, PeriodReturns([]Point{{at(0), 0, 0}, {at(1), 100, 100}}))
+	assert.Equal(t, 0.0, MaxDrawdown(nil))
+	assert.Equal(t, 0.0, SharpeRatio([]float64{0.1}))
+}
+
+func TestPositionsExposure(t *testing.T) {
+	parse := func(s string) primitive.Decimal128 {
+		d, _ := primitive.ParseDecimal128(s)
+		return d
+	}
+
+	positions := []*model.Position{
+		{MarketID: "eth", Ticker: "ETH/USDT PERP", QuoteDenom: "usdt", Quantity: parse("0.5"), MarkPrice: parse("3000000000")},
+		{MarketID: "btc", Ticker: "BTC/USDT PERP", QuoteDenom: "usdt", Quantity: parse("0.1"), MarkPrice: parse("40000000000")},
+		{MarketID: "eth", Ticker: "ETH/USDT PERP", QuoteDenom: "usdt", Quantity: parse("0.5"), MarkPrice: parse("3000000000")},
+		// market not in guild
+		{MarketID: "atom", Ticker: "ATOM/USDT PERP", Quantity: parse("10"), MarkPrice: parse("30000000")},
+	}
+
+	total, markets := PositionsExposure(positions, map[string]float64{"usdt": 1}, map[string]int{"usdt": 6})
+	assert.Equal(t, "7000", total.String())
+	assert.Len(t, markets, 3)
+	assert.Equal(t, "btc", markets[0].MarketID)
+	assert.Equal(t, 4000.0, markets[0].NotionalUSD)
+	assert.InDelta(t, 4.0/7, markets[0].Share, 1e-9)
+	assert.Equal(t, "eth", markets[1].MarketID)
+	assert.Equal(t, 3000.0, markets[1].NotionalUSD)
+	assert.Equal(t, "atom", markets[2].MarketID)
+	assert.Equal(t, 0.0, markets[2].Share)
+}
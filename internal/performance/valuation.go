@@ -0,0 +1,83 @@
+package performance
+
+import (
+	"github.com/InjectiveLabs/injective-guilds-service/internal/config"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
+	"github.com/shopspring/decimal"
+)
+
+const injDecimals = 18
+
+// DenomDecimals returns token decimals of denoms traded in guilds' markets and inj
+func DenomDecimals(guilds ...*model.Guild) map[string]int {
+	result := map[string]int{config.DEMOM_INJ: injDecimals}
+	for _, guild := range guilds {
+		for _, market := range guild.Markets {
+			if market.BaseTokenMeta != nil {
+				result[market.BaseDenom] = market.BaseTokenMeta.Decimals
+			}
+
+			if market.QuoteTokenMeta != nil {
+				result[market.QuoteDenom] = market.QuoteTokenMeta.Decimals
+			}
+		}
+	}
+	return result
+}
+
+// ValueUSD converts base unit amount to usd, stable coins are valued 1$. Denoms without known decimals are valued zero
+func ValueUSD(amount decimal.Decimal, denom string, priceUSD float64, decimals map[string]int) decimal.Decimal {
+	dec, ok := decimals[denom]
+	if !ok {
+		return decimal.Zero
+	}
+
+	if config.StableCoinDenoms[denom] {
+		priceUSD = 1
+	}
+	return amount.Shift(int32(-dec)).Mul(decimal.NewFromFloat(priceUSD))
+}
+
+// PortfolioValueUSD sums trading balances with unrealized pnl and bank balances
+func PortfolioValueUSD(balances []*model.Balance, bankBalances []*model.BankBalance, decimals map[string]int) decimal.Decimal {
+	result := decimal.Zero
+	for _, b := range balances {
+		total, _ := decimal.NewFromString(b.TotalBalance.String())
+		pnl, _ := decimal.NewFromString(b.UnrealizedPNL.String())
+		result = result.Add(ValueUSD(total.Add(pnl), b.Denom, b.PriceUSD, decimals))
+	}
+
+	for _, b := range bankBalances {
+		balance, _ := decimal.NewFromString(b.Balance.String())
+		result = result.Add(ValueUSD(balance, b.Denom, b.PriceUSD, decimals))
+	}
+	return result
+}
+
+// CashFlowsValueUSD values flows with denom prices of the snapshot holding them
+func CashFlowsValueUSD(
+	flows []*model.CashFlow,
+	balances []*model.Balance,
+	bankBalances []*model.BankBalance,
+	decimals map[string]int,
+) decimal.Decimal {
+	if len(flows) == 0 {
+		return decimal.Zero
+	}
+
+	prices := make(map[string]float64)
+	for _, b := range balances {
+		prices[b.Denom] = b.PriceUSD
+	}
+
+	for _, b := range bankBalances {
+		prices[b.Denom] = b.PriceUSD
+	}
+
+	result := decimal.Zero
+	for _, f := range flows {
+		amount, _ := decimal.NewFromString(f.Amount.String())
+		result = result.Add(ValueUSD(amount, f.Denom, prices[f.Denom], decimals))
+	}
+	return result
+}
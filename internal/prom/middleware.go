@@ -0,0 +1,20 @@
+package prom
+
+import (
+	"context"
+	"time"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// EndpointMiddleware observes latency of goa endpoints per method
+func EndpointMiddleware(e goa.Endpoint) goa.Endpoint {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		start := time.Now()
+		res, err := e(ctx, req)
+
+		method, _ := ctx.Value(goa.MethodKey).(string)
+		APIRequestDuration.WithLabelValues(method, StatusOf(err)).Observe(time.Since(start).Seconds())
+		return res, err
+	}
+}
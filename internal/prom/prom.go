@@ -0,0 +1,132 @@
+package prom
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "guilds"
+
+var (
+	registry = prometheus.NewRegistry()
+
+	// APIRequestDuration tracks goa method latency
+	APIRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "api",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of guilds api methods",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "status"})
+
+	// UpstreamCallDuration tracks exchange.DataProvider calls latency
+	UpstreamCallDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "upstream",
+		Name:      "call_duration_seconds",
+		Help:      "Latency of upstream (exchange api, lcd, asset price) calls",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method"})
+
+	UpstreamCallErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "upstream",
+		Name:      "call_errors_total",
+		Help:      "Number of failed upstream calls",
+	}, []string{"method"})
+
+	StreamSubscribers = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "api",
+		Name:      "stream_subscribers",
+		Help:      "Number of open portfolio update streams",
+	})
+
+	CaptureCycleDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "process",
+		Name:      "capture_cycle_duration_seconds",
+		Help:      "Duration of a portfolio capture cycle over all guilds",
+		Buckets:   []float64{1, 5, 10, 30, 60, 120, 300, 600, 1200},
+	})
+
+	MembersCaptured = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "process",
+		Name:      "members_captured_total",
+		Help:      "Number of member portfolio captures",
+	}, []string{"guild_id", "status"})
+
+	MarginHoldDiscrepancies = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "process",
+		Name:      "margin_hold_discrepancies_total",
+		Help:      "Number of subaccount balances whose computed order hold differs from chain hold",
+	}, []string{"denom"})
+
+	Disqualifications = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "process",
+		Name:      "disqualifications_total",
+		Help:      "Number of disqualified members per reason",
+	}, []string{"guild_id", "reason"})
+
+	GuildMemberCount = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "guild",
+		Name:      "member_count",
+		Help:      "Current number of members of a guild",
+	}, []string{"guild_id"})
+
+	GuildInactiveMarkets = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "guild",
+		Name:      "inactive_markets",
+		Help:      "Number of delisted or paused markets of a guild",
+	}, []string{"guild_id"})
+
+	GuildWaitlistLength = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "guild",
+		Name:      "waitlist_length",
+		Help:      "Number of addresses waiting for a slot in a guild",
+	}, []string{"guild_id"})
+)
+
+const (
+	StatusSuccess = "success"
+	StatusFailure = "failure"
+)
+
+func init() {
+	registry.MustRegister(
+		prometheus.NewGoCollector(),
+		prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}),
+		APIRequestDuration,
+		StreamSubscribers,
+		UpstreamCallDuration,
+		UpstreamCallErrors,
+		CaptureCycleDuration,
+		MembersCaptured,
+		Disqualifications,
+		MarginHoldDiscrepancies,
+		GuildMemberCount,
+		GuildInactiveMarkets,
+		GuildWaitlistLength,
+	)
+}
+
+// Handler serves registered metrics in prometheus format
+func Handler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+}
+
+// StatusOf returns metric status label of an error
+func StatusOf(err error) string {
+	if err != nil {
+		return StatusFailure
+	}
+	return StatusSuccess
+}
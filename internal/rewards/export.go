@@ -0,0 +1,127 @@
+package rewards
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	msgMultiSendType = "/cosmos.bank.v1beta1.MsgMultiSend"
+	defaultGasLimit  = "200000"
+)
+
+var ErrNoPayout = errors.New("no payout to export")
+
+// WriteCSV writes one row per member share
+func WriteCSV(w io.Writer, r *Result) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"injective_address", "score", "share", "amount", "denom"}); err != nil {
+		return err
+	}
+
+	for _, s := range r.Shares {
+		row := []string{s.InjectiveAddress, s.Score.String(), s.Share.String(), s.Amount.String(), r.Denom}
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+type Coin struct {
+	Denom  string `json:"denom"`
+	Amount string `json:"amount"`
+}
+
+type MultiSendIO struct {
+	Address string  `json:"address"`
+	Coins   []*Coin `json:"coins"`
+}
+
+type MsgMultiSend struct {
+	Type    string         `json:"@type"`
+	Inputs  []*MultiSendIO `json:"inputs"`
+	Outputs []*MultiSendIO `json:"outputs"`
+}
+
+type TxBody struct {
+	Messages                    []interface{} `json:"messages"`
+	Memo                        string        `json:"memo"`
+	TimeoutHeight               string        `json:"timeout_height"`
+	ExtensionOptions            []interface{} `json:"extension_options"`
+	NonCriticalExtensionOptions []interface{} `json:"non_critical_extension_options"`
+}
+
+type Fee struct {
+	Amount   []*Coin `json:"amount"`
+	GasLimit string  `json:"gas_limit"`
+	Payer    string  `json:"payer"`
+	Granter  string  `json:"granter"`
+}
+
+type AuthInfo struct {
+	SignerInfos []interface{} `json:"signer_infos"`
+	Fee         Fee           `json:"fee"`
+}
+
+// UnsignedTx has the layout of `tx --generate-only` output, ready for `tx sign` by guild master
+type UnsignedTx struct {
+	Body       TxBody   `json:"body"`
+	AuthInfo   AuthInfo `json:"auth_info"`
+	Signatures []string `json:"signatures"`
+}
+
+// MultiSendDraft builds an unsigned tx paying member amounts from guild master address
+func MultiSendDraft(r *Result) (*UnsignedTx, error) {
+	total := decimal.Zero
+	var outputs []*MultiSendIO
+	for _, s := range r.Shares {
+		if !s.Amount.IsPositive() {
+			continue
+		}
+
+		total = total.Add(s.Amount)
+		outputs = append(outputs, &MultiSendIO{
+			Address: s.InjectiveAddress,
+			Coins:   []*Coin{{Denom: r.Denom, Amount: s.Amount.String()}},
+		})
+	}
+
+	if len(outputs) == 0 {
+		return nil, ErrNoPayout
+	}
+
+	msg := &MsgMultiSend{
+		Type: msgMultiSendType,
+		Inputs: []*MultiSendIO{{
+			Address: r.MasterAddress,
+			Coins:   []*Coin{{Denom: r.Denom, Amount: total.String()}},
+		}},
+		Outputs: outputs,
+	}
+
+	return &UnsignedTx{
+		Body: TxBody{
+			Messages:                    []interface{}{msg},
+			Memo:                        fmt.Sprintf("guild %s rewards %s - %s", r.GuildID, r.From.Format(time.RFC3339), r.To.Format(time.RFC3339)),
+			TimeoutHeight:               "0",
+			ExtensionOptions:            []interface{}{},
+			NonCriticalExtensionOptions: []interface{}{},
+		},
+		AuthInfo: AuthInfo{
+			SignerInfos: []interface{}{},
+			Fee: Fee{
+				Amount:   []*Coin{},
+				GasLimit: defaultGasLimit,
+			},
+		},
+		Signatures: []string{},
+	}, nil
+}
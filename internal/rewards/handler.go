@@ -0,0 +1,131 @@
+package rewards
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db"
+	"github.com/shopspring/decimal"
+	log "github.com/xlab/suplog"
+	goahttp "goa.design/goa/v3/http"
+)
+
+const (
+	FormatJSON      = "json"
+	FormatCSV       = "csv"
+	FormatMultiSend = "multisend"
+)
+
+// Handler serves reward computation on admin listener, it must not be exposed publicly
+type Handler struct {
+	calc   *Calculator
+	logger log.Logger
+}
+
+func NewHandler(calc *Calculator, logger log.Logger) *Handler {
+	return &Handler{calc: calc, logger: logger}
+}
+
+// Mount registers /admin/rewards into mux
+func (h *Handler) Mount(mux goahttp.Muxer) {
+	mux.Handle(http.MethodGet, "/admin/rewards", h.Compute)
+}
+
+// Compute query params: guild_id, from, to, formula, exclude_default_member, denom, amount
+// and format (json, csv or multisend)
+func (h *Handler) Compute(w http.ResponseWriter, r *http.Request) {
+	params, format, err := parseQuery(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	result, err := h.calc.Compute(r.Context(), params)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrInvalidParams):
+			writeError(w, http.StatusBadRequest, err)
+		case errors.Is(err, db.ErrNotFound):
+			writeError(w, http.StatusNotFound, err)
+		default:
+			h.logger.WithError(err).Error("compute rewards error")
+			writeError(w, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	switch format {
+	case FormatCSV:
+		w.Header().Set("Content-Type", "text/csv")
+		w.WriteHeader(http.StatusOK)
+		if err := WriteCSV(w, result); err != nil {
+			h.logger.WithError(err).Error("write rewards csv error")
+		}
+	case FormatMultiSend:
+		tx, err := MultiSendDraft(result)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, tx)
+	default:
+		writeJSON(w, http.StatusOK, result)
+	}
+}
+
+func parseQuery(r *http.Request) (params Params, format string, err error) {
+	q := r.URL.Query()
+	params = Params{
+		GuildID: q.Get("guild_id"),
+		Denom:   q.Get("denom"),
+		Amount:  decimal.Zero,
+	}
+
+	if params.From, err = ParseTime(q.Get("from")); err != nil {
+		return params, "", err
+	}
+
+	if params.To, err = ParseTime(q.Get("to")); err != nil {
+		return params, "", err
+	}
+
+	formula := q.Get("formula")
+	if formula == "" {
+		formula = string(FormulaBalance)
+	}
+	if params.Formula, err = ParseFormula(formula); err != nil {
+		return params, "", err
+	}
+
+	if v := q.Get("exclude_default_member"); v != "" {
+		if params.ExcludeDefaultMember, err = strconv.ParseBool(v); err != nil {
+			return params, "", err
+		}
+	}
+
+	if v := q.Get("amount"); v != "" {
+		if params.Amount, err = decimal.NewFromString(v); err != nil {
+			return params, "", err
+		}
+	}
+
+	format = q.Get("format")
+	switch format {
+	case "", FormatJSON, FormatCSV, FormatMultiSend:
+	default:
+		return params, "", errors.New("unsupported format: " + format)
+	}
+	return params, format, nil
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, code int, err error) {
+	writeJSON(w, code, map[string]string{"error": err.Error()})
+}
@@ -0,0 +1,315 @@
+package rewards
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/performance"
+	"github.com/shopspring/decimal"
+)
+
+type Formula string
+
+const (
+	// FormulaBalance scores members by time-weighted usd value of their portfolio snapshots
+	FormulaBalance Formula = "balance"
+	// FormulaVolume scores members by usd trading volume of ingested trades
+	FormulaVolume Formula = "volume"
+	// FormulaPNL scores members by usd portfolio value change excluding cash flows, losses score zero
+	FormulaPNL Formula = "pnl"
+)
+
+var ErrInvalidParams = errors.New("invalid reward params")
+
+func ParseFormula(s string) (Formula, error) {
+	switch f := Formula(s); f {
+	case FormulaBalance, FormulaVolume, FormulaPNL:
+		return f, nil
+	default:
+		return "", fmt.Errorf("%w: unsupported formula %s", ErrInvalidParams, s)
+	}
+}
+
+// ParseTime accepts RFC3339 or date (UTC) layout
+func ParseTime(s string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: cannot parse time %s", ErrInvalidParams, s)
+	}
+	return t, nil
+}
+
+type Params struct {
+	GuildID              string
+	From                 time.Time
+	To                   time.Time
+	Formula              Formula
+	ExcludeDefaultMember bool
+
+	// Denom and Amount (in base unit) of reward pool, payouts are allocated only when Amount is positive
+	Denom  string
+	Amount decimal.Decimal
+}
+
+func (p *Params) Validate() error {
+	if !p.To.After(p.From) {
+		return fmt.Errorf("%w: to must be after from", ErrInvalidParams)
+	}
+
+	if _, err := ParseFormula(string(p.Formula)); err != nil {
+		return err
+	}
+
+	if p.Amount.IsNegative() {
+		return fmt.Errorf("%w: amount must not be negative", ErrInvalidParams)
+	}
+
+	if p.Amount.IsPositive() && p.Denom == "" {
+		return fmt.Errorf("%w: denom is required with amount", ErrInvalidParams)
+	}
+	return nil
+}
+
+type Share struct {
+	InjectiveAddress string          `json:"injective_address"`
+	Score            decimal.Decimal `json:"score"`
+	Share            decimal.Decimal `json:"share"`
+	Amount           decimal.Decimal `json:"amount"`
+}
+
+type Result struct {
+	GuildID       string          `json:"guild_id"`
+	MasterAddress string          `json:"master_address"`
+	From          time.Time       `json:"from"`
+	To            time.Time       `json:"to"`
+	Formula       Formula         `json:"formula"`
+	Denom         string          `json:"denom"`
+	TotalAmount   decimal.Decimal `json:"total_amount"`
+	Shares        []*Share        `json:"shares"`
+}
+
+// Calculator splits a guild reward pool between members for a period
+type Calculator struct {
+	dbSvc db.DBService
+}
+
+func NewCalculator(dbSvc db.DBService) *Calculator {
+	return &Calculator{dbSvc: dbSvc}
+}
+
+func (c *Calculator) Compute(ctx context.Context, params Params) (*Result, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	guild, err := c.dbSvc.GetSingleGuild(ctx, params.GuildID)
+	if err != nil {
+		return nil, fmt.Errorf("get guild err: %w", err)
+	}
+
+	members, err := c.dbSvc.ListGuildMembers(ctx, model.MemberFilter{
+		GuildID: &params.GuildID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list members err: %w", err)
+	}
+
+	var addresses []string
+	for _, m := range members {
+		if params.ExcludeDefaultMember && m.IsDefaultGuildMember {
+			continue
+		}
+		addresses = append(addresses, m.InjectiveAddress.String())
+	}
+
+	var scores map[string]decimal.Decimal
+	switch params.Formula {
+	case FormulaVolume:
+		scores, err = c.volumeScores(ctx, guild, params)
+	default:
+		scores, err = c.portfolioScores(ctx, guild, members, params)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &Result{
+		GuildID:       params.GuildID,
+		MasterAddress: guild.MasterAddress.String(),
+		From:          params.From,
+		To:            params.To,
+		Formula:       params.Formula,
+		Denom:         params.Denom,
+		TotalAmount:   params.Amount,
+		Shares:        allocate(addresses, scores, params.Amount),
+	}, nil
+}
+
+func (c *Calculator) portfolioScores(
+	ctx context.Context,
+	guild *model.Guild,
+	members []*model.GuildMember,
+	params Params,
+) (map[string]decimal.Decimal, error) {
+	decimals := performance.DenomDecimals(guild)
+	scores := make(map[string]decimal.Decimal)
+	for _, m := range members {
+		portfolios, err := c.dbSvc.ListAccountPortfolios(ctx, model.AccountPortfoliosFilter{
+			InjectiveAddress: m.InjectiveAddress,
+			StartTime:        &params.From,
+			EndTime:          &params.To,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list account portfolios err: %w", err)
+		}
+
+		// portfolios are sorted newest first, snapshots taken in another guild are skipped
+		var points []valuePoint
+		for i := len(portfolios) - 1; i >= 0; i-- {
+			p := portfolios[i]
+			if p.GuildID != guild.ID {
+				continue
+			}
+			points = append(points, valuePoint{
+				time:    p.UpdatedAt,
+				value:   performance.PortfolioValueUSD(p.Balances, p.BankBalances, decimals),
+				netFlow: performance.CashFlowsValueUSD(p.CashFlows, p.Balances, p.BankBalances, decimals),
+			})
+		}
+
+		address := m.InjectiveAddress.String()
+		if params.Formula == FormulaPNL {
+			scores[address] = valueChange(points)
+		} else {
+			scores[address] = timeWeightedValue(points, params.From, params.To)
+		}
+	}
+	return scores, nil
+}
+
+func (c *Calculator) volumeScores(ctx context.Context, guild *model.Guild, params Params) (map[string]decimal.Decimal, error) {
+	stats, err := c.dbSvc.GetMemberTradeStats(ctx, model.TradeFilter{
+		GuildID:   &params.GuildID,
+		StartTime: &params.From,
+		EndTime:   &params.To,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get member trade stats err: %w", err)
+	}
+
+	// volume is valued with prices of latest guild snapshot in the period
+	limit := int64(1)
+	portfolios, err := c.dbSvc.ListGuildPortfolios(ctx, model.GuildPortfoliosFilter{
+		GuildID: params.GuildID,
+		EndTime: &params.To,
+		Limit:   &limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list guild portfolios err: %w", err)
+	}
+
+	prices := make(map[string]float64)
+	if len(portfolios) > 0 {
+		for _, b := range portfolios[0].Balances {
+			prices[b.Denom] = b.PriceUSD
+		}
+	}
+
+	decimals := performance.DenomDecimals(guild)
+	scores := make(map[string]decimal.Decimal)
+	for _, s := range stats {
+		volume, _ := decimal.NewFromString(s.Volume.String())
+		scores[s.InjectiveAddress] = scores[s.InjectiveAddress].Add(
+			performance.ValueUSD(volume, s.QuoteDenom, prices[s.QuoteDenom], decimals),
+		)
+	}
+	return scores, nil
+}
+
+type valuePoint struct {
+	time  time.Time
+	value decimal.Decimal
+	// netFlow is external flow since previous point
+	netFlow decimal.Decimal
+}
+
+// timeWeightedValue averages values over [from, to), each value holds until next point.
+// Time before first point counts as zero
+func timeWeightedValue(points []valuePoint, from, to time.Time) decimal.Decimal {
+	period := to.Sub(from)
+	if len(points) == 0 || period <= 0 {
+		return decimal.Zero
+	}
+
+	weighted := decimal.Zero
+	for i, p := range points {
+		start := p.time
+		if start.Before(from) {
+			start = from
+		}
+
+		end := to
+		if i+1 < len(points) && points[i+1].time.Before(to) {
+			end = points[i+1].time
+		}
+
+		if end.After(start) {
+			weighted = weighted.Add(p.value.Mul(decimal.NewFromInt(int64(end.Sub(start)))))
+		}
+	}
+	return weighted.Div(decimal.NewFromInt(int64(period)))
+}
+
+// valueChange returns last minus first value, excluding cash flows in between
+func valueChange(points []valuePoint) decimal.Decimal {
+	if len(points) < 2 {
+		return decimal.Zero
+	}
+
+	result := points[len(points)-1].value.Sub(points[0].value)
+	for _, p := range points[1:] {
+		result = result.Sub(p.netFlow)
+	}
+	return result
+}
+
+// allocate computes share of each address by score, negative scores count as zero.
+// Amounts are rounded down to base unit so total payout never exceeds amount
+func allocate(addresses []string, scores map[string]decimal.Decimal, amount decimal.Decimal) []*Share {
+	total := decimal.Zero
+	for _, addr := range addresses {
+		if score := scores[addr]; score.IsPositive() {
+			total = total.Add(score)
+		}
+	}
+
+	result := make([]*Share, 0, len(addresses))
+	for _, addr := range addresses {
+		s := &Share{
+			InjectiveAddress: addr,
+			Score:            decimal.Max(scores[addr], decimal.Zero),
+			Share:            decimal.Zero,
+			Amount:           decimal.Zero,
+		}
+
+		if total.IsPositive() {
+			s.Share = s.Score.Div(total)
+			s.Amount = amount.Mul(s.Score).Div(total).Floor()
+		}
+		result = append(result, s)
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Score.GreaterThan(result[j].Score)
+	})
+	return result
+}
@@ -0,0 +1,110 @@
+package rewards
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeWeightedValue(t *testing.T) {
+	d := decimal.RequireFromString
+	from := time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(4 * time.Hour)
+
+	testCases := []struct {
+		name     string
+		points   []valuePoint
+		expected string
+	}{
+		{"no snapshot", nil, "0"},
+		{"constant", []valuePoint{{from, d("100"), decimal.Zero}}, "100"},
+		{"joined in the middle", []valuePoint{{from.Add(2 * time.Hour), d("100"), decimal.Zero}}, "50"},
+		{
+			"value changes",
+			[]valuePoint{
+				{from, d("100"), decimal.Zero},
+				{from.Add(time.Hour), d("200"), decimal.Zero},
+				{from.Add(3 * time.Hour), d("0"), decimal.Zero},
+			},
+			"125",
+		},
+		{"snapshot before period", []valuePoint{{from.Add(-time.Hour), d("80"), decimal.Zero}}, "80"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, timeWeightedValue(tc.points, from, to).String())
+		})
+	}
+}
+
+func TestValueChange(t *testing.T) {
+	d := decimal.RequireFromString
+	now := time.Now()
+	points := []valuePoint{
+		{now, d("100"), decimal.Zero},
+		{now.Add(time.Hour), d("250"), d("100")},
+		{now.Add(2 * time.Hour), d("230"), d("-50")},
+	}
+	// 230 - 100 - (100 - 50)
+	assert.Equal(t, "80", valueChange(points).String())
+	assert.Equal(t, "0", valueChange(points[:1]).String())
+}
+
+func TestAllocate(t *testing.T) {
+	d := decimal.RequireFromString
+	scores := map[string]decimal.Decimal{
+		"inj1a": d("300"),
+		"inj1b": d("100"),
+		"inj1c": d("-50"),
+	}
+
+	shares := allocate([]string{"inj1a", "inj1b", "inj1c", "inj1d"}, scores, d("1001"))
+	assert.Len(t, shares, 4)
+	assert.Equal(t, "inj1a", shares[0].InjectiveAddress)
+	assert.Equal(t, "0.75", shares[0].Share.String())
+	assert.Equal(t, "750", shares[0].Amount.String())
+	assert.Equal(t, "250", shares[1].Amount.String())
+	assert.Equal(t, "0", shares[2].Score.String())
+	assert.Equal(t, "0", shares[2].Amount.String())
+	assert.Equal(t, "0", shares[3].Amount.String())
+}
+
+func TestExport(t *testing.T) {
+	d := decimal.RequireFromString
+	result := &Result{
+		GuildID:       "6219f1b4c1c2b0e1a2d3c4f5",
+		MasterAddress: "inj1master",
+		Denom:         "inj",
+		TotalAmount:   d("100"),
+		Shares: []*Share{
+			{InjectiveAddress: "inj1a", Score: d("3"), Share: d("0.75"), Amount: d("75")},
+			{InjectiveAddress: "inj1b", Score: d("1"), Share: d("0.25"), Amount: d("25")},
+			{InjectiveAddress: "inj1c", Score: d("0"), Share: d("0"), Amount: d("0")},
+		},
+	}
+
+	var buf strings.Builder
+	assert.NoError(t, WriteCSV(&buf, result))
+	assert.Equal(t, "injective_address,score,share,amount,denom\ninj1a,3,0.75,75,inj\ninj1b,1,0.25,25,inj\ninj1c,0,0,0,inj\n", buf.String())
+
+	tx, err := MultiSendDraft(result)
+	assert.NoError(t, err)
+
+	msg := tx.Body.Messages[0].(*MsgMultiSend)
+	assert.Equal(t, msgMultiSendType, msg.Type)
+	assert.Equal(t, "inj1master", msg.Inputs[0].Address)
+	assert.Equal(t, "100", msg.Inputs[0].Coins[0].Amount)
+	assert.Len(t, msg.Outputs, 2)
+
+	_, err = json.Marshal(tx)
+	assert.NoError(t, err)
+
+	result.Shares = result.Shares[2:]
+	_, err = MultiSendDraft(result)
+	assert.ErrorIs(t, err, ErrNoPayout)
+}
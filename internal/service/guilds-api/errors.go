@@ -0,0 +1,55 @@
+package guildsapi
+
+import (
+	"errors"
+
+	"github.com/InjectiveLabs/injective-guilds-service/api/errcode"
+	svc "github.com/InjectiveLabs/injective-guilds-service/api/gen/guilds_service"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db"
+)
+
+const (
+	errNameNotFound   = "not_found"
+	errNameInvalidArg = "invalid_arg"
+	errNameInternal   = "internal"
+)
+
+func newAPIError(name, code string, err error, details map[string]string) *svc.APIError {
+	return &svc.APIError{
+		Name:    name,
+		Code:    code,
+		Message: err.Error(),
+		Details: details,
+	}
+}
+
+func makeNotFound(err error) *svc.APIError {
+	return newAPIError(errNameNotFound, errcode.NotFound, err, nil)
+}
+
+func makeInvalidArg(err error) *svc.APIError {
+	return newAPIError(errNameInvalidArg, errcode.InvalidArgument, err, nil)
+}
+
+func makeInternal(err error) *svc.APIError {
+	return newAPIError(errNameInternal, errcode.Internal, err, nil)
+}
+
+// makeMembershipError maps db errors of adding or moving a member
+func makeMembershipError(err error) *svc.APIError {
+	switch {
+	case errors.Is(err, db.ErrNotFound):
+		return newAPIError(errNameNotFound, errcode.MemberNotFound, errors.New("member not found"), nil)
+	case errors.Is(err, db.ErrMemberExceedCap):
+		return newAPIError(errNameInvalidArg, errcode.GuildFull, db.ErrMemberExceedCap, nil)
+	case errors.Is(err, db.ErrAlreadyMember):
+		return newAPIError(errNameInvalidArg, errcode.AlreadyMember, db.ErrAlreadyMember, nil)
+	case errors.Is(err, db.ErrGuildArchived):
+		return newAPIError(errNameInvalidArg, errcode.GuildArchived, db.ErrGuildArchived, nil)
+	case errors.Is(err, db.ErrInvalidInviteCode):
+		return newAPIError(errNameInvalidArg, errcode.InvalidInviteCode, db.ErrInvalidInviteCode, nil)
+	case errors.Is(err, db.ErrDefaultMember):
+		return makeInvalidArg(db.ErrDefaultMember)
+	}
+	return makeInternal(err)
+}
@@ -0,0 +1,119 @@
+package guildsapi
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/InjectiveLabs/injective-guilds-service/api/errcode"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db"
+	guildsprocess "github.com/InjectiveLabs/injective-guilds-service/internal/service/guilds-process"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMakeMembershipError(t *testing.T) {
+	testCases := []struct {
+		err  error
+		name string
+		code string
+	}{
+		{db.ErrNotFound, errNameNotFound, errcode.MemberNotFound},
+		{db.ErrMemberExceedCap, errNameInvalidArg, errcode.GuildFull},
+		{db.ErrAlreadyMember, errNameInvalidArg, errcode.AlreadyMember},
+		{db.ErrGuildArchived, errNameInvalidArg, errcode.GuildArchived},
+		{db.ErrInvalidInviteCode, errNameInvalidArg, errcode.InvalidInviteCode},
+		{db.ErrDefaultMember, errNameInvalidArg, errcode.InvalidArgument},
+		// wrapped errors are matched too
+		{fmt.Errorf("add member: %w", db.ErrMemberExceedCap), errNameInvalidArg, errcode.GuildFull},
+		{errors.New("connection reset"), errNameInternal, errcode.Internal},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.err.Error(), func(t *testing.T) {
+			assertAPIError(t, makeMembershipError(tc.err), tc.name, tc.code)
+		})
+	}
+}
+
+func TestMakeQualificationResult(t *testing.T) {
+	expiredAt := time.Date(2022, 3, 1, 12, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		desc        string
+		unqualified *guildsprocess.Unqualified
+		code        string
+		details     map[string]string
+	}{
+		{
+			desc: "insufficient balance",
+			unqualified: &guildsprocess.Unqualified{
+				Reason: guildsprocess.QualifyReasonInsufficientBalance,
+				Balance: &guildsprocess.UnmetRequirement{
+					Denom:        "inj",
+					BalanceUSD:   decimal.NewFromFloat(12.5),
+					MinAmountUSD: 100,
+				},
+			},
+			code: errcode.InsufficientBalance,
+			details: map[string]string{
+				"denom":          "inj",
+				"balance_usd":    "12.5",
+				"min_amount_usd": "100.00",
+			},
+		},
+		{
+			desc: "open orders",
+			unqualified: &guildsprocess.Unqualified{
+				Reason:       guildsprocess.QualifyReasonOpenOrders,
+				SubaccountID: "0x01",
+				MarketType:   guildsprocess.MarketTypeSpot,
+			},
+			code:    errcode.OpenOrders,
+			details: map[string]string{"subaccount_id": "0x01", "market_type": "spot"},
+		},
+		{
+			desc: "open positions",
+			unqualified: &guildsprocess.Unqualified{
+				Reason:       guildsprocess.QualifyReasonOpenPositions,
+				SubaccountID: "0x01",
+			},
+			code:    errcode.OpenPositions,
+			details: map[string]string{"subaccount_id": "0x01"},
+		},
+		{
+			desc: "missing grant",
+			unqualified: &guildsprocess.Unqualified{
+				Reason: guildsprocess.QualifyReasonMissingGrant,
+				Msg:    "/injective.exchange.v1beta1.MsgDeposit",
+			},
+			code:    errcode.MissingGrant,
+			details: map[string]string{"msg": "/injective.exchange.v1beta1.MsgDeposit"},
+		},
+		{
+			desc: "expired grant",
+			unqualified: &guildsprocess.Unqualified{
+				Reason:    guildsprocess.QualifyReasonMissingGrant,
+				Msg:       "/injective.exchange.v1beta1.MsgDeposit",
+				ExpiredAt: &expiredAt,
+			},
+			code:    errcode.MissingGrant,
+			details: map[string]string{"msg": "/injective.exchange.v1beta1.MsgDeposit", "expired_at": "2022-03-01T12:00:00Z"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			result := makeQualificationResult(tc.unqualified)
+			assert.Equal(t, StatusUnqualified, result.status)
+
+			apiErr := result.apiError()
+			assert.Equal(t, errNameInvalidArg, apiErr.Name)
+			assert.Equal(t, tc.code, apiErr.Code)
+			assert.Equal(t, tc.unqualified.String(), apiErr.Message)
+			assert.Equal(t, tc.details, apiErr.Details)
+		})
+	}
+
+	assert.Equal(t, StatusQualified, makeQualificationResult(nil).status)
+}
@@ -6,25 +6,33 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/InjectiveLabs/injective-guilds-service/api/errcode"
 	svc "github.com/InjectiveLabs/injective-guilds-service/api/gen/guilds_service"
 	"github.com/InjectiveLabs/injective-guilds-service/internal/config"
 	"github.com/InjectiveLabs/injective-guilds-service/internal/db"
 	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
 	"github.com/InjectiveLabs/injective-guilds-service/internal/exchange"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/performance"
 	guildsprocess "github.com/InjectiveLabs/injective-guilds-service/internal/service/guilds-process"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/webhook"
 	metrics "github.com/InjectiveLabs/metrics"
 	cosmtypes "github.com/cosmos/cosmos-sdk/types"
-	"github.com/shopspring/decimal"
 	log "github.com/xlab/suplog"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 const (
-	expirationTimeLayout = "2006-01-02T15:04:05Z"
-	ActionEnterGuild     = "enter-guild"
-	ActionLeaveGuild     = "leave-guild"
+	ActionEnterGuild = "enter-guild"
+	ActionLeaveGuild = "leave-guild"
 
 	StatusQualified   = "qualified"
 	StatusUnqualified = "unqualified"
+
+	// maxMemberSubaccounts limits non-default subaccounts a member can register
+	maxMemberSubaccounts = 10
+
+	defaultTradesLimit = 100
+	maxTradesLimit     = 1000
 )
 
 type GuildsAPI = svc.Service
@@ -32,19 +40,75 @@ type GuildsAPI = svc.Service
 type qualificationResult struct {
 	status string
 	detail string
+	// code and details of unqualified result are returned in invalid_arg error
+	code    string
+	details map[string]string
+}
+
+func (r *qualificationResult) apiError() *svc.APIError {
+	return newAPIError(errNameInvalidArg, r.code, errors.New(r.detail), r.details)
+}
+
+// makeQualificationResult maps the unmet requirement to error code and details, nil is qualified
+func makeQualificationResult(unqualified *guildsprocess.Unqualified) *qualificationResult {
+	if unqualified == nil {
+		return &qualificationResult{status: StatusQualified}
+	}
+
+	result := &qualificationResult{
+		status: StatusUnqualified,
+		detail: unqualified.String(),
+	}
+
+	switch unqualified.Reason {
+	case guildsprocess.QualifyReasonInsufficientBalance:
+		result.code = errcode.InsufficientBalance
+		result.details = map[string]string{
+			"denom":          unqualified.Balance.Denom,
+			"balance_usd":    unqualified.Balance.BalanceUSD.String(),
+			"min_amount_usd": fmt.Sprintf("%.2f", unqualified.Balance.MinAmountUSD),
+		}
+	case guildsprocess.QualifyReasonOpenOrders:
+		result.code = errcode.OpenOrders
+		result.details = map[string]string{
+			"subaccount_id": unqualified.SubaccountID,
+			"market_type":   unqualified.MarketType,
+		}
+	case guildsprocess.QualifyReasonOpenPositions:
+		result.code = errcode.OpenPositions
+		result.details = map[string]string{"subaccount_id": unqualified.SubaccountID}
+	case guildsprocess.QualifyReasonMissingGrant:
+		result.code = errcode.MissingGrant
+		result.details = map[string]string{"msg": unqualified.Msg}
+		if unqualified.ExpiredAt != nil {
+			result.details["expired_at"] = unqualified.ExpiredAt.Format(time.RFC3339)
+		}
+	default:
+		result.code = errcode.InvalidArgument
+	}
+	return result
 }
+
 type service struct {
 	svc.Service
 	exchangeProvider exchange.DataProvider
 	dbSvc            db.DBService
 	portfolioHelper  *guildsprocess.PortfolioHelper
+	dispatcher       *webhook.Dispatcher
+	portfolioUpdates *PortfolioUpdateHub
 	logger           log.Logger
 	svcTags          metrics.Tags
 	// TODO: Load as env var
 	grants []string
 }
 
-func NewService(ctx context.Context, dbSvc db.DBService, exchangeProvider exchange.DataProvider) (GuildsAPI, error) {
+func NewService(
+	ctx context.Context,
+	dbSvc db.DBService,
+	exchangeProvider exchange.DataProvider,
+	dispatcher *webhook.Dispatcher,
+	portfolioUpdates *PortfolioUpdateHub,
+) (GuildsAPI, error) {
 	logger := log.WithField("svc", "guilds_api")
 	svcTags := metrics.Tags{
 		"svc": "guilds_api",
@@ -59,6 +123,8 @@ func NewService(ctx context.Context, dbSvc db.DBService, exchangeProvider exchan
 		dbSvc:            dbSvc,
 		exchangeProvider: exchangeProvider,
 		portfolioHelper:  helper,
+		dispatcher:       dispatcher,
+		portfolioUpdates: portfolioUpdates,
 		logger:           logger,
 		grants:           config.GrantRequirements,
 		svcTags:          svcTags,
@@ -66,16 +132,17 @@ func NewService(ctx context.Context, dbSvc db.DBService, exchangeProvider exchan
 }
 
 // TODO: Refine error handling
-func (s *service) GetAllGuilds(ctx context.Context) (res *svc.GetAllGuildsResult, err error) {
+func (s *service) GetAllGuilds(ctx context.Context, payload *svc.GetAllGuildsPayload) (res *svc.GetAllGuildsResult, err error) {
 	doneFn := metrics.ReportFuncTiming(s.svcTags)
 	defer doneFn()
 	metrics.ReportFuncCall(s.svcTags)
 
-	guilds, err := s.dbSvc.ListAllGuilds(ctx)
+	includeArchived := payload.IncludeArchived != nil && *payload.IncludeArchived
+	guilds, err := s.dbSvc.ListAllGuilds(ctx, includeArchived)
 	if err != nil {
 		metrics.ReportFuncError(s.svcTags)
 		s.logger.WithError(err).Error("list all guilds error")
-		return nil, svc.MakeInternal(err)
+		return nil, makeInternal(err)
 	}
 
 	var (
@@ -92,7 +159,7 @@ func (s *service) GetAllGuilds(ctx context.Context) (res *svc.GetAllGuildsResult
 			metrics.ReportFuncError(s.svcTags)
 			s.logger.WithError(err).
 				WithField("guild_id", g.ID.Hex()).Error("list all guilds: default member error")
-			return nil, svc.MakeInternal(err)
+			return nil, makeInternal(err)
 		}
 
 		var portfolio model.GuildPortfolio
@@ -111,13 +178,13 @@ func (s *service) GetAllGuilds(ctx context.Context) (res *svc.GetAllGuildsResult
 			metrics.ReportFuncError(s.svcTags)
 			s.logger.WithError(err).
 				WithField("guild_id", g.ID.Hex()).Error("list all guilds: default member error")
-			return nil, svc.MakeInternal(err)
+			return nil, makeInternal(err)
 		}
 
 		if len(defaultMember) == 0 {
 			metrics.ReportFuncError(s.svcTags)
 			s.logger.WithField("guild_id", g.ID.Hex()).Error("list all guilds: no default member")
-			return nil, svc.MakeInternal(errors.New("guild has no default member"))
+			return nil, makeInternal(errors.New("guild has no default member"))
 		}
 
 		result = append(result, modelGuildToResponse(g, &portfolio, defaultMember[0]))
@@ -131,14 +198,11 @@ func (s *service) GetSingleGuild(ctx context.Context, payload *svc.GetSingleGuil
 	defer doneFn()
 	metrics.ReportFuncCall(s.svcTags)
 
-	guild, err := s.dbSvc.GetSingleGuild(ctx, payload.GuildID)
+	guild, err := s.getGuild(ctx, payload.GuildID)
 	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
 		s.logger.WithError(err).Error("get single guild error")
-		if err == db.ErrNotFound {
-			return nil, svc.MakeNotFound(err)
-		}
-
-		return nil, svc.MakeInternal(err)
+		return nil, err
 	}
 
 	var (
@@ -154,7 +218,7 @@ func (s *service) GetSingleGuild(ctx context.Context, payload *svc.GetSingleGuil
 	if err != nil {
 		metrics.ReportFuncError(s.svcTags)
 		s.logger.WithError(err).Error("list guild portfolio errors")
-		return nil, svc.MakeInternal(err)
+		return nil, makeInternal(err)
 	}
 
 	var portfolio model.GuildPortfolio
@@ -171,13 +235,13 @@ func (s *service) GetSingleGuild(ctx context.Context, payload *svc.GetSingleGuil
 	if err != nil {
 		metrics.ReportFuncError(s.svcTags)
 		s.logger.WithError(err).Error("list guild member error")
-		return nil, svc.MakeInternal(err)
+		return nil, makeInternal(err)
 	}
 
 	if len(defaultMember) == 0 {
 		metrics.ReportFuncError(s.svcTags)
 		s.logger.WithField("guild_id", guildID).Error("no default member")
-		return nil, svc.MakeInternal(errors.New("guild has no default member"))
+		return nil, makeInternal(errors.New("guild has no default member"))
 	}
 
 	return &svc.GetSingleGuildResult{
@@ -191,6 +255,12 @@ func (s *service) GetGuildMembers(ctx context.Context, payload *svc.GetGuildMemb
 	defer doneFn()
 	metrics.ReportFuncCall(s.svcTags)
 
+	if _, err := s.getGuild(ctx, payload.GuildID); err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		s.logger.WithError(err).Error("get single guild error")
+		return nil, err
+	}
+
 	members, err := s.dbSvc.ListGuildMembers(
 		ctx,
 		model.MemberFilter{
@@ -200,7 +270,7 @@ func (s *service) GetGuildMembers(ctx context.Context, payload *svc.GetGuildMemb
 	if err != nil {
 		metrics.ReportFuncError(s.svcTags)
 		s.logger.WithError(err).WithField("guild_id", payload.GuildID).Error("list guild member error")
-		return nil, svc.MakeInternal(err)
+		return nil, makeInternal(err)
 	}
 
 	var result []*svc.GuildMember
@@ -211,6 +281,7 @@ func (s *service) GetGuildMembers(ctx context.Context, payload *svc.GetGuildMemb
 			IsDefaultGuildMember: m.IsDefaultGuildMember,
 			Since:                m.Since.UnixMilli(),
 			Params:               m.Params,
+			SubaccountIds:        m.SubaccountIDs,
 		})
 	}
 
@@ -225,12 +296,11 @@ func (s *service) GetGuildMasterAddress(ctx context.Context, payload *svc.GetGui
 	defer doneFn()
 	metrics.ReportFuncCall(s.svcTags)
 
-	guild, err := s.dbSvc.GetSingleGuild(ctx, payload.GuildID)
+	guild, err := s.getGuild(ctx, payload.GuildID)
 	if err != nil {
 		metrics.ReportFuncError(s.svcTags)
-
 		s.logger.WithError(err).Error("get single guild error")
-		return nil, svc.MakeInternal(err)
+		return nil, err
 	}
 	address := guild.MasterAddress.String()
 
@@ -244,6 +314,12 @@ func (s *service) GetGuildDefaultMember(ctx context.Context, payload *svc.GetGui
 	defer doneFn()
 	metrics.ReportFuncCall(s.svcTags)
 
+	if _, err := s.getGuild(ctx, payload.GuildID); err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		s.logger.WithError(err).Error("get single guild error")
+		return nil, err
+	}
+
 	isDefaultMember := true
 	defaultMember, err := s.dbSvc.ListGuildMembers(
 		ctx,
@@ -256,14 +332,14 @@ func (s *service) GetGuildDefaultMember(ctx context.Context, payload *svc.GetGui
 		metrics.ReportFuncError(s.svcTags)
 
 		s.logger.WithError(err).Error("list guild members error")
-		return nil, svc.MakeInternal(err)
+		return nil, makeInternal(err)
 	}
 
 	if len(defaultMember) == 0 {
 		metrics.ReportFuncError(s.svcTags)
 
 		s.logger.WithField("guild_id", payload.GuildID).Error("default member not found")
-		return nil, svc.MakeNotFound(errors.New("default member not found"))
+		return nil, makeNotFound(errors.New("default member not found"))
 	}
 
 	return &svc.GetGuildDefaultMemberResult{
@@ -275,45 +351,23 @@ func (s *service) GetGuildDefaultMember(ctx context.Context, payload *svc.GetGui
 	}, nil
 }
 
-func (s *service) checkGrants(ctx context.Context, guild *model.Guild, address string) (*qualificationResult, error) {
-	grants, err := s.exchangeProvider.GetGrants(ctx, address, guild.MasterAddress.String())
-	if err != nil {
-		return nil, fmt.Errorf("get grants err: %w", err)
-	}
-
-	msgToExpiration := make(map[string]time.Time)
-
-	for _, g := range grants.Grants {
-		t, err := time.Parse(expirationTimeLayout, g.Expiration)
-		if err != nil {
-			return nil, fmt.Errorf("time parse err: %w", err)
-		}
-
-		msgToExpiration[g.Authorization.Msg] = t
+// getGuild returns guild, invalid or unknown guild ID is returned as typed api error instead of internal
+func (s *service) getGuild(ctx context.Context, guildID string) (*model.Guild, error) {
+	if _, err := primitive.ObjectIDFromHex(guildID); err != nil {
+		return nil, makeInvalidArg(fmt.Errorf("invalid guild id: %w", err))
 	}
 
-	// all expected grants must be provided
-	now := time.Now()
-	for _, expectedMsg := range s.grants {
-		expiration, ok := msgToExpiration[expectedMsg]
-		if !ok {
-			return &qualificationResult{
-				status: StatusUnqualified,
-				detail: fmt.Sprintf("%s not granted", expectedMsg),
-			}, nil
-		}
-
-		if expiration.Before(now) {
-			return &qualificationResult{
-				status: StatusUnqualified,
-				detail: fmt.Sprintf("%s expired", expectedMsg),
-			}, nil
+	guild, err := s.dbSvc.GetSingleGuild(ctx, guildID)
+	if err != nil {
+		if err == db.ErrNotFound {
+			return nil, newAPIError(errNameNotFound, errcode.GuildNotFound, errors.New("guild not found"), map[string]string{
+				"guild_id": guildID,
+			})
 		}
+		return nil, makeInternal(err)
 	}
 
-	return &qualificationResult{
-		status: StatusQualified,
-	}, nil
+	return guild, nil
 }
 
 func (s *service) getLatestGuildPortfolio(ctx context.Context, guildID string) (*model.GuildPortfolio, error) {
@@ -333,161 +387,28 @@ func (s *service) getLatestGuildPortfolio(ctx context.Context, guildID string) (
 	return portfolios[0], nil
 }
 
-func (s *service) checkBalances(ctx context.Context, guild *model.Guild, snapshot *model.AccountPortfolio) (*qualificationResult, error) {
-	if snapshot == nil {
-		return nil, fmt.Errorf("no snapshot found to check")
-	}
-
-	// get latest portfolio to have price in usd, we will compare min price with this snapshot
-	portfolio, err := s.getLatestGuildPortfolio(ctx, guild.ID.Hex())
-	if err != nil {
-		return nil, fmt.Errorf("get latest portfolio err: %w", err)
-	}
-
-	denomToUsdPrice := make(map[string]float64)
-	for _, b := range portfolio.Balances {
-		if _, isStableCoin := config.StableCoinDenoms[b.Denom]; isStableCoin {
-			// price can be fluctuate, let's consider it 1$ for stable coins
-			denomToUsdPrice[b.Denom] = 1
-			continue
-		}
-
-		denomToUsdPrice[b.Denom] = b.PriceUSD
-	}
-
-	denomToDecimal := make(map[string]int)
-	for _, market := range guild.Markets {
-		if market.BaseTokenMeta != nil {
-			denomToDecimal[market.BaseDenom] = market.BaseTokenMeta.Decimals
-		}
-
-		if market.QuoteTokenMeta != nil {
-			denomToDecimal[market.QuoteDenom] = market.QuoteTokenMeta.Decimals
-		}
-	}
-
-	denomToMinAmount := make(map[string]float64)
-	for _, req := range guild.Requirements {
-		denomToMinAmount[req.Denom] = req.MinAmountUSD
-	}
-
-	// we will use price usd from latest guild portfolio snapshot
-	for _, b := range snapshot.Balances {
-		availBalance, _ := decimal.NewFromString(b.AvailableBalance.String())
-		dec, exist := denomToDecimal[b.Denom]
-		if !exist {
-			metrics.ReportFuncError(s.svcTags)
-			return nil, fmt.Errorf("failed check denom not belongs to market")
-		}
-
-		min, exist := denomToMinAmount[b.Denom]
-		if !exist {
-			metrics.ReportFuncError(s.svcTags)
-			return nil, fmt.Errorf("failed check denom not belongs to market")
-		}
-
-		priceUsd, exist := denomToUsdPrice[b.Denom]
-		if !exist {
-			metrics.ReportFuncError(s.svcTags)
-			return nil, fmt.Errorf("failed to check denom price in usd")
-		}
-
-		usdInDecimal := decimal.NewFromFloat(priceUsd)
-		availBalanceFloat := availBalance.Shift(int32(dec)).Mul(usdInDecimal)
-		if !availBalanceFloat.GreaterThanOrEqual(decimal.NewFromFloat(min)) {
-			return &qualificationResult{
-				status: StatusUnqualified,
-				detail: fmt.Sprintf("Denom %s balance: %s < min %.2f", b.Denom, availBalanceFloat.String(), min),
-			}, nil
-		}
-	}
-
-	return &qualificationResult{
-		status: StatusQualified,
-	}, nil
-}
-
-func (s *service) checkOrders(ctx context.Context, guild *model.Guild, portfolio *model.AccountPortfolio) (*qualificationResult, error) {
-	defaultSubaccountID := defaultSubaccountIDFromInjAddress(portfolio.InjectiveAddress)
-	derivOrders, err := s.exchangeProvider.GetDerivativeOrders(ctx, []string{}, defaultSubaccountID)
-	if err != nil {
-		return nil, err
-	}
-
-	if len(derivOrders) > 0 {
-		return &qualificationResult{
-			status: StatusUnqualified,
-			detail: "trading account still has open derivative orders",
-		}, nil
-	}
-
-	spotOrders, err := s.exchangeProvider.GetSpotOrders(ctx, []string{}, defaultSubaccountID)
-	if err != nil {
-		return nil, err
-	}
-
-	if len(spotOrders) > 0 {
-		return &qualificationResult{
-			status: StatusUnqualified,
-			detail: "trading account still has open spot orders",
-		}, nil
-	}
-
-	return &qualificationResult{
-		status: StatusQualified,
-	}, nil
-}
-
-func (s *service) checkPositions(ctx context.Context, guild *model.Guild, portfolio *model.AccountPortfolio) (*qualificationResult, error) {
-	defaultSubaccountID := defaultSubaccountIDFromInjAddress(portfolio.InjectiveAddress)
-	positions, err := s.exchangeProvider.GetPositions(ctx, defaultSubaccountID)
-	if err != nil {
-		return nil, err
-	}
-
-	if len(positions) > 0 {
-		return &qualificationResult{
-			status: StatusUnqualified,
-			detail: "trading account still has open positions",
-		}, nil
-	}
-	return &qualificationResult{
-		status: StatusQualified,
-	}, nil
-}
-
+// checkAddressQualification checks member like the process does for waitlisted addresses,
+// prices are taken from latest guild portfolio snapshot
 func (s *service) checkAddressQualification(
 	ctx context.Context,
-	guild *model.Guild, portfolio *model.AccountPortfolio,
+	guild *model.Guild, member *model.GuildMember, portfolio *model.AccountPortfolio,
 ) (*qualificationResult, error) {
-	balanceQualifyResult, err := s.checkBalances(ctx, guild, portfolio)
-	if err != nil {
-		return nil, fmt.Errorf("balance check err: %w", err)
-	}
-
-	if balanceQualifyResult.status != StatusQualified {
-		return balanceQualifyResult, nil
+	if portfolio == nil {
+		return nil, fmt.Errorf("no snapshot found to check")
 	}
 
-	orderCheck, err := s.checkOrders(ctx, guild, portfolio)
+	guildPortfolio, err := s.getLatestGuildPortfolio(ctx, guild.ID.Hex())
 	if err != nil {
-		return nil, fmt.Errorf("order check err: %w", err)
-	}
-
-	if orderCheck.status != StatusQualified {
-		return orderCheck, nil
+		return nil, fmt.Errorf("get latest portfolio err: %w", err)
 	}
 
-	positionCheck, err := s.checkPositions(ctx, guild, portfolio)
+	unqualified, err := guildsprocess.CheckQualification(
+		ctx, s.exchangeProvider, guild, member, portfolio, guildsprocess.DenomPricesUSD(guildPortfolio), s.grants,
+	)
 	if err != nil {
-		return nil, fmt.Errorf("position check err: %w", err)
-	}
-
-	if positionCheck.status != StatusQualified {
-		return positionCheck, nil
+		return nil, err
 	}
-
-	return s.checkGrants(ctx, guild, portfolio.InjectiveAddress.String())
+	return makeQualificationResult(unqualified), nil
 }
 
 func (s *service) checkAddressLeaveCondition(
@@ -525,57 +446,86 @@ func (s *service) EnterGuild(ctx context.Context, payload *svc.EnterGuildPayload
 	if err != nil {
 		metrics.ReportFuncError(s.svcTags)
 		s.logger.WithError(err).Error("parse acc address error")
-		return nil, svc.MakeInvalidArg(err)
+		return nil, makeInvalidArg(err)
 	}
 
-	guild, err := s.dbSvc.GetSingleGuild(ctx, payload.GuildID)
+	guild, err := s.getGuild(ctx, payload.GuildID)
 	if err != nil {
 		metrics.ReportFuncError(s.svcTags)
 		s.logger.WithError(err).Error("get single guild error")
-		return nil, svc.MakeInternal(fmt.Errorf("guild error: %w", err))
+		return nil, err
+	}
+
+	// fail fast before capturing portfolio, AddMember checks it again
+	if guild.Archived {
+		return nil, makeMembershipError(db.ErrGuildArchived)
 	}
 
 	params := ""
 	if payload.Params != nil {
 		params = *payload.Params
 	}
+
+	if err := s.checkAccess(ctx, guild, model.Address{AccAddress: accAddress}, params); err != nil {
+		return nil, err
+	}
+
+	subaccountIDs, err := parseMemberSubaccountIDs(model.Address{AccAddress: accAddress}, payload.SubaccountIds)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		s.logger.WithError(err).Error("parse subaccount ids error")
+		return nil, makeInvalidArg(err)
+	}
+
+	member := &model.GuildMember{
+		GuildID:          guild.ID,
+		InjectiveAddress: model.Address{AccAddress: accAddress},
+		SubaccountIDs:    subaccountIDs,
+	}
 	// get portfolio
-	portfolio, err := s.portfolioHelper.CaptureSingleMemberPortfolio(
-		ctx,
-		guild,
-		&model.GuildMember{
-			GuildID:          guild.ID,
-			InjectiveAddress: model.Address{AccAddress: accAddress},
-		},
-		true,
-	)
+	portfolio, err := s.portfolioHelper.CaptureSingleMemberPortfolio(ctx, guild, member, true)
 	if err != nil {
 		metrics.ReportFuncError(s.svcTags)
 		s.logger.WithError(err).
 			WithField("address", payload.InjectiveAddress).Error("capture portfolio error")
-		return nil, svc.MakeInternal(fmt.Errorf("capture portfolio error: %w", err))
+		return nil, makeInternal(fmt.Errorf("capture portfolio error: %w", err))
 	}
 
 	// check qualification
-	qualificationResult, err := s.checkAddressQualification(ctx, guild, portfolio)
+	qualificationResult, err := s.checkAddressQualification(ctx, guild, member, portfolio)
 	if err != nil {
 		metrics.ReportFuncError(s.svcTags)
 		s.logger.WithError(err).Error("check qualifcation error")
-		return nil, svc.MakeInternal(fmt.Errorf("check qualification error: %w", err))
+		return nil, makeInternal(fmt.Errorf("check qualification error: %w", err))
 	}
 
 	if qualificationResult.status != StatusQualified {
-		return nil, svc.MakeInvalidArg(errors.New(qualificationResult.detail))
+		return nil, qualificationResult.apiError()
+	}
+
+	// qualified address waits for a slot, it's re-checked when admitted
+	if guild.MemberCount >= guild.Capacity {
+		return s.enterWaitlist(ctx, guild, member, params)
 	}
 
 	// add to database
-	err = s.dbSvc.AddMember(ctx, payload.GuildID, model.Address{AccAddress: accAddress}, portfolio, false, params)
+	err = guildsprocess.AddMemberWithAccess(
+		ctx, s.dbSvc, guild, model.Address{AccAddress: accAddress}, portfolio,
+		params, subaccountIDs, guildsprocess.GuildInviteCode(guild, params),
+	)
 	if err != nil {
+		if errors.Is(err, db.ErrMemberExceedCap) {
+			return s.enterWaitlist(ctx, guild, member, params)
+		}
+
 		metrics.ReportFuncError(s.svcTags)
 		s.logger.WithError(err).Errorln("cannot add member")
-		return nil, svc.MakeInternal(err)
+		return nil, makeMembershipError(err)
 	}
 	s.logger.WithField("injective_address", payload.InjectiveAddress).Info("new member joined guild")
+	s.dispatcher.Dispatch(payload.GuildID, webhook.EventMemberJoined, &webhook.MemberData{
+		InjectiveAddress: accAddress.String(),
+	})
 
 	joinStatus := "success"
 	return &svc.EnterGuildResult{
@@ -583,6 +533,153 @@ func (s *service) EnterGuild(ctx context.Context, payload *svc.EnterGuildPayload
 	}, nil
 }
 
+// checkAccess returns invalid arg error if address cannot enter guild by its access mode.
+// Invite code reserved by waitlist entry of address is accepted like admitting from waitlist
+func (s *service) checkAccess(ctx context.Context, guild *model.Guild, address model.Address, params string) error {
+	reservedCode := ""
+	entry, err := s.dbSvc.GetWaitlistEntry(ctx, guild.ID.Hex(), address)
+	if err != nil && err != db.ErrNotFound {
+		metrics.ReportFuncError(s.svcTags)
+		s.logger.WithError(err).Error("get waitlist entry error")
+		return makeInternal(err)
+	}
+
+	if entry != nil {
+		reservedCode = entry.InviteCode
+	}
+
+	reason, err := guildsprocess.CheckAccess(ctx, s.dbSvc, guild, address, guildsprocess.InviteCodeFromParams(params), reservedCode)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		s.logger.WithError(err).Error("check guild access error")
+		return makeInternal(err)
+	}
+
+	switch reason {
+	case guildsprocess.AccessReasonNotAllowlisted:
+		return newAPIError(errNameInvalidArg, errcode.NotAllowlisted, errors.New("address is not in guild allowlist"), nil)
+	case guildsprocess.AccessReasonInvalidInviteCode:
+		return makeMembershipError(db.ErrInvalidInviteCode)
+	}
+	return nil
+}
+
+// waitlistPosition returns 1-based position of address in guild waitlist, 0 if address is not in waitlist
+func waitlistPosition(entries []*model.WaitlistEntry, address string) int {
+	for i, e := range entries {
+		if e.InjectiveAddress.String() == address {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+func (s *service) enterWaitlist(
+	ctx context.Context,
+	guild *model.Guild,
+	member *model.GuildMember,
+	params string,
+) (*svc.EnterGuildResult, error) {
+	guildID := guild.ID.Hex()
+	address := member.InjectiveAddress.String()
+	members, err := s.dbSvc.ListGuildMembers(ctx, model.MemberFilter{
+		InjectiveAddress: &member.InjectiveAddress,
+	})
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		s.logger.WithError(err).Error("list guild member error")
+		return nil, makeInternal(err)
+	}
+
+	if len(members) > 0 {
+		return nil, makeMembershipError(db.ErrAlreadyMember)
+	}
+
+	err = s.dbSvc.AddToWaitlist(ctx, &model.WaitlistEntry{
+		GuildID:          member.GuildID,
+		InjectiveAddress: member.InjectiveAddress,
+		Params:           guildsprocess.MemberParams(guild, params),
+		SubaccountIDs:    member.SubaccountIDs,
+		InviteCode:       guildsprocess.GuildInviteCode(guild, params),
+		EnqueuedAt:       time.Now(),
+	})
+	// entering again keeps current position
+	if err != nil && err != db.ErrAlreadyWaitlisted {
+		metrics.ReportFuncError(s.svcTags)
+		s.logger.WithError(err).Error("cannot add address to waitlist")
+		return nil, makeMembershipError(err)
+	}
+
+	entries, err := s.dbSvc.ListWaitlist(ctx, guildID)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		s.logger.WithError(err).Error("list waitlist error")
+		return nil, makeInternal(err)
+	}
+
+	position := waitlistPosition(entries, address)
+	s.logger.WithFields(log.Fields{
+		"injective_address": address,
+		"guild_id":          guildID,
+		"position":          position,
+	}).Info("address waitlisted for guild")
+
+	joinStatus := "waitlisted"
+	message := db.ErrMemberExceedCap.Error()
+	return &svc.EnterGuildResult{
+		JoinStatus:       &joinStatus,
+		Message:          &message,
+		WaitlistPosition: &position,
+	}, nil
+}
+
+func (s *service) GetGuildWaitlist(ctx context.Context, payload *svc.GetGuildWaitlistPayload) (res *svc.GetGuildWaitlistResult, err error) {
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(s.svcTags)
+
+	if _, err := s.getGuild(ctx, payload.GuildID); err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		s.logger.WithError(err).Error("get single guild error")
+		return nil, err
+	}
+
+	entries, err := s.dbSvc.ListWaitlist(ctx, payload.GuildID)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		s.logger.WithError(err).Error("list waitlist error")
+		return nil, makeInternal(err)
+	}
+
+	result := make([]*svc.WaitlistEntry, 0, len(entries))
+	for i, e := range entries {
+		result = append(result, &svc.WaitlistEntry{
+			InjectiveAddress: e.InjectiveAddress.String(),
+			Position:         i + 1,
+			EnqueuedAt:       e.EnqueuedAt.UnixMilli(),
+		})
+	}
+
+	if payload.InjectiveAddress != nil {
+		accAddress, err := cosmtypes.AccAddressFromBech32(*payload.InjectiveAddress)
+		if err != nil {
+			metrics.ReportFuncError(s.svcTags)
+			s.logger.WithError(err).Error("parse acc address error")
+			return nil, makeInvalidArg(err)
+		}
+
+		position := waitlistPosition(entries, accAddress.String())
+		if position == 0 {
+			return nil, makeNotFound(errors.New("address not in waitlist"))
+		}
+		result = result[position-1 : position]
+	}
+
+	return &svc.GetGuildWaitlistResult{
+		Waitlist: result,
+	}, nil
+}
+
 func (s *service) LeaveGuild(ctx context.Context, payload *svc.LeaveGuildPayload) (res *svc.LeaveGuildResult, err error) {
 	doneFn := metrics.ReportFuncTiming(s.svcTags)
 	defer doneFn()
@@ -592,27 +689,27 @@ func (s *service) LeaveGuild(ctx context.Context, payload *svc.LeaveGuildPayload
 	if err != nil {
 		metrics.ReportFuncError(s.svcTags)
 		s.logger.WithError(err).Error("leave guild: invalid arg")
-		return nil, svc.MakeInvalidArg(err)
+		return nil, makeInvalidArg(err)
 	}
 
-	guild, err := s.dbSvc.GetSingleGuild(ctx, payload.GuildID)
+	guild, err := s.getGuild(ctx, payload.GuildID)
 	if err != nil {
 		metrics.ReportFuncError(s.svcTags)
 		s.logger.WithError(err).Error("get single guild error")
-		return nil, svc.MakeInternal(err)
+		return nil, err
 	}
 
 	shouldRemove, err := s.checkAddressLeaveCondition(ctx, guild, accAddress.String())
 	if err != nil {
 		metrics.ReportFuncError(s.svcTags)
 		s.logger.WithError(err).Error("check leave guild condition error")
-		return nil, svc.MakeInternal(fmt.Errorf("failed to check leave condition: %w", err))
+		return nil, makeInternal(fmt.Errorf("failed to check leave condition: %w", err))
 	}
 
 	if !shouldRemove {
 		metrics.ReportFuncError(s.svcTags)
 		s.logger.WithField("injective_address", accAddress.String()).Error("address hasnot remove some permissions")
-		return nil, svc.MakeInvalidArg(fmt.Errorf("address has not removed granted permissions"))
+		return nil, newAPIError(errNameInvalidArg, errcode.GrantsNotRevoked, errors.New("address has not removed granted permissions"), nil)
 	}
 
 	// remove member from database
@@ -620,13 +717,16 @@ func (s *service) LeaveGuild(ctx context.Context, payload *svc.LeaveGuildPayload
 	if err != nil {
 		metrics.ReportFuncError(s.svcTags)
 		s.logger.WithError(err).Error("cannot remove member on leave guild")
-		return nil, svc.MakeInternal(err)
+		return nil, makeMembershipError(err)
 	}
 
 	s.logger.WithFields(log.Fields{
 		"injective_address": payload.InjectiveAddress,
 		"guild_id":          payload.GuildID,
 	}).Info("member left guild")
+	s.dispatcher.Dispatch(payload.GuildID, webhook.EventMemberLeft, &webhook.MemberData{
+		InjectiveAddress: accAddress.String(),
+	})
 
 	leaveStatus := "success"
 	return &svc.LeaveGuildResult{
@@ -634,72 +734,220 @@ func (s *service) LeaveGuild(ctx context.Context, payload *svc.LeaveGuildPayload
 	}, nil
 }
 
-func (s *service) GetGuildMarkets(ctx context.Context, payload *svc.GetGuildMarketsPayload) (res *svc.GetGuildMarketsResult, err error) {
+func (s *service) TransferMember(ctx context.Context, payload *svc.TransferMemberPayload) (res *svc.TransferMemberResult, err error) {
 	doneFn := metrics.ReportFuncTiming(s.svcTags)
 	defer doneFn()
 	metrics.ReportFuncCall(s.svcTags)
 
-	guild, err := s.dbSvc.GetSingleGuild(ctx, payload.GuildID)
+	accAddress, err := cosmtypes.AccAddressFromBech32(payload.InjectiveAddress)
 	if err != nil {
 		metrics.ReportFuncError(s.svcTags)
-		s.logger.WithError(err).Error("get guild market error")
-		return nil, svc.MakeInternal(err)
+		s.logger.WithError(err).Error("parse acc address error")
+		return nil, makeInvalidArg(err)
 	}
+	address := model.Address{AccAddress: accAddress}
 
-	var markets []*svc.Market
-	for _, m := range guild.Markets {
-		markets = append(markets, &svc.Market{
-			MarketID:    m.MarketID.Hex(),
-			IsPerpetual: m.IsPerpetual,
-		})
+	members, err := s.dbSvc.ListGuildMembers(ctx, model.MemberFilter{
+		InjectiveAddress: &address,
+	})
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		s.logger.WithError(err).Error("list guild member error")
+		return nil, makeInternal(err)
 	}
 
-	return &svc.GetGuildMarketsResult{
-		Markets: markets,
-	}, nil
-}
-
-func (s *service) GetGuildPortfolios(
-	ctx context.Context,
-	payload *svc.GetGuildPortfoliosPayload,
-) (res *svc.GetGuildPortfoliosResult, err error) {
-	doneFn := metrics.ReportFuncTiming(s.svcTags)
-	defer doneFn()
-	metrics.ReportFuncCall(s.svcTags)
-
-	filter := model.GuildPortfoliosFilter{
-		GuildID: payload.GuildID,
+	if len(members) == 0 {
+		metrics.ReportFuncError(s.svcTags)
+		return nil, makeMembershipError(db.ErrNotFound)
 	}
+	fromGuildID := members[0].GuildID.Hex()
 
-	if payload.EndTime != nil {
-		to := time.UnixMilli(*payload.EndTime)
-		filter.EndTime = &to
+	if members[0].IsDefaultGuildMember {
+		return nil, makeMembershipError(db.ErrDefaultMember)
 	}
 
-	var from time.Time
-	if payload.StartTime != nil {
-		from = time.UnixMilli(*payload.StartTime)
-		filter.StartTime = &from
+	if fromGuildID == payload.GuildID {
+		return nil, makeMembershipError(db.ErrAlreadyMember)
 	}
 
-	portfolios, err := s.dbSvc.ListGuildPortfolios(ctx, filter)
+	guild, err := s.getGuild(ctx, payload.GuildID)
 	if err != nil {
 		metrics.ReportFuncError(s.svcTags)
-		s.logger.WithError(err).Error("list guild portfolio error")
-		return nil, svc.MakeInternal(fmt.Errorf("list guild portfolio err: %w", err))
+		s.logger.WithError(err).Error("get single guild error")
+		return nil, err
 	}
 
-	result := make([]*svc.SingleGuildPortfolio, 0)
-	// expected result to be sort by timestamp
-	for _, p := range portfolios {
-		if len(p.BankBalances) > 0 && p.BankBalances[0].Denom == config.DEMOM_INJ {
-			p.Balances = addInjBankToBalance(p.Balances, p.BankBalances[0])
-		}
+	// fail fast before capturing portfolio, TransferMember checks them again
+	if guild.Archived {
+		return nil, makeMembershipError(db.ErrGuildArchived)
+	}
 
-		var balances []*svc.Balance
-		for _, b := range p.Balances {
-			balances = append(balances, &svc.Balance{
-				Denom:            b.Denom,
+	if guild.MemberCount >= guild.Capacity {
+		return nil, makeMembershipError(db.ErrMemberExceedCap)
+	}
+
+	params := members[0].Params
+	if payload.Params != nil {
+		params = *payload.Params
+	}
+
+	if err := s.checkAccess(ctx, guild, address, params); err != nil {
+		return nil, err
+	}
+
+	subaccountIDs := members[0].SubaccountIDs
+	if payload.SubaccountIds != nil {
+		subaccountIDs, err = parseMemberSubaccountIDs(address, payload.SubaccountIds)
+		if err != nil {
+			metrics.ReportFuncError(s.svcTags)
+			s.logger.WithError(err).Error("parse subaccount ids error")
+			return nil, makeInvalidArg(err)
+		}
+	}
+
+	member := &model.GuildMember{
+		GuildID:          guild.ID,
+		InjectiveAddress: address,
+		SubaccountIDs:    subaccountIDs,
+	}
+	portfolio, err := s.portfolioHelper.CaptureSingleMemberPortfolio(ctx, guild, member, true)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		s.logger.WithError(err).
+			WithField("address", payload.InjectiveAddress).Error("capture portfolio error")
+		return nil, makeInternal(fmt.Errorf("capture portfolio error: %w", err))
+	}
+
+	// same qualification as entering guild, grants to old guild master don't need to be revoked
+	qualificationResult, err := s.checkAddressQualification(ctx, guild, member, portfolio)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		s.logger.WithError(err).Error("check qualifcation error")
+		return nil, makeInternal(fmt.Errorf("check qualification error: %w", err))
+	}
+
+	if qualificationResult.status != StatusQualified {
+		return nil, qualificationResult.apiError()
+	}
+
+	inviteCode := guildsprocess.GuildInviteCode(guild, params)
+	memberParams := guildsprocess.MemberParams(guild, params)
+	err = s.dbSvc.TransferMember(ctx, fromGuildID, payload.GuildID, address, portfolio, memberParams, subaccountIDs, inviteCode)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		s.logger.WithError(err).Errorln("cannot transfer member")
+		return nil, makeMembershipError(err)
+	}
+
+	s.logger.WithFields(log.Fields{
+		"injective_address": payload.InjectiveAddress,
+		"from_guild_id":     fromGuildID,
+		"guild_id":          payload.GuildID,
+	}).Info("member transferred guild")
+	s.dispatcher.Dispatch(fromGuildID, webhook.EventMemberLeft, &webhook.MemberData{
+		InjectiveAddress: accAddress.String(),
+	})
+	s.dispatcher.Dispatch(payload.GuildID, webhook.EventMemberJoined, &webhook.MemberData{
+		InjectiveAddress: accAddress.String(),
+	})
+
+	transferStatus := "success"
+	return &svc.TransferMemberResult{
+		TransferStatus: &transferStatus,
+		FromGuildID:    &fromGuildID,
+	}, nil
+}
+
+func (s *service) GetGuildMarkets(ctx context.Context, payload *svc.GetGuildMarketsPayload) (res *svc.GetGuildMarketsResult, err error) {
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(s.svcTags)
+
+	guild, err := s.getGuild(ctx, payload.GuildID)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		s.logger.WithError(err).Error("get single guild error")
+		return nil, err
+	}
+
+	var markets []*svc.Market
+	for _, m := range guild.Markets {
+		makerFeeRate := m.MakerFeeRate.String()
+		takerFeeRate := m.TakerFeeRate.String()
+		market := &svc.Market{
+			MarketID:     m.MarketID.Hex(),
+			IsPerpetual:  m.IsPerpetual,
+			MakerFeeRate: &makerFeeRate,
+			TakerFeeRate: &takerFeeRate,
+		}
+		if m.Status != "" {
+			status := m.Status
+			market.Status = &status
+		}
+		markets = append(markets, market)
+	}
+
+	return &svc.GetGuildMarketsResult{
+		Markets: markets,
+	}, nil
+}
+
+func (s *service) GetGuildPortfolios(
+	ctx context.Context,
+	payload *svc.GetGuildPortfoliosPayload,
+) (res *svc.GetGuildPortfoliosResult, err error) {
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(s.svcTags)
+
+	filter := model.GuildPortfoliosFilter{
+		GuildID: payload.GuildID,
+	}
+
+	if payload.EndTime != nil {
+		to := time.UnixMilli(*payload.EndTime)
+		filter.EndTime = &to
+	}
+
+	var from time.Time
+	if payload.StartTime != nil {
+		from = time.UnixMilli(*payload.StartTime)
+		filter.StartTime = &from
+	}
+
+	guild, err := s.getGuild(ctx, payload.GuildID)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		s.logger.WithError(err).Error("get single guild error")
+		return nil, err
+	}
+
+	portfolios, err := s.dbSvc.ListGuildPortfolios(ctx, filter)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		s.logger.WithError(err).Error("list guild portfolio error")
+		return nil, makeInternal(fmt.Errorf("list guild portfolio err: %w", err))
+	}
+
+	// values are computed before inj bank balance is merged into balances
+	decimals := performance.DenomDecimals(guild)
+	points := make([]performance.Point, 0, len(portfolios))
+	for _, p := range portfolios {
+		points = append(points, portfolioPoint(p.UpdatedAt, p.Balances, p.BankBalances, p.CashFlows, decimals))
+	}
+	returns := newestFirstReturns(points)
+
+	result := make([]*svc.SingleGuildPortfolio, 0)
+	// expected result to be sort by timestamp
+	for i, p := range portfolios {
+		if len(p.BankBalances) > 0 && p.BankBalances[0].Denom == config.DEMOM_INJ {
+			p.Balances = addInjBankToBalance(p.Balances, p.BankBalances[0])
+		}
+
+		var balances []*svc.Balance
+		for _, b := range p.Balances {
+			balances = append(balances, &svc.Balance{
+				Denom:            b.Denom,
 				PriceUsd:         b.PriceUSD,
 				TotalBalance:     b.TotalBalance.String(),
 				AvailableBalance: b.AvailableBalance.String(),
@@ -709,14 +957,31 @@ func (s *service) GetGuildPortfolios(
 		}
 
 		result = append(result, &svc.SingleGuildPortfolio{
-			GuildID:   &payload.GuildID,
-			Balances:  balances,
-			UpdatedAt: p.UpdatedAt.UnixMilli(),
+			GuildID:    &payload.GuildID,
+			Balances:   balances,
+			UpdatedAt:  p.UpdatedAt.UnixMilli(),
+			ValueUsd:   &points[i].Value,
+			CashFlows:  modelCashFlowsToHTTP(p.CashFlows),
+			NetFlowUsd: &points[i].NetFlow,
+			Twr:        &returns[i].TWR,
+			Mwr:        &returns[i].MWR,
 		})
 	}
 
+	netFlows, err := s.dbSvc.GetNetFlows(ctx, model.TransferFilter{
+		GuildID:   &payload.GuildID,
+		StartTime: filter.StartTime,
+		EndTime:   filter.EndTime,
+	})
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		s.logger.WithError(err).Error("get guild net flows error")
+		return nil, makeInternal(err)
+	}
+
 	return &svc.GetGuildPortfoliosResult{
 		Portfolios: result,
+		NetFlows:   modelNetFlowsToHTTP(netFlows),
 	}, nil
 }
 
@@ -729,7 +994,7 @@ func (s *service) GetAccountInfo(ctx context.Context, payload *svc.GetAccountInf
 	if err != nil {
 		metrics.ReportFuncError(s.svcTags)
 		s.logger.WithError(err).Error("parse acc address error")
-		return nil, svc.MakeInvalidArg(err)
+		return nil, makeInvalidArg(err)
 	}
 
 	members, err := s.dbSvc.ListGuildMembers(
@@ -741,12 +1006,12 @@ func (s *service) GetAccountInfo(ctx context.Context, payload *svc.GetAccountInf
 	if err != nil {
 		metrics.ReportFuncError(s.svcTags)
 		s.logger.WithError(err).Error("list guild member error")
-		return nil, svc.MakeInternal(err)
+		return nil, makeInternal(err)
 	}
 
 	if len(members) == 0 {
 		metrics.ReportFuncError(s.svcTags)
-		return nil, svc.MakeNotFound(errors.New("member not found"))
+		return nil, makeMembershipError(db.ErrNotFound)
 	}
 	guildID := members[0].GuildID.Hex()
 
@@ -757,6 +1022,7 @@ func (s *service) GetAccountInfo(ctx context.Context, payload *svc.GetAccountInf
 			IsDefaultGuildMember: members[0].IsDefaultGuildMember,
 			Since:                members[0].Since.UnixMilli(),
 			Params:               members[0].Params,
+			SubaccountIds:        members[0].SubaccountIDs,
 		},
 	}, nil
 }
@@ -770,16 +1036,19 @@ func (s *service) GetAccountPortfolio(ctx context.Context, payload *svc.GetAccou
 	if err != nil {
 		metrics.ReportFuncError(s.svcTags)
 		s.logger.WithError(err).Error("parse acc address error")
-		return nil, svc.MakeInvalidArg(err)
+		return nil, makeInvalidArg(err)
 	}
 
 	portfolio, err := s.dbSvc.GetAccountPortfolio(ctx, model.Address{
 		AccAddress: address,
 	})
 	if err != nil {
+		if err == db.ErrNotFound {
+			return nil, makeMembershipError(err)
+		}
 		metrics.ReportFuncError(s.svcTags)
 		s.logger.WithError(err).Error("get account portfolio error")
-		return nil, svc.MakeInternal(err)
+		return nil, makeInternal(err)
 	}
 
 	var (
@@ -804,10 +1073,12 @@ func (s *service) GetAccountPortfolio(ctx context.Context, payload *svc.GetAccou
 		balances = append(balances, aBalance)
 	}
 	updatedAt = portfolio.UpdatedAt
+	guildID := portfolio.GuildID.Hex()
 
 	return &svc.GetAccountPortfolioResult{
 		Data: &svc.SingleAccountPortfolio{
 			InjectiveAddress: address.String(),
+			GuildID:          &guildID,
 			Balances:         balances,
 			UpdatedAt:        updatedAt.UnixMilli(),
 		},
@@ -826,7 +1097,7 @@ func (s *service) GetAccountMonthlyPortfolios(
 	if err != nil {
 		metrics.ReportFuncError(s.svcTags)
 		s.logger.WithError(err).Error("parse acc address error")
-		return nil, svc.MakeInvalidArg(err)
+		return nil, makeInvalidArg(err)
 	}
 
 	filter := model.AccountPortfoliosFilter{
@@ -844,7 +1115,7 @@ func (s *service) GetAccountMonthlyPortfolios(
 
 			metrics.ReportFuncError(s.svcTags)
 			s.logger.WithField("end_time", endTime.String()).WithError(err).Error("invalid arg")
-			return nil, svc.MakeInvalidArg(err)
+			return nil, makeInvalidArg(err)
 		}
 		filter.EndTime = &endTime
 	}
@@ -859,14 +1130,14 @@ func (s *service) GetAccountMonthlyPortfolios(
 
 		metrics.ReportFuncError(s.svcTags)
 		s.logger.WithError(err).Error("invalid arg")
-		return nil, svc.MakeInvalidArg(err)
+		return nil, makeInvalidArg(err)
 	}
 
 	portfolios, err := s.dbSvc.ListAccountPortfolios(ctx, filter)
 	if err != nil {
 		metrics.ReportFuncError(s.svcTags)
 		s.logger.WithError(err).Error("list account portfolios error")
-		return nil, svc.MakeInternal(err)
+		return nil, makeInternal(err)
 	}
 
 	if len(portfolios) == 0 {
@@ -924,7 +1195,7 @@ func (s *service) GetAccountPortfolios(ctx context.Context, payload *svc.GetAcco
 	if err != nil {
 		metrics.ReportFuncError(s.svcTags)
 		s.logger.WithError(err).Error("parse acc address error")
-		return nil, svc.MakeInvalidArg(err)
+		return nil, makeInvalidArg(err)
 	}
 
 	filter := model.AccountPortfoliosFilter{
@@ -941,16 +1212,41 @@ func (s *service) GetAccountPortfolios(ctx context.Context, payload *svc.GetAcco
 		filter.StartTime = &startTime
 	}
 
+	if payload.GuildID != nil {
+		if _, err := primitive.ObjectIDFromHex(*payload.GuildID); err != nil {
+			metrics.ReportFuncError(s.svcTags)
+			s.logger.WithError(err).Error("parse guild id error")
+			return nil, makeInvalidArg(err)
+		}
+		filter.GuildID = payload.GuildID
+	}
+
 	portfolios, err := s.dbSvc.ListAccountPortfolios(ctx, filter)
 	if err != nil {
 		metrics.ReportFuncError(s.svcTags)
 		s.logger.WithError(err).Error("list account portfolios error")
-		return nil, svc.MakeInternal(err)
+		return nil, makeInternal(err)
 	}
 
+	// account might have been in several guilds, take decimals of all guild markets
+	guilds, err := s.dbSvc.ListAllGuilds(ctx, true)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		s.logger.WithError(err).Error("list all guilds error")
+		return nil, makeInternal(err)
+	}
+
+	// values are computed before inj bank balance is merged into balances
+	decimals := performance.DenomDecimals(guilds...)
+	points := make([]performance.Point, 0, len(portfolios))
+	for _, p := range portfolios {
+		points = append(points, portfolioPoint(p.UpdatedAt, p.Balances, p.BankBalances, p.CashFlows, decimals))
+	}
+	returns := newestFirstReturns(points)
+
 	result := make([]*svc.SingleAccountPortfolio, 0)
 	// expected result to be sorted by timestamp
-	for _, p := range portfolios {
+	for i, p := range portfolios {
 		if len(p.BankBalances) > 0 && p.BankBalances[0].Denom == config.DEMOM_INJ {
 			p.Balances = addInjBankToBalance(p.Balances, p.BankBalances[0])
 		}
@@ -967,14 +1263,395 @@ func (s *service) GetAccountPortfolios(ctx context.Context, payload *svc.GetAcco
 			})
 		}
 
+		guildID := p.GuildID.Hex()
 		result = append(result, &svc.SingleAccountPortfolio{
 			InjectiveAddress: address.String(),
+			GuildID:          &guildID,
 			Balances:         balances,
 			UpdatedAt:        p.UpdatedAt.UnixMilli(),
+			ValueUsd:         &points[i].Value,
+			CashFlows:        modelCashFlowsToHTTP(p.CashFlows),
+			NetFlowUsd:       &points[i].NetFlow,
+			Twr:              &returns[i].TWR,
+			Mwr:              &returns[i].MWR,
 		})
 	}
 
+	netFlows, err := s.dbSvc.GetNetFlows(ctx, model.TransferFilter{
+		InjectiveAddress: &filter.InjectiveAddress,
+		StartTime:        filter.StartTime,
+		EndTime:          filter.EndTime,
+	})
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		s.logger.WithError(err).Error("get account net flows error")
+		return nil, makeInternal(err)
+	}
+
 	return &svc.GetAccountPortfoliosResult{
 		Portfolios: result,
+		NetFlows:   modelNetFlowsToHTTP(netFlows),
+	}, nil
+}
+
+func (s *service) GetAccountMemberships(ctx context.Context, payload *svc.GetAccountMembershipsPayload) (res *svc.GetAccountMembershipsResult, err error) {
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(s.svcTags)
+
+	address, err := cosmtypes.AccAddressFromBech32(payload.InjectiveAddress)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		s.logger.WithError(err).Error("parse acc address error")
+		return nil, makeInvalidArg(err)
+	}
+	accAddress := model.Address{AccAddress: address}
+
+	members, err := s.dbSvc.ListGuildMembers(ctx, model.MemberFilter{
+		InjectiveAddress: &accAddress,
+	})
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		s.logger.WithError(err).Error("list guild member error")
+		return nil, makeInternal(err)
+	}
+
+	pastMemberships, err := s.dbSvc.ListMemberships(ctx, accAddress)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		s.logger.WithError(err).Error("list memberships error")
+		return nil, makeInternal(err)
+	}
+
+	// current membership goes first, it has no end time
+	memberships := make([]*svc.Membership, 0, len(members)+len(pastMemberships))
+	for _, m := range members {
+		memberships = append(memberships, &svc.Membership{
+			GuildID:              m.GuildID.Hex(),
+			IsDefaultGuildMember: m.IsDefaultGuildMember,
+			Since:                m.Since.UnixMilli(),
+		})
+	}
+
+	for _, m := range pastMemberships {
+		endedAt := m.EndedAt.UnixMilli()
+		memberships = append(memberships, &svc.Membership{
+			GuildID:              m.GuildID.Hex(),
+			IsDefaultGuildMember: m.IsDefaultGuildMember,
+			Since:                m.Since.UnixMilli(),
+			EndedAt:              &endedAt,
+		})
+	}
+
+	return &svc.GetAccountMembershipsResult{
+		Memberships: memberships,
+	}, nil
+}
+
+func (s *service) GetAccountGrants(ctx context.Context, payload *svc.GetAccountGrantsPayload) (res *svc.GetAccountGrantsResult, err error) {
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(s.svcTags)
+
+	address, err := cosmtypes.AccAddressFromBech32(payload.InjectiveAddress)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		s.logger.WithError(err).Error("parse acc address error")
+		return nil, makeInvalidArg(err)
+	}
+
+	members, err := s.dbSvc.ListGuildMembers(
+		ctx,
+		model.MemberFilter{
+			InjectiveAddress: &model.Address{AccAddress: address},
+		},
+	)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		s.logger.WithError(err).Error("list guild member error")
+		return nil, makeInternal(err)
+	}
+
+	if len(members) == 0 {
+		metrics.ReportFuncError(s.svcTags)
+		return nil, makeMembershipError(db.ErrNotFound)
+	}
+
+	guild, err := s.dbSvc.GetSingleGuild(ctx, members[0].GuildID.Hex())
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		s.logger.WithError(err).Error("get single guild error")
+		return nil, makeInternal(err)
+	}
+
+	grants, err := s.exchangeProvider.GetGrants(ctx, address.String(), guild.MasterAddress.String())
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		s.logger.WithError(err).Error("get grants error")
+		return nil, makeInternal(fmt.Errorf("get grants err: %w", err))
+	}
+
+	msgToExpiration, err := guildsprocess.GrantExpirations(grants)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		s.logger.WithError(err).Error("parse grants error")
+		return nil, makeInternal(err)
+	}
+
+	result := make([]*svc.Grant, 0, len(s.grants))
+	for _, msg := range s.grants {
+		grant := &svc.Grant{
+			Msg: msg,
+		}
+
+		if expiration, ok := msgToExpiration[msg]; ok {
+			expirationMilli := expiration.UnixMilli()
+			grant.IsGranted = true
+			grant.Expiration = &expirationMilli
+		}
+		result = append(result, grant)
+	}
+
+	return &svc.GetAccountGrantsResult{
+		Grants: result,
+	}, nil
+}
+
+func (s *service) GetAccountTrades(ctx context.Context, payload *svc.GetAccountTradesPayload) (res *svc.GetAccountTradesResult, err error) {
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(s.svcTags)
+
+	address, err := cosmtypes.AccAddressFromBech32(payload.InjectiveAddress)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		s.logger.WithError(err).Error("parse acc address error")
+		return nil, makeInvalidArg(err)
+	}
+
+	limit := int64(defaultTradesLimit)
+	if payload.Limit != nil {
+		if *payload.Limit <= 0 || *payload.Limit > maxTradesLimit {
+			metrics.ReportFuncError(s.svcTags)
+			return nil, makeInvalidArg(fmt.Errorf("limit must be in range (0, %d]", maxTradesLimit))
+		}
+		limit = *payload.Limit
+	}
+
+	filter := tradeFilterFromPayload(payload.StartTime, payload.EndTime)
+	filter.InjectiveAddress = &model.Address{AccAddress: address}
+	filter.Limit = &limit
+
+	trades, err := s.dbSvc.ListTrades(ctx, filter)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		s.logger.WithError(err).Error("list trades error")
+		return nil, makeInternal(err)
+	}
+
+	result := make([]*svc.Trade, 0, len(trades))
+	for _, t := range trades {
+		result = append(result, modelTradeToHTTP(t))
+	}
+
+	return &svc.GetAccountTradesResult{
+		Trades: result,
+	}, nil
+}
+
+func (s *service) GetAccountTradeStats(ctx context.Context, payload *svc.GetAccountTradeStatsPayload) (res *svc.GetAccountTradeStatsResult, err error) {
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(s.svcTags)
+
+	address, err := cosmtypes.AccAddressFromBech32(payload.InjectiveAddress)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		s.logger.WithError(err).Error("parse acc address error")
+		return nil, makeInvalidArg(err)
+	}
+
+	filter := tradeFilterFromPayload(payload.StartTime, payload.EndTime)
+	filter.InjectiveAddress = &model.Address{AccAddress: address}
+
+	stats, err := s.dbSvc.GetTradeStats(ctx, filter)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		s.logger.WithError(err).Error("get account trade stats error")
+		return nil, makeInternal(err)
+	}
+
+	return &svc.GetAccountTradeStatsResult{
+		Stats: modelTradeStatsToHTTP(stats),
+	}, nil
+}
+
+func (s *service) GetGuildTradeStats(ctx context.Context, payload *svc.GetGuildTradeStatsPayload) (res *svc.GetGuildTradeStatsResult, err error) {
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(s.svcTags)
+
+	if _, err := s.getGuild(ctx, payload.GuildID); err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		s.logger.WithError(err).Error("get single guild error")
+		return nil, err
+	}
+
+	filter := tradeFilterFromPayload(payload.StartTime, payload.EndTime)
+	filter.GuildID = &payload.GuildID
+
+	stats, err := s.dbSvc.GetTradeStats(ctx, filter)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		s.logger.WithError(err).Error("get guild trade stats error")
+		return nil, makeInternal(err)
+	}
+
+	return &svc.GetGuildTradeStatsResult{
+		Stats: modelTradeStatsToHTTP(stats),
+	}, nil
+}
+
+func (s *service) GetGuildFees(ctx context.Context, payload *svc.GetGuildFeesPayload) (res *svc.GetGuildFeesResult, err error) {
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(s.svcTags)
+
+	guild, err := s.getGuild(ctx, payload.GuildID)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		s.logger.WithError(err).Error("get single guild error")
+		return nil, err
+	}
+
+	tradeFilter := tradeFilterFromPayload(payload.StartTime, payload.EndTime)
+	fees, err := s.dbSvc.ListDailyFees(ctx, model.DailyFeeFilter{
+		GuildID:   payload.GuildID,
+		StartTime: tradeFilter.StartTime,
+		EndTime:   tradeFilter.EndTime,
+	})
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		s.logger.WithError(err).Error("list daily fees error")
+		return nil, makeInternal(err)
+	}
+
+	masterAddress := guild.MasterAddress.String()
+	totals, members, daily := summarizeDailyFees(fees)
+	return &svc.GetGuildFeesResult{
+		MasterAddress: &masterAddress,
+		Totals:        totals,
+		Members:       members,
+		Daily:         daily,
+	}, nil
+}
+
+func (s *service) GetAccountTransfers(ctx context.Context, payload *svc.GetAccountTransfersPayload) (res *svc.GetAccountTransfersResult, err error) {
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(s.svcTags)
+
+	address, err := cosmtypes.AccAddressFromBech32(payload.InjectiveAddress)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		s.logger.WithError(err).Error("parse acc address error")
+		return nil, makeInvalidArg(err)
+	}
+
+	limit := int64(defaultTradesLimit)
+	if payload.Limit != nil {
+		if *payload.Limit <= 0 || *payload.Limit > maxTradesLimit {
+			metrics.ReportFuncError(s.svcTags)
+			return nil, makeInvalidArg(fmt.Errorf("limit must be in range (0, %d]", maxTradesLimit))
+		}
+		limit = *payload.Limit
+	}
+
+	timeFilter := tradeFilterFromPayload(payload.StartTime, payload.EndTime)
+	filter := model.TransferFilter{
+		InjectiveAddress: &model.Address{AccAddress: address},
+		StartTime:        timeFilter.StartTime,
+		EndTime:          timeFilter.EndTime,
+	}
+
+	netFlows, err := s.dbSvc.GetNetFlows(ctx, filter)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		s.logger.WithError(err).Error("get net flows error")
+		return nil, makeInternal(err)
+	}
+
+	filter.Limit = &limit
+	transfers, err := s.dbSvc.ListTransfers(ctx, filter)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		s.logger.WithError(err).Error("list transfers error")
+		return nil, makeInternal(err)
+	}
+
+	result := make([]*svc.Transfer, 0, len(transfers))
+	for _, t := range transfers {
+		result = append(result, modelTransferToHTTP(t))
+	}
+
+	return &svc.GetAccountTransfersResult{
+		Transfers: result,
+		NetFlows:  modelNetFlowsToHTTP(netFlows),
+	}, nil
+}
+
+func (s *service) GetAccountPositions(ctx context.Context, payload *svc.GetAccountPositionsPayload) (res *svc.GetAccountPositionsResult, err error) {
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(s.svcTags)
+
+	address, err := cosmtypes.AccAddressFromBech32(payload.InjectiveAddress)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		s.logger.WithError(err).Error("parse acc address error")
+		return nil, makeInvalidArg(err)
+	}
+
+	portfolio, err := s.dbSvc.GetAccountPortfolio(ctx, model.Address{
+		AccAddress: address,
+	})
+	if err != nil {
+		if err == db.ErrNotFound {
+			return nil, makeMembershipError(err)
+		}
+		metrics.ReportFuncError(s.svcTags)
+		s.logger.WithError(err).Error("get account portfolio error")
+		return nil, makeInternal(err)
+	}
+
+	return &svc.GetAccountPositionsResult{
+		Positions: modelPositionsToHTTP(portfolio.Positions),
+		UpdatedAt: portfolio.UpdatedAt.UnixMilli(),
+	}, nil
+}
+
+func (s *service) GetGuildRisk(ctx context.Context, payload *svc.GetGuildRiskPayload) (res *svc.GetGuildRiskResult, err error) {
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(s.svcTags)
+
+	if _, err := s.getGuild(ctx, payload.GuildID); err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		s.logger.WithError(err).Error("get single guild error")
+		return nil, err
+	}
+
+	risk, err := s.dbSvc.GetLatestGuildRisk(ctx, payload.GuildID)
+	if err != nil {
+		if err == db.ErrNotFound {
+			return nil, makeNotFound(err)
+		}
+		metrics.ReportFuncError(s.svcTags)
+		s.logger.WithError(err).Error("get guild risk error")
+		return nil, makeInternal(err)
+	}
+
+	return &svc.GetGuildRiskResult{
+		Data: modelGuildRiskToHTTP(risk),
 	}, nil
 }
@@ -0,0 +1,297 @@
+package guildsapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/InjectiveLabs/injective-guilds-service/api/errcode"
+	svc "github.com/InjectiveLabs/injective-guilds-service/api/gen/guilds_service"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
+	cosmtypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	log "github.com/xlab/suplog"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const testAddress = "inj1awx03zmnnlsjuvp7x8ac3lphw50p0nea6p2584"
+
+func newTestService(t *testing.T) (*service, *db.MockDBService) {
+	cosmtypes.GetConfig().SetBech32PrefixForAccount("inj", "injpub")
+
+	ctrl := gomock.NewController(t)
+	dbSvc := db.NewMockDBService(ctrl)
+	return &service{
+		dbSvc:            dbSvc,
+		portfolioUpdates: NewPortfolioUpdateHub(dbSvc),
+		logger:           log.WithField("svc", "guilds_api_test"),
+	}, dbSvc
+}
+
+func TestGetAllGuildsIncludeArchived(t *testing.T) {
+	s, dbSvc := newTestService(t)
+	ctx := context.Background()
+
+	dbSvc.EXPECT().ListAllGuilds(ctx, false).Return(nil, nil)
+	_, err := s.GetAllGuilds(ctx, &svc.GetAllGuildsPayload{})
+	assert.NoError(t, err)
+
+	includeArchived := true
+	dbSvc.EXPECT().ListAllGuilds(ctx, true).Return(nil, nil)
+	_, err = s.GetAllGuilds(ctx, &svc.GetAllGuildsPayload{IncludeArchived: &includeArchived})
+	assert.NoError(t, err)
+}
+
+func TestEnterGuildArchived(t *testing.T) {
+	s, dbSvc := newTestService(t)
+	ctx := context.Background()
+
+	guildID := primitive.NewObjectID()
+	dbSvc.EXPECT().GetSingleGuild(ctx, guildID.Hex()).Return(&model.Guild{
+		ID:       guildID,
+		Capacity: 10,
+		Archived: true,
+	}, nil)
+
+	// rejected before access check and portfolio capture, no other db calls
+	_, err := s.EnterGuild(ctx, &svc.EnterGuildPayload{
+		GuildID:          guildID.Hex(),
+		InjectiveAddress: testAddress,
+	})
+	assertAPIError(t, err, errNameInvalidArg, errcode.GuildArchived)
+}
+
+func TestGetAccountPortfoliosGuildFilter(t *testing.T) {
+	s, dbSvc := newTestService(t)
+	ctx := context.Background()
+
+	invalidGuildID := "not-a-guild-id"
+	_, err := s.GetAccountPortfolios(ctx, &svc.GetAccountPortfoliosPayload{
+		InjectiveAddress: testAddress,
+		GuildID:          &invalidGuildID,
+	})
+	assertAPIError(t, err, errNameInvalidArg, errcode.InvalidArgument)
+
+	guildID := primitive.NewObjectID()
+	guildIDHex := guildID.Hex()
+	dbSvc.EXPECT().ListAccountPortfolios(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, filter model.AccountPortfoliosFilter) ([]*model.AccountPortfolio, error) {
+			assert.Equal(t, &guildIDHex, filter.GuildID)
+			assert.Equal(t, testAddress, filter.InjectiveAddress.String())
+			return []*model.AccountPortfolio{
+				{GuildID: guildID, UpdatedAt: time.UnixMilli(1650000000000)},
+			}, nil
+		})
+	dbSvc.EXPECT().ListAllGuilds(ctx, true).Return(nil, nil)
+	dbSvc.EXPECT().GetNetFlows(ctx, gomock.Any()).Return(nil, nil)
+
+	res, err := s.GetAccountPortfolios(ctx, &svc.GetAccountPortfoliosPayload{
+		InjectiveAddress: testAddress,
+		GuildID:          &guildIDHex,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, res.Portfolios, 1)
+	assert.Equal(t, guildIDHex, *res.Portfolios[0].GuildID)
+}
+
+func TestGetAccountMembershipsOrder(t *testing.T) {
+	s, dbSvc := newTestService(t)
+	ctx := context.Background()
+
+	currentGuildID := primitive.NewObjectID()
+	pastGuildIDs := []primitive.ObjectID{primitive.NewObjectID(), primitive.NewObjectID()}
+	since := time.UnixMilli(1650000000000)
+
+	dbSvc.EXPECT().ListGuildMembers(ctx, gomock.Any()).Return([]*model.GuildMember{
+		{GuildID: currentGuildID, Since: since.Add(48 * time.Hour)},
+	}, nil)
+	// db returns latest ended first
+	dbSvc.EXPECT().ListMemberships(ctx, gomock.Any()).Return([]*model.Membership{
+		{GuildID: pastGuildIDs[0], Since: since.Add(24 * time.Hour), EndedAt: since.Add(47 * time.Hour)},
+		{GuildID: pastGuildIDs[1], Since: since, EndedAt: since.Add(23 * time.Hour)},
+	}, nil)
+
+	res, err := s.GetAccountMemberships(ctx, &svc.GetAccountMembershipsPayload{
+		InjectiveAddress: testAddress,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, res.Memberships, 3)
+
+	assert.Equal(t, currentGuildID.Hex(), res.Memberships[0].GuildID)
+	assert.Nil(t, res.Memberships[0].EndedAt)
+
+	for i, guildID := range pastGuildIDs {
+		m := res.Memberships[i+1]
+		assert.Equal(t, guildID.Hex(), m.GuildID)
+		if assert.NotNil(t, m.EndedAt) {
+			assert.Equal(t, since.Add(time.Duration(47-24*i)*time.Hour).UnixMilli(), *m.EndedAt)
+		}
+	}
+}
+
+func TestTransferMemberRejected(t *testing.T) {
+	fromGuildID := primitive.NewObjectID()
+	toGuildID := primitive.NewObjectID()
+
+	testCases := []struct {
+		name      string
+		member    *model.GuildMember
+		toGuildID primitive.ObjectID
+		toGuild   *model.Guild
+		errName   string
+		errCode   string
+	}{
+		{
+			name:      "default member",
+			member:    &model.GuildMember{GuildID: fromGuildID, IsDefaultGuildMember: true},
+			toGuildID: toGuildID,
+			errName:   errNameInvalidArg,
+			errCode:   errcode.InvalidArgument,
+		},
+		{
+			name:      "same guild",
+			member:    &model.GuildMember{GuildID: fromGuildID},
+			toGuildID: fromGuildID,
+			errName:   errNameInvalidArg,
+			errCode:   errcode.AlreadyMember,
+		},
+		{
+			name:      "full target",
+			member:    &model.GuildMember{GuildID: fromGuildID},
+			toGuildID: toGuildID,
+			toGuild:   &model.Guild{ID: toGuildID, Capacity: 2, MemberCount: 2},
+			errName:   errNameInvalidArg,
+			errCode:   errcode.GuildFull,
+		},
+		{
+			name:      "archived target",
+			member:    &model.GuildMember{GuildID: fromGuildID},
+			toGuildID: toGuildID,
+			toGuild:   &model.Guild{ID: toGuildID, Capacity: 2, Archived: true},
+			errName:   errNameInvalidArg,
+			errCode:   errcode.GuildArchived,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s, dbSvc := newTestService(t)
+			ctx := context.Background()
+
+			dbSvc.EXPECT().ListGuildMembers(ctx, gomock.Any()).Return([]*model.GuildMember{tc.member}, nil)
+			if tc.toGuild != nil {
+				dbSvc.EXPECT().GetSingleGuild(ctx, tc.toGuildID.Hex()).Return(tc.toGuild, nil)
+			}
+
+			// rejected before capturing portfolio, TransferMember is never called
+			_, err := s.TransferMember(ctx, &svc.TransferMemberPayload{
+				GuildID:          tc.toGuildID.Hex(),
+				InjectiveAddress: testAddress,
+			})
+			assertAPIError(t, err, tc.errName, tc.errCode)
+		})
+	}
+}
+
+func TestTransferMemberNotMember(t *testing.T) {
+	s, dbSvc := newTestService(t)
+	ctx := context.Background()
+
+	dbSvc.EXPECT().ListGuildMembers(ctx, gomock.Any()).Return(nil, nil)
+	_, err := s.TransferMember(ctx, &svc.TransferMemberPayload{
+		GuildID:          primitive.NewObjectID().Hex(),
+		InjectiveAddress: testAddress,
+	})
+	assertAPIError(t, err, errNameNotFound, errcode.MemberNotFound)
+}
+
+func TestWaitlistPosition(t *testing.T) {
+	first := model.Address{AccAddress: cosmtypes.AccAddress("waitlist_position_1_")}
+	second := model.Address{AccAddress: cosmtypes.AccAddress("waitlist_position_2_")}
+	entries := []*model.WaitlistEntry{
+		{InjectiveAddress: first},
+		{InjectiveAddress: second},
+	}
+
+	assert.Equal(t, 1, waitlistPosition(entries, first.String()))
+	assert.Equal(t, 2, waitlistPosition(entries, second.String()))
+	assert.Equal(t, 0, waitlistPosition(entries, testAddress))
+	assert.Equal(t, 0, waitlistPosition(nil, testAddress))
+}
+
+func TestCheckAccessReservedInviteCode(t *testing.T) {
+	s, dbSvc := newTestService(t)
+	ctx := context.Background()
+
+	guild := &model.Guild{ID: primitive.NewObjectID(), AccessMode: model.AccessModeInviteCode}
+	address := model.Address{AccAddress: cosmtypes.AccAddress("reserved_invite_code")}
+
+	// code used up by waitlisted addresses is still accepted for the one holding its use
+	dbSvc.EXPECT().GetWaitlistEntry(ctx, guild.ID.Hex(), address).Return(&model.WaitlistEntry{InviteCode: "a1b2c3"}, nil)
+	assert.NoError(t, s.checkAccess(ctx, guild, address, `{"invite_code": "a1b2c3"}`))
+
+	dbSvc.EXPECT().GetWaitlistEntry(ctx, guild.ID.Hex(), address).Return(nil, db.ErrNotFound)
+	dbSvc.EXPECT().GetInviteCode(ctx, guild.ID.Hex(), "a1b2c3").Return(&model.InviteCode{MaxUses: 1, Uses: 1}, nil)
+	err := s.checkAccess(ctx, guild, address, `{"invite_code": "a1b2c3"}`)
+	assertAPIError(t, err, errNameInvalidArg, errcode.InvalidInviteCode)
+}
+
+func TestGetGuildWaitlistUnknownGuild(t *testing.T) {
+	s, dbSvc := newTestService(t)
+	ctx := context.Background()
+
+	guildID := primitive.NewObjectID().Hex()
+	dbSvc.EXPECT().GetSingleGuild(ctx, guildID).Return(nil, db.ErrNotFound)
+
+	_, err := s.GetGuildWaitlist(ctx, &svc.GetGuildWaitlistPayload{GuildID: guildID})
+	assertAPIError(t, err, errNameNotFound, errcode.GuildNotFound)
+
+	_, err = s.GetGuildWaitlist(ctx, &svc.GetGuildWaitlistPayload{GuildID: "not-a-guild-id"})
+	assertAPIError(t, err, errNameInvalidArg, errcode.InvalidArgument)
+}
+
+func assertAPIError(t *testing.T, err error, name, code string) {
+	apiErr, ok := err.(*svc.APIError)
+	if assert.True(t, ok, "expected api error, got %v", err) {
+		assert.Equal(t, name, apiErr.Name)
+		assert.Equal(t, code, apiErr.Code)
+	}
+}
+
+func TestGuildQueriesUnknownGuild(t *testing.T) {
+	s, dbSvc := newTestService(t)
+	ctx := context.Background()
+
+	guildID := primitive.NewObjectID().Hex()
+	dbSvc.EXPECT().GetSingleGuild(ctx, guildID).Return(nil, db.ErrNotFound).Times(4)
+
+	_, err := s.GetGuildMembers(ctx, &svc.GetGuildMembersPayload{GuildID: guildID})
+	assertAPIError(t, err, errNameNotFound, errcode.GuildNotFound)
+
+	_, err = s.GetGuildDefaultMember(ctx, &svc.GetGuildDefaultMemberPayload{GuildID: guildID})
+	assertAPIError(t, err, errNameNotFound, errcode.GuildNotFound)
+
+	_, err = s.GetGuildTradeStats(ctx, &svc.GetGuildTradeStatsPayload{GuildID: guildID})
+	assertAPIError(t, err, errNameNotFound, errcode.GuildNotFound)
+
+	_, err = s.GetGuildFees(ctx, &svc.GetGuildFeesPayload{GuildID: guildID})
+	assertAPIError(t, err, errNameNotFound, errcode.GuildNotFound)
+
+	_, err = s.GetGuildMembers(ctx, &svc.GetGuildMembersPayload{GuildID: "not-a-guild-id"})
+	assertAPIError(t, err, errNameInvalidArg, errcode.InvalidArgument)
+}
+
+func TestAccountPortfolioNotMember(t *testing.T) {
+	s, dbSvc := newTestService(t)
+	ctx := context.Background()
+
+	dbSvc.EXPECT().GetAccountPortfolio(ctx, gomock.Any()).Return(nil, db.ErrNotFound).Times(2)
+
+	_, err := s.GetAccountPortfolio(ctx, &svc.GetAccountPortfolioPayload{InjectiveAddress: testAddress})
+	assertAPIError(t, err, errNameNotFound, errcode.MemberNotFound)
+
+	_, err = s.GetAccountPositions(ctx, &svc.GetAccountPositionsPayload{InjectiveAddress: testAddress})
+	assertAPIError(t, err, errNameNotFound, errcode.MemberNotFound)
+}
@@ -0,0 +1,413 @@
+package guildsapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/InjectiveLabs/injective-guilds-service/api/errcode"
+	svc "github.com/InjectiveLabs/injective-guilds-service/api/gen/guilds_service"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/config"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/prom"
+	metrics "github.com/InjectiveLabs/metrics"
+	cosmtypes "github.com/cosmos/cosmos-sdk/types"
+	log "github.com/xlab/suplog"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	goahttp "goa.design/goa/v3/http"
+)
+
+// keepAliveInterval is how often a comment is sent on idle streams so proxies don't drop them
+const keepAliveInterval = 30 * time.Second
+
+// StreamHandler pushes portfolio snapshots and membership changes as server-sent events.
+// It's a plain http handler since goa doesn't generate sse, it's mounted on the api mux
+type StreamHandler struct {
+	dbSvc   db.DBService
+	hub     *PortfolioUpdateHub
+	logger  log.Logger
+	svcTags metrics.Tags
+
+	closeOnce sync.Once
+	done      chan struct{}
+}
+
+func NewStreamHandler(dbSvc db.DBService, hub *PortfolioUpdateHub) *StreamHandler {
+	return &StreamHandler{
+		dbSvc:  dbSvc,
+		hub:    hub,
+		logger: log.WithField("svc", "guilds_stream"),
+		svcTags: metrics.Tags{
+			"svc": "guilds_stream",
+		},
+		done: make(chan struct{}),
+	}
+}
+
+// Mount registers /stream/portfolios into mux
+func (h *StreamHandler) Mount(mux goahttp.Muxer) {
+	mux.Handle(http.MethodGet, "/stream/portfolios", h.StreamPortfolioUpdates)
+}
+
+// Close ends all open streams, http server shutdown doesn't wait for them
+func (h *StreamHandler) Close() {
+	h.closeOnce.Do(func() {
+		close(h.done)
+	})
+}
+
+type streamBalance struct {
+	Denom            string  `json:"denom"`
+	PriceUSD         float64 `json:"price_usd"`
+	TotalBalance     string  `json:"total_balance"`
+	AvailableBalance string  `json:"available_balance"`
+	UnrealizedPNL    string  `json:"unrealized_pnl"`
+	MarginHold       string  `json:"margin_hold"`
+}
+
+type streamPortfolio struct {
+	Balances []*streamBalance `json:"balances"`
+	// MemberCount is set for guild portfolio
+	MemberCount *int  `json:"member_count,omitempty"`
+	UpdatedAt   int64 `json:"updated_at"` // unix timestamp, millisecond
+}
+
+type streamMembership struct {
+	IsDefaultGuildMember bool   `json:"is_default_guild_member"`
+	Since                int64  `json:"since"`              // unix timestamp, millisecond
+	EndedAt              *int64 `json:"ended_at,omitempty"` // set for member_left
+}
+
+// streamEvent is the data of an event, event name is the update kind
+type streamEvent struct {
+	GuildID          string            `json:"guild_id"`
+	InjectiveAddress string            `json:"injective_address,omitempty"`
+	Portfolio        *streamPortfolio  `json:"portfolio,omitempty"`
+	Membership       *streamMembership `json:"membership,omitempty"`
+}
+
+// StreamPortfolioUpdates query params: guild_id and injective_address, both optional.
+// Updates are streamed until client disconnects, event id is sent with each update so
+// reconnecting clients get recent updates after Last-Event-ID header
+func (h *StreamHandler) StreamPortfolioUpdates(w http.ResponseWriter, r *http.Request) {
+	metrics.ReportFuncCall(h.svcTags)
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		metrics.ReportFuncError(h.svcTags)
+		writeAPIError(w, makeInternal(errors.New("streaming is not supported")))
+		return
+	}
+
+	ctx := r.Context()
+	filter, apiErr := h.parseFilter(ctx, r)
+	if apiErr != nil {
+		metrics.ReportFuncError(h.svcTags)
+		writeAPIError(w, apiErr)
+		return
+	}
+
+	sub := h.hub.Subscribe(filter, r.Header.Get("Last-Event-ID"))
+	defer sub.Close()
+
+	prom.StreamSubscribers.Inc()
+	defer prom.StreamSubscribers.Dec()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	keepAlive := time.NewTicker(keepAliveInterval)
+	defer keepAlive.Stop()
+
+	for {
+		var err error
+		select {
+		case update := <-sub.Updates():
+			err = writeEvent(w, update)
+		case <-keepAlive.C:
+			_, err = fmt.Fprint(w, ": keep-alive\n\n")
+		case <-sub.Dropped():
+			// client reconnects with the last id written
+			h.logger.Warning("slow stream subscriber is dropped")
+			for _, update := range pendingUpdates(sub) {
+				if err := writeEvent(w, update); err != nil {
+					return
+				}
+			}
+			flusher.Flush()
+			return
+		case <-ctx.Done():
+			return
+		case <-h.done:
+			return
+		}
+
+		// write errors mean client is gone
+		if err != nil {
+			return
+		}
+		flusher.Flush()
+	}
+}
+
+// pendingUpdates returns updates buffered for a dropped subscription
+func pendingUpdates(sub *PortfolioSubscription) []*model.PortfolioUpdate {
+	var updates []*model.PortfolioUpdate
+	for {
+		select {
+		case update := <-sub.Updates():
+			updates = append(updates, update)
+		default:
+			return updates
+		}
+	}
+}
+
+func (h *StreamHandler) parseFilter(ctx context.Context, r *http.Request) (model.PortfolioUpdateFilter, *svc.APIError) {
+	query := r.URL.Query()
+	filter, apiErr := portfolioUpdateFilter(ctx, h.dbSvc, query.Get("guild_id"), query.Get("injective_address"))
+	if apiErr != nil && apiErr.Name == errNameInternal {
+		h.logger.WithError(apiErr).Error("get single guild error")
+	}
+	return filter, apiErr
+}
+
+// portfolioUpdateFilter validates stream params shared by sse and grpc streams, empty params aren't filtered on
+func portfolioUpdateFilter(
+	ctx context.Context,
+	dbSvc db.DBService,
+	guildID string,
+	injectiveAddress string,
+) (filter model.PortfolioUpdateFilter, apiErr *svc.APIError) {
+	if guildID != "" {
+		if _, err := primitive.ObjectIDFromHex(guildID); err != nil {
+			return filter, makeInvalidArg(fmt.Errorf("invalid guild_id: %w", err))
+		}
+
+		if _, err := dbSvc.GetSingleGuild(ctx, guildID); err != nil {
+			if errors.Is(err, db.ErrNotFound) {
+				return filter, newAPIError(errNameNotFound, errcode.GuildNotFound, errors.New("guild not found"), map[string]string{
+					"guild_id": guildID,
+				})
+			}
+			return filter, makeInternal(err)
+		}
+		filter.GuildID = &guildID
+	}
+
+	if injectiveAddress != "" {
+		address, err := cosmtypes.AccAddressFromBech32(injectiveAddress)
+		if err != nil {
+			return filter, makeInvalidArg(err)
+		}
+		filter.InjectiveAddress = &model.Address{AccAddress: address}
+	}
+	return filter, nil
+}
+
+// StreamPortfolioUpdates is the grpc variant of sse stream, updates are sent until client cancels
+func (s *service) StreamPortfolioUpdates(
+	ctx context.Context,
+	payload *svc.StreamPortfolioUpdatesPayload,
+	stream svc.StreamPortfolioUpdatesServerStream,
+) error {
+	metrics.ReportFuncCall(s.svcTags)
+	defer stream.Close()
+
+	var guildID, injectiveAddress, lastEventID string
+	if payload.GuildID != nil {
+		guildID = *payload.GuildID
+	}
+	if payload.InjectiveAddress != nil {
+		injectiveAddress = *payload.InjectiveAddress
+	}
+	if payload.LastEventID != nil {
+		lastEventID = *payload.LastEventID
+	}
+
+	filter, apiErr := portfolioUpdateFilter(ctx, s.dbSvc, guildID, injectiveAddress)
+	if apiErr != nil {
+		metrics.ReportFuncError(s.svcTags)
+		if apiErr.Name == errNameInternal {
+			s.logger.WithError(apiErr).Error("get single guild error")
+		}
+		return apiErr
+	}
+
+	sub := s.portfolioUpdates.Subscribe(filter, lastEventID)
+	defer sub.Close()
+
+	prom.StreamSubscribers.Inc()
+	defer prom.StreamSubscribers.Dec()
+
+	for {
+		select {
+		case update := <-sub.Updates():
+			if err := stream.Send(modelPortfolioUpdateToSvc(update)); err != nil {
+				return err
+			}
+		case <-sub.Dropped():
+			s.logger.Warning("slow stream subscriber is dropped")
+			for _, update := range pendingUpdates(sub) {
+				if err := stream.Send(modelPortfolioUpdateToSvc(update)); err != nil {
+					return err
+				}
+			}
+			return makeInternal(errors.New("stream is behind, resume with last_event_id"))
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// apiErrorBody is the json body of api errors returned by goa methods
+type apiErrorBody struct {
+	Name    string            `json:"name"`
+	Code    string            `json:"code"`
+	Message string            `json:"message"`
+	Details map[string]string `json:"details,omitempty"`
+}
+
+// writeAPIError writes error with the status goa design maps its name to
+func writeAPIError(w http.ResponseWriter, apiErr *svc.APIError) {
+	status := http.StatusInternalServerError
+	switch apiErr.Name {
+	case errNameInvalidArg:
+		status = http.StatusBadRequest
+	case errNameNotFound:
+		status = http.StatusNotFound
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(&apiErrorBody{
+		Name:    apiErr.Name,
+		Code:    apiErr.Code,
+		Message: apiErr.Message,
+		Details: apiErr.Details,
+	})
+}
+
+func writeEvent(w http.ResponseWriter, update *model.PortfolioUpdate) error {
+	data, err := json.Marshal(modelPortfolioUpdateToStream(update))
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", update.ID, update.Kind, data)
+	return err
+}
+
+func modelPortfolioUpdateToStream(u *model.PortfolioUpdate) *streamEvent {
+	event := &streamEvent{
+		GuildID: u.GuildID.Hex(),
+	}
+
+	if !u.InjectiveAddress.IsEmpty() {
+		event.InjectiveAddress = u.InjectiveAddress.String()
+	}
+
+	switch u.Kind {
+	case model.PortfolioUpdateGuildPortfolio:
+		memberCount := u.GuildPortfolio.MemberCount
+		event.Portfolio = &streamPortfolio{
+			Balances:    modelBalancesToStream(u.GuildPortfolio.Balances, u.GuildPortfolio.BankBalances),
+			MemberCount: &memberCount,
+			UpdatedAt:   u.GuildPortfolio.UpdatedAt.UnixMilli(),
+		}
+	case model.PortfolioUpdateAccountPortfolio:
+		event.Portfolio = &streamPortfolio{
+			Balances:  modelBalancesToStream(u.AccountPortfolio.Balances, u.AccountPortfolio.BankBalances),
+			UpdatedAt: u.AccountPortfolio.UpdatedAt.UnixMilli(),
+		}
+	case model.PortfolioUpdateMemberJoined:
+		event.Membership = &streamMembership{
+			IsDefaultGuildMember: u.Member.IsDefaultGuildMember,
+			Since:                u.Member.Since.UnixMilli(),
+		}
+	case model.PortfolioUpdateMemberLeft:
+		endedAt := u.Membership.EndedAt.UnixMilli()
+		event.Membership = &streamMembership{
+			IsDefaultGuildMember: u.Membership.IsDefaultGuildMember,
+			Since:                u.Membership.Since.UnixMilli(),
+			EndedAt:              &endedAt,
+		}
+	}
+	return event
+}
+
+// modelBalancesToStream merges inj bank balance into balances like portfolio api methods,
+// balances are copied since the update is shared by all subscribers
+func modelBalancesToStream(modelBalances []*model.Balance, bankBalances []*model.BankBalance) []*streamBalance {
+	balances := make([]*model.Balance, 0, len(modelBalances))
+	for _, b := range modelBalances {
+		balance := *b
+		balances = append(balances, &balance)
+	}
+
+	if len(bankBalances) > 0 && bankBalances[0].Denom == config.DEMOM_INJ {
+		balances = addInjBankToBalance(balances, bankBalances[0])
+	}
+
+	result := make([]*streamBalance, 0, len(balances))
+	for _, b := range balances {
+		result = append(result, &streamBalance{
+			Denom:            b.Denom,
+			PriceUSD:         b.PriceUSD,
+			TotalBalance:     b.TotalBalance.String(),
+			AvailableBalance: b.AvailableBalance.String(),
+			UnrealizedPNL:    b.UnrealizedPNL.String(),
+			MarginHold:       b.MarginHold.String(),
+		})
+	}
+	return result
+}
+
+// modelPortfolioUpdateToSvc converts update to grpc stream result, it has the same fields as sse event data
+func modelPortfolioUpdateToSvc(u *model.PortfolioUpdate) *svc.PortfolioUpdate {
+	event := modelPortfolioUpdateToStream(u)
+	result := &svc.PortfolioUpdate{
+		ID:      u.ID,
+		Kind:    u.Kind,
+		GuildID: event.GuildID,
+	}
+
+	if event.InjectiveAddress != "" {
+		result.InjectiveAddress = &event.InjectiveAddress
+	}
+
+	if event.Portfolio != nil {
+		result.Portfolio = &svc.StreamPortfolio{
+			MemberCount: event.Portfolio.MemberCount,
+			UpdatedAt:   event.Portfolio.UpdatedAt,
+		}
+		for _, b := range event.Portfolio.Balances {
+			result.Portfolio.Balances = append(result.Portfolio.Balances, &svc.Balance{
+				Denom:            b.Denom,
+				PriceUsd:         b.PriceUSD,
+				TotalBalance:     b.TotalBalance,
+				AvailableBalance: b.AvailableBalance,
+				UnrealizedPnl:    b.UnrealizedPNL,
+				MarginHold:       b.MarginHold,
+			})
+		}
+	}
+
+	if event.Membership != nil {
+		result.Membership = &svc.StreamMembership{
+			IsDefaultGuildMember: event.Membership.IsDefaultGuildMember,
+			Since:                event.Membership.Since,
+			EndedAt:              event.Membership.EndedAt,
+		}
+	}
+	return result
+}
@@ -0,0 +1,194 @@
+package guildsapi
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
+	metrics "github.com/InjectiveLabs/metrics"
+	log "github.com/xlab/suplog"
+)
+
+const (
+	// subscriberBufferSize is how many updates a subscriber can lag behind before it's dropped,
+	// it's also the number of recent updates kept for subscribers resuming with last event id
+	subscriberBufferSize = 256
+
+	minWatchBackoff = time.Second
+	maxWatchBackoff = time.Minute
+)
+
+// PortfolioUpdateHub runs a single change stream and fans updates out to all sse and grpc streams.
+// The change stream is reopened after the last update it delivered when it fails
+type PortfolioUpdateHub struct {
+	dbSvc   db.DBService
+	logger  log.Logger
+	svcTags metrics.Tags
+	// watch is retried with backoff doubling from minBackoff up to maxBackoff
+	minBackoff time.Duration
+	maxBackoff time.Duration
+
+	mu          sync.Mutex
+	subscribers map[*PortfolioSubscription]struct{}
+	// recent updates, oldest first
+	recent      []*model.PortfolioUpdate
+	resumeToken string
+}
+
+// PortfolioSubscription receives updates matching its filter until it's closed or dropped
+type PortfolioSubscription struct {
+	hub     *PortfolioUpdateHub
+	filter  model.PortfolioUpdateFilter
+	updates chan *model.PortfolioUpdate
+	// dropped is closed when subscriber doesn't keep up, it should resume with last event id
+	dropped chan struct{}
+}
+
+func NewPortfolioUpdateHub(dbSvc db.DBService) *PortfolioUpdateHub {
+	return &PortfolioUpdateHub{
+		dbSvc:  dbSvc,
+		logger: log.WithField("svc", "portfolio_update_hub"),
+		svcTags: metrics.Tags{
+			"svc": "portfolio_update_hub",
+		},
+		minBackoff:  minWatchBackoff,
+		maxBackoff:  maxWatchBackoff,
+		subscribers: make(map[*PortfolioSubscription]struct{}),
+	}
+}
+
+// Run watches portfolio updates until ctx is done
+func (h *PortfolioUpdateHub) Run(ctx context.Context) {
+	backoff := h.minBackoff
+	for {
+		start := time.Now()
+		err := h.dbSvc.WatchPortfolioUpdates(ctx, h.lastResumeToken(), h.publish)
+		if ctx.Err() != nil {
+			return
+		}
+
+		if err == nil {
+			err = errors.New("change stream is closed")
+		}
+
+		metrics.ReportFuncError(h.svcTags)
+		if errors.Is(err, db.ErrHistoryLost) {
+			// updates since last token are lost, streams continue from now
+			h.logger.WithError(err).Warning("portfolio updates are resumed from now")
+			h.mu.Lock()
+			h.resumeToken = ""
+			h.mu.Unlock()
+		} else {
+			h.logger.WithError(err).Error("watch portfolio updates error")
+		}
+
+		// watch that ran for a while failed for a new reason
+		if time.Since(start) > h.maxBackoff {
+			backoff = h.minBackoff
+		}
+
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return
+		}
+
+		backoff *= 2
+		if backoff > h.maxBackoff {
+			backoff = h.maxBackoff
+		}
+	}
+}
+
+func (h *PortfolioUpdateHub) lastResumeToken() string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.resumeToken
+}
+
+func (h *PortfolioUpdateHub) publish(update *model.PortfolioUpdate) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.resumeToken = update.ID
+	h.recent = append(h.recent, update)
+	if len(h.recent) > subscriberBufferSize {
+		h.recent = h.recent[len(h.recent)-subscriberBufferSize:]
+	}
+
+	for sub := range h.subscribers {
+		if !sub.filter.Match(update) {
+			continue
+		}
+
+		select {
+		case sub.updates <- update:
+		default:
+			// slow subscriber must not block others
+			h.drop(sub)
+		}
+	}
+	return nil
+}
+
+// Subscribe returns updates matching filter. Recent updates after lastEventID are sent first,
+// updates are sent from now if it's empty or too old
+func (h *PortfolioUpdateHub) Subscribe(filter model.PortfolioUpdateFilter, lastEventID string) *PortfolioSubscription {
+	sub := &PortfolioSubscription{
+		hub:     h,
+		filter:  filter,
+		updates: make(chan *model.PortfolioUpdate, subscriberBufferSize),
+		dropped: make(chan struct{}),
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if lastEventID != "" {
+		for i, update := range h.recent {
+			if update.ID != lastEventID {
+				continue
+			}
+
+			// buffer is as large as recent updates
+			for _, missed := range h.recent[i+1:] {
+				if filter.Match(missed) {
+					sub.updates <- missed
+				}
+			}
+			break
+		}
+	}
+
+	h.subscribers[sub] = struct{}{}
+	return sub
+}
+
+// drop must be called with mu held
+func (h *PortfolioUpdateHub) drop(sub *PortfolioSubscription) {
+	if _, ok := h.subscribers[sub]; !ok {
+		return
+	}
+	delete(h.subscribers, sub)
+	close(sub.dropped)
+}
+
+// Updates are updates matching subscription filter in the order they are written
+func (s *PortfolioSubscription) Updates() <-chan *model.PortfolioUpdate {
+	return s.updates
+}
+
+// Dropped is closed when subscriber falls too far behind, pending updates are still readable from Updates
+func (s *PortfolioSubscription) Dropped() <-chan struct{} {
+	return s.dropped
+}
+
+// Close stops sending updates, it's safe to call after subscription is dropped
+func (s *PortfolioSubscription) Close() {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+	s.hub.drop(s)
+}
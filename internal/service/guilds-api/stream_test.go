@@ -0,0 +1,289 @@
+package guildsapi
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/InjectiveLabs/injective-guilds-service/api/errcode"
+	svc "github.com/InjectiveLabs/injective-guilds-service/api/gen/guilds_service"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
+	cosmtypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// testPortfolioStream collects updates sent to grpc stream, cancel is called after the first one
+type testPortfolioStream struct {
+	updates []*svc.PortfolioUpdate
+	closed  bool
+	cancel  context.CancelFunc
+}
+
+func (s *testPortfolioStream) Send(update *svc.PortfolioUpdate) error {
+	s.updates = append(s.updates, update)
+	s.cancel()
+	return nil
+}
+
+func (s *testPortfolioStream) Close() error {
+	s.closed = true
+	return nil
+}
+
+func testMemberJoined(t *testing.T, id string, guildID primitive.ObjectID) *model.PortfolioUpdate {
+	cosmtypes.GetConfig().SetBech32PrefixForAccount("inj", "injpub")
+	address, err := cosmtypes.AccAddressFromBech32(testAddress)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &model.PortfolioUpdate{
+		ID:               id,
+		Kind:             model.PortfolioUpdateMemberJoined,
+		GuildID:          guildID,
+		InjectiveAddress: model.Address{AccAddress: address},
+		Member: &model.GuildMember{
+			GuildID:          guildID,
+			InjectiveAddress: model.Address{AccAddress: address},
+			Since:            time.UnixMilli(1646092800000),
+		},
+	}
+}
+
+func testDecimal(v int64) primitive.Decimal128 {
+	d, _ := primitive.ParseDecimal128(strconv.FormatInt(v, 10))
+	return d
+}
+
+func testGuildPortfolioUpdate(id string, guildID primitive.ObjectID) *model.PortfolioUpdate {
+	return &model.PortfolioUpdate{
+		ID:      id,
+		Kind:    model.PortfolioUpdateGuildPortfolio,
+		GuildID: guildID,
+		GuildPortfolio: &model.GuildPortfolio{
+			GuildID:     guildID,
+			MemberCount: 2,
+			Balances: []*model.Balance{
+				{
+					Denom:            "inj",
+					PriceUSD:         10,
+					TotalBalance:     testDecimal(5),
+					AvailableBalance: testDecimal(3),
+					UnrealizedPNL:    testDecimal(0),
+					MarginHold:       testDecimal(2),
+				},
+			},
+			BankBalances: []*model.BankBalance{{Denom: "inj", PriceUSD: 10, Balance: testDecimal(1)}},
+			UpdatedAt:    time.UnixMilli(1646092800000),
+		},
+	}
+}
+
+func TestStreamPortfolioUpdatesInvalidFilter(t *testing.T) {
+	s, dbSvc := newTestService(t)
+	ctx := context.Background()
+
+	invalidID := "invalid"
+	err := s.StreamPortfolioUpdates(ctx, &svc.StreamPortfolioUpdatesPayload{GuildID: &invalidID}, &testPortfolioStream{})
+	assertAPIError(t, err, errNameInvalidArg, errcode.InvalidArgument)
+
+	unknownID := primitive.NewObjectID().Hex()
+	dbSvc.EXPECT().GetSingleGuild(ctx, unknownID).Return(nil, db.ErrNotFound)
+	err = s.StreamPortfolioUpdates(ctx, &svc.StreamPortfolioUpdatesPayload{GuildID: &unknownID}, &testPortfolioStream{})
+	assertAPIError(t, err, errNameNotFound, errcode.GuildNotFound)
+
+	invalidAddress := "inj1invalid"
+	err = s.StreamPortfolioUpdates(ctx, &svc.StreamPortfolioUpdatesPayload{InjectiveAddress: &invalidAddress}, &testPortfolioStream{})
+	assertAPIError(t, err, errNameInvalidArg, errcode.InvalidArgument)
+}
+
+func TestStreamPortfolioUpdatesResume(t *testing.T) {
+	s, _ := newTestService(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	guildID := primitive.NewObjectID()
+	assert.NoError(t, s.portfolioUpdates.publish(testMemberJoined(t, "1", guildID)))
+	assert.NoError(t, s.portfolioUpdates.publish(testGuildPortfolioUpdate("2", guildID)))
+	assert.NoError(t, s.portfolioUpdates.publish(testMemberJoined(t, "3", guildID)))
+
+	// guild portfolio after last event id is filtered out by address
+	stream := &testPortfolioStream{cancel: cancel}
+	injectiveAddress, lastEventID := testAddress, "1"
+	err := s.StreamPortfolioUpdates(ctx, &svc.StreamPortfolioUpdatesPayload{
+		InjectiveAddress: &injectiveAddress,
+		LastEventID:      &lastEventID,
+	}, stream)
+	assert.NoError(t, err)
+	assert.True(t, stream.closed)
+
+	if assert.Len(t, stream.updates, 1) {
+		update := stream.updates[0]
+		assert.Equal(t, "3", update.ID)
+		assert.Equal(t, model.PortfolioUpdateMemberJoined, update.Kind)
+		assert.Equal(t, guildID.Hex(), update.GuildID)
+		assert.Equal(t, testAddress, *update.InjectiveAddress)
+		assert.Nil(t, update.Portfolio)
+		assert.Equal(t, &svc.StreamMembership{Since: 1646092800000}, update.Membership)
+	}
+}
+
+func TestPortfolioUpdateHubFanOut(t *testing.T) {
+	s, _ := newTestService(t)
+	hub := s.portfolioUpdates
+
+	guildID, otherGuildID := primitive.NewObjectID(), primitive.NewObjectID()
+	guildHex := guildID.Hex()
+	all := hub.Subscribe(model.PortfolioUpdateFilter{}, "")
+	guild := hub.Subscribe(model.PortfolioUpdateFilter{GuildID: &guildHex}, "")
+	defer all.Close()
+	defer guild.Close()
+
+	assert.NoError(t, hub.publish(testGuildPortfolioUpdate("1", otherGuildID)))
+	assert.NoError(t, hub.publish(testGuildPortfolioUpdate("2", guildID)))
+
+	assert.Equal(t, []string{"1", "2"}, updateIDs(pendingUpdates(all)))
+	assert.Equal(t, []string{"2"}, updateIDs(pendingUpdates(guild)))
+
+	// unknown last event id starts from now
+	resumed := hub.Subscribe(model.PortfolioUpdateFilter{}, "unknown")
+	defer resumed.Close()
+	assert.Empty(t, pendingUpdates(resumed))
+}
+
+func TestPortfolioUpdateHubDropsSlowSubscriber(t *testing.T) {
+	s, _ := newTestService(t)
+	hub := s.portfolioUpdates
+
+	slow := hub.Subscribe(model.PortfolioUpdateFilter{}, "")
+	for i := 0; i <= subscriberBufferSize; i++ {
+		assert.NoError(t, hub.publish(testGuildPortfolioUpdate("", primitive.NewObjectID())))
+	}
+
+	select {
+	case <-slow.Dropped():
+	default:
+		t.Fatal("slow subscriber is not dropped")
+	}
+	assert.Len(t, pendingUpdates(slow), subscriberBufferSize)
+	assert.Empty(t, hub.subscribers)
+
+	// closing dropped subscription is a no-op
+	slow.Close()
+}
+
+func TestPortfolioUpdateHubRun(t *testing.T) {
+	s, dbSvc := newTestService(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s.portfolioUpdates.minBackoff = time.Millisecond
+	guildID := primitive.NewObjectID()
+	gomock.InOrder(
+		dbSvc.EXPECT().WatchPortfolioUpdates(ctx, "", gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, handler func(*model.PortfolioUpdate) error) error {
+				assert.NoError(t, handler(testGuildPortfolioUpdate("1", guildID)))
+				return errors.New("connection reset")
+			}),
+		// reopened after the last update
+		dbSvc.EXPECT().WatchPortfolioUpdates(ctx, "1", gomock.Any()).Return(db.ErrHistoryLost),
+		// token is too old, watch from now
+		dbSvc.EXPECT().WatchPortfolioUpdates(ctx, "", gomock.Any()).
+			DoAndReturn(func(context.Context, string, func(*model.PortfolioUpdate) error) error {
+				cancel()
+				return nil
+			}),
+	)
+
+	done := make(chan struct{})
+	go func() {
+		s.portfolioUpdates.Run(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("hub didn't stop")
+	}
+}
+
+func updateIDs(updates []*model.PortfolioUpdate) []string {
+	ids := make([]string, 0, len(updates))
+	for _, u := range updates {
+		ids = append(ids, u.ID)
+	}
+	return ids
+}
+
+func TestModelPortfolioUpdateToStream(t *testing.T) {
+	guildID := primitive.NewObjectID()
+	memberCount := 2
+
+	// inj bank balance is merged into a copy, shared update is converted the same way for every subscriber
+	update := testGuildPortfolioUpdate("1", guildID)
+	for i := 0; i < 2; i++ {
+		assert.Equal(t, &streamEvent{
+			GuildID: guildID.Hex(),
+			Portfolio: &streamPortfolio{
+				Balances: []*streamBalance{{
+					Denom:            "inj",
+					PriceUSD:         10,
+					TotalBalance:     "6",
+					AvailableBalance: "4",
+					UnrealizedPNL:    "0",
+					MarginHold:       "2",
+				}},
+				MemberCount: &memberCount,
+				UpdatedAt:   1646092800000,
+			},
+		}, modelPortfolioUpdateToStream(update))
+	}
+
+	joined := testMemberJoined(t, "2", guildID)
+	assert.Equal(t, &streamEvent{
+		GuildID:          guildID.Hex(),
+		InjectiveAddress: testAddress,
+		Membership:       &streamMembership{Since: 1646092800000},
+	}, modelPortfolioUpdateToStream(joined))
+
+	endedAt := int64(1648771200000)
+	left := &model.PortfolioUpdate{
+		ID:               "3",
+		Kind:             model.PortfolioUpdateMemberLeft,
+		GuildID:          guildID,
+		InjectiveAddress: joined.InjectiveAddress,
+		Membership: &model.Membership{
+			GuildID:              guildID,
+			InjectiveAddress:     joined.InjectiveAddress,
+			IsDefaultGuildMember: true,
+			Since:                time.UnixMilli(1646092800000),
+			EndedAt:              time.UnixMilli(endedAt),
+		},
+	}
+	assert.Equal(t, &streamEvent{
+		GuildID:          guildID.Hex(),
+		InjectiveAddress: testAddress,
+		Membership:       &streamMembership{IsDefaultGuildMember: true, Since: 1646092800000, EndedAt: &endedAt},
+	}, modelPortfolioUpdateToStream(left))
+}
+
+func TestWriteEvent(t *testing.T) {
+	guildID, err := primitive.ObjectIDFromHex("62283b3c9f6a5c41a1b5a3c1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w := httptest.NewRecorder()
+	assert.NoError(t, writeEvent(w, testMemberJoined(t, "8262", guildID)))
+	assert.Equal(t, "id: 8262\n"+
+		"event: member_joined\n"+
+		`data: {"guild_id":"62283b3c9f6a5c41a1b5a3c1","injective_address":"`+testAddress+`",`+
+		`"membership":{"is_default_guild_member":false,"since":1646092800000}}`+"\n\n", w.Body.String())
+}
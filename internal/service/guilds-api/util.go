@@ -1,13 +1,15 @@
 package guildsapi
 
 import (
+	"fmt"
 	"math"
+	"sort"
 	"time"
 
 	svc "github.com/InjectiveLabs/injective-guilds-service/api/gen/guilds_service"
 	"github.com/InjectiveLabs/injective-guilds-service/internal/config"
 	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
-	"github.com/ethereum/go-ethereum/common"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/performance"
 	"github.com/shopspring/decimal"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
@@ -93,6 +95,7 @@ func modelGuildToResponse(m *model.Guild, portfolio *model.GuildPortfolio, defau
 		requirements    []*svc.Requirement
 		balances        []*svc.Balance
 		denomToUsdPrice = make(map[string]float64)
+		accessMode      = m.GetAccessMode()
 	)
 
 	if len(portfolio.BankBalances) > 0 && portfolio.BankBalances[0].Denom == config.DEMOM_INJ {
@@ -149,6 +152,9 @@ func modelGuildToResponse(m *model.Guild, portfolio *model.GuildPortfolio, defau
 		MemberCount:          m.MemberCount,
 		CurrentPortfolio:     currentPortfolio,
 		DefaultMemberAddress: defaultMember.InjectiveAddress.String(),
+		HasInactiveMarkets:   &m.HasInactiveMarkets,
+		Archived:             &m.Archived,
+		AccessMode:           &accessMode,
 	}
 }
 
@@ -159,7 +165,316 @@ func sum(a primitive.Decimal128, b primitive.Decimal128) primitive.Decimal128 {
 	return result
 }
 
-func defaultSubaccountIDFromInjAddress(injAddress model.Address) string {
-	ethAddr := common.BytesToAddress(injAddress.Bytes())
-	return ethAddr.Hex() + "000000000000000000000000"
+// parseMemberSubaccountIDs validates ownership of registered subaccounts, default subaccount and duplicates are dropped
+func parseMemberSubaccountIDs(address model.Address, subaccountIDs []string) ([]string, error) {
+	if len(subaccountIDs) > maxMemberSubaccounts {
+		return nil, fmt.Errorf("too many subaccounts: %d > %d", len(subaccountIDs), maxMemberSubaccounts)
+	}
+
+	var result []string
+	seen := make(map[string]bool)
+	for _, subaccountID := range subaccountIDs {
+		id, err := model.ParseOwnedSubaccountID(address, subaccountID)
+		if err != nil {
+			return nil, err
+		}
+
+		if model.IsDefaultSubaccountID(id) || seen[id] {
+			continue
+		}
+		seen[id] = true
+		result = append(result, id)
+	}
+	return result, nil
+}
+
+func tradeFilterFromPayload(startTime, endTime *int64) model.TradeFilter {
+	var filter model.TradeFilter
+	if startTime != nil {
+		from := time.UnixMilli(*startTime)
+		filter.StartTime = &from
+	}
+
+	if endTime != nil {
+		to := time.UnixMilli(*endTime)
+		filter.EndTime = &to
+	}
+	return filter
+}
+
+func modelTradeToHTTP(t *model.Trade) *svc.Trade {
+	return &svc.Trade{
+		TradeID:       t.TradeID,
+		SubaccountID:  t.SubaccountID,
+		MarketID:      t.MarketID,
+		IsPerpetual:   t.IsPerpetual,
+		Direction:     t.Direction,
+		ExecutionType: t.ExecutionType,
+		Price:         t.Price.String(),
+		Quantity:      t.Quantity.String(),
+		QuoteDenom:    t.QuoteDenom,
+		Fee:           t.Fee.String(),
+		Volume:        t.Volume.String(),
+		RealizedPnl:   t.RealizedPNL.String(),
+		ExecutedAt:    t.ExecutedAt.UnixMilli(),
+	}
+}
+
+func modelTradeStatsToHTTP(stats []*model.TradeStats) []*svc.TradeStats {
+	result := make([]*svc.TradeStats, 0, len(stats))
+	for _, s := range stats {
+		result = append(result, &svc.TradeStats{
+			QuoteDenom:  s.QuoteDenom,
+			RealizedPnl: s.RealizedPNL.String(),
+			Fees:        s.Fees.String(),
+			Volume:      s.Volume.String(),
+			TradeCount:  s.TradeCount,
+		})
+	}
+	return result
+}
+
+type feeAccumulator struct {
+	feesPaid       decimal.Decimal
+	masterFees     decimal.Decimal
+	unresolvedFees decimal.Decimal
+	volume         decimal.Decimal
+	tradeCount     int
+}
+
+func (a *feeAccumulator) add(f *model.DailyFee) {
+	feesPaid, _ := decimal.NewFromString(f.FeesPaid.String())
+	masterFees, _ := decimal.NewFromString(f.MasterFees.String())
+	// fees accounted before unresolved fees were tracked don't have them
+	unresolvedFees, _ := decimal.NewFromString(f.UnresolvedFees.String())
+	volume, _ := decimal.NewFromString(f.Volume.String())
+
+	a.feesPaid = a.feesPaid.Add(feesPaid)
+	a.masterFees = a.masterFees.Add(masterFees)
+	a.unresolvedFees = a.unresolvedFees.Add(unresolvedFees)
+	a.volume = a.volume.Add(volume)
+	a.tradeCount += f.TradeCount
+}
+
+type memberFeeKey struct {
+	address    string
+	quoteDenom string
+}
+
+type dailyFeeKey struct {
+	day        time.Time
+	quoteDenom string
+}
+
+// summarizeDailyFees aggregates daily member fees into totals per denom, per member contribution and per day
+func summarizeDailyFees(fees []*model.DailyFee) (
+	totals []*svc.GuildFeeTotal,
+	members []*svc.MemberFeeContribution,
+	daily []*svc.DailyGuildFee,
+) {
+	totalAcc := make(map[string]*feeAccumulator)
+	memberAcc := make(map[memberFeeKey]*feeAccumulator)
+	dailyAcc := make(map[dailyFeeKey]*feeAccumulator)
+
+	for _, f := range fees {
+		if _, ok := totalAcc[f.QuoteDenom]; !ok {
+			totalAcc[f.QuoteDenom] = &feeAccumulator{}
+		}
+		totalAcc[f.QuoteDenom].add(f)
+
+		mKey := memberFeeKey{address: f.InjectiveAddress, quoteDenom: f.QuoteDenom}
+		if _, ok := memberAcc[mKey]; !ok {
+			memberAcc[mKey] = &feeAccumulator{}
+		}
+		memberAcc[mKey].add(f)
+
+		dKey := dailyFeeKey{day: f.Day, quoteDenom: f.QuoteDenom}
+		if _, ok := dailyAcc[dKey]; !ok {
+			dailyAcc[dKey] = &feeAccumulator{}
+		}
+		dailyAcc[dKey].add(f)
+	}
+
+	for denom, acc := range totalAcc {
+		totals = append(totals, &svc.GuildFeeTotal{
+			QuoteDenom:     denom,
+			FeesPaid:       acc.feesPaid.String(),
+			MasterFees:     acc.masterFees.String(),
+			UnresolvedFees: acc.unresolvedFees.String(),
+			Volume:         acc.volume.String(),
+			TradeCount:     acc.tradeCount,
+		})
+	}
+	sort.Slice(totals, func(i, j int) bool {
+		return totals[i].QuoteDenom < totals[j].QuoteDenom
+	})
+
+	for key, acc := range memberAcc {
+		share := decimal.Zero
+		if total := totalAcc[key.quoteDenom].masterFees; !total.IsZero() {
+			share = acc.masterFees.Div(total)
+		}
+
+		members = append(members, &svc.MemberFeeContribution{
+			InjectiveAddress: key.address,
+			QuoteDenom:       key.quoteDenom,
+			FeesPaid:         acc.feesPaid.String(),
+			MasterFees:       acc.masterFees.String(),
+			UnresolvedFees:   acc.unresolvedFees.String(),
+			Volume:           acc.volume.String(),
+			TradeCount:       acc.tradeCount,
+			Share:            share.String(),
+		})
+	}
+	sort.Slice(members, func(i, j int) bool {
+		if members[i].QuoteDenom != members[j].QuoteDenom {
+			return members[i].QuoteDenom < members[j].QuoteDenom
+		}
+		return members[i].InjectiveAddress < members[j].InjectiveAddress
+	})
+
+	for key, acc := range dailyAcc {
+		daily = append(daily, &svc.DailyGuildFee{
+			Day:            key.day.UnixMilli(),
+			QuoteDenom:     key.quoteDenom,
+			FeesPaid:       acc.feesPaid.String(),
+			MasterFees:     acc.masterFees.String(),
+			UnresolvedFees: acc.unresolvedFees.String(),
+			Volume:         acc.volume.String(),
+			TradeCount:     acc.tradeCount,
+		})
+	}
+	sort.Slice(daily, func(i, j int) bool {
+		if daily[i].Day != daily[j].Day {
+			return daily[i].Day < daily[j].Day
+		}
+		return daily[i].QuoteDenom < daily[j].QuoteDenom
+	})
+
+	return totals, members, daily
+}
+
+func modelCashFlowsToHTTP(flows []*model.CashFlow) []*svc.CashFlow {
+	result := make([]*svc.CashFlow, 0, len(flows))
+	for _, f := range flows {
+		flow := &svc.CashFlow{
+			Type:       f.Type,
+			Denom:      f.Denom,
+			Amount:     f.Amount.String(),
+			ExecutedAt: f.ExecutedAt.UnixMilli(),
+		}
+
+		if !f.InjectiveAddress.IsEmpty() {
+			address := f.InjectiveAddress.String()
+			flow.InjectiveAddress = &address
+		}
+		result = append(result, flow)
+	}
+	return result
+}
+
+func portfolioPoint(
+	updatedAt time.Time,
+	balances []*model.Balance,
+	bankBalances []*model.BankBalance,
+	flows []*model.CashFlow,
+	decimals map[string]int,
+) performance.Point {
+	value, _ := performance.PortfolioValueUSD(balances, bankBalances, decimals).Float64()
+	netFlow, _ := performance.CashFlowsValueUSD(flows, balances, bankBalances, decimals).Float64()
+	return performance.Point{
+		Time:    updatedAt,
+		Value:   value,
+		NetFlow: netFlow,
+	}
+}
+
+// newestFirstReturns computes cumulative returns of points sorted newest first, result keeps the order
+func newestFirstReturns(points []performance.Point) []performance.Return {
+	n := len(points)
+	ascending := make([]performance.Point, n)
+	for i, p := range points {
+		ascending[n-1-i] = p
+	}
+
+	returns := performance.Returns(ascending)
+	result := make([]performance.Return, n)
+	for i, r := range returns {
+		result[n-1-i] = r
+	}
+	return result
+}
+
+func modelTransferToHTTP(t *model.Transfer) *svc.Transfer {
+	return &svc.Transfer{
+		TransferID:   t.TransferID,
+		SubaccountID: t.SubaccountID,
+		TransferType: t.TransferType,
+		Denom:        t.Denom,
+		Amount:       t.Amount.String(),
+		ExecutedAt:   t.ExecutedAt.UnixMilli(),
+	}
+}
+
+func modelNetFlowsToHTTP(flows []*model.NetFlow) []*svc.NetFlow {
+	result := make([]*svc.NetFlow, 0, len(flows))
+	for _, f := range flows {
+		result = append(result, &svc.NetFlow{
+			Denom:         f.Denom,
+			Deposits:      f.Deposits.String(),
+			Withdrawals:   f.Withdrawals.String(),
+			NetFlow:       f.NetFlow.String(),
+			TransferCount: f.TransferCount,
+		})
+	}
+	return result
+}
+
+func modelPositionsToHTTP(positions []*model.Position) []*svc.Position {
+	result := make([]*svc.Position, 0, len(positions))
+	for _, p := range positions {
+		aPosition := &svc.Position{
+			SubaccountID:     p.SubaccountID,
+			MarketID:         p.MarketID,
+			Ticker:           p.Ticker,
+			Direction:        p.Direction,
+			Quantity:         p.Quantity.String(),
+			EntryPrice:       p.EntryPrice.String(),
+			MarkPrice:        p.MarkPrice.String(),
+			Margin:           p.Margin.String(),
+			LiquidationPrice: p.LiquidationPrice.String(),
+		}
+		if p.QuoteDenom != "" {
+			quoteDenom := p.QuoteDenom
+			aPosition.QuoteDenom = &quoteDenom
+		}
+		result = append(result, aPosition)
+	}
+	return result
+}
+
+func modelGuildRiskToHTTP(r *model.GuildRisk) *svc.GuildRisk {
+	markets := make([]*svc.MarketExposure, 0, len(r.Markets))
+	for _, m := range r.Markets {
+		markets = append(markets, &svc.MarketExposure{
+			MarketID:    m.MarketID,
+			Ticker:      m.Ticker,
+			NotionalUsd: m.NotionalUSD,
+			Share:       m.Share,
+		})
+	}
+
+	return &svc.GuildRisk{
+		GuildID:       r.GuildID.Hex(),
+		WindowStart:   r.WindowStart.UnixMilli(),
+		SnapshotCount: r.SnapshotCount,
+		MaxDrawdown:   r.MaxDrawdown,
+		Volatility:    r.Volatility,
+		SharpeRatio:   r.SharpeRatio,
+		NotionalUsd:   r.NotionalUSD,
+		EquityUsd:     r.EquityUSD,
+		Leverage:      r.Leverage,
+		Markets:       markets,
+		UpdatedAt:     r.UpdatedAt.UnixMilli(),
+	}
 }
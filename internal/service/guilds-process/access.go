@@ -0,0 +1,128 @@
+package guildsprocess
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
+)
+
+const (
+	AccessReasonNotAllowlisted    = "not_allowlisted"
+	AccessReasonInvalidInviteCode = "invalid_invite_code"
+)
+
+// InviteCodeFromParams returns invite code carried by member params,
+// params is either {"invite_code": "<code>"} or the code itself
+func InviteCodeFromParams(params string) string {
+	var p struct {
+		InviteCode string `json:"invite_code"`
+	}
+	if err := json.Unmarshal([]byte(params), &p); err == nil {
+		return strings.TrimSpace(p.InviteCode)
+	}
+	return strings.TrimSpace(params)
+}
+
+// MemberParams returns params to store with member or waitlist entry. Params are returned by public apis,
+// invite code is removed from them, it's kept by db only where it's needed to use or release the code
+func MemberParams(guild *model.Guild, params string) string {
+	var p map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(params), &p); err != nil || p == nil {
+		// params of invite-only guild is the code itself
+		if guild.GetAccessMode() == model.AccessModeInviteCode {
+			return ""
+		}
+		return params
+	}
+
+	if _, ok := p["invite_code"]; !ok {
+		return params
+	}
+
+	delete(p, "invite_code")
+	if len(p) == 0 {
+		return ""
+	}
+
+	result, _ := json.Marshal(p)
+	return string(result)
+}
+
+// GuildInviteCode returns invite code carried by params if guild is invite-only, empty otherwise
+func GuildInviteCode(guild *model.Guild, params string) string {
+	if guild.GetAccessMode() != model.AccessModeInviteCode {
+		return ""
+	}
+	return InviteCodeFromParams(params)
+}
+
+// CheckAccess returns reason if address cannot enter guild by its access mode.
+// Invite code is only checked here, it's used when member is added. reservedCode is a code
+// whose use is already reserved for address by its waitlist entry, it's accepted as is
+func CheckAccess(
+	ctx context.Context,
+	dbSvc db.DBService,
+	guild *model.Guild,
+	address model.Address,
+	code string,
+	reservedCode string,
+) (string, error) {
+	guildID := guild.ID.Hex()
+	switch guild.GetAccessMode() {
+	case model.AccessModeAllowlist:
+		allowed, err := dbSvc.IsAllowlisted(ctx, guildID, address)
+		if err != nil {
+			return "", fmt.Errorf("check allowlist err: %w", err)
+		}
+
+		if !allowed {
+			return AccessReasonNotAllowlisted, nil
+		}
+	case model.AccessModeInviteCode:
+		if code == "" {
+			return AccessReasonInvalidInviteCode, nil
+		}
+
+		if code == reservedCode {
+			return "", nil
+		}
+
+		inviteCode, err := dbSvc.GetInviteCode(ctx, guildID, code)
+		if err == db.ErrNotFound {
+			return AccessReasonInvalidInviteCode, nil
+		}
+
+		if err != nil {
+			return "", fmt.Errorf("get invite code err: %w", err)
+		}
+
+		if !inviteCode.IsUsable(time.Now()) {
+			return AccessReasonInvalidInviteCode, nil
+		}
+	}
+
+	return "", nil
+}
+
+// AddMemberWithAccess adds member like db AddMember, one use of invite code is taken for invite-only guilds
+// in the same transaction. Params are stored without invite code. Db errors are returned as is
+func AddMemberWithAccess(
+	ctx context.Context,
+	dbSvc db.DBService,
+	guild *model.Guild,
+	address model.Address,
+	portfolio *model.AccountPortfolio,
+	params string,
+	subaccountIDs []string,
+	inviteCode string,
+) error {
+	if guild.GetAccessMode() != model.AccessModeInviteCode {
+		inviteCode = ""
+	}
+	return dbSvc.AddMember(ctx, guild.ID.Hex(), address, portfolio, false, MemberParams(guild, params), subaccountIDs, inviteCode)
+}
@@ -0,0 +1,156 @@
+package guildsprocess
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
+	cosmtypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestInviteCodeFromParams(t *testing.T) {
+	assert.Equal(t, "a1b2c3", InviteCodeFromParams(`{"invite_code": "a1b2c3"}`))
+	assert.Equal(t, "a1b2c3", InviteCodeFromParams(" a1b2c3 "))
+	assert.Equal(t, "", InviteCodeFromParams(`{"referrer": "inj1"}`))
+	assert.Equal(t, "", InviteCodeFromParams(""))
+}
+
+func TestInviteCodeIsUsable(t *testing.T) {
+	now := time.Now()
+	code := &model.InviteCode{MaxUses: 2, Uses: 1}
+	assert.True(t, code.IsUsable(now))
+
+	code.Uses = 2
+	assert.False(t, code.IsUsable(now))
+
+	expiresAt := now.Add(-time.Minute)
+	code = &model.InviteCode{MaxUses: 1, ExpiresAt: &expiresAt}
+	assert.False(t, code.IsUsable(now))
+}
+
+func TestCheckAccess(t *testing.T) {
+	ctx := context.Background()
+	address := model.Address{AccAddress: cosmtypes.AccAddress("access_member_______")}
+	guildID := primitive.NewObjectID()
+
+	testCases := []struct {
+		name         string
+		mode         string
+		code         string
+		reservedCode string
+		setup        func(m *db.MockDBService)
+		reason       string
+	}{
+		{
+			name:  "open",
+			mode:  model.AccessModeOpen,
+			setup: func(m *db.MockDBService) {},
+		},
+		{
+			name: "allowlisted",
+			mode: model.AccessModeAllowlist,
+			setup: func(m *db.MockDBService) {
+				m.EXPECT().IsAllowlisted(ctx, guildID.Hex(), address).Return(true, nil)
+			},
+		},
+		{
+			name: "not allowlisted",
+			mode: model.AccessModeAllowlist,
+			setup: func(m *db.MockDBService) {
+				m.EXPECT().IsAllowlisted(ctx, guildID.Hex(), address).Return(false, nil)
+			},
+			reason: AccessReasonNotAllowlisted,
+		},
+		{
+			name:   "missing invite code",
+			mode:   model.AccessModeInviteCode,
+			setup:  func(m *db.MockDBService) {},
+			reason: AccessReasonInvalidInviteCode,
+		},
+		{
+			name: "unknown invite code",
+			mode: model.AccessModeInviteCode,
+			code: "a1b2c3",
+			setup: func(m *db.MockDBService) {
+				m.EXPECT().GetInviteCode(ctx, guildID.Hex(), "a1b2c3").Return(nil, db.ErrNotFound)
+			},
+			reason: AccessReasonInvalidInviteCode,
+		},
+		{
+			name: "used up invite code",
+			mode: model.AccessModeInviteCode,
+			code: "a1b2c3",
+			setup: func(m *db.MockDBService) {
+				m.EXPECT().GetInviteCode(ctx, guildID.Hex(), "a1b2c3").Return(&model.InviteCode{MaxUses: 1, Uses: 1}, nil)
+			},
+			reason: AccessReasonInvalidInviteCode,
+		},
+		{
+			name: "usable invite code",
+			mode: model.AccessModeInviteCode,
+			code: "a1b2c3",
+			setup: func(m *db.MockDBService) {
+				m.EXPECT().GetInviteCode(ctx, guildID.Hex(), "a1b2c3").Return(&model.InviteCode{MaxUses: 2, Uses: 1}, nil)
+			},
+		},
+		{
+			name:         "reserved invite code isn't checked again",
+			mode:         model.AccessModeInviteCode,
+			code:         "a1b2c3",
+			reservedCode: "a1b2c3",
+			setup:        func(m *db.MockDBService) {},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dbSvc := db.NewMockDBService(gomock.NewController(t))
+			tc.setup(dbSvc)
+
+			guild := &model.Guild{ID: guildID, AccessMode: tc.mode}
+			reason, err := CheckAccess(ctx, dbSvc, guild, address, tc.code, tc.reservedCode)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.reason, reason)
+		})
+	}
+}
+
+func TestAddMemberWithAccess(t *testing.T) {
+	ctx := context.Background()
+	address := model.Address{AccAddress: cosmtypes.AccAddress("access_member_______")}
+	params := `{"invite_code": "a1b2c3", "referrer": "inj1"}`
+	subaccountIDs := []string{"0x01"}
+
+	// invite code is passed to db to be used in the same transaction, it's not stored with params
+	guild := &model.Guild{ID: primitive.NewObjectID(), AccessMode: model.AccessModeInviteCode}
+	dbSvc := db.NewMockDBService(gomock.NewController(t))
+	dbSvc.EXPECT().AddMember(ctx, guild.ID.Hex(), address, gomock.Any(), false, `{"referrer":"inj1"}`, subaccountIDs, "a1b2c3").
+		Return(db.ErrInvalidInviteCode)
+	err := AddMemberWithAccess(ctx, dbSvc, guild, address, &model.AccountPortfolio{}, params, subaccountIDs, "a1b2c3")
+	assert.ErrorIs(t, err, db.ErrInvalidInviteCode)
+
+	// open guild doesn't use a code
+	guild = &model.Guild{ID: primitive.NewObjectID()}
+	dbSvc.EXPECT().AddMember(ctx, guild.ID.Hex(), address, gomock.Any(), false, `{"referrer":"inj1"}`, subaccountIDs, "").Return(nil)
+	err = AddMemberWithAccess(ctx, dbSvc, guild, address, &model.AccountPortfolio{}, params, subaccountIDs, "a1b2c3")
+	assert.NoError(t, err)
+}
+
+func TestMemberParams(t *testing.T) {
+	inviteOnly := &model.Guild{AccessMode: model.AccessModeInviteCode}
+	open := &model.Guild{}
+
+	assert.Equal(t, `{"referrer":"inj1"}`, MemberParams(inviteOnly, `{"invite_code": "a1b2c3", "referrer": "inj1"}`))
+	assert.Equal(t, "", MemberParams(inviteOnly, `{"invite_code": "a1b2c3"}`))
+	assert.Equal(t, "", MemberParams(inviteOnly, "a1b2c3"))
+	assert.Equal(t, `{"referrer": "inj1"}`, MemberParams(inviteOnly, `{"referrer": "inj1"}`))
+	assert.Equal(t, "", MemberParams(open, `{"invite_code": "a1b2c3"}`))
+	// plain params of other guilds aren't a code
+	assert.Equal(t, "hello", MemberParams(open, "hello"))
+	assert.Equal(t, "", MemberParams(open, ""))
+}
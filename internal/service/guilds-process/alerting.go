@@ -0,0 +1,264 @@
+package guildsprocess
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/notifier"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/performance"
+	metrics "github.com/InjectiveLabs/metrics"
+)
+
+type alertResult struct {
+	firing  bool
+	value   float64
+	message string
+}
+
+// evaluateAlerts evaluates alert rules of every guild, notifications are sent when an alert starts firing or gets resolved
+func (p *GuildsProcess) evaluateAlerts(ctx context.Context) error {
+	doneFn := metrics.ReportFuncTiming(p.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(p.svcTags)
+
+	rules, err := p.dbSvc.ListAlertRules(ctx, model.AlertRuleFilter{})
+	if err != nil {
+		return fmt.Errorf("list alert rules err: %w", err)
+	}
+
+	if len(rules) == 0 {
+		return nil
+	}
+
+	guilds, err := p.dbSvc.ListAllGuilds(ctx, false)
+	if err != nil {
+		return fmt.Errorf("list guild err: %w", err)
+	}
+
+	states, err := p.dbSvc.ListAlertStates(ctx)
+	if err != nil {
+		return fmt.Errorf("list alert states err: %w", err)
+	}
+
+	keyToState := make(map[string]*model.AlertState)
+	for _, s := range states {
+		keyToState[alertStateKey(s.RuleID.Hex(), s.GuildID.Hex())] = s
+	}
+
+	now := time.Now()
+	for _, guild := range guilds {
+		for _, rule := range rules {
+			if rule.GuildID != nil && *rule.GuildID != guild.ID {
+				continue
+			}
+
+			logger := p.logger.WithField("guild_id", guild.ID.Hex()).WithField("rule_id", rule.ID.Hex())
+			result, err := p.evaluateAlertRule(ctx, rule, guild, now)
+			if err != nil {
+				logger.WithError(err).Warningln("cannot evaluate alert rule")
+				continue
+			}
+
+			prev := keyToState[alertStateKey(rule.ID.Hex(), guild.ID.Hex())]
+			state, kind := nextAlertState(prev, rule, guild, result, now)
+			if err := p.dbSvc.UpsertAlertState(ctx, state); err != nil {
+				logger.WithError(err).Warningln("cannot save alert state")
+				continue
+			}
+
+			if kind != "" {
+				p.sendAlert(ctx, rule, guild, state, kind)
+			}
+		}
+	}
+	return nil
+}
+
+func alertStateKey(ruleID, guildID string) string {
+	return ruleID + "/" + guildID
+}
+
+func (p *GuildsProcess) evaluateAlertRule(
+	ctx context.Context,
+	rule *model.AlertRule,
+	guild *model.Guild,
+	now time.Time,
+) (*alertResult, error) {
+	switch rule.Kind {
+	case model.AlertKindEquityDrop:
+		start := now.Add(-rule.Window)
+		history, err := p.dbSvc.ListGuildPortfolios(ctx, model.GuildPortfoliosFilter{
+			GuildID:   guild.ID.Hex(),
+			StartTime: &start,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list guild portfolios err: %w", err)
+		}
+		return evaluateEquityDrop(rule, guild, history), nil
+	case model.AlertKindMemberCountBelow:
+		return evaluateMemberCountBelow(rule, guild), nil
+	case model.AlertKindCaptureStale:
+		limit := int64(1)
+		latest, err := p.dbSvc.ListGuildPortfolios(ctx, model.GuildPortfoliosFilter{
+			GuildID: guild.ID.Hex(),
+			Limit:   &limit,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list guild portfolios err: %w", err)
+		}
+		return evaluateCaptureStale(rule, latest, now), nil
+	case model.AlertKindLeverageAbove:
+		risk, err := p.dbSvc.GetLatestGuildRisk(ctx, guild.ID.Hex())
+		if err != nil && err != db.ErrNotFound {
+			return nil, fmt.Errorf("get guild risk err: %w", err)
+		}
+		return evaluateLeverageAbove(rule, risk), nil
+	default:
+		return nil, fmt.Errorf("unsupported alert kind: %s", rule.Kind)
+	}
+}
+
+// evaluateEquityDrop computes time-weighted return over history (sorted newest first),
+// so members depositing or withdrawing don't fire or hide a drop
+func evaluateEquityDrop(rule *model.AlertRule, guild *model.Guild, history []*model.GuildPortfolio) *alertResult {
+	if len(history) < 2 {
+		return &alertResult{}
+	}
+
+	points := guildPortfolioPoints(history, performance.DenomDecimals(guild))
+	oldest, newest := points[0], points[len(points)-1]
+	if oldest.Value <= 0 {
+		return &alertResult{}
+	}
+
+	returns := performance.Returns(points)
+	drop := -returns[len(returns)-1].TWR
+	return &alertResult{
+		firing: drop > rule.Threshold,
+		value:  drop,
+		message: fmt.Sprintf(
+			"guild time-weighted return is %.2f%% since %s (value $%.2f to $%.2f), threshold %.2f%% drop",
+			-drop*100, oldest.Time.Format(time.RFC3339), oldest.Value, newest.Value, rule.Threshold*100,
+		),
+	}
+}
+
+func evaluateMemberCountBelow(rule *model.AlertRule, guild *model.Guild) *alertResult {
+	count := float64(guild.MemberCount)
+	return &alertResult{
+		firing:  count < rule.Threshold,
+		value:   count,
+		message: fmt.Sprintf("guild has %d members, threshold %v", guild.MemberCount, rule.Threshold),
+	}
+}
+
+// evaluateCaptureStale fires if latest guild portfolio is older than window or guild has never been captured
+func evaluateCaptureStale(rule *model.AlertRule, latest []*model.GuildPortfolio, now time.Time) *alertResult {
+	if len(latest) == 0 {
+		return &alertResult{
+			firing:  true,
+			message: "guild portfolio has never been captured",
+		}
+	}
+
+	age := now.Sub(latest[0].UpdatedAt)
+	return &alertResult{
+		firing: age > rule.Window,
+		value:  age.Hours(),
+		message: fmt.Sprintf(
+			"latest guild portfolio was captured at %s (%s ago), window %s",
+			latest[0].UpdatedAt.Format(time.RFC3339), age.Truncate(time.Second), rule.Window,
+		),
+	}
+}
+
+func evaluateLeverageAbove(rule *model.AlertRule, risk *model.GuildRisk) *alertResult {
+	if risk == nil {
+		return &alertResult{}
+	}
+
+	return &alertResult{
+		firing:  risk.Leverage > rule.Threshold,
+		value:   risk.Leverage,
+		message: fmt.Sprintf("guild leverage is %.2fx, threshold %.2fx", risk.Leverage, rule.Threshold),
+	}
+}
+
+// nextAlertState returns new state and notification kind if firing status changed, empty kind otherwise
+func nextAlertState(
+	prev *model.AlertState,
+	rule *model.AlertRule,
+	guild *model.Guild,
+	result *alertResult,
+	now time.Time,
+) (*model.AlertState, string) {
+	state := &model.AlertState{
+		RuleID:    rule.ID,
+		GuildID:   guild.ID,
+		Firing:    result.firing,
+		Value:     result.value,
+		Message:   result.message,
+		Since:     now,
+		UpdatedAt: now,
+	}
+
+	wasFiring := prev != nil && prev.Firing
+	switch {
+	case result.firing && !wasFiring:
+		return state, notifier.KindAlertFiring
+	case !result.firing && wasFiring:
+		return state, notifier.KindAlertResolved
+	}
+
+	if prev != nil {
+		state.Since = prev.Since
+	}
+	return state, ""
+}
+
+// sendAlert notifies through sinks of rule, all configured sinks if rule has none
+func (p *GuildsProcess) sendAlert(
+	ctx context.Context,
+	rule *model.AlertRule,
+	guild *model.Guild,
+	state *model.AlertState,
+	kind string,
+) {
+	status := "FIRING"
+	if kind == notifier.KindAlertResolved {
+		status = "RESOLVED"
+	}
+
+	n := &notifier.Notification{
+		Kind:      kind,
+		GuildID:   guild.ID.Hex(),
+		Title:     fmt.Sprintf("[%s] %s: %s", status, guild.Name, rule.Kind),
+		Message:   state.Message,
+		CreatedAt: state.UpdatedAt,
+	}
+
+	sinks := rule.Sinks
+	if len(sinks) == 0 {
+		for name := range p.alertSinks {
+			sinks = append(sinks, name)
+		}
+	}
+
+	for _, name := range sinks {
+		sink, exist := p.alertSinks[name]
+		if !exist {
+			p.logger.WithField("rule_id", rule.ID.Hex()).Warningln("alert sink is not configured: ", name)
+			continue
+		}
+
+		if err := sink.Notify(ctx, n); err != nil {
+			p.logger.
+				WithField("rule_id", rule.ID.Hex()).
+				WithError(err).Warningln(strings.ToLower(status), " alert notify error through ", name)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package guildsprocess
+
+import (
+	"testing"
+	"time"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/notifier"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestEvaluateAlertRules(t *testing.T) {
+	dec := func(s string) primitive.Decimal128 {
+		d, _ := primitive.ParseDecimal128(s)
+		return d
+	}
+
+	now := time.Date(2022, 3, 2, 0, 0, 0, 0, time.UTC)
+	guild := &model.Guild{
+		ID:          primitive.NewObjectID(),
+		MemberCount: 3,
+		Markets: []*model.GuildMarket{
+			{QuoteDenom: denomUsdt, QuoteTokenMeta: &model.TokenMeta{Decimals: 6}},
+		},
+	}
+	snapshot := func(hoursAgo int, totalBalance string) *model.GuildPortfolio {
+		return &model.GuildPortfolio{
+			Balances:  []*model.Balance{{Denom: denomUsdt, TotalBalance: dec(totalBalance), UnrealizedPNL: dec("0")}},
+			UpdatedAt: now.Add(-time.Duration(hoursAgo) * time.Hour),
+		}
+	}
+
+	// 1000 -> 850 usdt is 15% drop
+	history := []*model.GuildPortfolio{snapshot(0, "850000000"), snapshot(12, "950000000"), snapshot(24, "1000000000")}
+	result := evaluateEquityDrop(&model.AlertRule{Threshold: 0.1}, guild, history)
+	assert.True(t, result.firing)
+	assert.InDelta(t, 0.15, result.value, 1e-9)
+	assert.False(t, evaluateEquityDrop(&model.AlertRule{Threshold: 0.2}, guild, history).firing)
+	assert.False(t, evaluateEquityDrop(&model.AlertRule{Threshold: 0.1}, guild, history[:1]).firing)
+
+	// value dropped by a 150 usdt withdrawal is not a loss
+	withdrawn := snapshot(0, "850000000")
+	withdrawn.CashFlows = []*model.CashFlow{{Type: model.CashFlowWithdraw, Denom: denomUsdt, Amount: dec("-150000000")}}
+	result = evaluateEquityDrop(&model.AlertRule{Threshold: 0.1}, guild, []*model.GuildPortfolio{withdrawn, history[1], history[2]})
+	assert.False(t, result.firing)
+	assert.InDelta(t, 0, result.value, 1e-9)
+
+	assert.True(t, evaluateMemberCountBelow(&model.AlertRule{Threshold: 5}, guild).firing)
+	assert.False(t, evaluateMemberCountBelow(&model.AlertRule{Threshold: 3}, guild).firing)
+
+	stale := &model.AlertRule{Window: 6 * time.Hour}
+	assert.True(t, evaluateCaptureStale(stale, nil, now).firing)
+	assert.True(t, evaluateCaptureStale(stale, history[2:], now).firing)
+	assert.False(t, evaluateCaptureStale(stale, history[:1], now).firing)
+
+	leverage := &model.AlertRule{Threshold: 3}
+	assert.False(t, evaluateLeverageAbove(leverage, nil).firing)
+	assert.True(t, evaluateLeverageAbove(leverage, &model.GuildRisk{Leverage: 3.5}).firing)
+	assert.False(t, evaluateLeverageAbove(leverage, &model.GuildRisk{Leverage: 2}).firing)
+}
+
+func TestNextAlertState(t *testing.T) {
+	rule := &model.AlertRule{ID: primitive.NewObjectID()}
+	guild := &model.Guild{ID: primitive.NewObjectID()}
+	start := time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	// first evaluation without firing doesn't notify
+	state, kind := nextAlertState(nil, rule, guild, &alertResult{}, start)
+	assert.Equal(t, "", kind)
+	assert.Equal(t, rule.ID, state.RuleID)
+	assert.Equal(t, guild.ID, state.GuildID)
+
+	state, kind = nextAlertState(state, rule, guild, &alertResult{firing: true, value: 1}, start.Add(time.Hour))
+	assert.Equal(t, notifier.KindAlertFiring, kind)
+	assert.Equal(t, start.Add(time.Hour), state.Since)
+
+	// still firing: no notification, since is kept
+	state, kind = nextAlertState(state, rule, guild, &alertResult{firing: true, value: 2}, start.Add(2*time.Hour))
+	assert.Equal(t, "", kind)
+	assert.Equal(t, start.Add(time.Hour), state.Since)
+	assert.Equal(t, 2.0, state.Value)
+
+	state, kind = nextAlertState(state, rule, guild, &alertResult{}, start.Add(3*time.Hour))
+	assert.Equal(t, notifier.KindAlertResolved, kind)
+	assert.False(t, state.Firing)
+	assert.Equal(t, start.Add(3*time.Hour), state.Since)
+}
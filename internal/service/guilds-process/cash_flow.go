@@ -0,0 +1,108 @@
+package guildsprocess
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/config"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/exchange"
+	"github.com/shopspring/decimal"
+)
+
+// captureCashFlows returns external flows of member's trading subaccounts in [memberSince, until)
+// for account snapshot and in [guildSince, until) for guild snapshot. Zero since means no previous snapshot, no flow.
+// Flows are read from transfers collection, member transfers are ingested first so it's up to date
+func (p *GuildsProcess) captureCashFlows(
+	ctx context.Context,
+	guild *model.Guild,
+	member *model.GuildMember,
+	memberSince, guildSince, until time.Time,
+) (memberFlows, guildFlows []*model.CashFlow, err error) {
+	if memberSince.IsZero() && guildSince.IsZero() {
+		return nil, nil, nil
+	}
+
+	if _, err := p.ingestMemberTransfers(ctx, guild, member); err != nil {
+		return nil, nil, fmt.Errorf("ingest transfers err: %w", err)
+	}
+
+	// snapshot times are stored in milliseconds, windows of consecutive snapshots must share the boundary
+	until = until.Truncate(time.Millisecond)
+	startTime := memberSince
+	if startTime.IsZero() || (!guildSince.IsZero() && guildSince.Before(startTime)) {
+		startTime = guildSince
+	}
+
+	guildID := guild.ID.Hex()
+	transfers, err := p.dbSvc.ListTransfers(ctx, model.TransferFilter{
+		GuildID:          &guildID,
+		InjectiveAddress: &member.InjectiveAddress,
+		StartTime:        &startTime,
+		EndTime:          &until,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("list transfers err: %w", err)
+	}
+
+	if !memberSince.IsZero() {
+		memberFlows, err = transferCashFlows(transfers, memberSince, until)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if !guildSince.IsZero() {
+		guildFlows, err = transferCashFlows(transfers, guildSince, until)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return memberFlows, guildFlows, nil
+}
+
+// transferCashFlows converts transfers in [since, until) to cash flows.
+// Inj deposits and withdrawals are skipped since inj bank balance is part of portfolio
+func transferCashFlows(transfers []*model.Transfer, since, until time.Time) ([]*model.CashFlow, error) {
+	var result []*model.CashFlow
+	for _, t := range transfers {
+		if t.ExecutedAt.Before(since) || !t.ExecutedAt.Before(until) {
+			continue
+		}
+
+		var flowType string
+		switch t.TransferType {
+		case exchange.TransferTypeDeposit:
+			flowType = model.CashFlowDeposit
+		case exchange.TransferTypeWithdraw:
+			flowType = model.CashFlowWithdraw
+		case exchange.TransferTypeInternal, exchange.TransferTypeExternal:
+			amount, err := decimal.NewFromString(t.Amount.String())
+			if err != nil {
+				return nil, fmt.Errorf("parse transfer amount err: %w", err)
+			}
+
+			flowType = model.CashFlowTransferIn
+			if amount.IsNegative() {
+				flowType = model.CashFlowTransferOut
+			}
+		default:
+			continue
+		}
+
+		if (flowType == model.CashFlowDeposit || flowType == model.CashFlowWithdraw) && t.Denom == config.DEMOM_INJ {
+			continue
+		}
+
+		result = append(result, &model.CashFlow{
+			Type:             flowType,
+			InjectiveAddress: t.InjectiveAddress,
+			Denom:            t.Denom,
+			Amount:           t.Amount,
+			ExecutedAt:       t.ExecutedAt,
+		})
+	}
+	return result, nil
+}
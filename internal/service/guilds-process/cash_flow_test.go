@@ -0,0 +1,51 @@
+package guildsprocess
+
+import (
+	"testing"
+	"time"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/exchange"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransferCashFlows(t *testing.T) {
+	since := time.UnixMilli(1000)
+	until := time.UnixMilli(5000)
+	usdt := "peggy0xdAC17F958D2ee523a2206206994597C13D831ec7"
+	transfers := []*model.Transfer{
+		{TransferType: exchange.TransferTypeDeposit, Denom: usdt, Amount: parseDecimal128("100"), ExecutedAt: time.UnixMilli(900)},
+		{TransferType: exchange.TransferTypeDeposit, Denom: usdt, Amount: parseDecimal128("50"), ExecutedAt: time.UnixMilli(1000)},
+		{TransferType: exchange.TransferTypeDeposit, Denom: "inj", Amount: parseDecimal128("7"), ExecutedAt: time.UnixMilli(2000)},
+		{TransferType: exchange.TransferTypeWithdraw, Denom: usdt, Amount: parseDecimal128("-20"), ExecutedAt: time.UnixMilli(3000)},
+		{TransferType: exchange.TransferTypeExternal, Denom: usdt, Amount: parseDecimal128("-5"), ExecutedAt: time.UnixMilli(4000)},
+		{TransferType: exchange.TransferTypeExternal, Denom: usdt, Amount: parseDecimal128("3"), ExecutedAt: time.UnixMilli(4999)},
+		// belongs to next window
+		{TransferType: exchange.TransferTypeDeposit, Denom: usdt, Amount: parseDecimal128("1"), ExecutedAt: time.UnixMilli(5000)},
+	}
+
+	flows, err := transferCashFlows(transfers, since, until)
+	assert.NoError(t, err)
+	assert.Len(t, flows, 4)
+
+	expected := []struct {
+		flowType string
+		amount   string
+	}{
+		{model.CashFlowDeposit, "50"},
+		{model.CashFlowWithdraw, "-20"},
+		{model.CashFlowTransferOut, "-5"},
+		{model.CashFlowTransferIn, "3"},
+	}
+	for i, e := range expected {
+		assert.Equal(t, e.flowType, flows[i].Type)
+		assert.Equal(t, e.amount, flows[i].Amount.String())
+		assert.Equal(t, usdt, flows[i].Denom)
+	}
+
+	// consecutive windows don't drop or repeat the boundary transfer
+	next, err := transferCashFlows(transfers, until, time.UnixMilli(6000))
+	assert.NoError(t, err)
+	assert.Len(t, next, 1)
+	assert.Equal(t, "1", next[0].Amount.String())
+}
@@ -0,0 +1,158 @@
+package guildsprocess
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
+	metrics "github.com/InjectiveLabs/metrics"
+	"github.com/shopspring/decimal"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const oneDay = 24 * time.Hour
+
+// accountMasterFees recomputes daily fees routed to guild masters from ingested member trades.
+// Master earns relayer share of fees paid by trades with master as fee recipient
+func (p *GuildsProcess) accountMasterFees(ctx context.Context) error {
+	doneFn := metrics.ReportFuncTiming(p.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(p.svcTags)
+
+	guilds, err := p.dbSvc.ListAllGuilds(ctx, false)
+	if err != nil {
+		metrics.ReportFuncError(p.svcTags)
+		return fmt.Errorf("list guild err: %w", err)
+	}
+
+	today := time.Now().UTC().Truncate(oneDay)
+	for _, guild := range guilds {
+		guildID := guild.ID.Hex()
+		masterAddress := strings.ToLower(guild.MasterAddress.String())
+		for i := p.feeLookbackDays - 1; i >= 0; i-- {
+			dayStart := today.Add(-time.Duration(i) * oneDay)
+			dayEnd := dayStart.Add(oneDay)
+
+			stats, err := p.dbSvc.GetMemberTradeStats(ctx, model.TradeFilter{
+				GuildID:   &guildID,
+				StartTime: &dayStart,
+				EndTime:   &dayEnd,
+			})
+			if err != nil {
+				metrics.ReportFuncError(p.svcTags)
+				p.logger.WithField("guild_id", guildID).WithError(err).Warningln("get member trade stats error")
+				continue
+			}
+
+			masterStats, err := p.dbSvc.GetMemberTradeStats(ctx, model.TradeFilter{
+				GuildID:      &guildID,
+				FeeRecipient: &masterAddress,
+				StartTime:    &dayStart,
+				EndTime:      &dayEnd,
+			})
+			if err != nil {
+				metrics.ReportFuncError(p.svcTags)
+				p.logger.WithField("guild_id", guildID).WithError(err).Warningln("get master trade stats error")
+				continue
+			}
+
+			unknownRecipient := model.FeeRecipientUnknown
+			unresolvedStats, err := p.dbSvc.GetMemberTradeStats(ctx, model.TradeFilter{
+				GuildID:      &guildID,
+				FeeRecipient: &unknownRecipient,
+				StartTime:    &dayStart,
+				EndTime:      &dayEnd,
+			})
+			if err != nil {
+				metrics.ReportFuncError(p.svcTags)
+				p.logger.WithField("guild_id", guildID).WithError(err).Warningln("get unresolved trade stats error")
+				continue
+			}
+
+			fees, err := buildDailyFees(guild.ID, dayStart, stats, masterStats, unresolvedStats, p.relayerFeeShareRate)
+			if err != nil {
+				metrics.ReportFuncError(p.svcTags)
+				p.logger.WithField("guild_id", guildID).WithError(err).Warningln("build daily fees error")
+				continue
+			}
+
+			if err := p.dbSvc.UpsertDailyFees(ctx, fees); err != nil {
+				metrics.ReportFuncError(p.svcTags)
+				p.logger.WithField("guild_id", guildID).WithError(err).Warningln("upsert daily fees error")
+				continue
+			}
+		}
+	}
+
+	return nil
+}
+
+// buildDailyFees converts member trade stats of a day into daily fee records,
+// master fees are computed from masterStats, stats of trades routed to guild master,
+// and unresolved fees from unresolvedStats, stats of trades with unknown fee recipient
+func buildDailyFees(
+	guildID primitive.ObjectID,
+	dayStart time.Time,
+	stats []*model.MemberTradeStats,
+	masterStats []*model.MemberTradeStats,
+	unresolvedStats []*model.MemberTradeStats,
+	relayerFeeShareRate decimal.Decimal,
+) ([]*model.DailyFee, error) {
+	// address+denom -> fees charged on trades routed to master
+	masterChargedFees, err := chargedFeesByMember(masterStats)
+	if err != nil {
+		return nil, err
+	}
+
+	// address+denom -> fees charged on trades with unknown fee recipient
+	unresolvedChargedFees, err := chargedFeesByMember(unresolvedStats)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	result := make([]*model.DailyFee, 0, len(stats))
+	for _, s := range stats {
+		fee := &model.DailyFee{
+			GuildID:          guildID,
+			Day:              dayStart,
+			InjectiveAddress: s.InjectiveAddress,
+			QuoteDenom:       s.QuoteDenom,
+			FeesPaid:         s.Fees,
+			Volume:           s.Volume,
+			TradeCount:       s.TradeCount,
+			UpdatedAt:        now,
+		}
+
+		// zero when member has no trade routed to master
+		chargedFees := masterChargedFees[s.InjectiveAddress+s.QuoteDenom]
+		fee.MasterFees, err = primitive.ParseDecimal128(chargedFees.Mul(relayerFeeShareRate).String())
+		if err != nil {
+			return nil, fmt.Errorf("parse master fees err: %w", err)
+		}
+
+		chargedFees = unresolvedChargedFees[s.InjectiveAddress+s.QuoteDenom]
+		fee.UnresolvedFees, err = primitive.ParseDecimal128(chargedFees.Mul(relayerFeeShareRate).String())
+		if err != nil {
+			return nil, fmt.Errorf("parse unresolved fees err: %w", err)
+		}
+
+		result = append(result, fee)
+	}
+
+	return result, nil
+}
+
+func chargedFeesByMember(stats []*model.MemberTradeStats) (map[string]decimal.Decimal, error) {
+	result := make(map[string]decimal.Decimal)
+	for _, s := range stats {
+		chargedFees, err := decimal.NewFromString(s.ChargedFees.String())
+		if err != nil {
+			return nil, fmt.Errorf("parse charged fees err: %w", err)
+		}
+		result[s.InjectiveAddress+s.QuoteDenom] = chargedFees
+	}
+	return result, nil
+}
@@ -0,0 +1,72 @@
+package guildsprocess
+
+import (
+	"testing"
+	"time"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestBuildDailyFees(t *testing.T) {
+	dec := func(s string) primitive.Decimal128 {
+		d, _ := primitive.ParseDecimal128(s)
+		return d
+	}
+
+	guildID := primitive.NewObjectID()
+	dayStart := time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+	stats := []*model.MemberTradeStats{
+		{
+			InjectiveAddress: "inj1member",
+			QuoteDenom:       "peggy0xdAC17F958D2ee523a2206206994597C13D831ec7",
+			Fees:             dec("90"),
+			ChargedFees:      dec("100"),
+			Volume:           dec("100000"),
+			TradeCount:       3,
+		},
+	}
+
+	// part of trades were routed to another fee recipient
+	masterStats := []*model.MemberTradeStats{
+		{
+			InjectiveAddress: "inj1member",
+			QuoteDenom:       "peggy0xdAC17F958D2ee523a2206206994597C13D831ec7",
+			Fees:             dec("50"),
+			ChargedFees:      dec("60"),
+			Volume:           dec("60000"),
+			TradeCount:       2,
+		},
+	}
+
+	// fee recipient of a filled order couldn't be resolved
+	unresolvedStats := []*model.MemberTradeStats{
+		{
+			InjectiveAddress: "inj1member",
+			QuoteDenom:       "peggy0xdAC17F958D2ee523a2206206994597C13D831ec7",
+			Fees:             dec("30"),
+			ChargedFees:      dec("30"),
+			Volume:           dec("30000"),
+			TradeCount:       1,
+		},
+	}
+
+	fees, err := buildDailyFees(guildID, dayStart, stats, masterStats, unresolvedStats, decimal.RequireFromString("0.4"))
+	assert.NoError(t, err)
+	assert.Len(t, fees, 1)
+	assert.Equal(t, guildID, fees[0].GuildID)
+	assert.Equal(t, dayStart, fees[0].Day)
+	assert.Equal(t, "90", fees[0].FeesPaid.String())
+	assert.Equal(t, "24", fees[0].MasterFees.String())
+	assert.Equal(t, "12", fees[0].UnresolvedFees.String())
+	assert.Equal(t, 3, fees[0].TradeCount)
+
+	// no trade routed to master
+	fees, err = buildDailyFees(guildID, dayStart, stats, nil, nil, decimal.RequireFromString("0.4"))
+	assert.NoError(t, err)
+	assert.Len(t, fees, 1)
+	assert.Equal(t, "0", fees[0].MasterFees.String())
+	assert.Equal(t, "0", fees[0].UnresolvedFees.String())
+}
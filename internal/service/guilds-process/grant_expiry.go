@@ -0,0 +1,189 @@
+package guildsprocess
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/notifier"
+	metrics "github.com/InjectiveLabs/metrics"
+	log "github.com/xlab/suplog"
+)
+
+type expiringGrant struct {
+	Msg        string
+	Expiration time.Time
+}
+
+// expiringGrants returns required grants which are still valid but expire before deadline
+func expiringGrants(
+	msgToExpiration map[string]time.Time,
+	requiredMsgs []string,
+	now time.Time,
+	deadline time.Time,
+) (result []*expiringGrant) {
+	for _, msg := range requiredMsgs {
+		expiration, ok := msgToExpiration[msg]
+		if !ok || expiration.Before(now) {
+			// missing or expired grants are handled by disqualification
+			continue
+		}
+
+		if expiration.Before(deadline) {
+			result = append(result, &expiringGrant{
+				Msg:        msg,
+				Expiration: expiration,
+			})
+		}
+	}
+	return result
+}
+
+// processGrantExpiry finds members whose grants expire soon and notify them through notifier
+func (p *GuildsProcess) processGrantExpiry(ctx context.Context) error {
+	doneFn := metrics.ReportFuncTiming(p.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(p.svcTags)
+
+	guilds, err := p.dbSvc.ListAllGuilds(ctx, false)
+	if err != nil {
+		metrics.ReportFuncError(p.svcTags)
+		return fmt.Errorf("list guild err: %w", err)
+	}
+
+	now := time.Now()
+	deadline := now.Add(p.grantExpiryWindow)
+	for _, g := range guilds {
+		guildID := g.ID.Hex()
+		members, err := p.dbSvc.ListGuildMembers(ctx, model.MemberFilter{
+			GuildID: &guildID,
+		})
+		if err != nil {
+			err = fmt.Errorf("list member err: %w", err)
+			p.logger.
+				WithField("guild_id", guildID).
+				WithError(err).Warningln("skip this guild")
+			continue
+		}
+
+		countNotified := 0
+		for _, member := range members {
+			address := member.InjectiveAddress.String()
+			grants, err := p.exchange.GetGrants(ctx, address, g.MasterAddress.String())
+			if err != nil {
+				metrics.ReportFuncError(p.svcTags)
+				p.logger.WithField("address", address).WithError(err).Warningln("get grants error")
+				continue
+			}
+
+			msgToExpiration, err := GrantExpirations(grants)
+			if err != nil {
+				metrics.ReportFuncError(p.svcTags)
+				p.logger.WithField("address", address).WithError(err).Warningln("parse grants error")
+				continue
+			}
+
+			expiring := expiringGrants(msgToExpiration, p.grants, now, deadline)
+			if len(expiring) == 0 {
+				continue
+			}
+
+			// notified grants are persisted, restarts don't notify members again
+			notified, err := p.dbSvc.ListGrantNotifications(ctx, member.InjectiveAddress)
+			if err != nil {
+				metrics.ReportFuncError(p.svcTags)
+				p.logger.WithField("address", address).WithError(err).Warningln("list grant notifications error")
+				continue
+			}
+
+			expiring = filterNotified(expiring, notified)
+			if len(expiring) == 0 {
+				continue
+			}
+
+			if err := p.notifyExpiringGrants(ctx, g, address, expiring); err != nil {
+				metrics.ReportFuncError(p.svcTags)
+				p.logger.WithField("address", address).WithError(err).Warningln("notify expiring grants error")
+				continue
+			}
+
+			if err := p.markNotified(ctx, member.InjectiveAddress, expiring); err != nil {
+				metrics.ReportFuncError(p.svcTags)
+				p.logger.WithField("address", address).WithError(err).Warningln("record grant notifications error")
+			}
+			countNotified++
+		}
+
+		p.logger.WithField("count", countNotified).WithField("guild_id", guildID).Info("notified members with expiring grants")
+	}
+
+	return nil
+}
+
+func (p *GuildsProcess) notifyExpiringGrants(
+	ctx context.Context,
+	guild *model.Guild,
+	address string,
+	expiring []*expiringGrant,
+) error {
+	lines := make([]string, 0, len(expiring))
+	for _, e := range expiring {
+		lines = append(lines, fmt.Sprintf("- %s expires at %s", e.Msg, e.Expiration.UTC().Format(expirationTimeLayout)))
+	}
+
+	p.logger.WithFields(log.Fields{
+		"address":  address,
+		"guild_id": guild.ID.Hex(),
+		"count":    len(expiring),
+	}).Info("account has expiring grants")
+
+	if p.notifier == nil {
+		return nil
+	}
+
+	return p.notifier.Notify(ctx, &notifier.Notification{
+		Kind:             notifier.KindGrantExpiring,
+		GuildID:          guild.ID.Hex(),
+		InjectiveAddress: address,
+		Title:            fmt.Sprintf("Guild %s: grants of %s are expiring", guild.Name, address),
+		Message: fmt.Sprintf(
+			"Please renew grants to guild master %s to stay in the guild:\n%s",
+			guild.MasterAddress.String(), strings.Join(lines, "\n"),
+		),
+		CreatedAt: time.Now(),
+	})
+}
+
+// filterNotified removes grants which we already notified with the same expiration,
+// so members are notified once per grant renewal
+func filterNotified(grants []*expiringGrant, notified []*model.GrantNotification) (result []*expiringGrant) {
+	for _, g := range grants {
+		alreadyNotified := false
+		for _, n := range notified {
+			if n.Msg == g.Msg && n.Expiration.Equal(g.Expiration) {
+				alreadyNotified = true
+				break
+			}
+		}
+
+		if !alreadyNotified {
+			result = append(result, g)
+		}
+	}
+	return result
+}
+
+func (p *GuildsProcess) markNotified(ctx context.Context, address model.Address, grants []*expiringGrant) error {
+	notifications := make([]*model.GrantNotification, 0, len(grants))
+	for _, g := range grants {
+		notifications = append(notifications, &model.GrantNotification{
+			InjectiveAddress: address,
+			Msg:              g.Msg,
+			Expiration:       g.Expiration,
+			NotifiedAt:       time.Now(),
+		})
+	}
+	return p.dbSvc.AddGrantNotifications(ctx, notifications)
+}
@@ -0,0 +1,49 @@
+package guildsprocess
+
+import (
+	"testing"
+	"time"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpiringGrants(t *testing.T) {
+	now := time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+	deadline := now.Add(72 * time.Hour)
+	required := []string{"msgA", "msgB", "msgC", "msgD"}
+
+	msgToExpiration := map[string]time.Time{
+		"msgA": now.Add(24 * time.Hour),  // expiring soon
+		"msgB": now.Add(-time.Hour),      // already expired
+		"msgC": now.Add(240 * time.Hour), // far away
+		// msgD missing
+	}
+
+	result := expiringGrants(msgToExpiration, required, now, deadline)
+	assert.Equal(t, []*expiringGrant{
+		{
+			Msg:        "msgA",
+			Expiration: now.Add(24 * time.Hour),
+		},
+	}, result)
+}
+
+func TestFilterNotified(t *testing.T) {
+	expiration := time.Date(2022, 3, 2, 0, 0, 0, 0, time.UTC)
+	grants := []*expiringGrant{
+		{Msg: "msgA", Expiration: expiration},
+		{Msg: "msgB", Expiration: expiration},
+	}
+
+	notified := []*model.GrantNotification{
+		{Msg: "msgA", Expiration: expiration},
+		// renewed grant, notified for previous expiration
+		{Msg: "msgB", Expiration: expiration.Add(-24 * time.Hour)},
+	}
+
+	result := filterNotified(grants, notified)
+	assert.Equal(t, []*expiringGrant{
+		{Msg: "msgB", Expiration: expiration},
+	}, result)
+}
@@ -11,9 +11,17 @@ import (
 	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
 	"github.com/InjectiveLabs/injective-guilds-service/internal/db/mongoimpl"
 	"github.com/InjectiveLabs/injective-guilds-service/internal/exchange"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/health"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/notifier"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/prom"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/rewards"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/tracing"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/webhook"
 	metrics "github.com/InjectiveLabs/metrics"
+	"github.com/shopspring/decimal"
 	log "github.com/xlab/suplog"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 const (
@@ -24,6 +32,10 @@ const (
 
 	OrderSideBuy  = "buy"
 	OrderSideSell = "sell"
+
+	DisqualifyReasonMissingGrant                  = "missing_grant"
+	DisqualifyReasonInvalidSpotFeeRecipient       = "invalid_spot_fee_recipient"
+	DisqualifyReasonInvalidDerivativeFeeRecipient = "invalid_derivative_fee_recipient"
 )
 
 type GuildsProcess struct {
@@ -31,9 +43,24 @@ type GuildsProcess struct {
 	exchange        exchange.DataProvider
 	logger          log.Logger
 	portfolioHelper *PortfolioHelper
-
-	portfolioUpdateInterval time.Duration
-	disqualifyInterval      time.Duration
+	notifier        notifier.Notifier
+	dispatcher      *webhook.Dispatcher
+	// alertSinks are notifiers by name to fire alerts through
+	alertSinks map[string]notifier.Notifier
+
+	portfolioUpdateInterval  time.Duration
+	disqualifyInterval       time.Duration
+	grantExpiryCheckInterval time.Duration
+	grantExpiryWindow        time.Duration
+	tradeIngestInterval      time.Duration
+	feeAccountingInterval    time.Duration
+	transferIngestInterval   time.Duration
+	marketRefreshInterval    time.Duration
+	waitlistInterval         time.Duration
+	alertInterval            time.Duration
+	riskWindow               time.Duration
+	feeLookbackDays          int
+	relayerFeeShareRate      decimal.Decimal
 
 	grants  []string
 	svcTags metrics.Tags
@@ -62,6 +89,10 @@ func NewProcess(cfg config.GuildProcessConfig) (*GuildsProcess, error) {
 		return nil, err
 	}
 
+	if cfg.PrometheusEnabled {
+		exchangeProvider = exchange.NewInstrumentedProvider(exchangeProvider)
+	}
+
 	svcTags := metrics.Tags{
 		"svc": "guilds_process",
 	}
@@ -70,40 +101,100 @@ func NewProcess(cfg config.GuildProcessConfig) (*GuildsProcess, error) {
 		return nil, err
 	}
 
+	relayerFeeShareRate, err := decimal.NewFromString(cfg.RelayerFeeShareRate)
+	if err != nil {
+		return nil, fmt.Errorf("parse relayer fee share rate err: %w", err)
+	}
+
+	grantNotifier := notifier.NewFromConfig(cfg.NotifierConfig)
+	if grantNotifier == nil {
+		logger.Warningln("no notifier configured, expiring grants will be logged only")
+	}
+
 	return &GuildsProcess{
-		dbSvc:                   dbService,
-		exchange:                exchangeProvider,
-		logger:                  logger,
-		portfolioUpdateInterval: cfg.PortfolioUpdateInterval,
-		disqualifyInterval:      cfg.DisqualifyInterval,
-		portfolioHelper:         portfolioHelper,
-		grants:                  config.GrantRequirements,
-		svcTags:                 svcTags,
+		dbSvc:                    dbService,
+		exchange:                 exchangeProvider,
+		logger:                   logger,
+		portfolioUpdateInterval:  cfg.PortfolioUpdateInterval,
+		disqualifyInterval:       cfg.DisqualifyInterval,
+		grantExpiryCheckInterval: cfg.GrantExpiryCheckInterval,
+		grantExpiryWindow:        cfg.GrantExpiryWindow,
+		tradeIngestInterval:      cfg.TradeIngestInterval,
+		feeAccountingInterval:    cfg.FeeAccountingInterval,
+		transferIngestInterval:   cfg.TransferIngestInterval,
+		marketRefreshInterval:    cfg.MarketRefreshInterval,
+		waitlistInterval:         cfg.WaitlistInterval,
+		alertInterval:            cfg.AlertInterval,
+		riskWindow:               cfg.RiskWindow,
+		feeLookbackDays:          cfg.FeeAccountingLookbackDays,
+		relayerFeeShareRate:      relayerFeeShareRate,
+		portfolioHelper:          portfolioHelper,
+		notifier:                 grantNotifier,
+		alertSinks:               notifier.NewNamedFromConfig(cfg.NotifierConfig, logger),
+		dispatcher:               webhook.NewDispatcher(dbService, logger),
+		grants:                   config.GrantRequirements,
+		svcTags:                  svcTags,
 	}, nil
 }
 
 func (p *GuildsProcess) Run(ctx context.Context) {
 	p.logger.Infoln("guilds process is running to update portfolio and check to disqualify members")
-	// run 2 cron jobs
-	go p.runWithInterval(ctx, p.portfolioUpdateInterval, func(ctx context.Context) error {
+	// run 9 cron jobs
+	go p.runWithInterval(ctx, "capture_member_portfolios", p.portfolioUpdateInterval, func(ctx context.Context) error {
 		return p.captureMemberPortfolios(ctx)
 	})
 
-	go p.runWithInterval(ctx, p.disqualifyInterval, func(ctx context.Context) error {
+	go p.runWithInterval(ctx, "process_disqualification", p.disqualifyInterval, func(ctx context.Context) error {
 		return p.processDisqualification(ctx)
 	})
+
+	go p.runWithInterval(ctx, "process_grant_expiry", p.grantExpiryCheckInterval, func(ctx context.Context) error {
+		return p.processGrantExpiry(ctx)
+	})
+
+	go p.runWithInterval(ctx, "ingest_trades", p.tradeIngestInterval, func(ctx context.Context) error {
+		return p.ingestTrades(ctx)
+	})
+
+	go p.runWithInterval(ctx, "account_master_fees", p.feeAccountingInterval, func(ctx context.Context) error {
+		return p.accountMasterFees(ctx)
+	})
+
+	go p.runWithInterval(ctx, "ingest_transfers", p.transferIngestInterval, func(ctx context.Context) error {
+		return p.ingestTransfers(ctx)
+	})
+
+	go p.runWithInterval(ctx, "refresh_markets", p.marketRefreshInterval, func(ctx context.Context) error {
+		return p.refreshMarkets(ctx)
+	})
+
+	go p.runWithInterval(ctx, "admit_waitlist", p.waitlistInterval, func(ctx context.Context) error {
+		return p.admitWaitlist(ctx)
+	})
+
+	go p.runWithInterval(ctx, "evaluate_alerts", p.alertInterval, func(ctx context.Context) error {
+		return p.evaluateAlerts(ctx)
+	})
 }
 
-func (p *GuildsProcess) runWithInterval(ctx context.Context, interval time.Duration, fn func(ctx context.Context) error) {
+func (p *GuildsProcess) runWithInterval(
+	ctx context.Context,
+	name string,
+	interval time.Duration,
+	fn func(ctx context.Context) error,
+) {
 	for {
 		select {
 		case <-ctx.Done():
 			return
 		default:
 			timeMarker := time.Now()
-			err := fn(ctx)
+			// each run is a root span, upstream and db calls are its children
+			spanCtx, span := tracing.StartSpan(ctx, "guilds_process."+name)
+			err := fn(spanCtx)
+			tracing.EndSpan(span, err)
 			if err != nil {
-				p.logger.WithError(err).Errorln("error while doing cronjob")
+				p.logger.WithError(err).WithField("job", name).Errorln("error while doing cronjob")
 			}
 			timeElasped := time.Since(timeMarker)
 
@@ -120,14 +211,19 @@ func (p *GuildsProcess) captureMemberPortfolios(ctx context.Context) error {
 	defer doneFn()
 	metrics.ReportFuncCall(p.svcTags)
 
-	guilds, err := p.dbSvc.ListAllGuilds(ctx)
+	guilds, err := p.dbSvc.ListAllGuilds(ctx, false)
 	if err != nil {
 		return fmt.Errorf("list guild err: %w", err)
 	}
 
 	now := time.Now()
+	defer func() {
+		prom.CaptureCycleDuration.Observe(time.Since(now).Seconds())
+	}()
+
 	for _, guild := range guilds {
 		guildID := guild.ID.Hex()
+		prom.GuildMemberCount.WithLabelValues(guildID).Set(float64(guild.MemberCount))
 
 		members, err := p.dbSvc.ListGuildMembers(ctx, model.MemberFilter{
 			GuildID: &guildID,
@@ -153,18 +249,39 @@ func (p *GuildsProcess) captureMemberPortfolios(ctx context.Context) error {
 			continue
 		}
 
+		// cash flows of guild snapshot are counted since previous guild snapshot
+		var guildSince time.Time
+		latestLimit := int64(1)
+		latestGuildPortfolios, err := p.dbSvc.ListGuildPortfolios(ctx, model.GuildPortfoliosFilter{
+			GuildID: guildID,
+			Limit:   &latestLimit,
+		})
+		if err != nil {
+			p.logger.WithField("guild_id", guildID).WithError(err).Warningln("get latest guild portfolio error")
+		} else if len(latestGuildPortfolios) > 0 {
+			guildSince = latestGuildPortfolios[0].UpdatedAt
+		}
+
 		portfolios := make([]*model.AccountPortfolio, 0)
+		var guildCashFlows []*model.CashFlow
 		denomToBalance := make(map[string]*model.Balance)
 		var sumInjBankBalance = primitive.NewDecimal128(0, 0)
 		// TODO: Create queue to re-try when failure happens
 		// TODO: Create bulk accounts balances query on injective-exchange
 		for _, member := range members {
-			portfolioSnapshot, err := p.portfolioHelper.CaptureSingleMemberPortfolio(ctx, guild, member, false)
+			memberCtx, span := tracing.StartSpan(
+				ctx, "guilds_process.capture_member_portfolio",
+				attribute.String("guild_id", guildID),
+				attribute.String("injective_address", member.InjectiveAddress.String()),
+			)
+			portfolioSnapshot, err := p.portfolioHelper.CaptureSingleMemberPortfolio(memberCtx, guild, member, false)
+			tracing.EndSpan(span, err)
 			if err != nil {
 				p.logger.
 					WithField("guild_id", guildID).
 					WithField("memberAddr", member.InjectiveAddress.String()).
 					WithError(err).Warningln("capture snapshot error")
+				prom.MembersCaptured.WithLabelValues(guildID, prom.StatusFailure).Inc()
 				continue
 			}
 
@@ -207,8 +324,31 @@ func (p *GuildsProcess) captureMemberPortfolios(ctx context.Context) error {
 				}
 			}
 
+			// snapshots taken in previous guilds are kept, only latest snapshot in this guild counts
+			var memberSince time.Time
+			latestPortfolios, err := p.dbSvc.ListAccountPortfolios(ctx, model.AccountPortfoliosFilter{
+				InjectiveAddress: member.InjectiveAddress,
+				GuildID:          &guildID,
+				Limit:            &latestLimit,
+			})
+			if err == nil && len(latestPortfolios) > 0 {
+				memberSince = latestPortfolios[0].UpdatedAt
+			}
+
+			memberFlows, guildFlows, err := p.captureCashFlows(ctx, guild, member, memberSince, guildSince, now)
+			if err != nil {
+				// returns computed from this snapshot will treat flows as profit or loss
+				p.logger.
+					WithField("guild_id", guildID).
+					WithField("memberAddr", member.InjectiveAddress.String()).
+					WithError(err).Warningln("capture cash flows error")
+			}
+			portfolioSnapshot.CashFlows = memberFlows
+			guildCashFlows = append(guildCashFlows, guildFlows...)
+
 			portfolioSnapshot.UpdatedAt = now
 			portfolios = append(portfolios, portfolioSnapshot)
+			prom.MembersCaptured.WithLabelValues(guildID, prom.StatusSuccess).Inc()
 		}
 
 		if len(portfolios) > 0 {
@@ -230,6 +370,7 @@ func (p *GuildsProcess) captureMemberPortfolios(ctx context.Context) error {
 				GuildID:     guild.ID,
 				UpdatedAt:   now,
 				MemberCount: len(members),
+				CashFlows:   guildCashFlows,
 				BankBalances: []*model.BankBalance{
 					{
 						Denom:    config.DEMOM_INJ,
@@ -247,6 +388,17 @@ func (p *GuildsProcess) captureMemberPortfolios(ctx context.Context) error {
 				p.logger.
 					WithField("guild_id", guildID).
 					WithError(err).Warningln("cannot add guild portfolio")
+			} else {
+				p.dispatcher.Dispatch(guildID, webhook.EventPortfolioCaptured, &webhook.PortfolioData{
+					MemberCount: guildPortfolio.MemberCount,
+					UpdatedAt:   guildPortfolio.UpdatedAt.UnixMilli(),
+				})
+
+				if err := p.captureGuildRisk(ctx, guild, portfolios, priceMap, now); err != nil {
+					p.logger.
+						WithField("guild_id", guildID).
+						WithError(err).Warningln("cannot capture guild risk")
+				}
 			}
 		}
 	}
@@ -260,7 +412,7 @@ func (p *GuildsProcess) processDisqualification(ctx context.Context) error {
 	defer doneFn()
 	metrics.ReportFuncCall(p.svcTags)
 
-	guilds, err := p.dbSvc.ListAllGuilds(ctx)
+	guilds, err := p.dbSvc.ListAllGuilds(ctx, false)
 	if err != nil {
 		metrics.ReportFuncError(p.svcTags)
 		return fmt.Errorf("list guild err: %w", err)
@@ -290,14 +442,14 @@ func (p *GuildsProcess) processDisqualification(ctx context.Context) error {
 
 		countDisqualifed := 0
 		for _, member := range members {
-			needDisqualified, err := p.shouldDisqualify(ctx, g, member.InjectiveAddress)
+			reason, err := p.shouldDisqualify(ctx, g, member)
 			if err != nil {
 				continue
 			}
 
 			// we don't expect this to regularly happen,
 			// so decided to delete each document this way
-			if needDisqualified {
+			if reason != "" {
 				err = p.dbSvc.RemoveMember(ctx, g.ID.Hex(), member.InjectiveAddress)
 				if err != nil {
 					log.WithField("memberAddress", member.InjectiveAddress.String()).
@@ -305,6 +457,11 @@ func (p *GuildsProcess) processDisqualification(ctx context.Context) error {
 					continue
 				}
 
+				p.dispatcher.Dispatch(guildID, webhook.EventMemberDisqualified, &webhook.MemberData{
+					InjectiveAddress: member.InjectiveAddress.String(),
+					Reason:           reason,
+				})
+				prom.Disqualifications.WithLabelValues(guildID, reason).Inc()
 				countDisqualifed++
 			}
 		}
@@ -323,46 +480,23 @@ func (p *GuildsProcess) meetGrantRequirements(
 	defer doneFn()
 	metrics.ReportFuncCall(p.svcTags)
 
-	grants, err := p.exchange.GetGrants(ctx, address, guild.MasterAddress.String())
+	unqualified, err := CheckGrants(ctx, p.exchange, guild, address, p.grants)
 	if err != nil {
 		metrics.ReportFuncError(p.svcTags)
 		return false, err
 	}
 
-	msgToExpiration := make(map[string]time.Time)
-
-	for _, g := range grants.Grants {
-		t, err := time.Parse(expirationTimeLayout, g.Expiration)
-		if err != nil {
-			return false, fmt.Errorf("time parse err: %w", err)
-		}
-
-		msgToExpiration[g.Authorization.Msg] = t
-	}
-
-	// all expected grants must be provided
-	now := time.Now()
-	for _, expectedMsg := range p.grants {
-		expiration, ok := msgToExpiration[expectedMsg]
-		if !ok {
-			p.logger.WithFields(log.Fields{
-				"address":        address,
-				"guild_id":       guild.ID.Hex(),
-				"missed_message": expectedMsg,
-			}).Info("account missed a grant")
-
-			return false, nil
+	if unqualified != nil {
+		fields := log.Fields{
+			"address":        address,
+			"guild_id":       guild.ID.Hex(),
+			"missed_message": unqualified.Msg,
 		}
-
-		if expiration.Before(now) {
-			p.logger.WithFields(log.Fields{
-				"address":         address,
-				"guild_id":        guild.ID.Hex(),
-				"expired_message": expectedMsg,
-				"expired_at":      expiration.String(),
-			}).Info("account missed a grant")
-			return false, nil
+		if unqualified.ExpiredAt != nil {
+			fields["expired_at"] = unqualified.ExpiredAt.String()
 		}
+		p.logger.WithFields(fields).Info("account missed a grant")
+		return false, nil
 	}
 
 	return true, nil
@@ -371,7 +505,7 @@ func (p *GuildsProcess) meetGrantRequirements(
 func (p *GuildsProcess) spotOrdersHaveInvalidFeeRecipient(
 	ctx context.Context,
 	guild *model.Guild,
-	defaultSubaccountID string,
+	subaccountID string,
 	masterAddress string,
 ) (bool, error) {
 	doneFn := metrics.ReportFuncTiming(p.svcTags)
@@ -381,7 +515,7 @@ func (p *GuildsProcess) spotOrdersHaveInvalidFeeRecipient(
 	// open orders
 	spotOrders, err := p.exchange.GetSpotOrders(
 		ctx, []string{},
-		defaultSubaccountID,
+		subaccountID,
 	)
 	if err != nil {
 		metrics.ReportFuncError(p.svcTags)
@@ -392,7 +526,7 @@ func (p *GuildsProcess) spotOrdersHaveInvalidFeeRecipient(
 		if strings.ToLower(o.FeeRecipient) != masterAddress {
 			// we can log here to trace
 			p.logger.WithFields(log.Fields{
-				"subaccount_id": defaultSubaccountID,
+				"subaccount_id": subaccountID,
 				"guild_id":      guild.ID.Hex(),
 				"fee_recipient": o.FeeRecipient,
 			}).Info("account has invalid spot order")
@@ -406,7 +540,7 @@ func (p *GuildsProcess) spotOrdersHaveInvalidFeeRecipient(
 func (p *GuildsProcess) derivativeOrdersHaveInvalidFeeRecipient(
 	ctx context.Context,
 	guild *model.Guild,
-	defaultSubaccountID string,
+	subaccountID string,
 	masterAddress string,
 ) (bool, error) {
 	doneFn := metrics.ReportFuncTiming(p.svcTags)
@@ -415,7 +549,7 @@ func (p *GuildsProcess) derivativeOrdersHaveInvalidFeeRecipient(
 
 	derivativeOrders, err := p.exchange.GetDerivativeOrders(
 		ctx, []string{},
-		defaultSubaccountID,
+		subaccountID,
 	)
 	if err != nil {
 		metrics.ReportFuncError(p.svcTags)
@@ -425,7 +559,7 @@ func (p *GuildsProcess) derivativeOrdersHaveInvalidFeeRecipient(
 	for _, o := range derivativeOrders {
 		if strings.ToLower(o.FeeRecipient) != masterAddress {
 			p.logger.WithFields(log.Fields{
-				"subaccount_id": defaultSubaccountID,
+				"subaccount_id": subaccountID,
 				"guild_id":      guild.ID.Hex(),
 				"fee_recipient": o.FeeRecipient,
 			}).Info("account has invalid derivative order")
@@ -436,19 +570,20 @@ func (p *GuildsProcess) derivativeOrdersHaveInvalidFeeRecipient(
 	return false, nil
 }
 
-// shouldDisqualify disqualifies a person if:
+// shouldDisqualify returns disqualification reason, empty if member is still qualified.
+// A person is disqualified if:
 // - not enough grant requirement (user revoked at least one of them)
-// - deriv/spot orders has fee recipient != master address
+// - deriv/spot orders of any trading subaccount has fee recipient != master address
 func (p *GuildsProcess) shouldDisqualify(
 	ctx context.Context,
 	guild *model.Guild,
-	address model.Address,
-) (bool, error) {
+	member *model.GuildMember,
+) (string, error) {
 	doneFn := metrics.ReportFuncTiming(p.svcTags)
 	defer doneFn()
 	metrics.ReportFuncCall(p.svcTags)
 
-	defaultSubaccountID := defaultSubaccountIDFromInjAddress(address)
+	address := member.InjectiveAddress
 	masterAddress := strings.ToLower(guild.MasterAddress.String())
 	// check grants
 	meetRequirement, err := p.meetGrantRequirements(ctx, guild, address.String())
@@ -460,38 +595,56 @@ func (p *GuildsProcess) shouldDisqualify(
 	}
 
 	if err == nil && !meetRequirement {
-		// TODO: Add disqualification reason
-		return true, nil
+		return DisqualifyReasonMissingGrant, nil
 	}
 
-	isInvalid, err := p.spotOrdersHaveInvalidFeeRecipient(ctx, guild, defaultSubaccountID, masterAddress)
-	if err != nil {
-		metrics.ReportFuncError(p.svcTags)
-		p.logger.WithField("address", address.String()).
-			WithError(err).Warningln("check orders error")
-	}
+	for _, subaccountID := range member.TradingSubaccountIDs() {
+		isInvalid, err := p.spotOrdersHaveInvalidFeeRecipient(ctx, guild, subaccountID, masterAddress)
+		if err != nil {
+			metrics.ReportFuncError(p.svcTags)
+			p.logger.WithField("address", address.String()).
+				WithField("subaccount_id", subaccountID).
+				WithError(err).Warningln("check orders error")
+		}
 
-	if err == nil && isInvalid {
-		return true, nil
-	}
+		if err == nil && isInvalid {
+			return DisqualifyReasonInvalidSpotFeeRecipient, nil
+		}
 
-	isInvalid, err = p.derivativeOrdersHaveInvalidFeeRecipient(ctx, guild, defaultSubaccountID, masterAddress)
-	if err != nil {
-		metrics.ReportFuncError(p.svcTags)
-		return false, err
-	}
+		isInvalid, err = p.derivativeOrdersHaveInvalidFeeRecipient(ctx, guild, subaccountID, masterAddress)
+		if err != nil {
+			metrics.ReportFuncError(p.svcTags)
+			return "", err
+		}
 
-	if err == nil && isInvalid {
-		return true, nil
+		if isInvalid {
+			return DisqualifyReasonInvalidDerivativeFeeRecipient, nil
+		}
 	}
 
-	return false, nil
+	return "", nil
+}
+
+// HealthChecks returns dependency checks for readiness probe
+func (p *GuildsProcess) HealthChecks() []health.Check {
+	return health.ServiceChecks(p.dbSvc, p.exchange)
+}
+
+// RewardsHandler serves reward computation on admin listener
+func (p *GuildsProcess) RewardsHandler() *rewards.Handler {
+	return rewards.NewHandler(rewards.NewCalculator(p.dbSvc), p.logger.WithField("component", "rewards"))
 }
 
 func (p *GuildsProcess) GracefullyShutdown(ctx context.Context) {
 	shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
+	// webhook deliveries need db, wait for them before closing it
+	log.Info("waiting for webhook deliveries")
+	if err := p.dispatcher.Wait(shutdownCtx); err != nil {
+		log.WithError(err).Warning("webhook deliveries in flight are dropped")
+	}
+
 	// close db
 	log.Info("closing db connection")
 	if err := p.dbSvc.Disconnect(shutdownCtx); err != nil {
@@ -0,0 +1,157 @@
+package guildsprocess
+
+import (
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/exchange"
+	"github.com/shopspring/decimal"
+)
+
+// order_side values of exchange api besides OrderSideBuy and OrderSideSell
+const (
+	OrderSideBuyPO    = "buy_po"
+	OrderSideSellPO   = "sell_po"
+	OrderSideStopBuy  = "stop_buy"
+	OrderSideStopSell = "stop_sell"
+	OrderSideTakeBuy  = "take_buy"
+	OrderSideTakeSell = "take_sell"
+)
+
+// holdDiscrepancyRate is relative difference between computed and chain hold tolerated by reconciliation
+var holdDiscrepancyRate = decimal.RequireFromString("0.0001")
+
+func isBuySide(orderSide string) bool {
+	switch orderSide {
+	case OrderSideBuy, OrderSideBuyPO, OrderSideStopBuy, OrderSideTakeBuy:
+		return true
+	}
+	return false
+}
+
+func isConditional(orderSide string) bool {
+	switch orderSide {
+	case OrderSideStopBuy, OrderSideStopSell, OrderSideTakeBuy, OrderSideTakeSell:
+		return true
+	}
+	return false
+}
+
+// MarginHoldCalculator computes balance holds of open orders as the exchange module does:
+//   - resting limit orders (post-only or not) are maker orders, the taker part of the fee hold
+//     is refunded when an order rests, so fee hold uses maker rate. Negative maker fee (rebate) holds nothing
+//   - conditional (stop/take) orders are not in orderbook yet and keep fee hold at taker rate
+//   - spot buy orders hold quote: price * unfilled * (1 + feeRate), spot sell orders hold base: unfilled
+//   - derivative orders hold quote: margin of unfilled part + price * unfilled * feeRate
+//   - reduce-only derivative orders hold nothing, fees are paid from position margin
+type MarginHoldCalculator struct {
+	idToMarket map[string]*model.GuildMarket
+}
+
+func NewMarginHoldCalculator(guild *model.Guild) *MarginHoldCalculator {
+	return &MarginHoldCalculator{idToMarket: getIDToMarket(guild)}
+}
+
+func (c *MarginHoldCalculator) feeRate(market *model.GuildMarket, orderSide string) decimal.Decimal {
+	rate := market.MakerFeeRate
+	if isConditional(orderSide) {
+		rate = market.TakerFeeRate
+	}
+
+	// expected to parse successfully -> skip error
+	result, _ := decimal.NewFromString(rate.String())
+	return decimal.Max(result, decimal.Zero)
+}
+
+// SpotOrderHold returns held denom and amount, ok is false if order market is not in guild
+func (c *MarginHoldCalculator) SpotOrderHold(o *exchange.SpotOrder) (denom string, amount decimal.Decimal, ok bool) {
+	market, exist := c.idToMarket[o.MarketID]
+	if !exist {
+		return "", decimal.Zero, false
+	}
+
+	if !isBuySide(o.OrderSide) {
+		return market.BaseDenom, o.UnfilledQuantity, true
+	}
+
+	notional := o.Price.Mul(o.UnfilledQuantity)
+	return market.QuoteDenom, notional.Add(notional.Mul(c.feeRate(market, o.OrderSide))), true
+}
+
+// DerivativeOrderHold returns held quote denom and amount, ok is false if order market is not in guild
+func (c *MarginHoldCalculator) DerivativeOrderHold(o *exchange.DerivativeOrder) (denom string, amount decimal.Decimal, ok bool) {
+	market, exist := c.idToMarket[o.MarketID]
+	if !exist {
+		return "", decimal.Zero, false
+	}
+
+	if o.IsReduceOnly {
+		return market.QuoteDenom, decimal.Zero, true
+	}
+
+	// margin is of whole order, partially filled orders hold the unfilled part only
+	margin := o.Margin
+	if o.Quantity.IsPositive() {
+		margin = o.Margin.Mul(o.UnfilledQuantity).Div(o.Quantity)
+	}
+
+	fee := o.Price.Mul(o.UnfilledQuantity).Mul(c.feeRate(market, o.OrderSide))
+	return market.QuoteDenom, margin.Add(fee), true
+}
+
+// OrderHolds sums holds of orders per denom
+func (c *MarginHoldCalculator) OrderHolds(
+	spotOrders []*exchange.SpotOrder,
+	derivOrders []*exchange.DerivativeOrder,
+) map[string]decimal.Decimal {
+	result := make(map[string]decimal.Decimal)
+	for _, o := range spotOrders {
+		if denom, amount, ok := c.SpotOrderHold(o); ok {
+			result[denom] = result[denom].Add(amount)
+		}
+	}
+
+	for _, o := range derivOrders {
+		if denom, amount, ok := c.DerivativeOrderHold(o); ok {
+			result[denom] = result[denom].Add(amount)
+		}
+	}
+	return result
+}
+
+// PositionMargins sums margins of positions per quote denom
+func (c *MarginHoldCalculator) PositionMargins(positions []*exchange.DerivativePosition) map[string]decimal.Decimal {
+	result := make(map[string]decimal.Decimal)
+	for _, p := range positions {
+		market, exist := c.idToMarket[p.MarketID]
+		if !exist {
+			continue
+		}
+		result[market.QuoteDenom] = result[market.QuoteDenom].Add(p.Margin)
+	}
+	return result
+}
+
+type holdDiscrepancy struct {
+	denom    string
+	chain    decimal.Decimal
+	computed decimal.Decimal
+}
+
+// reconcileHolds compares computed order holds with chain holds (total - available balance),
+// orders of markets out of guild are not computed so they show up as discrepancies too
+func reconcileHolds(balances []*exchange.Balance, orderHolds map[string]decimal.Decimal) []*holdDiscrepancy {
+	var result []*holdDiscrepancy
+	for _, b := range balances {
+		chain := b.TotalBalance.Sub(b.AvailableBalance)
+		computed := orderHolds[b.Denom]
+		// 1 base unit is tolerated for rounding
+		tolerance := decimal.Max(chain.Abs().Mul(holdDiscrepancyRate), decimal.NewFromInt(1))
+		if computed.Sub(chain).Abs().GreaterThan(tolerance) {
+			result = append(result, &holdDiscrepancy{
+				denom:    b.Denom,
+				chain:    chain,
+				computed: computed,
+			})
+		}
+	}
+	return result
+}
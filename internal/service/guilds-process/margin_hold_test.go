@@ -0,0 +1,136 @@
+package guildsprocess
+
+import (
+	"testing"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/exchange"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func newMarginHoldTestGuild(makerFee, takerFee string) *model.Guild {
+	dec := func(s string) primitive.Decimal128 {
+		d, _ := primitive.ParseDecimal128(s)
+		return d
+	}
+
+	return &model.Guild{
+		Markets: []*model.GuildMarket{
+			{
+				MarketID:     model.Hash{Hash: common.HexToHash(marketInjUstID)},
+				BaseDenom:    denomInj,
+				QuoteDenom:   denomUst,
+				MakerFeeRate: dec(makerFee),
+				TakerFeeRate: dec(takerFee),
+			},
+			{
+				MarketID:     model.Hash{Hash: common.HexToHash(marketWethUsdtID)},
+				IsPerpetual:  true,
+				QuoteDenom:   denomUsdt,
+				MakerFeeRate: dec(makerFee),
+				TakerFeeRate: dec(takerFee),
+			},
+		},
+	}
+}
+
+func TestSpotOrderHold(t *testing.T) {
+	d := decimal.RequireFromString
+	tests := []struct {
+		name      string
+		makerFee  string
+		orderSide string
+		denom     string
+		amount    string
+	}{
+		{name: "limit buy holds maker fee", makerFee: "0.001", orderSide: OrderSideBuy, denom: denomUst, amount: "1001"},
+		{name: "post-only buy holds maker fee", makerFee: "0.001", orderSide: OrderSideBuyPO, denom: denomUst, amount: "1001"},
+		{name: "negative maker fee holds no fee", makerFee: "-0.0001", orderSide: OrderSideBuy, denom: denomUst, amount: "1000"},
+		{name: "stop buy holds taker fee", makerFee: "0.001", orderSide: OrderSideStopBuy, denom: denomUst, amount: "1002"},
+		{name: "take buy holds taker fee", makerFee: "0.001", orderSide: OrderSideTakeBuy, denom: denomUst, amount: "1002"},
+		{name: "limit sell holds base", makerFee: "0.001", orderSide: OrderSideSell, denom: denomInj, amount: "100"},
+		{name: "stop sell holds base", makerFee: "0.001", orderSide: OrderSideStopSell, denom: denomInj, amount: "100"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			calc := NewMarginHoldCalculator(newMarginHoldTestGuild(tc.makerFee, "0.002"))
+			denom, amount, ok := calc.SpotOrderHold(&exchange.SpotOrder{
+				MarketID:         marketInjUstID,
+				OrderSide:        tc.orderSide,
+				Price:            d("10"),
+				UnfilledQuantity: d("100"),
+			})
+			assert.True(t, ok)
+			assert.Equal(t, tc.denom, denom)
+			assert.True(t, d(tc.amount).Equal(amount), "expected %s, got %s", tc.amount, amount)
+		})
+	}
+}
+
+func TestDerivativeOrderHold(t *testing.T) {
+	d := decimal.RequireFromString
+	tests := []struct {
+		name       string
+		orderSide  string
+		reduceOnly bool
+		quantity   string
+		unfilled   string
+		amount     string
+	}{
+		// margin 1000 + 10 * 100 * 0.001
+		{name: "resting order", orderSide: OrderSideBuy, quantity: "100", unfilled: "100", amount: "1001"},
+		// margin 1000 * 40 / 100 + 10 * 40 * 0.001
+		{name: "partially filled order", orderSide: OrderSideSell, quantity: "100", unfilled: "40", amount: "400.4"},
+		// margin 1000 + 10 * 100 * 0.002
+		{name: "conditional order holds taker fee", orderSide: OrderSideTakeSell, quantity: "100", unfilled: "100", amount: "1002"},
+		{name: "reduce-only order holds nothing", orderSide: OrderSideSell, reduceOnly: true, quantity: "100", unfilled: "100", amount: "0"},
+	}
+
+	calc := NewMarginHoldCalculator(newMarginHoldTestGuild("0.001", "0.002"))
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			denom, amount, ok := calc.DerivativeOrderHold(&exchange.DerivativeOrder{
+				MarketID:         marketWethUsdtID,
+				OrderSide:        tc.orderSide,
+				IsReduceOnly:     tc.reduceOnly,
+				Margin:           d("1000"),
+				Price:            d("10"),
+				Quantity:         d(tc.quantity),
+				UnfilledQuantity: d(tc.unfilled),
+			})
+			assert.True(t, ok)
+			assert.Equal(t, denomUsdt, denom)
+			assert.True(t, d(tc.amount).Equal(amount), "expected %s, got %s", tc.amount, amount)
+		})
+	}
+
+	_, _, ok := calc.DerivativeOrderHold(&exchange.DerivativeOrder{MarketID: "0xunknown"})
+	assert.False(t, ok)
+}
+
+func TestReconcileHolds(t *testing.T) {
+	d := decimal.RequireFromString
+	balances := []*exchange.Balance{
+		{Denom: denomUst, TotalBalance: d("5000"), AvailableBalance: d("3999")},
+		{Denom: denomInj, TotalBalance: d("100"), AvailableBalance: d("100")},
+		{Denom: denomUsdt, TotalBalance: d("2000000"), AvailableBalance: d("1000000")},
+	}
+	orderHolds := map[string]decimal.Decimal{
+		// within 1 unit
+		denomUst: d("1000"),
+		// chain holds nothing for inj
+		denomInj: d("50"),
+		// within relative tolerance
+		denomUsdt: d("1000050"),
+	}
+
+	result := reconcileHolds(balances, orderHolds)
+	assert.Len(t, result, 1)
+	assert.Equal(t, denomInj, result[0].denom)
+	assert.True(t, result[0].chain.IsZero())
+	assert.True(t, d("50").Equal(result[0].computed))
+}
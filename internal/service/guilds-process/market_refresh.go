@@ -0,0 +1,149 @@
+package guildsprocess
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/exchange"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/prom"
+	metrics "github.com/InjectiveLabs/metrics"
+	"github.com/ethereum/go-ethereum/common"
+	log "github.com/xlab/suplog"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func modelTokenMeta(meta *exchange.TokenMeta) *model.TokenMeta {
+	return &model.TokenMeta{
+		Name:      meta.Name,
+		Address:   meta.Address,
+		Symbol:    meta.Symbol,
+		Decimals:  meta.Decimals,
+		UpdatedAt: meta.UpdatedAt,
+	}
+}
+
+// refreshedMarket returns a copy of market updated with market info,
+// info is nil if market is not found in exchange api
+func refreshedMarket(market *model.GuildMarket, info *exchange.MarketInfo) (*model.GuildMarket, error) {
+	result := *market
+	if info == nil {
+		result.Status = model.MarketStatusDelisted
+		return &result, nil
+	}
+
+	makerFeeRate, err := primitive.ParseDecimal128(info.MakerFeeRate.String())
+	if err != nil {
+		return nil, fmt.Errorf("parse maker fee rate err: %w", err)
+	}
+
+	takerFeeRate, err := primitive.ParseDecimal128(info.TakerFeeRate.String())
+	if err != nil {
+		return nil, fmt.Errorf("parse taker fee rate err: %w", err)
+	}
+
+	result.Status = info.MarketStatus
+	result.MakerFeeRate = makerFeeRate
+	result.TakerFeeRate = takerFeeRate
+	if !market.IsPerpetual && info.BaseTokenMeta != nil {
+		result.BaseTokenMeta = modelTokenMeta(info.BaseTokenMeta)
+	}
+
+	if info.QuoteTokenMeta != nil {
+		result.QuoteTokenMeta = modelTokenMeta(info.QuoteTokenMeta)
+	}
+	return &result, nil
+}
+
+// NewGuildMarket builds guild market from market info of exchange api
+func NewGuildMarket(info *exchange.MarketInfo, isPerpetual bool) (*model.GuildMarket, error) {
+	return refreshedMarket(&model.GuildMarket{
+		MarketID:    model.Hash{Hash: common.HexToHash(info.MarketID)},
+		IsPerpetual: isPerpetual,
+		BaseDenom:   info.BaseDenom,
+		QuoteDenom:  info.QuoteDenom,
+	}, info)
+}
+
+func (p *GuildsProcess) getMarketInfo(ctx context.Context, market *model.GuildMarket) (*exchange.MarketInfo, error) {
+	var (
+		info *exchange.MarketInfo
+		err  error
+	)
+	if market.IsPerpetual {
+		info, err = p.exchange.GetDerivativeMarket(ctx, market.MarketID.String())
+	} else {
+		info, err = p.exchange.GetSpotMarket(ctx, market.MarketID.String())
+	}
+
+	if errors.Is(err, exchange.ErrMarketNotFound) {
+		return nil, nil
+	}
+	return info, err
+}
+
+// refreshMarkets re-fetches info of all guild markets, updates fee rates, token metadata and status,
+// guilds having delisted or paused markets are flagged
+func (p *GuildsProcess) refreshMarkets(ctx context.Context) error {
+	doneFn := metrics.ReportFuncTiming(p.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(p.svcTags)
+
+	guilds, err := p.dbSvc.ListAllGuilds(ctx, false)
+	if err != nil {
+		metrics.ReportFuncError(p.svcTags)
+		return fmt.Errorf("list guild err: %w", err)
+	}
+
+	// guilds may share markets, each market is fetched once per run
+	idToInfo := make(map[string]*exchange.MarketInfo)
+	for _, guild := range guilds {
+		guildID := guild.ID.Hex()
+		markets := make([]*model.GuildMarket, 0, len(guild.Markets))
+		var inactiveMarketIDs []string
+		for _, m := range guild.Markets {
+			marketID := m.MarketID.String()
+			info, fetched := idToInfo[marketID]
+			if !fetched {
+				info, err = p.getMarketInfo(ctx, m)
+				if err != nil {
+					metrics.ReportFuncError(p.svcTags)
+					p.logger.WithField("market_id", marketID).WithError(err).Warningln("get market info error, keep current market info")
+					markets = append(markets, m)
+					continue
+				}
+				idToInfo[marketID] = info
+			}
+
+			market, err := refreshedMarket(m, info)
+			if err != nil {
+				metrics.ReportFuncError(p.svcTags)
+				p.logger.WithField("market_id", marketID).WithError(err).Warningln("refresh market error, keep current market info")
+				markets = append(markets, m)
+				continue
+			}
+
+			if !market.IsActive() {
+				inactiveMarketIDs = append(inactiveMarketIDs, marketID)
+			}
+			markets = append(markets, market)
+		}
+
+		prom.GuildInactiveMarkets.WithLabelValues(guildID).Set(float64(len(inactiveMarketIDs)))
+		if len(inactiveMarketIDs) > 0 {
+			p.logger.WithFields(log.Fields{
+				"guild_id":   guildID,
+				"market_ids": inactiveMarketIDs,
+			}).Warningln("guild has inactive markets")
+		}
+
+		if err := p.dbSvc.UpdateGuildMarkets(ctx, guildID, markets); err != nil {
+			metrics.ReportFuncError(p.svcTags)
+			p.logger.WithField("guild_id", guildID).WithError(err).Warningln("update guild markets error")
+			continue
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package guildsprocess
+
+import (
+	"testing"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/exchange"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestRefreshedMarket(t *testing.T) {
+	oldFeeRate, _ := primitive.ParseDecimal128("0.001")
+	spotMarket := &model.GuildMarket{
+		MarketID:       model.Hash{Hash: common.HexToHash(marketInjUstID)},
+		BaseDenom:      denomInj,
+		BaseTokenMeta:  &model.TokenMeta{Symbol: "INJ", Decimals: 18},
+		QuoteDenom:     denomUst,
+		QuoteTokenMeta: &model.TokenMeta{Symbol: "UST", Decimals: 6},
+		MakerFeeRate:   oldFeeRate,
+		TakerFeeRate:   oldFeeRate,
+	}
+
+	refreshed, err := refreshedMarket(spotMarket, &exchange.MarketInfo{
+		MarketID:       marketInjUstID,
+		MarketStatus:   exchange.MarketStatusPaused,
+		BaseDenom:      denomInj,
+		BaseTokenMeta:  &exchange.TokenMeta{Symbol: "INJ", Decimals: 18},
+		QuoteDenom:     denomUst,
+		QuoteTokenMeta: &exchange.TokenMeta{Symbol: "UST", Decimals: 8, UpdatedAt: 1650000000000},
+		MakerFeeRate:   decimal.RequireFromString("-0.0001"),
+		TakerFeeRate:   decimal.RequireFromString("0.002"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, exchange.MarketStatusPaused, refreshed.Status)
+	assert.False(t, refreshed.IsActive())
+	assert.Equal(t, "-0.0001", refreshed.MakerFeeRate.String())
+	assert.Equal(t, "0.002", refreshed.TakerFeeRate.String())
+	assert.Equal(t, 8, refreshed.QuoteTokenMeta.Decimals)
+	assert.Equal(t, int64(1650000000000), refreshed.QuoteTokenMeta.UpdatedAt)
+	// original market is untouched
+	assert.Equal(t, "0.001", spotMarket.MakerFeeRate.String())
+	assert.Equal(t, 6, spotMarket.QuoteTokenMeta.Decimals)
+
+	derivativeMarket := &model.GuildMarket{
+		MarketID:    model.Hash{Hash: common.HexToHash(marketWethUsdtID)},
+		IsPerpetual: true,
+		QuoteDenom:  denomUsdt,
+	}
+	refreshed, err = refreshedMarket(derivativeMarket, &exchange.MarketInfo{
+		MarketID:     marketWethUsdtID,
+		MarketStatus: exchange.MarketStatusActive,
+		QuoteDenom:   denomUsdt,
+		MakerFeeRate: decimal.RequireFromString("0.0005"),
+		TakerFeeRate: decimal.RequireFromString("0.001"),
+	})
+	assert.NoError(t, err)
+	assert.True(t, refreshed.IsActive())
+	assert.Nil(t, refreshed.BaseTokenMeta)
+	assert.Equal(t, "0.0005", refreshed.MakerFeeRate.String())
+	assert.Equal(t, "0.001", refreshed.TakerFeeRate.String())
+
+	// market is not found in exchange api
+	refreshed, err = refreshedMarket(derivativeMarket, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, model.MarketStatusDelisted, refreshed.Status)
+	assert.False(t, refreshed.IsActive())
+}
@@ -8,13 +8,14 @@ import (
 	"github.com/InjectiveLabs/injective-guilds-service/internal/config"
 	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
 	"github.com/InjectiveLabs/injective-guilds-service/internal/exchange"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/prom"
 	metrics "github.com/InjectiveLabs/metrics"
 	"github.com/shopspring/decimal"
 	log "github.com/xlab/suplog"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
-// PortfolioHelper supports capture account portfolio (default subaccount)
+// PortfolioHelper supports capture account portfolio (default and registered subaccounts)
 type PortfolioHelper struct {
 	exchangeProvider exchange.DataProvider
 	logger           log.Logger
@@ -48,33 +49,46 @@ func (p *PortfolioHelper) CaptureSingleMemberPortfolio(
 	metrics.ReportFuncCall(p.svcTags)
 
 	denoms := model.GetGuildDenoms(guild)
-	defaultSubaccountID := defaultSubaccountIDFromInjAddress(member.InjectiveAddress)
 
-	// get balances
-	balances, err := p.getSubaccountBalances(ctx, denoms, defaultSubaccountID)
-	if err != nil {
-		metrics.ReportFuncError(p.svcTags)
-		return nil, fmt.Errorf("get balance error: %w", err)
-	}
+	var (
+		balances       []*exchange.Balance
+		positions      []*exchange.DerivativePosition
+		modelPositions []*model.Position
+		marginHold     = make(map[string]decimal.Decimal)
+	)
+	// aggregate over default and registered subaccounts
+	for _, subaccountID := range member.TradingSubaccountIDs() {
+		subaccountBalances, err := p.getSubaccountBalances(ctx, denoms, subaccountID)
+		if err != nil {
+			metrics.ReportFuncError(p.svcTags)
+			return nil, fmt.Errorf("get balance error: %w", err)
+		}
+		balances = mergeBalances(balances, subaccountBalances)
 
-	// get all positions
-	positions, err := p.exchangeProvider.GetPositions(ctx, defaultSubaccountID)
-	if err != nil {
-		metrics.ReportFuncError(p.svcTags)
-		// TODO: Put intro retry queue
-		return nil, fmt.Errorf("get position err: %w", err)
+		// get all positions
+		subaccountPositions, err := p.exchangeProvider.GetPositions(ctx, subaccountID)
+		if err != nil {
+			metrics.ReportFuncError(p.svcTags)
+			// TODO: Put intro retry queue
+			return nil, fmt.Errorf("get position err: %w", err)
+		}
+		positions = append(positions, subaccountPositions...)
+		modelPositions = append(modelPositions, buildPositions(guild, subaccountID, subaccountPositions)...)
+
+		// compute margin hold
+		subaccountMarginHold, err := p.getMarginHold(ctx, guild, subaccountPositions, subaccountBalances, subaccountID)
+		if err != nil {
+			metrics.ReportFuncError(p.svcTags)
+			return nil, fmt.Errorf("get margin hold err: %w", err)
+		}
+		for denom, amount := range subaccountMarginHold {
+			marginHold[denom] = marginHold[denom].Add(amount)
+		}
 	}
 
 	// compute pnl
 	pnl := p.getUnrealizedPNL(guild, positions)
 
-	// compute margin hold
-	marginHold, err := p.getMarginHold(ctx, guild, positions, member)
-	if err != nil {
-		metrics.ReportFuncError(p.svcTags)
-		return nil, fmt.Errorf("get margin hold err: %w", err)
-	}
-
 	// bank balance
 	injBalance, err := p.getInjBankBalances(ctx, member)
 	if err != nil {
@@ -93,7 +107,9 @@ func (p *PortfolioHelper) CaptureSingleMemberPortfolio(
 		}
 	}
 
-	return buildPortfolio(member, balances, injBalance, pnl, marginHold, prices), nil
+	portfolio := buildPortfolio(member, balances, injBalance, pnl, marginHold, prices)
+	portfolio.Positions = modelPositions
+	return portfolio, nil
 }
 
 func (p *PortfolioHelper) getInjBankBalances(
@@ -124,8 +140,8 @@ func (p *PortfolioHelper) getInjBankBalances(
 	return nil, nil
 }
 
-func (p *PortfolioHelper) getSubaccountBalances(ctx context.Context, denoms []string, defaultSubaccountID string) (result []*exchange.Balance, err error) {
-	balances, err := p.exchangeProvider.GetSubaccountBalances(ctx, defaultSubaccountID)
+func (p *PortfolioHelper) getSubaccountBalances(ctx context.Context, denoms []string, subaccountID string) (result []*exchange.Balance, err error) {
+	balances, err := p.exchangeProvider.GetSubaccountBalances(ctx, subaccountID)
 	if err != nil {
 		return nil, err
 	}
@@ -174,76 +190,44 @@ func (p *PortfolioHelper) getUnrealizedPNL(
 	return pnl
 }
 
+// getMarginHold returns margins of positions and holds of open orders per denom,
+// order holds are reconciled with chain holds of subaccount balances
 func (p *PortfolioHelper) getMarginHold(
 	ctx context.Context,
 	guild *model.Guild,
 	positions []*exchange.DerivativePosition,
-	member *model.GuildMember,
+	balances []*exchange.Balance,
+	subaccountID string,
 ) (marginHolds map[string]decimal.Decimal, err error) {
-	defaultSubaccountID := defaultSubaccountIDFromInjAddress(member.InjectiveAddress)
-
-	idToMarket := getIDToMarket(guild)
-	// Bojan: marginHold = sumOf(positions.margin) + sumOf(orders.margin) where orders.margin = notionalValue + fees
-	marginHolds = make(map[string]decimal.Decimal)
-	for _, p := range positions {
-		market, exist := idToMarket[p.MarketID]
-		if !exist {
-			continue
-		}
-
-		quoteDenom := market.QuoteDenom
-		marginHolds[quoteDenom] = marginHolds[quoteDenom].Add(p.Margin)
-	}
-
-	// margins from derivative orders
-	derivOrders, err := p.exchangeProvider.GetDerivativeOrders(ctx, marketsFromGuild(guild, true), defaultSubaccountID)
+	derivOrders, err := p.exchangeProvider.GetDerivativeOrders(ctx, marketsFromGuild(guild, true), subaccountID)
 	if err != nil {
 		p.logger.WithError(err).Errorln("cannot get derivaitve orders")
 		return nil, err
 	}
 
-	for _, o := range derivOrders {
-		market, exist := idToMarket[o.MarketID]
-		if !exist {
-			// TODO: Optimization: Put into queue to remove this person
-			// Reason: we don't support market which is not in guild
-			continue
-		}
-
-		// we only have marginHold of quoteDenom in perp markets
-		quoteDenom := market.QuoteDenom
-		marginHolds[quoteDenom] = marginHolds[quoteDenom].Add(o.Margin)
-	}
-
-	// margins from spot orders
-	spotOrders, err := p.exchangeProvider.GetSpotOrders(ctx, marketsFromGuild(guild, false), defaultSubaccountID)
+	spotOrders, err := p.exchangeProvider.GetSpotOrders(ctx, marketsFromGuild(guild, false), subaccountID)
 	if err != nil {
 		p.logger.WithError(err).Errorln("cannot get spot orders")
 		return nil, err
 	}
 
-	// Albert:
-	// for a limit buy in the ETH/USDT market, denom is USDT and balanceHold is (1 + takerFee)*(price * quantity)
-	// for a limit sell in the ETH/USDT market, denom is ETH and balanceHold is just quantity
-	for _, o := range spotOrders {
-		market, exist := idToMarket[o.MarketID]
-		if !exist {
-			continue
-		}
-
-		if o.OrderSide == OrderSideBuy {
-			// expected to parse successfully -> skip error
-			takerFee, _ := decimal.NewFromString(market.TakerFeeRate.String())
-			fee := takerFee.Mul(o.Price).Mul(o.UnfilledQuantity)
-			margin := o.Price.Mul(o.UnfilledQuantity)
-
-			// price * unfilled * (1 + takerFee)
-			marginHolds[market.QuoteDenom] = marginHolds[market.QuoteDenom].Add(margin.Add(fee))
-		} else {
-			marginHolds[market.BaseDenom] = marginHolds[market.BaseDenom].Add(o.UnfilledQuantity)
-		}
+	calculator := NewMarginHoldCalculator(guild)
+	orderHolds := calculator.OrderHolds(spotOrders, derivOrders)
+	for _, d := range reconcileHolds(balances, orderHolds) {
+		p.logger.WithFields(log.Fields{
+			"subaccount_id": subaccountID,
+			"denom":         d.denom,
+			"chain_hold":    d.chain.String(),
+			"computed_hold": d.computed.String(),
+		}).Warningln("margin hold discrepancy")
+		prom.MarginHoldDiscrepancies.WithLabelValues(d.denom).Inc()
 	}
 
+	// marginHold = sumOf(positions.margin) + sumOf(orders.hold)
+	marginHolds = calculator.PositionMargins(positions)
+	for denom, amount := range orderHolds {
+		marginHolds[denom] = marginHolds[denom].Add(amount)
+	}
 	return marginHolds, nil
 }
 
@@ -31,6 +31,28 @@ func parseDecimal128(s string) primitive.Decimal128 {
 	return p
 }
 
+// assertBalancesEqual compares balances by decimal value, the same number can have different decimal128 encodings
+func assertBalancesEqual(t *testing.T, expected, actual []*model.Balance) {
+	t.Helper()
+	if !assert.Len(t, actual, len(expected)) {
+		return
+	}
+
+	equal := func(a, b primitive.Decimal128) bool {
+		return decimal.RequireFromString(a.String()).Equal(decimal.RequireFromString(b.String()))
+	}
+
+	for i, e := range expected {
+		a := actual[i]
+		assert.Equal(t, e.Denom, a.Denom)
+		assert.Equal(t, e.PriceUSD, a.PriceUSD, "%s price usd", e.Denom)
+		assert.True(t, equal(e.TotalBalance, a.TotalBalance), "%s total balance: expected %s, actual %s", e.Denom, e.TotalBalance, a.TotalBalance)
+		assert.True(t, equal(e.AvailableBalance, a.AvailableBalance), "%s available balance: expected %s, actual %s", e.Denom, e.AvailableBalance, a.AvailableBalance)
+		assert.True(t, equal(e.MarginHold, a.MarginHold), "%s margin hold: expected %s, actual %s", e.Denom, e.MarginHold, a.MarginHold)
+		assert.True(t, equal(e.UnrealizedPNL, a.UnrealizedPNL), "%s unrealized pnl: expected %s, actual %s", e.Denom, e.UnrealizedPNL, a.UnrealizedPNL)
+	}
+}
+
 func TestCaptureSinglePortfolio(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	ctx := context.Background()
@@ -162,6 +184,7 @@ func TestCaptureSinglePortfolio(t *testing.T) {
 	mockExchange.EXPECT().GetPriceUSD(gomock.Any(), gomock.Any()).Return(priceUSD, nil).Times(1)
 
 	takerFee, _ := primitive.ParseDecimal128("0.001")
+	makerFee, _ := primitive.ParseDecimal128("0")
 	portfolio, err := helper.CaptureSingleMemberPortfolio(
 		ctx,
 		&model.Guild{
@@ -177,6 +200,7 @@ func TestCaptureSinglePortfolio(t *testing.T) {
 					QuoteTokenMeta: &model.TokenMeta{
 						Decimals: 6,
 					},
+					MakerFeeRate: makerFee,
 					TakerFeeRate: takerFee,
 				},
 				{
@@ -198,13 +222,13 @@ func TestCaptureSinglePortfolio(t *testing.T) {
 
 	assert.Equal(t, model.Address{AccAddress: accAddress}, portfolio.InjectiveAddress)
 
-	assert.Equal(t, []*model.Balance{
+	assertBalancesEqual(t, []*model.Balance{
 		{
 			Denom:            "ibc/B448C0CA358B958301D328CCDC5D5AD642FC30A6D3AE106FF721DB315F3DDE5C",
 			PriceUSD:         1,
 			TotalBalance:     parseDecimal128("30000000"),
 			AvailableBalance: parseDecimal128("30000000"),
-			MarginHold:       parseDecimal128("11211200"),
+			MarginHold:       parseDecimal128("11200000"),
 			UnrealizedPNL:    parseDecimal128("0"),
 		},
 		{
@@ -250,6 +274,90 @@ func TestCaptureSinglePortfolio(t *testing.T) {
 	// ibc/B448C0CA358B958301D328CCDC5D5AD642FC30A6D3AE106FF721DB315F3DDE5C // ust
 	// Total balance: 30000000
 	// Available balance: 30000000
-	// Margin hold: 11211200 # 240000000000000 * 0,000000016 * (1 + 0,001) + 0,000000032 * 230000000000000 * (1 + 0,001)
+	// Margin hold: 11200000 # 240000000000000 * 0,000000016 + 0,000000032 * 230000000000000
+	// resting buy orders hold fee at maker rate (0 here), taker part is refunded once they rest.
+	// Holding taker fee 0.001 would give 11211200
 	// UnrealizedPNL: 0
 }
+
+func TestCaptureMultipleSubaccountsPortfolio(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+	mockExchange := exchange.NewMockDataProvider(ctrl)
+	cosmtypes.GetConfig().SetBech32PrefixForAccount("inj", "injpub")
+
+	helper, err := NewPortfolioHelper(ctx, mockExchange, log.WithField("svc", "test"))
+	assert.NoError(t, err)
+
+	accAddress, _ := cosmtypes.AccAddressFromBech32("inj1awx03zmnnlsjuvp7x8ac3lphw50p0nea6p2584")
+	defaultSubaccountID := "0xEB8cf88b739fE12E303E31fb88fC37751E17cF3D000000000000000000000000"
+	subaccountID := "0xeb8cf88b739fe12e303e31fb88fc37751e17cf3d000000000000000000000001"
+
+	mockExchange.EXPECT().GetSubaccountBalances(gomock.Any(), defaultSubaccountID).Return([]*exchange.Balance{
+		{
+			Denom:            denomUsdt,
+			TotalBalance:     decimal.RequireFromString("50000000"),
+			AvailableBalance: decimal.RequireFromString("40000000"),
+		},
+	}, nil).Times(1)
+	mockExchange.EXPECT().GetSubaccountBalances(gomock.Any(), subaccountID).Return([]*exchange.Balance{
+		{
+			Denom:            denomUsdt,
+			TotalBalance:     decimal.RequireFromString("20000000"),
+			AvailableBalance: decimal.RequireFromString("20000000"),
+		},
+	}, nil).Times(1)
+	mockExchange.EXPECT().GetPositions(gomock.Any(), defaultSubaccountID).Return(nil, nil).Times(1)
+	mockExchange.EXPECT().GetPositions(gomock.Any(), subaccountID).Return([]*exchange.DerivativePosition{
+		{
+			MarketID:   marketWethUsdtID,
+			Direction:  DirectionShort,
+			Quantity:   decimal.RequireFromString("0.5"),
+			Margin:     decimal.RequireFromString("10000000"),
+			EntryPrice: decimal.RequireFromString("3500000000"),
+			MarkPrice:  decimal.RequireFromString("3300000000"),
+		},
+	}, nil).Times(1)
+	mockExchange.EXPECT().GetDerivativeOrders(gomock.Any(), []string{marketWethUsdtID}, gomock.Any()).
+		Return(nil, nil).Times(2)
+	mockExchange.EXPECT().GetSpotOrders(gomock.Any(), nil, gomock.Any()).Return(nil, nil).Times(2)
+	mockExchange.EXPECT().GetBankBalance(gomock.Any(), accAddress.String()).
+		Return(&exchange.BankAccountBalances{}, nil).Times(1)
+
+	portfolio, err := helper.CaptureSingleMemberPortfolio(
+		ctx,
+		&model.Guild{
+			Markets: []*model.GuildMarket{
+				{
+					IsPerpetual: true,
+					MarketID:    model.Hash{Hash: common.HexToHash(marketWethUsdtID)},
+					QuoteDenom:  denomUsdt,
+				},
+			},
+		},
+		&model.GuildMember{
+			InjectiveAddress: model.Address{AccAddress: accAddress},
+			SubaccountIDs:    []string{subaccountID},
+		}, false,
+	)
+	assert.NoError(t, err)
+	assert.Len(t, portfolio.Balances, 1)
+
+	balance := portfolio.Balances[0]
+	assert.Equal(t, denomUsdt, balance.Denom)
+	assert.Equal(t, "70000000", balance.TotalBalance.String())
+	assert.Equal(t, "60000000", balance.AvailableBalance.String())
+	// (3300000000 - 3500000000) * 0.5 * -1
+	assert.Equal(t, "100000000", decimal.RequireFromString(balance.UnrealizedPNL.String()).String())
+	assert.Equal(t, "10000000", decimal.RequireFromString(balance.MarginHold.String()).String())
+
+	assert.Len(t, portfolio.Positions, 1)
+	position := portfolio.Positions[0]
+	assert.Equal(t, subaccountID, position.SubaccountID)
+	assert.Equal(t, marketWethUsdtID, position.MarketID)
+	assert.Equal(t, denomUsdt, position.QuoteDenom)
+	assert.Equal(t, DirectionShort, position.Direction)
+	assert.Equal(t, "0.5", position.Quantity.String())
+	assert.Equal(t, "3300000000", position.MarkPrice.String())
+	assert.Equal(t, "0", position.LiquidationPrice.String())
+}
@@ -0,0 +1,149 @@
+package guildsprocess
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/exchange"
+)
+
+const (
+	QualifyReasonInsufficientBalance = "insufficient_balance"
+	QualifyReasonOpenOrders          = "open_orders"
+	QualifyReasonOpenPositions       = "open_positions"
+	QualifyReasonMissingGrant        = DisqualifyReasonMissingGrant
+
+	MarketTypeSpot       = "spot"
+	MarketTypeDerivative = "derivative"
+)
+
+// Unqualified is the first guild requirement an address doesn't meet
+type Unqualified struct {
+	Reason string
+	// SubaccountID is set for open orders and positions, MarketType for open orders
+	SubaccountID string
+	MarketType   string
+	// Balance is set for insufficient balance
+	Balance *UnmetRequirement
+	// Msg is set for missing grant, ExpiredAt is set if grant exists but is expired
+	Msg       string
+	ExpiredAt *time.Time
+}
+
+func (u *Unqualified) String() string {
+	switch u.Reason {
+	case QualifyReasonInsufficientBalance:
+		return u.Balance.String()
+	case QualifyReasonOpenOrders:
+		return fmt.Sprintf("trading account %s still has open %s orders", u.SubaccountID, u.MarketType)
+	case QualifyReasonOpenPositions:
+		return fmt.Sprintf("trading account %s still has open positions", u.SubaccountID)
+	case QualifyReasonMissingGrant:
+		if u.ExpiredAt != nil {
+			return fmt.Sprintf("%s expired", u.Msg)
+		}
+		return fmt.Sprintf("%s not granted", u.Msg)
+	}
+	return u.Reason
+}
+
+// CheckQualification checks whether member can join guild with portfolio captured for it.
+// Balances must meet guild requirements priced by denomToUsdPrice, trading subaccounts must have
+// no open orders and positions, and all grants must be given to guild master.
+// It returns nil if member qualifies
+func CheckQualification(
+	ctx context.Context,
+	provider exchange.DataProvider,
+	guild *model.Guild,
+	member *model.GuildMember,
+	portfolio *model.AccountPortfolio,
+	denomToUsdPrice map[string]float64,
+	grants []string,
+) (*Unqualified, error) {
+	unmet, err := CheckBalanceRequirements(guild, portfolio, denomToUsdPrice)
+	if err != nil {
+		return nil, fmt.Errorf("balance check err: %w", err)
+	}
+
+	if unmet != nil {
+		return &Unqualified{Reason: QualifyReasonInsufficientBalance, Balance: unmet}, nil
+	}
+
+	for _, subaccountID := range member.TradingSubaccountIDs() {
+		derivOrders, err := provider.GetDerivativeOrders(ctx, []string{}, subaccountID)
+		if err != nil {
+			return nil, fmt.Errorf("get derivative orders err: %w", err)
+		}
+
+		if len(derivOrders) > 0 {
+			return &Unqualified{
+				Reason:       QualifyReasonOpenOrders,
+				SubaccountID: subaccountID,
+				MarketType:   MarketTypeDerivative,
+			}, nil
+		}
+
+		spotOrders, err := provider.GetSpotOrders(ctx, []string{}, subaccountID)
+		if err != nil {
+			return nil, fmt.Errorf("get spot orders err: %w", err)
+		}
+
+		if len(spotOrders) > 0 {
+			return &Unqualified{
+				Reason:       QualifyReasonOpenOrders,
+				SubaccountID: subaccountID,
+				MarketType:   MarketTypeSpot,
+			}, nil
+		}
+	}
+
+	for _, subaccountID := range member.TradingSubaccountIDs() {
+		positions, err := provider.GetPositions(ctx, subaccountID)
+		if err != nil {
+			return nil, fmt.Errorf("get positions err: %w", err)
+		}
+
+		if len(positions) > 0 {
+			return &Unqualified{Reason: QualifyReasonOpenPositions, SubaccountID: subaccountID}, nil
+		}
+	}
+
+	return CheckGrants(ctx, provider, guild, member.InjectiveAddress.String(), grants)
+}
+
+// CheckGrants checks that address granted all grants to guild master and none of them is expired,
+// it returns nil if they are
+func CheckGrants(
+	ctx context.Context,
+	provider exchange.DataProvider,
+	guild *model.Guild,
+	address string,
+	grants []string,
+) (*Unqualified, error) {
+	result, err := provider.GetGrants(ctx, address, guild.MasterAddress.String())
+	if err != nil {
+		return nil, fmt.Errorf("get grants err: %w", err)
+	}
+
+	msgToExpiration, err := GrantExpirations(result)
+	if err != nil {
+		return nil, err
+	}
+
+	// all expected grants must be provided
+	now := time.Now()
+	for _, expectedMsg := range grants {
+		expiration, ok := msgToExpiration[expectedMsg]
+		if !ok {
+			return &Unqualified{Reason: QualifyReasonMissingGrant, Msg: expectedMsg}, nil
+		}
+
+		if expiration.Before(now) {
+			return &Unqualified{Reason: QualifyReasonMissingGrant, Msg: expectedMsg, ExpiredAt: &expiration}, nil
+		}
+	}
+
+	return nil, nil
+}
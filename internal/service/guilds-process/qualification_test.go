@@ -0,0 +1,164 @@
+package guildsprocess
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/exchange"
+	cosmtypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+const testGrantMsg = "/injective.exchange.v1beta1.MsgCreateSpotLimitOrder"
+
+func newTestGrants(t *testing.T, expiration time.Time, msgs ...string) *exchange.Grants {
+	type grant struct {
+		Authorization map[string]string `json:"authorization"`
+		Expiration    string            `json:"expiration"`
+	}
+
+	grants := make([]grant, 0, len(msgs))
+	for _, msg := range msgs {
+		grants = append(grants, grant{
+			Authorization: map[string]string{"msg": msg},
+			Expiration:    expiration.UTC().Format(expirationTimeLayout),
+		})
+	}
+
+	data, err := json.Marshal(map[string]interface{}{"grants": grants})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var result exchange.Grants
+	if err := json.Unmarshal(data, &result); err != nil {
+		t.Fatal(err)
+	}
+	return &result
+}
+
+func TestCheckQualification(t *testing.T) {
+	ctx := context.Background()
+	guild := &model.Guild{
+		MasterAddress: model.Address{AccAddress: cosmtypes.AccAddress("qualify_master______")},
+		Markets: []*model.GuildMarket{
+			{BaseDenom: denomInj, BaseTokenMeta: &model.TokenMeta{Decimals: 0}},
+		},
+		Requirements: []*model.DenomRequirement{{Denom: denomInj, MinAmountUSD: 100}},
+	}
+	member := &model.GuildMember{InjectiveAddress: model.Address{AccAddress: cosmtypes.AccAddress("qualify_member______")}}
+	subaccountID := model.DefaultSubaccountID(member.InjectiveAddress)
+	prices := map[string]float64{denomInj: 5}
+
+	enough := &model.AccountPortfolio{
+		InjectiveAddress: member.InjectiveAddress,
+		Balances:         []*model.Balance{{Denom: denomInj, AvailableBalance: parseDecimal128("20")}},
+	}
+	notEnough := &model.AccountPortfolio{
+		InjectiveAddress: member.InjectiveAddress,
+		Balances:         []*model.Balance{{Denom: denomInj, AvailableBalance: parseDecimal128("19")}},
+	}
+	expiredAt := time.Now().Add(-time.Hour).Truncate(time.Second)
+
+	testCases := []struct {
+		name        string
+		portfolio   *model.AccountPortfolio
+		setup       func(m *exchange.MockDataProvider)
+		reason      string
+		expectedStr string
+	}{
+		{
+			name:        "insufficient balance is checked first",
+			portfolio:   notEnough,
+			setup:       func(m *exchange.MockDataProvider) {},
+			reason:      QualifyReasonInsufficientBalance,
+			expectedStr: "Denom " + denomInj + " balance: 95 < min 100.00",
+		},
+		{
+			name:      "open derivative orders",
+			portfolio: enough,
+			setup: func(m *exchange.MockDataProvider) {
+				m.EXPECT().GetDerivativeOrders(ctx, []string{}, subaccountID).Return([]*exchange.DerivativeOrder{{}}, nil)
+			},
+			reason:      QualifyReasonOpenOrders,
+			expectedStr: "trading account " + subaccountID + " still has open derivative orders",
+		},
+		{
+			name:      "open positions",
+			portfolio: enough,
+			setup: func(m *exchange.MockDataProvider) {
+				m.EXPECT().GetDerivativeOrders(ctx, []string{}, subaccountID).Return(nil, nil)
+				m.EXPECT().GetSpotOrders(ctx, []string{}, subaccountID).Return(nil, nil)
+				m.EXPECT().GetPositions(ctx, subaccountID).Return([]*exchange.DerivativePosition{{}}, nil)
+			},
+			reason:      QualifyReasonOpenPositions,
+			expectedStr: "trading account " + subaccountID + " still has open positions",
+		},
+		{
+			name:      "expired grant",
+			portfolio: enough,
+			setup: func(m *exchange.MockDataProvider) {
+				m.EXPECT().GetDerivativeOrders(ctx, []string{}, subaccountID).Return(nil, nil)
+				m.EXPECT().GetSpotOrders(ctx, []string{}, subaccountID).Return(nil, nil)
+				m.EXPECT().GetPositions(ctx, subaccountID).Return(nil, nil)
+				m.EXPECT().GetGrants(ctx, member.InjectiveAddress.String(), guild.MasterAddress.String()).
+					Return(newTestGrants(t, expiredAt, testGrantMsg), nil)
+			},
+			reason:      QualifyReasonMissingGrant,
+			expectedStr: testGrantMsg + " expired",
+		},
+		{
+			name:      "qualified",
+			portfolio: enough,
+			setup: func(m *exchange.MockDataProvider) {
+				m.EXPECT().GetDerivativeOrders(ctx, []string{}, subaccountID).Return(nil, nil)
+				m.EXPECT().GetSpotOrders(ctx, []string{}, subaccountID).Return(nil, nil)
+				m.EXPECT().GetPositions(ctx, subaccountID).Return(nil, nil)
+				m.EXPECT().GetGrants(ctx, member.InjectiveAddress.String(), guild.MasterAddress.String()).
+					Return(newTestGrants(t, time.Now().Add(time.Hour), testGrantMsg), nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockExchange := exchange.NewMockDataProvider(gomock.NewController(t))
+			tc.setup(mockExchange)
+
+			unqualified, err := CheckQualification(ctx, mockExchange, guild, member, tc.portfolio, prices, []string{testGrantMsg})
+			assert.NoError(t, err)
+			if tc.reason == "" {
+				assert.Nil(t, unqualified)
+				return
+			}
+
+			if assert.NotNil(t, unqualified) {
+				assert.Equal(t, tc.reason, unqualified.Reason)
+				assert.Equal(t, tc.expectedStr, unqualified.String())
+			}
+		})
+	}
+}
+
+func TestCheckGrantsMissing(t *testing.T) {
+	ctx := context.Background()
+	guild := &model.Guild{MasterAddress: model.Address{AccAddress: cosmtypes.AccAddress("qualify_master______")}}
+	address := model.Address{AccAddress: cosmtypes.AccAddress("qualify_member______")}.String()
+
+	mockExchange := exchange.NewMockDataProvider(gomock.NewController(t))
+	mockExchange.EXPECT().GetGrants(ctx, address, guild.MasterAddress.String()).
+		Return(newTestGrants(t, time.Now().Add(time.Hour), testGrantMsg), nil)
+
+	otherMsg := "/injective.exchange.v1beta1.MsgDeposit"
+	unqualified, err := CheckGrants(ctx, mockExchange, guild, address, []string{testGrantMsg, otherMsg})
+	assert.NoError(t, err)
+	if assert.NotNil(t, unqualified) {
+		assert.Equal(t, otherMsg, unqualified.Msg)
+		assert.Nil(t, unqualified.ExpiredAt)
+		assert.Equal(t, otherMsg+" not granted", unqualified.String())
+	}
+}
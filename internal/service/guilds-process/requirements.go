@@ -0,0 +1,89 @@
+package guildsprocess
+
+import (
+	"fmt"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/config"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
+	"github.com/shopspring/decimal"
+)
+
+// DenomPricesUSD returns usd prices of guild portfolio balances
+func DenomPricesUSD(portfolio *model.GuildPortfolio) map[string]float64 {
+	denomToUsdPrice := make(map[string]float64)
+	for _, b := range portfolio.Balances {
+		if _, isStableCoin := config.StableCoinDenoms[b.Denom]; isStableCoin {
+			// price can be fluctuate, let's consider it 1$ for stable coins
+			denomToUsdPrice[b.Denom] = 1
+			continue
+		}
+
+		denomToUsdPrice[b.Denom] = b.PriceUSD
+	}
+	return denomToUsdPrice
+}
+
+// UnmetRequirement is a guild requirement which available balance doesn't meet
+type UnmetRequirement struct {
+	Denom        string
+	BalanceUSD   decimal.Decimal
+	MinAmountUSD float64
+}
+
+func (r *UnmetRequirement) String() string {
+	return fmt.Sprintf("Denom %s balance: %s < min %.2f", r.Denom, r.BalanceUSD.String(), r.MinAmountUSD)
+}
+
+// CheckBalanceRequirements compares available balances of snapshot in usd with guild requirements,
+// it returns the first unmet requirement, or nil if snapshot meets all requirements
+func CheckBalanceRequirements(
+	guild *model.Guild,
+	snapshot *model.AccountPortfolio,
+	denomToUsdPrice map[string]float64,
+) (*UnmetRequirement, error) {
+	denomToDecimal := make(map[string]int)
+	for _, market := range guild.Markets {
+		if market.BaseTokenMeta != nil {
+			denomToDecimal[market.BaseDenom] = market.BaseTokenMeta.Decimals
+		}
+
+		if market.QuoteTokenMeta != nil {
+			denomToDecimal[market.QuoteDenom] = market.QuoteTokenMeta.Decimals
+		}
+	}
+
+	denomToBalance := make(map[string]*model.Balance)
+	for _, b := range snapshot.Balances {
+		denomToBalance[b.Denom] = b
+	}
+
+	for _, req := range guild.Requirements {
+		dec, exist := denomToDecimal[req.Denom]
+		if !exist {
+			return nil, fmt.Errorf("failed check denom %s not belongs to market", req.Denom)
+		}
+
+		priceUsd, exist := denomToUsdPrice[req.Denom]
+		if !exist {
+			return nil, fmt.Errorf("failed to check denom %s price in usd", req.Denom)
+		}
+
+		availBalance := decimal.Zero
+		if b, exist := denomToBalance[req.Denom]; exist {
+			availBalance, _ = decimal.NewFromString(b.AvailableBalance.String())
+		}
+
+		usdInDecimal := decimal.NewFromFloat(priceUsd)
+		// base unit to token amount
+		availBalanceFloat := availBalance.Shift(int32(-dec)).Mul(usdInDecimal)
+		if !availBalanceFloat.GreaterThanOrEqual(decimal.NewFromFloat(req.MinAmountUSD)) {
+			return &UnmetRequirement{
+				Denom:        req.Denom,
+				BalanceUSD:   availBalanceFloat,
+				MinAmountUSD: req.MinAmountUSD,
+			}, nil
+		}
+	}
+
+	return nil, nil
+}
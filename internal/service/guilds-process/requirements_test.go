@@ -0,0 +1,105 @@
+package guildsprocess
+
+import (
+	"testing"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckBalanceRequirements(t *testing.T) {
+	guild := &model.Guild{
+		Markets: []*model.GuildMarket{
+			{
+				BaseDenom:      denomInj,
+				BaseTokenMeta:  &model.TokenMeta{Decimals: 0},
+				QuoteDenom:     denomUsdt,
+				QuoteTokenMeta: &model.TokenMeta{Decimals: 0},
+			},
+		},
+		Requirements: []*model.DenomRequirement{
+			{Denom: denomInj, MinAmountUSD: 100},
+			{Denom: denomUsdt, MinAmountUSD: 50},
+		},
+	}
+	prices := DenomPricesUSD(&model.GuildPortfolio{
+		Balances: []*model.Balance{
+			{Denom: denomInj, PriceUSD: 5},
+			{Denom: denomUsdt, PriceUSD: 1},
+		},
+	})
+
+	snapshot := &model.AccountPortfolio{
+		Balances: []*model.Balance{
+			{Denom: denomInj, AvailableBalance: parseDecimal128("20")},
+			{Denom: denomUsdt, AvailableBalance: parseDecimal128("50")},
+		},
+	}
+	unmet, err := CheckBalanceRequirements(guild, snapshot, prices)
+	assert.NoError(t, err)
+	assert.Nil(t, unmet)
+
+	// missing balance counts as zero
+	snapshot = &model.AccountPortfolio{
+		Balances: []*model.Balance{
+			{Denom: denomInj, AvailableBalance: parseDecimal128("20")},
+		},
+	}
+	unmet, err = CheckBalanceRequirements(guild, snapshot, prices)
+	assert.NoError(t, err)
+	assert.Equal(t, denomUsdt, unmet.Denom)
+	assert.Equal(t, "Denom "+denomUsdt+" balance: 0 < min 50.00", unmet.String())
+
+	// price of denom is unknown until next portfolio capture
+	delete(prices, denomUsdt)
+	_, err = CheckBalanceRequirements(guild, snapshot, prices)
+	assert.Error(t, err)
+}
+
+func TestCheckBalanceRequirementsTokenDecimals(t *testing.T) {
+	guild := &model.Guild{
+		Markets: []*model.GuildMarket{
+			{
+				BaseDenom:      denomInj,
+				BaseTokenMeta:  &model.TokenMeta{Decimals: 18},
+				QuoteDenom:     denomUsdt,
+				QuoteTokenMeta: &model.TokenMeta{Decimals: 6},
+			},
+		},
+		Requirements: []*model.DenomRequirement{
+			{Denom: denomInj, MinAmountUSD: 100},
+			{Denom: denomUsdt, MinAmountUSD: 50},
+		},
+	}
+	prices := DenomPricesUSD(&model.GuildPortfolio{
+		Balances: []*model.Balance{
+			{Denom: denomInj, PriceUSD: 5},
+			{Denom: denomUsdt, PriceUSD: 1},
+		},
+	})
+
+	// 20 inj is $100, 50 usdt is $50
+	snapshot := &model.AccountPortfolio{
+		Balances: []*model.Balance{
+			{Denom: denomInj, AvailableBalance: parseDecimal128("20000000000000000000")},
+			{Denom: denomUsdt, AvailableBalance: parseDecimal128("50000000")},
+		},
+	}
+	unmet, err := CheckBalanceRequirements(guild, snapshot, prices)
+	assert.NoError(t, err)
+	assert.Nil(t, unmet)
+
+	// 49.99 usdt
+	snapshot.Balances[1].AvailableBalance = parseDecimal128("49990000")
+	unmet, err = CheckBalanceRequirements(guild, snapshot, prices)
+	assert.NoError(t, err)
+	assert.Equal(t, denomUsdt, unmet.Denom)
+	assert.Equal(t, "Denom "+denomUsdt+" balance: 49.99 < min 50.00", unmet.String())
+
+	// 19.9 inj is $99.5
+	snapshot.Balances[0].AvailableBalance = parseDecimal128("19900000000000000000")
+	unmet, err = CheckBalanceRequirements(guild, snapshot, prices)
+	assert.NoError(t, err)
+	assert.Equal(t, denomInj, unmet.Denom)
+	assert.Equal(t, "Denom inj balance: 99.5 < min 100.00", unmet.String())
+}
@@ -0,0 +1,90 @@
+package guildsprocess
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/performance"
+)
+
+// captureGuildRisk computes risk metrics of guild from portfolio history within risk window
+// and positions of member snapshots captured in this cycle
+func (p *GuildsProcess) captureGuildRisk(
+	ctx context.Context,
+	guild *model.Guild,
+	memberPortfolios []*model.AccountPortfolio,
+	prices map[string]float64,
+	now time.Time,
+) error {
+	windowStart := now.Add(-p.riskWindow)
+	history, err := p.dbSvc.ListGuildPortfolios(ctx, model.GuildPortfoliosFilter{
+		GuildID:   guild.ID.Hex(),
+		StartTime: &windowStart,
+	})
+	if err != nil {
+		return fmt.Errorf("list guild portfolios err: %w", err)
+	}
+
+	var positions []*model.Position
+	for _, portfolio := range memberPortfolios {
+		positions = append(positions, portfolio.Positions...)
+	}
+
+	if err := p.dbSvc.AddGuildRisk(ctx, buildGuildRisk(guild, history, positions, prices, now)); err != nil {
+		return fmt.Errorf("add guild risk err: %w", err)
+	}
+	return nil
+}
+
+// guildPortfolioPoints converts guild history sorted newest first into valued points sorted oldest first
+func guildPortfolioPoints(history []*model.GuildPortfolio, decimals map[string]int) []performance.Point {
+	points := make([]performance.Point, 0, len(history))
+	for i := len(history) - 1; i >= 0; i-- {
+		h := history[i]
+		value, _ := performance.PortfolioValueUSD(h.Balances, h.BankBalances, decimals).Float64()
+		netFlow, _ := performance.CashFlowsValueUSD(h.CashFlows, h.Balances, h.BankBalances, decimals).Float64()
+		points = append(points, performance.Point{
+			Time:    h.UpdatedAt,
+			Value:   value,
+			NetFlow: netFlow,
+		})
+	}
+	return points
+}
+
+// buildGuildRisk expects history sorted newest first, the newest snapshot is used as current equity
+func buildGuildRisk(
+	guild *model.Guild,
+	history []*model.GuildPortfolio,
+	positions []*model.Position,
+	prices map[string]float64,
+	now time.Time,
+) *model.GuildRisk {
+	decimals := performance.DenomDecimals(guild)
+	points := guildPortfolioPoints(history, decimals)
+
+	returns := performance.PeriodReturns(points)
+	risk := &model.GuildRisk{
+		GuildID:       guild.ID,
+		SnapshotCount: len(points),
+		MaxDrawdown:   performance.MaxDrawdown(returns),
+		Volatility:    performance.Volatility(returns),
+		SharpeRatio:   performance.SharpeRatio(returns),
+		UpdatedAt:     now,
+	}
+
+	if len(points) > 0 {
+		risk.WindowStart = points[0].Time
+		risk.EquityUSD = points[len(points)-1].Value
+	}
+
+	notional, markets := performance.PositionsExposure(positions, prices, decimals)
+	risk.NotionalUSD, _ = notional.Float64()
+	risk.Markets = markets
+	if risk.EquityUSD > 0 {
+		risk.Leverage = risk.NotionalUSD / risk.EquityUSD
+	}
+	return risk
+}
@@ -0,0 +1,67 @@
+package guildsprocess
+
+import (
+	"testing"
+	"time"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestBuildGuildRisk(t *testing.T) {
+	dec := func(s string) primitive.Decimal128 {
+		d, _ := primitive.ParseDecimal128(s)
+		return d
+	}
+
+	guild := &model.Guild{
+		ID: primitive.NewObjectID(),
+		Markets: []*model.GuildMarket{
+			{
+				IsPerpetual:    true,
+				QuoteDenom:     denomUsdt,
+				QuoteTokenMeta: &model.TokenMeta{Decimals: 6},
+			},
+		},
+	}
+
+	now := time.Date(2022, 3, 2, 0, 0, 0, 0, time.UTC)
+	snapshot := func(hoursAgo int, totalBalance string) *model.GuildPortfolio {
+		return &model.GuildPortfolio{
+			GuildID: guild.ID,
+			Balances: []*model.Balance{
+				{Denom: denomUsdt, TotalBalance: dec(totalBalance), UnrealizedPNL: dec("0")},
+			},
+			UpdatedAt: now.Add(-time.Duration(hoursAgo) * time.Hour),
+		}
+	}
+
+	// newest first: 1000 -> 1200 -> 900 -> 1100 usdt
+	history := []*model.GuildPortfolio{
+		snapshot(0, "1100000000"),
+		snapshot(1, "900000000"),
+		snapshot(2, "1200000000"),
+		snapshot(3, "1000000000"),
+	}
+	positions := []*model.Position{
+		{MarketID: marketWethUsdtID, QuoteDenom: denomUsdt, Direction: DirectionShort, Quantity: dec("0.5"), MarkPrice: dec("3300000000")},
+	}
+
+	risk := buildGuildRisk(guild, history, positions, map[string]float64{}, now)
+	assert.Equal(t, guild.ID, risk.GuildID)
+	assert.Equal(t, 4, risk.SnapshotCount)
+	assert.Equal(t, now.Add(-3*time.Hour), risk.WindowStart)
+	assert.Equal(t, now, risk.UpdatedAt)
+	assert.InDelta(t, 0.25, risk.MaxDrawdown, 1e-9)
+	assert.Equal(t, 1100.0, risk.EquityUSD)
+	assert.Equal(t, 1650.0, risk.NotionalUSD)
+	assert.InDelta(t, 1.5, risk.Leverage, 1e-9)
+	assert.Len(t, risk.Markets, 1)
+	assert.Equal(t, 1.0, risk.Markets[0].Share)
+
+	// no history, no leverage
+	risk = buildGuildRisk(guild, nil, positions, map[string]float64{}, now)
+	assert.Equal(t, 0, risk.SnapshotCount)
+	assert.Equal(t, 0.0, risk.Leverage)
+}
@@ -0,0 +1,394 @@
+package guildsprocess
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/exchange"
+	metrics "github.com/InjectiveLabs/metrics"
+	"github.com/shopspring/decimal"
+	log "github.com/xlab/suplog"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	tradesPageLimit = 100
+	// maxTradePages bounds trades fetched per subaccount and market in one run
+	maxTradePages = 50
+)
+
+// ingestTrades pulls new trades of members' trading subaccounts in guild markets,
+// computes realized pnl against average entry price then stores them
+func (p *GuildsProcess) ingestTrades(ctx context.Context) error {
+	doneFn := metrics.ReportFuncTiming(p.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(p.svcTags)
+
+	guilds, err := p.dbSvc.ListAllGuilds(ctx, false)
+	if err != nil {
+		metrics.ReportFuncError(p.svcTags)
+		return fmt.Errorf("list guild err: %w", err)
+	}
+
+	for _, guild := range guilds {
+		guildID := guild.ID.Hex()
+		members, err := p.dbSvc.ListGuildMembers(ctx, model.MemberFilter{
+			GuildID: &guildID,
+		})
+		if err != nil {
+			p.logger.
+				WithField("guild_id", guildID).
+				WithError(err).Warningln("list members error, skip this guild")
+			continue
+		}
+
+		countTrades := 0
+		for _, member := range members {
+			for _, subaccountID := range member.TradingSubaccountIDs() {
+				for _, market := range guild.Markets {
+					count, err := p.ingestMarketTrades(ctx, guild, member, subaccountID, market)
+					if err != nil {
+						metrics.ReportFuncError(p.svcTags)
+						p.logger.WithFields(log.Fields{
+							"guild_id":      guildID,
+							"subaccount_id": subaccountID,
+							"market_id":     market.MarketID.Hex(),
+						}).WithError(err).Warningln("ingest trades error")
+						continue
+					}
+					countTrades += count
+				}
+			}
+		}
+
+		p.logger.WithField("count", countTrades).WithField("guild_id", guildID).Info("ingested trades")
+	}
+
+	return nil
+}
+
+func (p *GuildsProcess) ingestMarketTrades(
+	ctx context.Context,
+	guild *model.Guild,
+	member *model.GuildMember,
+	subaccountID string,
+	market *model.GuildMarket,
+) (int, error) {
+	marketID := market.MarketID.Hex()
+	cursor, err := p.dbSvc.GetTradeCursor(ctx, subaccountID, marketID)
+	if err != nil && err != db.ErrNotFound {
+		return 0, fmt.Errorf("get trade cursor err: %w", err)
+	}
+	cursor = memberTradeCursor(cursor, member, subaccountID, marketID)
+
+	newTrades, resumeSkip, err := p.fetchTradesSince(ctx, market, subaccountID, cursor)
+	if err != nil {
+		return 0, err
+	}
+
+	if resumeSkip > 0 {
+		// trades older than fetched ones are not reached yet, they must be applied first
+		p.logger.WithFields(log.Fields{
+			"subaccount_id": subaccountID,
+			"market_id":     marketID,
+			"resume_skip":   resumeSkip,
+		}).Warningln("reached max trade pages, resume next run")
+
+		nextCursor := *cursor
+		nextCursor.ResumeSkip = resumeSkip
+		if err := p.dbSvc.AddTrades(ctx, nil, &nextCursor); err != nil {
+			return 0, fmt.Errorf("update trade cursor err: %w", err)
+		}
+		return 0, nil
+	}
+
+	if len(newTrades) == 0 {
+		return 0, nil
+	}
+
+	feeRecipients, err := p.orderFeeRecipients(ctx, market, subaccountID, newTrades)
+	if err != nil {
+		return 0, err
+	}
+
+	trades, nextCursor, err := buildTrades(guild, member, market, cursor, newTrades, feeRecipients)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := p.dbSvc.AddTrades(ctx, trades, nextCursor); err != nil {
+		return 0, fmt.Errorf("add trades err: %w", err)
+	}
+
+	return len(trades), nil
+}
+
+// memberTradeCursor returns cursor to ingest trades of current membership from.
+// Cursor left before member joined, i.e. member rejoined or transferred from another guild,
+// starts over at member since so trades of previous membership aren't counted for this guild
+func memberTradeCursor(
+	cursor *model.TradeCursor,
+	member *model.GuildMember,
+	subaccountID, marketID string,
+) *model.TradeCursor {
+	if cursor != nil && !cursor.LastExecutedAt.Before(member.Since) {
+		return cursor
+	}
+
+	return &model.TradeCursor{
+		SubaccountID:     subaccountID,
+		MarketID:         marketID,
+		LastExecutedAt:   member.Since,
+		PositionQuantity: primitive.NewDecimal128(0, 0),
+		AvgEntryPrice:    primitive.NewDecimal128(0, 0),
+	}
+}
+
+// fetchTradesSince returns trades not ingested yet by cursor, oldest first.
+// Paging starts from cursor resume skip, if max pages are reached before cursor position,
+// no trade is returned with the skip to resume from next run
+func (p *GuildsProcess) fetchTradesSince(
+	ctx context.Context,
+	market *model.GuildMarket,
+	subaccountID string,
+	cursor *model.TradeCursor,
+) (result []*exchange.Trade, resumeSkip uint64, err error) {
+	marketID := market.MarketID.Hex()
+	sinceMs := cursor.LastExecutedAt.UnixMilli()
+
+	// trades shift to next pages when new trades come in between requests, skip the ones seen
+	seen := make(map[string]bool)
+	for _, id := range cursor.LastTradeIDs {
+		seen[id] = true
+	}
+
+	skip := cursor.ResumeSkip
+	for page := 0; page < maxTradePages; page++ {
+		var trades []*exchange.Trade
+		if market.IsPerpetual {
+			trades, err = p.exchange.GetDerivativeTrades(ctx, marketID, subaccountID, skip, tradesPageLimit)
+		} else {
+			trades, err = p.exchange.GetSpotTrades(ctx, marketID, subaccountID, skip, tradesPageLimit)
+		}
+		if err != nil {
+			return nil, 0, fmt.Errorf("get trades err: %w", err)
+		}
+
+		// trades are sorted newest first
+		for _, t := range trades {
+			if t.ExecutedAt < sinceMs {
+				return reverseTrades(result), 0, nil
+			}
+
+			id := tradeID(t)
+			if seen[id] {
+				continue
+			}
+			seen[id] = true
+			result = append(result, t)
+		}
+
+		if len(trades) < tradesPageLimit {
+			return reverseTrades(result), 0, nil
+		}
+		skip += tradesPageLimit
+	}
+
+	return nil, skip, nil
+}
+
+// orderFeeRecipients returns fee recipient by order hash of trades, resolved from subaccount open orders in market
+// then from earlier ingested trades of the orders. Orders closed before any of their trades was ingested
+// have FeeRecipientUnknown, exchange api has no order history to resolve them
+func (p *GuildsProcess) orderFeeRecipients(
+	ctx context.Context,
+	market *model.GuildMarket,
+	subaccountID string,
+	trades []*exchange.Trade,
+) (map[string]string, error) {
+	marketIDs := []string{market.MarketID.Hex()}
+	result := make(map[string]string)
+	if market.IsPerpetual {
+		orders, err := p.exchange.GetDerivativeOrders(ctx, marketIDs, subaccountID)
+		if err != nil {
+			return nil, fmt.Errorf("get derivative orders err: %w", err)
+		}
+
+		for _, o := range orders {
+			result[o.OrderHash] = strings.ToLower(o.FeeRecipient)
+		}
+	} else {
+		orders, err := p.exchange.GetSpotOrders(ctx, marketIDs, subaccountID)
+		if err != nil {
+			return nil, fmt.Errorf("get spot orders err: %w", err)
+		}
+
+		for _, o := range orders {
+			result[o.OrderHash] = strings.ToLower(o.FeeRecipient)
+		}
+	}
+
+	var closedOrderHashes []string
+	for _, t := range trades {
+		if _, ok := result[t.OrderHash]; !ok {
+			result[t.OrderHash] = model.FeeRecipientUnknown
+			closedOrderHashes = append(closedOrderHashes, t.OrderHash)
+		}
+	}
+
+	if len(closedOrderHashes) == 0 {
+		return result, nil
+	}
+
+	ingested, err := p.dbSvc.GetOrderFeeRecipients(ctx, closedOrderHashes)
+	if err != nil {
+		return nil, fmt.Errorf("get order fee recipients err: %w", err)
+	}
+
+	for orderHash, feeRecipient := range ingested {
+		result[orderHash] = feeRecipient
+	}
+	return result, nil
+}
+
+func reverseTrades(trades []*exchange.Trade) []*exchange.Trade {
+	for i, j := 0, len(trades)-1; i < j; i, j = i+1, j-1 {
+		trades[i], trades[j] = trades[j], trades[i]
+	}
+	return trades
+}
+
+// buildTrades converts oldest first trades to model, applying them to cursor cost basis
+func buildTrades(
+	guild *model.Guild,
+	member *model.GuildMember,
+	market *model.GuildMarket,
+	cursor *model.TradeCursor,
+	trades []*exchange.Trade,
+	feeRecipients map[string]string,
+) ([]*model.Trade, *model.TradeCursor, error) {
+	position, err := decimal.NewFromString(cursor.PositionQuantity.String())
+	if err != nil {
+		return nil, nil, fmt.Errorf("parse position quantity err: %w", err)
+	}
+
+	avgEntry, err := decimal.NewFromString(cursor.AvgEntryPrice.String())
+	if err != nil {
+		return nil, nil, fmt.Errorf("parse avg entry price err: %w", err)
+	}
+
+	result := make([]*model.Trade, 0, len(trades))
+	lastExecutedAt := cursor.LastExecutedAt
+	lastTradeIDs := cursor.LastTradeIDs
+	for _, t := range trades {
+		var realized decimal.Decimal
+		position, avgEntry, realized = applyFill(
+			position, avgEntry,
+			t.Direction == OrderSideBuy, t.Price, t.Quantity,
+			market.IsPerpetual,
+		)
+
+		executedAt := time.UnixMilli(t.ExecutedAt)
+		trade := &model.Trade{
+			TradeID:          tradeID(t),
+			GuildID:          guild.ID,
+			InjectiveAddress: member.InjectiveAddress,
+			SubaccountID:     cursor.SubaccountID,
+			MarketID:         cursor.MarketID,
+			IsPerpetual:      market.IsPerpetual,
+			OrderHash:        t.OrderHash,
+			Direction:        t.Direction,
+			ExecutionType:    t.ExecutionType,
+			IsLiquidation:    t.IsLiquidation,
+			FeeRecipient:     feeRecipients[t.OrderHash],
+			QuoteDenom:       market.QuoteDenom,
+			ExecutedAt:       executedAt,
+		}
+		trade.Price, _ = primitive.ParseDecimal128(t.Price.String())
+		trade.Quantity, _ = primitive.ParseDecimal128(t.Quantity.String())
+		trade.Fee, _ = primitive.ParseDecimal128(t.Fee.String())
+		trade.Volume, _ = primitive.ParseDecimal128(t.Price.Mul(t.Quantity).String())
+		trade.RealizedPNL, _ = primitive.ParseDecimal128(realized.String())
+
+		result = append(result, trade)
+		switch {
+		case executedAt.After(lastExecutedAt):
+			lastExecutedAt = executedAt
+			lastTradeIDs = []string{trade.TradeID}
+		case executedAt.Equal(lastExecutedAt):
+			lastTradeIDs = append(lastTradeIDs, trade.TradeID)
+		}
+	}
+
+	nextCursor := &model.TradeCursor{
+		SubaccountID:   cursor.SubaccountID,
+		MarketID:       cursor.MarketID,
+		LastExecutedAt: lastExecutedAt,
+		LastTradeIDs:   lastTradeIDs,
+	}
+	nextCursor.PositionQuantity, _ = primitive.ParseDecimal128(position.String())
+	nextCursor.AvgEntryPrice, _ = primitive.ParseDecimal128(avgEntry.String())
+
+	return result, nextCursor, nil
+}
+
+// applyFill applies a fill to signed position with average entry price, returns realized pnl of closed quantity.
+// Spot holdings can't be short: selling more than tracked holdings realizes nothing for the excess
+func applyFill(
+	position, avgEntry decimal.Decimal,
+	isBuy bool,
+	price, quantity decimal.Decimal,
+	allowShort bool,
+) (newPosition, newAvgEntry, realized decimal.Decimal) {
+	if quantity.IsZero() {
+		return position, avgEntry, decimal.Zero
+	}
+
+	delta := quantity
+	if !isBuy {
+		delta = quantity.Neg()
+	}
+
+	// open or increase position
+	if position.IsZero() || position.Sign() == delta.Sign() {
+		newPosition = position.Add(delta)
+		if !allowShort && newPosition.IsNegative() {
+			return decimal.Zero, decimal.Zero, decimal.Zero
+		}
+
+		cost := position.Abs().Mul(avgEntry).Add(quantity.Mul(price))
+		return newPosition, cost.Div(newPosition.Abs()), decimal.Zero
+	}
+
+	// reduce, close or flip position
+	closed := decimal.Min(position.Abs(), quantity)
+	realized = price.Sub(avgEntry).Mul(closed).Mul(decimal.NewFromInt(int64(position.Sign())))
+	newPosition = position.Add(delta)
+
+	switch {
+	case newPosition.IsZero():
+		return newPosition, decimal.Zero, realized
+	case newPosition.Sign() != position.Sign():
+		if !allowShort {
+			return decimal.Zero, decimal.Zero, realized
+		}
+		return newPosition, price, realized
+	default:
+		return newPosition, avgEntry, realized
+	}
+}
+
+// tradeID derives a stable id since exchange api trades have no id
+func tradeID(t *exchange.Trade) string {
+	h := sha256.Sum256([]byte(fmt.Sprintf(
+		"%s|%s|%s|%d|%s|%s|%s",
+		t.SubaccountID, t.MarketID, t.OrderHash, t.ExecutedAt, t.Direction, t.Price.String(), t.Quantity.String(),
+	)))
+	return hex.EncodeToString(h[:])
+}
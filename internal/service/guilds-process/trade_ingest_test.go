@@ -0,0 +1,180 @@
+package guildsprocess
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/exchange"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	log "github.com/xlab/suplog"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestApplyFill(t *testing.T) {
+	d := decimal.RequireFromString
+
+	testCases := []struct {
+		name       string
+		position   string
+		avgEntry   string
+		isBuy      bool
+		price      string
+		quantity   string
+		allowShort bool
+
+		expectedPosition string
+		expectedAvgEntry string
+		expectedRealized string
+	}{
+		{"open long", "0", "0", true, "100", "2", true, "2", "100", "0"},
+		{"increase long", "2", "100", true, "130", "1", true, "3", "110", "0"},
+		{"reduce long", "3", "110", false, "150", "1", true, "2", "110", "40"},
+		{"close short", "-2", "100", true, "80", "2", true, "0", "0", "40"},
+		{"flip long to short", "1", "100", false, "90", "3", true, "-2", "90", "-10"},
+		{"spot sell without holdings", "0", "0", false, "100", "1", false, "0", "0", "0"},
+		{"spot oversell", "1", "100", false, "120", "3", false, "0", "0", "20"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			position, avgEntry, realized := applyFill(
+				d(tc.position), d(tc.avgEntry), tc.isBuy, d(tc.price), d(tc.quantity), tc.allowShort,
+			)
+			assert.Equal(t, tc.expectedPosition, position.String())
+			assert.Equal(t, tc.expectedAvgEntry, avgEntry.String())
+			assert.Equal(t, tc.expectedRealized, realized.String())
+		})
+	}
+}
+
+func newTestTrade(orderHash string, executedAt int64) *exchange.Trade {
+	return &exchange.Trade{
+		MarketID:     marketInjUstID,
+		OrderHash:    orderHash,
+		Direction:    OrderSideBuy,
+		Price:        decimal.NewFromInt(10),
+		Quantity:     decimal.NewFromInt(1),
+		Fee:          decimal.Zero,
+		ExecutedAt:   executedAt,
+		SubaccountID: "0x01",
+	}
+}
+
+func TestFetchTradesSinceSkipsSeenTrades(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockExchange := exchange.NewMockDataProvider(ctrl)
+	p := &GuildsProcess{exchange: mockExchange, logger: log.WithField("svc", "test")}
+
+	market := &model.GuildMarket{MarketID: model.Hash{Hash: common.HexToHash(marketInjUstID)}}
+	seenTrade := newTestTrade("0xa", 1000)
+	sameMsTrade := newTestTrade("0xb", 1000)
+	newTrade := newTestTrade("0xc", 2000)
+
+	mockExchange.EXPECT().
+		GetSpotTrades(gomock.Any(), marketInjUstID, "0x01", uint64(0), int32(tradesPageLimit)).
+		Return([]*exchange.Trade{newTrade, sameMsTrade, seenTrade, newTestTrade("0xd", 999)}, nil)
+
+	result, resumeSkip, err := p.fetchTradesSince(context.Background(), market, "0x01", &model.TradeCursor{
+		LastExecutedAt: time.UnixMilli(1000),
+		LastTradeIDs:   []string{tradeID(seenTrade)},
+	})
+	assert.NoError(t, err)
+	assert.Zero(t, resumeSkip)
+	assert.Equal(t, []*exchange.Trade{sameMsTrade, newTrade}, result)
+}
+
+func TestFetchTradesSinceResumesAfterMaxPages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockExchange := exchange.NewMockDataProvider(ctrl)
+	p := &GuildsProcess{exchange: mockExchange, logger: log.WithField("svc", "test")}
+
+	market := &model.GuildMarket{MarketID: model.Hash{Hash: common.HexToHash(marketInjUstID)}}
+	fullPage := func(ctx context.Context, marketID, subaccountID string, skip uint64, limit int32) ([]*exchange.Trade, error) {
+		trades := make([]*exchange.Trade, 0, limit)
+		for i := int64(0); i < int64(limit); i++ {
+			trades = append(trades, newTestTrade("0xa", 1000000-int64(skip)-i))
+		}
+		return trades, nil
+	}
+
+	// first run stops at max pages without returning trades
+	mockExchange.EXPECT().GetSpotTrades(gomock.Any(), marketInjUstID, "0x01", gomock.Any(), int32(tradesPageLimit)).
+		DoAndReturn(fullPage).Times(maxTradePages)
+	cursor := &model.TradeCursor{LastExecutedAt: time.UnixMilli(0)}
+	result, resumeSkip, err := p.fetchTradesSince(context.Background(), market, "0x01", cursor)
+	assert.NoError(t, err)
+	assert.Empty(t, result)
+	assert.Equal(t, uint64(maxTradePages*tradesPageLimit), resumeSkip)
+
+	// next run starts from resume skip and returns the oldest trades
+	mockExchange.EXPECT().
+		GetSpotTrades(gomock.Any(), marketInjUstID, "0x01", resumeSkip, int32(tradesPageLimit)).
+		Return([]*exchange.Trade{newTestTrade("0xb", 2), newTestTrade("0xc", 1)}, nil)
+	cursor.ResumeSkip = resumeSkip
+	result, resumeSkip, err = p.fetchTradesSince(context.Background(), market, "0x01", cursor)
+	assert.NoError(t, err)
+	assert.Zero(t, resumeSkip)
+	assert.Equal(t, []*exchange.Trade{newTestTrade("0xc", 1), newTestTrade("0xb", 2)}, result)
+}
+
+func TestOrderFeeRecipients(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockExchange := exchange.NewMockDataProvider(ctrl)
+	mockDB := db.NewMockDBService(ctrl)
+	p := &GuildsProcess{exchange: mockExchange, dbSvc: mockDB, logger: log.WithField("svc", "test")}
+
+	market := &model.GuildMarket{MarketID: model.Hash{Hash: common.HexToHash(marketInjUstID)}}
+	trades := []*exchange.Trade{newTestTrade("0xa", 1000), newTestTrade("0xb", 1000), newTestTrade("0xc", 1000)}
+
+	mockExchange.EXPECT().GetSpotOrders(gomock.Any(), []string{marketInjUstID}, "0x01").
+		Return([]*exchange.SpotOrder{{OrderHash: "0xa", FeeRecipient: "INJ1MASTER"}}, nil)
+	// filled orders are resolved from their earlier trades
+	mockDB.EXPECT().GetOrderFeeRecipients(gomock.Any(), []string{"0xb", "0xc"}).
+		Return(map[string]string{"0xb": "inj1master"}, nil)
+
+	result, err := p.orderFeeRecipients(context.Background(), market, "0x01", trades)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"0xa": "inj1master",
+		"0xb": "inj1master",
+		"0xc": model.FeeRecipientUnknown,
+	}, result)
+}
+
+func TestMemberTradeCursor(t *testing.T) {
+	since := time.UnixMilli(1646092800000)
+	member := &model.GuildMember{Since: since}
+	current := &model.TradeCursor{
+		SubaccountID:   "0x01",
+		MarketID:       marketInjUstID,
+		LastExecutedAt: since.Add(time.Hour),
+		LastTradeIDs:   []string{"trade"},
+	}
+	// left by previous membership, e.g. member rejoined or transferred from another guild
+	stale := &model.TradeCursor{
+		SubaccountID:   "0x01",
+		MarketID:       marketInjUstID,
+		LastExecutedAt: since.Add(-time.Hour),
+		LastTradeIDs:   []string{"trade"},
+		ResumeSkip:     100,
+	}
+
+	assert.Equal(t, current, memberTradeCursor(current, member, "0x01", marketInjUstID))
+
+	for name, cursor := range map[string]*model.TradeCursor{"no cursor": nil, "stale cursor": stale} {
+		t.Run(name, func(t *testing.T) {
+			result := memberTradeCursor(cursor, member, "0x01", marketInjUstID)
+			assert.Equal(t, since, result.LastExecutedAt)
+			assert.Empty(t, result.LastTradeIDs)
+			assert.Zero(t, result.ResumeSkip)
+			assert.Equal(t, primitive.NewDecimal128(0, 0), result.PositionQuantity)
+			assert.Equal(t, primitive.NewDecimal128(0, 0), result.AvgEntryPrice)
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package guildsprocess
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/exchange"
+	metrics "github.com/InjectiveLabs/metrics"
+	log "github.com/xlab/suplog"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ingestTransfers stores transfers of members' trading subaccounts since they joined
+func (p *GuildsProcess) ingestTransfers(ctx context.Context) error {
+	doneFn := metrics.ReportFuncTiming(p.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(p.svcTags)
+
+	guilds, err := p.dbSvc.ListAllGuilds(ctx, false)
+	if err != nil {
+		metrics.ReportFuncError(p.svcTags)
+		return fmt.Errorf("list guild err: %w", err)
+	}
+
+	for _, guild := range guilds {
+		guildID := guild.ID.Hex()
+		members, err := p.dbSvc.ListGuildMembers(ctx, model.MemberFilter{
+			GuildID: &guildID,
+		})
+		if err != nil {
+			p.logger.
+				WithField("guild_id", guildID).
+				WithError(err).Warningln("list members error, skip this guild")
+			continue
+		}
+
+		countTransfers := 0
+		for _, member := range members {
+			count, err := p.ingestMemberTransfers(ctx, guild, member)
+			if err != nil {
+				metrics.ReportFuncError(p.svcTags)
+				p.logger.WithFields(log.Fields{
+					"guild_id":          guildID,
+					"injective_address": member.InjectiveAddress.String(),
+				}).WithError(err).Warningln("ingest transfers error")
+			}
+			countTransfers += count
+		}
+
+		p.logger.WithField("count", countTransfers).WithField("guild_id", guildID).Info("ingested transfers")
+	}
+
+	return nil
+}
+
+// ingestMemberTransfers stores transfers of member trading subaccounts, returns number of transfers fetched.
+// Subaccounts are ingested even if others fail, the first error is returned
+func (p *GuildsProcess) ingestMemberTransfers(ctx context.Context, guild *model.Guild, member *model.GuildMember) (count int, err error) {
+	subaccountIDs := member.TradingSubaccountIDs()
+	tracked := make(map[string]bool)
+	for _, id := range subaccountIDs {
+		tracked[strings.ToLower(id)] = true
+	}
+
+	for _, subaccountID := range subaccountIDs {
+		history, histErr := p.exchange.GetSubaccountHistory(ctx, subaccountID)
+		if histErr != nil {
+			if err == nil {
+				err = fmt.Errorf("get subaccount history err: %w", histErr)
+			}
+			continue
+		}
+
+		transfers := buildTransfers(guild, member, subaccountID, tracked, history)
+		if addErr := p.dbSvc.AddTransfers(ctx, transfers); addErr != nil {
+			if err == nil {
+				err = fmt.Errorf("add transfers err: %w", addErr)
+			}
+			continue
+		}
+		count += len(transfers)
+	}
+
+	return count, err
+}
+
+// buildTransfers picks transfers executed since member joined which move value in or out of subaccountID:
+// deposits, withdrawals and transfers with subaccounts not in tracked
+func buildTransfers(
+	guild *model.Guild,
+	member *model.GuildMember,
+	subaccountID string,
+	tracked map[string]bool,
+	history []*exchange.SubaccountTransfer,
+) []*model.Transfer {
+	sinceMs := member.Since.UnixMilli()
+	lowerSubaccountID := strings.ToLower(subaccountID)
+
+	var result []*model.Transfer
+	for _, t := range history {
+		if t.ExecutedAt < sinceMs {
+			continue
+		}
+
+		amount := t.Amount
+		switch t.TransferType {
+		case exchange.TransferTypeDeposit:
+		case exchange.TransferTypeWithdraw:
+			amount = amount.Neg()
+		case exchange.TransferTypeInternal, exchange.TransferTypeExternal:
+			src, dst := strings.ToLower(t.SrcSubaccountID), strings.ToLower(t.DstSubaccountID)
+			switch {
+			case dst == lowerSubaccountID && !tracked[src]:
+			case src == lowerSubaccountID && !tracked[dst]:
+				amount = amount.Neg()
+			default:
+				continue
+			}
+		default:
+			continue
+		}
+
+		transfer := &model.Transfer{
+			TransferID:       transferID(subaccountID, t),
+			GuildID:          guild.ID,
+			InjectiveAddress: member.InjectiveAddress,
+			SubaccountID:     subaccountID,
+			TransferType:     t.TransferType,
+			SrcSubaccountID:  t.SrcSubaccountID,
+			DstSubaccountID:  t.DstSubaccountID,
+			Denom:            t.Denom,
+			ExecutedAt:       time.UnixMilli(t.ExecutedAt),
+		}
+		transfer.Amount, _ = primitive.ParseDecimal128(amount.String())
+		result = append(result, transfer)
+	}
+	return result
+}
+
+// transferID derives a stable id since exchange api transfers have no id
+func transferID(subaccountID string, t *exchange.SubaccountTransfer) string {
+	h := sha256.Sum256([]byte(fmt.Sprintf(
+		"%s|%s|%s|%s|%s|%s|%d",
+		subaccountID, t.TransferType, t.SrcSubaccountID, t.DstSubaccountID, t.Denom, t.Amount.String(), t.ExecutedAt,
+	)))
+	return hex.EncodeToString(h[:])
+}
@@ -0,0 +1,41 @@
+package guildsprocess
+
+import (
+	"testing"
+	"time"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/exchange"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestBuildTransfers(t *testing.T) {
+	guild := &model.Guild{ID: primitive.NewObjectID()}
+	member := &model.GuildMember{Since: time.UnixMilli(1000)}
+	subaccountID := "0xc7dc5d3361d1c5c0a0018c47e8bc5364c6a0e101000000000000000000000000"
+
+	otherTracked := "0xc7dc5d3361d1c5c0a0018c47e8bc5364c6a0e101000000000000000000000001"
+	tracked := map[string]bool{subaccountID: true, otherTracked: true}
+
+	history := []*exchange.SubaccountTransfer{
+		{TransferType: exchange.TransferTypeDeposit, Denom: "inj", Amount: decimal.NewFromInt(5), ExecutedAt: 500},
+		{TransferType: exchange.TransferTypeDeposit, Denom: "inj", Amount: decimal.NewFromInt(10), ExecutedAt: 1000},
+		{TransferType: exchange.TransferTypeWithdraw, Denom: "inj", Amount: decimal.NewFromInt(4), ExecutedAt: 2000},
+		// between tracked subaccounts, not a flow
+		{TransferType: exchange.TransferTypeInternal, SrcSubaccountID: subaccountID, DstSubaccountID: otherTracked, Denom: "inj", Amount: decimal.NewFromInt(1), ExecutedAt: 3000},
+		{TransferType: exchange.TransferTypeExternal, SrcSubaccountID: subaccountID, DstSubaccountID: "0xabc", Denom: "inj", Amount: decimal.NewFromInt(2), ExecutedAt: 4000},
+		{TransferType: exchange.TransferTypeExternal, SrcSubaccountID: "0xabc", DstSubaccountID: subaccountID, Denom: "inj", Amount: decimal.NewFromInt(3), ExecutedAt: 5000},
+	}
+
+	transfers := buildTransfers(guild, member, subaccountID, tracked, history)
+	assert.Len(t, transfers, 4)
+	assert.Equal(t, "10", transfers[0].Amount.String())
+	assert.Equal(t, "-4", transfers[1].Amount.String())
+	assert.Equal(t, "-2", transfers[2].Amount.String())
+	assert.Equal(t, "3", transfers[3].Amount.String())
+	assert.Equal(t, guild.ID, transfers[1].GuildID)
+	assert.NotEqual(t, transfers[0].TransferID, transfers[1].TransferID)
+	assert.Equal(t, transfers[0].TransferID, buildTransfers(guild, member, subaccountID, tracked, history)[0].TransferID)
+}
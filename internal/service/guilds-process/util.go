@@ -1,12 +1,12 @@
 package guildsprocess
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/InjectiveLabs/injective-guilds-service/internal/config"
 	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
 	"github.com/InjectiveLabs/injective-guilds-service/internal/exchange"
-	"github.com/ethereum/go-ethereum/common"
 	"github.com/shopspring/decimal"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
@@ -82,6 +82,35 @@ func buildPortfolio(
 	return portfolio
 }
 
+// buildPositions converts positions of a subaccount, quote denom is attached for guild markets
+func buildPositions(
+	guild *model.Guild,
+	subaccountID string,
+	positions []*exchange.DerivativePosition,
+) []*model.Position {
+	idToMarket := getIDToMarket(guild)
+	result := make([]*model.Position, 0, len(positions))
+	for _, pos := range positions {
+		aPosition := &model.Position{
+			SubaccountID: subaccountID,
+			MarketID:     pos.MarketID,
+			Ticker:       pos.Ticker,
+			Direction:    pos.Direction,
+		}
+		if market, exist := idToMarket[pos.MarketID]; exist {
+			aPosition.QuoteDenom = market.QuoteDenom
+		}
+
+		aPosition.Quantity, _ = primitive.ParseDecimal128(pos.Quantity.String())
+		aPosition.EntryPrice, _ = primitive.ParseDecimal128(pos.EntryPrice.String())
+		aPosition.MarkPrice, _ = primitive.ParseDecimal128(pos.MarkPrice.String())
+		aPosition.Margin, _ = primitive.ParseDecimal128(pos.Margin.String())
+		aPosition.LiquidationPrice, _ = primitive.ParseDecimal128(pos.LiquidationPrice.String())
+		result = append(result, aPosition)
+	}
+	return result
+}
+
 func marketsFromGuild(guild *model.Guild, isPerp bool) []string {
 	var result []string
 	for _, m := range guild.Markets {
@@ -92,9 +121,32 @@ func marketsFromGuild(guild *model.Guild, isPerp bool) []string {
 	return result
 }
 
-func defaultSubaccountIDFromInjAddress(injAddress model.Address) string {
-	ethAddr := common.BytesToAddress(injAddress.Bytes())
-	return ethAddr.Hex() + "000000000000000000000000"
+// mergeBalances sums balances of the same denom, inputs are not modified
+func mergeBalances(a, b []*exchange.Balance) []*exchange.Balance {
+	if len(a) == 0 {
+		return b
+	}
+
+	result := make([]*exchange.Balance, 0, len(a))
+	denomToBalance := make(map[string]*exchange.Balance)
+	for _, balances := range [][]*exchange.Balance{a, b} {
+		for _, balance := range balances {
+			merged, exist := denomToBalance[balance.Denom]
+			if !exist {
+				merged = &exchange.Balance{
+					Denom:            balance.Denom,
+					TotalBalance:     decimal.Zero,
+					AvailableBalance: decimal.Zero,
+				}
+				denomToBalance[balance.Denom] = merged
+				result = append(result, merged)
+			}
+
+			merged.TotalBalance = merged.TotalBalance.Add(balance.TotalBalance)
+			merged.AvailableBalance = merged.AvailableBalance.Add(balance.AvailableBalance)
+		}
+	}
+	return result
 }
 
 func sum(a primitive.Decimal128, b primitive.Decimal128) primitive.Decimal128 {
@@ -103,3 +155,17 @@ func sum(a primitive.Decimal128, b primitive.Decimal128) primitive.Decimal128 {
 	result, _ := primitive.ParseDecimal128(parsedA.Add(parsedB).String())
 	return result
 }
+
+// GrantExpirations returns map[msg]expiration of given grants
+func GrantExpirations(grants *exchange.Grants) (map[string]time.Time, error) {
+	msgToExpiration := make(map[string]time.Time)
+	for _, g := range grants.Grants {
+		t, err := time.Parse(expirationTimeLayout, g.Expiration)
+		if err != nil {
+			return nil, fmt.Errorf("time parse err: %w", err)
+		}
+
+		msgToExpiration[g.Authorization.Msg] = t
+	}
+	return msgToExpiration, nil
+}
@@ -0,0 +1,163 @@
+package guildsprocess
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/prom"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/webhook"
+	metrics "github.com/InjectiveLabs/metrics"
+	log "github.com/xlab/suplog"
+)
+
+// checkWaitlistEntry re-checks qualification of a waitlisted address like entering guild does,
+// it returns reason if address is no longer qualified, and portfolio to join with otherwise
+func (p *GuildsProcess) checkWaitlistEntry(
+	ctx context.Context,
+	guild *model.Guild,
+	member *model.GuildMember,
+	denomToUsdPrice map[string]float64,
+) (string, *model.AccountPortfolio, error) {
+	portfolio, err := p.portfolioHelper.CaptureSingleMemberPortfolio(ctx, guild, member, true)
+	if err != nil {
+		return "", nil, fmt.Errorf("capture portfolio err: %w", err)
+	}
+
+	unqualified, err := CheckQualification(ctx, p.exchange, guild, member, portfolio, denomToUsdPrice, p.grants)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if unqualified != nil {
+		return unqualified.Reason, nil, nil
+	}
+	return "", portfolio, nil
+}
+
+// admitWaitlist fills open slots of guilds from their waitlists in order,
+// slots open when members leave or are disqualified, or capacity is raised.
+// Addresses no longer qualified are dropped from waitlist
+func (p *GuildsProcess) admitWaitlist(ctx context.Context) error {
+	doneFn := metrics.ReportFuncTiming(p.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(p.svcTags)
+
+	guilds, err := p.dbSvc.ListAllGuilds(ctx, false)
+	if err != nil {
+		metrics.ReportFuncError(p.svcTags)
+		return fmt.Errorf("list guild err: %w", err)
+	}
+
+	for _, guild := range guilds {
+		guildID := guild.ID.Hex()
+		entries, err := p.dbSvc.ListWaitlist(ctx, guildID)
+		if err != nil {
+			metrics.ReportFuncError(p.svcTags)
+			p.logger.WithField("guild_id", guildID).WithError(err).Warningln("list waitlist error")
+			continue
+		}
+		prom.GuildWaitlistLength.WithLabelValues(guildID).Set(float64(len(entries)))
+
+		freeSlots := guild.Capacity - guild.MemberCount
+		if len(entries) == 0 || freeSlots <= 0 {
+			continue
+		}
+
+		limit := int64(1)
+		guildPortfolios, err := p.dbSvc.ListGuildPortfolios(ctx, model.GuildPortfoliosFilter{
+			GuildID: guildID,
+			Limit:   &limit,
+		})
+		if err != nil || len(guildPortfolios) == 0 {
+			metrics.ReportFuncError(p.svcTags)
+			p.logger.WithField("guild_id", guildID).WithError(err).Warningln("no guild portfolio to get denom prices, skip waitlist")
+			continue
+		}
+		denomToUsdPrice := DenomPricesUSD(guildPortfolios[0])
+
+		countAdmitted, countDropped := 0, 0
+		for _, entry := range entries {
+			if freeSlots <= 0 {
+				break
+			}
+
+			address := entry.InjectiveAddress.String()
+			logger := p.logger.WithFields(log.Fields{
+				"guild_id":          guildID,
+				"injective_address": address,
+			})
+
+			member := &model.GuildMember{
+				GuildID:          guild.ID,
+				InjectiveAddress: entry.InjectiveAddress,
+				SubaccountIDs:    entry.SubaccountIDs,
+			}
+			// access might have been changed while address is waiting
+			reason, err := CheckAccess(ctx, p.dbSvc, guild, entry.InjectiveAddress, entry.InviteCode, entry.InviteCode)
+			var portfolio *model.AccountPortfolio
+			if err == nil && reason == "" {
+				reason, portfolio, err = p.checkWaitlistEntry(ctx, guild, member, denomToUsdPrice)
+			}
+
+			if err != nil {
+				// entry keeps its position, it's checked again next run
+				metrics.ReportFuncError(p.svcTags)
+				logger.WithError(err).Warningln("check waitlist entry error")
+				continue
+			}
+
+			if reason == "" {
+				err = AddMemberWithAccess(
+					ctx, p.dbSvc, guild, entry.InjectiveAddress, portfolio, entry.Params, entry.SubaccountIDs, entry.InviteCode,
+				)
+				if errors.Is(err, db.ErrMemberExceedCap) {
+					// someone entered directly in the meantime
+					break
+				}
+
+				if errors.Is(err, db.ErrAlreadyMember) {
+					// address joined this or another guild in the meantime
+					reason = db.ErrAlreadyMember.Error()
+				} else if errors.Is(err, db.ErrInvalidInviteCode) {
+					// code was used up while address is waiting, entry has no use reserved
+					reason = AccessReasonInvalidInviteCode
+				} else if err != nil {
+					metrics.ReportFuncError(p.svcTags)
+					logger.WithError(err).Warningln("cannot admit waitlisted address")
+					continue
+				}
+			}
+
+			if reason != "" {
+				if err := p.dbSvc.RemoveFromWaitlist(ctx, guildID, entry.InjectiveAddress); err != nil && err != db.ErrNotFound {
+					metrics.ReportFuncError(p.svcTags)
+					logger.WithError(err).Warningln("cannot remove address from waitlist")
+					continue
+				}
+
+				logger.WithField("reason", reason).Info("address dropped from waitlist")
+				countDropped++
+				continue
+			}
+
+			freeSlots--
+			countAdmitted++
+			logger.Info("waitlisted address admitted to guild")
+			p.dispatcher.Dispatch(guildID, webhook.EventMemberJoined, &webhook.MemberData{
+				InjectiveAddress: address,
+			})
+		}
+
+		prom.GuildWaitlistLength.WithLabelValues(guildID).Set(float64(len(entries) - countAdmitted - countDropped))
+		p.logger.WithFields(log.Fields{
+			"guild_id": guildID,
+			"admitted": countAdmitted,
+			"dropped":  countDropped,
+		}).Info("processed guild waitlist")
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package guildsprocess
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/exchange"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/webhook"
+	cosmtypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	log "github.com/xlab/suplog"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type waitlistTest struct {
+	ctx          context.Context
+	process      *GuildsProcess
+	dbSvc        *db.MockDBService
+	exchange     *exchange.MockDataProvider
+	guild        *model.Guild
+	qualified    []model.Address
+	missingGrant model.Address
+}
+
+// newWaitlistTest sets up a guild with 2 free slots, waitlisted addresses are unqualified one
+// (missing grant) followed by 3 qualified ones
+func newWaitlistTest(t *testing.T) *waitlistTest {
+	ctrl := gomock.NewController(t)
+	dbSvc := db.NewMockDBService(ctrl)
+	mockExchange := exchange.NewMockDataProvider(ctrl)
+	logger := log.WithField("svc", "test")
+
+	helper, _ := NewPortfolioHelper(context.Background(), mockExchange, logger)
+	wt := &waitlistTest{
+		ctx: context.Background(),
+		process: &GuildsProcess{
+			dbSvc:           dbSvc,
+			exchange:        mockExchange,
+			logger:          logger,
+			portfolioHelper: helper,
+			dispatcher:      webhook.NewDispatcher(dbSvc, logger),
+			grants:          []string{testGrantMsg},
+		},
+		dbSvc:    dbSvc,
+		exchange: mockExchange,
+		guild: &model.Guild{
+			ID:            primitive.NewObjectID(),
+			MasterAddress: model.Address{AccAddress: cosmtypes.AccAddress("waitlist_master_____")},
+			Capacity:      3,
+			MemberCount:   1,
+		},
+		missingGrant: model.Address{AccAddress: cosmtypes.AccAddress("waitlist_no_grant___")},
+	}
+	for _, a := range []string{"waitlist_qualified_1", "waitlist_qualified_2", "waitlist_qualified_3"} {
+		wt.qualified = append(wt.qualified, model.Address{AccAddress: cosmtypes.AccAddress(a)})
+	}
+
+	guildID := wt.guild.ID.Hex()
+	entries := []*model.WaitlistEntry{{GuildID: wt.guild.ID, InjectiveAddress: wt.missingGrant}}
+	for _, a := range wt.qualified {
+		entries = append(entries, &model.WaitlistEntry{GuildID: wt.guild.ID, InjectiveAddress: a})
+	}
+
+	dbSvc.EXPECT().ListAllGuilds(wt.ctx, false).Return([]*model.Guild{wt.guild}, nil)
+	dbSvc.EXPECT().ListWaitlist(wt.ctx, guildID).Return(entries, nil)
+	dbSvc.EXPECT().ListGuildPortfolios(wt.ctx, gomock.Any()).Return([]*model.GuildPortfolio{{GuildID: wt.guild.ID}}, nil)
+	dbSvc.EXPECT().ListWebhooks(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+
+	// empty portfolios, guild has no requirements
+	mockExchange.EXPECT().GetSubaccountBalances(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockExchange.EXPECT().GetPositions(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockExchange.EXPECT().GetSpotOrders(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockExchange.EXPECT().GetDerivativeOrders(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockExchange.EXPECT().GetBankBalance(gomock.Any(), gomock.Any()).Return(&exchange.BankAccountBalances{}, nil).AnyTimes()
+	mockExchange.EXPECT().GetPriceUSD(gomock.Any(), gomock.Any()).
+		Return([]*exchange.CoinPrice{{ID: "injective-protocol", CurrentPrice: 10}}, nil).AnyTimes()
+
+	master := wt.guild.MasterAddress.String()
+	expiration := time.Now().Add(24 * time.Hour)
+	mockExchange.EXPECT().GetGrants(gomock.Any(), wt.missingGrant.String(), master).Return(newTestGrants(t, expiration), nil).AnyTimes()
+	for _, a := range wt.qualified {
+		mockExchange.EXPECT().GetGrants(gomock.Any(), a.String(), master).Return(newTestGrants(t, expiration, testGrantMsg), nil).AnyTimes()
+	}
+	return wt
+}
+
+func (wt *waitlistTest) run(t *testing.T) {
+	assert.NoError(t, wt.process.admitWaitlist(wt.ctx))
+	assert.NoError(t, wt.process.dispatcher.Wait(wt.ctx))
+}
+
+func TestAdmitWaitlist(t *testing.T) {
+	wt := newWaitlistTest(t)
+	guildID := wt.guild.ID.Hex()
+
+	// unqualified head is dropped, then 2 free slots are filled in order, last address keeps waiting
+	wt.dbSvc.EXPECT().RemoveFromWaitlist(wt.ctx, guildID, wt.missingGrant).Return(nil)
+	gomock.InOrder(
+		wt.dbSvc.EXPECT().AddMember(wt.ctx, guildID, wt.qualified[0], gomock.Any(), false, "", nil, "").Return(nil),
+		wt.dbSvc.EXPECT().AddMember(wt.ctx, guildID, wt.qualified[1], gomock.Any(), false, "", nil, "").Return(nil),
+	)
+	wt.run(t)
+}
+
+func TestAdmitWaitlistStopsWhenGuildIsFull(t *testing.T) {
+	wt := newWaitlistTest(t)
+	guildID := wt.guild.ID.Hex()
+
+	// someone entered directly, remaining entries keep their positions
+	wt.dbSvc.EXPECT().RemoveFromWaitlist(wt.ctx, guildID, wt.missingGrant).Return(nil)
+	wt.dbSvc.EXPECT().AddMember(wt.ctx, guildID, wt.qualified[0], gomock.Any(), false, "", nil, "").Return(db.ErrMemberExceedCap)
+	wt.run(t)
+}
+
+func TestAdmitWaitlistDropsAlreadyMember(t *testing.T) {
+	wt := newWaitlistTest(t)
+	guildID := wt.guild.ID.Hex()
+
+	wt.dbSvc.EXPECT().RemoveFromWaitlist(wt.ctx, guildID, wt.missingGrant).Return(nil)
+	wt.dbSvc.EXPECT().AddMember(wt.ctx, guildID, wt.qualified[0], gomock.Any(), false, "", nil, "").Return(db.ErrAlreadyMember)
+	wt.dbSvc.EXPECT().RemoveFromWaitlist(wt.ctx, guildID, wt.qualified[0]).Return(nil)
+	gomock.InOrder(
+		wt.dbSvc.EXPECT().AddMember(wt.ctx, guildID, wt.qualified[1], gomock.Any(), false, "", nil, "").Return(nil),
+		wt.dbSvc.EXPECT().AddMember(wt.ctx, guildID, wt.qualified[2], gomock.Any(), false, "", nil, "").Return(nil),
+	)
+	wt.run(t)
+}
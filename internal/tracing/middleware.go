@@ -0,0 +1,33 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/trace"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// HTTPHandler wraps h so that every request gets a server span continuing incoming trace context
+func HTTPHandler(h http.Handler, operation string) http.Handler {
+	return otelhttp.NewHandler(h, operation)
+}
+
+// EndpointMiddleware names the current server span after goa service method,
+// avoids high cardinality span names from request paths
+func EndpointMiddleware(e goa.Endpoint) goa.Endpoint {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		span := trace.SpanFromContext(ctx)
+		if method, ok := ctx.Value(goa.MethodKey).(string); ok {
+			service, _ := ctx.Value(goa.ServiceKey).(string)
+			span.SetName(service + "." + method)
+		}
+
+		res, err := e(ctx, req)
+		if err != nil {
+			span.RecordError(err)
+		}
+		return res, err
+	}
+}
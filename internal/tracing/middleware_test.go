@@ -0,0 +1,33 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	goa "goa.design/goa/v3/pkg"
+)
+
+func TestEndpointMiddleware(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	ctx, span := provider.Tracer("test").Start(context.Background(), "GET /guilds/123")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "GuildsService")
+	ctx = context.WithValue(ctx, goa.MethodKey, "GetSingleGuild")
+
+	endpoint := EndpointMiddleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, errors.New("not found")
+	})
+	_, err := endpoint(ctx, nil)
+	assert.Error(t, err)
+	span.End()
+
+	spans := recorder.Ended()
+	assert.Len(t, spans, 1)
+	assert.Equal(t, "GuildsService.GetSingleGuild", spans[0].Name())
+	assert.Len(t, spans[0].Events(), 1)
+}
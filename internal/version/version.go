@@ -0,0 +1,26 @@
+package version
+
+import "runtime"
+
+// set by -ldflags at build time, see Makefile
+var (
+	AppVersion = "dev"
+	GitCommit  = "unknown"
+	BuildTime  = "unknown"
+)
+
+type Info struct {
+	AppVersion string `json:"app_version"`
+	GitCommit  string `json:"git_commit"`
+	BuildTime  string `json:"build_time"`
+	GoVersion  string `json:"go_version"`
+}
+
+func GetInfo() Info {
+	return Info{
+		AppVersion: AppVersion,
+		GitCommit:  GitCommit,
+		BuildTime:  BuildTime,
+		GoVersion:  runtime.Version(),
+	}
+}
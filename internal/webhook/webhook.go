@@ -0,0 +1,253 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db"
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
+	metrics "github.com/InjectiveLabs/metrics"
+	log "github.com/xlab/suplog"
+)
+
+const (
+	EventMemberJoined       = "member_joined"
+	EventMemberLeft         = "member_left"
+	EventMemberDisqualified = "member_disqualified"
+	EventCapacityChanged    = "capacity_changed"
+	EventPortfolioCaptured  = "portfolio_captured"
+
+	SignatureHeader = "X-Guilds-Signature"
+	EventHeader     = "X-Guilds-Event"
+
+	// maxAttempts includes first delivery, so failed deliveries are retried maxAttempts-1 times
+	maxAttempts    = 3
+	initialBackoff = time.Second
+	deliverTimeout = time.Minute
+)
+
+var Events = []string{
+	EventMemberJoined,
+	EventMemberLeft,
+	EventMemberDisqualified,
+	EventCapacityChanged,
+	EventPortfolioCaptured,
+}
+
+func IsValidEvent(event string) bool {
+	for _, e := range Events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// Payload is the json body posted to webhook url
+type Payload struct {
+	Event     string      `json:"event"`
+	GuildID   string      `json:"guild_id"`
+	CreatedAt int64       `json:"created_at"` // unix timestamp, millisecond
+	Data      interface{} `json:"data"`
+}
+
+type MemberData struct {
+	InjectiveAddress string `json:"injective_address"`
+	Reason           string `json:"reason,omitempty"`
+}
+
+type CapacityData struct {
+	Capacity    int `json:"capacity"`
+	MemberCount int `json:"member_count"`
+}
+
+type PortfolioData struct {
+	MemberCount int   `json:"member_count"`
+	UpdatedAt   int64 `json:"updated_at"` // unix timestamp, millisecond
+}
+
+// Dispatcher delivers guild events to subscribed webhooks
+type Dispatcher struct {
+	dbSvc      db.DBService
+	httpClient *http.Client
+	logger     log.Logger
+	svcTags    metrics.Tags
+
+	// inFlight tracks background deliveries, so shutdown can wait for them
+	inFlight sync.WaitGroup
+}
+
+func NewDispatcher(dbSvc db.DBService, logger log.Logger) *Dispatcher {
+	return &Dispatcher{
+		dbSvc: dbSvc,
+		httpClient: &http.Client{
+			Timeout: 10 * time.Second,
+		},
+		logger: logger,
+		svcTags: metrics.Tags{
+			"svc": "webhook_dispatcher",
+		},
+	}
+}
+
+// Dispatch delivers event in background, so callers are not blocked by slow webhooks.
+// Call Wait before exiting to not drop deliveries in flight
+func (d *Dispatcher) Dispatch(guildID string, event string, data interface{}) {
+	d.inFlight.Add(1)
+	go func() {
+		defer d.inFlight.Done()
+
+		ctx, cancel := context.WithTimeout(context.Background(), deliverTimeout)
+		defer cancel()
+
+		d.DispatchSync(ctx, guildID, event, data)
+	}()
+}
+
+// Wait blocks until background deliveries are done or ctx is done
+func (d *Dispatcher) Wait(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		d.inFlight.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// DispatchSync delivers event to all subscribed webhooks of a guild, errors are logged
+func (d *Dispatcher) DispatchSync(ctx context.Context, guildID string, event string, data interface{}) {
+	doneFn := metrics.ReportFuncTiming(d.svcTags)
+	defer doneFn()
+	metrics.ReportFuncCall(d.svcTags)
+
+	webhooks, err := d.dbSvc.ListWebhooks(ctx, model.WebhookFilter{
+		GuildID: &guildID,
+		Event:   &event,
+	})
+	if err != nil {
+		metrics.ReportFuncError(d.svcTags)
+		d.logger.WithError(err).WithField("guild_id", guildID).Errorln("list webhooks error")
+		return
+	}
+
+	if len(webhooks) == 0 {
+		return
+	}
+
+	body, err := json.Marshal(&Payload{
+		Event:     event,
+		GuildID:   guildID,
+		CreatedAt: time.Now().UnixMilli(),
+		Data:      data,
+	})
+	if err != nil {
+		metrics.ReportFuncError(d.svcTags)
+		d.logger.WithError(err).Errorln("marshal webhook payload error")
+		return
+	}
+
+	for _, w := range webhooks {
+		delivery := d.deliver(ctx, w, event, body)
+		if !delivery.Success {
+			metrics.ReportFuncError(d.svcTags)
+			d.logger.WithFields(log.Fields{
+				"webhook_id": w.ID.Hex(),
+				"guild_id":   guildID,
+				"event":      event,
+			}).Warningln("webhook delivery failed: ", delivery.Error)
+		}
+
+		if err := d.dbSvc.AddWebhookDelivery(ctx, delivery); err != nil {
+			d.logger.WithError(err).WithField("webhook_id", w.ID.Hex()).Errorln("cannot log webhook delivery")
+		}
+	}
+}
+
+// deliver posts body to webhook, retries with exponential backoff
+func (d *Dispatcher) deliver(ctx context.Context, w *model.Webhook, event string, body []byte) *model.WebhookDelivery {
+	delivery := &model.WebhookDelivery{
+		WebhookID: w.ID,
+		GuildID:   w.GuildID,
+		Event:     event,
+		Payload:   string(body),
+		CreatedAt: time.Now(),
+	}
+
+	backoff := initialBackoff
+	for delivery.Attempts < maxAttempts {
+		delivery.Attempts++
+
+		statusCode, err := d.post(ctx, w, event, body)
+		delivery.StatusCode = statusCode
+		if err == nil {
+			delivery.Success = true
+			delivery.Error = ""
+			return delivery
+		}
+		delivery.Error = err.Error()
+
+		if delivery.Attempts < maxAttempts {
+			select {
+			case <-ctx.Done():
+				return delivery
+			case <-time.After(backoff):
+			}
+			backoff *= 2
+		}
+	}
+
+	return delivery
+}
+
+func (d *Dispatcher) post(ctx context.Context, w *model.Webhook, event string, body []byte) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("new request err: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, event)
+	req.Header.Set(SignatureHeader, "sha256="+Sign(w.Secret, body))
+
+	resp, err := d.httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("request err: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("response bad status: %d", resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
+
+// Sign returns hex encoded HMAC-SHA256 of body using secret
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// NewSecret generates a random secret to sign payloads
+func NewSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
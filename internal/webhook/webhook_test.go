@@ -0,0 +1,59 @@
+package webhook
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/InjectiveLabs/injective-guilds-service/internal/db/model"
+	"github.com/stretchr/testify/assert"
+	log "github.com/xlab/suplog"
+)
+
+func TestDeliverSignsAndRetries(t *testing.T) {
+	secret := "secret"
+	body := []byte(`{"event":"member_joined"}`)
+
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		received, _ := ioutil.ReadAll(r.Body)
+		assert.Equal(t, body, received)
+		assert.Equal(t, "sha256="+Sign(secret, body), r.Header.Get(SignatureHeader))
+		assert.Equal(t, EventMemberJoined, r.Header.Get(EventHeader))
+
+		// first attempt fails
+		if calls == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	d := NewDispatcher(nil, log.WithField("svc", "test"))
+	delivery := d.deliver(context.Background(), &model.Webhook{
+		URL:    server.URL,
+		Secret: secret,
+	}, EventMemberJoined, body)
+
+	assert.True(t, delivery.Success)
+	assert.Equal(t, 2, delivery.Attempts)
+	assert.Equal(t, http.StatusOK, delivery.StatusCode)
+	assert.Empty(t, delivery.Error)
+}
+
+func TestWaitDrainsInFlight(t *testing.T) {
+	d := NewDispatcher(nil, log.WithField("svc", "test"))
+	d.inFlight.Add(1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, d.Wait(ctx), context.DeadlineExceeded)
+
+	d.inFlight.Done()
+	assert.NoError(t, d.Wait(context.Background()))
+}